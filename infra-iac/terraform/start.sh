@@ -202,6 +202,10 @@ fi
 ADMIN_TOKEN=$(openssl rand -base64 32 | tr -dc 'a-zA-Z0-9!@#$%^&*()_+{}|:<>?=-' | head -c 30)
 echo "admin_token=${ADMIN_TOKEN}" >> "$CONFIG_FILE"
 
+# Generate random seed shared by the API, orchestrator and client proxy for the sandbox port access tokens
+PORT_ACCESS_TOKEN_SEED=$(openssl rand -hex 32)
+echo "port_access_token_seed=${PORT_ACCESS_TOKEN_SEED}" >> "$CONFIG_FILE"
+
 # Get ECR token
 ECR_TOKEN=$(aws ecr get-login-password --region "$AWSREGION" 2>/dev/null)
 if [ -n "$ECR_TOKEN" ]; then
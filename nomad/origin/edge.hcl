@@ -88,6 +88,8 @@ job "client-proxy" {
         LOGS_COLLECTOR_ADDRESS        = "analytics_collector_host"
         REDIS_URL                     = "${REDIS_ENDPOINT}:6379"
         LOKI_URL                      = "http://loki.service.consul:3100"
+
+        SANDBOX_PORT_ACCESS_TOKEN_SEED = "${port_access_token_seed}"
       }
 
       config {
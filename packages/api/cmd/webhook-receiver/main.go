@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+)
+
+// Receives the webhook deliveries, verifies their signatures and prints the events.
+func main() {
+	port := flag.Int("port", 8090, "port to listen on")
+	secret := flag.String("secret", "", "secret of the webhook returned when it was created")
+
+	flag.Parse()
+
+	if *secret == "" {
+		log.Fatal("the webhook secret is required")
+	}
+
+	receiver := webhooks.NewReceiver(*secret, func(event webhooks.Event) {
+		data, err := json.MarshalIndent(event, "", "  ")
+		if err != nil {
+			log.Printf("error printing the event: %v", err)
+
+			return
+		}
+
+		fmt.Println(string(data))
+	})
+
+	log.Printf("listening for webhook deliveries on :%d", *port)
+
+	err := http.ListenAndServe(fmt.Sprintf(":%d", *port), receiver)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
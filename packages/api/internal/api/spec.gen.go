@@ -18,77 +18,155 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xd3W/bOpb/VwjtPuwCbuzmdi52AsxDmvbOFrftBE3aWaA3WNDSccyJROqSVBIj8P8+",
-	"4JdESdSHHcdJ2jy1kSjy8Jwfzwd5Dn0XxSzLGQUqRXR0F+WY4wwkcP0XjmMQ4pxdAf3wTj0gNDqKciyX",
-	"0SSiOIPoqNFmEnH4syAckuhI8gImkYiXkGH1sVzl6gMhOaGX0Xo9iXBOfodVd9fu9Wa9zguSJp2dureb",
-	"9UlZAp1d2peb9SgwTebstrPT6v1m/UrI8hTLbmq9Bpv0vFaNRc6oAI2NN7OZ+idmVAKVGi15npIYS8Lo",
-	"9F+CUfWs6u8/OSyio+g/phXgpuatmL7nnHEzRgIi5iRXnURH0VucIEUiCBmtJ9Gb2euHH/O4kEug0vaK",
-	"wLRTg795+ME/M4kWrKCJGfGvDz/iCaOLlMSav3/Zh0zPgF8Dd3xdO8xpUJ2cfj1hhRm6QebpVxQzDgIt",
-	"GEdyCcgukGgSLRjPsIyOIkLlL4fRJMoIJVmRRUevJw7HhEq4BC3IEw5YQnJcKS6t+TjLgUti0B3bNgFK",
-	"zkkGQuIsR2yBjPZDUvWC9EeqkUdSgiW8kiSDaNJcUpOIJO3uPyQKfAsCXPWvJuqP4XddFCQJ9ZphcTUk",
-	"nWqUT1hcEXr5DiQmqVDfGz3RpOszzqCDohYF0jG1wbkloEWRpitk2TvQ0drXT98jPVtNnBvBznXiieui",
-	"EvA54Oz49MPvsNpevsenH9AVrDYXrR3grR4bp+k/FtHR936ZKHq/CoXRi0lEizTF8xSMYh6NFUvvGJhc",
-	"Gb7Ue/yCb9A1Tgtod9jqIMVCfhUQoOsjFhIpziC5JKJk4g0WqFAfdDCxPudHQXbndENYNA0tBC0w60h8",
-	"T6+/YetLJQlRA+L0tIbEOi3v6TXhjGZAJbrGnCh2hFRemzqjb9tAZ0lgyrox0u8C6rOtMjMQAl92dTTI",
-	"LTuQ60VxplNMrRko/kLyTYHylMOC3LapMM81thChyHyBroELZcGtaI2OZrwLzt44Z8UiOI55fs9x8v5J",
-	"yCWWiDjuiFaXSHcY6Fcv249AL+UysCL1834SS3k3pGcJro8wCcglxEMl649ESEjOLHhbAsYpwYGlcKwe",
-	"lxRbpzWoalMCVBp/d0g/mrbBXvKidD369EvpoqwnEdBOC4JulkD9RYtuSJoiuM0Jh9FWJIOM8dWnt0NE",
-	"fXLt9DcSJ1gOOmhWHp9c82ZMMsTKTmU0iYTEvMe6BniDBbIfjeaNkAoP4yZ5ptu2oqOhKbrWaMFZhm6W",
-	"JF4iImqUW30/qAJrUZcf25Xo9dnmwdEDgQOcm7taW588hNSnY940bYfSW7qrHqf58H9CNuAz3PS6zPd1",
-	"GxsM091dmHG7VUch2SkuhB14gYtURkcLnAoIhHUswyqsUw5orj6qSxIvJBhWKcSxwtMSc8ZSwNQs+dKi",
-	"90Y/ttk91yPEBQ8w9Uw/RzhNkVgJCRmKWZYV1MWsN0Qu2+vTm8Vmy8CJpVcLO6b5gnj9l0lo+UuGUnIN",
-	"IWgKiBlNxEEvQGeDJsubn4VQXzCwO7ewAq11u5qWLmWxUhcnp18DAxbZ3DC9bIfKsHecn1Z+aNUCCeiF",
-	"40zplfowRsVo3UDejhtKIY6EAnX93DGO8XgJQnIsQ26ic5d/c55fF0Pq2hYtdHs/xiFU/vomSGe1czcE",
-	"c2q81LahMYN3bEy0iASBeEEpoZeIUb/jEUwVpbniktDL4SFtQ3Tmxm6MEx5FYlkMqjAF4TPTUnmXxslt",
-	"E/Ot7v32C7y5XNzGqaWowetJfcEE4V2HUAcHK/JL3LpFasKOQNyE4yUkbwuSJgFkKo9Wzdi0QnpPWSCS",
-	"NCROJGQisJla8gVzjlc/5WqCHq4OLaSSrX3wrQcdAZY//VWgV3MNiE20d8L6rJxcw3PQzxsyAqqs6veI",
-	"A06UjUs4JortultKIZbmj4IuAadyuYouAoKthj1ZYnoZMH+bc7zBKduBmuQXEEXWF1Tu3TN8ZAdI8cSs",
-	"FsuTfxK5/ASSk1i8xNxPN+bOKhGN0mtVF5zEQb32nIL4HyIeV/roie9uAb1uHng16PEPsvQGp1JO6rN6",
-	"bNnV+beRllH36Mw6oYMg3BGYnzTOfP55WOpyTV90d6c6ea4wf9nnfdnn7d/ntRP8yC4DcRO7REAlX5mN",
-	"P1keYGOaoJRQxda6CtEPg/2oN8glgHRs8+nOBw7OFe9SR9dIWTdZWQ41MQTX+RDwalP7tDUt0QbzJs6W",
-	"4nrL02pQq8f2KPzkLc9xZ7/ui8GFVxtEeYGhrjiJNwSFr9G7tg423I+M8+KrgOQ07sjoKQS+BJQDj4FK",
-	"fFlT9IuUYQ+CVNNgleU5kzgN7m7qN737mR07GxlkitRgp/YoxZ37ju5zk8WSeSK7/3rx1Iong9os64z0",
-	"kHvmFG576wDa2Cw3D4yxU6jCOskjtEdwDjgLODQ6zzNgrG3eiAuTpfo6lEYl3rmAu9nFP5cgl1B97tS6",
-	"jdAbXXrR/PCZQBc16vlYsxPqoWVQdHdlgolllj/rC8vZl2SnzmSnnz5XyaInmC9XyqKFHMhsBNLI+lGP",
-	"HRmF+nLr5Eb79YAAQzMytBn6bbATDpWgK1iCULg0fs9e7/oPWkytletepsKW+liOM6JePvsQNxXMkSh0",
-	"kLMoUnMwofXnJblWvnhfWLhFQDegWaoAoDb3ypd+JPWi2HSW4xu6MemawX16YSehXV7M05BbV7dmliwi",
-	"kGmPGEeMpiubZ0HmKaD5KmBpPDMnFBe2xXCTDz2O0FbhWIidRZ5sgTgjNvPpls6VH9dVNSTh8M3Kz18f",
-	"PuU+optgrImkpmN8TaePg9rqbgNNoZsGLWUZQ1mX6vtFqypDaxXdcBN9KUadSXnCd66lptX4ljeY2MMo",
-	"d1hl6ggudrbnty0SysO7MhCsCeuLrWLZ/W7eFmo7YfEV8AVJAx7Gu/Kd53d3D7+NetOiO8kC/sEX9QbF",
-	"S4iv9H6cilUlQ3ALcaFUXWNpVwdznXDWey3BsXSKwI5G2bHP78nHB9JXrUE6kbQvq6GoNaRskVQFN0i9",
-	"KbG1YWaVS4ojcnWmkGXG8rZ8jwuT/jwHzIH/5hS9mdz/u9RDjUo9Kd2sGn0pZa4EepxkhNY61JV0S8CJ",
-	"bm5r6f7vlW746rye0mhjNNWP/t9QH6cfXv3u86D6/qzI8RwLeD2GFte4mxzX4lBLbmxvNRi4zpQoCF0w",
-	"rfqJVKoken/4VgnUS0w4imYHrw9mamyWA8U5iY6iXw5mBzO9USCXWn5TI55XWjwGy0yEtoxMwgpGFG6a",
-	"2aQKezpq/ZBER9EpE9JDhbCljiDkW5asdlbk1siJXddRa73NWtnk4Q5LGAOFbKF6xlaJGiRejJCuvMrK",
-	"0Ggl+VPVqKoS7G+rGvmrVXvsITR/v1AuusTK6/ge1YGg13sdHNO7WsHx2oAkhdCO1Tv9HGHajxXTzEfL",
-	"caOm2a+K7gg8qibTekW0ml0DAW8GzqXMfO4nJFutOtT2zaMINCevrmCluXEJsiMRDKep2bKzJkK0BPd3",
-	"kEa/muVd4/FmhayjDgM8a9c+DGiXuXrCQxxkwSkkgUk98uIL2oSGCJ24lC8yQjH78wsrZk9oD6KTfUk9",
-	"ikpuEtAIVD0GPUmNvBko/CU9vXOXNozSzP1YsYrZoOW4ugxiQ3XsPhyniWvCee6aeOPVjWUcqB403v6Q",
-	"uE7VxzuW1u7VQytyGaUhZgNAsZs7PwlQ1Io3qcCdJvx/9WsTyocMt3kfjWG0DXhN8kjJ3824q4U8pSyB",
-	"EV6HaRYg+rN9sRtfY9xOtq4kWl/cy+MwE9qbUWkGzw0cqbcWRJqw6Z3JgF93SubvIPUckA44uwTz2eXR",
-	"b6ZxbPp9wDrs7jIUr9JktODKBP0nqUbGybjTX9QVAkiUO7/Y1SK0vcWdyfYBXM1mycO6fU9S2MmwsnUc",
-	"0KdiuovnYELGr+9asU+/0nXJkn7tS2ud+2VuDSR05FL9WYDL3JEMLUjq9nKrIqP/goPLA/RHVAjgf8Pz",
-	"+I9iNjv8Fef533LOkj+i/z5A73G81HYe08TcjyJQVgiJ5oC+fvmIgMYsgeQgmpjdND1qtZlWpmr2XbB1",
-	"sV+70qiPup+BaQtPg3E2BoyzPRombzf2+8V6cg9vqJrpiKjYJZHq9NLGiUpb4fkgf6AAuRT7fqPj2rBt",
-	"jehnCXeHxT8JqGrqc+pVCW2oRk36p/u+T6d+Ktu8qNZ7qdbuOrxdq9m6cJ/D8hiF9rsycb53F+l3kqaV",
-	"du3YPirhfeYl42/mRVZp/CO3kBq67Iqk6fNw7B7KPnZGdZVtnK+QTuvr1k8PJMDZrs3bNoGeqOrUfxpY",
-	"dK75qctB6oSNA43NQRqBmY+m5da4mQTTR5QyloGyF2GucRNLVqSJsmSlsAlFGUlTYuu8O6yazlqpmbRW",
-	"kl3/bTgto41vVWtEy5y+Pio7qEpJRupUVYXus9ls04r1PSxFLfVtFqJB1stqVKtxyPf0F+QYP7Nck50O",
-	"5/7U+S5K7reBV81l++kRlruLOsJxtL7Ho1F21BM2l/gy93/s29sz5U81b0/HNjGmRsfqi0seUvL23vCh",
-	"tn99ZijhsOAgltCTIPbFNKktNbiVQBNdhy2FrfQx96+MhNGXctz7Qmm7vZx6XmVSGIIDh+r2jc6tNEXM",
-	"Ph8qM38FuURY30BT3Tiji4NujbX+5VdlzQfu27OP2PxfEMvRO98N1Wg4uycf+AkgWK39Pviq91toOvPh",
-	"I+Gzdyukfk3T091vtGp5b0H6D6qjvUuwwhA/A+nfpNW8AusAnYevAEG3TlF5u+ikKoa04D1AJzhNdVi2",
-	"JEK5WUuWoKxIJclTsIVM7Br4DSfS1jSdn3+cIMCxKZJHhTCfA4oLzoFKv3beXgrhYr+cEfWeoQywKDjU",
-	"puY09cHIRXxeXi72+FamdplZs8hKTa4yHJU8fH7ZqolOM9S+2GWba14tlRc7sUbCQtNR6nr/2Xx0CTgb",
-	"mZsbDPTO7Yt9brnrGoV77q6bCe1vY7xZtNInxtoBu3rmRGXOEUeJyzUNiqx62VA+oY2g8k4Cfydoq7qn",
-	"i33DxJ673hsqjl9PHy4VraPzt3sOp32kPITDGCyaHOU2Hu6chi6/0VTVK68RxzHk0kXzT+7obReQqamZ",
-	"6V1V/To2wbsDTKZFCadzv6p2M//HK8gdv2NTKw/fRZr346/s3tzt7kWtPnsQMTyccqgXwm6dwN26z6Az",
-	"ifuHXNmTzl0Io+AwHWkKngdonqNF+QGsxNRcjj+9s1clrHv2BnRFvl9oPwp05m70t+VNDNsjcDLY2t33",
-	"EDA0h2ENY0S79C6+/GElO61u+Og8KCwVruFLV0L/kJjP3G0bexF26zj9A03gtrwLz+0Gzd29KJ2n/+Yq",
-	"xsaFU6GTdnYp/rFYCOg4bn9SZ+31S2k2Og4t2fA091h2tX6uDzfJhO/NgP92+CPnwLcW2m+G2IrQ+Qox",
-	"CohxlDFu6ic0J+A2T/VvLtmfeOjIqtErbrvj/+pu4da9Rit9+4VakQFdcVJwwbjivCjtW6pkveAs62AW",
-	"hVt57t9VMo5b7SwfPUE1tll2KAeOcnPR6o4yfNyZoXnf98vLLxUOzzcZXY/Dr52iKXhqL8sRR9MpzskB",
-	"HM4PcJ5HXg93zV+cF3p11H/fvv5Qb236f9duj/BfuGLU9cX63wEAAP//EslEScSAAAA=",
+	"H4sIAAAAAAAC/+x9C2/cttLoXyF0L9DvA9aPpGlxj4EPuImdnmM07jFip71AawRcaXaXnyVRJSk7ewP/",
+	"9w98ipKo13rXj8Q4wGms5WNIzgznxZmvUUyzguaQCx4dfY0KzHAGApj6C8cxcH5JryE/PZEfSB4dRQUW",
+	"q2gW5TiD6KjRZhYx+LskDJLoSLASZhGPV5Bh2VmsC9mBC0byZXR3N4twQX6FdffQ9udpo85Lkiadg9pf",
+	"p40ZryC+LijJRefAtSbTRs9pAp3jmh+njVhQJjrGUz/1jfa/GSyio+h/HVSocaB/5Qe/gbil7PpcjiHn",
+	"4ThP5vRLJ/DV79PgF3jZMaL8ZeJYgLNOAM2PfSMuKMuwiI6isiRJNAvOkBUpFtAzi2swDfZbmK8ove4c",
+	"uPr9Piu4k515QXMOiu7fHB7K/8Q0F5ArPMJFkZIYC0Lzg//mNJffxmHMe8Yo03MkwGNGCjlIdBS9wwmS",
+	"IAMX0d0senP4avdzvi3FCnJhRkWg28nJ3+x+8t+oQAta5ome8R+7n/GY5ouUxGp/f3qIM70AdgPM7uud",
+	"xUGFVG/PT3+F9UVMJe59bXaUn9GS4VxAggRFOEdvz0/RNayjWQR5mUVHf1puAvwoZoAFRDPvEwOc1D7c",
+	"MqKaWOLjR4r3176YTtUH28kQFj/KcI6XEF21qGYWvS0TIj5QxakKRgtggoC5OPW6+rfR9n+rW8sLMRaU",
+	"nZ609+c0kWi7IMAQXSCxAlRy+W+m/m12CokVFijDCaiv8QrnS7mWvExTPE/BMobWQtS0l+vQwcivdkrV",
+	"LDgJ+g8JzgzhgnyWgFCGcJKR/D+jwGz67JK3IjAbyQDdriD3x77FXM0XzSpGlmABe4JkEJqAJGM2EMvd",
+	"RyldRrMhBjmLSBEY8hzhJGHAeQhatGA0G7P5GQicYKGIDicJkaPj9LyGT61OdUhOQGCScrsyd/CmG53/",
+	"N2gmYPjtOAwzjTfGKgacliyGcbPpcRNke0U9Iw6j6vBod/6F+Wekjr3CzJklYZ84GgDUVnjl8YO3jvob",
+	"t4/6jhjElCWQIJK3ENFyOi1VfxZSrN43YEWz+ucEUjCfNdn5Dc2XdpuySEwbKVjuc4FFyT+b/ar4p9fT",
+	"Msd9xT4/xziPIU1DPxmMqf9kgPos6eGzGd3/PTCRwMvPHZ8r8N1n+ynEoo/PPx3TMg+wmuPzTyimDDha",
+	"GC5awebYAcnFj6+jWZSRnGTyZF65OUguYAlKejh2kn/7JmgqDsNk4Ma6H+u0wyiGVCHGOA6qpcvmFL/h",
+	"DMbBWVMLhpbs4UQviTYULF+1UPD62yPJ8dj8VSmogePp31AucFZIODXhIUV4ejc1c9jqheTNMeZOyjC/",
+	"HhIxqlnOML8m+dJcFeMOuQFRCwJhN7WxcytAizJN1xbvBgYKsWJzoraHWmvHAV8CzrRwGRDE0pTeyiZG",
+	"vgucwwlX4kpKMAd3hTqBsCZfxThHcyV6JeiWiNUM4TT12mIGyMyoSRGyQqyjWUQEZOGL3HzAjOH1CAp3",
+	"COlAmoqLZoJ3a7M9/15ER3/2I5Hc4E8cWHR31bz272YRfCkIAz7MkyzIpkMXxINyxThqqrSHQUK6hnV7",
+	"xI/4Ft3gtIT2gK0BUszFJw4BuD5gLpBcGBIrwt0WSJ4ssWjjPdg97fcsl0tljXcocdzX4ryBZv4faIW5",
+	"YhEVZzBkh7MOyulVpDzVskVTIe6iF2aYSt/l8YdWAydfHBXWG0Vyo2sYcokEAbT6YwViBVpsgRu5F4r3",
+	"JJCSG2DV3pvJq7HnlKaAla6p+7XHfq/H6xlr1JmYnVOjXa5DBzOWkquJBymZQ8wgcCQX6rsazayLGHbN",
+	"yTJ33JyIHzhiIEomv9E8XSOah1WRkqXtaT59/NB3JONuPjmyO50KB9zimpj6Pr/5HTPepz42Dji/IYzm",
+	"GeQC3WBG5PghKbgNq7b7tGmBJgFmohoj9VtAom5L0RlwjpddAw1Lh3oiO4rcmV8ou4bkwiyoLRuUgp7j",
+	"kpspF7hMRXS0wCmHgN2QZliQGEupppCdtGSwUFMgZ3RCeCEMXUqipqUI0l5c6SRm2lcta2GZzTUNVIML",
+	"qiYMaij4i9FQDofUFd/g0EfBZuPObHNJwGZNNch/moUYoKBIYr7Dq9ZOkRxxiGme8P1eleuwvQZ59p23",
+	"W+ucJZuH5Hd5l58zWJAvbRTT37VgR3Kke6AbYFwq7FYmVMI/ZV3XojfPRbkIzqO/33Oeon8RylxD7O7w",
+	"1pBIaONFa1wl7XyAfClWAUFGfe8HsTqfOmkagOszzALnEtpDScgfCBd9hCxl94C5RX5uCvRBkTglMFo/",
+	"V22DoxSlMzX0kZUzSagLfoQEYUgG3ZI0NcLzaAkig4yy9dm7IaDObLv78Yft6P2ziAvMRklXbm8wR6bT",
+	"6L3hAgsYucgL1bbl6htaom2tjMHodkXiFSK8BnklE/bfbzUXom/7cNjrb5uHjh4SWISza5e0deZhSH05",
+	"+pemYCD51tk7f5PbRrLX/yd07/i+4/axHp9LfvLp5BwZB3X3FffzTz/9+NPQLfcb3PZafu5r/Wgcjxru",
+	"Ss/bZw+cYlmboTInf5eACmAetWT4i+XSaqczkru/p4C5U8HIYstIeWgj3unk3l5fpWn28Mww1/g+sqej",
+	"jpTESjkqynlKYkktgbtNfTYYSuYpKAWGlgJhRT413NVWqkL3YFDrJfloTgXiIMYqdrUQkLZOxyEuGQRV",
+	"sFKbxxBfcwEZimmWlbn1xcsFtK8ED0emcV6L9I4Fa/sD1jKBxsjTE+UfSm/AGSBkSy4QL9UeLcoUKc/G",
+	"PvrEAf3lYDgSePlXJDvxAt/qq0g1tNMLvJSzVD3+r4k7avZakhvIzSRBE+vmErfHsDcWtDvvIMNDvinb",
+	"64NbMr9fK5x/D3Xa2B7BQpWR/FT3fdXGj53YfGrmHrUjxprSJqYYC0iOzz8FUMYZC1w75Byc48wvrqO5",
+	"/UhAInybycu3Po2+VJVUSN6Nm0oyfhJyyarvFvUpi1fABcMiZP2xVrBfrM4/YD0xcjZaqPa+KZHk4uc3",
+	"QTirsMyh2ybXxqcuP2iHCzpk4mFlnpN8iWjuDzxiU7lTVJgg+XJ4StMQXdi5G/OEZ1HhAoMiAk3gQreU",
+	"dgVt3mgD83vd7tF/4E0GYqNiDUSNvZ7VCSaI3nUU6tjBCnyHt5ZItcEpYA7F8QqSd/JKD2CmtGXIFetW",
+	"+ubniCSNE5/gMfzeqAl6dnWIkEZdDHVzU0jGffJUoKi5hohNbO9E6wu3uIZcob43zsjGKzHAyTqaRQnD",
+	"RG67GjbPIRb6jzJfAU7Fah2M0qmmPdbRZW0bLlkyLOCijgF9mumZ7qGAtbhQs6RT5UGT6+CVZCf/RIQj",
+	"bx1tTWT68TeOzQwgd1yqUb12itFCqbbJmubGl3WDU2IcWVYriEvGIBdyT9qGuHGGsxXgBFgbnn+p7x4w",
+	"WcmFFNq5nJAEQ0jsQ4UpGmdH1ImaUom98qzrIq2cZmq8kbFDWfuPWbWvJsgD/IgFfCAZCQmqpwf/RgoJ",
+	"U9WgzqBsbIwyDfp6zwwdSq2ozDkI5AwqSig3KM+jWQNLEsKv3+E8uSWJWJ2Rd0UAmhPCr9HcNtIwGblN",
+	"W5iUktiQAn5+M6AiztTcp7RzRgmmUvG5N4ueffpcxqYytFSDMcOrlSHLRUq0DgE4XqGEMIibETWTgPt3",
+	"H0QFjq9BtLdii4BIPP4IvMx27PucZuJ7ZEOG3BN9C5g9+YOI1RkIRmL+4lF6uh6lrDqiUbJbNQQjcVB2",
+	"e04uqm/C2yRvySfuu4X8phkn3IDHj/9VNkjJnGS3uhm7a/DfR0r/akQrrpJ8EAm3hMxPGs/8/fNwqUv9",
+	"fuHdPfGEzxPNX6IYXqIY+qMYzALNY8yGbYguEeSCrbWPUbgweqyE7xxa2pT6GBxH/oLsG9oOd50afCB8",
+	"X/kaLVwjz7q5lW6qmQa4vg8BqTY1X1vL4m1kniJsfaB67X0OHzW3B+HZiFePzXAU3WOMGt+Y5JN6IRaw",
+	"Ko0AYtCJ1wHlNayV9YGD0E439YGDkB9z5XRjgBhk9AYShYqykTZKqaby52soRPsdZ2Nr3Srq28tIHNxE",
+	"RuKJ5ODfZV2G4Yneprgo5YOJ87jjZV7J8VLFvcSQC7ysXXGLlGJvV3IFg7kmLqnAadB3pX7p9VZ12K0z",
+	"kI9fkuCgJkTKxnOOHnMKm8i8I7s/p/AYqncGtVXWN9JHKm1S/WiSNrTISWC2BPFbh51ffpe4n3mGWXt9",
+	"CKppZA5cIHyDiSI5Z40tSHwNSTNaJZDFIhhL0/YfL9Wj7QVhcKtDY2S7Bl/ZR29NNIF55A1cUUhCM0xy",
+	"jgS+BlQwiCGBPAZEb4wRJIGcqNcDpoM1P3LEQMKqbDp8H50zciP3wZDd9Z50UKWISQM4l+EOPyjbqYlp",
+	"2G9RpfnhmCQsZHo8v3njQU4ZOj49+di4tHGOjJVenUDJAR3uq/8dHEp81ktRM8iTSyBfSz83W4uVFNwg",
+	"5bCPLlewVsyKLHPK7DHJeSTCsxxcNBLhKCFcnmzN27U/yd1lln2ijyFgc7Tn07NQsQLC/HNlitcQmhgr",
+	"lwkLSjaBrCNi6wS4ILmOdZLBjvLYbbRjP7QSRfW9UMVwGSQjiy1GbnmnvTE+/SA80Cftnp596rH+IB7g",
+	"YDVo2zjXzu3Z+Ng8rufsuXU+wUBALsH7Fy1DJ6s+ay6F1UVm4SVcyR9oDgvKQDuUzKv8mWJ3jCTGFOzm",
+	"qFQGnO2jU1HxsZTmS8Uhcd7TpdfW/Sps160pN0G3JbRlRue41EpoNIv06oP+STODjWTbts1BLkIG0gXG",
+	"VRnQAgObAC5rIZd7F3x4zk+srb3vCaHsbjU6Y5xvDOkZ8oeD1rqgqRJxDWucOBsWa/Rw7gGn2Sx/1Vdm",
+	"Z1+eh788D9/4efjL6+5n9rrb8INgzgiNrMF3pupz46ZQFI4F4oIWhRRbmb0lib3G5EUPiREz2o55HGDf",
+	"J3htcnjJGQlHeLlksNThAJTN9CYtGS3lnPM1kqM0kCl0dNITf0beXWgnaYc/npP/D9YRnpWpINrtPF/b",
+	"UBmF0JWvtTYxLeepN3WleyeldvJ3zq31bzt8a5enzsdw1rfSM1/P3+IyWZn3xsf58p5sO9O+fGXSlMjD",
+	"lF8eQZ5oWLDCLIdWEhdorsAbaUjYzJA7Cfn6nCU3cVF2HkK1LTfH55/41o6hQfPqTNoYWAeuiTEtYqmY",
+	"AwSeuENmfE0NS4L87Gfk2zz7j+k9nGy0xe40bBr+XgEVukRUCAmp44USFcM6aCGUB83raCgvStlZjDMa",
+	"eol9h3YzxYFXPEpc1g9ueh2AG7juRqcDqa19aj6QrQtncpsu5FukyaCrDS75BOA3ceLpN3BDyosBi3Ck",
+	"20t2qmIevZdu87UTQcIxnXIXNsXh5j5sm1+HttNkvdvo2FwOvU2Myb4Hr0qmHXbUmfOrZ1asIPcxuomM",
+	"tSOp8Rif06ng5ja7m8ApXE7YltjvvGVGg/7zqpXCWPZFquEUfvl3CSWcU0460kQWBaNfSCbPrDCtatBa",
+	"2VP/oUabKc9SLdBXfbcPDMZhJx8V+u1hpbWiqEm0GeUWExPzrQGIZi44HEwilyqD5NXWYlE2xVsXOO8c",
+	"lDXUUo/DOl6gtaQqfR5cMM1kGoqAEU/OQnK5+c2OuCA54e51CCpWmCsZKSNpSoygFDhx3c48RGsOpX4c",
+	"iQdh/7fDduUlDMwvf3J5iwL8vy+/DzI/B4ZVaKMHrgcfKGYG/lG4vW/NrpbftSb148CG+tguh1Lne0Pc",
+	"G4p8QZYlk995jgu+oprVpRSH0ZyPMFeq4w/Apfq24QqQnkeYFizTRIOmbwFMdIapAcLsd1paoBVYMwun",
+	"VnvU99y9jtb72rHLIyMjB1MAqwG93dEbbjFBEbzjSVeD3MPkzHFb0OIUnV7Re8ejbSCOJjS+BrYgKYQ8",
+	"KfY3z3zcPf0mYhurvYno6+W9nrgzF8VxFtCXPspfdGoMFYmmHKsUwReISwH2QnScuApJ77zeFW4G51IP",
+	"ALc0y5ZN3t65+gh4iZf3l4Fc3gKVe4QH3y67whw9xn+8vJ+4ipeVpKqCYzaUUxUc1UVfAdDYOR0c1Em+",
+	"E7axpW+GzC5yfXxFyzTRGz3mjbiFwYd8AOyH0pzuPJC86M/ti+OjNWy99Zuo11ZWGjuHbb+xnV/gUEDe",
+	"JV5yjRsyyELQasYJWkYHBtU1MW/BBhiJYRqzHtVtZjwEKCVcaJcZr/vSHjWvx9g8HnCL5C/umt2+06W+",
+	"UapVp+dlO+4WiR9STv+ntA+/C7mmjTXZU2eUpdmTxDxxQzo2QrLmH5hl55SmoXAGnASmrWw0BYO9W8yy",
+	"WrJJ1UvuxhxQnGKSQTLO5ii9JZNnU2ETDd/84FwNilUTW935ytuSAca/EcCComuAogazfIaqw1M1ucpO",
+	"qJBncr8kQgpAtaCX3MrPKbfyI2Q8rrtuzapP9Piha0lIziKG/HMGwDUy7WdVLhT1wOHVyBDmASztmG08",
+	"gtpkyyFriRlca+6hOdrD3Yx+1WQ0eBXwpR2a5hJTV/AK7DR8DNqAQ9INkHocEvdtcRdYm7zxqTZHuU3n",
+	"tafOdctOyY+DSbH/dXl5jnQDlRq7yjY+X3cRZM/lpBWOfh5mmaSUCAolRHjGkZY4HyBPizn+WdYf71T7",
+	"biHqoN33PjJ0m27sfqdkAfE6TgPGHBOkXXF9+0V72P0vJrCv+iApLvmsT89+uyaddi8D+8C9+63dJw+R",
+	"Ce3O5oskYn0hYdFb6T1RlAUb5ac5YAbsF0sSfh2syFQZVFummlUzrYQoVKW+JCN5bUAiIXYpPbQAH/2/",
+	"PdVw77Ke8tUEFspx1L+Gxjg/3fsV1qH+F2WBpfX41RhYbONucGyL10pZGztaTZG3g8mjIPmCyhEEEan8",
+	"7f3rd1LV8JIFHUWH+6/2D+XctIAcFyQ6in6UDwZUAK1YqfM70Mezp45HfSkoDz30UcSLMMrhtplt12UN",
+	"OU1UulPuZ8nhkStl945qbWArdS0bOYPv6uzQ+MxrlVJfb7FqaaBeVaiEaasSFSSe5Slde8VUQ7M58A9k",
+	"o6owaH9b2cinVhV3EMLmP6/urowtwZWxs4hwJUeoI8fB11r96DuNJCmEXCMn6rvUeXtxRTfzseVto0S1",
+	"X+S6I3yianJQA1CFUTQw4M3AO2q9nvsdkilQO9T2zaMcaEH25ItFOekyVH3lg7Hi6ABQY7zgrYP7JwjN",
+	"XzV51/Z4Wu3aUZeeZ+AKmjmalRuqw6uEtvaiHpn4gndC4wjtcV3dzcYwZn99YcbsHdpOeLJ/Uo/CkpsA",
+	"NCRXb4OeJEeehhQ+SR98tTX4R3HmflwxjFljy9uqtv9Edmw7juPEtcN57px4MnVjEQdquWhNZui4zmXn",
+	"LZ/W9tlDy1kxikMcDiCKcRZ+J4giKV6n5+y8wv+lftYO+NDFrX+Pxmy00aB1shO3v9N2Vx3ygcrdOSx1",
+	"6GYBoH8zP2xH1hgXjyvnjO6u7iVx6AU92KXSVJ4beCR/NUikADv4qrPS3nWezD9BmKf9UuHsOpjfbG7b",
+	"aRxHTx66HQ63J5NU2Z9HH5xLmvsk2ci4M+6UF3Xpe+7CRLHND9yWFrd2tjsQNZtpiO/MZTIoZJiztTug",
+	"/FGmAv3Tv0LG03ctAXc/022lXA7RuZ96voEJHVl1/i7B5lsRFC1IaiOw3DzoP2B/uY/+ikoO7L/wPP6r",
+	"PDx8/TMuiv8qGE3+iv5zH72Xb7LkPS8DvFRROu5yFUsrJuQxTXTODWVNU7NWxjSXcGfmIVbTtnn1sPdK",
+	"I2f5/S6Y9uEpZDwcg4yHD3gxedbYP6/uZveQhqqVjtCKTeN25uYgwxuN5L/C2tZtXMvdt2WMgJtwWgaC",
+	"kSqahllBSqVxsn46i9ZzmthTVT9SRpYkl08wzaYiknMBWEWp6WfiMmt2rjONeInAQubk0wSyggrI47Ux",
+	"dVeI65Une/3TT0Plya52ZjVwtPCwJoPatO1rwk/1120r2A2lvTn8x5i2/3iSVFm7fw68tMAT7yFNLrZ/",
+	"36V05tq83E33upu6E29v+56qH+5zuLRGYftX52zvNcP9KlPCuuvJPMEwrz60VN7O8KPHSjqMdY4WLjxv",
+	"/zSZ3YE+1mDXYJLaMf8cxOhdSSOdOnQlicyl5NDLzHZ0gIfbvjc3Uat5VannO0KLfuOuyRzpMqbiqiAS",
+	"a7CBsOV3B5izfTEvnOd1vAl4F0AM4q41LvuRTu7a3qn494zxvfNCPKjqJQ+Ig/XiyoGcxyO457E32SMy",
+	"0lEO7grWzR3cjT379nV7KUYn3pKdsl0XNfs1/Z2gy06UZB9HHti13pi5LQV6h/A42vKPW1usCtoOrdND",
+	"EIQZ5D/46Y/da4zmM9GJzPwb0vs7GP/B1+qPcbEKdcY2dBd0aUPe8R17ANyH1meDjf2lTlCqGuT0fGIh",
+	"nhBiSVAF1eXtwxfJR92gkd28gW4qaXbzSpHaOIMixXH1GqDqIk9KYAH7LdwcvHl8zDTgPTiCbv/yalS4",
+	"e7pWXoMyD3lxffdXw4Ky624a/UUWYhQhCswFVTGPblRbjkf7eojgujKhtarNanR+DVC4wsMj6VTC8gRF",
+	"QwnWTolrSn2ZMepLk+oWagFPkuaeER3ZHGidJkj3Uosu+TgN+oNueZ/rJ5CuRflLAwWWbPpenXBj7r23",
+	"a6TT6nCnKPKv+VKmlWJtQXuGv8jWKHdPUvug7IDKFs+toKpKqh4eHk59n/0AZl116psYdTVmvVCjpcYD",
+	"k+Ksiygv1M+13UOYowtgN8D2LiAXSD8aNJ5G/ZxVHrUqr4FRdWDKAymvvNMTm3EtRGKSknKcU5eXjkFV",
+	"+t1USOQ28XGVMknmTt9ToOydnuyP5x0XLr3eE+MgM505R4oPeteZ17ZRuuhBWM0HegtcoBRuIB2zoC5m",
+	"I/vX4LKPcBOYl8vIvBuUmR9Z3ptRrWV0wwKWlK3vAVxshrinu1vAF3GgSGGvoq+pDG6Qv1lZUk9hQ3za",
+	"jO47ibPqZnRD0R2+5DEmksMxkM6Qjidmuh+oYr3JPVoLivjur1JThK5bRzzzqtQ11URBXZxcThOoK4GE",
+	"W/eqvD5NNgJb7kxXyFO9GmF7pyctDA4rjAawJ+yMrVcJfBxn7BgV0eDAN6ckTg/nrpFGrsuN6SSCQQun",
+	"slEq1IXeSoqDPrMygN+m2tnTxe96cclR6B2wyA9GBJhjMFv6YruYzOILVw4vyOBVtbxep0+Y/6p+Txc7",
+	"NXhTHq74LNEGBvnIKa8xk6LR3GYvxuvdIi5l0vEk/3N3IGyh/k5BWMkVspXO/6ii+F3yR/kQK98zyV3l",
+	"iBtFvajSl/L/bCaaHTqPClXmcpcmKbmQgSQr3eK02sNa/pEXzjwVwRksGPAV8D43qmpSuxHhi4BcJrNX",
+	"Rilddo8qf8xI1v3Rzfs47DtcEKKnHIQMANEVD/x9qIwjKs8oVh6pWgWtTBuZo6Mffz48HDIbtarL300X",
+	"YOyJPlA87hPAYHkR9qGv/H0D6UJ3fILixXNyt5dZQ4Z5EUImY7jwS8mNSRKkm1dvIv2HJ74y2Ao/nwVt",
+	"LDVfuo6XKRiNdVlv29gUZLB9VLVv7Mk4zkvPaT3h/QpzlFO/LKMqL6FLPujZXFs/PTnNjeJbSwDPxwbl",
+	"XFbvRJ+o+uAgDF4Dr7c2nT9PiKIbNZUqrPIo3ZjTX7SR3TICkgEtRTcfuLA6iG5YFSkymVVRjUPLJ2o6",
+	"vT76YiWW6nj92rAGy/fRMU5T7SwhHGUgVjSxpUR1D66q0d8yYisLXF5+MIVX1YAlt76WuGQMcuE5+jCv",
+	"KhXJVrYmB8oA89IE8tmlWZFtNL3rfk9C3PTOsZ2sWi6O5O3z8PfL+G475VF9qvdMDm+hvNqKWMpB1CC1",
+	"o39vapgAnI1Mzxh0pF2aHx7y0fClCnS/undqxod8s9LMW9x3jDWjvPzmHdXBV1036u4AlwkRe71hWO5t",
+	"k2qrnejeYwEtXuWgYgEWhHHRecKXatK3cpiNorQ00IEAi1+qt/AekPO1qnVNGcooA4Rj2VrOCl+KVGVP",
+	"X+CUQ9jxr1tHsxCC9VY3Mat7q/sHCidwsU7lB8nGAmv5WKXU8NZiH6dgJU7aKBeizRTTAz56y1xNg2gO",
+	"Cx2P3g8M5MkWQDkuGZfXvxWtJUyqNI28UTpmzuGLsyt2x3CMCKRT5y/nNllPCpCqxhI2CqILXWPWqqJj",
+	"7Nyt9moHIXWTcPl+AQIVznwDpszNObDjs0E+XNrSpb1meNXKZ74/8CqUfIawEIzMS6+QkzZi+rKUoEUh",
+	"FU2rJQ8w60+mtNKWGLUufegE8IKqeKUCGKHJDBn6UDT24yFK8JpX7EUV+OwgNUP99+Uu7/NkFGw5ve0A",
+	"RNAtgNFT4So059JUypqNzWjql9d6IE6iq8TLNdyLlZR2iO+Ui+j1WwbiFccbkrtt0yCxVz82qDyI4Zq0",
+	"69faRpVHrx5a3rcWofvK/Ha/nqjcP+vLAVRBPzo7e0/qOR93dmE0DBZAHuUaeDhLYlUO1BZAeqJBtrtB",
+	"ohorOvhq/zk+oXsHeukWDsEu/er6U4UR13X8Y2bbaWtPmR/htmjQen86n04yl912cgy7Yxf1SlobJ2xv",
+	"okF30vbnTesTr4ePoFkezkdeDs8DaZ7jHfNN3hsHarX84KupKd17kRzjPIa0cpnOvERketcId0qvfW9W",
+	"YCYITmUKTbDvqGwl+JG3kMIW/s5Vvd4crYcj1sw+TLi9KnyJ1f6k350X82El5gtnjLRl1EexwyeCRK/7",
+	"kGjVdIR/HzznoKqI2fcMVrRDCaSNb4aKlapLj3OTVsWk6+9/Kysfu6p5vRexdLHg4Exm0pZdzbPZU1jt",
+	"L9fwg/TGunLXQ0En/4RBLH5viyw/CC63TGeneQJf7GY5n7vbsObjT70L3S9T6ZL/W+1/2Iy/g4fw93go",
+	"WhNk1Dls+GC0gdCGEr4zq9tOmIou39Fv5q9vflcFmyE61GVGngsdVs/Y7ZtFT7N4IpS5JQ1jmsHbbcML",
+	"RSmKks0Pvgq87FUKPippXm8gXlZmaD3OzLvrbBObAk1QG45pbT7qFr3FLOHjlYNLvOSXeLlj6hN4OcWg",
+	"hZcmfFlpOi+Ji7pimxryfiii7VzH8IXRy7peqw22mqmt3FAwmlFnYFc/Ih3UwGiaojmOr5F6Ao5omgBD",
+	"NAcTMoyXiFSRF2SBiEAJBS7TesIXwsV+6A3sE8DQ3ZltLvFyU3PftuHojDXGy0exHz5r0uu5BEyx+BER",
+	"a00a5C1itQIHYfKYeC2YzXTpiWlr0dXvFrTtOil24pHXExqI7+eXd9vpjuYFVRWq3mKW7RWUpoOhPbIl",
+	"ki0lihYM9uSH6hELtFFXPc5zKZy1j30Mjv6BWXYuQXpMHO1DTQfgJBx0G/gioVRvJqrgxc1QaoYOjcTI",
+	"G5s8Ts7YHq5tX4KwsD2q+NCH65c1tvAiQWzAlm9eT6kp2ltL9PfX33I10a6Q+grQRjS9smqPDaZXjafH",
+	"0psNvxDGAjIxkv7bCRZ/MtHiL7ViH+F11S3MV5Rej2BgtmUj8rLFy/6wIz6EpmEmi+6FBW4PnuOBOuBH",
+	"xFyattKzB+TG5fAky9yrq5iSBcTrOAXjnOk9b+l8rh34Tgr9uFN+4Co/2hZWm72+s+anBy/v89Qwz+ck",
+	"B1/Nv8aGaZrmHSZwi11/2EEni/sOnJEWbXuo32WRmTo76Q3r7D45Fde5/YPbgZqmZ3hcLW2YvbyoZvdn",
+	"RQcJyFxTjMAI265S8wQyXdYIC6n86buwG+094cch/Uk16z3Qf4RO0IbV6Qff7BtSs8tmj9f3szO3NvC7",
+	"oho1jQwd06hZsjQ6ilZCFPzo4AAXZB9ez/dxUUTeAF+rd1vVsyX30dep3EeVLMD/W53GnkpGWG9YkL1r",
+	"WNe+eXK6N6B7++p9NW/5ru7+ZwBSpN9dIxABAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
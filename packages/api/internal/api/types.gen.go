@@ -17,6 +17,35 @@ const (
 	Supabase2TeamAuthScopes  = "Supabase2TeamAuth.Scopes"
 )
 
+// Defines values for APIKeyScope.
+const (
+	SandboxesCreate APIKeyScope = "sandboxes:create"
+	SandboxesRead   APIKeyScope = "sandboxes:read"
+	SandboxesWrite  APIKeyScope = "sandboxes:write"
+	TemplatesBuild  APIKeyScope = "templates:build"
+	TemplatesRead   APIKeyScope = "templates:read"
+	TemplatesWrite  APIKeyScope = "templates:write"
+	WebhooksManage  APIKeyScope = "webhooks:manage"
+)
+
+// Defines values for AuditLogAction.
+const (
+	AccessTokenCreated         AuditLogAction = "access_token.created"
+	AccessTokenDeleted         AuditLogAction = "access_token.deleted"
+	ApiKeyCreated              AuditLogAction = "api_key.created"
+	ApiKeyDeleted              AuditLogAction = "api_key.deleted"
+	ApiKeyUpdated              AuditLogAction = "api_key.updated"
+	NodeStatusChanged          AuditLogAction = "node.status_changed"
+	SandboxDeleted             AuditLogAction = "sandbox.deleted"
+	TemplateBuildCancelled     AuditLogAction = "template.build_cancelled"
+	TemplateBuildRequested     AuditLogAction = "template.build_requested"
+	TemplateCreatedFromSandbox AuditLogAction = "template.created_from_sandbox"
+	TemplateDeleted            AuditLogAction = "template.deleted"
+	TemplateTagDeleted         AuditLogAction = "template.tag_deleted"
+	TemplateTagUpdated         AuditLogAction = "template.tag_updated"
+	TemplateUpdated            AuditLogAction = "template.updated"
+)
+
 // Defines values for NodeStatus.
 const (
 	NodeStatusConnecting NodeStatus = "connecting"
@@ -33,15 +62,122 @@ const (
 
 // Defines values for TemplateBuildStatus.
 const (
-	TemplateBuildStatusBuilding TemplateBuildStatus = "building"
-	TemplateBuildStatusError    TemplateBuildStatus = "error"
-	TemplateBuildStatusReady    TemplateBuildStatus = "ready"
-	TemplateBuildStatusWaiting  TemplateBuildStatus = "waiting"
+	TemplateBuildStatusBuilding  TemplateBuildStatus = "building"
+	TemplateBuildStatusCancelled TemplateBuildStatus = "cancelled"
+	TemplateBuildStatusError     TemplateBuildStatus = "error"
+	TemplateBuildStatusQueued    TemplateBuildStatus = "queued"
+	TemplateBuildStatusReady     TemplateBuildStatus = "ready"
+	TemplateBuildStatusWaiting   TemplateBuildStatus = "waiting"
+)
+
+// Defines values for TemplateBuildEventPhase.
+const (
+	Base      TemplateBuildEventPhase = "base"
+	Configure TemplateBuildEventPhase = "configure"
+	Provision TemplateBuildEventPhase = "provision"
+	Snapshot  TemplateBuildEventPhase = "snapshot"
+	Upload    TemplateBuildEventPhase = "upload"
+)
+
+// Defines values for TemplateBuildEventState.
+const (
+	Building     TemplateBuildEventState = "building"
+	Cancelled    TemplateBuildEventState = "cancelled"
+	Failed       TemplateBuildEventState = "failed"
+	Queued       TemplateBuildEventState = "queued"
+	Snapshotting TemplateBuildEventState = "snapshotting"
+	Uploaded     TemplateBuildEventState = "uploaded"
+	Waiting      TemplateBuildEventState = "waiting"
+)
+
+// Defines values for TemplateBuildEventType.
+const (
+	TemplateBuildEventTypeError TemplateBuildEventType = "error"
+	TemplateBuildEventTypeLog   TemplateBuildEventType = "log"
+	TemplateBuildEventTypePhase TemplateBuildEventType = "phase"
+	TemplateBuildEventTypeState TemplateBuildEventType = "state"
 )
 
+// Defines values for UsageGroupBy.
+const (
+	UsageGroupByDay      UsageGroupBy = "day"
+	UsageGroupByTemplate UsageGroupBy = "template"
+)
+
+// Defines values for WebhookEventType.
+const (
+	SandboxCreated  WebhookEventType = "sandbox.created"
+	SandboxKilled   WebhookEventType = "sandbox.killed"
+	SandboxPaused   WebhookEventType = "sandbox.paused"
+	SandboxResumed  WebhookEventType = "sandbox.resumed"
+	SandboxTimedOut WebhookEventType = "sandbox.timed_out"
+)
+
+// Defines values for GetSandboxesSandboxIDLogsStreamParamsLevel.
+const (
+	GetSandboxesSandboxIDLogsStreamParamsLevelDebug GetSandboxesSandboxIDLogsStreamParamsLevel = "debug"
+	GetSandboxesSandboxIDLogsStreamParamsLevelError GetSandboxesSandboxIDLogsStreamParamsLevel = "error"
+	GetSandboxesSandboxIDLogsStreamParamsLevelInfo  GetSandboxesSandboxIDLogsStreamParamsLevel = "info"
+	GetSandboxesSandboxIDLogsStreamParamsLevelWarn  GetSandboxesSandboxIDLogsStreamParamsLevel = "warn"
+)
+
+// APIKeyScope Scope granted to an API key
+type APIKeyScope string
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// Action Action recorded in the audit log
+	Action AuditLogAction `json:"action"`
+
+	// ActorID Identifier of the user or the API key that made the change
+	ActorID *string `json:"actorID"`
+
+	// ActorType Type of the actor that made the change (user, api_key or admin)
+	ActorType string `json:"actorType"`
+
+	// CreatedAt Time when the change was made
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Identifier of the audit log
+	Id openapi_types.UUID `json:"id"`
+
+	// Ip IP address the change was made from
+	Ip *string `json:"ip"`
+
+	// Metadata Details of the change
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// RequestID Identifier of the request that made the change
+	RequestID *string `json:"requestID"`
+
+	// ResourceID Identifier of the changed resource
+	ResourceID string `json:"resourceID"`
+
+	// ResourceType Type of the changed resource
+	ResourceType string `json:"resourceType"`
+}
+
+// AuditLogAction Action recorded in the audit log
+type AuditLogAction string
+
 // CPUCount CPU cores for the sandbox
 type CPUCount = int32
 
+// Checkpoint defines model for Checkpoint.
+type Checkpoint struct {
+	// CheckpointID Identifier of the checkpoint
+	CheckpointID string `json:"checkpointID"`
+
+	// CreatedAt Time when the checkpoint was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Name Name of the checkpoint
+	Name string `json:"name"`
+
+	// SandboxID Identifier of the sandbox
+	SandboxID string `json:"sandboxID"`
+}
+
 // CreatedAccessToken defines model for CreatedAccessToken.
 type CreatedAccessToken struct {
 	// CreatedAt Timestamp of access token creation
@@ -60,10 +196,16 @@ type CreatedAccessToken struct {
 
 // CreatedTeamAPIKey defines model for CreatedTeamAPIKey.
 type CreatedTeamAPIKey struct {
+	// AllowedTemplates IDs or aliases of the templates the API key can be used with, all templates are allowed when empty
+	AllowedTemplates *[]string `json:"allowedTemplates,omitempty"`
+
 	// CreatedAt Timestamp of API key creation
 	CreatedAt time.Time `json:"createdAt"`
 	CreatedBy *TeamUser `json:"createdBy"`
 
+	// ExpiresAt Time when the API key expires
+	ExpiresAt *time.Time `json:"expiresAt"`
+
 	// Id Identifier of the API key
 	Id openapi_types.UUID `json:"id"`
 
@@ -76,6 +218,30 @@ type CreatedTeamAPIKey struct {
 
 	// Name Name of the API key
 	Name string `json:"name"`
+
+	// Scopes Scopes granted to the API key, the API key has full access to the team when empty
+	Scopes *[]APIKeyScope `json:"scopes,omitempty"`
+}
+
+// CreatedWebhook defines model for CreatedWebhook.
+type CreatedWebhook struct {
+	// CreatedAt Time when the webhook was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Enabled Whether the events are delivered to the webhook
+	Enabled bool `json:"enabled"`
+
+	// Events Events delivered to the webhook
+	Events []WebhookEventType `json:"events"`
+
+	// Id Identifier of the webhook
+	Id openapi_types.UUID `json:"id"`
+
+	// Secret Secret the deliveries are signed with, it's returned only once
+	Secret string `json:"secret"`
+
+	// Url URL the events are delivered to
+	Url string `json:"url"`
 }
 
 // EnvVars defines model for EnvVars.
@@ -90,6 +256,19 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// ForkedSandbox defines model for ForkedSandbox.
+type ForkedSandbox struct {
+	// AutoPause Automatically pauses the forked sandboxes after the timeout
+	AutoPause *bool `json:"autoPause,omitempty"`
+
+	// Count Number of sandboxes to fork
+	Count    *int32           `json:"count,omitempty"`
+	Metadata *SandboxMetadata `json:"metadata,omitempty"`
+
+	// Timeout Time to live for the forked sandboxes in seconds.
+	Timeout *int32 `json:"timeout,omitempty"`
+}
+
 // IdentifierMaskingDetails defines model for IdentifierMaskingDetails.
 type IdentifierMaskingDetails struct {
 	// MaskedValuePrefix Prefix used in masked version of the token or key
@@ -139,23 +318,44 @@ type ListedSandbox struct {
 // MemoryMB Memory for the sandbox in MB
 type MemoryMB = int32
 
+// NetworkPort TCP or UDP port
+type NetworkPort = int32
+
 // NewAccessToken defines model for NewAccessToken.
 type NewAccessToken struct {
 	// Name Name of the access token
 	Name string `json:"name"`
 }
 
+// NewCheckpoint defines model for NewCheckpoint.
+type NewCheckpoint struct {
+	// Name Name of the checkpoint, unique per sandbox
+	Name string `json:"name"`
+}
+
 // NewSandbox defines model for NewSandbox.
 type NewSandbox struct {
 	// AutoPause Automatically pauses the sandbox after the timeout
-	AutoPause *bool            `json:"autoPause,omitempty"`
-	EnvVars   *EnvVars         `json:"envVars,omitempty"`
-	Metadata  *SandboxMetadata `json:"metadata,omitempty"`
+	AutoPause *bool `json:"autoPause,omitempty"`
+
+	// CpuCount CPU cores for the sandbox
+	CpuCount *CPUCount `json:"cpuCount,omitempty"`
+	EnvVars  *EnvVars  `json:"envVars,omitempty"`
+
+	// MemoryMB Memory for the sandbox in MB
+	MemoryMB *MemoryMB        `json:"memoryMB,omitempty"`
+	Metadata *SandboxMetadata `json:"metadata,omitempty"`
+
+	// Network Egress firewall policy of the sandbox. Allowed addresses and domains take precedence over the denied ones and the ports restrictions. Private and link-local ranges can't be allowed.
+	Network *SandboxNetworkPolicy `json:"network,omitempty"`
+
+	// PublicPorts Ports accessible without a port access token, all ports are accessible when not set
+	PublicPorts *[]NetworkPort `json:"publicPorts,omitempty"`
 
 	// Secure Secure all system communication with sandbox
 	Secure *bool `json:"secure,omitempty"`
 
-	// TemplateID Identifier of the required template
+	// TemplateID Identifier of the required template, the alias or the ID resolves to the latest successful build. Use "template:tag" to spawn the build of the tag or "template@buildID" to spawn the given build.
 	TemplateID string `json:"templateID"`
 
 	// Timeout Time to live for the sandbox in seconds.
@@ -164,8 +364,26 @@ type NewSandbox struct {
 
 // NewTeamAPIKey defines model for NewTeamAPIKey.
 type NewTeamAPIKey struct {
+	// AllowedTemplates IDs or aliases of the templates the API key can be used with, all templates are allowed when empty
+	AllowedTemplates *[]string `json:"allowedTemplates,omitempty"`
+
+	// ExpiresAt Time when the API key expires
+	ExpiresAt *time.Time `json:"expiresAt"`
+
 	// Name Name of the API key
 	Name string `json:"name"`
+
+	// Scopes Scopes granted to the API key, the API key has full access to the team when empty
+	Scopes *[]APIKeyScope `json:"scopes,omitempty"`
+}
+
+// NewWebhook defines model for NewWebhook.
+type NewWebhook struct {
+	// Events Events delivered to the webhook
+	Events []WebhookEventType `json:"events"`
+
+	// Url URL the events are delivered to
+	Url string `json:"url"`
 }
 
 // Node defines model for Node.
@@ -227,10 +445,43 @@ type NodeStatus string
 
 // NodeStatusChange defines model for NodeStatusChange.
 type NodeStatusChange struct {
+	// MigrateSandboxes Migrate the running sandboxes to other nodes when the node is draining
+	MigrateSandboxes *bool `json:"migrateSandboxes,omitempty"`
+
 	// Status Status of the node
 	Status NodeStatus `json:"status"`
 }
 
+// PortAccessToken defines model for PortAccessToken.
+type PortAccessToken struct {
+	// ExpiresAt Time when the token expires, it's valid only for the current run of the sandbox
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Header Header the token must be sent in
+	Header string `json:"header"`
+
+	// Port TCP or UDP port
+	Port NetworkPort `json:"port"`
+
+	// Token Token granting access to the port of the sandbox
+	Token string `json:"token"`
+}
+
+// RateLimits I/O rate limits of sandboxes created from the template, 0 or unset uses the team defaults
+type RateLimits struct {
+	// DiskBandwidthMiBps Disk bandwidth limit in MiB per second
+	DiskBandwidthMiBps *int64 `json:"diskBandwidthMiBps,omitempty"`
+
+	// DiskIops Disk operations per second limit
+	DiskIops *int64 `json:"diskIops,omitempty"`
+
+	// NetworkBandwidthMiBps Network bandwidth limit in MiB per second, applied to each direction
+	NetworkBandwidthMiBps *int64 `json:"networkBandwidthMiBps,omitempty"`
+
+	// NetworkOps Network packets per second limit, applied to each direction
+	NetworkOps *int64 `json:"networkOps,omitempty"`
+}
+
 // ResumedSandbox defines model for ResumedSandbox.
 type ResumedSandbox struct {
 	// AutoPause Automatically pauses the sandbox after the timeout
@@ -345,6 +596,12 @@ type SandboxLogs struct {
 // SandboxMetadata defines model for SandboxMetadata.
 type SandboxMetadata map[string]string
 
+// SandboxMetadataUpdate defines model for SandboxMetadataUpdate.
+type SandboxMetadataUpdate struct {
+	// Metadata Metadata keys to set, the keys set to null are removed and the other keys are kept
+	Metadata map[string]*string `json:"metadata"`
+}
+
 // SandboxMetric Metric entry with timestamp and line
 type SandboxMetric struct {
 	// CpuCount Number of CPU cores
@@ -363,9 +620,48 @@ type SandboxMetric struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// SandboxMigrateRequest defines model for SandboxMigrateRequest.
+type SandboxMigrateRequest struct {
+	// TargetNodeID Node to migrate the sandbox to, the best available node is picked when not set
+	TargetNodeID *string `json:"targetNodeID,omitempty"`
+}
+
+// SandboxNetworkPolicy Egress firewall policy of the sandbox. Allowed addresses and domains take precedence over the denied ones and the ports restrictions. Private and link-local ranges can't be allowed.
+type SandboxNetworkPolicy struct {
+	// AllowedCidrs IPv4 addresses or CIDRs the sandbox can connect to, use 0.0.0.0/0 in deniedCidrs to deny everything else. They are ignored when the internet access is disabled on the node.
+	AllowedCidrs *[]string `json:"allowedCidrs,omitempty"`
+
+	// AllowedDomains Domains the sandbox can connect to, their addresses are periodically resolved
+	AllowedDomains *[]string `json:"allowedDomains,omitempty"`
+
+	// AllowedPorts Destination TCP and UDP ports the sandbox can connect to, all other ports are denied if set
+	AllowedPorts *[]NetworkPort `json:"allowedPorts,omitempty"`
+
+	// DeniedCidrs IPv4 addresses or CIDRs the sandbox can't connect to
+	DeniedCidrs *[]string `json:"deniedCidrs,omitempty"`
+
+	// DeniedDomains Domains the sandbox can't connect to, their addresses are periodically resolved
+	DeniedDomains *[]string `json:"deniedDomains,omitempty"`
+
+	// DeniedPorts Destination TCP and UDP ports the sandbox can't connect to
+	DeniedPorts *[]NetworkPort `json:"deniedPorts,omitempty"`
+}
+
+// SandboxPause defines model for SandboxPause.
+type SandboxPause struct {
+	// RetentionHours Hours the paused sandbox is kept before it's deleted, overrides the retention of the team. It can't be longer than the retention of the team.
+	RetentionHours *int64 `json:"retentionHours,omitempty"`
+}
+
 // SandboxState State of the sandbox
 type SandboxState string
 
+// SandboxTemplate defines model for SandboxTemplate.
+type SandboxTemplate struct {
+	// Alias Alias of the template
+	Alias *string `json:"alias,omitempty"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	// ApiKey API key for the team
@@ -383,10 +679,16 @@ type Team struct {
 
 // TeamAPIKey defines model for TeamAPIKey.
 type TeamAPIKey struct {
+	// AllowedTemplates IDs or aliases of the templates the API key can be used with, all templates are allowed when empty
+	AllowedTemplates *[]string `json:"allowedTemplates,omitempty"`
+
 	// CreatedAt Timestamp of API key creation
 	CreatedAt time.Time `json:"createdAt"`
 	CreatedBy *TeamUser `json:"createdBy"`
 
+	// ExpiresAt Time when the API key expires
+	ExpiresAt *time.Time `json:"expiresAt"`
+
 	// Id Identifier of the API key
 	Id openapi_types.UUID `json:"id"`
 
@@ -396,6 +698,33 @@ type TeamAPIKey struct {
 
 	// Name Name of the API key
 	Name string `json:"name"`
+
+	// Scopes Scopes granted to the API key, the API key has full access to the team when empty
+	Scopes *[]APIKeyScope `json:"scopes,omitempty"`
+}
+
+// TeamUsage Usage of the sandboxes that stopped or paused in the reported period
+type TeamUsage struct {
+	// Day Day the usage is aggregated for, when grouped by day
+	Day *openapi_types.Date `json:"day,omitempty"`
+
+	// DiskMiBSeconds Disk size in MiB multiplied by the runtime in seconds
+	DiskMiBSeconds float64 `json:"diskMiBSeconds"`
+
+	// DurationSeconds Total runtime of the sandboxes in seconds
+	DurationSeconds float64 `json:"durationSeconds"`
+
+	// RamMiBSeconds Memory in MiB multiplied by the runtime in seconds
+	RamMiBSeconds float64 `json:"ramMiBSeconds"`
+
+	// Runs Number of the sandbox runs, each start or resume ended by a stop or pause is one run
+	Runs int64 `json:"runs"`
+
+	// TemplateID Identifier of the template the usage is aggregated for, when grouped by template
+	TemplateID *string `json:"templateID,omitempty"`
+
+	// VcpuSeconds Number of vCPUs multiplied by the runtime in seconds
+	VcpuSeconds float64 `json:"vcpuSeconds"`
 }
 
 // TeamUser defines model for TeamUser.
@@ -452,6 +781,9 @@ type TemplateBuild struct {
 	// Logs Build logs
 	Logs []string `json:"logs"`
 
+	// QueuePosition Approximate position of the build in the build queue, set only for the queued builds
+	QueuePosition *int64 `json:"queuePosition,omitempty"`
+
 	// Status Status of the template
 	Status TemplateBuildStatus `json:"status"`
 
@@ -462,6 +794,39 @@ type TemplateBuild struct {
 // TemplateBuildStatus Status of the template
 type TemplateBuildStatus string
 
+// TemplateBuildEvent Event of the template build stream
+type TemplateBuildEvent struct {
+	// DurationMs Duration of the finished build phase in milliseconds, set only for the phase events of the finished phases
+	DurationMs *int64 `json:"durationMs,omitempty"`
+
+	// Line Build log line, set only for the log events
+	Line *string `json:"line,omitempty"`
+
+	// Message Error message, set only for the error event which is the last event of the stream
+	Message *string `json:"message,omitempty"`
+
+	// Phase Build phase, set only for the phase events
+	Phase *TemplateBuildEventPhase `json:"phase,omitempty"`
+
+	// State State of the build, set only for the state events
+	State *TemplateBuildEventState `json:"state,omitempty"`
+
+	// Timestamp Time of the event, the start or the end of the phase for the phase events
+	Timestamp time.Time `json:"timestamp"`
+
+	// Type Type of the event
+	Type TemplateBuildEventType `json:"type"`
+}
+
+// TemplateBuildEventPhase Build phase, set only for the phase events
+type TemplateBuildEventPhase string
+
+// TemplateBuildEventState State of the build, set only for the state events
+type TemplateBuildEventState string
+
+// TemplateBuildEventType Type of the event
+type TemplateBuildEventType string
+
 // TemplateBuildRequest defines model for TemplateBuildRequest.
 type TemplateBuildRequest struct {
 	// Alias Alias of the template
@@ -476,6 +841,9 @@ type TemplateBuildRequest struct {
 	// MemoryMB Memory for the sandbox in MB
 	MemoryMB *MemoryMB `json:"memoryMB,omitempty"`
 
+	// RateLimits I/O rate limits of sandboxes created from the template, 0 or unset uses the team defaults
+	RateLimits *RateLimits `json:"rateLimits,omitempty"`
+
 	// ReadyCmd Ready check command to execute in the template after the build
 	ReadyCmd *string `json:"readyCmd,omitempty"`
 
@@ -486,16 +854,139 @@ type TemplateBuildRequest struct {
 	TeamID *string `json:"teamID,omitempty"`
 }
 
+// TemplateTag defines model for TemplateTag.
+type TemplateTag struct {
+	// BuildID Identifier of the build the tag points to
+	BuildID string `json:"buildID"`
+
+	// Tag Name of the tag
+	Tag string `json:"tag"`
+
+	// UpdatedAt Time when the tag was last moved
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TemplateTagUpdateRequest defines model for TemplateTagUpdateRequest.
+type TemplateTagUpdateRequest struct {
+	// BuildID Identifier of the successful build of the template the tag should point to
+	BuildID string `json:"buildID"`
+}
+
 // TemplateUpdateRequest defines model for TemplateUpdateRequest.
 type TemplateUpdateRequest struct {
 	// Public Whether the template is public or only accessible by the team
 	Public *bool `json:"public,omitempty"`
 }
 
+// TemplateVersion defines model for TemplateVersion.
+type TemplateVersion struct {
+	// BuildID Identifier of the build
+	BuildID string `json:"buildID"`
+
+	// CreatedAt Time when the build was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FinishedAt Time when the build finished
+	FinishedAt *time.Time `json:"finishedAt"`
+
+	// Tags Tags pointing to the build
+	Tags []string `json:"tags"`
+}
+
 // UpdateTeamAPIKey defines model for UpdateTeamAPIKey.
 type UpdateTeamAPIKey struct {
+	// AllowedTemplates IDs or aliases of the templates the API key can be used with, an empty list allows all templates
+	AllowedTemplates *[]string `json:"allowedTemplates,omitempty"`
+
+	// ExpiresAt Time when the API key expires
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
 	// Name New name for the API key
-	Name string `json:"name"`
+	Name *string `json:"name,omitempty"`
+
+	// Scopes Scopes granted to the API key, an empty list grants full access to the team
+	Scopes *[]APIKeyScope `json:"scopes,omitempty"`
+}
+
+// UsageGroupBy Aggregation of the usage
+type UsageGroupBy string
+
+// WarmPool defines model for WarmPool.
+type WarmPool struct {
+	// Ready Number of pre-warmed sandboxes ready to be claimed
+	Ready int32 `json:"ready"`
+
+	// Size Number of pre-warmed sandboxes kept for the team
+	Size int32 `json:"size"`
+}
+
+// WarmPoolUpdateRequest defines model for WarmPoolUpdateRequest.
+type WarmPoolUpdateRequest struct {
+	// Size Number of pre-warmed sandboxes to keep for the team, 0 removes the warm pool
+	Size int32 `json:"size"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	// CreatedAt Time when the webhook was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Enabled Whether the events are delivered to the webhook
+	Enabled bool `json:"enabled"`
+
+	// Events Events delivered to the webhook
+	Events []WebhookEventType `json:"events"`
+
+	// Id Identifier of the webhook
+	Id openapi_types.UUID `json:"id"`
+
+	// Url URL the events are delivered to
+	Url string `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempt Number of the delivery attempt, starting from 1
+	Attempt int32 `json:"attempt"`
+
+	// CreatedAt Time of the delivery attempt
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Error of the failed delivery attempt
+	Error *string `json:"error,omitempty"`
+
+	// EventID Identifier of the event, it's the same for all the attempts
+	EventID openapi_types.UUID `json:"eventID"`
+
+	// EventType Type of the sandbox lifecycle event
+	EventType WebhookEventType `json:"eventType"`
+
+	// Id Identifier of the delivery attempt
+	Id openapi_types.UUID `json:"id"`
+
+	// SandboxID Identifier of the sandbox the event is about
+	SandboxID string `json:"sandboxID"`
+
+	// StatusCode HTTP status code returned by the webhook
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// Success Whether the webhook accepted the event
+	Success bool `json:"success"`
+}
+
+// WebhookEventType Type of the sandbox lifecycle event
+type WebhookEventType string
+
+// WebhookUpdateRequest defines model for WebhookUpdateRequest.
+type WebhookUpdateRequest struct {
+	// Enabled Whether the events are delivered to the webhook
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Events Events delivered to the webhook
+	Events *[]WebhookEventType `json:"events,omitempty"`
+
+	// Url URL the events are delivered to
+	Url *string `json:"url,omitempty"`
 }
 
 // AccessTokenID defines model for accessTokenID.
@@ -507,15 +998,30 @@ type ApiKeyID = string
 // BuildID defines model for buildID.
 type BuildID = string
 
+// CheckpointID defines model for checkpointID.
+type CheckpointID = string
+
 // NodeID defines model for nodeID.
 type NodeID = string
 
+// Port TCP or UDP port
+type Port = NetworkPort
+
 // SandboxID defines model for sandboxID.
 type SandboxID = string
 
+// Tag defines model for tag.
+type Tag = string
+
+// TeamID defines model for teamID.
+type TeamID = openapi_types.UUID
+
 // TemplateID defines model for templateID.
 type TemplateID = string
 
+// WebhookID defines model for webhookID.
+type WebhookID = openapi_types.UUID
+
 // N400 defines model for 400.
 type N400 = Error
 
@@ -537,6 +1043,12 @@ type GetSandboxesParams struct {
 	Metadata *string `form:"metadata,omitempty" json:"metadata,omitempty"`
 }
 
+// PostSandboxesParams defines parameters for PostSandboxes.
+type PostSandboxesParams struct {
+	// IdempotencyKey Key identifying the request, the retries of the request with the same key and body return the original response instead of creating another sandbox
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
 // GetSandboxesMetricsParams defines parameters for GetSandboxesMetrics.
 type GetSandboxesMetricsParams struct {
 	// Metadata Metadata query used to filter the sandboxes (e.g. "user=abc&app=prod"). Each key and values must be URL encoded.
@@ -552,6 +1064,21 @@ type GetSandboxesSandboxIDLogsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetSandboxesSandboxIDLogsStreamParams defines parameters for GetSandboxesSandboxIDLogsStream.
+type GetSandboxesSandboxIDLogsStreamParams struct {
+	// Start Starting timestamp of the logs that should be returned in milliseconds, only new logs are returned when not set
+	Start *int64 `form:"start,omitempty" json:"start,omitempty"`
+
+	// Level Lowest level of the logs that should be returned
+	Level *GetSandboxesSandboxIDLogsStreamParamsLevel `form:"level,omitempty" json:"level,omitempty"`
+
+	// Category Category of the logs that should be returned
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+}
+
+// GetSandboxesSandboxIDLogsStreamParamsLevel defines parameters for GetSandboxesSandboxIDLogsStream.
+type GetSandboxesSandboxIDLogsStreamParamsLevel string
+
 // PostSandboxesSandboxIDRefreshesJSONBody defines parameters for PostSandboxesSandboxIDRefreshes.
 type PostSandboxesSandboxIDRefreshesJSONBody struct {
 	// Duration Duration for which the sandbox should be kept alive in seconds
@@ -564,11 +1091,47 @@ type PostSandboxesSandboxIDTimeoutJSONBody struct {
 	Timeout int32 `json:"timeout"`
 }
 
+// GetTeamsTeamIDAuditLogsParams defines parameters for GetTeamsTeamIDAuditLogs.
+type GetTeamsTeamIDAuditLogsParams struct {
+	// Action Filter the audit logs by one or more actions
+	Action *[]AuditLogAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// Start Return the audit logs created at or after this time
+	Start *time.Time `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Return the audit logs created before this time
+	End *time.Time `form:"end,omitempty" json:"end,omitempty"`
+
+	// NextToken Cursor to start the list from
+	NextToken *string `form:"nextToken,omitempty" json:"nextToken,omitempty"`
+
+	// Limit Maximum number of items to return per page
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetTeamsTeamIDUsageParams defines parameters for GetTeamsTeamIDUsage.
+type GetTeamsTeamIDUsageParams struct {
+	// From Start of the reported period, defaults to 30 days before the end
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the reported period, defaults to now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Aggregation of the usage
+	GroupBy *UsageGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+}
+
 // GetTemplatesParams defines parameters for GetTemplates.
 type GetTemplatesParams struct {
 	TeamID *string `form:"teamID,omitempty" json:"teamID,omitempty"`
 }
 
+// GetTemplatesTemplateIDBuildsBuildIDEventsParams defines parameters for GetTemplatesTemplateIDBuildsBuildIDEvents.
+type GetTemplatesTemplateIDBuildsBuildIDEventsParams struct {
+	// LogsOffset Index of the starting build log that should be streamed
+	LogsOffset *int32 `form:"logsOffset,omitempty" json:"logsOffset,omitempty"`
+}
+
 // GetTemplatesTemplateIDBuildsBuildIDStatusParams defines parameters for GetTemplatesTemplateIDBuildsBuildIDStatus.
 type GetTemplatesTemplateIDBuildsBuildIDStatusParams struct {
 	// LogsOffset Index of the starting build log that should be returned with the template
@@ -590,6 +1153,12 @@ type GetV2SandboxesParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetWebhooksWebhookIDDeliveriesParams defines parameters for GetWebhooksWebhookIDDeliveries.
+type GetWebhooksWebhookIDDeliveriesParams struct {
+	// Limit Maximum number of delivery attempts to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // PostAccessTokensJSONRequestBody defines body for PostAccessTokens for application/json ContentType.
 type PostAccessTokensJSONRequestBody = NewAccessToken
 
@@ -605,12 +1174,36 @@ type PostNodesNodeIDJSONRequestBody = NodeStatusChange
 // PostSandboxesJSONRequestBody defines body for PostSandboxes for application/json ContentType.
 type PostSandboxesJSONRequestBody = NewSandbox
 
+// PatchSandboxesSandboxIDJSONRequestBody defines body for PatchSandboxesSandboxID for application/json ContentType.
+type PatchSandboxesSandboxIDJSONRequestBody = SandboxMetadataUpdate
+
+// PostSandboxesSandboxIDCheckpointsJSONRequestBody defines body for PostSandboxesSandboxIDCheckpoints for application/json ContentType.
+type PostSandboxesSandboxIDCheckpointsJSONRequestBody = NewCheckpoint
+
+// PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody defines body for PostSandboxesSandboxIDCheckpointsCheckpointIDRestore for application/json ContentType.
+type PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody = ResumedSandbox
+
+// PostSandboxesSandboxIDForkJSONRequestBody defines body for PostSandboxesSandboxIDFork for application/json ContentType.
+type PostSandboxesSandboxIDForkJSONRequestBody = ForkedSandbox
+
+// PostSandboxesSandboxIDMigrateJSONRequestBody defines body for PostSandboxesSandboxIDMigrate for application/json ContentType.
+type PostSandboxesSandboxIDMigrateJSONRequestBody = SandboxMigrateRequest
+
+// PutSandboxesSandboxIDNetworkJSONRequestBody defines body for PutSandboxesSandboxIDNetwork for application/json ContentType.
+type PutSandboxesSandboxIDNetworkJSONRequestBody = SandboxNetworkPolicy
+
+// PostSandboxesSandboxIDPauseJSONRequestBody defines body for PostSandboxesSandboxIDPause for application/json ContentType.
+type PostSandboxesSandboxIDPauseJSONRequestBody = SandboxPause
+
 // PostSandboxesSandboxIDRefreshesJSONRequestBody defines body for PostSandboxesSandboxIDRefreshes for application/json ContentType.
 type PostSandboxesSandboxIDRefreshesJSONRequestBody PostSandboxesSandboxIDRefreshesJSONBody
 
 // PostSandboxesSandboxIDResumeJSONRequestBody defines body for PostSandboxesSandboxIDResume for application/json ContentType.
 type PostSandboxesSandboxIDResumeJSONRequestBody = ResumedSandbox
 
+// PostSandboxesSandboxIDTemplateJSONRequestBody defines body for PostSandboxesSandboxIDTemplate for application/json ContentType.
+type PostSandboxesSandboxIDTemplateJSONRequestBody = SandboxTemplate
+
 // PostSandboxesSandboxIDTimeoutJSONRequestBody defines body for PostSandboxesSandboxIDTimeout for application/json ContentType.
 type PostSandboxesSandboxIDTimeoutJSONRequestBody PostSandboxesSandboxIDTimeoutJSONBody
 
@@ -622,3 +1215,15 @@ type PatchTemplatesTemplateIDJSONRequestBody = TemplateUpdateRequest
 
 // PostTemplatesTemplateIDJSONRequestBody defines body for PostTemplatesTemplateID for application/json ContentType.
 type PostTemplatesTemplateIDJSONRequestBody = TemplateBuildRequest
+
+// PutTemplatesTemplateIDTagsTagJSONRequestBody defines body for PutTemplatesTemplateIDTagsTag for application/json ContentType.
+type PutTemplatesTemplateIDTagsTagJSONRequestBody = TemplateTagUpdateRequest
+
+// PutTemplatesTemplateIDWarmPoolJSONRequestBody defines body for PutTemplatesTemplateIDWarmPool for application/json ContentType.
+type PutTemplatesTemplateIDWarmPoolJSONRequestBody = WarmPoolUpdateRequest
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = NewWebhook
+
+// PatchWebhooksWebhookIDJSONRequestBody defines body for PatchWebhooksWebhookID for application/json ContentType.
+type PatchWebhooksWebhookIDJSONRequestBody = WebhookUpdateRequest
@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/middleware"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+const recordTimeout = 5 * time.Second
+
+type ActorType string
+
+const (
+	ActorUser   ActorType = "user"
+	ActorAPIKey ActorType = "api_key"
+	// ActorAdmin is used for the requests authenticated with the admin token, they don't have any user or team.
+	ActorAdmin ActorType = "admin"
+)
+
+type ResourceType string
+
+const (
+	ResourceAccessToken ResourceType = "access_token"
+	ResourceAPIKey      ResourceType = "api_key"
+	ResourceTemplate    ResourceType = "template"
+	ResourceSandbox     ResourceType = "sandbox"
+	ResourceNode        ResourceType = "node"
+)
+
+// store is the part of the database the audit logger uses.
+type store interface {
+	CreateAuditLog(ctx context.Context, log *models.AuditLog) error
+}
+
+// Logger records the changes made through the API to the audit log.
+type Logger struct {
+	store store
+}
+
+func NewLogger(store store) *Logger {
+	return &Logger{store: store}
+}
+
+// Record appends the action made by the actor of the request to the audit log.
+// The failures are only logged, so the audit log can't fail the request that already made the change.
+func (l *Logger) Record(c *gin.Context, teamID *uuid.UUID, action api.AuditLogAction, resourceType ResourceType, resourceID string, metadata map[string]string) {
+	actorType, actorID := actor(c)
+
+	log := &models.AuditLog{
+		TeamID:       teamID,
+		Action:       string(action),
+		ActorType:    string(actorType),
+		ActorID:      actorID,
+		ResourceType: string(resourceType),
+		ResourceID:   resourceID,
+		Metadata:     metadata,
+	}
+
+	if ip := c.ClientIP(); ip != "" {
+		log.IP = &ip
+	}
+
+	if requestID := c.GetString(middleware.RequestIDContextKey); requestID != "" {
+		log.RequestID = &requestID
+	}
+
+	// The change is already made, the log must be recorded even if the client disconnects
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), recordTimeout)
+	defer cancel()
+
+	err := l.store.CreateAuditLog(ctx, log)
+	if err != nil {
+		zap.L().Error("Error recording audit log", zap.String("action", log.Action), zap.String("resource_id", resourceID), zap.Error(err))
+	}
+}
+
+// actor returns who made the request, the user takes precedence over the API key.
+func actor(c *gin.Context) (ActorType, *string) {
+	if userID, ok := c.Value(auth.UserIDContextKey).(uuid.UUID); ok {
+		id := userID.String()
+
+		return ActorUser, &id
+	}
+
+	if teamInfo, ok := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo); ok && teamInfo.APIKey != nil {
+		id := teamInfo.APIKey.ID.String()
+
+		return ActorAPIKey, &id
+	}
+
+	return ActorAdmin, nil
+}
@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/middleware"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+type fakeStore struct {
+	logs []*models.AuditLog
+	err  error
+}
+
+func (s *fakeStore) CreateAuditLog(_ context.Context, log *models.AuditLog) error {
+	s.logs = append(s.logs, log)
+
+	return s.err
+}
+
+func newTestContext(values map[string]any) *gin.Context {
+	gin.SetMode(gin.TestMode)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/api-keys", nil)
+	c.Request.RemoteAddr = "10.0.0.1:1234"
+
+	for key, value := range values {
+		c.Set(key, value)
+	}
+
+	return c
+}
+
+func TestRecord_Actor(t *testing.T) {
+	userID := uuid.New()
+	apiKey := &models.TeamAPIKey{ID: uuid.New()}
+	teamID := uuid.New()
+
+	tests := []struct {
+		name      string
+		values    map[string]any
+		actorType ActorType
+		actorID   *string
+	}{
+		{
+			name:      "user",
+			values:    map[string]any{auth.UserIDContextKey: userID},
+			actorType: ActorUser,
+			actorID:   toPtr(userID.String()),
+		},
+		{
+			name:      "api key",
+			values:    map[string]any{auth.TeamContextKey: authcache.AuthTeamInfo{Team: &models.Team{ID: teamID}, APIKey: apiKey}},
+			actorType: ActorAPIKey,
+			actorID:   toPtr(apiKey.ID.String()),
+		},
+		{
+			name:      "admin",
+			values:    map[string]any{},
+			actorType: ActorAdmin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeStore{}
+			logger := NewLogger(store)
+
+			c := newTestContext(tt.values)
+			c.Set(middleware.RequestIDContextKey, "request-id")
+
+			logger.Record(c, &teamID, api.ApiKeyCreated, ResourceAPIKey, "resource-id", map[string]string{"name": "key"})
+
+			require.Len(t, store.logs, 1)
+			log := store.logs[0]
+
+			assert.Equal(t, string(tt.actorType), log.ActorType)
+			assert.Equal(t, tt.actorID, log.ActorID)
+			assert.Equal(t, &teamID, log.TeamID)
+			assert.Equal(t, string(api.ApiKeyCreated), log.Action)
+			assert.Equal(t, string(ResourceAPIKey), log.ResourceType)
+			assert.Equal(t, "resource-id", log.ResourceID)
+			assert.Equal(t, map[string]string{"name": "key"}, log.Metadata)
+			assert.Equal(t, toPtr("10.0.0.1"), log.IP)
+			assert.Equal(t, toPtr("request-id"), log.RequestID)
+		})
+	}
+}
+
+func TestRecord_StoreErrorDoesNotPanic(t *testing.T) {
+	store := &fakeStore{err: errors.New("db down")}
+	logger := NewLogger(store)
+
+	logger.Record(newTestContext(nil), nil, api.NodeStatusChanged, ResourceNode, "node-id", nil)
+
+	assert.Len(t, store.logs, 1)
+}
+
+func toPtr[T any](v T) *T {
+	return &v
+}
@@ -204,10 +204,41 @@ func CreateAuthenticationFunc(
 
 		for _, validator := range authenticators {
 			if input.SecuritySchemeName == validator.SecuritySchemeName() {
-				return validator.Authenticate(ctx, input)
+				err := validator.Authenticate(ctx, input)
+				if err != nil {
+					return err
+				}
+
+				if input.SecuritySchemeName == "ApiKeyAuth" {
+					return authorizeAPIKey(ginContext, input)
+				}
+
+				return nil
 			}
 		}
 
 		return fmt.Errorf("invalid security scheme name '%s'", input.SecuritySchemeName)
 	}
 }
+
+// authorizeAPIKey checks the restrictions of the authenticated API key against the called operation.
+func authorizeAPIKey(c *gin.Context, input *openapi3filter.AuthenticationInput) error {
+	teamInfo := c.Value(TeamContextKey).(authcache.AuthTeamInfo)
+	if teamInfo.APIKey == nil {
+		return nil
+	}
+
+	var operationID string
+	if route := input.RequestValidationInput.Route; route != nil && route.Operation != nil {
+		operationID = route.Operation.OperationID
+	}
+
+	err := CheckAPIKeyOperation(teamInfo.APIKey, operationID, input.RequestValidationInput.PathParams["templateID"])
+	if err != nil {
+		telemetry.ReportError(c.Request.Context(), "API key isn't allowed to call the operation", err)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+// operationScopes maps the operations accepting API keys to the scope the key needs for calling them.
+// The operations missing here can't be called with a scoped key.
+var operationScopes = map[string]api.APIKeyScope{
+	"PostSandboxes":                                        api.SandboxesCreate,
+	"PostSandboxesSandboxIDResume":                         api.SandboxesCreate,
+	"PostSandboxesSandboxIDFork":                           api.SandboxesCreate,
+	"PostSandboxesSandboxIDCheckpointsCheckpointIDRestore": api.SandboxesCreate,
+
+	"GetSandboxes":                     api.SandboxesRead,
+	"GetV2Sandboxes":                   api.SandboxesRead,
+	"GetSandboxesMetrics":              api.SandboxesRead,
+	"GetSandboxesSandboxID":            api.SandboxesRead,
+	"GetSandboxesSandboxIDCheckpoints": api.SandboxesRead,
+	"GetSandboxesSandboxIDLogs":        api.SandboxesRead,
+	"GetSandboxesSandboxIDLogsStream":  api.SandboxesRead,
+	"GetSandboxesSandboxIDMetrics":     api.SandboxesRead,
+
+	"DeleteSandboxesSandboxID":                        api.SandboxesWrite,
+	"PatchSandboxesSandboxID":                         api.SandboxesWrite,
+	"PostSandboxesSandboxIDPause":                     api.SandboxesWrite,
+	"PostSandboxesSandboxIDRefreshes":                 api.SandboxesWrite,
+	"PostSandboxesSandboxIDTimeout":                   api.SandboxesWrite,
+	"PutSandboxesSandboxIDNetwork":                    api.SandboxesWrite,
+	"PostSandboxesSandboxIDCheckpoints":               api.SandboxesWrite,
+	"DeleteSandboxesSandboxIDCheckpointsCheckpointID": api.SandboxesWrite,
+	"GetSandboxesSandboxIDPortsPortToken":             api.SandboxesWrite,
+
+	"GetTemplates": api.TemplatesRead,
+	"GetTemplatesTemplateIDBuildsBuildIDStatus": api.TemplatesRead,
+	"GetTemplatesTemplateIDBuildsBuildIDEvents": api.TemplatesRead,
+	"GetTemplatesTemplateIDWarmPool":            api.TemplatesRead,
+	"GetTemplatesTemplateIDVersions":            api.TemplatesRead,
+
+	"PostTemplates":                          api.TemplatesBuild,
+	"PostTemplatesTemplateID":                api.TemplatesBuild,
+	"PostTemplatesTemplateIDBuildsBuildID":   api.TemplatesBuild,
+	"DeleteTemplatesTemplateIDBuildsBuildID": api.TemplatesBuild,
+	"PostSandboxesSandboxIDTemplate":         api.TemplatesBuild,
+
+	"PutTemplatesTemplateIDWarmPool":   api.TemplatesWrite,
+	"PutTemplatesTemplateIDTagsTag":    api.TemplatesWrite,
+	"DeleteTemplatesTemplateIDTagsTag": api.TemplatesWrite,
+
+	"GetWebhooks":                    api.WebhooksManage,
+	"PostWebhooks":                   api.WebhooksManage,
+	"PatchWebhooksWebhookID":         api.WebhooksManage,
+	"DeleteWebhooksWebhookID":        api.WebhooksManage,
+	"GetWebhooksWebhookIDDeliveries": api.WebhooksManage,
+}
+
+// APIKeyForbiddenError is returned when the API key is valid, but it isn't allowed to call the operation.
+type APIKeyForbiddenError struct {
+	message string
+}
+
+func (e *APIKeyForbiddenError) Error() string {
+	return e.message
+}
+
+var ErrAPIKeyExpired = errors.New("API key has expired")
+
+// CheckAPIKeyExpiration returns an error if the API key has expired.
+func CheckAPIKeyExpiration(apiKey *models.TeamAPIKey, now time.Time) error {
+	if apiKey.ExpiresAt != nil && !now.Before(*apiKey.ExpiresAt) {
+		return ErrAPIKeyExpired
+	}
+
+	return nil
+}
+
+// CheckAPIKeyOperation checks the API key has the scope required by the operation
+// and that the template from the path (if any) is allowed for the key.
+// The template of the sandbox from the path is checked by the handlers, as it has to be looked up.
+func CheckAPIKeyOperation(apiKey *models.TeamAPIKey, operationID string, templateID string) error {
+	if len(apiKey.Scopes) > 0 {
+		scope, ok := operationScopes[operationID]
+		if !ok {
+			return &APIKeyForbiddenError{message: fmt.Sprintf("API key with scopes can't be used for the operation '%s'", operationID)}
+		}
+
+		if !slices.Contains(apiKey.Scopes, string(scope)) {
+			return &APIKeyForbiddenError{message: fmt.Sprintf("API key is missing the '%s' scope", scope)}
+		}
+	}
+
+	if len(apiKey.AllowedTemplates) == 0 {
+		return nil
+	}
+
+	// New templates can't be in the allowlist yet
+	if operationID == "PostTemplates" {
+		return &APIKeyForbiddenError{message: "API key restricted to templates can't create new templates"}
+	}
+
+	if templateID != "" && !APIKeyAllowsTemplate(apiKey, templateID) {
+		return &APIKeyForbiddenError{message: fmt.Sprintf("API key isn't allowed to access the template '%s'", templateID)}
+	}
+
+	return nil
+}
+
+// APIKeyAllowsTemplate returns true if any of the template's ID or aliases is allowed for the API key.
+func APIKeyAllowsTemplate(apiKey *models.TeamAPIKey, templateIDOrAliases ...string) bool {
+	if apiKey == nil || len(apiKey.AllowedTemplates) == 0 {
+		return true
+	}
+
+	for _, template := range templateIDOrAliases {
+		cleaned, err := id.CleanEnvID(template)
+		if err != nil {
+			continue
+		}
+
+		if slices.Contains(apiKey.AllowedTemplates, cleaned) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+// Every operation accepting API keys must have a scope, otherwise the scoped keys couldn't call it.
+func TestOperationScopes_CoverAPIKeyOperations(t *testing.T) {
+	swagger, err := api.GetSwagger()
+	require.NoError(t, err)
+
+	apiKeyOperations := make(map[string]bool)
+	for _, pathItem := range swagger.Paths.Map() {
+		for _, operation := range pathItem.Operations() {
+			if operation.Security == nil {
+				continue
+			}
+
+			for _, requirement := range *operation.Security {
+				if _, ok := requirement["ApiKeyAuth"]; ok {
+					apiKeyOperations[operation.OperationID] = true
+				}
+			}
+		}
+	}
+
+	for operationID := range apiKeyOperations {
+		assert.Contains(t, operationScopes, operationID, "operation '%s' accepts API keys but has no scope", operationID)
+	}
+
+	for operationID := range operationScopes {
+		assert.True(t, apiKeyOperations[operationID], "operation '%s' has a scope but doesn't accept API keys", operationID)
+	}
+}
+
+func TestCheckAPIKeyOperation_Scopes(t *testing.T) {
+	unrestricted := &models.TeamAPIKey{}
+	assert.NoError(t, CheckAPIKeyOperation(unrestricted, "DeleteSandboxesSandboxID", ""))
+
+	ci := &models.TeamAPIKey{Scopes: []string{string(api.TemplatesBuild), string(api.TemplatesRead)}}
+	assert.NoError(t, CheckAPIKeyOperation(ci, "PostTemplatesTemplateID", "base"))
+	assert.NoError(t, CheckAPIKeyOperation(ci, "GetTemplatesTemplateIDBuildsBuildIDStatus", "base"))
+
+	var forbidden *APIKeyForbiddenError
+	assert.ErrorAs(t, CheckAPIKeyOperation(ci, "PostSandboxes", ""), &forbidden)
+	assert.ErrorAs(t, CheckAPIKeyOperation(ci, "DeleteTemplatesTemplateID", "base"), &forbidden)
+}
+
+func TestCheckAPIKeyOperation_AllowedTemplates(t *testing.T) {
+	apiKey := &models.TeamAPIKey{AllowedTemplates: []string{"base", "my-template"}}
+
+	assert.NoError(t, CheckAPIKeyOperation(apiKey, "GetTemplatesTemplateIDWarmPool", "base"))
+	assert.NoError(t, CheckAPIKeyOperation(apiKey, "GetTemplatesTemplateIDWarmPool", "My-Template"))
+	assert.NoError(t, CheckAPIKeyOperation(apiKey, "GetSandboxes", ""))
+
+	var forbidden *APIKeyForbiddenError
+	assert.ErrorAs(t, CheckAPIKeyOperation(apiKey, "GetTemplatesTemplateIDWarmPool", "other"), &forbidden)
+	assert.ErrorAs(t, CheckAPIKeyOperation(apiKey, "PostTemplates", ""), &forbidden)
+}
+
+func TestAPIKeyAllowsTemplate(t *testing.T) {
+	assert.True(t, APIKeyAllowsTemplate(nil, "base"))
+	assert.True(t, APIKeyAllowsTemplate(&models.TeamAPIKey{}, "base"))
+
+	apiKey := &models.TeamAPIKey{AllowedTemplates: []string{"my-template"}}
+	assert.True(t, APIKeyAllowsTemplate(apiKey, "rki5dems9wqfm4r03t7g", "my-template"))
+	assert.False(t, APIKeyAllowsTemplate(apiKey, "rki5dems9wqfm4r03t7g", "base"))
+}
+
+func TestCheckAPIKeyExpiration(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	assert.NoError(t, CheckAPIKeyExpiration(&models.TeamAPIKey{}, now))
+	assert.NoError(t, CheckAPIKeyExpiration(&models.TeamAPIKey{ExpiresAt: &future}, now))
+	assert.ErrorIs(t, CheckAPIKeyExpiration(&models.TeamAPIKey{ExpiresAt: &past}, now), ErrAPIKeyExpired)
+}
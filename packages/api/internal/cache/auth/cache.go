@@ -20,18 +20,19 @@ const (
 type AuthTeamInfo struct {
 	Team *models.Team
 	Tier *models.Tier
+	// APIKey is set when the team was authenticated with an API key.
+	APIKey *models.TeamAPIKey
 }
 
 type TeamInfo struct {
-	team *models.Team
-	tier *models.Tier
+	info AuthTeamInfo
 
 	lastRefresh time.Time
 	once        singleflight.Group
 	lock        sync.Mutex
 }
 
-type DataCallback = func(ctx context.Context, key string) (*models.Team, *models.Tier, error)
+type DataCallback = func(ctx context.Context, key string) (AuthTeamInfo, error)
 
 type TeamAuthCache struct {
 	cache *ttlcache.Cache[string, *TeamInfo]
@@ -47,21 +48,21 @@ func NewTeamAuthCache() *TeamAuthCache {
 }
 
 // TODO: save blocked teams to cache as well, handle the condition in the GetOrSet method
-func (c *TeamAuthCache) GetOrSet(ctx context.Context, key string, dataCallback DataCallback) (team *models.Team, tier *models.Tier, err error) {
+func (c *TeamAuthCache) GetOrSet(ctx context.Context, key string, dataCallback DataCallback) (info AuthTeamInfo, err error) {
 	var item *ttlcache.Item[string, *TeamInfo]
 	var templateInfo *TeamInfo
 
 	item = c.cache.Get(key)
 	if item == nil {
-		team, tier, err = dataCallback(ctx, key)
+		info, err = dataCallback(ctx, key)
 		if err != nil {
-			return nil, nil, fmt.Errorf("error while getting the team: %w", err)
+			return AuthTeamInfo{}, fmt.Errorf("error while getting the team: %w", err)
 		}
 
-		templateInfo = &TeamInfo{team: team, tier: tier, lastRefresh: time.Now()}
+		templateInfo = &TeamInfo{info: info, lastRefresh: time.Now()}
 		c.cache.Set(key, templateInfo, authInfoExpiration)
 
-		return team, tier, nil
+		return info, nil
 	}
 
 	templateInfo = item.Value()
@@ -72,7 +73,12 @@ func (c *TeamAuthCache) GetOrSet(ctx context.Context, key string, dataCallback D
 		})
 	}
 
-	return templateInfo.team, templateInfo.tier, nil
+	return templateInfo.info, nil
+}
+
+// Invalidate removes the cached team of the key, it's loaded again on the next request.
+func (c *TeamAuthCache) Invalidate(key string) {
+	c.cache.Delete(key)
 }
 
 // Refresh refreshes the cache for the given team ID.
@@ -80,12 +86,12 @@ func (c *TeamAuthCache) Refresh(key string, dataCallback DataCallback) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	team, tier, err := dataCallback(ctx, key)
+	info, err := dataCallback(ctx, key)
 	if err != nil {
 		c.cache.Delete(key)
 
 		return
 	}
 
-	c.cache.Set(key, &TeamInfo{team: team, tier: tier, lastRefresh: time.Now()}, authInfoExpiration)
+	c.cache.Set(key, &TeamInfo{info: info, lastRefresh: time.Now()}, authInfoExpiration)
 }
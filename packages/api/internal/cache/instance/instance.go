@@ -14,6 +14,7 @@ import (
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -40,6 +41,7 @@ func NewInstanceInfo(
 	VCpu int64,
 	TotalDiskSizeMB int64,
 	RamMB int64,
+	MemoryTargetMB int64,
 	KernelVersion string,
 	FirecrackerVersion string,
 	EnvdVersion string,
@@ -47,19 +49,24 @@ func NewInstanceInfo(
 	AutoPause bool,
 	EnvdAccessToken *string,
 	BaseTemplateID string,
+	RateLimits *orchestrator.SandboxRateLimits,
+	networkPolicy *orchestrator.SandboxNetworkPolicy,
+	PortAccess *orchestrator.SandboxPortAccess,
+	IsResume bool,
 ) *InstanceInfo {
 	instance := &InstanceInfo{
 		Instance:           Instance,
 		ExecutionID:        ExecutionID,
 		TeamID:             TeamID,
 		BuildID:            BuildID,
-		Metadata:           Metadata,
+		metadata:           Metadata,
 		MaxInstanceLength:  MaxInstanceLength,
 		StartTime:          StartTime,
 		endTime:            endTime,
 		VCpu:               VCpu,
 		TotalDiskSizeMB:    TotalDiskSizeMB,
 		RamMB:              RamMB,
+		MemoryTargetMB:     MemoryTargetMB,
 		KernelVersion:      KernelVersion,
 		FirecrackerVersion: FirecrackerVersion,
 		EnvdVersion:        EnvdVersion,
@@ -68,6 +75,10 @@ func NewInstanceInfo(
 		AutoPause:          atomic.Bool{},
 		Pausing:            utils.NewSetOnce[*node.NodeInfo](),
 		BaseTemplateID:     BaseTemplateID,
+		RateLimits:         RateLimits,
+		networkPolicy:      networkPolicy,
+		PortAccess:         PortAccess,
+		IsResume:           IsResume,
 		mu:                 sync.RWMutex{},
 	}
 
@@ -82,21 +93,35 @@ type InstanceInfo struct {
 	TeamID             *uuid.UUID
 	BuildID            *uuid.UUID
 	BaseTemplateID     string
-	Metadata           map[string]string
+	metadata           map[string]string
 	MaxInstanceLength  time.Duration
 	StartTime          time.Time
 	endTime            time.Time
 	VCpu               int64
 	TotalDiskSizeMB    int64
 	RamMB              int64
+	MemoryTargetMB     int64 // 0 means the whole RamMB
 	KernelVersion      string
 	FirecrackerVersion string
 	EnvdVersion        string
 	EnvdAccessToken    *string
+	RateLimits         *orchestrator.SandboxRateLimits
+	networkPolicy      *orchestrator.SandboxNetworkPolicy
+	PortAccess         *orchestrator.SandboxPortAccess
 	Node               *node.NodeInfo
 	AutoPause          atomic.Bool
 	Pausing            *utils.SetOnce[*node.NodeInfo]
-	mu                 sync.RWMutex
+	// IsResume is set when the sandbox was started from its paused snapshot.
+	IsResume bool
+	// pausedRetentionHours overrides the tier retention of the snapshot when the sandbox is paused.
+	pausedRetentionHours *int64
+	// removed is set when the sandbox is removed before it timed out.
+	removed atomic.Bool
+	// migrating is set when the sandbox is paused only to be resumed on another node.
+	migrating atomic.Bool
+	// metadataUpdateMu serializes the metadata updates, which are merged into the current metadata.
+	metadataUpdateMu sync.Mutex
+	mu               sync.RWMutex
 }
 
 func (i *InstanceInfo) LoggerMetadata() sbxlogger.SandboxMetadata {
@@ -107,6 +132,15 @@ func (i *InstanceInfo) LoggerMetadata() sbxlogger.SandboxMetadata {
 	}
 }
 
+// MemoryMB returns the memory the sandbox is sized to, the memory reclaimed by the balloon device isn't counted.
+func (i *InstanceInfo) MemoryMB() int64 {
+	if i.MemoryTargetMB > 0 && i.MemoryTargetMB < i.RamMB {
+		return i.MemoryTargetMB
+	}
+
+	return i.RamMB
+}
+
 func (i *InstanceInfo) IsExpired() bool {
 	i.mu.RLock()
 	defer i.mu.RUnlock()
@@ -128,8 +162,112 @@ func (i *InstanceInfo) SetEndTime(endTime time.Time) {
 	i.endTime = endTime
 }
 
+func (i *InstanceInfo) GetNetworkPolicy() *orchestrator.SandboxNetworkPolicy {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.networkPolicy
+}
+
+func (i *InstanceInfo) SetNetworkPolicy(networkPolicy *orchestrator.SandboxNetworkPolicy) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.networkPolicy = networkPolicy
+}
+
+func (i *InstanceInfo) GetMetadata() map[string]string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.metadata
+}
+
+// LockMetadataUpdate locks the metadata for the update merged into the current metadata,
+// so the concurrent updates aren't lost. The returned function unlocks it.
+func (i *InstanceInfo) LockMetadataUpdate() (unlock func()) {
+	i.metadataUpdateMu.Lock()
+
+	return i.metadataUpdateMu.Unlock
+}
+
+// SetMetadata replaces the metadata, the map must not be modified after it's set.
+func (i *InstanceInfo) SetMetadata(metadata map[string]string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.metadata = metadata
+}
+
+func (i *InstanceInfo) GetPausedRetentionHours() *int64 {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.pausedRetentionHours
+}
+
+func (i *InstanceInfo) SetPausedRetentionHours(retentionHours *int64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.pausedRetentionHours = retentionHours
+}
+
+// SetExpired removes the sandbox before it timed out, the eviction doesn't report it as timed out.
 func (i *InstanceInfo) SetExpired() {
-	i.SetEndTime(time.Now())
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.removed.Store(true)
+	i.endTime = time.Now()
+}
+
+// TimedOut reports whether the sandbox was evicted because it reached its end time.
+func (i *InstanceInfo) TimedOut() bool {
+	return !i.removed.Load()
+}
+
+// SetMigrating marks the sandbox as paused only to be resumed on another node, its snapshot is transient.
+func (i *InstanceInfo) SetMigrating() {
+	i.migrating.Store(true)
+}
+
+// IsMigrating reports whether the sandbox is paused only to be resumed on another node.
+func (i *InstanceInfo) IsMigrating() bool {
+	return i.migrating.Load()
+}
+
+// KeepRunningUntil returns the copy of the sandbox whose pause was rejected, it's put back into the cache running until the end time.
+// The copy gets a new pause state, the waiters for the rejected pause already got its error.
+func (i *InstanceInfo) KeepRunningUntil(endTime time.Time) *InstanceInfo {
+	sbx := NewInstanceInfo(
+		i.Instance,
+		i.ExecutionID,
+		i.TeamID,
+		i.BuildID,
+		i.GetMetadata(),
+		i.MaxInstanceLength,
+		i.StartTime,
+		endTime,
+		i.VCpu,
+		i.TotalDiskSizeMB,
+		i.RamMB,
+		i.MemoryTargetMB,
+		i.KernelVersion,
+		i.FirecrackerVersion,
+		i.EnvdVersion,
+		i.Node,
+		i.AutoPause.Load(),
+		i.EnvdAccessToken,
+		i.BaseTemplateID,
+		i.RateLimits,
+		i.GetNetworkPolicy(),
+		i.PortAccess,
+		i.IsResume,
+	)
+	sbx.SetPausedRetentionHours(i.GetPausedRetentionHours())
+
+	return sbx
 }
 
 type InstanceCache struct {
@@ -139,6 +277,9 @@ type InstanceCache struct {
 	cache          *lifecycleCache[*InstanceInfo]
 	insertInstance func(data *InstanceInfo, created bool) error
 
+	// pooledUsage returns the number and resources of the pooled sandboxes of the team, they count against its quota.
+	pooledUsage func(teamID uuid.UUID) (int64, Resources)
+
 	sandboxCounter metric.Int64UpDownCounter
 	createdCounter metric.Int64Counter
 
@@ -0,0 +1,70 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+)
+
+func TestInstanceInfoKeepRunningUntil(t *testing.T) {
+	teamID := uuid.New()
+	buildID := uuid.New()
+	retentionHours := int64(24)
+	startTime := time.Now().Add(-time.Hour)
+
+	sbx := NewInstanceInfo(
+		&api.Sandbox{SandboxID: "sandbox-id", TemplateID: "template-id", ClientID: "client-id"},
+		"execution-id",
+		&teamID,
+		&buildID,
+		map[string]string{"key": "value"},
+		2*time.Hour,
+		startTime,
+		time.Now(),
+		2,
+		1024,
+		512,
+		512,
+		"kernel",
+		"firecracker",
+		"0.2.0",
+		nil,
+		true,
+		nil,
+		"base-template-id",
+		nil,
+		nil,
+		nil,
+		false,
+	)
+	sbx.SetPausedRetentionHours(&retentionHours)
+	sbx.SetExpired()
+
+	pauseErr := errors.New("paused storage quota exceeded")
+	sbx.PauseDone(pauseErr)
+
+	endTime := time.Now().Add(5 * time.Minute)
+	running := sbx.KeepRunningUntil(endTime)
+
+	assert.Equal(t, "execution-id", running.ExecutionID)
+	assert.Equal(t, startTime, running.StartTime)
+	assert.Equal(t, endTime, running.GetEndTime())
+	assert.Equal(t, map[string]string{"key": "value"}, running.GetMetadata())
+	assert.True(t, running.AutoPause.Load())
+	assert.Equal(t, &retentionHours, running.GetPausedRetentionHours())
+	assert.False(t, running.IsExpired())
+
+	// The rejected pause isn't carried over, the next pause of the sandbox is awaited
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := running.Pausing.WaitWithContext(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
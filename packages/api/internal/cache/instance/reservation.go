@@ -11,6 +11,20 @@ import (
 type Reservation struct {
 	instanceID string
 	team       uuid.UUID
+	resources  Resources
+}
+
+// Resources of the sandbox counted against the team quota.
+type Resources struct {
+	VCPU  int64
+	RAMMB int64
+}
+
+// Quota limits the sandboxes the team can run at the same time, 0 means unlimited for the vCPU and RAM limits.
+type Quota struct {
+	Instances int64
+	VCPU      int64
+	RAMMB     int64
 }
 
 type ReservationCache struct {
@@ -23,10 +37,11 @@ func NewReservationCache() *ReservationCache {
 	}
 }
 
-func (r *ReservationCache) insertIfAbsent(instanceID string, team uuid.UUID) bool {
+func (r *ReservationCache) insertIfAbsent(instanceID string, team uuid.UUID, resources Resources) bool {
 	return r.reservations.InsertIfAbsent(instanceID, &Reservation{
 		team:       team,
 		instanceID: instanceID,
+		resources:  resources,
 	})
 }
 
@@ -34,19 +49,19 @@ func (r *ReservationCache) release(instanceID string) {
 	r.reservations.Remove(instanceID)
 }
 
-func (r *ReservationCache) list(teamID uuid.UUID) (instanceIDs []string) {
+func (r *ReservationCache) list(teamID uuid.UUID) (reservations []*Reservation) {
 	for _, item := range r.reservations.Items() {
 		currentTeamID := item.team
 
 		if currentTeamID == teamID {
-			instanceIDs = append(instanceIDs, item.instanceID)
+			reservations = append(reservations, item)
 		}
 	}
 
-	return instanceIDs
+	return reservations
 }
 
-func (c *InstanceCache) list(teamID uuid.UUID) (instanceIDs []string) {
+func (c *InstanceCache) list(teamID uuid.UUID) (instances []*InstanceInfo) {
 	for _, value := range c.cache.Items() {
 		currentTeamID := value.TeamID
 
@@ -55,11 +70,54 @@ func (c *InstanceCache) list(teamID uuid.UUID) (instanceIDs []string) {
 		}
 
 		if *currentTeamID == teamID {
-			instanceIDs = append(instanceIDs, value.Instance.SandboxID)
+			instances = append(instances, value)
 		}
 	}
 
-	return instanceIDs
+	return instances
+}
+
+// SetPooledUsage sets the function returning the usage of the pooled sandboxes of the team.
+// The pooled sandboxes aren't in the cache until they are claimed, but they hold the resources of the team.
+func (c *InstanceCache) SetPooledUsage(pooledUsage func(teamID uuid.UUID) (int64, Resources)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pooledUsage = pooledUsage
+}
+
+// usage returns the number of the sandboxes of the team and their resources, the running, the pooled and the ones being started.
+func (c *InstanceCache) usage(teamID uuid.UUID) (int64, Resources) {
+	ids := map[string]struct{}{}
+	usage := Resources{}
+
+	for _, item := range c.reservations.list(teamID) {
+		ids[item.instanceID] = struct{}{}
+		usage.VCPU += item.resources.VCPU
+		usage.RAMMB += item.resources.RAMMB
+	}
+
+	for _, item := range c.list(teamID) {
+		// The sandbox is already added to the cache, but its reservation isn't released yet
+		if _, ok := ids[item.Instance.SandboxID]; ok {
+			continue
+		}
+
+		ids[item.Instance.SandboxID] = struct{}{}
+		usage.VCPU += item.VCpu
+		usage.RAMMB += item.MemoryMB()
+	}
+
+	count := int64(len(ids))
+	if c.pooledUsage != nil {
+		pooledCount, pooled := c.pooledUsage(teamID)
+
+		count += pooledCount
+		usage.VCPU += pooled.VCPU
+		usage.RAMMB += pooled.RAMMB
+	}
+
+	return count, usage
 }
 
 type ErrAlreadyBeingStarted struct {
@@ -78,22 +136,47 @@ func (e *ErrSandboxLimitExceeded) Error() string {
 	return fmt.Sprintf("sandbox %s has exceeded the limit", e.teamID)
 }
 
+// ErrQuotaExceeded is returned when the resources of the new sandbox don't fit the team quota.
+type ErrQuotaExceeded struct {
+	Usage     Resources
+	Requested Resources
+	Quota     Quota
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf(
+		"team quota exceeded: %d + %d of %d vCPUs, %d + %d of %d MiB RAM",
+		e.Usage.VCPU, e.Requested.VCPU, e.Quota.VCPU,
+		e.Usage.RAMMB, e.Requested.RAMMB, e.Quota.RAMMB,
+	)
+}
+
 func (c *InstanceCache) Reserve(instanceID string, team uuid.UUID, limit int64) (release func(), err error) {
+	return c.ReserveWithQuota(instanceID, team, Resources{}, Quota{Instances: limit})
+}
+
+// ReserveWithQuota reserves the sandbox for the team if both the number of the sandboxes
+// and their total resources stay within the team quota.
+func (c *InstanceCache) ReserveWithQuota(instanceID string, team uuid.UUID, resources Resources, quota Quota) (release func(), err error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// Count unique IDs for team
-	ids := map[string]struct{}{}
+	count, usage := c.usage(team)
 
-	for _, item := range append(c.reservations.list(team), c.list(team)...) {
-		ids[item] = struct{}{}
+	if count >= quota.Instances {
+		return nil, &ErrSandboxLimitExceeded{teamID: team.String()}
 	}
 
-	if int64(len(ids)) >= limit {
-		return nil, &ErrSandboxLimitExceeded{teamID: team.String()}
+	if (quota.VCPU > 0 && usage.VCPU+resources.VCPU > quota.VCPU) ||
+		(quota.RAMMB > 0 && usage.RAMMB+resources.RAMMB > quota.RAMMB) {
+		return nil, &ErrQuotaExceeded{
+			Usage:     usage,
+			Requested: resources,
+			Quota:     quota,
+		}
 	}
 
-	inserted := c.reservations.insertIfAbsent(instanceID, team)
+	inserted := c.reservations.insertIfAbsent(instanceID, team, resources)
 	if !inserted {
 		return nil, &ErrAlreadyBeingStarted{
 			sandboxID: instanceID,
@@ -62,3 +62,69 @@ func TestReservation_Release(t *testing.T) {
 	_, err = cache.Reserve(sandboxID, teamID, 1)
 	assert.NoError(t, err)
 }
+
+func TestReservation_QuotaExceeded(t *testing.T) {
+	cache, cancel := newInstanceCache()
+	defer cancel()
+
+	quota := Quota{Instances: 10, VCPU: 8, RAMMB: 4096}
+
+	_, err := cache.ReserveWithQuota("sandbox-1", teamID, Resources{VCPU: 4, RAMMB: 2048}, quota)
+	require.NoError(t, err)
+
+	_, err = cache.ReserveWithQuota("sandbox-2", teamID, Resources{VCPU: 8, RAMMB: 1024}, quota)
+	require.Error(t, err)
+
+	var quotaErr *ErrQuotaExceeded
+	require.ErrorAs(t, err, &quotaErr)
+	assert.Equal(t, Resources{VCPU: 4, RAMMB: 2048}, quotaErr.Usage)
+	assert.Equal(t, Resources{VCPU: 8, RAMMB: 1024}, quotaErr.Requested)
+
+	// Other teams have their own quota
+	_, err = cache.ReserveWithQuota("sandbox-3", uuid.New(), Resources{VCPU: 8, RAMMB: 4096}, quota)
+	assert.NoError(t, err)
+}
+
+func TestReservation_QuotaRelease(t *testing.T) {
+	cache, cancel := newInstanceCache()
+	defer cancel()
+
+	quota := Quota{Instances: 10, RAMMB: 4096}
+
+	release, err := cache.ReserveWithQuota("sandbox-1", teamID, Resources{VCPU: 2, RAMMB: 4096}, quota)
+	require.NoError(t, err)
+
+	_, err = cache.ReserveWithQuota("sandbox-2", teamID, Resources{VCPU: 2, RAMMB: 512}, quota)
+	assert.IsType(t, &ErrQuotaExceeded{}, err)
+
+	release()
+
+	// The vCPUs are unlimited
+	_, err = cache.ReserveWithQuota("sandbox-2", teamID, Resources{VCPU: 64, RAMMB: 512}, quota)
+	assert.NoError(t, err)
+}
+
+func TestReservation_PooledUsage(t *testing.T) {
+	cache, cancel := newInstanceCache()
+	defer cancel()
+
+	cache.SetPooledUsage(func(team uuid.UUID) (int64, Resources) {
+		if team != teamID {
+			return 0, Resources{}
+		}
+
+		return 2, Resources{VCPU: 4, RAMMB: 2048}
+	})
+
+	quota := Quota{Instances: 3, RAMMB: 4096}
+
+	_, err := cache.ReserveWithQuota("sandbox-1", teamID, Resources{VCPU: 2, RAMMB: 4096}, quota)
+	assert.IsType(t, &ErrQuotaExceeded{}, err)
+
+	_, err = cache.ReserveWithQuota("sandbox-1", teamID, Resources{VCPU: 2, RAMMB: 1024}, quota)
+	require.NoError(t, err)
+
+	// The pooled sandboxes count against the sandbox limit too
+	_, err = cache.ReserveWithQuota("sandbox-2", teamID, Resources{VCPU: 2, RAMMB: 512}, quota)
+	assert.IsType(t, &ErrSandboxLimitExceeded{}, err)
+}
@@ -41,7 +41,8 @@ func (c *InstanceCache) KeepAliveFor(instanceID string, duration time.Duration,
 	}
 
 	if (time.Since(instance.StartTime)) > instance.MaxInstanceLength {
-		c.cache.Remove(instanceID)
+		// Let the eviction handle the sandbox as timed out
+		instance.SetEndTime(now)
 
 		msg := fmt.Sprintf("Sandbox '%s' reached maximal allowed uptime", instanceID)
 		return nil, &api.APIError{Code: http.StatusForbidden, ClientMsg: msg, Err: errors.New(msg)}
@@ -17,6 +17,7 @@ import (
 	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 )
@@ -57,6 +58,9 @@ type TemplateCache struct {
 	cache      *ttlcache.Cache[string, *TemplateInfo]
 	db         *sqlcdb.Client
 	aliasCache *AliasCache
+
+	// pinnedBuilds caches the builds of the build pinned template references
+	pinnedBuilds *ttlcache.Cache[string, *queries.EnvBuild]
 }
 
 func NewTemplateCache(db *sqlcdb.Client) *TemplateCache {
@@ -64,10 +68,14 @@ func NewTemplateCache(db *sqlcdb.Client) *TemplateCache {
 	aliasCache := NewAliasCache()
 	go cache.Start()
 
+	pinnedBuilds := ttlcache.New(ttlcache.WithTTL[string, *queries.EnvBuild](templateInfoExpiration))
+	go pinnedBuilds.Start()
+
 	return &TemplateCache{
-		cache:      cache,
-		db:         db,
-		aliasCache: aliasCache,
+		cache:        cache,
+		db:           db,
+		aliasCache:   aliasCache,
+		pinnedBuilds: pinnedBuilds,
 	}
 }
 
@@ -135,6 +143,69 @@ func (c *TemplateCache) Invalidate(templateID string) {
 	c.cache.Delete(templateID)
 }
 
+// GetRef returns the template and the build the reference resolves to.
+// The reference without the tag or the build resolves to the latest successful build like Get.
+func (c *TemplateCache) GetRef(ctx context.Context, ref id.TemplateRef, teamID uuid.UUID, public bool) (*api.Template, *queries.EnvBuild, *api.APIError) {
+	template, build, apiErr := c.Get(ctx, ref.AliasOrEnvID, teamID, public)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	if ref.Tag == "" && ref.BuildID == nil {
+		return template, build, nil
+	}
+
+	if ref.BuildID != nil {
+		build, apiErr = c.getPinnedBuild(ctx, template.TemplateID, *ref.BuildID)
+	} else {
+		build, apiErr = c.getTaggedBuild(ctx, template.TemplateID, ref.Tag)
+	}
+
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	pinned := *template
+	pinned.BuildID = build.ID.String()
+
+	return &pinned, build, nil
+}
+
+// getPinnedBuild returns the uploaded build of the template, the build doesn't change once it's uploaded so it's cached.
+func (c *TemplateCache) getPinnedBuild(ctx context.Context, templateID string, buildID uuid.UUID) (*queries.EnvBuild, *api.APIError) {
+	key := fmt.Sprintf("%s@%s", templateID, buildID)
+
+	item := c.pinnedBuilds.Get(key)
+	if item != nil {
+		return item.Value(), nil
+	}
+
+	row, err := c.db.GetUploadedEnvBuild(ctx, queries.GetUploadedEnvBuildParams{EnvID: &templateID, BuildID: buildID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &api.APIError{Code: http.StatusNotFound, ClientMsg: fmt.Sprintf("template '%s' not found", key), Err: err}
+	} else if err != nil {
+		return nil, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: fmt.Sprintf("error while getting template build: %v", err), Err: err}
+	}
+
+	build := &row.EnvBuild
+	c.pinnedBuilds.Set(key, build, templateInfoExpiration)
+
+	return build, nil
+}
+
+// getTaggedBuild returns the build the tag of the template points to.
+// The tag isn't cached, the other replicas wouldn't see it moved or removed until the cache expires.
+func (c *TemplateCache) getTaggedBuild(ctx context.Context, templateID string, tag string) (*queries.EnvBuild, *api.APIError) {
+	row, err := c.db.GetTaggedEnvBuild(ctx, queries.GetTaggedEnvBuildParams{EnvID: templateID, Tag: tag})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &api.APIError{Code: http.StatusNotFound, ClientMsg: fmt.Sprintf("template '%s:%s' not found", templateID, tag), Err: err}
+	} else if err != nil {
+		return nil, &api.APIError{Code: http.StatusInternalServerError, ClientMsg: fmt.Sprintf("error while getting template build: %v", err), Err: err}
+	}
+
+	return &row.EnvBuild, nil
+}
+
 type TemplateBuildInfo struct {
 	TeamID      uuid.UUID
 	TemplateID  string
@@ -0,0 +1,199 @@
+package templatecache
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+)
+
+// templateDB is the database with the template, its uploaded builds and tags, it counts the queries by their name.
+type templateDB struct {
+	mu      sync.Mutex
+	queries map[string]int
+
+	template queries.Env
+	latest   queries.EnvBuild
+	builds   map[uuid.UUID]queries.EnvBuild
+	tags     map[string]uuid.UUID
+}
+
+func (d *templateDB) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+
+func (d *templateDB) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	return nil, pgx.ErrNoRows
+}
+
+func (d *templateDB) QueryRow(_ context.Context, query string, args ...interface{}) pgx.Row {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	name := strings.Fields(query)[2]
+	d.queries[name]++
+
+	switch name {
+	case "GetEnvWithBuild":
+		return structRow{row: queries.GetEnvWithBuildRow{Env: d.template, EnvBuild: d.latest}}
+	case "GetUploadedEnvBuild":
+		if build, ok := d.builds[args[1].(uuid.UUID)]; ok {
+			return structRow{row: queries.GetUploadedEnvBuildRow{EnvBuild: build}}
+		}
+	case "GetTaggedEnvBuild":
+		if buildID, ok := d.tags[args[1].(string)]; ok {
+			return structRow{row: queries.GetTaggedEnvBuildRow{EnvBuild: d.builds[buildID]}}
+		}
+	}
+
+	return noRow{}
+}
+
+func (d *templateDB) count(name string) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.queries[name]
+}
+
+type noRow struct{}
+
+func (noRow) Scan(...any) error {
+	return pgx.ErrNoRows
+}
+
+// structRow scans the fields of the row in order, the fields of the embedded models are scanned in place.
+type structRow struct {
+	row any
+}
+
+func (r structRow) Scan(dest ...any) error {
+	modelsPkg := reflect.TypeOf(queries.Env{}).PkgPath()
+
+	var values []reflect.Value
+	row := reflect.ValueOf(r.row)
+	for i := range row.NumField() {
+		field := row.Field(i)
+		if field.Kind() == reflect.Struct && field.Type().PkgPath() == modelsPkg {
+			for j := range field.NumField() {
+				values = append(values, field.Field(j))
+			}
+
+			continue
+		}
+
+		values = append(values, field)
+	}
+
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(values[i])
+	}
+
+	return nil
+}
+
+func newTemplateDB(teamID uuid.UUID) *templateDB {
+	templateID := "template-id"
+
+	latest := queries.EnvBuild{ID: uuid.New(), EnvID: &templateID, Status: "uploaded"}
+	stable := queries.EnvBuild{ID: uuid.New(), EnvID: &templateID, Status: "uploaded"}
+
+	return &templateDB{
+		queries:  make(map[string]int),
+		template: queries.Env{ID: templateID, TeamID: teamID},
+		latest:   latest,
+		builds:   map[uuid.UUID]queries.EnvBuild{latest.ID: latest, stable.ID: stable},
+		tags:     map[string]uuid.UUID{"stable": stable.ID},
+	}
+}
+
+func TestTemplateCacheGetRef(t *testing.T) {
+	teamID := uuid.New()
+
+	t.Run("reference without the tag or the build resolves to the latest build", func(t *testing.T) {
+		db := newTemplateDB(teamID)
+		c := NewTemplateCache(&sqlcdb.Client{Queries: queries.New(db)})
+
+		template, build, apiErr := c.GetRef(t.Context(), id.TemplateRef{AliasOrEnvID: "template-id"}, teamID, false)
+		require.Nil(t, apiErr)
+
+		assert.Equal(t, db.latest.ID, build.ID)
+		assert.Equal(t, db.latest.ID.String(), template.BuildID)
+	})
+
+	t.Run("tag resolves to the tagged build on every spawn", func(t *testing.T) {
+		db := newTemplateDB(teamID)
+		c := NewTemplateCache(&sqlcdb.Client{Queries: queries.New(db)})
+		ref := id.TemplateRef{AliasOrEnvID: "template-id", Tag: "stable"}
+
+		template, build, apiErr := c.GetRef(t.Context(), ref, teamID, false)
+		require.Nil(t, apiErr)
+
+		assert.Equal(t, db.tags["stable"], build.ID)
+		assert.Equal(t, db.tags["stable"].String(), template.BuildID)
+
+		// The tag is moved, possibly by another replica
+		db.mu.Lock()
+		db.tags["stable"] = db.latest.ID
+		db.mu.Unlock()
+
+		_, build, apiErr = c.GetRef(t.Context(), ref, teamID, false)
+		require.Nil(t, apiErr)
+
+		assert.Equal(t, db.latest.ID, build.ID)
+		assert.Equal(t, 2, db.count("GetTaggedEnvBuild"))
+	})
+
+	t.Run("build pinned reference is cached", func(t *testing.T) {
+		db := newTemplateDB(teamID)
+		c := NewTemplateCache(&sqlcdb.Client{Queries: queries.New(db)})
+		buildID := db.tags["stable"]
+		ref := id.TemplateRef{AliasOrEnvID: "template-id", BuildID: &buildID}
+
+		for range 2 {
+			template, build, apiErr := c.GetRef(t.Context(), ref, teamID, false)
+			require.Nil(t, apiErr)
+
+			assert.Equal(t, buildID, build.ID)
+			assert.Equal(t, buildID.String(), template.BuildID)
+		}
+
+		assert.Equal(t, 1, db.count("GetUploadedEnvBuild"))
+	})
+
+	t.Run("unknown tag or build isn't found", func(t *testing.T) {
+		db := newTemplateDB(teamID)
+		c := NewTemplateCache(&sqlcdb.Client{Queries: queries.New(db)})
+		buildID := uuid.New()
+
+		_, _, apiErr := c.GetRef(t.Context(), id.TemplateRef{AliasOrEnvID: "template-id", Tag: "missing"}, teamID, false)
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.Code)
+
+		_, _, apiErr = c.GetRef(t.Context(), id.TemplateRef{AliasOrEnvID: "template-id", BuildID: &buildID}, teamID, false)
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.Code)
+	})
+
+	t.Run("template of another team is forbidden", func(t *testing.T) {
+		db := newTemplateDB(uuid.New())
+		c := NewTemplateCache(&sqlcdb.Client{Queries: queries.New(db)})
+
+		_, _, apiErr := c.GetRef(t.Context(), id.TemplateRef{AliasOrEnvID: "template-id", Tag: "stable"}, teamID, false)
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusForbidden, apiErr.Code)
+		assert.Zero(t, db.count("GetTaggedEnvBuild"))
+	})
+}
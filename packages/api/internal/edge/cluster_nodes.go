@@ -29,10 +29,17 @@ type ClusterNode struct {
 
 	roles  []infogrpc.ServiceInfoRole
 	status infogrpc.ServiceInfoStatus
+	builds BuildsCapacity
 	mutex  sync.RWMutex
 	tracer trace.Tracer
 }
 
+// BuildsCapacity is the builds capacity reported by the template builder.
+type BuildsCapacity struct {
+	Running int64
+	Max     int64
+}
+
 const (
 	clusterNodesSyncInterval = 15 * time.Second
 	clusterNodesSyncTimeout  = 15 * time.Second
@@ -60,6 +67,7 @@ func (c *Cluster) syncNode(ctx context.Context, node *ClusterNode) {
 
 	node.status = info.ServiceStatus
 	node.roles = info.ServiceRoles
+	node.builds = BuildsCapacity{Running: info.MetricBuildsRunning, Max: info.MetricBuildsMax}
 }
 
 func (n *ClusterNode) GetStatus() infogrpc.ServiceInfoStatus {
@@ -68,6 +76,12 @@ func (n *ClusterNode) GetStatus() infogrpc.ServiceInfoStatus {
 	return n.status
 }
 
+func (n *ClusterNode) GetBuildsCapacity() BuildsCapacity {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.builds
+}
+
 func (n *ClusterNode) hasRole(r infogrpc.ServiceInfoRole) bool {
 	n.mutex.RLock()
 	defer n.mutex.RUnlock()
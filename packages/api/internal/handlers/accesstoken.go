@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/audit"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
@@ -57,6 +58,8 @@ func (a *APIStore) PostAccessTokens(c *gin.Context) {
 		return
 	}
 
+	a.auditLog.Record(c, nil, api.AccessTokenCreated, audit.ResourceAccessToken, accessTokenDB.ID.String(), map[string]string{"name": accessTokenDB.Name})
+
 	c.JSON(http.StatusCreated, api.CreatedAccessToken{
 		Id:    accessTokenDB.ID,
 		Token: accessToken.PrefixedRawValue,
@@ -99,5 +102,7 @@ func (a *APIStore) DeleteAccessTokensAccessTokenID(c *gin.Context, accessTokenID
 		return
 	}
 
+	a.auditLog.Record(c, nil, api.AccessTokenDeleted, audit.ResourceAccessToken, accessTokenIDParsed.String(), nil)
+
 	c.Status(http.StatusNoContent)
 }
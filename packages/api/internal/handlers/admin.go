@@ -2,13 +2,16 @@ package handlers
 
 import (
 	"cmp"
+	"context"
 	"fmt"
 	"net/http"
 	"slices"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/audit"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -60,5 +63,17 @@ func (a *APIStore) PostNodesNodeID(c *gin.Context, nodeId api.NodeID) {
 		return
 	}
 
+	a.auditLog.Record(c, nil, api.NodeStatusChanged, audit.ResourceNode, nodeId, map[string]string{"status": string(body.Status)})
+
+	if body.Status == api.NodeStatusDraining && body.MigrateSandboxes != nil && *body.MigrateSandboxes {
+		// The migrations outlive the request
+		started := a.nodeDrains.start(nodeId, func(ctx context.Context) {
+			a.drainNode(ctx, nodeId)
+		})
+		if !started {
+			zap.L().Info("Node is already being drained", zap.String("node_id", nodeId))
+		}
+	}
+
 	c.Status(http.StatusNoContent)
 }
@@ -11,8 +11,10 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/audit"
 	"github.com/e2b-dev/infra/packages/api/internal/team"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
@@ -22,6 +24,8 @@ import (
 func (a *APIStore) PatchApiKeysApiKeyID(c *gin.Context, apiKeyID string) {
 	ctx := c.Request.Context()
 
+	teamID := a.GetTeamInfo(c).Team.ID
+
 	body, err := utils.ParseBody[api.UpdateTeamAPIKey](ctx, c)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
@@ -38,17 +42,52 @@ func (a *APIStore) PatchApiKeysApiKeyID(c *gin.Context, apiKeyID string) {
 		return
 	}
 
-	err = a.db.Client.TeamAPIKey.UpdateOneID(apiKeyIDParsed).SetName(body.Name).SetUpdatedAt(time.Now()).Exec(ctx)
+	update := a.db.Client.TeamAPIKey.
+		UpdateOneID(apiKeyIDParsed).
+		Where(teamapikey.TeamID(teamID)).
+		SetNillableName(body.Name).
+		SetNillableExpiresAt(body.ExpiresAt).
+		SetUpdatedAt(time.Now())
+
+	if body.Scopes != nil {
+		if len(*body.Scopes) == 0 {
+			update.ClearScopes()
+		} else {
+			update.SetScopes(apiKeyScopesToDB(*body.Scopes))
+		}
+	}
+
+	if body.AllowedTemplates != nil {
+		allowedTemplates, err := cleanAllowedTemplates(*body.AllowedTemplates)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		if len(allowedTemplates) == 0 {
+			update.ClearAllowedTemplates()
+		} else {
+			update.SetAllowedTemplates(allowedTemplates)
+		}
+	}
+
+	apiKey, err := update.Save(ctx)
 	if models.IsNotFound(err) {
 		c.String(http.StatusNotFound, "id not found")
 		return
 	} else if err != nil {
-		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when updating team API key name: %s", err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when updating team API key: %s", err))
 
-		telemetry.ReportCriticalError(ctx, "error when updating team API key name", err)
+		telemetry.ReportCriticalError(ctx, "error when updating team API key", err)
 		return
 	}
 
+	// The restrictions of the key apply to the next request instead of after the cache refresh
+	a.authCache.Invalidate(apiKey.APIKey)
+
+	a.auditLog.Record(c, &teamID, api.ApiKeyUpdated, audit.ResourceAPIKey, apiKeyIDParsed.String(), nil)
+
 	c.Status(http.StatusAccepted)
 }
 
@@ -97,9 +136,12 @@ func (a *APIStore) GetApiKeys(c *gin.Context) {
 				MaskedValuePrefix: maskedKeyProperties.MaskedValuePrefix,
 				MaskedValueSuffix: maskedKeyProperties.MaskedValueSuffix,
 			},
-			CreatedAt: apiKey.CreatedAt,
-			CreatedBy: createdBy,
-			LastUsed:  apiKey.LastUsed,
+			CreatedAt:        apiKey.CreatedAt,
+			CreatedBy:        createdBy,
+			LastUsed:         apiKey.LastUsed,
+			ExpiresAt:        apiKey.ExpiresAt,
+			Scopes:           apiKeyScopesFromDB(apiKey.Scopes),
+			AllowedTemplates: allowedTemplatesFromDB(apiKey.AllowedTemplates),
 		}
 	}
 	c.JSON(http.StatusOK, teamAPIKeys)
@@ -108,6 +150,8 @@ func (a *APIStore) GetApiKeys(c *gin.Context) {
 func (a *APIStore) DeleteApiKeysApiKeyID(c *gin.Context, apiKeyID string) {
 	ctx := c.Request.Context()
 
+	teamID := a.GetTeamInfo(c).Team.ID
+
 	apiKeyIDParsed, err := uuid.Parse(apiKeyID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing API key ID: %s", err))
@@ -116,7 +160,10 @@ func (a *APIStore) DeleteApiKeysApiKeyID(c *gin.Context, apiKeyID string) {
 		return
 	}
 
-	err = a.db.Client.TeamAPIKey.DeleteOneID(apiKeyIDParsed).Exec(ctx)
+	err = a.db.Client.TeamAPIKey.
+		DeleteOneID(apiKeyIDParsed).
+		Where(teamapikey.TeamID(teamID)).
+		Exec(ctx)
 	if models.IsNotFound(err) {
 		c.String(http.StatusNotFound, "id not found")
 		return
@@ -127,6 +174,8 @@ func (a *APIStore) DeleteApiKeysApiKeyID(c *gin.Context, apiKeyID string) {
 		return
 	}
 
+	a.auditLog.Record(c, &teamID, api.ApiKeyDeleted, audit.ResourceAPIKey, apiKeyIDParsed.String(), nil)
+
 	c.Status(http.StatusNoContent)
 }
 
@@ -145,7 +194,32 @@ func (a *APIStore) PostApiKeys(c *gin.Context) {
 		return
 	}
 
-	apiKey, err := team.CreateAPIKey(ctx, a.db, teamID, userID, body.Name)
+	var allowedTemplates []string
+	if body.AllowedTemplates != nil {
+		allowedTemplates, err = cleanAllowedTemplates(*body.AllowedTemplates)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+			return
+		}
+	}
+
+	var scopes []string
+	if body.Scopes != nil {
+		scopes = apiKeyScopesToDB(*body.Scopes)
+	}
+
+	if body.ExpiresAt != nil && !body.ExpiresAt.After(time.Now()) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Expiration time of the API key must be in the future")
+
+		return
+	}
+
+	apiKey, err := team.CreateAPIKey(ctx, a.db, teamID, userID, body.Name, team.APIKeyRestrictions{
+		Scopes:           scopes,
+		AllowedTemplates: allowedTemplates,
+		ExpiresAt:        body.ExpiresAt,
+	})
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when creating team API key: %s", err))
 
@@ -163,6 +237,8 @@ func (a *APIStore) PostApiKeys(c *gin.Context) {
 		return
 	}
 
+	a.auditLog.Record(c, &teamID, api.ApiKeyCreated, audit.ResourceAPIKey, apiKey.ID.String(), map[string]string{"name": apiKey.Name})
+
 	c.JSON(http.StatusCreated, api.CreatedTeamAPIKey{
 		Id:   apiKey.ID,
 		Name: apiKey.Name,
@@ -177,7 +253,54 @@ func (a *APIStore) PostApiKeys(c *gin.Context) {
 			Id:    user.ID,
 			Email: user.Email,
 		},
-		CreatedAt: apiKey.CreatedAt,
-		LastUsed:  apiKey.LastUsed,
+		CreatedAt:        apiKey.CreatedAt,
+		LastUsed:         apiKey.LastUsed,
+		ExpiresAt:        apiKey.ExpiresAt,
+		Scopes:           apiKeyScopesFromDB(apiKey.Scopes),
+		AllowedTemplates: allowedTemplatesFromDB(apiKey.AllowedTemplates),
 	})
 }
+
+func apiKeyScopesToDB(scopes []api.APIKeyScope) []string {
+	result := make([]string, len(scopes))
+	for i, scope := range scopes {
+		result[i] = string(scope)
+	}
+
+	return result
+}
+
+func apiKeyScopesFromDB(scopes []string) *[]api.APIKeyScope {
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	result := make([]api.APIKeyScope, len(scopes))
+	for i, scope := range scopes {
+		result[i] = api.APIKeyScope(scope)
+	}
+
+	return &result
+}
+
+func allowedTemplatesFromDB(templates []string) *[]string {
+	if len(templates) == 0 {
+		return nil
+	}
+
+	return &templates
+}
+
+func cleanAllowedTemplates(templates []string) ([]string, error) {
+	result := make([]string, len(templates))
+	for i, template := range templates {
+		cleaned, err := id.CleanEnvID(template)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed template '%s'", template)
+		}
+
+		result[i] = cleaned
+	}
+
+	return result, nil
+}
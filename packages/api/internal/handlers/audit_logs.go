@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+)
+
+const defaultAuditLogsLimit = 100
+
+func (a *APIStore) GetTeamsTeamIDAuditLogs(c *gin.Context, teamID api.TeamID, params api.GetTeamsTeamIDAuditLogsParams) {
+	ctx := c.Request.Context()
+
+	if !a.checkTeamMember(c, teamID) {
+		return
+	}
+
+	filter := db.AuditLogsFilter{
+		Start: params.Start,
+		End:   params.End,
+		Limit: defaultAuditLogsLimit,
+	}
+
+	if params.Limit != nil {
+		filter.Limit = int(*params.Limit)
+	}
+
+	if params.Action != nil {
+		for _, action := range *params.Action {
+			filter.Actions = append(filter.Actions, string(action))
+		}
+	}
+
+	if params.NextToken != nil && *params.NextToken != "" {
+		cursorTime, cursorID, err := utils.ParseCursor(*params.NextToken)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid next token")
+
+			return
+		}
+
+		filter.CursorID, err = uuid.Parse(cursorID)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid next token")
+
+			return
+		}
+
+		filter.CursorTime = &cursorTime
+	}
+
+	limit := filter.Limit
+	// Get one more log to know if there is a next page
+	filter.Limit++
+
+	logsDB, err := a.db.GetAuditLogs(ctx, teamID, filter)
+	if err != nil {
+		zap.L().Error("Error getting audit logs", zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting audit logs")
+
+		return
+	}
+
+	if len(logsDB) > limit {
+		last := logsDB[limit-1]
+		c.Header("X-Next-Token", utils.EncodeCursor(last.CreatedAt.UTC().Truncate(time.Microsecond), last.ID.String()))
+
+		logsDB = logsDB[:limit]
+	}
+
+	logs := make([]api.AuditLog, len(logsDB))
+	for i, l := range logsDB {
+		logs[i] = api.AuditLog{
+			Id:           l.ID,
+			CreatedAt:    l.CreatedAt,
+			Action:       api.AuditLogAction(l.Action),
+			ActorType:    l.ActorType,
+			ActorID:      l.ActorID,
+			ResourceType: l.ResourceType,
+			ResourceID:   l.ResourceID,
+			Ip:           l.IP,
+			RequestID:    l.RequestID,
+		}
+
+		if len(l.Metadata) > 0 {
+			metadata := l.Metadata
+			logs[i].Metadata = &metadata
+		}
+	}
+
+	c.JSON(http.StatusOK, logs)
+}
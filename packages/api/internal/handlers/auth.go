@@ -1,21 +1,34 @@
 package handlers
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
 func (a *APIStore) GetUserID(c *gin.Context) uuid.UUID {
 	return c.Value(auth.UserIDContextKey).(uuid.UUID)
 }
 
+// GetUserAndTeams returns the user and their teams. When authenticated with an API key,
+// it returns the creator of the key and only the key's team, which is marked as the default one.
 func (a *APIStore) GetUserAndTeams(c *gin.Context) (*uuid.UUID, []queries.GetTeamsWithUsersTeamsWithTierRow, error) {
+	if teamInfo, ok := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo); ok && teamInfo.APIKey != nil {
+		return a.getAPIKeyCreatorAndTeam(c, teamInfo)
+	}
+
 	userID := a.GetUserID(c)
 	ctx := c.Request.Context()
 
@@ -27,6 +40,151 @@ func (a *APIStore) GetUserAndTeams(c *gin.Context) (*uuid.UUID, []queries.GetTea
 	return &userID, teams, err
 }
 
+func (a *APIStore) getAPIKeyCreatorAndTeam(c *gin.Context, teamInfo authcache.AuthTeamInfo) (*uuid.UUID, []queries.GetTeamsWithUsersTeamsWithTierRow, error) {
+	userID := teamInfo.APIKey.CreatedBy
+	if userID == nil {
+		return nil, nil, fmt.Errorf("API key '%s' has no creator", teamInfo.APIKey.ID)
+	}
+
+	teams, err := a.sqlcDB.GetTeamsWithUsersTeamsWithTier(c.Request.Context(), *userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error when getting teams of the API key creator: %w", err)
+	}
+
+	for _, t := range teams {
+		if t.Team.ID == teamInfo.Team.ID {
+			t.UsersTeam.IsDefault = true
+
+			return userID, []queries.GetTeamsWithUsersTeamsWithTierRow{t}, nil
+		}
+	}
+
+	return userID, nil, nil
+}
+
 func (a *APIStore) GetTeamInfo(c *gin.Context) authcache.AuthTeamInfo {
 	return c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
 }
+
+// getAPIKey returns the API key the request was authenticated with, it's nil for the other authentication methods.
+func (a *APIStore) getAPIKey(c *gin.Context) *models.TeamAPIKey {
+	teamInfo, ok := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+	if !ok {
+		return nil
+	}
+
+	return teamInfo.APIKey
+}
+
+// apiKeyAllowsTemplates returns true if the API key isn't restricted to templates or it allows any of the templates.
+// The key can allow the template by its alias, so the aliases of the templates are looked up when their IDs aren't allowed.
+func (a *APIStore) apiKeyAllowsTemplates(ctx context.Context, teamInfo authcache.AuthTeamInfo, templateIDs ...string) bool {
+	if teamInfo.APIKey == nil || len(teamInfo.APIKey.AllowedTemplates) == 0 {
+		return true
+	}
+
+	for _, templateID := range templateIDs {
+		if templateID == "" {
+			continue
+		}
+
+		if auth.APIKeyAllowsTemplate(teamInfo.APIKey, templateID) {
+			return true
+		}
+
+		template, _, apiErr := a.templateCache.Get(ctx, templateID, teamInfo.Team.ID, true)
+		if apiErr != nil {
+			continue
+		}
+
+		if auth.APIKeyAllowsTemplate(teamInfo.APIKey, templateIDAndAliases(template.TemplateID, template.Aliases)...) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sandboxTemplateIDs returns the templates the running sandbox was started from,
+// the forked, resumed and committed sandboxes keep the template of the original sandbox.
+func sandboxTemplateIDs(sbx *instance.InstanceInfo) []string {
+	return []string{sbx.Instance.TemplateID, sbx.BaseTemplateID}
+}
+
+// filterAPIKeySandboxes returns the running sandboxes the API key is allowed to access.
+func (a *APIStore) filterAPIKeySandboxes(ctx context.Context, teamInfo authcache.AuthTeamInfo, sandboxes []*instance.InstanceInfo) []*instance.InstanceInfo {
+	if teamInfo.APIKey == nil || len(teamInfo.APIKey.AllowedTemplates) == 0 {
+		return sandboxes
+	}
+
+	allowed := make([]*instance.InstanceInfo, 0, len(sandboxes))
+	for _, sbx := range sandboxes {
+		if a.apiKeyAllowsTemplates(ctx, teamInfo, sandboxTemplateIDs(sbx)...) {
+			allowed = append(allowed, sbx)
+		}
+	}
+
+	return allowed
+}
+
+// checkAPIKeySandbox checks the API key is allowed to access the template of the sandbox, otherwise it sends the error response.
+// The template is resolved from the running sandbox or the last snapshot of the paused one,
+// the keys restricted to templates can't access the sandboxes which aren't running or paused anymore.
+func (a *APIStore) checkAPIKeySandbox(c *gin.Context, sandboxID string) bool {
+	ctx := c.Request.Context()
+	teamInfo := a.GetTeamInfo(c)
+
+	if teamInfo.APIKey == nil || len(teamInfo.APIKey.AllowedTemplates) == 0 {
+		return true
+	}
+
+	var templateIDs []string
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err == nil && *sbx.TeamID == teamInfo.Team.ID {
+		templateIDs = sandboxTemplateIDs(sbx)
+	} else {
+		lastSnapshot, err := a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: teamInfo.Team.ID})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting the sandbox template")
+
+			telemetry.ReportCriticalError(ctx, "error when getting last snapshot", err)
+
+			return false
+		}
+
+		if err == nil {
+			templateIDs = []string{lastSnapshot.Snapshot.BaseEnvID}
+		}
+	}
+
+	if !a.apiKeyAllowsTemplates(ctx, teamInfo, templateIDs...) {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("API key isn't allowed to access the sandbox '%s'", sandboxID))
+
+		return false
+	}
+
+	return true
+}
+
+// checkTeamMember checks the user is a member of the team, otherwise it sends the error response.
+func (a *APIStore) checkTeamMember(c *gin.Context, teamID uuid.UUID) bool {
+	_, teams, err := a.GetUserAndTeams(c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting teams")
+
+		telemetry.ReportCriticalError(c.Request.Context(), "error when getting teams", err)
+
+		return false
+	}
+
+	for _, t := range teams {
+		if t.Team.ID == teamID {
+			return true
+		}
+	}
+
+	a.sendAPIStoreError(c, http.StatusNotFound, "Team not found")
+
+	return false
+}
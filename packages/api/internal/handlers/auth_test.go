@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+// emptyDB is the database without any rows.
+type emptyDB struct{}
+
+func (emptyDB) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+
+func (emptyDB) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	return nil, pgx.ErrNoRows
+}
+
+func (emptyDB) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	return emptyRow{}
+}
+
+type emptyRow struct{}
+
+func (emptyRow) Scan(...any) error {
+	return pgx.ErrNoRows
+}
+
+func TestFilterAPIKeySandboxes(t *testing.T) {
+	a := &APIStore{templateCache: templatecache.NewTemplateCache(&sqlcdb.Client{Queries: queries.New(emptyDB{})})}
+
+	newSandbox := func(templateID, baseTemplateID string) *instance.InstanceInfo {
+		return &instance.InstanceInfo{
+			Instance:       &api.Sandbox{SandboxID: uuid.NewString(), TemplateID: templateID},
+			BaseTemplateID: baseTemplateID,
+		}
+	}
+
+	started := newSandbox("allowed", "allowed")
+	committed := newSandbox("allowed", "other")
+	resumed := newSandbox("snapshot", "allowed")
+	other := newSandbox("other", "other")
+	sandboxes := []*instance.InstanceInfo{started, committed, resumed, other}
+
+	t.Run("keeps all the sandboxes for the unrestricted key", func(t *testing.T) {
+		teamInfo := authcache.AuthTeamInfo{Team: &models.Team{ID: uuid.New()}, APIKey: &models.TeamAPIKey{}}
+
+		assert.Equal(t, sandboxes, a.filterAPIKeySandboxes(context.Background(), teamInfo, sandboxes))
+	})
+
+	t.Run("keeps all the sandboxes without the key", func(t *testing.T) {
+		teamInfo := authcache.AuthTeamInfo{Team: &models.Team{ID: uuid.New()}}
+
+		assert.Equal(t, sandboxes, a.filterAPIKeySandboxes(context.Background(), teamInfo, sandboxes))
+	})
+
+	t.Run("keeps the sandboxes of the allowed templates", func(t *testing.T) {
+		teamInfo := authcache.AuthTeamInfo{
+			Team:   &models.Team{ID: uuid.New()},
+			APIKey: &models.TeamAPIKey{AllowedTemplates: []string{"allowed"}},
+		}
+
+		assert.Equal(t, []*instance.InstanceInfo{started, committed, resumed}, a.filterAPIKeySandboxes(context.Background(), teamInfo, sandboxes))
+	})
+}
+
+func TestAPIKeyAllowsTemplates(t *testing.T) {
+	a := &APIStore{templateCache: templatecache.NewTemplateCache(&sqlcdb.Client{Queries: queries.New(emptyDB{})})}
+	teamInfo := authcache.AuthTeamInfo{
+		Team:   &models.Team{ID: uuid.New()},
+		APIKey: &models.TeamAPIKey{AllowedTemplates: []string{"allowed"}},
+	}
+
+	assert.True(t, a.apiKeyAllowsTemplates(context.Background(), teamInfo, "other", "allowed"))
+	// The unknown template can't be resolved to the allowed alias
+	assert.False(t, a.apiKeyAllowsTemplates(context.Background(), teamInfo, "unknown"))
+	// The sandbox which isn't running or paused has no template
+	assert.False(t, a.apiKeyAllowsTemplates(context.Background(), teamInfo))
+}
+
+func TestSandboxRoutesCheckAPIKeyTemplates(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	teamID := uuid.New()
+	restricted := authcache.AuthTeamInfo{
+		Team:   &models.Team{ID: teamID},
+		Tier:   &models.Tier{},
+		APIKey: &models.TeamAPIKey{AllowedTemplates: []string{"allowed"}},
+	}
+
+	running := &instance.InstanceInfo{
+		Instance:          &api.Sandbox{SandboxID: "running", TemplateID: "other", ClientID: "client"},
+		ExecutionID:       "execution",
+		TeamID:            &teamID,
+		BaseTemplateID:    "other",
+		StartTime:         time.Now(),
+		MaxInstanceLength: time.Hour,
+	}
+	running.SetEndTime(time.Now().Add(time.Hour))
+
+	paused := &queries.GetLastSnapshotRow{Snapshot: queries.Snapshot{SandboxID: "paused", BaseEnvID: "other"}}
+
+	newStore := func(t *testing.T) *APIStore {
+		t.Helper()
+
+		return &APIStore{
+			orchestrator:          orchestrator.NewWithSandboxes(t.Context(), running),
+			templateCache:         templatecache.NewTemplateCache(&sqlcdb.Client{Queries: queries.New(emptyDB{})}),
+			sqlcDB:                &sqlcdb.Client{Queries: queries.New(lastSnapshotDB{snapshot: paused})},
+			portAccessTokenSigner: keys.NewPortAccessTokenSigner([]byte("seed")),
+		}
+	}
+
+	newContext := func(teamInfo authcache.AuthTeamInfo) (*gin.Context, *httptest.ResponseRecorder) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/sandboxes", nil)
+		c.Set(auth.TeamContextKey, teamInfo)
+
+		return c, w
+	}
+
+	t.Run("port access token of the sandbox of another template is forbidden", func(t *testing.T) {
+		c, w := newContext(restricted)
+
+		newStore(t).GetSandboxesSandboxIDPortsPortToken(c, "running", 8080)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("pause of the sandbox of another template is forbidden", func(t *testing.T) {
+		c, w := newContext(restricted)
+		a := newStore(t)
+
+		a.PostSandboxesSandboxIDPause(c, "running")
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		// The sandbox keeps running
+		_, err := a.orchestrator.GetSandbox("running")
+		assert.NoError(t, err)
+	})
+
+	t.Run("paused sandbox of another template isn't revealed", func(t *testing.T) {
+		c, w := newContext(restricted)
+
+		newStore(t).PostSandboxesSandboxIDPause(c, "paused")
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("port access token of the sandbox of the allowed template is issued", func(t *testing.T) {
+		allowed := restricted
+		allowed.APIKey = &models.TeamAPIKey{AllowedTemplates: []string{"other"}}
+		c, w := newContext(allowed)
+
+		newStore(t).GetSandboxesSandboxIDPortsPortToken(c, "running", 8080)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var token api.PortAccessToken
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &token))
+		assert.True(t, token.ExpiresAt.After(time.Now()))
+		// The token is bound to the current run of the sandbox
+		signer := keys.NewPortAccessTokenSigner([]byte("seed"))
+		assert.True(t, signer.Verify("running", "execution", 8080, token.Token))
+		assert.False(t, signer.Verify("running", "other-execution", 8080, token.Token))
+	})
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+const (
+	forkEnvReaperInterval  = 5 * time.Minute
+	forkEnvReaperBatchSize = 100
+	// forkEnvMinAge protects the envs of the forks that are still being created, no sandbox runs from them yet.
+	forkEnvMinAge = 30 * time.Minute
+)
+
+// deleteUnusedForkEnvs removes the fork env if no sandbox runs from it and no env is derived from it,
+// then continues with the env it was forked from, so the whole chain of unused forks is removed.
+func (a *APIStore) deleteUnusedForkEnvs(ctx context.Context, envID string, teamID uuid.UUID, clusterID *uuid.UUID) {
+	for envID != "" {
+		if a.envHasSandboxes(ctx, envID, teamID) {
+			return
+		}
+
+		forkEnv, err := a.db.GetForkEnv(ctx, envID)
+		if errors.Is(err, db.EnvNotFound{}) {
+			return
+		}
+
+		if err != nil {
+			zap.L().Error("Error getting fork env", logger.WithTemplateID(envID), zap.Error(err))
+
+			return
+		}
+
+		// The env isn't deleted if another env was derived from it in the meantime
+		sources, err := a.sqlcDB.DeleteUnusedForkEnv(ctx, envID)
+		if err != nil {
+			zap.L().Error("Error deleting fork env", logger.WithTemplateID(envID), zap.Error(err))
+
+			return
+		}
+
+		if len(sources) == 0 {
+			return
+		}
+
+		a.deleteEnvBuilds(envID, forkEnv.Edges.Builds, clusterID)
+
+		zap.L().Info("Deleted unused fork env", logger.WithTemplateID(envID), logger.WithTeamID(teamID.String()))
+
+		envID = ""
+		if sources[0] != nil {
+			envID = *sources[0]
+		}
+	}
+}
+
+// envHasSandboxes checks if any sandbox of the team runs from the env.
+func (a *APIStore) envHasSandboxes(ctx context.Context, envID string, teamID uuid.UUID) bool {
+	for _, sbx := range a.orchestrator.GetSandboxes(ctx, &teamID) {
+		if sbx.Instance.TemplateID == envID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reapUnusedForkEnvs periodically deletes the fork envs of the sandboxes that were killed on timeout or whose forks failed.
+func (a *APIStore) reapUnusedForkEnvs(ctx context.Context) {
+	ticker := time.NewTicker(forkEnvReaperInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			zap.L().Info("Stopping unused fork envs reaper")
+
+			return
+		case <-ticker.C:
+			a.deleteUnusedForkEnvBatch(ctx)
+		}
+	}
+}
+
+func (a *APIStore) deleteUnusedForkEnvBatch(ctx context.Context) {
+	ctx, span := a.Tracer.Start(ctx, "delete-unused-fork-envs")
+	defer span.End()
+
+	envs, err := a.sqlcDB.GetUnusedForkEnvs(ctx, queries.GetUnusedForkEnvsParams{
+		CreatedBefore: time.Now().Add(-forkEnvMinAge),
+		MaxEnvs:       forkEnvReaperBatchSize,
+	})
+	if err != nil {
+		zap.L().Error("Error getting unused fork envs", zap.Error(err))
+
+		return
+	}
+
+	for _, env := range envs {
+		a.deleteUnusedForkEnvs(ctx, env.ID, env.TeamID, env.ClusterID)
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+// drainMigrationConcurrency limits the number of sandboxes migrated at once by all the node drains.
+const drainMigrationConcurrency = 4
+
+// nodeDrains runs the migrations of the sandboxes from the draining nodes in the background.
+// There is at most one drain per node and the drains are stopped when the API shuts down.
+type nodeDrains struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	nodes      *smap.Map[struct{}]
+	migrations chan struct{}
+}
+
+func newNodeDrains(ctx context.Context) *nodeDrains {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &nodeDrains{
+		ctx:        ctx,
+		cancel:     cancel,
+		nodes:      smap.New[struct{}](),
+		migrations: make(chan struct{}, drainMigrationConcurrency),
+	}
+}
+
+// start runs the drain of the node in the background, it returns false if the node is already being drained.
+func (d *nodeDrains) start(nodeID string, drain func(ctx context.Context)) bool {
+	if d.ctx.Err() != nil {
+		return false
+	}
+
+	if !d.nodes.InsertIfAbsent(nodeID, struct{}{}) {
+		return false
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer d.nodes.Remove(nodeID)
+
+		drain(d.ctx)
+	}()
+
+	return true
+}
+
+// migrateAll migrates the sandboxes with the concurrency shared by all the drains.
+// No new migrations are started after the context is canceled.
+func (d *nodeDrains) migrateAll(ctx context.Context, sandboxes []*instance.InstanceInfo, migrate func(sbx *instance.InstanceInfo)) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for _, sbx := range sandboxes {
+		// The select picks randomly when both are ready
+		if ctx.Err() != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case d.migrations <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(sbx *instance.InstanceInfo) {
+			defer wg.Done()
+			defer func() { <-d.migrations }()
+
+			migrate(sbx)
+		}(sbx)
+	}
+}
+
+// close stops the drains and waits for the running migrations to finish, so no sandbox is left paused mid-migration.
+func (d *nodeDrains) close(ctx context.Context) error {
+	d.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+)
+
+func TestNodeDrains(t *testing.T) {
+	t.Run("drains the node only once at a time", func(t *testing.T) {
+		drains := newNodeDrains(context.Background())
+
+		release := make(chan struct{})
+		started := drains.start("node-id", func(context.Context) { <-release })
+		require.True(t, started)
+
+		assert.False(t, drains.start("node-id", func(context.Context) {}))
+		assert.True(t, drains.start("other-node-id", func(context.Context) {}))
+
+		close(release)
+		require.NoError(t, drains.close(context.Background()))
+	})
+
+	t.Run("drains the node again after the previous drain finished", func(t *testing.T) {
+		drains := newNodeDrains(context.Background())
+
+		done := make(chan struct{})
+		require.True(t, drains.start("node-id", func(context.Context) { close(done) }))
+		<-done
+
+		assert.Eventually(t, func() bool {
+			return drains.start("node-id", func(context.Context) {})
+		}, time.Second, time.Millisecond)
+
+		require.NoError(t, drains.close(context.Background()))
+	})
+
+	t.Run("close cancels the drains and waits for them", func(t *testing.T) {
+		drains := newNodeDrains(context.Background())
+
+		finished := atomic.Bool{}
+		require.True(t, drains.start("node-id", func(ctx context.Context) {
+			<-ctx.Done()
+			finished.Store(true)
+		}))
+
+		require.NoError(t, drains.close(context.Background()))
+		assert.True(t, finished.Load())
+
+		assert.False(t, drains.start("other-node-id", func(context.Context) {}))
+	})
+
+	t.Run("close gives up waiting with its context", func(t *testing.T) {
+		drains := newNodeDrains(context.Background())
+
+		release := make(chan struct{})
+		defer close(release)
+		require.True(t, drains.start("node-id", func(context.Context) { <-release }))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, drains.close(ctx), context.DeadlineExceeded)
+	})
+}
+
+func TestNodeDrains_MigrateAll(t *testing.T) {
+	sandboxes := make([]*instance.InstanceInfo, 20)
+	for i := range sandboxes {
+		sandboxes[i] = &instance.InstanceInfo{}
+	}
+
+	t.Run("limits the concurrent migrations", func(t *testing.T) {
+		drains := newNodeDrains(context.Background())
+
+		running := atomic.Int32{}
+		maxRunning := atomic.Int32{}
+		migrated := atomic.Int32{}
+
+		drains.migrateAll(context.Background(), sandboxes, func(*instance.InstanceInfo) {
+			current := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				previous := maxRunning.Load()
+				if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+			migrated.Add(1)
+		})
+
+		assert.Equal(t, int32(len(sandboxes)), migrated.Load())
+		assert.LessOrEqual(t, maxRunning.Load(), int32(drainMigrationConcurrency))
+	})
+
+	t.Run("stops starting migrations when canceled", func(t *testing.T) {
+		drains := newNodeDrains(context.Background())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		migrated := atomic.Int32{}
+
+		drains.migrateAll(ctx, sandboxes, func(*instance.InstanceInfo) {
+			migrated.Add(1)
+			cancel()
+		})
+
+		assert.Less(t, migrated.Load(), int32(len(sandboxes)))
+	})
+}
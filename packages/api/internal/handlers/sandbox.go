@@ -12,6 +12,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	orchestratorgrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -31,6 +32,8 @@ func (a *APIStore) startSandbox(
 	baseTemplateID string,
 	autoPause bool,
 	envdAccessToken *string,
+	networkPolicy *orchestratorgrpc.SandboxNetworkPolicy,
+	portAccess *orchestratorgrpc.SandboxPortAccess,
 ) (*api.Sandbox, *api.APIError) {
 	startTime := time.Now()
 	endTime := startTime.Add(timeout)
@@ -54,6 +57,8 @@ func (a *APIStore) startSandbox(
 		baseTemplateID,
 		autoPause,
 		envdAccessToken,
+		networkPolicy,
+		portAccess,
 	)
 	if instanceErr != nil {
 		telemetry.ReportCriticalError(ctx, "error when creating instance", instanceErr.Err)
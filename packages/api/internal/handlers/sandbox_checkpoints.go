@@ -0,0 +1,403 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func checkpointToAPI(c *models.Checkpoint) api.Checkpoint {
+	return api.Checkpoint{
+		CheckpointID: c.ID.String(),
+		SandboxID:    c.SandboxID,
+		Name:         c.Name,
+		CreatedAt:    c.CreatedAt,
+	}
+}
+
+// checkpointsToEvict returns the oldest checkpoints over the tier retention, the created checkpoint is kept.
+// The checkpoints are ordered from the oldest.
+func checkpointsToEvict(checkpoints []*models.Checkpoint, createdID uuid.UUID, maxCheckpoints int64) []*models.Checkpoint {
+	older := make([]*models.Checkpoint, 0, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		if checkpoint.ID != createdID {
+			older = append(older, checkpoint)
+		}
+	}
+
+	// The created checkpoint takes one of the retained slots
+	excess := len(older) + 1 - int(maxCheckpoints)
+	if excess <= 0 {
+		return nil
+	}
+
+	return older[:min(excess, len(older))]
+}
+
+// deleteCheckpoint removes the checkpoint and the files of the checkpoint builds that aren't used by anything else from the storage.
+func (a *APIStore) deleteCheckpoint(ctx context.Context, checkpointID uuid.UUID, sandboxID string, teamID uuid.UUID, teamClusterID *uuid.UUID) error {
+	var runningBuildID *uuid.UUID
+	if sbx, err := a.orchestrator.GetSandbox(sandboxID); err == nil {
+		runningBuildID = sbx.BuildID
+	}
+
+	builds, err := a.db.DeleteCheckpoint(ctx, checkpointID, sandboxID, teamID, runningBuildID)
+	if err != nil {
+		return err
+	}
+
+	// The builds still used by the sandbox are removed later, or together with the sandbox
+	if len(builds) == 0 {
+		return nil
+	}
+
+	a.deleteEnvBuilds(*builds[0].EnvID, builds, teamClusterID)
+
+	return nil
+}
+
+func (a *APIStore) GetSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
+	checkpoints, err := a.db.GetCheckpoints(ctx, sandboxID, teamID)
+	if err != nil {
+		zap.L().Error("Error getting checkpoints", zap.Error(err), logger.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error getting checkpoints")
+
+		return
+	}
+
+	result := make([]api.Checkpoint, 0, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		result = append(result, checkpointToAPI(checkpoint))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDCheckpoints(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+	team := teamInfo.Team
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDCheckpointsJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.String("checkpoint.name", body.Name),
+	)
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error creating checkpoint - sandbox '%s' is not running", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != team.ID {
+		telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, team.ID.String()))
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error creating checkpoint - sandbox '%s' does not belong to your team '%s'", sandboxID, team.ID.String()))
+
+		return
+	}
+
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
+	// The created checkpoint would be evicted right away
+	if teamInfo.Tier.MaxCheckpoints <= 0 {
+		a.sendAPIStoreError(c, http.StatusForbidden, "Checkpoints aren't available for your team's tier")
+
+		return
+	}
+
+	exists, err := a.db.CheckpointNameExists(ctx, sandboxID, team.ID, body.Name)
+	if err != nil {
+		zap.L().Error("Error checking checkpoint name", zap.Error(err), logger.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating checkpoint")
+
+		return
+	}
+
+	if exists {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Checkpoint '%s' already exists for sandbox '%s'", body.Name, sandboxID))
+
+		return
+	}
+
+	build, err := a.orchestrator.CheckpointInstance(ctx, sbx, team.ID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating checkpoint", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating checkpoint")
+
+		return
+	}
+
+	checkpoint, err := a.db.CreateCheckpoint(ctx, sandboxID, team.ID, body.Name, build.ID, sbx.BuildID)
+	if errors.Is(err, db.CheckpointAlreadyExists{}) {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Checkpoint '%s' already exists for sandbox '%s'", body.Name, sandboxID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error saving checkpoint", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error creating checkpoint")
+
+		return
+	}
+
+	// Evict the oldest checkpoints over the tier retention
+	checkpoints, err := a.db.GetCheckpoints(ctx, sandboxID, team.ID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error getting checkpoints for retention", err)
+	} else {
+		for _, evicted := range checkpointsToEvict(checkpoints, checkpoint.ID, teamInfo.Tier.MaxCheckpoints) {
+			evictErr := a.deleteCheckpoint(ctx, evicted.ID, sandboxID, team.ID, team.ClusterID)
+			if evictErr != nil {
+				telemetry.ReportError(ctx, "error evicting checkpoint", evictErr, telemetry.WithSandboxID(sandboxID))
+			}
+		}
+	}
+
+	sbxlogger.I(sbx).Info("Sandbox checkpoint created", zap.String("checkpoint_id", checkpoint.ID.String()), zap.String("checkpoint_name", checkpoint.Name))
+
+	c.JSON(http.StatusCreated, checkpointToAPI(checkpoint))
+}
+
+func (a *APIStore) DeleteSandboxesSandboxIDCheckpointsCheckpointID(c *gin.Context, sandboxID api.SandboxID, checkpointID api.CheckpointID) {
+	ctx := c.Request.Context()
+
+	team := a.GetTeamInfo(c).Team
+	sandboxID = utils.ShortID(sandboxID)
+
+	checkpointUUID, err := uuid.Parse(checkpointID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' not found", checkpointID))
+
+		return
+	}
+
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
+	err = a.deleteCheckpoint(ctx, checkpointUUID, sandboxID, team.ID, team.ClusterID)
+	if errors.Is(err, db.CheckpointNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' not found", checkpointID))
+
+		return
+	}
+
+	if err != nil {
+		zap.L().Error("Error deleting checkpoint", zap.Error(err), logger.WithSandboxID(sandboxID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error deleting checkpoint")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a *APIStore) PostSandboxesSandboxIDCheckpointsCheckpointIDRestore(c *gin.Context, sandboxID api.SandboxID, checkpointID api.CheckpointID) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+	teamID := teamInfo.Team.ID
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDCheckpointsCheckpointIDRestoreJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	timeout := instance.InstanceExpiration
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	autoPause := instance.InstanceAutoPauseDefault
+	if body.AutoPause != nil {
+		autoPause = *body.AutoPause
+	}
+
+	checkpointUUID, err := uuid.Parse(checkpointID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' not found", checkpointID))
+
+		return
+	}
+
+	// The snapshot isn't changed while the sandbox is restored from it
+	unlock := a.sandboxLocks.lock(sandboxID)
+	defer unlock()
+
+	checkpoint, err := a.sqlcDB.GetCheckpoint(ctx, queries.GetCheckpointParams{CheckpointID: checkpointUUID, SandboxID: sandboxID, TeamID: teamID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Checkpoint '%s' not found", checkpointID))
+
+			return
+		}
+
+		zap.L().Error("Error getting checkpoint", logger.WithSandboxID(sandboxID), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting checkpoint")
+
+		return
+	}
+
+	if !a.apiKeyAllowsTemplates(ctx, teamInfo, checkpoint.Snapshot.BaseEnvID) {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("API key isn't allowed to access the sandbox '%s'", sandboxID))
+
+		return
+	}
+
+	var clientID *string
+
+	// The running sandbox is paused first, so its current state isn't lost and the same sandbox ID can be reused
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err == nil {
+		if *sbx.TeamID != teamID {
+			telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String()))
+
+			a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error restoring sandbox - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+			return
+		}
+
+		found := a.orchestrator.DeleteInstance(ctx, sandboxID, true)
+		if found {
+			_, err = sbx.Pausing.WaitWithContext(ctx)
+			if err != nil {
+				a.sendAPIStoreError(c, pauseErrorCode(err), fmt.Sprintf("Error pausing sandbox before restoring: %s", err))
+
+				return
+			}
+		}
+
+		clientID = &sbx.Instance.ClientID
+	} else {
+		pausedOnNode, err := a.orchestrator.WaitForPause(ctx, sandboxID)
+		if err != nil && !errors.Is(err, instance.ErrPausingInstanceNotFound) {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error while pausing sandbox %s: %s", sandboxID, err))
+
+			return
+		}
+
+		if err == nil {
+			clientID = &pausedOnNode.ID
+		}
+	}
+
+	snap := checkpoint.Snapshot
+	build := checkpoint.EnvBuild
+
+	alias := ""
+	if len(checkpoint.Aliases) > 0 {
+		alias = checkpoint.Aliases[0]
+	}
+
+	sbxlogger.E(&sbxlogger.SandboxMetadata{
+		SandboxID:  sandboxID,
+		TemplateID: *build.EnvID,
+		TeamID:     teamID.String(),
+	}).Debug("Started restoring sandbox", zap.String("checkpoint_id", checkpointID))
+
+	var envdAccessToken *string = nil
+	if snap.EnvSecure {
+		accessToken, tokenErr := a.getEnvdAccessToken(build.EnvdVersion, sandboxID)
+		if tokenErr != nil {
+			zap.L().Error("Secure envd access token error", zap.Error(tokenErr.Err), logger.WithTemplateID(*build.EnvID), logger.WithBuildID(build.ID.String()))
+			a.sendAPIStoreError(c, tokenErr.Code, tokenErr.ClientMsg)
+
+			return
+		}
+
+		envdAccessToken = &accessToken
+	}
+
+	restored, createErr := a.startSandbox(
+		ctx,
+		snap.SandboxID,
+		timeout,
+		nil,
+		snap.Metadata,
+		alias,
+		teamInfo,
+		build,
+		&c.Request.Header,
+		true,
+		clientID,
+		snap.BaseEnvID,
+		autoPause,
+		envdAccessToken,
+		orchestrator.NetworkPolicyFromSnapshot(snap.NetworkPolicy),
+		orchestrator.PortAccessFromSnapshot(snap.PortAccess),
+	)
+	if createErr != nil {
+		zap.L().Error("Failed to restore sandbox", zap.Error(createErr.Err))
+		a.sendAPIStoreError(c, createErr.Code, createErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, &restored)
+}
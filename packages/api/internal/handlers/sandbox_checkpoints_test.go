@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestCheckpointsToEvict(t *testing.T) {
+	newCheckpoints := func(n int) []*models.Checkpoint {
+		checkpoints := make([]*models.Checkpoint, 0, n)
+		for range n {
+			checkpoints = append(checkpoints, &models.Checkpoint{ID: uuid.New()})
+		}
+
+		return checkpoints
+	}
+
+	t.Run("keeps the checkpoints within the retention", func(t *testing.T) {
+		checkpoints := newCheckpoints(3)
+
+		assert.Empty(t, checkpointsToEvict(checkpoints, checkpoints[2].ID, 3))
+	})
+
+	t.Run("evicts the oldest checkpoints over the retention", func(t *testing.T) {
+		checkpoints := newCheckpoints(4)
+
+		assert.Equal(t, checkpoints[:2], checkpointsToEvict(checkpoints, checkpoints[3].ID, 2))
+	})
+
+	t.Run("keeps the created checkpoint created at the same time as the older ones", func(t *testing.T) {
+		checkpoints := newCheckpoints(3)
+		created := checkpoints[0]
+
+		assert.Equal(t, checkpoints[1:], checkpointsToEvict(checkpoints, created.ID, 1))
+	})
+
+	t.Run("keeps the created checkpoint not listed yet", func(t *testing.T) {
+		checkpoints := newCheckpoints(2)
+
+		assert.Equal(t, checkpoints, checkpointsToEvict(checkpoints, uuid.New(), 1))
+	})
+}
+
+func TestPostSandboxCheckpointWithoutRetention(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	teamID := uuid.New()
+	sbx := &instance.InstanceInfo{
+		Instance:          &api.Sandbox{SandboxID: "sandbox", TemplateID: "template-id", ClientID: "client"},
+		TeamID:            &teamID,
+		StartTime:         time.Now(),
+		MaxInstanceLength: time.Hour,
+	}
+	sbx.SetEndTime(time.Now().Add(time.Hour))
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/sandboxes/sandbox/checkpoints", bytes.NewBufferString(`{"name":"checkpoint"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set(auth.TeamContextKey, authcache.AuthTeamInfo{Team: &models.Team{ID: teamID}, Tier: &models.Tier{}})
+
+	a := &APIStore{orchestrator: orchestrator.NewWithSandboxes(t.Context(), sbx)}
+	a.PostSandboxesSandboxIDCheckpoints(c, "sandbox")
+
+	// The checkpoint isn't created, it would be evicted right away
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
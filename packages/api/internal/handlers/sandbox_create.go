@@ -15,11 +15,15 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/constants"
 	"github.com/e2b-dev/infra/packages/api/internal/middleware/otel/metrics"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 	sharedUtils "github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
@@ -39,7 +43,7 @@ var mostUsedTemplates = map[string]struct{}{
 	"desktop":               {},
 }
 
-func (a *APIStore) PostSandboxes(c *gin.Context) {
+func (a *APIStore) PostSandboxes(c *gin.Context, params api.PostSandboxesParams) {
 	ctx := c.Request.Context()
 
 	// Get team from context, use TeamContextKey
@@ -62,7 +66,17 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	telemetry.ReportEvent(ctx, "Parsed body")
 
-	cleanedAliasOrEnvID, err := id.CleanEnvID(body.TemplateID)
+	sandboxID := InstanceIDPrefix + id.Generate()
+
+	c.Set("instanceID", sandboxID)
+
+	idempotentReq, ok := a.claimIdempotencyKey(c, teamInfo.Team.ID, "PostSandboxes", params.IdempotencyKey, body, sandboxID)
+	if !ok {
+		return
+	}
+	defer idempotentReq.release(ctx)
+
+	templateRef, err := id.ParseTemplateRef(body.TemplateID)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid environment ID: %s", err))
 
@@ -77,7 +91,7 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 	defer templateSpan.End()
 
 	// Check if team has access to the environment
-	env, build, checkErr := a.templateCache.Get(ctx, cleanedAliasOrEnvID, teamInfo.Team.ID, true)
+	env, build, checkErr := a.templateCache.GetRef(ctx, templateRef, teamInfo.Team.ID, true)
 	if checkErr != nil {
 		telemetry.ReportCriticalError(ctx, "error when getting template", checkErr.Err)
 		a.sendAPIStoreError(c, checkErr.Code, checkErr.ClientMsg)
@@ -85,6 +99,12 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 	}
 	templateSpan.End()
 
+	if !auth.APIKeyAllowsTemplate(teamInfo.APIKey, templateIDAndAliases(env.TemplateID, env.Aliases)...) {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("API key isn't allowed to access the template '%s'", body.TemplateID))
+
+		return
+	}
+
 	telemetry.ReportEvent(ctx, "Checked team access")
 
 	c.Set("envID", env.TemplateID)
@@ -92,10 +112,6 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		setTemplateNameMetric(c, *aliases)
 	}
 
-	sandboxID := InstanceIDPrefix + id.Generate()
-
-	c.Set("instanceID", sandboxID)
-
 	sbxlogger.E(&sbxlogger.SandboxMetadata{
 		SandboxID:  sandboxID,
 		TemplateID: env.TemplateID,
@@ -136,6 +152,36 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		autoPause = *body.AutoPause
 	}
 
+	networkPolicy, err := orchestrator.NetworkPolicyFromAPI(body.Network)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid network policy: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "invalid network policy", err)
+
+		return
+	}
+
+	portAccess, err := orchestrator.PortAccessFromAPI(body.PublicPorts)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid public ports: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "invalid public ports", err)
+
+		return
+	}
+
+	memoryTargetMB, apiErr := getSandboxMemoryTarget(teamInfo.Tier, *build, body.CpuCount, body.MemoryMB)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "invalid sandbox resources", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	// The build is shared by the template cache, the memory target applies only to this sandbox
+	sbxBuild := *build
+	sbxBuild.MemoryTargetMb = memoryTargetMB
+
 	var envdAccessToken *string = nil
 	if body.Secure != nil && *body.Secure == true {
 		accessToken, tokenErr := a.getEnvdAccessToken(build.EnvdVersion, sandboxID)
@@ -156,13 +202,15 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 		metadata,
 		alias,
 		teamInfo,
-		*build,
+		sbxBuild,
 		&c.Request.Header,
 		false,
 		nil,
-		env.TemplateID,
+		orchestrator.BaseTemplateID(*build),
 		autoPause,
 		envdAccessToken,
+		networkPolicy,
+		portAccess,
 	)
 	if createErr != nil {
 		zap.L().Error("Failed to create sandbox", zap.Error(createErr.Err))
@@ -172,6 +220,8 @@ func (a *APIStore) PostSandboxes(c *gin.Context) {
 
 	c.Set("nodeID", sbx.ClientID)
 
+	idempotentReq.complete(ctx, http.StatusCreated, &sbx)
+
 	c.JSON(http.StatusCreated, &sbx)
 }
 
@@ -205,6 +255,75 @@ func (a *APIStore) getEnvdAccessToken(envdVersion *string, sandboxID string) (st
 	return key, nil
 }
 
+// getSandboxMemoryTarget validates the CPU and memory requested for the sandbox against the tier limits and the template build.
+// The sandbox is resumed from the snapshot of the build, so the vCPU count can't be changed and the memory can only be
+// sized down by the balloon device. It returns the memory target of the sandbox, nil means the whole memory of the build.
+func getSandboxMemoryTarget(tier *models.Tier, build queries.EnvBuild, cpuCount, memoryMB *int32) (*int64, *api.APIError) {
+	if cpuCount != nil {
+		cpu := int64(*cpuCount)
+
+		if cpu < constants.MinTemplateCPU {
+			return nil, &api.APIError{
+				Err:       fmt.Errorf("CPU count must be at least %d", constants.MinTemplateCPU),
+				ClientMsg: fmt.Sprintf("CPU count must be at least %d", constants.MinTemplateCPU),
+				Code:      http.StatusBadRequest,
+			}
+		}
+
+		if cpu > tier.MaxVcpu {
+			return nil, &api.APIError{
+				Err:       fmt.Errorf("CPU count exceeds team limits (%d)", tier.MaxVcpu),
+				ClientMsg: fmt.Sprintf("CPU count can't be higher than %d (if you need to increase this limit, please contact support)", tier.MaxVcpu),
+				Code:      http.StatusBadRequest,
+			}
+		}
+
+		if cpu != build.Vcpu {
+			return nil, &api.APIError{
+				Err:       fmt.Errorf("CPU count %d doesn't match the template build (%d)", cpu, build.Vcpu),
+				ClientMsg: fmt.Sprintf("The template is built with %d CPUs and the CPU count can't be changed when starting the sandbox, rebuild the template with %d CPUs instead", build.Vcpu, cpu),
+				Code:      http.StatusBadRequest,
+			}
+		}
+	}
+
+	if memoryMB == nil {
+		return nil, nil
+	}
+
+	ramMB := int64(*memoryMB)
+
+	if ramMB < constants.MinTemplateMemory {
+		return nil, &api.APIError{
+			Err:       fmt.Errorf("memory must be at least %d MiB", constants.MinTemplateMemory),
+			ClientMsg: fmt.Sprintf("Memory must be at least %d MiB", constants.MinTemplateMemory),
+			Code:      http.StatusBadRequest,
+		}
+	}
+
+	if ramMB > tier.MaxRAMMB {
+		return nil, &api.APIError{
+			Err:       fmt.Errorf("memory exceeds team limits (%d MiB)", tier.MaxRAMMB),
+			ClientMsg: fmt.Sprintf("Memory can't be higher than %d MiB (if you need to increase this limit, please contact support)", tier.MaxRAMMB),
+			Code:      http.StatusBadRequest,
+		}
+	}
+
+	if ramMB > build.RamMb {
+		return nil, &api.APIError{
+			Err:       fmt.Errorf("memory %d MiB exceeds the template build (%d MiB)", ramMB, build.RamMb),
+			ClientMsg: fmt.Sprintf("The template is built with %d MiB of memory and the memory can only be decreased when starting the sandbox, rebuild the template with %d MiB instead", build.RamMb, ramMB),
+			Code:      http.StatusBadRequest,
+		}
+	}
+
+	if ramMB == build.RamMb {
+		return nil, nil
+	}
+
+	return &ramMB, nil
+}
+
 func setTemplateNameMetric(c *gin.Context, aliases []string) {
 	for _, alias := range aliases {
 		if _, exists := mostUsedTemplates[alias]; exists {
@@ -217,6 +336,14 @@ func setTemplateNameMetric(c *gin.Context, aliases []string) {
 	c.Set(metricTemplateAlias, "other")
 }
 
+func templateIDAndAliases(templateID string, aliases *[]string) []string {
+	if aliases == nil {
+		return []string{templateID}
+	}
+
+	return append([]string{templateID}, *aliases...)
+}
+
 func firstAlias(aliases *[]string) string {
 	if aliases == nil {
 		return ""
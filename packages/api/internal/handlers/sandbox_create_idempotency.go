@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/idempotency"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+const idempotentReplayedHeader = "Idempotent-Replayed"
+
+// idempotentRequest is the request that claimed its idempotency key.
+// The nil request (without the key) does nothing, so the handlers don't have to check for it.
+type idempotentRequest struct {
+	store  idempotency.Store
+	key    string
+	record idempotency.Record
+	// finished is set when the sandbox was created, the key isn't released even if the response couldn't be stored,
+	// so the retry doesn't create another sandbox.
+	finished bool
+
+	stopRefresh func()
+}
+
+// claimIdempotencyKey claims the idempotency key for the new sandbox.
+// When the key was already used, it sends the response and returns false.
+// The store errors are only logged, so the sandboxes can still be created without the store.
+func (a *APIStore) claimIdempotencyKey(c *gin.Context, teamID uuid.UUID, operation string, key *string, body any, sandboxID string) (*idempotentRequest, bool) {
+	if key == nil {
+		return nil, true
+	}
+
+	ctx := c.Request.Context()
+
+	if len(*key) == 0 || len(*key) > idempotency.MaxKeyLength {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Idempotency key must be between 1 and %d characters long", idempotency.MaxKeyLength))
+
+		return nil, false
+	}
+
+	bodyHash, err := idempotency.HashBody(body)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when processing the idempotency key")
+
+		zap.L().Error("Error hashing the request body", logger.WithTeamID(teamID.String()), zap.Error(err))
+
+		return nil, false
+	}
+
+	req := &idempotentRequest{
+		store:  a.idempotency,
+		key:    idempotency.Key(teamID, operation, *key),
+		record: idempotency.Record{BodyHash: bodyHash, SandboxID: sandboxID},
+	}
+
+	stored, claimed, err := a.idempotency.Claim(ctx, req.key, req.record, idempotency.PendingTTL)
+	if err != nil {
+		zap.L().Error("Error claiming the idempotency key, continuing without it", logger.WithTeamID(teamID.String()), zap.Error(err))
+
+		return nil, true
+	}
+
+	if claimed {
+		req.startRefresh(ctx, idempotency.PendingRefreshInterval)
+
+		return req, true
+	}
+
+	switch {
+	case stored.BodyHash != bodyHash:
+		a.sendAPIStoreError(c, http.StatusConflict, "Idempotency key was already used with a different request")
+	case !stored.Completed():
+		// The original request holds the reservation of the sandbox until it's started
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Sandbox %s is already being started", stored.SandboxID))
+	default:
+		c.Header(idempotentReplayedHeader, "true")
+		c.Data(stored.StatusCode, gin.MIMEJSON, stored.Response)
+	}
+
+	return nil, false
+}
+
+// startRefresh keeps the key claimed until the request finishes.
+func (r *idempotentRequest) startRefresh(ctx context.Context, interval time.Duration) {
+	// The key must stay claimed even if the client disconnected, the sandbox is still being created
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := r.store.Refresh(ctx, r.key, idempotency.PendingTTL)
+				if err != nil {
+					zap.L().Warn("Error refreshing the idempotency key", logger.WithSandboxID(r.record.SandboxID), zap.Error(err))
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	r.stopRefresh = func() {
+		once.Do(func() {
+			cancel()
+			<-done
+		})
+	}
+}
+
+// complete stores the response of the request for its retries.
+func (r *idempotentRequest) complete(ctx context.Context, statusCode int, response any) {
+	if r == nil {
+		return
+	}
+
+	// The refresh must not shorten the TTL of the stored response
+	r.stopRefresh()
+	r.finished = true
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		zap.L().Error("Error marshalling the idempotent response", logger.WithSandboxID(r.record.SandboxID), zap.Error(err))
+
+		return
+	}
+
+	r.record.StatusCode = statusCode
+	r.record.Response = data
+
+	// The response must be stored even if the client disconnected, it will retry the request.
+	// If it can't be stored, the pending record stays until it expires and the retries get the conflict.
+	err = r.store.Complete(context.WithoutCancel(ctx), r.key, r.record, idempotency.CompletedTTL)
+	if err != nil {
+		zap.L().Error("Error storing the idempotent response", logger.WithSandboxID(r.record.SandboxID), zap.Error(err))
+	}
+}
+
+// release frees the key of the request that didn't create the sandbox, so it can be retried.
+func (r *idempotentRequest) release(ctx context.Context) {
+	if r == nil {
+		return
+	}
+
+	r.stopRefresh()
+
+	if r.finished {
+		return
+	}
+
+	err := r.store.Release(context.WithoutCancel(ctx), r.key)
+	if err != nil {
+		zap.L().Error("Error releasing the idempotency key", logger.WithSandboxID(r.record.SandboxID), zap.Error(err))
+	}
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/idempotency"
+)
+
+// failingCompleteStore can't store the responses.
+type failingCompleteStore struct {
+	*idempotency.MemoryStore
+
+	refreshes atomic.Int32
+}
+
+func (s *failingCompleteStore) Complete(context.Context, string, idempotency.Record, time.Duration) error {
+	return errors.New("store unavailable")
+}
+
+func (s *failingCompleteStore) Refresh(ctx context.Context, key string, ttl time.Duration) error {
+	s.refreshes.Add(1)
+
+	return s.MemoryStore.Refresh(ctx, key, ttl)
+}
+
+func newIdempotencyTestContext() (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/sandboxes", nil)
+
+	return c, w
+}
+
+func TestClaimIdempotencyKey(t *testing.T) {
+	teamID := uuid.New()
+	key := "create-sandbox"
+	body := api.NewSandbox{TemplateID: "base"}
+
+	t.Run("replays the completed response", func(t *testing.T) {
+		a := &APIStore{idempotency: idempotency.NewMemoryStore()}
+
+		c, _ := newIdempotencyTestContext()
+		req, ok := a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, body, "sandbox-1")
+		require.True(t, ok)
+		require.NotNil(t, req)
+
+		req.complete(c.Request.Context(), http.StatusCreated, api.Sandbox{SandboxID: "sandbox-1"})
+		req.release(c.Request.Context())
+
+		c, w := newIdempotencyTestContext()
+		req, ok = a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, body, "sandbox-2")
+
+		assert.False(t, ok)
+		assert.Nil(t, req)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "true", w.Header().Get(idempotentReplayedHeader))
+		assert.Contains(t, w.Body.String(), `"sandboxID":"sandbox-1"`)
+	})
+
+	t.Run("rejects the key used with a different body", func(t *testing.T) {
+		a := &APIStore{idempotency: idempotency.NewMemoryStore()}
+
+		c, _ := newIdempotencyTestContext()
+		req, ok := a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, body, "sandbox-1")
+		require.True(t, ok)
+		req.complete(c.Request.Context(), http.StatusCreated, api.Sandbox{SandboxID: "sandbox-1"})
+
+		c, w := newIdempotencyTestContext()
+		_, ok = a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, api.NewSandbox{TemplateID: "other"}, "sandbox-2")
+
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Empty(t, w.Header().Get(idempotentReplayedHeader))
+	})
+
+	t.Run("rejects the retry while the request is in progress", func(t *testing.T) {
+		a := &APIStore{idempotency: idempotency.NewMemoryStore()}
+
+		c, _ := newIdempotencyTestContext()
+		req, ok := a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, body, "sandbox-1")
+		require.True(t, ok)
+		defer req.release(c.Request.Context())
+
+		c, w := newIdempotencyTestContext()
+		_, ok = a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, body, "sandbox-2")
+
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "sandbox-1")
+	})
+
+	t.Run("releases the key of the failed request", func(t *testing.T) {
+		a := &APIStore{idempotency: idempotency.NewMemoryStore()}
+
+		c, _ := newIdempotencyTestContext()
+		req, ok := a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, body, "sandbox-1")
+		require.True(t, ok)
+		req.release(c.Request.Context())
+
+		c, _ = newIdempotencyTestContext()
+		req, ok = a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, body, "sandbox-2")
+
+		assert.True(t, ok)
+		require.NotNil(t, req)
+		assert.Equal(t, "sandbox-2", req.record.SandboxID)
+		req.release(c.Request.Context())
+	})
+
+	t.Run("keeps the key when the response can't be stored", func(t *testing.T) {
+		a := &APIStore{idempotency: &failingCompleteStore{MemoryStore: idempotency.NewMemoryStore()}}
+
+		c, _ := newIdempotencyTestContext()
+		req, ok := a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, body, "sandbox-1")
+		require.True(t, ok)
+
+		req.complete(c.Request.Context(), http.StatusCreated, api.Sandbox{SandboxID: "sandbox-1"})
+		req.release(c.Request.Context())
+
+		// The sandbox was created, the retry must not create another one
+		c, w := newIdempotencyTestContext()
+		_, ok = a.claimIdempotencyKey(c, teamID, "PostSandboxes", &key, body, "sandbox-2")
+
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("rejects the too long key", func(t *testing.T) {
+		a := &APIStore{idempotency: idempotency.NewMemoryStore()}
+		longKey := strings.Repeat("k", idempotency.MaxKeyLength+1)
+
+		c, w := newIdempotencyTestContext()
+		_, ok := a.claimIdempotencyKey(c, teamID, "PostSandboxes", &longKey, body, "sandbox-1")
+
+		assert.False(t, ok)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("runs without the key", func(t *testing.T) {
+		a := &APIStore{idempotency: idempotency.NewMemoryStore()}
+
+		c, _ := newIdempotencyTestContext()
+		req, ok := a.claimIdempotencyKey(c, teamID, "PostSandboxes", nil, body, "sandbox-1")
+
+		assert.True(t, ok)
+		assert.Nil(t, req)
+		// The nil request does nothing
+		req.complete(c.Request.Context(), http.StatusCreated, api.Sandbox{})
+		req.release(c.Request.Context())
+	})
+}
+
+func TestIdempotentRequest_RefreshesPendingKey(t *testing.T) {
+	store := &failingCompleteStore{MemoryStore: idempotency.NewMemoryStore()}
+	ctx := context.Background()
+
+	key := idempotency.Key(uuid.New(), "PostSandboxes", "key")
+	_, claimed, err := store.Claim(ctx, key, idempotency.Record{SandboxID: "sandbox-1"}, idempotency.PendingTTL)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	req := &idempotentRequest{store: store, key: key, record: idempotency.Record{SandboxID: "sandbox-1"}}
+	req.startRefresh(ctx, time.Millisecond)
+
+	require.Eventually(t, func() bool { return store.refreshes.Load() >= 2 }, time.Second, time.Millisecond)
+
+	req.release(ctx)
+
+	// The refresh stops with the request
+	refreshes := store.refreshes.Load()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, refreshes, store.refreshes.Load())
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestGetSandboxMemoryTarget(t *testing.T) {
+	tier := &models.Tier{MaxVcpu: 8, MaxRAMMB: 8192}
+	build := queries.EnvBuild{Vcpu: 2, RamMb: 2048}
+
+	tests := []struct {
+		name     string
+		cpuCount *int32
+		memoryMB *int32
+		want     *int64
+		wantCode int
+	}{
+		{
+			name: "template resources",
+		},
+		{
+			name:     "same resources as the template",
+			cpuCount: toPtr(int32(2)),
+			memoryMB: toPtr(int32(2048)),
+		},
+		{
+			name:     "less memory than the template",
+			memoryMB: toPtr(int32(512)),
+			want:     toPtr(int64(512)),
+		},
+		{
+			name:     "more memory than the template",
+			memoryMB: toPtr(int32(4096)),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "more memory than the tier",
+			memoryMB: toPtr(int32(16384)),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "too little memory",
+			memoryMB: toPtr(int32(64)),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "different CPU count than the template",
+			cpuCount: toPtr(int32(4)),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "more CPUs than the tier",
+			cpuCount: toPtr(int32(16)),
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, apiErr := getSandboxMemoryTarget(tier, build, tt.cpuCount, tt.memoryMB)
+			if tt.wantCode != 0 {
+				require.NotNil(t, apiErr)
+				assert.Equal(t, tt.wantCode, apiErr.Code)
+
+				return
+			}
+
+			require.Nil(t, apiErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func toPtr[T any](v T) *T {
+	return &v
+}
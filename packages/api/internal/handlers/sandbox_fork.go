@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PostSandboxesSandboxIDFork(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+	teamID := teamInfo.Team.ID
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	span := trace.SpanFromContext(ctx)
+	traceID := span.SpanContext().TraceID().String()
+	c.Set("traceID", traceID)
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDForkJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	telemetry.ReportEvent(ctx, "Parsed body")
+
+	count := 1
+	if body.Count != nil {
+		count = int(*body.Count)
+	}
+
+	timeout := instance.InstanceExpiration
+	if body.Timeout != nil {
+		timeout = time.Duration(*body.Timeout) * time.Second
+
+		if timeout > time.Duration(teamInfo.Tier.MaxLengthHours)*time.Hour {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Timeout cannot be greater than %d hours", teamInfo.Tier.MaxLengthHours))
+
+			return
+		}
+	}
+
+	autoPause := instance.InstanceAutoPauseDefault
+	if body.AutoPause != nil {
+		autoPause = *body.AutoPause
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error forking sandbox - sandbox '%s' is not running", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamID {
+		telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String()))
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error forking sandbox - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	if !a.apiKeyAllowsTemplates(ctx, teamInfo, sandboxTemplateIDs(sbx)...) {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("API key isn't allowed to access the sandbox '%s'", sandboxID))
+
+		return
+	}
+
+	metadata := sbx.GetMetadata()
+	if body.Metadata != nil {
+		metadata = *body.Metadata
+	}
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		attribute.Int("fork.count", count),
+	)
+
+	children := make([]orchestrator.ForkedSandbox, count)
+	for i := range children {
+		childID := InstanceIDPrefix + id.Generate()
+
+		var envdAccessToken *string = nil
+		if sbx.EnvdAccessToken != nil {
+			accessToken, tokenErr := a.getEnvdAccessToken(&sbx.Instance.EnvdVersion, childID)
+			if tokenErr != nil {
+				zap.L().Error("Secure envd access token error", zap.Error(tokenErr.Err), logger.WithSandboxID(sandboxID))
+				a.sendAPIStoreError(c, tokenErr.Code, tokenErr.ClientMsg)
+
+				return
+			}
+
+			envdAccessToken = &accessToken
+		}
+
+		children[i] = orchestrator.ForkedSandbox{
+			SandboxID:       childID,
+			EnvdAccessToken: envdAccessToken,
+		}
+	}
+
+	sandboxes, forkErr := a.orchestrator.ForkSandbox(
+		ctx,
+		sbx,
+		teamInfo,
+		children,
+		metadata,
+		timeout,
+		autoPause,
+	)
+	if forkErr != nil {
+		zap.L().Error("Failed to fork sandbox", logger.WithSandboxID(sandboxID), zap.Error(forkErr.Err))
+		a.sendAPIStoreError(c, forkErr.Code, forkErr.ClientMsg)
+
+		return
+	}
+
+	for _, child := range sandboxes {
+		sbxlogger.E(&sbxlogger.SandboxMetadata{
+			SandboxID:  child.SandboxID,
+			TemplateID: child.TemplateID,
+			TeamID:     teamID.String(),
+		}).Info("Sandbox forked", zap.String("source_sandbox_id", sandboxID))
+	}
+
+	c.JSON(http.StatusCreated, sandboxes)
+}
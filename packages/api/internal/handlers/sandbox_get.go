@@ -11,6 +11,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -26,6 +27,10 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 
 	sandboxId := strings.Split(id, "-")[0]
 
+	if !a.checkAPIKeySandbox(c, sandboxId) {
+		return
+	}
+
 	// Try to get the running sandbox first
 	info, err := a.orchestrator.GetInstance(ctx, sandboxId)
 	if err == nil {
@@ -44,15 +49,15 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 			SandboxID:       info.Instance.SandboxID,
 			StartedAt:       info.StartTime,
 			CpuCount:        api.CPUCount(info.VCpu),
-			MemoryMB:        api.MemoryMB(info.RamMB),
+			MemoryMB:        api.MemoryMB(info.MemoryMB()),
 			EndAt:           info.GetEndTime(),
 			State:           api.Running,
 			EnvdVersion:     &info.EnvdVersion,
 			EnvdAccessToken: info.EnvdAccessToken,
 		}
 
-		if info.Metadata != nil {
-			meta := api.SandboxMetadata(info.Metadata)
+		if metadata := info.GetMetadata(); metadata != nil {
+			meta := api.SandboxMetadata(metadata)
 			sandbox.Metadata = &meta
 		}
 
@@ -68,7 +73,7 @@ func (a *APIStore) GetSandboxesSandboxID(c *gin.Context, id string) {
 		return
 	}
 
-	memoryMB := int32(lastSnapshot.EnvBuild.RamMb)
+	memoryMB := int32(orchestrator.SandboxMemoryMB(lastSnapshot.EnvBuild))
 	cpuCount := int32(lastSnapshot.EnvBuild.Vcpu)
 
 	var sbxAccessToken *string = nil
@@ -10,11 +10,14 @@ import (
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/audit"
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -24,45 +27,57 @@ func (a *APIStore) deleteSnapshot(ctx context.Context, sandboxID string, teamID
 		return err
 	}
 
-	dbErr := a.db.DeleteEnv(ctx, env.ID)
+	deleted, dbErr := a.db.DeleteSnapshotEnv(ctx, env.ID, sandboxID)
 	if dbErr != nil {
 		return fmt.Errorf("error deleting env from db: %w", dbErr)
 	}
 
+	a.templateCache.Invalidate(env.ID)
+
+	// The sandbox was forked, the builds are removed with the last env derived from the snapshot
+	if !deleted {
+		return nil
+	}
+
+	a.deleteEnvBuilds(env.ID, builds, teamClusterID)
+
+	if env.SourceEnvID != nil {
+		a.deleteUnusedForkEnvs(ctx, *env.SourceEnvID, teamID, teamClusterID)
+	}
+
+	return nil
+}
+
+// deleteEnvBuilds removes the files of the deleted env builds from the storage and the nodes.
+func (a *APIStore) deleteEnvBuilds(templateID string, builds []*models.EnvBuild, clusterID *uuid.UUID) {
+	envBuildIDs := make([]template_manager.DeleteBuild, 0, len(builds))
+	for _, build := range builds {
+		envBuildIDs = append(
+			envBuildIDs,
+			template_manager.DeleteBuild{
+				BuildID:    build.ID,
+				TemplateID: *build.EnvID,
+
+				ClusterID:     clusterID,
+				ClusterNodeID: build.ClusterNodeID,
+			},
+		)
+	}
+
+	if len(envBuildIDs) == 0 {
+		return
+	}
+
 	go func() {
-		// remove any snapshots when the sandbox is not running
-		deleteCtx, span := a.Tracer.Start(context.Background(), "delete-snapshot")
+		deleteCtx, span := a.Tracer.Start(context.Background(), "delete-env-builds")
 		defer span.End()
-		span.SetAttributes(telemetry.WithSandboxID(sandboxID))
-		span.SetAttributes(telemetry.WithTemplateID(env.ID))
-
-		envBuildIDs := make([]template_manager.DeleteBuild, 0)
-		for _, build := range builds {
-			envBuildIDs = append(
-				envBuildIDs,
-				template_manager.DeleteBuild{
-					BuildID:    build.ID,
-					TemplateID: *build.EnvID,
-
-					ClusterID:     teamClusterID,
-					ClusterNodeID: build.ClusterNodeID,
-				},
-			)
-		}
-
-		if len(envBuildIDs) == 0 {
-			return
-		}
+		span.SetAttributes(telemetry.WithTemplateID(templateID))
 
 		deleteJobErr := a.templateManager.DeleteBuilds(deleteCtx, envBuildIDs)
 		if deleteJobErr != nil {
-			telemetry.ReportError(deleteCtx, "error deleting snapshot builds", deleteJobErr, telemetry.WithSandboxID(sandboxID))
+			telemetry.ReportError(deleteCtx, "error deleting env builds", deleteJobErr, telemetry.WithTemplateID(templateID))
 		}
 	}()
-
-	a.templateCache.Invalidate(env.ID)
-
-	return nil
 }
 
 func (a *APIStore) DeleteSandboxesSandboxID(
@@ -82,6 +97,10 @@ func (a *APIStore) DeleteSandboxesSandboxID(
 
 	telemetry.ReportEvent(ctx, "killing sandbox")
 
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
 	sbx, err := a.orchestrator.GetSandbox(sandboxID)
 	if err == nil {
 		if *sbx.TeamID != teamID {
@@ -110,8 +129,13 @@ func (a *APIStore) DeleteSandboxesSandboxID(
 			return
 		}
 
+		// remove the fork env the sandbox was started from if it isn't used anymore
+		a.deleteUnusedForkEnvs(ctx, sbx.Instance.TemplateID, teamID, team.ClusterID)
+
 		telemetry.ReportEvent(ctx, "deleted sandbox from orchestrator")
 
+		a.auditLog.Record(c, &teamID, api.SandboxDeleted, audit.ResourceSandbox, sandboxID, nil)
+
 		c.Status(http.StatusNoContent)
 
 		return
@@ -133,5 +157,7 @@ func (a *APIStore) DeleteSandboxesSandboxID(
 		return
 	}
 
+	a.auditLog.Record(c, &teamID, api.SandboxDeleted, audit.ResourceSandbox, sandboxID, map[string]string{"paused": "true"})
+
 	c.Status(http.StatusNoContent)
 }
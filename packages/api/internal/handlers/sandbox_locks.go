@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"sync"
+)
+
+// sandboxLocks serializes the operations starting the paused sandbox from its snapshot and the operations changing the snapshot,
+// so the sandbox isn't resumed from the snapshot which is being updated or removed. The locks are held only by this API instance.
+type sandboxLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sandboxLock
+}
+
+type sandboxLock struct {
+	mu sync.Mutex
+	// refs is the number of the holders and the waiters, the lock is removed when it drops to zero.
+	refs int
+}
+
+func newSandboxLocks() *sandboxLocks {
+	return &sandboxLocks{
+		locks: make(map[string]*sandboxLock),
+	}
+}
+
+// lock waits for the lock of the sandbox, the returned function unlocks it.
+func (l *sandboxLocks) lock(sandboxID string) (unlock func()) {
+	lock := l.acquire(sandboxID)
+	lock.mu.Lock()
+
+	return func() {
+		lock.mu.Unlock()
+		l.release(sandboxID, lock)
+	}
+}
+
+// tryLock locks the sandbox if it isn't locked already, the returned function unlocks it.
+func (l *sandboxLocks) tryLock(sandboxID string) (unlock func(), ok bool) {
+	lock := l.acquire(sandboxID)
+	if !lock.mu.TryLock() {
+		l.release(sandboxID, lock)
+
+		return nil, false
+	}
+
+	return func() {
+		lock.mu.Unlock()
+		l.release(sandboxID, lock)
+	}, true
+}
+
+func (l *sandboxLocks) acquire(sandboxID string) *sandboxLock {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lock, ok := l.locks[sandboxID]
+	if !ok {
+		lock = &sandboxLock{}
+		l.locks[sandboxID] = lock
+	}
+
+	lock.refs++
+
+	return lock
+}
+
+func (l *sandboxLocks) release(sandboxID string, lock *sandboxLock) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lock.refs--
+	if lock.refs == 0 {
+		delete(l.locks, sandboxID)
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSandboxLocks(t *testing.T) {
+	t.Run("serializes the operations of the sandbox", func(t *testing.T) {
+		locks := newSandboxLocks()
+
+		unlock := locks.lock("sandbox-id")
+
+		locked := make(chan struct{})
+		go func() {
+			defer locks.lock("sandbox-id")()
+			close(locked)
+		}()
+
+		select {
+		case <-locked:
+			t.Fatal("the sandbox was locked twice")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		unlock()
+		<-locked
+	})
+
+	t.Run("doesn't block the other sandboxes", func(t *testing.T) {
+		locks := newSandboxLocks()
+
+		defer locks.lock("sandbox-id")()
+
+		unlock, ok := locks.tryLock("other-sandbox-id")
+		require.True(t, ok)
+		unlock()
+	})
+
+	t.Run("try lock fails for the locked sandbox", func(t *testing.T) {
+		locks := newSandboxLocks()
+
+		unlock := locks.lock("sandbox-id")
+
+		_, ok := locks.tryLock("sandbox-id")
+		assert.False(t, ok)
+
+		unlock()
+
+		unlock, ok = locks.tryLock("sandbox-id")
+		require.True(t, ok)
+		unlock()
+	})
+
+	t.Run("removes the unused locks", func(t *testing.T) {
+		locks := newSandboxLocks()
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer locks.lock("sandbox-id")()
+			}()
+		}
+		wg.Wait()
+
+		assert.Empty(t, locks.locks)
+	})
+}
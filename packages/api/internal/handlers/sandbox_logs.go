@@ -38,6 +38,10 @@ func (a *APIStore) GetSandboxesSandboxIDLogs(
 		telemetry.WithTeamID(teamID.String()),
 	)
 
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
 	var start time.Time
 
 	end := time.Now()
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	sandboxlogs "github.com/e2b-dev/infra/packages/api/internal/sandbox/logs"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// logsStreamKeepAlive is the interval of the comments sent to keep the idle stream open through the proxies.
+	logsStreamKeepAlive = 15 * time.Second
+	// lastEventIDHeader is sent by the reconnecting Server-Sent Events clients.
+	lastEventIDHeader = "Last-Event-ID"
+)
+
+func (a *APIStore) GetSandboxesSandboxIDLogsStream(
+	c *gin.Context,
+	sandboxID string,
+	params api.GetSandboxesSandboxIDLogsStreamParams,
+) {
+	ctx := c.Request.Context()
+	sandboxID = utils.ShortID(sandboxID)
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		telemetry.WithTeamID(teamID.String()),
+	)
+
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
+	start := time.Now()
+	if params.Start != nil {
+		start = time.UnixMilli(*params.Start)
+	}
+
+	// The event IDs are the timestamps of the logs, resume after the last received one
+	if lastEventID := c.GetHeader(lastEventIDHeader); lastEventID != "" {
+		cursor, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid %s header '%s'", lastEventIDHeader, lastEventID))
+
+			return
+		}
+
+		start = time.Unix(0, cursor+1)
+	}
+
+	if oldest := time.Now().Add(-oldestLogsLimit); start.Before(oldest) {
+		start = oldest
+	}
+
+	query := sandboxlogs.Query{
+		TeamID:    teamID.String(),
+		SandboxID: sandboxID,
+		Start:     start,
+	}
+
+	if params.Level != nil {
+		query.Level = string(*params.Level)
+	}
+
+	if params.Category != nil {
+		query.Category = *params.Category
+	}
+
+	entries, err := a.sandboxLogs.Tail(ctx, query)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when streaming logs for sandbox", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error streaming logs for sandbox '%s'", sandboxID))
+
+		return
+	}
+
+	// The stream is open longer than the server write timeout allows
+	err = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	if err != nil {
+		telemetry.ReportError(ctx, "error when clearing write deadline for logs stream", err)
+	}
+
+	c.Header("Content-Type", sse.ContentType)
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(logsStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			_, err := c.Writer.WriteString(": keep-alive\n\n")
+			if err != nil {
+				return
+			}
+		case entry, ok := <-entries:
+			if !ok {
+				return
+			}
+
+			err := sse.Encode(c.Writer, sse.Event{
+				Id:    strconv.FormatInt(entry.Timestamp.UnixNano(), 10),
+				Event: "log",
+				Data: api.SandboxLog{
+					Timestamp: entry.Timestamp,
+					Line:      entry.Line,
+				},
+			})
+			if err != nil {
+				return
+			}
+		}
+
+		c.Writer.Flush()
+	}
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+func (a *APIStore) PatchSandboxesSandboxID(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	telemetry.SetAttributes(ctx,
+		attribute.String("instance.id", sandboxID),
+		telemetry.WithTeamID(teamID.String()),
+	)
+
+	body, err := utils.ParseBody[api.PatchSandboxesSandboxIDJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err == nil {
+		a.updateRunningSandboxMetadata(c, sbx, teamID, body.Metadata)
+
+		return
+	}
+
+	// The paused sandbox isn't resumed while its snapshot is updated, it would start with the previous metadata
+	unlock := a.sandboxLocks.lock(sandboxID)
+	defer unlock()
+
+	// The sandbox could have been resumed while waiting for the lock
+	sbx, err = a.orchestrator.GetSandbox(sandboxID)
+	if err == nil {
+		a.updateRunningSandboxMetadata(c, sbx, teamID, body.Metadata)
+
+		return
+	}
+
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
+	// The paused sandbox gets the metadata from its snapshot when it's resumed
+	set, removed := orchestrator.SplitMetadataUpdate(body.Metadata)
+
+	metadata, err := a.sqlcDB.UpdateSnapshotMetadata(ctx, queries.UpdateSnapshotMetadataParams{
+		Metadata:    set,
+		RemovedKeys: removed,
+		SandboxID:   sandboxID,
+		TeamID:      teamID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Sandbox '%s' doesn't exist or you don't have access to it", sandboxID))
+
+		return
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when updating snapshot metadata", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when updating sandbox metadata")
+
+		return
+	}
+
+	if metadata == nil {
+		metadata = types.JSONBStringMap{}
+	}
+
+	c.JSON(http.StatusOK, api.SandboxMetadata(metadata))
+}
+
+func (a *APIStore) updateRunningSandboxMetadata(c *gin.Context, sbx *instance.InstanceInfo, teamID uuid.UUID, update map[string]*string) {
+	ctx := c.Request.Context()
+	sandboxID := sbx.Instance.SandboxID
+
+	if *sbx.TeamID != teamID {
+		telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String()))
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error updating sandbox metadata - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
+	metadata, apiErr := a.orchestrator.UpdateMetadata(ctx, sbx, update)
+	if apiErr != nil {
+		telemetry.ReportCriticalError(ctx, "error when updating sandbox metadata", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, api.SandboxMetadata(metadata))
+}
@@ -169,6 +169,10 @@ func (a *APIStore) GetSandboxesSandboxIDMetrics(
 		telemetry.WithTeamID(teamID),
 	)
 
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
 	metrics, err := a.readMetricsBasedOnConfig(ctx, sandboxID, teamID, a)
 	if err != nil {
 		telemetry.ReportCriticalError(ctx, "error returning metrics for sandbox", err, telemetry.WithSandboxID(sandboxID))
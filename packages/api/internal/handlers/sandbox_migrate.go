@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// migrationTimeout is the maximum time for pausing and resuming a single sandbox.
+	migrationTimeout = 10 * time.Minute
+)
+
+func (a *APIStore) PostSandboxesSandboxIDMigrate(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	body, err := utils.ParseBody[api.PostSandboxesSandboxIDMigrateJSONRequestBody](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error migrating sandbox - sandbox '%s' is not running", sandboxID))
+
+		return
+	}
+
+	targetNodeID, err := a.orchestrator.MigrationTarget(sbx, body.TargetNodeID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error migrating sandbox: %s", err))
+
+		return
+	}
+
+	migrated, apiErr := a.migrateSandbox(ctx, sbx, targetNodeID)
+	if apiErr != nil {
+		telemetry.ReportCriticalError(ctx, "error when migrating sandbox", apiErr.Err)
+
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, migrated)
+}
+
+// migrateSandbox pauses the sandbox to the storage and resumes it with the same ID on the target node
+// after the snapshot is uploaded. The memory and the filesystem of the sandbox are kept, its processes are only
+// stopped for the time of the migration. If the snapshot can't be uploaded or the sandbox can't be resumed
+// on the target node, it's resumed on its original node.
+// The migration isn't canceled with the context, an interrupted migration would leave the sandbox paused.
+func (a *APIStore) migrateSandbox(ctx context.Context, sbx *instance.InstanceInfo, targetNodeID string) (*api.Sandbox, *api.APIError) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), migrationTimeout)
+	defer cancel()
+
+	ctx, span := a.Tracer.Start(ctx, "migrate-sandbox")
+	defer span.End()
+
+	sandboxID := sbx.Instance.SandboxID
+	sourceNodeID := sbx.Instance.ClientID
+
+	span.SetAttributes(telemetry.WithSandboxID(sandboxID))
+
+	team, tier, err := a.db.GetTeamWithTier(ctx, *sbx.TeamID)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error migrating sandbox",
+			Err:       err,
+		}
+	}
+
+	// The sandbox keeps the time it had left
+	timeout := max(time.Until(sbx.GetEndTime()), instance.InstanceExpiration)
+	autoPause := sbx.AutoPause.Load()
+
+	alias := ""
+	if sbx.Instance.Alias != nil {
+		alias = *sbx.Instance.Alias
+	}
+
+	// The snapshot is only kept until the sandbox is resumed on the target node, it isn't limited by the paused storage quota
+	sbx.SetMigrating()
+
+	found := a.orchestrator.DeleteInstance(ctx, sandboxID, true)
+	if !found {
+		return nil, &api.APIError{
+			Code:      http.StatusNotFound,
+			ClientMsg: fmt.Sprintf("Error migrating sandbox - sandbox '%s' was not found", sandboxID),
+			Err:       fmt.Errorf("sandbox '%s' not found in cache", sandboxID),
+		}
+	}
+
+	_, err = sbx.Pausing.WaitWithContext(ctx)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      pauseErrorCode(err),
+			ClientMsg: fmt.Sprintf("Error migrating sandbox - pausing failed: %s", err),
+			Err:       fmt.Errorf("error pausing sandbox '%s': %w", sandboxID, err),
+		}
+	}
+
+	telemetry.ReportEvent(ctx, "Paused sandbox for migration")
+
+	lastSnapshot, err := a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: team.ID})
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error migrating sandbox - the sandbox was left paused",
+			Err:       fmt.Errorf("error getting snapshot of sandbox '%s': %w", sandboxID, err),
+		}
+	}
+
+	resume := func(nodeID string) (*api.Sandbox, *api.APIError) {
+		startTime := time.Now()
+
+		return a.orchestrator.CreateSandbox(
+			ctx,
+			sandboxID,
+			uuid.New().String(),
+			alias,
+			authcache.AuthTeamInfo{Team: team, Tier: tier},
+			lastSnapshot.EnvBuild,
+			sbx.GetMetadata(),
+			nil,
+			startTime,
+			startTime.Add(timeout),
+			timeout,
+			true,
+			&nodeID,
+			sbx.BaseTemplateID,
+			autoPause,
+			sbx.EnvdAccessToken,
+			sbx.GetNetworkPolicy(),
+			sbx.PortAccess,
+		)
+	}
+
+	// resumeOnSource resumes the sandbox back on its original node, where the snapshot is in the template cache
+	resumeOnSource := func(cause error) *api.APIError {
+		zap.L().Error("Error migrating sandbox, resuming it on the original node", logger.WithSandboxID(sandboxID), zap.String("target_node_id", targetNodeID), zap.Error(cause))
+
+		_, fallbackErr := resume(sourceNodeID)
+		if fallbackErr != nil {
+			return &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: "Error migrating sandbox - the sandbox was left paused",
+				Err:       fmt.Errorf("error resuming sandbox '%s' on node '%s': %w", sandboxID, sourceNodeID, errors.Join(cause, fallbackErr.Err)),
+			}
+		}
+
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error migrating sandbox - the sandbox was resumed on its original node",
+			Err:       cause,
+		}
+	}
+
+	// The snapshot is uploaded in the background after the pause, the target node can only read it from the storage
+	if targetNodeID != sourceNodeID {
+		err = a.orchestrator.WaitForSnapshotUpload(ctx, sourceNodeID, lastSnapshot.EnvBuild.ID.String())
+		if err != nil {
+			return nil, resumeOnSource(err)
+		}
+
+		telemetry.ReportEvent(ctx, "Uploaded snapshot for migration")
+	}
+
+	migrated, apiErr := resume(targetNodeID)
+	if apiErr != nil {
+		return nil, resumeOnSource(fmt.Errorf("error resuming sandbox '%s' on node '%s': %w", sandboxID, targetNodeID, apiErr.Err))
+	}
+
+	zap.L().Info("Migrated sandbox", logger.WithSandboxID(sandboxID), zap.String("source_node_id", sourceNodeID), zap.String("target_node_id", migrated.ClientID))
+
+	return migrated, nil
+}
+
+// drainNode migrates all the sandboxes running on the node to other nodes.
+// It stops starting new migrations when the context is canceled, the running ones are finished.
+func (a *APIStore) drainNode(ctx context.Context, nodeID string) {
+	sandboxes := a.orchestrator.GetNodeSandboxes(nodeID)
+
+	zap.L().Info("Migrating sandboxes from draining node", zap.String("node_id", nodeID), zap.Int("sandboxes_count", len(sandboxes)))
+
+	a.nodeDrains.migrateAll(ctx, sandboxes, func(sbx *instance.InstanceInfo) {
+		targetNodeID, err := a.orchestrator.MigrationTarget(sbx, nil)
+		if err != nil {
+			zap.L().Error("Error finding node to migrate sandbox to", logger.WithSandboxID(sbx.Instance.SandboxID), zap.Error(err))
+
+			return
+		}
+
+		_, apiErr := a.migrateSandbox(ctx, sbx, targetNodeID)
+		if apiErr != nil {
+			zap.L().Error("Error migrating sandbox from draining node", logger.WithSandboxID(sbx.Instance.SandboxID), zap.String("node_id", nodeID), zap.Error(apiErr.Err))
+		}
+	})
+
+	zap.L().Info("Finished migrating sandboxes from draining node", zap.String("node_id", nodeID))
+}
@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,9 +13,12 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -21,7 +26,8 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 	ctx := c.Request.Context()
 	// Get team from context, use TeamContextKey
 
-	teamID := a.GetTeamInfo(c).Team.ID
+	teamInfo := a.GetTeamInfo(c)
+	teamID := teamInfo.Team.ID
 
 	sandboxID = utils.ShortID(sandboxID)
 
@@ -29,10 +35,33 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 	traceID := span.SpanContext().TraceID().String()
 	c.Set("traceID", traceID)
 
+	// The body is optional
+	var body api.SandboxPause
+	if c.Request.ContentLength != 0 {
+		var err error
+
+		body, err = utils.ParseBody[api.SandboxPause](ctx, c)
+		if err != nil && !errors.Is(err, io.EOF) {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+			return
+		}
+	}
+
+	if apiErr := validatePausedRetention(teamInfo.Tier, body.RetentionHours); apiErr != nil {
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
 	sbx, err := a.orchestrator.GetSandbox(sandboxID)
 	if err != nil {
 		_, fErr := a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: teamID})
 		if fErr == nil {
+			if !a.checkAPIKeySandbox(c, sandboxID) {
+				return
+			}
+
 			zap.L().Warn("Sandbox is already paused", logger.WithSandboxID(sandboxID))
 			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Error pausing sandbox - sandbox '%s' is already paused", sandboxID))
 			return
@@ -57,6 +86,21 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 		return
 	}
 
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
+	// Rejects the pause early while the sandbox is still running, the quota is checked again atomically with the pause
+	apiErr := a.checkPausedStorageQuota(ctx, teamInfo.Tier, sbx)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "paused storage quota check failed", apiErr.Err)
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	sbx.SetPausedRetentionHours(body.RetentionHours)
+
 	found := a.orchestrator.DeleteInstance(ctx, sandboxID, true)
 	if !found {
 		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error pausing sandbox - sandbox '%s' was not found", sandboxID))
@@ -65,10 +109,79 @@ func (a *APIStore) PostSandboxesSandboxIDPause(c *gin.Context, sandboxID api.San
 
 	_, err = sbx.Pausing.WaitWithContext(ctx)
 	if err != nil {
-		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error pausing sandbox: %s", err))
+		a.sendAPIStoreError(c, pauseErrorCode(err), fmt.Sprintf("Error pausing sandbox: %s", err))
 
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
+
+// validatePausedRetention checks the retention override of the paused sandbox, it can't be longer than the retention of the tier.
+// The retention can't be disabled by the override, 0 would keep the snapshot forever.
+func validatePausedRetention(tier *models.Tier, retentionHours *int64) *api.APIError {
+	if retentionHours == nil {
+		return nil
+	}
+
+	if *retentionHours < 1 {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: "Retention must be at least 1 hour",
+			Err:       fmt.Errorf("invalid retention %d hours", *retentionHours),
+		}
+	}
+
+	if tier.PausedRetentionHours > 0 && *retentionHours > tier.PausedRetentionHours {
+		return &api.APIError{
+			Code:      http.StatusBadRequest,
+			ClientMsg: fmt.Sprintf("Retention can't be longer than %d hours", tier.PausedRetentionHours),
+			Err:       fmt.Errorf("retention %d hours is longer than the tier retention %d hours", *retentionHours, tier.PausedRetentionHours),
+		}
+	}
+
+	return nil
+}
+
+// pauseErrorCode returns the status code for the failed pause of the sandbox.
+// The sandbox keeps running when its snapshot doesn't fit the paused storage quota of the team.
+func pauseErrorCode(err error) int {
+	if errors.As(err, &db.PausedStorageQuotaExceeded{}) {
+		return http.StatusTooManyRequests
+	}
+
+	return http.StatusInternalServerError
+}
+
+// checkPausedStorageQuota checks the snapshot of the sandbox fits the paused storage quota of the team.
+// The snapshot is counted as the whole memory and disk of the sandbox, it replaces the previous snapshot of the same sandbox.
+func (a *APIStore) checkPausedStorageQuota(ctx context.Context, tier *models.Tier, sbx *instance.InstanceInfo) *api.APIError {
+	if tier.PausedStorageMB <= 0 {
+		return nil
+	}
+
+	usedMB, err := a.sqlcDB.GetTeamSnapshotStorage(ctx, queries.GetTeamSnapshotStorageParams{
+		TeamID:           *sbx.TeamID,
+		ExcludeSandboxID: sbx.Instance.SandboxID,
+	})
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error pausing sandbox",
+			Err:       fmt.Errorf("error getting paused storage of the team: %w", err),
+		}
+	}
+
+	requestedMB := sbx.RamMB + sbx.TotalDiskSizeMB
+	if usedMB+requestedMB > tier.PausedStorageMB {
+		return &api.APIError{
+			Code: http.StatusTooManyRequests,
+			ClientMsg: fmt.Sprintf(
+				"you have reached the paused sandboxes storage quota of your team: %d MiB in use + %d MiB requested (limit %d MiB). "+
+					"Kill some of the paused sandboxes or contact us at 'https://e2b.dev/docs/getting-help'", usedMB, requestedMB, tier.PausedStorageMB),
+			Err: fmt.Errorf("team '%s' has reached its paused storage quota (%d MiB)", sbx.TeamID, tier.PausedStorageMB),
+		}
+	}
+
+	return nil
+}
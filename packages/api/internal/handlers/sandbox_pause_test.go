@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestValidatePausedRetention(t *testing.T) {
+	tests := []struct {
+		name           string
+		tierRetention  int64
+		retentionHours *int64
+		wantErr        bool
+	}{
+		{
+			name:          "tier retention",
+			tierRetention: 24,
+		},
+		{
+			name:           "shorter than the tier retention",
+			tierRetention:  24,
+			retentionHours: toPtr(int64(1)),
+		},
+		{
+			name:           "same as the tier retention",
+			tierRetention:  24,
+			retentionHours: toPtr(int64(24)),
+		},
+		{
+			name:           "longer than the tier retention",
+			tierRetention:  24,
+			retentionHours: toPtr(int64(25)),
+			wantErr:        true,
+		},
+		{
+			name:           "any retention without the tier retention",
+			retentionHours: toPtr(int64(10_000)),
+		},
+		{
+			name:           "zero retention",
+			tierRetention:  24,
+			retentionHours: toPtr(int64(0)),
+			wantErr:        true,
+		},
+		{
+			name:           "negative retention",
+			retentionHours: toPtr(int64(-1)),
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := validatePausedRetention(&models.Tier{PausedRetentionHours: tt.tierRetention}, tt.retentionHours)
+			if tt.wantErr {
+				require.NotNil(t, apiErr)
+				assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+
+				return
+			}
+
+			assert.Nil(t, apiErr)
+		})
+	}
+}
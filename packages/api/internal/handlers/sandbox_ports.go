@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/proxy"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// portAccessTokenTTL is how long the port access token is valid, a new token is requested after it expires.
+const portAccessTokenTTL = time.Hour
+
+func (a *APIStore) GetSandboxesSandboxIDPortsPortToken(c *gin.Context, sandboxID api.SandboxID, port api.Port) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+	sandboxID = utils.ShortID(sandboxID)
+
+	if port < 1 || port > 65535 {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid port %d", port))
+
+		return
+	}
+
+	if a.portAccessTokenSigner == nil {
+		telemetry.ReportCriticalError(ctx, "port access tokens are not configured", errors.New("port access token seed is not set"))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Port access tokens are not available")
+
+		return
+	}
+
+	sbx, err := a.orchestrator.GetSandbox(sandboxID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Error getting port access token - sandbox '%s' is not running", sandboxID))
+
+		return
+	}
+
+	if *sbx.TeamID != teamID {
+		telemetry.ReportCriticalError(ctx, "sandbox does not belong to team", fmt.Errorf("sandbox '%s' does not belong to team '%s'", sandboxID, teamID.String()))
+
+		a.sendAPIStoreError(c, http.StatusUnauthorized, fmt.Sprintf("Error getting port access token - sandbox '%s' does not belong to your team '%s'", sandboxID, teamID.String()))
+
+		return
+	}
+
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
+	expiresAt := time.Now().Add(portAccessTokenTTL)
+
+	token, err := a.portAccessTokenSigner.Sign(sandboxID, sbx.ExecutionID, uint64(port), expiresAt)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when signing port access token", err)
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when generating port access token")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, api.PortAccessToken{
+		Port:      port,
+		Token:     token,
+		Header:    proxy.PortAccessTokenHeader,
+		ExpiresAt: expiresAt,
+	})
+}
@@ -41,6 +41,10 @@ func (a *APIStore) PostSandboxesSandboxIDRefreshes(
 		duration = instance.InstanceExpiration
 	}
 
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
 	apiErr := a.orchestrator.KeepAliveFor(ctx, sandboxID, duration, false)
 	if apiErr != nil {
 		telemetry.ReportCriticalError(ctx, "error when refreshing sandbox", apiErr.Err)
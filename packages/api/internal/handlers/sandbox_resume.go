@@ -16,6 +16,7 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -78,6 +79,10 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 
 	sandboxID = utils.ShortID(sandboxID)
 
+	// The snapshot isn't changed while the sandbox is resumed from it
+	unlock := a.sandboxLocks.lock(sandboxID)
+	defer unlock()
+
 	sbxCache, err := a.orchestrator.GetSandbox(sandboxID)
 	if err == nil {
 		zap.L().Debug("Sandbox is already running",
@@ -121,6 +126,12 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 	snap := lastSnapshot.Snapshot
 	build := lastSnapshot.EnvBuild
 
+	if !a.apiKeyAllowsTemplates(ctx, teamInfo, snap.BaseEnvID) {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("API key isn't allowed to access the sandbox '%s'", sandboxID))
+
+		return
+	}
+
 	alias := ""
 	if len(lastSnapshot.Aliases) > 0 {
 		alias = lastSnapshot.Aliases[0]
@@ -159,6 +170,8 @@ func (a *APIStore) PostSandboxesSandboxIDResume(c *gin.Context, sandboxID api.Sa
 		snap.BaseEnvID,
 		autoPause,
 		envdAccessToken,
+		orchestrator.NetworkPolicyFromSnapshot(snap.NetworkPolicy),
+		orchestrator.PortAccessFromSnapshot(snap.PortAccess),
 	)
 
 	if createErr != nil {
@@ -0,0 +1,335 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/audit"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// The snapshot layers are flattened and uploaded as a whole, it takes longer than the other sandbox operations.
+	sandboxTemplateCommitTimeout = 30 * time.Minute
+
+	// staleCommitBuildAge is the age after which the commit build can't be finished anymore, the commit is bound by its timeout.
+	staleCommitBuildAge           = sandboxTemplateCommitTimeout + 5*time.Minute
+	staleCommitBuildsSyncInterval = 5 * time.Minute
+)
+
+// PostSandboxesSandboxIDTemplate creates a new template from the snapshot of the running or paused sandbox.
+// The template build is finished in the background, the build status can be polled the same way as for the other template builds.
+func (a *APIStore) PostSandboxesSandboxIDTemplate(c *gin.Context, sandboxID api.SandboxID) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+	team := teamInfo.Team
+
+	sandboxID = utils.ShortID(sandboxID)
+
+	var body api.SandboxTemplate
+	if c.Request.ContentLength != 0 {
+		var err error
+
+		body, err = utils.ParseBody[api.SandboxTemplate](ctx, c)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+			telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+			return
+		}
+	}
+
+	var alias string
+	if body.Alias != nil {
+		var err error
+
+		alias, err = id.CleanEnvID(*body.Alias)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid alias: %s", *body.Alias))
+
+			telemetry.ReportError(ctx, "invalid alias", err)
+
+			return
+		}
+	}
+
+	telemetry.SetAttributes(ctx,
+		telemetry.WithSandboxID(sandboxID),
+		attribute.String("env.alias", alias),
+	)
+
+	snapshotInfo, commit, apiErr := a.sandboxTemplateSource(ctx, a.orchestrator, sandboxID, team.ID)
+	if apiErr != nil {
+		if apiErr.Err != nil {
+			telemetry.ReportError(ctx, "error when getting sandbox for template", apiErr.Err)
+		}
+
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return
+	}
+
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
+	envBuild, err := a.db.NewCommitBuild(
+		ctx,
+		snapshotInfo,
+		team.ID,
+		team.ClusterID,
+		templateCreator(c, teamInfo),
+		alias,
+	)
+	if errors.Is(err, db.TemplateAliasAlreadyUsed{}) {
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Alias '%s' is already used", alias))
+
+		return
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when creating template from sandbox", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating template from sandbox")
+
+		return
+	}
+
+	templateID := *envBuild.EnvID
+
+	go a.commitSandboxTemplate(sandboxID, snapshotInfo, envBuild, commit)
+
+	a.auditLog.Record(c, &team.ID, api.TemplateCreatedFromSandbox, audit.ResourceTemplate, templateID, map[string]string{"build_id": envBuild.ID.String(), "sandbox_id": sandboxID})
+
+	zap.L().Info("Creating template from sandbox", logger.WithSandboxID(sandboxID), logger.WithTemplateID(templateID), logger.WithBuildID(envBuild.ID.String()))
+
+	aliases := make([]string, 0, 1)
+	if alias != "" {
+		aliases = append(aliases, alias)
+	}
+
+	c.JSON(http.StatusAccepted, &api.Template{
+		TemplateID: templateID,
+		BuildID:    envBuild.ID.String(),
+		Public:     false,
+		Aliases:    &aliases,
+	})
+}
+
+// sandboxCommitter is the part of the orchestrator the sandboxes are committed to templates with.
+type sandboxCommitter interface {
+	GetSandbox(sandboxID string) (*instance.InstanceInfo, error)
+	WaitForPause(ctx context.Context, sandboxID string) (*node.NodeInfo, error)
+	CommitInstance(ctx context.Context, sbx *instance.InstanceInfo, envBuild *models.EnvBuild) error
+	CommitSnapshot(ctx context.Context, snapshotBuild queries.EnvBuild, baseTemplateID string, envBuild *models.EnvBuild) error
+}
+
+// commitFunc stores the snapshot of the sandbox as the template build.
+type commitFunc func(ctx context.Context, envBuild *models.EnvBuild) error
+
+// sandboxTemplateSource returns the configuration of the sandbox the template is created from and the function committing it.
+// The template is committed from the running sandbox, or from the last snapshot when the sandbox is paused.
+func (a *APIStore) sandboxTemplateSource(ctx context.Context, orch sandboxCommitter, sandboxID string, teamID uuid.UUID) (*db.SnapshotInfo, commitFunc, *api.APIError) {
+	sbx, err := orch.GetSandbox(sandboxID)
+	if err == nil {
+		if *sbx.TeamID != teamID {
+			return nil, nil, &api.APIError{
+				Code:      http.StatusNotFound,
+				ClientMsg: fmt.Sprintf("Sandbox '%s' not found", sandboxID),
+			}
+		}
+
+		commit := func(ctx context.Context, envBuild *models.EnvBuild) error {
+			return orch.CommitInstance(ctx, sbx, envBuild)
+		}
+
+		return orchestrator.NewSnapshotInfo(sbx), commit, nil
+	}
+
+	// Wait for any pausing for this sandbox in progress.
+	_, err = orch.WaitForPause(ctx, sandboxID)
+	if err != nil && !errors.Is(err, instance.ErrPausingInstanceNotFound) {
+		return nil, nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: fmt.Sprintf("Error while pausing sandbox %s: %s", sandboxID, err),
+			Err:       err,
+		}
+	}
+
+	lastSnapshot, err := a.sqlcDB.GetLastSnapshot(ctx, queries.GetLastSnapshotParams{SandboxID: sandboxID, TeamID: teamID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, &api.APIError{
+			Code:      http.StatusNotFound,
+			ClientMsg: fmt.Sprintf("Sandbox '%s' not found", sandboxID),
+		}
+	} else if err != nil {
+		zap.L().Error("Error getting last snapshot", logger.WithSandboxID(sandboxID), zap.Error(err))
+
+		return nil, nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when getting snapshot",
+			Err:       err,
+		}
+	}
+
+	commit := func(ctx context.Context, envBuild *models.EnvBuild) error {
+		return orch.CommitSnapshot(ctx, lastSnapshot.EnvBuild, lastSnapshot.Snapshot.BaseEnvID, envBuild)
+	}
+
+	return pausedSnapshotInfo(lastSnapshot.Snapshot, lastSnapshot.EnvBuild), commit, nil
+}
+
+// commitSandboxTemplate stores the sandbox snapshot as the template build and finishes the build.
+func (a *APIStore) commitSandboxTemplate(sandboxID string, snapshotInfo *db.SnapshotInfo, envBuild *models.EnvBuild, commit commitFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), sandboxTemplateCommitTimeout)
+	defer cancel()
+
+	ctx, span := a.Tracer.Start(ctx, "commit-sandbox-template")
+	defer span.End()
+
+	templateID := *envBuild.EnvID
+	span.SetAttributes(
+		telemetry.WithSandboxID(sandboxID),
+		telemetry.WithTemplateID(templateID),
+		telemetry.WithBuildID(envBuild.ID.String()),
+	)
+
+	err := commit(ctx, envBuild)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when committing sandbox template", err)
+
+		statusErr := a.templateManager.SetStatus(ctx, templateID, envBuild.ID, envbuild.StatusFailed, "error when committing sandbox snapshot")
+		if statusErr != nil {
+			telemetry.ReportError(ctx, "error when setting template build status", statusErr)
+		}
+
+		return
+	}
+
+	err = a.templateManager.SetFinished(ctx, templateID, envBuild.ID, snapshotInfo.TotalDiskSizeMB, snapshotInfo.EnvdVersion)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when finishing sandbox template build", err)
+
+		return
+	}
+
+	zap.L().Info("Created template from sandbox", logger.WithSandboxID(sandboxID), logger.WithTemplateID(templateID), logger.WithBuildID(envBuild.ID.String()))
+}
+
+// failStaleCommitBuilds periodically fails the commit builds left snapshotting by the replicas stopped during the commit.
+func (a *APIStore) failStaleCommitBuilds(ctx context.Context) {
+	ticker := time.NewTicker(staleCommitBuildsSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			zap.L().Info("Stopping stale commit builds sync")
+
+			return
+		case <-ticker.C:
+			a.failStaleCommitBuildsBatch(ctx)
+		}
+	}
+}
+
+func (a *APIStore) failStaleCommitBuildsBatch(ctx context.Context) {
+	ctx, span := a.Tracer.Start(ctx, "fail-stale-commit-builds")
+	defer span.End()
+
+	builds, err := a.sqlcDB.FailStaleCommitBuilds(ctx, time.Now().Add(-staleCommitBuildAge))
+	if err != nil {
+		zap.L().Error("Error failing stale commit builds", zap.Error(err))
+
+		return
+	}
+
+	for _, build := range builds {
+		a.templateBuildsCache.SetStatus(build.ID, envbuild.StatusFailed, "template commit didn't finish")
+
+		zap.L().Warn("Failed stale template build from sandbox", logger.WithBuildID(build.ID.String()))
+	}
+}
+
+// pausedSnapshotInfo returns the configuration of the paused sandbox stored with its last snapshot.
+func pausedSnapshotInfo(snapshot queries.Snapshot, build queries.EnvBuild) *db.SnapshotInfo {
+	info := &db.SnapshotInfo{
+		SandboxID:          snapshot.SandboxID,
+		SandboxStartedAt:   snapshot.SandboxStartedAt.Time,
+		BaseTemplateID:     snapshot.BaseEnvID,
+		VCPU:               build.Vcpu,
+		RAMMB:              build.RamMb,
+		Metadata:           snapshot.Metadata,
+		KernelVersion:      build.KernelVersion,
+		FirecrackerVersion: build.FirecrackerVersion,
+		EnvdSecured:        snapshot.EnvSecure,
+		NetworkPolicy:      snapshot.NetworkPolicy,
+		PortAccess:         snapshot.PortAccess,
+		SourceTemplateID:   snapshot.EnvID,
+		SourceBuildID:      &build.ID,
+	}
+
+	if build.TotalDiskSizeMb != nil {
+		info.TotalDiskSizeMB = *build.TotalDiskSizeMb
+	}
+
+	if build.EnvdVersion != nil {
+		info.EnvdVersion = *build.EnvdVersion
+	}
+
+	if build.NetworkBandwidthMibps != nil {
+		info.NetworkBandwidthMiBps = *build.NetworkBandwidthMibps
+	}
+
+	if build.NetworkOps != nil {
+		info.NetworkOps = *build.NetworkOps
+	}
+
+	if build.DiskBandwidthMibps != nil {
+		info.DiskBandwidthMiBps = *build.DiskBandwidthMibps
+	}
+
+	if build.DiskIops != nil {
+		info.DiskIops = *build.DiskIops
+	}
+
+	if build.MemoryTargetMb != nil {
+		info.MemoryTargetMB = *build.MemoryTargetMb
+	}
+
+	return info
+}
+
+// templateCreator returns the user creating the template, it's the creator of the key when authenticated with an API key.
+func templateCreator(c *gin.Context, teamInfo authcache.AuthTeamInfo) *uuid.UUID {
+	if userID, ok := c.Value(auth.UserIDContextKey).(uuid.UUID); ok {
+		return &userID
+	}
+
+	if teamInfo.APIKey != nil {
+		return teamInfo.APIKey.CreatedBy
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+// fakeSandboxCommitter has the running sandbox when set, it records the commits.
+type fakeSandboxCommitter struct {
+	sandbox *instance.InstanceInfo
+
+	committedSandbox        *instance.InstanceInfo
+	committedSnapshotBuild  *queries.EnvBuild
+	committedBaseTemplateID string
+}
+
+func (f *fakeSandboxCommitter) GetSandbox(sandboxID string) (*instance.InstanceInfo, error) {
+	if f.sandbox == nil || f.sandbox.Instance.SandboxID != sandboxID {
+		return nil, errors.New("sandbox not found")
+	}
+
+	return f.sandbox, nil
+}
+
+func (f *fakeSandboxCommitter) WaitForPause(context.Context, string) (*node.NodeInfo, error) {
+	return nil, instance.ErrPausingInstanceNotFound
+}
+
+func (f *fakeSandboxCommitter) CommitInstance(_ context.Context, sbx *instance.InstanceInfo, _ *models.EnvBuild) error {
+	f.committedSandbox = sbx
+
+	return nil
+}
+
+func (f *fakeSandboxCommitter) CommitSnapshot(_ context.Context, snapshotBuild queries.EnvBuild, baseTemplateID string, _ *models.EnvBuild) error {
+	f.committedSnapshotBuild = &snapshotBuild
+	f.committedBaseTemplateID = baseTemplateID
+
+	return nil
+}
+
+// lastSnapshotDB is the database returning the last snapshot of the paused sandbox, there are no rows when it's not set.
+type lastSnapshotDB struct {
+	emptyDB
+
+	snapshot *queries.GetLastSnapshotRow
+}
+
+func (d lastSnapshotDB) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	if d.snapshot == nil {
+		return emptyRow{}
+	}
+
+	return structRow{row: *d.snapshot}
+}
+
+// structRow scans the fields of the row in order, the fields of the embedded models are scanned in place.
+type structRow struct {
+	row any
+}
+
+func (r structRow) Scan(dest ...any) error {
+	modelsPkg := reflect.TypeOf(queries.Snapshot{}).PkgPath()
+
+	var values []reflect.Value
+	row := reflect.ValueOf(r.row)
+	for i := range row.NumField() {
+		field := row.Field(i)
+		if field.Kind() == reflect.Struct && field.Type().PkgPath() == modelsPkg {
+			for j := range field.NumField() {
+				values = append(values, field.Field(j))
+			}
+
+			continue
+		}
+
+		values = append(values, field)
+	}
+
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(values[i])
+	}
+
+	return nil
+}
+
+func TestSandboxTemplateSource(t *testing.T) {
+	teamID := uuid.New()
+
+	t.Run("running sandbox is committed live", func(t *testing.T) {
+		sbx := &instance.InstanceInfo{
+			Instance:       &api.Sandbox{SandboxID: "sandbox-id", TemplateID: "template-id"},
+			TeamID:         &teamID,
+			BaseTemplateID: "base-template-id",
+		}
+		committer := &fakeSandboxCommitter{sandbox: sbx}
+		a := &APIStore{sqlcDB: &sqlcdb.Client{Queries: queries.New(lastSnapshotDB{})}}
+
+		info, commit, apiErr := a.sandboxTemplateSource(t.Context(), committer, "sandbox-id", teamID)
+		require.Nil(t, apiErr)
+
+		assert.Equal(t, "sandbox-id", info.SandboxID)
+		assert.Equal(t, "base-template-id", info.BaseTemplateID)
+
+		require.NoError(t, commit(t.Context(), &models.EnvBuild{}))
+		assert.Same(t, sbx, committer.committedSandbox)
+		assert.Nil(t, committer.committedSnapshotBuild)
+	})
+
+	t.Run("running sandbox of another team isn't found", func(t *testing.T) {
+		otherTeamID := uuid.New()
+		committer := &fakeSandboxCommitter{sandbox: &instance.InstanceInfo{
+			Instance: &api.Sandbox{SandboxID: "sandbox-id"},
+			TeamID:   &otherTeamID,
+		}}
+		a := &APIStore{sqlcDB: &sqlcdb.Client{Queries: queries.New(lastSnapshotDB{})}}
+
+		_, _, apiErr := a.sandboxTemplateSource(t.Context(), committer, "sandbox-id", teamID)
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.Code)
+		assert.Nil(t, committer.committedSandbox)
+	})
+
+	t.Run("paused sandbox is committed from its last snapshot", func(t *testing.T) {
+		snapshotEnvID := "snapshot-env-id"
+		totalDiskSizeMB := int64(1024)
+		envdVersion := "0.2.0"
+		lastSnapshot := &queries.GetLastSnapshotRow{
+			Snapshot: queries.Snapshot{
+				SandboxID: "sandbox-id",
+				EnvID:     snapshotEnvID,
+				BaseEnvID: "base-template-id",
+			},
+			EnvBuild: queries.EnvBuild{
+				ID:              uuid.New(),
+				EnvID:           &snapshotEnvID,
+				Vcpu:            2,
+				RamMb:           512,
+				TotalDiskSizeMb: &totalDiskSizeMB,
+				EnvdVersion:     &envdVersion,
+			},
+		}
+
+		committer := &fakeSandboxCommitter{}
+		a := &APIStore{sqlcDB: &sqlcdb.Client{Queries: queries.New(lastSnapshotDB{snapshot: lastSnapshot})}}
+
+		info, commit, apiErr := a.sandboxTemplateSource(t.Context(), committer, "sandbox-id", teamID)
+		require.Nil(t, apiErr)
+
+		assert.Equal(t, "base-template-id", info.BaseTemplateID)
+		assert.Equal(t, int64(2), info.VCPU)
+		assert.Equal(t, int64(512), info.RAMMB)
+		assert.Equal(t, totalDiskSizeMB, info.TotalDiskSizeMB)
+		assert.Equal(t, envdVersion, info.EnvdVersion)
+		assert.Equal(t, &lastSnapshot.EnvBuild.ID, info.SourceBuildID)
+
+		require.NoError(t, commit(t.Context(), &models.EnvBuild{}))
+		assert.Nil(t, committer.committedSandbox)
+		require.NotNil(t, committer.committedSnapshotBuild)
+		assert.Equal(t, lastSnapshot.EnvBuild.ID, committer.committedSnapshotBuild.ID)
+		assert.Equal(t, "base-template-id", committer.committedBaseTemplateID)
+	})
+
+	t.Run("sandbox without snapshot isn't found", func(t *testing.T) {
+		a := &APIStore{sqlcDB: &sqlcdb.Client{Queries: queries.New(lastSnapshotDB{})}}
+
+		_, _, apiErr := a.sandboxTemplateSource(t.Context(), &fakeSandboxCommitter{}, "sandbox-id", teamID)
+		require.NotNil(t, apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.Code)
+	})
+}
@@ -36,6 +36,10 @@ func (a *APIStore) PostSandboxesSandboxIDTimeout(
 		duration = time.Duration(body.Timeout) * time.Second
 	}
 
+	if !a.checkAPIKeySandbox(c, sandboxID) {
+		return
+	}
+
 	apiErr := a.orchestrator.KeepAliveFor(ctx, sandboxID, duration, true)
 	if apiErr != nil {
 		telemetry.ReportCriticalError(ctx, "error when setting timeout", apiErr.Err)
@@ -53,9 +53,10 @@ func (a *APIStore) getPausedSandboxes(ctx context.Context, teamID uuid.UUID, run
 	return sandboxes, nil
 }
 
-func getRunningSandboxes(ctx context.Context, orchestrator *orchestrator.Orchestrator, teamID uuid.UUID, metadataFilter *map[string]string) []utils.PaginatedSandbox {
-	// Get all sandbox instances
-	runningSandboxes := orchestrator.GetSandboxes(ctx, &teamID)
+func (a *APIStore) getRunningSandboxes(ctx context.Context, teamInfo authcache.AuthTeamInfo, metadataFilter *map[string]string) []utils.PaginatedSandbox {
+	// Get all sandbox instances the API key can access
+	runningSandboxes := a.orchestrator.GetSandboxes(ctx, &teamInfo.Team.ID)
+	runningSandboxes = a.filterAPIKeySandboxes(ctx, teamInfo, runningSandboxes)
 
 	// Running Sandbox IDs
 	runningSandboxList := instanceInfoToPaginatedSandboxes(runningSandboxes)
@@ -85,7 +86,7 @@ func (a *APIStore) GetSandboxes(c *gin.Context, params api.GetSandboxesParams) {
 		return
 	}
 
-	sandboxes := getRunningSandboxes(ctx, a.orchestrator, team.ID, metadataFilter)
+	sandboxes := a.getRunningSandboxes(ctx, teamInfo, metadataFilter)
 
 	// Sort sandboxes by start time descending
 	slices.SortFunc(sandboxes, func(a, b utils.PaginatedSandbox) int {
@@ -150,7 +151,7 @@ func (a *APIStore) GetV2Sandboxes(c *gin.Context, params api.GetV2SandboxesParam
 	}
 
 	if slices.Contains(states, api.Running) {
-		runningSandboxList := instanceInfoToPaginatedSandboxes(runningSandboxes)
+		runningSandboxList := instanceInfoToPaginatedSandboxes(a.filterAPIKeySandboxes(ctx, teamInfo, runningSandboxes))
 
 		// Filter based on metadata
 		runningSandboxList = utils.FilterSandboxesOnMetadata(runningSandboxList, metadataFilter)
@@ -169,6 +170,12 @@ func (a *APIStore) GetV2Sandboxes(c *gin.Context, params api.GetV2SandboxesParam
 
 			return
 		}
+
+		// The paused sandboxes keep the template they were started from
+		pausedSandboxList = slices.DeleteFunc(pausedSandboxList, func(sbx utils.PaginatedSandbox) bool {
+			return !a.apiKeyAllowsTemplates(ctx, teamInfo, sbx.TemplateID)
+		})
+
 		sandboxes = append(sandboxes, pausedSandboxList...)
 	}
 
@@ -221,7 +228,7 @@ func snapshotsToPaginatedSandboxes(snapshots []queries.GetSnapshotsWithCursorRow
 				SandboxID:  snapshot.SandboxID,
 				StartedAt:  snapshot.SandboxStartedAt.Time,
 				CpuCount:   int32(build.Vcpu),
-				MemoryMB:   int32(build.RamMb),
+				MemoryMB:   int32(orchestrator.SandboxMemoryMB(build)),
 				EndAt:      snapshot.CreatedAt.Time,
 				State:      api.Paused,
 			},
@@ -252,15 +259,15 @@ func instanceInfoToPaginatedSandboxes(runningSandboxes []*instance.InstanceInfo)
 				SandboxID:  info.Instance.SandboxID,
 				StartedAt:  info.StartTime,
 				CpuCount:   api.CPUCount(info.VCpu),
-				MemoryMB:   api.MemoryMB(info.RamMB),
+				MemoryMB:   api.MemoryMB(info.MemoryMB()),
 				EndAt:      info.GetEndTime(),
 				State:      api.Running,
 			},
 			PaginationTimestamp: info.StartTime,
 		}
 
-		if info.Metadata != nil {
-			meta := api.SandboxMetadata(info.Metadata)
+		if metadata := info.GetMetadata(); metadata != nil {
+			meta := api.SandboxMetadata(metadata)
 			sandbox.Metadata = &meta
 		}
 
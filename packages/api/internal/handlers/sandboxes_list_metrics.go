@@ -187,7 +187,8 @@ func (a *APIStore) GetSandboxesMetrics(c *gin.Context, params api.GetSandboxesMe
 	ctx, cancel := context.WithTimeout(ctx, getSandboxesMetricsTimeout)
 	defer cancel()
 
-	team := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo).Team
+	teamInfo := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+	team := teamInfo.Team
 
 	a.posthog.IdentifyAnalyticsTeam(team.ID.String(), team.Name)
 	properties := a.posthog.GetPackageToPosthogProperties(&c.Request.Header)
@@ -201,8 +202,9 @@ func (a *APIStore) GetSandboxesMetrics(c *gin.Context, params api.GetSandboxesMe
 		return
 	}
 
-	// Get relevant running sandboxes
-	sandboxes := getRunningSandboxes(ctx, a.orchestrator, team.ID, metadataFilter)
+	// Get relevant running sandboxes, the sandboxes of the templates the API key isn't allowed to access are left out
+	runningSandboxes := a.filterAPIKeySandboxes(ctx, teamInfo, a.orchestrator.GetSandboxes(ctx, &team.ID))
+	sandboxes := utils.FilterSandboxesOnMetadata(instanceInfoToPaginatedSandboxes(runningSandboxes), metadataFilter)
 
 	sandboxesWithMetrics, err := a.getSandboxesMetrics(ctx, team.ID, sandboxes)
 	if err != nil {
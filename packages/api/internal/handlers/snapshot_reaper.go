@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+const (
+	snapshotReaperInterval  = 5 * time.Minute
+	snapshotReaperBatchSize = 100
+
+	// snapshotReaperLockID is the Postgres advisory lock held by the replica deleting the expired snapshots
+	snapshotReaperLockID int64 = 0x736e6170
+)
+
+// reapExpiredSnapshots periodically deletes the snapshots of the sandboxes paused for longer than their retention.
+func (a *APIStore) reapExpiredSnapshots(ctx context.Context) {
+	ticker := time.NewTicker(snapshotReaperInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			zap.L().Info("Stopping expired snapshots reaper")
+
+			return
+		case <-ticker.C:
+			a.deleteExpiredSnapshots(ctx)
+		}
+	}
+}
+
+func (a *APIStore) deleteExpiredSnapshots(ctx context.Context) {
+	ctx, span := a.Tracer.Start(ctx, "delete-expired-snapshots")
+	defer span.End()
+
+	// Only one replica deletes the expired snapshots at a time, the lock is released with the transaction
+	txDB, tx, err := a.sqlcDB.WithTx(ctx)
+	if err != nil {
+		zap.L().Error("Error starting expired snapshots reaper transaction", zap.Error(err))
+
+		return
+	}
+	defer tx.Rollback(context.WithoutCancel(ctx))
+
+	locked, err := txDB.TryAdvisoryXactLock(ctx, snapshotReaperLockID)
+	if err != nil {
+		zap.L().Error("Error locking expired snapshots reaper", zap.Error(err))
+
+		return
+	}
+
+	if !locked {
+		zap.L().Debug("Expired snapshots are being deleted by another replica")
+
+		return
+	}
+
+	a.deleteExpiredSnapshotsBatch(ctx)
+}
+
+// deleteExpiredSnapshotsBatch deletes the oldest expired snapshots, the rest is deleted in the next runs.
+func (a *APIStore) deleteExpiredSnapshotsBatch(ctx context.Context) {
+	snapshots, err := a.sqlcDB.GetExpiredSnapshots(ctx, snapshotReaperBatchSize)
+	if err != nil {
+		zap.L().Error("Error getting expired snapshots", zap.Error(err))
+
+		return
+	}
+
+	for _, snapshot := range snapshots {
+		deleted, err := a.deleteExpiredSnapshot(ctx, snapshot)
+		if err != nil {
+			zap.L().Error("Error deleting expired snapshot", logger.WithSandboxID(snapshot.SandboxID), logger.WithTeamID(snapshot.TeamID.String()), zap.Error(err))
+
+			continue
+		}
+
+		if deleted {
+			zap.L().Info("Deleted expired snapshot", logger.WithSandboxID(snapshot.SandboxID), logger.WithTeamID(snapshot.TeamID.String()))
+		}
+	}
+}
+
+// deleteExpiredSnapshot deletes the snapshot unless the sandbox is being resumed from it or is running.
+func (a *APIStore) deleteExpiredSnapshot(ctx context.Context, snapshot queries.GetExpiredSnapshotsRow) (bool, error) {
+	// The sandbox is being resumed, its snapshot is checked again in the next run
+	unlock, ok := a.sandboxLocks.tryLock(snapshot.SandboxID)
+	if !ok {
+		return false, nil
+	}
+	defer unlock()
+
+	// The sandbox was resumed, its snapshot doesn't expire while it's running
+	if _, err := a.orchestrator.GetSandbox(snapshot.SandboxID); err == nil {
+		return false, nil
+	}
+
+	err := a.deleteSnapshot(ctx, snapshot.SandboxID, snapshot.TeamID, snapshot.ClusterID)
+	if errors.Is(err, db.EnvNotFound{}) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
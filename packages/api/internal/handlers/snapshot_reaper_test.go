@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+// expiredSnapshotsDB is the database returning the expired snapshots, it records the queries.
+type expiredSnapshotsDB struct {
+	emptyDB
+
+	snapshots []queries.GetExpiredSnapshotsRow
+	queries   []string
+	args      [][]any
+}
+
+func (d *expiredSnapshotsDB) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	d.queries = append(d.queries, sql)
+	d.args = append(d.args, args)
+
+	values := make([][]any, 0, len(d.snapshots))
+	for _, s := range d.snapshots {
+		values = append(values, []any{s.SandboxID, s.TeamID, s.ClusterID})
+	}
+
+	return &fakeRows{values: values}, nil
+}
+
+// fakeRows returns the rows of the values, the values are scanned to the destinations of the same types.
+type fakeRows struct {
+	pgx.Rows
+
+	values [][]any
+	row    int
+}
+
+func (r *fakeRows) Next() bool {
+	r.row++
+
+	return r.row <= len(r.values)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	for i, v := range r.values[r.row-1] {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(v))
+	}
+
+	return nil
+}
+
+func (r *fakeRows) Close() {}
+
+func (r *fakeRows) Err() error {
+	return nil
+}
+
+func (r *fakeRows) CommandTag() pgconn.CommandTag {
+	return pgconn.CommandTag{}
+}
+
+func TestDeleteExpiredSnapshotsBatch(t *testing.T) {
+	t.Run("skips the snapshots of the sandboxes being resumed", func(t *testing.T) {
+		database := &expiredSnapshotsDB{
+			snapshots: []queries.GetExpiredSnapshotsRow{
+				{SandboxID: "resumed-1", TeamID: uuid.New()},
+				{SandboxID: "resumed-2", TeamID: uuid.New()},
+			},
+		}
+
+		a := &APIStore{
+			Tracer:       noop.NewTracerProvider().Tracer(""),
+			sqlcDB:       &sqlcdb.Client{Queries: queries.New(database)},
+			sandboxLocks: newSandboxLocks(),
+		}
+
+		for _, s := range database.snapshots {
+			unlock := a.sandboxLocks.lock(s.SandboxID)
+			defer unlock()
+		}
+
+		a.deleteExpiredSnapshotsBatch(context.Background())
+
+		require.Len(t, database.queries, 1)
+		assert.Contains(t, database.queries[0], "GetExpiredSnapshots")
+		assert.Equal(t, []any{int32(snapshotReaperBatchSize)}, database.args[0])
+	})
+}
+
+func TestDeleteExpiredSnapshot(t *testing.T) {
+	t.Run("keeps the snapshot while the sandbox is being resumed", func(t *testing.T) {
+		a := &APIStore{sandboxLocks: newSandboxLocks()}
+
+		unlock := a.sandboxLocks.lock("sandbox-id")
+		defer unlock()
+
+		deleted, err := a.deleteExpiredSnapshot(context.Background(), queries.GetExpiredSnapshotsRow{SandboxID: "sandbox-id", TeamID: uuid.New()})
+		require.NoError(t, err)
+
+		assert.False(t, deleted)
+	})
+
+	t.Run("releases the lock of the sandbox", func(t *testing.T) {
+		a := &APIStore{sandboxLocks: newSandboxLocks()}
+
+		unlock := a.sandboxLocks.lock("sandbox-id")
+		_, _ = a.deleteExpiredSnapshot(context.Background(), queries.GetExpiredSnapshotsRow{SandboxID: "sandbox-id", TeamID: uuid.New()})
+		unlock()
+
+		unlock, ok := a.sandboxLocks.tryLock("sandbox-id")
+		require.True(t, ok)
+		unlock()
+	})
+}
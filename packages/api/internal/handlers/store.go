@@ -22,18 +22,25 @@ import (
 
 	analyticscollector "github.com/e2b-dev/infra/packages/api/internal/analytics_collector"
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/audit"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
 	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
 	"github.com/e2b-dev/infra/packages/api/internal/edge"
+	"github.com/e2b-dev/infra/packages/api/internal/idempotency"
+	"github.com/e2b-dev/infra/packages/api/internal/middleware/ratelimit"
 	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
 	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	sandboxlogs "github.com/e2b-dev/infra/packages/api/internal/sandbox/logs"
 	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
+	"github.com/e2b-dev/infra/packages/api/internal/usage"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
 	sqlcdb "github.com/e2b-dev/infra/packages/db/client"
 	"github.com/e2b-dev/infra/packages/shared/pkg/chdb"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
-	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -53,24 +60,32 @@ type APIStore struct {
 	posthog                  *analyticscollector.PosthogClient
 	Tracer                   trace.Tracer
 	Telemetry                *telemetry.Client
+	RateLimiter              ratelimit.Limiter
 	orchestrator             *orchestrator.Orchestrator
 	templateManager          *template_manager.TemplateManager
 	db                       *db.DB
 	sqlcDB                   *sqlcdb.Client
 	lokiClient               *loki.DefaultClient
+	sandboxLogs              sandboxlogs.Provider
 	templateCache            *templatecache.TemplateCache
 	templateBuildsCache      *templatecache.TemplatesBuildCache
 	authCache                *authcache.TeamAuthCache
 	templateSpawnCounter     *utils.TemplateSpawnCounter
+	auditLog                 *audit.Logger
+	idempotency              idempotency.Store
 	clickhouseStore          chdb.Store
 	envdAccessTokenGenerator *sandbox.EnvdAccessTokenGenerator
+	portAccessTokenSigner    *keys.PortAccessTokenSigner
 	// should use something like this: https://github.com/spf13/viper
 	// but for now this is good
 	readMetricsFromClickHouse string
 	clustersPool              *edge.Pool
+	nodeDrains                *nodeDrains
+	sandboxLocks              *sandboxLocks
 }
 
-func NewAPIStore(ctx context.Context, tel *telemetry.Client) *APIStore {
+// NewAPIStore creates the handlers, the memory sandbox logs are streamed when Loki isn't configured.
+func NewAPIStore(ctx context.Context, tel *telemetry.Client, memorySandboxLogs *sandboxlogs.MemoryProvider) *APIStore {
 	tracer := tel.TracerProvider.Tracer("api")
 
 	zap.L().Info("Initializing API store and services")
@@ -90,7 +105,8 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client) *APIStore {
 	readMetricsFromClickHouse := os.Getenv("READ_METRICS_FROM_CLICKHOUSE")
 	var clickhouseStore chdb.Store = nil
 
-	if readMetricsFromClickHouse == "true" {
+	// The store is also used for the usage metering, which doesn't depend on reading the metrics from ClickHouse
+	if readMetricsFromClickHouse == "true" || os.Getenv("CLICKHOUSE_CONNECTION_STRING") != "" {
 		clickhouseStore, err = chdb.NewStore(chdb.ClickHouseConfig{
 			ConnectionString: os.Getenv("CLICKHOUSE_CONNECTION_STRING"),
 			Username:         os.Getenv("CLICKHOUSE_USERNAME"),
@@ -148,18 +164,36 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client) *APIStore {
 		zap.L().Info("Connected to Redis cluster")
 	}
 
-	orch, err := orchestrator.New(ctx, tel, tracer, nomadClient, posthogClient, redisClient, dbClient)
+	var rateLimiter ratelimit.Limiter
+	var idempotencyStore idempotency.Store
+	if redisClient != nil {
+		rateLimiter = ratelimit.NewRedisLimiter(redisClient)
+		idempotencyStore = idempotency.NewRedisStore(redisClient)
+	} else {
+		zap.L().Warn("Redis not configured, API rate limits and idempotency keys are kept in memory of each instance")
+		rateLimiter = ratelimit.NewMemoryLimiter()
+		idempotencyStore = idempotency.NewMemoryStore()
+	}
+
+	webhookDispatcher := webhooks.NewDispatcher(ctx, dbClient)
+
+	usageRecorder := usage.NewRecorder(clickhouseStore)
+
+	orch, err := orchestrator.New(ctx, tel, tracer, nomadClient, posthogClient, redisClient, dbClient, webhookDispatcher, usageRecorder)
 	if err != nil {
 		zap.L().Fatal("Initializing Orchestrator client", zap.Error(err))
 	}
 
 	var lokiClient *loki.DefaultClient
+	var sandboxLogsProvider sandboxlogs.Provider
 	if laddr := os.Getenv("LOKI_ADDRESS"); laddr != "" {
 		lokiClient = &loki.DefaultClient{
 			Address: laddr,
 		}
+		sandboxLogsProvider = sandboxlogs.NewLokiProvider(lokiClient)
 	} else {
-		zap.L().Warn("LOKI_ADDRESS not set, disabling Loki client")
+		zap.L().Warn("LOKI_ADDRESS not set, disabling Loki client and streaming the sandbox logs from memory")
+		sandboxLogsProvider = memorySandboxLogs
 	}
 
 	authCache := authcache.NewTeamAuthCache()
@@ -177,13 +211,14 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client) *APIStore {
 	}
 
 	templateBuildsCache := templatecache.NewTemplateBuildCache(dbClient)
-	templateManager, err := template_manager.New(ctx, tracer, tel.TracerProvider, tel.MeterProvider, dbClient, sqlcDB, clustersPool, lokiClient, templateBuildsCache)
+	templateManager, err := template_manager.New(ctx, tracer, tel.TracerProvider, tel.MeterProvider, dbClient, sqlcDB, clustersPool, lokiClient, templateBuildsCache, templateCache)
 	if err != nil {
 		zap.L().Fatal("Initializing Template manager client", zap.Error(err))
 	}
 
 	// Start the periodic sync of template builds statuses
 	go templateManager.BuildsStatusPeriodicalSync(ctx)
+	go templateManager.BuildQueuePeriodicalDispatch(ctx)
 
 	a := &APIStore{
 		Healthy:                   false,
@@ -193,18 +228,35 @@ func NewAPIStore(ctx context.Context, tel *telemetry.Client) *APIStore {
 		sqlcDB:                    sqlcDB,
 		Telemetry:                 tel,
 		Tracer:                    tracer,
+		RateLimiter:               rateLimiter,
 		posthog:                   posthogClient,
 		lokiClient:                lokiClient,
+		sandboxLogs:               sandboxLogsProvider,
 		templateCache:             templateCache,
 		templateBuildsCache:       templateBuildsCache,
 		authCache:                 authCache,
 		templateSpawnCounter:      templateSpawnCounter,
+		auditLog:                  audit.NewLogger(dbClient),
+		idempotency:               idempotencyStore,
 		clickhouseStore:           clickhouseStore,
 		envdAccessTokenGenerator:  accessTokenGenerator,
+		portAccessTokenSigner:     keys.NewPortAccessTokenSignerFromEnv(),
 		readMetricsFromClickHouse: readMetricsFromClickHouse,
 		clustersPool:              clustersPool,
+		nodeDrains:                newNodeDrains(ctx),
+		sandboxLocks:              newSandboxLocks(),
 	}
 
+	// Keep the configured warm pools of pre-warmed sandboxes filled
+	go a.keepWarmPools(ctx)
+
+	// Delete the snapshots of the sandboxes paused for longer than their retention
+	go a.reapExpiredSnapshots(ctx)
+	go a.reapUnusedForkEnvs(ctx)
+
+	// Fail the templates from sandboxes whose commit was interrupted by a replica restart
+	go a.failStaleCommitBuilds(ctx)
+
 	// Wait till there's at least one, otherwise we can't create sandboxes yet
 	go func() {
 		ticker := time.NewTicker(5 * time.Millisecond)
@@ -229,6 +281,11 @@ func (a *APIStore) Close(ctx context.Context) error {
 	a.templateSpawnCounter.Close()
 
 	errs := []error{}
+	// The migrations must finish before the clients they use are closed
+	if err := a.nodeDrains.close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("waiting for node drains: %w", err))
+	}
+
 	if err := a.posthog.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("closing Posthog client: %w", err))
 	}
@@ -275,8 +332,13 @@ func (a *APIStore) GetHealth(c *gin.Context) {
 }
 
 func (a *APIStore) GetTeamFromAPIKey(ctx context.Context, apiKey string) (authcache.AuthTeamInfo, *api.APIError) {
-	team, tier, err := a.authCache.GetOrSet(ctx, apiKey, func(ctx context.Context, key string) (*models.Team, *models.Tier, error) {
-		return a.db.GetTeamAuth(ctx, key)
+	teamInfo, err := a.authCache.GetOrSet(ctx, apiKey, func(ctx context.Context, key string) (authcache.AuthTeamInfo, error) {
+		team, tier, apiKey, err := a.db.GetTeamAuth(ctx, key)
+		if err != nil {
+			return authcache.AuthTeamInfo{}, err
+		}
+
+		return authcache.AuthTeamInfo{Team: team, Tier: tier, APIKey: apiKey}, nil
 	})
 	if err != nil {
 		var usageErr *db.TeamForbiddenError
@@ -304,10 +366,17 @@ func (a *APIStore) GetTeamFromAPIKey(ctx context.Context, apiKey string) (authca
 		}
 	}
 
-	return authcache.AuthTeamInfo{
-		Team: team,
-		Tier: tier,
-	}, nil
+	// The expiration is checked on every request as the key is cached
+	err = auth.CheckAPIKeyExpiration(teamInfo.APIKey, time.Now())
+	if err != nil {
+		return authcache.AuthTeamInfo{}, &api.APIError{
+			Err:       err,
+			ClientMsg: "API key has expired",
+			Code:      http.StatusUnauthorized,
+		}
+	}
+
+	return teamInfo, nil
 }
 
 func (a *APIStore) GetUserFromAccessToken(ctx context.Context, accessToken string) (uuid.UUID, *api.APIError) {
@@ -400,8 +469,13 @@ func (a *APIStore) GetUserIDFromSupabaseToken(ctx context.Context, supabaseToken
 func (a *APIStore) GetTeamFromSupabaseToken(ctx context.Context, teamID string) (authcache.AuthTeamInfo, *api.APIError) {
 	userID := a.GetUserID(middleware.GetGinContext(ctx))
 
-	team, tier, err := a.authCache.GetOrSet(ctx, teamID, func(ctx context.Context, key string) (*models.Team, *models.Tier, error) {
-		return a.db.GetTeamByIDAndUserIDAuth(ctx, teamID, userID)
+	teamInfo, err := a.authCache.GetOrSet(ctx, teamID, func(ctx context.Context, key string) (authcache.AuthTeamInfo, error) {
+		team, tier, err := a.db.GetTeamByIDAndUserIDAuth(ctx, teamID, userID)
+		if err != nil {
+			return authcache.AuthTeamInfo{}, err
+		}
+
+		return authcache.AuthTeamInfo{Team: team, Tier: tier}, nil
 	})
 	if err != nil {
 		var usageErr *db.TeamForbiddenError
@@ -429,8 +503,5 @@ func (a *APIStore) GetTeamFromSupabaseToken(ctx context.Context, teamID string)
 		}
 	}
 
-	return authcache.AuthTeamInfo{
-		Team: team,
-		Tier: tier,
-	}, nil
+	return teamInfo, nil
 }
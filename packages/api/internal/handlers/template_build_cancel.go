@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/audit"
+	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// DeleteTemplatesTemplateIDBuildsBuildID cancels the template build, the running build is stopped on the template manager
+func (a *APIStore) DeleteTemplatesTemplateIDBuildsBuildID(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when parsing build id", err)
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+
+		return
+	}
+
+	_, teams, err := a.GetUserAndTeams(c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get the default team")
+
+		telemetry.ReportCriticalError(ctx, "error when getting teams", err)
+
+		return
+	}
+
+	buildInfo, err := a.templateBuildsCache.Get(ctx, buildUUID, templateID)
+	if errors.Is(err, templatecache.TemplateBuildInfoNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+
+		return
+	} else if err != nil {
+		telemetry.ReportError(ctx, "error when getting template build", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template build")
+
+		return
+	}
+
+	var team *queries.Team
+	for _, t := range teams {
+		if t.Team.ID == buildInfo.TeamID {
+			team = &t.Team
+			break
+		}
+	}
+
+	if team == nil || buildInfo.TemplateID != templateID {
+		telemetry.ReportError(ctx, "user doesn't have access to the template build", fmt.Errorf("user doesn't have access to the build '%s'", buildUUID), telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+
+		return
+	}
+
+	switch buildInfo.BuildStatus {
+	case envbuild.StatusWaiting, envbuild.StatusQueued:
+		// The build wasn't started on the template manager yet, it's enough to mark it as cancelled
+		cancelled, err := a.sqlcDB.CancelNotStartedTemplateBuild(ctx, buildUUID)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "error when cancelling template build", err, telemetry.WithTemplateID(templateID))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when cancelling the build")
+
+			return
+		}
+
+		if cancelled > 0 {
+			break
+		}
+
+		// The build was started by the queue dispatcher in the meantime
+		fallthrough
+	case envbuild.StatusBuilding:
+		err = a.templateManager.CancelBuild(ctx, buildUUID, templateID, team.ClusterID, buildInfo.ClusterNodeID)
+		if errors.Is(err, template_manager.ErrBuildNotRunning) || errors.Is(err, template_manager.ErrBuildNotFound) {
+			a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Build '%s' has already finished", buildUUID))
+
+			return
+		} else if err != nil {
+			telemetry.ReportCriticalError(ctx, "error when cancelling template build", err, telemetry.WithTemplateID(templateID))
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when cancelling the build")
+
+			return
+		}
+	default:
+		a.sendAPIStoreError(c, http.StatusConflict, fmt.Sprintf("Build '%s' has already finished", buildUUID))
+
+		return
+	}
+
+	err = a.templateManager.SetStatus(ctx, templateID, buildUUID, envbuild.StatusCancelled, "build cancelled by the user")
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when setting build status", err, telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when cancelling the build")
+
+		return
+	}
+
+	a.auditLog.Record(c, &team.ID, api.TemplateBuildCancelled, audit.ResourceTemplate, templateID, map[string]string{"build_id": buildUUID.String()})
+
+	zap.L().Info("Cancelled template build", logger.WithTemplateID(templateID), logger.WithBuildID(buildUUID.String()), logger.WithTeamID(team.ID.String()))
+
+	c.Status(http.StatusNoContent)
+}
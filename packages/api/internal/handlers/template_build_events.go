@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
+	template_manager "github.com/e2b-dev/infra/packages/api/internal/template-manager"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+var buildEventPhases = map[templatemanagergrpc.TemplateBuildPhaseType]api.TemplateBuildEventPhase{
+	templatemanagergrpc.TemplateBuildPhaseType_Base:      api.Base,
+	templatemanagergrpc.TemplateBuildPhaseType_Provision: api.Provision,
+	templatemanagergrpc.TemplateBuildPhaseType_Configure: api.Configure,
+	templatemanagergrpc.TemplateBuildPhaseType_Snapshot:  api.Snapshot,
+	templatemanagergrpc.TemplateBuildPhaseType_Upload:    api.Upload,
+}
+
+// GetTemplatesTemplateIDBuildsBuildIDEvents streams the template build logs, phases and state changes (e.g. to CLI)
+func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDEvents(c *gin.Context, templateID api.TemplateID, buildID api.BuildID, params api.GetTemplatesTemplateIDBuildsBuildIDEventsParams) {
+	ctx := c.Request.Context()
+
+	buildUUID, err := uuid.Parse(buildID)
+	if err != nil {
+		telemetry.ReportError(ctx, "error when parsing build id", err)
+		a.sendAPIStoreError(c, http.StatusBadRequest, "Invalid build id")
+
+		return
+	}
+
+	var logsOffset int32
+	if params.LogsOffset != nil {
+		logsOffset = *params.LogsOffset
+	}
+
+	// The log event IDs are the offsets of the next logs, resume after the last received one
+	if lastEventID := c.GetHeader(lastEventIDHeader); lastEventID != "" {
+		offset, err := strconv.ParseInt(lastEventID, 10, 32)
+		if err != nil || offset < 0 {
+			a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid %s header '%s'", lastEventIDHeader, lastEventID))
+
+			return
+		}
+
+		logsOffset = int32(offset)
+	}
+
+	_, teams, err := a.GetUserAndTeams(c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get the default team")
+
+		telemetry.ReportCriticalError(ctx, "error when getting teams", err)
+
+		return
+	}
+
+	buildInfo, err := a.templateBuildsCache.Get(ctx, buildUUID, templateID)
+	if errors.Is(err, templatecache.TemplateBuildInfoNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+
+		return
+	} else if err != nil {
+		telemetry.ReportError(ctx, "error when getting template build", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template build")
+
+		return
+	}
+
+	var team *queries.Team
+	for _, t := range teams {
+		if t.Team.ID == buildInfo.TeamID {
+			team = &t.Team
+			break
+		}
+	}
+
+	if team == nil || buildInfo.TemplateID != templateID {
+		telemetry.ReportError(ctx, "user doesn't have access to the template build", fmt.Errorf("user doesn't have access to the build '%s'", buildUUID), telemetry.WithTemplateID(templateID))
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Build '%s' not found", buildUUID))
+
+		return
+	}
+
+	events := a.templateManager.StreamBuildEvents(ctx, buildUUID, templateID, team.ClusterID, logsOffset)
+
+	// The stream is open longer than the server write timeout allows
+	err = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	if err != nil {
+		telemetry.ReportError(ctx, "error when clearing write deadline for build events stream", err)
+	}
+
+	c.Header("Content-Type", sse.ContentType)
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(logsStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			_, err := c.Writer.WriteString(": keep-alive\n\n")
+			if err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			err := sse.Encode(c.Writer, buildSSEEvent(event))
+			if err != nil {
+				return
+			}
+		}
+
+		c.Writer.Flush()
+	}
+}
+
+func buildSSEEvent(event template_manager.BuildEvent) sse.Event {
+	data := api.TemplateBuildEvent{
+		Type:      api.TemplateBuildEventType(event.Type),
+		Timestamp: event.Timestamp,
+	}
+
+	var id string
+	switch event.Type {
+	case template_manager.BuildEventState:
+		state := api.TemplateBuildEventState(event.State)
+		data.State = &state
+	case template_manager.BuildEventPhase:
+		phase := buildEventPhases[event.Phase]
+		data.Phase = &phase
+
+		if event.Duration != nil {
+			durationMs := event.Duration.Milliseconds()
+			data.DurationMs = &durationMs
+		}
+	case template_manager.BuildEventLog:
+		data.Line = &event.Line
+		id = strconv.FormatInt(int64(event.LogsOffset), 10)
+	case template_manager.BuildEventError:
+		data.Message = &event.Error
+	}
+
+	return sse.Event{
+		Id:    id,
+		Event: string(event.Type),
+		Data:  data,
+	}
+}
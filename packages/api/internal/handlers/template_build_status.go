@@ -10,7 +10,6 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
-	"github.com/e2b-dev/infra/packages/api/internal/auth"
 	"github.com/e2b-dev/infra/packages/db/queries"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -22,8 +21,7 @@ import (
 func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context, templateID api.TemplateID, buildID api.BuildID, params api.GetTemplatesTemplateIDBuildsBuildIDStatusParams) {
 	ctx := c.Request.Context()
 
-	userID := c.Value(auth.UserIDContextKey).(uuid.UUID)
-	teams, err := a.sqlcDB.GetTeamsWithUsersTeams(ctx, userID)
+	_, teams, err := a.GetUserAndTeams(c)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, "Failed to get the default team")
 
@@ -83,6 +81,26 @@ func (a *APIStore) GetTemplatesTemplateIDBuildsBuildIDStatus(c *gin.Context, tem
 		return
 	}
 
+	// the queued build has no logs yet, report its position in the build queue instead
+	if buildInfo.BuildStatus == envbuild.StatusQueued {
+		result := api.TemplateBuild{
+			Logs:       make([]string, 0),
+			TemplateID: templateID,
+			BuildID:    buildID,
+			Status:     api.TemplateBuildStatusQueued,
+		}
+
+		position, err := a.templateManager.QueuePosition(ctx, buildUUID)
+		if err != nil {
+			zap.L().Error("Failed to get build queue position", zap.Error(err), logger.WithBuildID(buildID), logger.WithTemplateID(templateID))
+		} else {
+			result.QueuePosition = &position
+		}
+
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
 	logs := make([]string, 0)
 	l, err := a.templateManager.GetLogs(ctx, buildUUID, templateID, team.ClusterID, buildInfo.ClusterNodeID, params.LogsOffset)
 	if err != nil {
@@ -105,10 +123,14 @@ func getCorrespondingTemplateBuildStatus(s envbuild.Status) api.TemplateBuildSta
 	switch s {
 	case envbuild.StatusWaiting:
 		return api.TemplateBuildStatusWaiting
+	case envbuild.StatusQueued:
+		return api.TemplateBuildStatusQueued
 	case envbuild.StatusFailed:
 		return api.TemplateBuildStatusError
 	case envbuild.StatusUploaded:
 		return api.TemplateBuildStatusReady
+	case envbuild.StatusCancelled:
+		return api.TemplateBuildStatusCancelled
 	default:
 		return api.TemplateBuildStatusBuilding
 	}
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/audit"
 	"github.com/e2b-dev/infra/packages/api/internal/constants"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/db/queries"
@@ -180,6 +181,14 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 		return nil
 	}
 
+	rateLimits, apiError := getRateLimits(tier, body.RateLimits)
+	if apiError != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting rate limits", apiError.Err)
+		a.sendAPIStoreError(c, apiError.Code, apiError.ClientMsg)
+
+		return nil
+	}
+
 	var alias string
 	if body.Alias != nil {
 		alias, err = id.CleanEnvID(*body.Alias)
@@ -272,6 +281,10 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 		SetNillableReadyCmd(body.ReadyCmd).
 		SetNillableClusterNodeID(builderNodeID).
 		SetDockerfile(body.Dockerfile).
+		SetNillableNetworkBandwidthMibps(rateLimits.NetworkBandwidthMiBps).
+		SetNillableNetworkOps(rateLimits.NetworkOps).
+		SetNillableDiskBandwidthMibps(rateLimits.DiskBandwidthMiBps).
+		SetNillableDiskIops(rateLimits.DiskIops).
 		Exec(ctx)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, fmt.Sprintf("Error when inserting build: %s", err))
@@ -377,6 +390,8 @@ func (a *APIStore) TemplateRequestBuild(c *gin.Context, templateID api.TemplateI
 	)
 	telemetry.ReportEvent(ctx, "started updating environment")
 
+	a.auditLog.Record(c, &team.ID, api.TemplateBuildRequested, audit.ResourceTemplate, templateID, map[string]string{"build_id": buildID.String()})
+
 	var aliases []string
 
 	if alias != "" {
@@ -447,3 +462,45 @@ func getCPUAndRAM(tier *queries.Tier, cpuCount, memoryMB *int32) (int64, int64,
 
 	return cpu, ramMB, nil
 }
+
+func getRateLimits(tier *queries.Tier, rateLimits *api.RateLimits) (api.RateLimits, *api.APIError) {
+	if rateLimits == nil {
+		return api.RateLimits{}, nil
+	}
+
+	limits := []struct {
+		name      string
+		value     *int64
+		tierLimit int64
+	}{
+		{"Network bandwidth (MiB/s)", rateLimits.NetworkBandwidthMiBps, tier.NetworkBandwidthMibps},
+		{"Network operations", rateLimits.NetworkOps, tier.NetworkOps},
+		{"Disk bandwidth (MiB/s)", rateLimits.DiskBandwidthMiBps, tier.DiskBandwidthMibps},
+		{"Disk IOPS", rateLimits.DiskIops, tier.DiskIops},
+	}
+
+	for _, limit := range limits {
+		if limit.value == nil {
+			continue
+		}
+
+		if *limit.value < 0 {
+			return api.RateLimits{}, &api.APIError{
+				Err:       fmt.Errorf("%s limit must not be negative", limit.name),
+				ClientMsg: fmt.Sprintf("%s limit must not be negative", limit.name),
+				Code:      http.StatusBadRequest,
+			}
+		}
+
+		// 0 means the tier doesn't limit the value
+		if limit.tierLimit > 0 && *limit.value > limit.tierLimit {
+			return api.RateLimits{}, &api.APIError{
+				Err:       fmt.Errorf("%s limit exceeds team limits (%d)", limit.name, limit.tierLimit),
+				ClientMsg: fmt.Sprintf("%s limit can't be higher than %d (if you need to increase this limit, please contact support)", limit.name, limit.tierLimit),
+				Code:      http.StatusBadRequest,
+			}
+		}
+	}
+
+	return *rateLimits, nil
+}
@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -10,7 +10,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/posthog/posthog-go"
 	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/api/internal/api"
@@ -26,7 +25,6 @@ import (
 // PostTemplatesTemplateIDBuildsBuildID triggers a new build after the user pushes the Docker image to the registry
 func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, templateID api.TemplateID, buildID api.BuildID) {
 	ctx := c.Request.Context()
-	span := trace.SpanFromContext(ctx)
 	
 	zap.L().Info("开始处理构建请求", 
 		zap.String("templateID", templateID), 
@@ -90,6 +88,7 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		zap.Int("buildsCount", len(envDB.Edges.Builds)))
 
 	var team *queries.Team
+	var tier *queries.Tier
 	// Check if the user has access to the template
 	zap.L().Info("开始检查用户访问权限", 
 		zap.String("userID", userID.String()), 
@@ -97,6 +96,7 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 	for _, t := range teams {
 		if t.Team.ID == envDB.TeamID {
 			team = &t.Team
+			tier = &t.Tier
 			break
 		}
 	}
@@ -131,7 +131,7 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		Query().
 		Where(
 			envbuild.EnvID(envDB.ID),
-			envbuild.StatusIn(envbuild.StatusWaiting, envbuild.StatusBuilding),
+			envbuild.StatusIn(envbuild.StatusWaiting, envbuild.StatusQueued, envbuild.StatusBuilding),
 			envbuild.IDNotIn(buildUUID),
 		).
 		All(ctx)
@@ -166,20 +166,22 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 			return
 		}
 		telemetry.ReportEvent(ctx, "canceled running builds")
+
+		// The queued builds would be otherwise still started by the queue dispatcher
+		err = a.db.Client.EnvBuild.Update().Where(
+			envbuild.EnvID(envDB.ID),
+			envbuild.StatusEQ(envbuild.StatusQueued),
+			envbuild.IDNEQ(buildUUID),
+		).SetStatus(envbuild.StatusCancelled).SetFinishedAt(time.Now()).Exec(ctx)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusInternalServerError, "Error during template build cancel request")
+			telemetry.ReportCriticalError(ctx, "error when cancelling queued builds", err)
+			return
+		}
 	}
 
 	startTime := time.Now()
 	build := envDB.Edges.Builds[0]
-	var startCmd string
-	if build.StartCmd != nil {
-		startCmd = *build.StartCmd
-	}
-
-	var readyCmd string
-	if build.ReadyCmd != nil {
-		readyCmd = *build.ReadyCmd
-	}
-
 	// only waiting builds can be triggered
 	if build.Status != envbuild.StatusWaiting {
 		a.sendAPIStoreError(c, http.StatusBadRequest, "build is not in waiting state")
@@ -194,116 +196,19 @@ func (a *APIStore) PostTemplatesTemplateIDBuildsBuildID(c *gin.Context, template
 		return
 	}
 
-	// Call the Template Manager to build the environment
-	zap.L().Info("开始创建模板", 
-		zap.String("templateID", templateID), 
-		zap.String("buildID", buildUUID.String()), 
-		zap.String("kernelVersion", build.KernelVersion), 
-		zap.String("firecrackerVersion", build.FirecrackerVersion), 
-		zap.Int64("vcpu", build.Vcpu), 
-		zap.Int64("ramMB", build.RAMMB))
-	buildErr := a.templateManager.CreateTemplate(
-		a.Tracer,
-		ctx,
-		templateID,
-		buildUUID,
-		build.KernelVersion,
-		build.FirecrackerVersion,
-		startCmd,
-		build.Vcpu,
-		build.FreeDiskSizeMB,
-		build.RAMMB,
-		readyCmd,
-		team.ClusterID,
-		build.ClusterNodeID,
-	)
-
-	if buildErr != nil {
-		zap.L().Error("创建模板失败", 
-			zap.String("templateID", templateID), 
-			zap.String("buildID", buildUUID.String()), 
-			zap.Error(buildErr))
-		telemetry.ReportCriticalError(ctx, "build failed", buildErr, telemetry.WithTemplateID(templateID))
-		err = a.templateManager.SetStatus(
-			ctx,
-			templateID,
-			buildUUID,
-			envbuild.StatusFailed,
-			fmt.Sprintf("error when building env: %s", buildErr),
-		)
-		if err != nil {
-			zap.L().Error("设置构建状态失败", 
-				zap.String("templateID", templateID), 
-				zap.String("buildID", buildUUID.String()), 
-				zap.Error(err))
-			telemetry.ReportCriticalError(ctx, "error when setting build status", err)
-		}
-
+	// Add the build to the build queue, the queue dispatcher starts it once both the team and a builder have capacity for it
+	err = a.templateManager.EnqueueBuild(ctx, templateID, buildUUID, tier.BuildPriority)
+	if errors.Is(err, template_manager.ErrBuildNotWaiting) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "build is not in waiting state")
+		telemetry.ReportError(ctx, "build is not in waiting state", err, telemetry.WithTemplateID(templateID))
 		return
-	}
-	
-	zap.L().Info("成功创建模板", 
-		zap.String("templateID", templateID), 
-		zap.String("buildID", buildUUID.String()))
-
-	// status building must be set after build is triggered because then
-	// it's possible build status job will be triggered before build cache on template manager is created and build will fail
-	zap.L().Info("开始设置构建状态为building", 
-		zap.String("templateID", templateID), 
-		zap.String("buildID", buildUUID.String()))
-	err = a.templateManager.SetStatus(
-		ctx,
-		templateID,
-		buildUUID,
-		envbuild.StatusBuilding,
-		"starting build",
-	)
-	if err != nil {
-		zap.L().Error("设置构建状态失败", 
-			zap.String("templateID", templateID), 
-			zap.String("buildID", buildUUID.String()), 
-			zap.Error(err))
-		telemetry.ReportCriticalError(ctx, "error when setting build status", err)
+	} else if err != nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when adding the build to the build queue")
+		telemetry.ReportCriticalError(ctx, "error when adding build to the build queue", err, telemetry.WithTemplateID(templateID))
 		return
 	}
-	
-	zap.L().Info("成功设置构建状态为building", 
-		zap.String("templateID", templateID), 
-		zap.String("buildID", buildUUID.String()))
-
-	telemetry.ReportEvent(ctx, "created new environment", telemetry.WithTemplateID(templateID))
-
-	// Do not wait for global build sync trigger it immediately
-	zap.L().Info("开始触发后台构建同步", 
-		zap.String("templateID", templateID), 
-		zap.String("buildID", buildUUID.String()))
-	go func() {
-		buildContext, buildSpan := a.Tracer.Start(
-			trace.ContextWithSpanContext(context.Background(), span.SpanContext()),
-			"template-background-build-env",
-		)
-		defer buildSpan.End()
-
-		zap.L().Info("开始后台构建状态同步", 
-			zap.String("templateID", templateID), 
-			zap.String("buildID", buildUUID.String()))
-		err := a.templateManager.BuildStatusSync(buildContext, buildUUID, templateID, team.ClusterID, build.ClusterNodeID)
-		if err != nil {
-			zap.L().Error("后台构建状态同步失败", 
-				zap.String("templateID", templateID), 
-				zap.String("buildID", buildUUID.String()), 
-				zap.Error(err))
-		} else {
-			zap.L().Info("后台构建状态同步成功", 
-				zap.String("templateID", templateID), 
-				zap.String("buildID", buildUUID.String()))
-		}
 
-		// Invalidate the cache
-		zap.L().Info("开始使模板缓存失效", zap.String("templateID", templateID))
-		a.templateCache.Invalidate(templateID)
-		zap.L().Info("成功使模板缓存失效", zap.String("templateID", templateID))
-	}()
+	telemetry.ReportEvent(ctx, "queued environment build", telemetry.WithTemplateID(templateID))
 
 	a.posthog.CreateAnalyticsUserEvent(userID.String(), team.ID.String(), "built environment", posthog.NewProperties().
 		Set("user_id", userID).
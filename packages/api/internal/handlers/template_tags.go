@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/audit"
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// GetTemplatesTemplateIDVersions lists the successful builds of the template, the builds can be spawned with "template@buildID".
+func (a *APIStore) GetTemplatesTemplateIDVersions(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	templateID, ok := a.getTeamTemplateID(c, aliasOrTemplateID)
+	if !ok {
+		return
+	}
+
+	builds, err := a.db.GetEnvBuildsWithTags(ctx, templateID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting template versions", err, telemetry.WithTemplateID(templateID))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting template versions")
+
+		return
+	}
+
+	versions := make([]api.TemplateVersion, 0, len(builds))
+	for _, build := range builds {
+		tags := make([]string, 0, len(build.Edges.Tags))
+		for _, t := range build.Edges.Tags {
+			tags = append(tags, t.Tag)
+		}
+
+		versions = append(versions, api.TemplateVersion{
+			BuildID:    build.ID.String(),
+			CreatedAt:  build.CreatedAt,
+			FinishedAt: build.FinishedAt,
+			Tags:       tags,
+		})
+	}
+
+	c.JSON(http.StatusOK, versions)
+}
+
+// PutTemplatesTemplateIDTagsTag points the tag to the build, it's used both to promote a new build and to roll back to an older one.
+func (a *APIStore) PutTemplatesTemplateIDTagsTag(c *gin.Context, aliasOrTemplateID api.TemplateID, tag api.Tag) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	body, err := utils.ParseBody[api.TemplateTagUpdateRequest](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+
+		return
+	}
+
+	buildID, err := uuid.Parse(body.BuildID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid build ID: %s", body.BuildID))
+
+		return
+	}
+
+	cleanedTag, ok := a.cleanTemplateTag(c, tag)
+	if !ok {
+		return
+	}
+
+	templateID, ok := a.getTeamTemplateID(c, aliasOrTemplateID)
+	if !ok {
+		return
+	}
+
+	t, err := a.db.SetEnvBuildTag(ctx, templateID, cleanedTag, buildID)
+	if errors.Is(err, db.TemplateBuildNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Successful build '%s' of the template '%s' not found", buildID, templateID))
+
+		return
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when setting template tag", err, telemetry.WithTemplateID(templateID))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting template tag")
+
+		return
+	}
+
+	a.auditLog.Record(c, &teamID, api.TemplateTagUpdated, audit.ResourceTemplate, templateID, map[string]string{"tag": cleanedTag, "build_id": buildID.String()})
+
+	zap.L().Info("Updated template tag", logger.WithTemplateID(templateID), logger.WithBuildID(buildID.String()), zap.String("tag", cleanedTag))
+
+	c.JSON(http.StatusOK, api.TemplateTag{
+		Tag:       t.Tag,
+		BuildID:   t.BuildID.String(),
+		UpdatedAt: t.UpdatedAt,
+	})
+}
+
+// DeleteTemplatesTemplateIDTagsTag removes the tag, the build it pointed to is no longer protected from deletion.
+func (a *APIStore) DeleteTemplatesTemplateIDTagsTag(c *gin.Context, aliasOrTemplateID api.TemplateID, tag api.Tag) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	cleanedTag, ok := a.cleanTemplateTag(c, tag)
+	if !ok {
+		return
+	}
+
+	templateID, ok := a.getTeamTemplateID(c, aliasOrTemplateID)
+	if !ok {
+		return
+	}
+
+	err := a.db.DeleteEnvBuildTag(ctx, templateID, cleanedTag)
+	if errors.Is(err, db.TemplateTagNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Tag '%s' of the template '%s' not found", cleanedTag, templateID))
+
+		return
+	} else if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when deleting template tag", err, telemetry.WithTemplateID(templateID))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting template tag")
+
+		return
+	}
+
+	a.auditLog.Record(c, &teamID, api.TemplateTagDeleted, audit.ResourceTemplate, templateID, map[string]string{"tag": cleanedTag})
+
+	zap.L().Info("Deleted template tag", logger.WithTemplateID(templateID), zap.String("tag", cleanedTag))
+
+	c.Status(http.StatusNoContent)
+}
+
+// cleanTemplateTag validates the tag, the "latest" tag always resolves to the latest successful build and can't be set.
+// It sends the error response if it fails.
+func (a *APIStore) cleanTemplateTag(c *gin.Context, tag string) (string, bool) {
+	cleanedTag, err := id.CleanTag(tag)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid tag: %s", tag))
+
+		return "", false
+	}
+
+	if cleanedTag == id.LatestTag {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Tag '%s' is reserved for the latest build", id.LatestTag))
+
+		return "", false
+	}
+
+	return cleanedTag, true
+}
+
+// getTeamTemplateID resolves the template owned by the team, it sends the error response if it fails.
+func (a *APIStore) getTeamTemplateID(c *gin.Context, aliasOrTemplateID api.TemplateID) (string, bool) {
+	ctx := c.Request.Context()
+
+	teamInfo := a.GetTeamInfo(c)
+
+	cleanedAliasOrEnvID, err := id.CleanEnvID(aliasOrTemplateID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid template ID: %s", aliasOrTemplateID))
+
+		return "", false
+	}
+
+	template, _, apiErr := a.templateCache.Get(ctx, cleanedAliasOrEnvID, teamInfo.Team.ID, false)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "error when getting template", apiErr.Err)
+
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return "", false
+	}
+
+	if !auth.APIKeyAllowsTemplate(teamInfo.APIKey, templateIDAndAliases(template.TemplateID, template.Aliases)...) {
+		a.sendAPIStoreError(c, http.StatusForbidden, fmt.Sprintf("API key isn't allowed to access the template '%s'", aliasOrTemplateID))
+
+		return "", false
+	}
+
+	return template.TemplateID, true
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/orchestrator"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	warmPoolRefillInterval = 10 * time.Second
+
+	// warmPoolRefillLockID is the Postgres advisory lock held by the replica refilling the warm pools
+	warmPoolRefillLockID int64 = 0x7761726d
+)
+
+func (a *APIStore) GetTemplatesTemplateIDWarmPool(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	templateID, ok := a.getWarmPoolTemplateID(c, aliasOrTemplateID)
+	if !ok {
+		return
+	}
+
+	size, err := a.db.GetWarmPoolSize(ctx, teamID, templateID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting warm pool", err, telemetry.WithTemplateID(templateID))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting warm pool")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, api.WarmPool{
+		Size:  size,
+		Ready: int32(a.orchestrator.WarmPoolReady(teamID, templateID)),
+	})
+}
+
+func (a *APIStore) PutTemplatesTemplateIDWarmPool(c *gin.Context, aliasOrTemplateID api.TemplateID) {
+	ctx := c.Request.Context()
+
+	team := a.GetTeamInfo(c)
+
+	body, err := utils.ParseBody[api.WarmPoolUpdateRequest](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+
+		return
+	}
+
+	if body.Size < 0 || int64(body.Size) > team.Tier.ConcurrentInstances {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Warm pool size must be between 0 and %d", team.Tier.ConcurrentInstances))
+
+		return
+	}
+
+	templateID, ok := a.getWarmPoolTemplateID(c, aliasOrTemplateID)
+	if !ok {
+		return
+	}
+
+	err = a.db.SetWarmPoolSize(ctx, team.Team.ID, templateID, body.Size)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when setting warm pool", err, telemetry.WithTemplateID(templateID))
+
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when setting warm pool")
+
+		return
+	}
+
+	zap.L().Info("Updated warm pool", logger.WithTemplateID(templateID), logger.WithTeamID(team.Team.ID.String()), zap.Int32("size", body.Size))
+
+	c.JSON(http.StatusOK, api.WarmPool{
+		Size:  body.Size,
+		Ready: int32(a.orchestrator.WarmPoolReady(team.Team.ID, templateID)),
+	})
+}
+
+// getWarmPoolTemplateID resolves the template the team can start sandboxes from, it sends the error response if it fails.
+func (a *APIStore) getWarmPoolTemplateID(c *gin.Context, aliasOrTemplateID api.TemplateID) (string, bool) {
+	ctx := c.Request.Context()
+
+	cleanedAliasOrEnvID, err := id.CleanEnvID(aliasOrTemplateID)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Invalid template ID: %s", aliasOrTemplateID))
+
+		return "", false
+	}
+
+	template, _, apiErr := a.templateCache.Get(ctx, cleanedAliasOrEnvID, a.GetTeamInfo(c).Team.ID, true)
+	if apiErr != nil {
+		telemetry.ReportError(ctx, "error when getting template", apiErr.Err)
+
+		a.sendAPIStoreError(c, apiErr.Code, apiErr.ClientMsg)
+
+		return "", false
+	}
+
+	return template.TemplateID, true
+}
+
+// keepWarmPools periodically loads the warm pools the sandboxes are claimed from, one of the replicas refills them.
+func (a *APIStore) keepWarmPools(ctx context.Context) {
+	ticker := time.NewTicker(warmPoolRefillInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			zap.L().Info("Stopping warm pools refill")
+
+			return
+		case <-ticker.C:
+			a.refillWarmPools(ctx)
+		}
+	}
+}
+
+func (a *APIStore) refillWarmPools(ctx context.Context) {
+	targets, err := a.loadWarmPools(ctx)
+	if err != nil {
+		zap.L().Error("Error loading warm pools", zap.Error(err))
+
+		return
+	}
+
+	// Every replica claims the pooled sandboxes, they are synced from the nodes
+	a.orchestrator.SetWarmPools(targets)
+
+	// Only one replica refills the warm pools at a time, the lock is released with the transaction
+	txDB, tx, err := a.sqlcDB.WithTx(ctx)
+	if err != nil {
+		zap.L().Error("Error starting warm pools refill transaction", zap.Error(err))
+
+		return
+	}
+	defer tx.Rollback(context.WithoutCancel(ctx))
+
+	locked, err := txDB.TryAdvisoryXactLock(ctx, warmPoolRefillLockID)
+	if err != nil {
+		zap.L().Error("Error locking warm pools refill", zap.Error(err))
+
+		return
+	}
+
+	if !locked {
+		zap.L().Debug("Warm pools are being refilled by another replica")
+
+		return
+	}
+
+	a.orchestrator.RefillWarmPools(ctx)
+}
+
+// loadWarmPools returns the warm pools with the current builds of their templates.
+func (a *APIStore) loadWarmPools(ctx context.Context) ([]orchestrator.WarmPoolTarget, error) {
+	pools, err := a.db.GetWarmPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]orchestrator.WarmPoolTarget, 0, len(pools))
+	for _, pool := range pools {
+		team := pool.Edges.Team
+		if team == nil || team.Edges.TeamTier == nil || team.IsBlocked || team.IsBanned {
+			continue
+		}
+
+		_, build, apiErr := a.templateCache.Get(ctx, pool.EnvID, team.ID, true)
+		if apiErr != nil {
+			zap.L().Warn("Skipping warm pool, template isn't available", logger.WithTemplateID(pool.EnvID), logger.WithTeamID(team.ID.String()), zap.Error(apiErr.Err))
+
+			continue
+		}
+
+		targets = append(targets, orchestrator.WarmPoolTarget{
+			Team:  authcache.AuthTeamInfo{Team: team, Tier: team.Edges.TeamTier},
+			Build: *build,
+			Size:  int(pool.Size),
+		})
+	}
+
+	return targets, nil
+}
@@ -17,10 +17,8 @@ import (
 func (a *APIStore) GetTemplates(c *gin.Context, params api.GetTemplatesParams) {
 	ctx := c.Request.Context()
 
-	userID := c.Value(auth.UserIDContextKey).(uuid.UUID)
-
 	var team *queries.Team
-	teams, err := a.sqlcDB.GetTeamsWithUsersTeams(ctx, userID)
+	userID, teams, err := a.GetUserAndTeams(c)
 	if err != nil {
 		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting teams")
 
@@ -90,8 +88,14 @@ func (a *APIStore) GetTemplates(c *gin.Context, params api.GetTemplatesParams) {
 	properties := a.posthog.GetPackageToPosthogProperties(&c.Request.Header)
 	a.posthog.CreateAnalyticsUserEvent(userID.String(), team.ID.String(), "listed environments", properties)
 
+	apiKey := a.getAPIKey(c)
+
 	templates := make([]*api.Template, 0, len(envs))
 	for _, item := range envs {
+		if !auth.APIKeyAllowsTemplate(apiKey, templateIDAndAliases(item.TemplateID, item.Aliases)...) {
+			continue
+		}
+
 		var createdBy *api.TeamUser
 		if item.CreatedBy != nil {
 			createdBy = &api.TeamUser{
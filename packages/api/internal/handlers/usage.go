@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/chdb"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+const defaultUsagePeriod = 30 * 24 * time.Hour
+
+func (a *APIStore) GetTeamsTeamIDUsage(c *gin.Context, teamID api.TeamID, params api.GetTeamsTeamIDUsageParams) {
+	ctx := c.Request.Context()
+
+	if !a.checkTeamMember(c, teamID) {
+		return
+	}
+
+	if a.clickhouseStore == nil {
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Usage metering is not enabled")
+
+		return
+	}
+
+	to := time.Now()
+	if params.To != nil {
+		to = *params.To
+	}
+
+	from := to.Add(-defaultUsagePeriod)
+	if params.From != nil {
+		from = *params.From
+	}
+
+	if !from.Before(to) {
+		a.sendAPIStoreError(c, http.StatusBadRequest, "The start of the period must be before its end")
+
+		return
+	}
+
+	groupBy := api.UsageGroupByTemplate
+	if params.GroupBy != nil {
+		groupBy = *params.GroupBy
+	}
+
+	usageDB, err := a.clickhouseStore.QueryTeamUsage(ctx, teamID.String(), from.UTC(), to.UTC(), chdb.UsageGroupBy(groupBy))
+	if err != nil {
+		zap.L().Error("Error getting team usage", logger.WithTeamID(teamID.String()), zap.Error(err))
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting team usage")
+
+		return
+	}
+
+	usage := make([]api.TeamUsage, len(usageDB))
+	for i, u := range usageDB {
+		usage[i] = api.TeamUsage{
+			Runs:            int64(u.Runs),
+			DurationSeconds: u.DurationSeconds,
+			VcpuSeconds:     u.VCPUSeconds,
+			RamMiBSeconds:   u.RAMMiBSeconds,
+			DiskMiBSeconds:  u.DiskMiBSeconds,
+		}
+
+		switch groupBy {
+		case api.UsageGroupByDay:
+			usage[i].Day = &openapi_types.Date{Time: u.Day}
+		case api.UsageGroupByTemplate:
+			templateID := u.TemplateID
+			usage[i].TemplateID = &templateID
+		}
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const defaultWebhookDeliveriesLimit = 100
+
+func (a *APIStore) GetWebhooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	webhooksDB, err := a.db.GetWebhooks(ctx, teamID)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting webhooks", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting webhooks")
+
+		return
+	}
+
+	webhooks := make([]api.Webhook, len(webhooksDB))
+	for i, w := range webhooksDB {
+		webhooks[i] = webhookFromDB(w)
+	}
+
+	c.JSON(http.StatusOK, webhooks)
+}
+
+func (a *APIStore) PostWebhooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	body, err := utils.ParseBody[api.NewWebhook](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	err = webhooks.ValidateURL(body.Url)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	secret, err := keys.GenerateKey(keys.WebhookSecretPrefix)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when generating webhook secret", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating webhook")
+
+		return
+	}
+
+	w, err := a.db.CreateWebhook(ctx, teamID, body.Url, secret.PrefixedRawValue, webhookEventsToDB(body.Events))
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when creating webhook", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when creating webhook")
+
+		return
+	}
+
+	zap.L().Info("Created webhook", logger.WithTeamID(teamID.String()), zap.String("webhook_id", w.ID.String()))
+
+	c.JSON(http.StatusCreated, api.CreatedWebhook{
+		Id:        w.ID,
+		Url:       w.URL,
+		Events:    body.Events,
+		Enabled:   w.Enabled,
+		Secret:    w.Secret,
+		CreatedAt: w.CreatedAt,
+	})
+}
+
+func (a *APIStore) PatchWebhooksWebhookID(c *gin.Context, webhookID api.WebhookID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	body, err := utils.ParseBody[api.WebhookUpdateRequest](ctx, c)
+	if err != nil {
+		a.sendAPIStoreError(c, http.StatusBadRequest, fmt.Sprintf("Error when parsing request: %s", err))
+
+		telemetry.ReportCriticalError(ctx, "error when parsing request", err)
+
+		return
+	}
+
+	if body.Url != nil {
+		err = webhooks.ValidateURL(*body.Url)
+		if err != nil {
+			a.sendAPIStoreError(c, http.StatusBadRequest, err.Error())
+
+			return
+		}
+	}
+
+	var events []string
+	if body.Events != nil {
+		events = webhookEventsToDB(*body.Events)
+	}
+
+	w, err := a.db.UpdateWebhook(ctx, teamID, webhookID, body.Url, events, body.Enabled)
+	if errors.Is(err, db.WebhookNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Webhook '%s' not found", webhookID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when updating webhook", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when updating webhook")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookFromDB(w))
+}
+
+func (a *APIStore) DeleteWebhooksWebhookID(c *gin.Context, webhookID api.WebhookID) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	err := a.db.DeleteWebhook(ctx, teamID, webhookID)
+	if errors.Is(err, db.WebhookNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Webhook '%s' not found", webhookID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when deleting webhook", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when deleting webhook")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a *APIStore) GetWebhooksWebhookIDDeliveries(c *gin.Context, webhookID api.WebhookID, params api.GetWebhooksWebhookIDDeliveriesParams) {
+	ctx := c.Request.Context()
+
+	teamID := a.GetTeamInfo(c).Team.ID
+
+	// Check the webhook belongs to the team
+	_, err := a.db.GetWebhook(ctx, teamID, webhookID)
+	if errors.Is(err, db.WebhookNotFound{}) {
+		a.sendAPIStoreError(c, http.StatusNotFound, fmt.Sprintf("Webhook '%s' not found", webhookID))
+
+		return
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting webhook", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting webhook deliveries")
+
+		return
+	}
+
+	limit := defaultWebhookDeliveriesLimit
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+
+	deliveriesDB, err := a.db.GetWebhookDeliveries(ctx, webhookID, limit)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error when getting webhook deliveries", err)
+		a.sendAPIStoreError(c, http.StatusInternalServerError, "Error when getting webhook deliveries")
+
+		return
+	}
+
+	deliveries := make([]api.WebhookDelivery, len(deliveriesDB))
+	for i, d := range deliveriesDB {
+		deliveries[i] = api.WebhookDelivery{
+			Id:         d.ID,
+			EventID:    d.EventID,
+			EventType:  api.WebhookEventType(d.EventType),
+			SandboxID:  d.SandboxID,
+			Attempt:    d.Attempt,
+			Success:    d.Success,
+			StatusCode: d.StatusCode,
+			Error:      d.Error,
+			CreatedAt:  d.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+func webhookEventsToDB(events []api.WebhookEventType) []string {
+	result := make([]string, len(events))
+	for i, event := range events {
+		result[i] = string(event)
+	}
+
+	return result
+}
+
+func webhookFromDB(w *models.Webhook) api.Webhook {
+	events := make([]api.WebhookEventType, len(w.Events))
+	for i, event := range w.Events {
+		events[i] = api.WebhookEventType(event)
+	}
+
+	return api.Webhook{
+		Id:        w.ID,
+		Url:       w.URL,
+		Events:    events,
+		Enabled:   w.Enabled,
+		CreatedAt: w.CreatedAt,
+	}
+}
@@ -0,0 +1,53 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+)
+
+// MemoryStore keeps the records in memory, it's used when the API runs without Redis.
+// The retries handled by another API instance aren't deduplicated.
+type MemoryStore struct {
+	records *ttlcache.Cache[string, Record]
+}
+
+func NewMemoryStore() *MemoryStore {
+	records := ttlcache.New[string, Record]()
+	go records.Start()
+
+	return &MemoryStore{records: records}
+}
+
+func (s *MemoryStore) Claim(_ context.Context, key string, record Record, ttl time.Duration) (Record, bool, error) {
+	item, loaded := s.records.GetOrSet(key, record, ttlcache.WithTTL[string, Record](ttl))
+	if loaded {
+		return item.Value(), false, nil
+	}
+
+	return record, true, nil
+}
+
+func (s *MemoryStore) Complete(_ context.Context, key string, record Record, ttl time.Duration) error {
+	s.records.Set(key, record, ttl)
+
+	return nil
+}
+
+func (s *MemoryStore) Refresh(_ context.Context, key string, ttl time.Duration) error {
+	item := s.records.Get(key)
+	if item == nil || item.Value().Completed() {
+		return nil
+	}
+
+	s.records.Set(key, item.Value(), ttl)
+
+	return nil
+}
+
+func (s *MemoryStore) Release(_ context.Context, key string) error {
+	s.records.Delete(key)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore_Claim(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	key := Key(uuid.New(), "PostSandboxes", "key")
+	record := Record{BodyHash: "hash", SandboxID: "sandbox-1"}
+
+	stored, claimed, err := store.Claim(ctx, key, record, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, record, stored)
+
+	// The retry gets the pending record of the first request
+	stored, claimed, err = store.Claim(ctx, key, Record{BodyHash: "hash", SandboxID: "sandbox-2"}, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.Equal(t, "sandbox-1", stored.SandboxID)
+	assert.False(t, stored.Completed())
+
+	record.StatusCode = 201
+	record.Response = []byte(`{"sandboxID":"sandbox-1"}`)
+	require.NoError(t, store.Complete(ctx, key, record, time.Minute))
+
+	stored, claimed, err = store.Claim(ctx, key, Record{BodyHash: "hash", SandboxID: "sandbox-3"}, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.True(t, stored.Completed())
+	assert.Equal(t, record, stored)
+}
+
+func TestMemoryStore_Release(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	key := Key(uuid.New(), "PostSandboxes", "key")
+
+	_, claimed, err := store.Claim(ctx, key, Record{SandboxID: "sandbox-1"}, time.Minute)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	require.NoError(t, store.Release(ctx, key))
+
+	stored, claimed, err := store.Claim(ctx, key, Record{SandboxID: "sandbox-2"}, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, "sandbox-2", stored.SandboxID)
+}
+
+func TestKey_ScopedToTeam(t *testing.T) {
+	assert.NotEqual(t, Key(uuid.New(), "PostSandboxes", "key"), Key(uuid.New(), "PostSandboxes", "key"))
+}
+
+func TestHashBody_IgnoresFormatting(t *testing.T) {
+	type body struct {
+		TemplateID string            `json:"templateID"`
+		Metadata   map[string]string `json:"metadata"`
+	}
+
+	first, err := HashBody(body{TemplateID: "base", Metadata: map[string]string{"a": "1", "b": "2"}})
+	require.NoError(t, err)
+
+	second, err := HashBody(body{TemplateID: "base", Metadata: map[string]string{"b": "2", "a": "1"}})
+	require.NoError(t, err)
+
+	other, err := HashBody(body{TemplateID: "other"})
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first, other)
+}
+
+func TestMemoryStore_Refresh(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	key := Key(uuid.New(), "PostSandboxes", "key")
+
+	_, claimed, err := store.Claim(ctx, key, Record{SandboxID: "sandbox-1"}, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	require.NoError(t, store.Refresh(ctx, key, time.Minute))
+	time.Sleep(100 * time.Millisecond)
+
+	stored, claimed, err := store.Claim(ctx, key, Record{SandboxID: "sandbox-2"}, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.Equal(t, "sandbox-1", stored.SandboxID)
+}
@@ -0,0 +1,92 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// claimAttempts covers the claimed key expiring between the failed SETNX and the GET.
+const claimAttempts = 2
+
+// RedisStore shares the records between the API instances.
+type RedisStore struct {
+	client redis.UniversalClient
+}
+
+func NewRedisStore(client redis.UniversalClient) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func (s *RedisStore) Claim(ctx context.Context, key string, record Record, ttl time.Duration) (Record, bool, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return Record{}, false, fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+
+	for range claimAttempts {
+		claimed, err := s.client.SetNX(ctx, key, data, ttl).Result()
+		if err != nil {
+			return Record{}, false, fmt.Errorf("failed to claim idempotency key: %w", err)
+		}
+
+		if claimed {
+			return record, true, nil
+		}
+
+		stored, err := s.client.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+
+		if err != nil {
+			return Record{}, false, fmt.Errorf("failed to get idempotency record: %w", err)
+		}
+
+		var storedRecord Record
+		err = json.Unmarshal(stored, &storedRecord)
+		if err != nil {
+			return Record{}, false, fmt.Errorf("failed to unmarshal idempotency record: %w", err)
+		}
+
+		return storedRecord, false, nil
+	}
+
+	return Record{}, false, fmt.Errorf("failed to claim idempotency key after %d attempts", claimAttempts)
+}
+
+func (s *RedisStore) Complete(ctx context.Context, key string, record Record, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+
+	err = s.client.Set(ctx, key, data, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to store idempotency record: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RedisStore) Refresh(ctx context.Context, key string, ttl time.Duration) error {
+	err := s.client.Expire(ctx, key, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to refresh idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	err := s.client.Del(ctx, key).Err()
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
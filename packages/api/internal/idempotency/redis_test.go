@@ -0,0 +1,99 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedisStore(t *testing.T) (*RedisStore, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewRedisStore(client), server
+}
+
+func TestRedisStore_Claim(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestRedisStore(t)
+
+	key := Key(uuid.New(), "PostSandboxes", "key")
+	record := Record{BodyHash: "hash", SandboxID: "sandbox-1"}
+
+	stored, claimed, err := store.Claim(ctx, key, record, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, record, stored)
+
+	// The retry gets the pending record of the first request
+	stored, claimed, err = store.Claim(ctx, key, Record{BodyHash: "hash", SandboxID: "sandbox-2"}, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.Equal(t, "sandbox-1", stored.SandboxID)
+	assert.False(t, stored.Completed())
+
+	record.StatusCode = 201
+	record.Response = []byte(`{"sandboxID":"sandbox-1"}`)
+	require.NoError(t, store.Complete(ctx, key, record, time.Minute))
+
+	stored, claimed, err = store.Claim(ctx, key, Record{BodyHash: "hash", SandboxID: "sandbox-3"}, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	assert.True(t, stored.Completed())
+	assert.Equal(t, record, stored)
+}
+
+func TestRedisStore_Release(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestRedisStore(t)
+
+	key := Key(uuid.New(), "PostSandboxes", "key")
+
+	_, claimed, err := store.Claim(ctx, key, Record{SandboxID: "sandbox-1"}, time.Minute)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	require.NoError(t, store.Release(ctx, key))
+
+	stored, claimed, err := store.Claim(ctx, key, Record{SandboxID: "sandbox-2"}, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, "sandbox-2", stored.SandboxID)
+}
+
+func TestRedisStore_Expiration(t *testing.T) {
+	ctx := context.Background()
+	store, server := newTestRedisStore(t)
+
+	key := Key(uuid.New(), "PostSandboxes", "key")
+
+	_, claimed, err := store.Claim(ctx, key, Record{SandboxID: "sandbox-1"}, time.Minute)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	// The refreshed key outlives its original TTL
+	server.FastForward(30 * time.Second)
+	require.NoError(t, store.Refresh(ctx, key, time.Minute))
+	server.FastForward(45 * time.Second)
+
+	_, claimed, err = store.Claim(ctx, key, Record{SandboxID: "sandbox-2"}, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	// The pending key of the stopped request expires
+	server.FastForward(time.Minute)
+
+	stored, claimed, err := store.Claim(ctx, key, Record{SandboxID: "sandbox-3"}, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, "sandbox-3", stored.SandboxID)
+}
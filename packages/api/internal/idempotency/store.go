@@ -0,0 +1,68 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxKeyLength bounds the size of the keys kept in the store.
+	MaxKeyLength = 255
+
+	// PendingTTL limits how long the key stays claimed by a request that didn't finish,
+	// e.g. because the API instance handling it was stopped.
+	// The request in progress refreshes the TTL every PendingRefreshInterval, so it doesn't expire during a slow create.
+	PendingTTL             = 5 * time.Minute
+	PendingRefreshInterval = time.Minute
+	// CompletedTTL is how long the response is kept for the retries.
+	CompletedTTL = 24 * time.Hour
+)
+
+// Record is the request the idempotency key was first used with.
+type Record struct {
+	BodyHash  string `json:"bodyHash"`
+	SandboxID string `json:"sandboxID"`
+
+	// The response is empty while the request is in progress.
+	StatusCode int             `json:"statusCode,omitempty"`
+	Response   json.RawMessage `json:"response,omitempty"`
+}
+
+func (r Record) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// Store keeps the records of the idempotency keys.
+type Store interface {
+	// Claim stores the record if the key isn't used yet, otherwise it returns the stored record.
+	Claim(ctx context.Context, key string, record Record, ttl time.Duration) (stored Record, claimed bool, err error)
+	// Complete replaces the record of the claimed key with the record containing the response.
+	Complete(ctx context.Context, key string, record Record, ttl time.Duration) error
+	// Refresh extends the TTL of the claimed key while its request is in progress.
+	Refresh(ctx context.Context, key string, ttl time.Duration) error
+	// Release removes the key, so the request can be retried.
+	Release(ctx context.Context, key string) error
+}
+
+// Key scopes the idempotency key to the team and the operation.
+func Key(teamID uuid.UUID, operation, key string) string {
+	return fmt.Sprintf("idempotency:%s:%s:%s", teamID, operation, key)
+}
+
+// HashBody returns the hash of the parsed request body, so the formatting of the JSON doesn't matter.
+func HashBody(body any) (string, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the request body: %w", err)
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+)
+
+// idleBucketExpiration is the time after which the unused in-memory buckets are removed.
+const idleBucketExpiration = 10 * time.Minute
+
+// Limit is the token bucket configuration, the bucket refills Rate tokens per second up to Burst tokens.
+type Limit struct {
+	Rate  float64
+	Burst int64
+}
+
+// Limiter takes a token from the bucket identified by the key.
+// When the bucket is empty, it returns the time after which the next token is available.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (allowed bool, retryAfter time.Duration, err error)
+}
+
+type bucket struct {
+	mu        sync.Mutex
+	tokens    float64
+	updatedAt time.Time
+}
+
+// take refills the bucket for the time elapsed since the last update and takes a token if there is one.
+func (b *bucket) take(now time.Time, limit Limit) (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	elapsed := max(now.Sub(b.updatedAt).Seconds(), 0)
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+	b.updatedAt = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+
+		return true, 0
+	}
+
+	return false, time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+}
+
+// MemoryLimiter keeps the buckets in memory, it's used when the API runs without Redis.
+type MemoryLimiter struct {
+	buckets *ttlcache.Cache[string, *bucket]
+	now     func() time.Time
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	buckets := ttlcache.New(ttlcache.WithTTL[string, *bucket](idleBucketExpiration))
+	go buckets.Start()
+
+	return &MemoryLimiter{buckets: buckets, now: time.Now}
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	now := l.now()
+
+	item, _ := l.buckets.GetOrSet(key, &bucket{tokens: float64(limit.Burst), updatedAt: now})
+	allowed, retryAfter := item.Value().take(now, limit)
+
+	return allowed, retryAfter, nil
+}
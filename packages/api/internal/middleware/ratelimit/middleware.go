@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// Middleware limits the requests of each team to each route with the token buckets configured by the team's tier.
+// It must run after the authentication, the requests without a team aren't limited.
+// When the limiter fails, the request is allowed, so the rate limiting can't take the API down.
+func Middleware(limiter Limiter, meterProvider metric.MeterProvider) gin.HandlerFunc {
+	counter, err := telemetry.GetCounter(meterProvider.Meter("api.rate_limit"), telemetry.RateLimitCounterMeterName)
+	if err != nil {
+		zap.L().Error("Error creating the rate limit counter", zap.Error(err))
+	}
+
+	return func(c *gin.Context) {
+		teamInfo, ok := c.Value(auth.TeamContextKey).(authcache.AuthTeamInfo)
+		if !ok || teamInfo.Team == nil || teamInfo.Tier == nil || teamInfo.Tier.APIRequestsPerSecond <= 0 {
+			c.Next()
+
+			return
+		}
+
+		route := c.Request.Method + " " + c.FullPath()
+		limit := Limit{
+			Rate:  float64(teamInfo.Tier.APIRequestsPerSecond),
+			Burst: max(teamInfo.Tier.APIRequestsBurst, 1),
+		}
+
+		ctx := c.Request.Context()
+		allowed, retryAfter, err := limiter.Allow(ctx, fmt.Sprintf("ratelimit:%s:%s", teamInfo.Team.ID, route), limit)
+		if err != nil {
+			zap.L().Error("Error checking the rate limit", logger.WithTeamID(teamInfo.Team.ID.String()), zap.String("route", route), zap.Error(err))
+			c.Next()
+
+			return
+		}
+
+		if counter != nil {
+			counter.Add(ctx, 1, metric.WithAttributes(
+				attribute.String("route", route),
+				attribute.Bool("allowed", allowed),
+			))
+		}
+
+		if !allowed {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+
+			c.Header("Retry-After", strconv.Itoa(max(seconds, 1)))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"code":    http.StatusTooManyRequests,
+				"message": fmt.Sprintf("Rate limit exceeded for %s, retry after %d seconds", route, max(seconds, 1)),
+			})
+
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,101 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/e2b-dev/infra/packages/api/internal/auth"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func TestMemoryLimiter_RefillsBucket(t *testing.T) {
+	now := time.Now()
+
+	limiter := NewMemoryLimiter()
+	limiter.now = func() time.Time { return now }
+
+	limit := Limit{Rate: 2, Burst: 3}
+
+	for range 3 {
+		allowed, _, err := limiter.Allow(context.Background(), "key", limit)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+
+	allowed, retryAfter, err := limiter.Allow(context.Background(), "key", limit)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// Other buckets are independent
+	allowed, _, err = limiter.Allow(context.Background(), "other-key", limit)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	now = now.Add(500 * time.Millisecond)
+
+	allowed, _, err = limiter.Allow(context.Background(), "key", limit)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+}
+
+func newTestRouter(limiter Limiter, tier *models.Tier) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	team := &models.Team{ID: uuid.New()}
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if tier != nil {
+			c.Set(auth.TeamContextKey, authcache.AuthTeamInfo{Team: team, Tier: tier})
+		}
+	})
+	r.Use(Middleware(limiter, noop.NewMeterProvider()))
+	r.GET("/sandboxes", func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.GET("/sandboxes/:sandboxID", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	return r
+}
+
+func request(r *gin.Engine, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	return rec
+}
+
+func TestMiddleware_LimitsPerRoute(t *testing.T) {
+	r := newTestRouter(NewMemoryLimiter(), &models.Tier{APIRequestsPerSecond: 1, APIRequestsBurst: 2})
+
+	assert.Equal(t, http.StatusOK, request(r, "/sandboxes").Code)
+	assert.Equal(t, http.StatusOK, request(r, "/sandboxes").Code)
+
+	rec := request(r, "/sandboxes")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+	// The bucket is per route, not per path
+	assert.Equal(t, http.StatusOK, request(r, "/sandboxes/a").Code)
+	assert.Equal(t, http.StatusOK, request(r, "/sandboxes/b").Code)
+	assert.Equal(t, http.StatusTooManyRequests, request(r, "/sandboxes/c").Code)
+}
+
+func TestMiddleware_Unlimited(t *testing.T) {
+	unlimited := newTestRouter(NewMemoryLimiter(), &models.Tier{APIRequestsPerSecond: 0})
+	unauthenticated := newTestRouter(NewMemoryLimiter(), nil)
+
+	for range 10 {
+		assert.Equal(t, http.StatusOK, request(unlimited, "/sandboxes").Code)
+		assert.Equal(t, http.StatusOK, request(unauthenticated, "/sandboxes").Code)
+	}
+}
@@ -2,6 +2,7 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -16,6 +17,8 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/api"
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
+	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -28,6 +31,9 @@ const cacheSyncTime = 20 * time.Second
 // This timeout is also set in the CloudRun for Analytics Collector, there it is 3 minutes.
 const reportTimeout = 4 * time.Minute
 
+// pausedStorageQuotaRetryInterval is how long the sandbox keeps running when its auto-pause didn't fit the paused storage quota of the team.
+const pausedStorageQuotaRetryInterval = 5 * time.Minute
+
 type closeType string
 
 const (
@@ -152,13 +158,27 @@ func (o *Orchestrator) syncNode(ctx context.Context, node *Node, nodes []*node.N
 		}
 		node.setStatus(nodeStatus)
 
-		activeInstances, instancesErr := o.getSandboxes(ctx, node.Info)
+		syncStart := time.Now()
+
+		activeInstances, pooled, memoryReclaimedMiB, instancesErr := o.getSandboxes(ctx, node.Info)
 		if instancesErr != nil {
 			zap.L().Error("Error getting instances", zap.Error(instancesErr))
 			continue
 		}
 
+		node.RamReclaimed.Store(memoryReclaimedMiB)
+
 		instanceCache.Sync(ctx, activeInstances, node.Info.ID)
+		node.syncPooled(pooled, syncStart)
+
+		// The layers of the templates the sandboxes are based on are cached on the node
+		for _, sbx := range activeInstances {
+			node.InsertTemplate(sbx.BaseTemplateID)
+		}
+
+		for _, sbx := range pooled {
+			node.InsertTemplate(sbx.templateID)
+		}
 
 		syncRetrySuccess = true
 		break
@@ -206,27 +226,32 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 			ct = CloseDelete
 		}
 
-		// Run in separate goroutine to not block sandbox deletion
-		// Also use parentCtx to not cancel the request with this hook timeout
-		go reportInstanceStopAnalytics(
-			parentCtx,
-			posthogClient,
-			o.analytics,
-			info.TeamID.String(),
-			info.Instance.SandboxID,
-			info.ExecutionID,
-			info.Instance.TemplateID,
-			info.VCpu,
-			info.RamMB,
-			info.TotalDiskSizeMB,
-			stopTime,
-			ct,
-			duration,
-		)
+		// The sandbox is reported as paused only when its snapshot was created, otherwise it was stopped
+		reportStopped := func(ct closeType) {
+			// Run in separate goroutine to not block sandbox deletion
+			// Also use parentCtx to not cancel the request with this hook timeout
+			go reportInstanceStopAnalytics(
+				parentCtx,
+				posthogClient,
+				o.analytics,
+				info.TeamID.String(),
+				info.Instance.SandboxID,
+				info.ExecutionID,
+				info.Instance.TemplateID,
+				info.VCpu,
+				info.RamMB,
+				info.TotalDiskSizeMB,
+				stopTime,
+				ct,
+				duration,
+			)
+			go o.usage.Stopped(parentCtx, info, stopTime, ct == ClosePause)
+		}
 
 		node := o.GetNode(info.Instance.ClientID)
 		if node == nil {
 			zap.L().Error("failed to get node", zap.String("node_id", info.Instance.ClientID))
+			reportStopped(CloseDelete)
 
 			return fmt.Errorf("node '%s' not found", info.Instance.ClientID)
 		}
@@ -238,6 +263,7 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 
 		if node.Client == nil {
 			zap.L().Error("client for node not found", zap.String("node_id", info.Instance.ClientID))
+			reportStopped(CloseDelete)
 
 			return fmt.Errorf("client for node '%s' not found", info.Instance.ClientID)
 		}
@@ -246,8 +272,21 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 			o.instanceCache.MarkAsPausing(info)
 
 			err := o.PauseInstance(ctx, o.tracer, info, *info.TeamID)
+			if errors.As(err, &db.PausedStorageQuotaExceeded{}) {
+				// The snapshot can't be kept, the sandbox keeps running and the pause is retried after the interval
+				o.instanceCache.UnmarkAsPausing(info)
+				info.PauseDone(err)
+
+				o.instanceCache.Set(info.Instance.SandboxID, info.KeepRunningUntil(time.Now().Add(pausedStorageQuotaRetryInterval)), false)
+
+				sbxlogger.I(info).Warn("sandbox over the paused storage quota is kept running", zap.Error(err))
+
+				return fmt.Errorf("failed to pause sandbox '%s': %w", info.Instance.SandboxID, err)
+			}
+
 			if err != nil {
 				info.PauseDone(err)
+				reportStopped(CloseDelete)
 
 				return fmt.Errorf("failed to auto pause sandbox '%s': %w", info.Instance.SandboxID, err)
 			}
@@ -256,12 +295,22 @@ func (o *Orchestrator) getDeleteInstanceFunction(
 			// where we are creating a new instance, and the pausing one is still in the pausing cache.
 			o.instanceCache.UnmarkAsPausing(info)
 			info.PauseDone(nil)
+			reportStopped(ClosePause)
+
+			o.webhooks.Emit(webhooks.NewSandboxEvent(api.SandboxPaused, info))
 		} else {
 			req := &orchestrator.SandboxDeleteRequest{SandboxId: info.Instance.SandboxID}
 			_, err := node.Client.Sandbox.Delete(ctx, req)
+			reportStopped(CloseDelete)
 			if err != nil {
 				return fmt.Errorf("failed to delete sandbox '%s': %w", info.Instance.SandboxID, err)
 			}
+
+			if info.TimedOut() {
+				o.webhooks.Emit(webhooks.NewSandboxEvent(api.SandboxTimedOut, info))
+			} else {
+				o.webhooks.Emit(webhooks.NewSandboxEvent(api.SandboxKilled, info))
+			}
 		}
 
 		sbxlogger.I(info).Debug("Deleted sandbox from cache hook",
@@ -343,6 +392,12 @@ func (o *Orchestrator) getInsertInstanceFunction(parentCtx context.Context, time
 		}
 
 		if created {
+			if info.IsResume {
+				o.webhooks.Emit(webhooks.NewSandboxEvent(api.SandboxResumed, info))
+			} else {
+				o.webhooks.Emit(webhooks.NewSandboxEvent(api.SandboxCreated, info))
+			}
+
 			// Run in separate goroutine to not block sandbox creation
 			// Also use parentCtx to not cancel the request with this hook timeout
 			go reportInstanceStartAnalytics(
@@ -357,6 +412,7 @@ func (o *Orchestrator) getInsertInstanceFunction(parentCtx context.Context, time
 				info.RamMB,
 				info.TotalDiskSizeMB,
 			)
+			go o.usage.Started(parentCtx, info)
 		}
 
 		sbxlogger.I(info).Debug("Inserted sandbox to cache hook",
@@ -0,0 +1,66 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// CheckpointInstance takes a snapshot of the running sandbox without stopping it.
+// The snapshot is stored as a new checkpoint build of the sandbox snapshot env, so it can be restored the same way as a paused sandbox is resumed.
+func (o *Orchestrator) CheckpointInstance(
+	ctx context.Context,
+	sbx *instance.InstanceInfo,
+	teamID uuid.UUID,
+) (*models.EnvBuild, error) {
+	ctx, span := o.tracer.Start(ctx, "checkpoint-sandbox")
+	defer span.End()
+
+	node := o.GetNode(sbx.Instance.ClientID)
+	if node == nil || node.Client == nil {
+		return nil, fmt.Errorf("node '%s' of the sandbox '%s' is not available", sbx.Instance.ClientID, sbx.Instance.SandboxID)
+	}
+
+	envBuild, err := o.dbClient.NewCheckpointBuild(
+		ctx,
+		NewSnapshotInfo(sbx),
+		teamID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating checkpoint build: %w", err)
+	}
+
+	_, err = node.Client.Sandbox.Checkpoint(ctx, &orchestrator.SandboxCheckpointRequest{
+		SandboxId:  sbx.Instance.SandboxID,
+		TemplateId: *envBuild.EnvID,
+		BuildId:    envBuild.ID.String(),
+	})
+	if err != nil {
+		err = utils.UnwrapGRPCError(err)
+
+		statusErr := o.dbClient.EnvBuildSetStatus(context.WithoutCancel(ctx), *envBuild.EnvID, envBuild.ID, envbuild.StatusFailed)
+		if statusErr != nil {
+			telemetry.ReportError(ctx, "error setting checkpoint build status", statusErr)
+		}
+
+		return nil, fmt.Errorf("failed to checkpoint sandbox '%s': %w", sbx.Instance.SandboxID, err)
+	}
+
+	// The build is ready on the node, it's usable anywhere else only after the snapshot is uploaded
+	go o.finishSnapshotBuild(node, envBuild)
+
+	// The build should be cached on the node now
+	node.InsertBuild(envBuild.ID.String())
+
+	telemetry.ReportEvent(ctx, "Checkpointed sandbox")
+
+	return envBuild, nil
+}
@@ -77,6 +77,9 @@ func (o *Orchestrator) connectToNode(ctx context.Context, node *node.NodeInfo) e
 	buildCache := ttlcache.New[string, interface{}]()
 	go buildCache.Start()
 
+	templateCache := ttlcache.New[string, interface{}]()
+	go templateCache.Start()
+
 	nodeStatus := api.NodeStatusUnhealthy
 	nodeVersion := "unknown"
 	nodeCommit := "unknown"
@@ -112,11 +115,15 @@ func (o *Orchestrator) connectToNode(ctx context.Context, node *node.NodeInfo) e
 			Info:           node,
 			orchestratorID: orchestratorID,
 			buildCache:     buildCache,
+			templateCache:  templateCache,
 			status:         nodeStatus,
 			version:        nodeVersion,
 			commit:         nodeCommit,
 			sbxsInProgress: smap.New[*sbxInProgress](),
+			pooled:         smap.New[*pooledSandbox](),
 			createFails:    atomic.Uint64{},
+
+			recentCreateFails: newRecentFailures(recentCreateFailsWindow),
 		},
 	)
 
@@ -0,0 +1,84 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// BaseTemplateID returns the template the sandboxes of the build are started from.
+// The builds of the templates created from sandboxes keep the template of the original sandbox, the snapshot expects its rootfs path.
+func BaseTemplateID(build queries.EnvBuild) string {
+	if build.BaseEnvID != nil {
+		return *build.BaseEnvID
+	}
+
+	return *build.EnvID
+}
+
+// CommitInstance stores the snapshot of the running sandbox as the build of the template created from it, the sandbox keeps running.
+func (o *Orchestrator) CommitInstance(
+	ctx context.Context,
+	sbx *instance.InstanceInfo,
+	envBuild *models.EnvBuild,
+) error {
+	ctx, span := o.tracer.Start(ctx, "commit-sandbox")
+	defer span.End()
+
+	node := o.GetNode(sbx.Instance.ClientID)
+	if node == nil || node.Client == nil {
+		return fmt.Errorf("node '%s' of the sandbox '%s' is not available", sbx.Instance.ClientID, sbx.Instance.SandboxID)
+	}
+
+	_, err := node.Client.Sandbox.Commit(ctx, &orchestrator.SandboxCommitRequest{
+		SandboxId:  sbx.Instance.SandboxID,
+		TemplateId: *envBuild.EnvID,
+		BuildId:    envBuild.ID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit sandbox '%s': %w", sbx.Instance.SandboxID, utils.UnwrapGRPCError(err))
+	}
+
+	telemetry.ReportEvent(ctx, "Committed sandbox")
+
+	return nil
+}
+
+// CommitSnapshot stores the snapshot of the paused sandbox as the build of the template created from it.
+// The node which has the snapshot cached is preferred.
+func (o *Orchestrator) CommitSnapshot(
+	ctx context.Context,
+	snapshotBuild queries.EnvBuild,
+	baseTemplateID string,
+	envBuild *models.EnvBuild,
+) error {
+	ctx, span := o.tracer.Start(ctx, "commit-snapshot")
+	defer span.End()
+
+	node, err := o.getLeastBusyNode(ctx, nil, placementRequest{buildID: snapshotBuild.ID.String(), baseTemplateID: baseTemplateID})
+	if err != nil {
+		return fmt.Errorf("failed to get node for the snapshot commit: %w", err)
+	}
+
+	_, err = node.Client.Sandbox.Commit(ctx, &orchestrator.SandboxCommitRequest{
+		SourceTemplateId:   *snapshotBuild.EnvID,
+		SourceBuildId:      snapshotBuild.ID.String(),
+		KernelVersion:      snapshotBuild.KernelVersion,
+		FirecrackerVersion: snapshotBuild.FirecrackerVersion,
+		TemplateId:         *envBuild.EnvID,
+		BuildId:            envBuild.ID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit snapshot '%s': %w", snapshotBuild.ID, utils.UnwrapGRPCError(err))
+	}
+
+	telemetry.ReportEvent(ctx, "Committed snapshot")
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+func TestBaseTemplateID(t *testing.T) {
+	envID := "template-id"
+	baseEnvID := "base-template-id"
+
+	t.Run("build of a template created from a sandbox keeps the base template", func(t *testing.T) {
+		assert.Equal(t, baseEnvID, BaseTemplateID(queries.EnvBuild{EnvID: &envID, BaseEnvID: &baseEnvID}))
+	})
+
+	t.Run("build of a template is started from the template", func(t *testing.T) {
+		assert.Equal(t, envID, BaseTemplateID(queries.EnvBuild{EnvID: &envID}))
+	})
+}
@@ -50,42 +50,30 @@ func (o *Orchestrator) CreateSandbox(
 	baseTemplateID string,
 	autoPause bool,
 	envdAuthToken *string,
+	networkPolicy *orchestrator.SandboxNetworkPolicy,
+	portAccess *orchestrator.SandboxPortAccess,
 ) (*api.Sandbox, *api.APIError) {
 	childCtx, childSpan := o.tracer.Start(ctx, "create-sandbox")
 	defer childSpan.End()
 
-	// Check if team has reached max instances
-	releaseTeamSandboxReservation, err := o.instanceCache.Reserve(sandboxID, team.Team.ID, team.Tier.ConcurrentInstances)
+	// The pooled sandbox is counted against the team quota, it's taken from the pool so it isn't counted twice
+	var pooledNode *Node
+	var pooled *pooledSandbox
+	if !isResume {
+		pooledNode, pooled = o.takeWarmPoolSandbox(childCtx, team.Team.ID, *build.EnvID, build.ID.String())
+	}
+
+	// Check if team has reached max instances or its resource quota
+	resources := instance.Resources{VCPU: build.Vcpu, RAMMB: SandboxMemoryMB(build)}
+	releaseTeamSandboxReservation, err := o.instanceCache.ReserveWithQuota(sandboxID, team.Team.ID, resources, teamQuota(team.Tier))
 	if err != nil {
-		var limitErr *instance.ErrSandboxLimitExceeded
-		var alreadyErr *instance.ErrAlreadyBeingStarted
+		if pooled != nil {
+			returnPooledSandbox(pooledNode, pooled)
+		}
 
 		telemetry.ReportCriticalError(ctx, "failed to reserve sandbox for team", err)
 
-		switch {
-		case errors.As(err, &limitErr):
-			return nil, &api.APIError{
-				Code: http.StatusTooManyRequests,
-				ClientMsg: fmt.Sprintf(
-					"you have reached the maximum number of concurrent E2B sandboxes (%d). If you need more, "+
-						"please contact us at 'https://e2b.dev/docs/getting-help'", team.Tier.ConcurrentInstances),
-				Err: fmt.Errorf("team '%s' has reached the maximum number of instances (%d)", team.Team.ID, team.Tier.ConcurrentInstances),
-			}
-		case errors.As(err, &alreadyErr):
-			zap.L().Warn("sandbox already being started", logger.WithSandboxID(sandboxID), zap.Error(err))
-			return nil, &api.APIError{
-				Code:      http.StatusConflict,
-				ClientMsg: fmt.Sprintf("Sandbox %s is already being started", sandboxID),
-				Err:       err,
-			}
-		default:
-			zap.L().Error("failed to reserve sandbox for team", logger.WithSandboxID(sandboxID), zap.Error(err))
-			return nil, &api.APIError{
-				Code:      http.StatusInternalServerError,
-				ClientMsg: fmt.Sprintf("Failed to create sandbox: %s", err),
-				Err:       err,
-			}
-		}
+		return nil, reservationError(sandboxID, team, err)
 	}
 
 	telemetry.ReportEvent(childCtx, "Reserved sandbox for team")
@@ -104,6 +92,8 @@ func (o *Orchestrator) CreateSandbox(
 
 	telemetry.ReportEvent(childCtx, "Got FC version info")
 
+	rateLimits := sandboxRateLimits(team.Tier, build)
+
 	sbxRequest := &orchestrator.SandboxCreateRequest{
 		Sandbox: &orchestrator.SandboxConfig{
 			BaseTemplateId:     baseTemplateID,
@@ -122,9 +112,13 @@ func (o *Orchestrator) CreateSandbox(
 			MaxSandboxLength:   team.Tier.MaxLengthHours,
 			HugePages:          features.HasHugePages(),
 			RamMb:              build.RamMb,
+			MemoryTargetMb:     memoryTargetMB(build),
 			Vcpu:               build.Vcpu,
 			Snapshot:           isResume,
 			AutoPause:          &autoPause,
+			RateLimits:         rateLimits,
+			NetworkPolicy:      networkPolicy,
+			PortAccess:         portAccess,
 		},
 		StartTime: timestamppb.New(startTime),
 		EndTime:   timestamppb.New(endTime),
@@ -132,6 +126,13 @@ func (o *Orchestrator) CreateSandbox(
 
 	var node *Node
 
+	claimed := false
+	if pooled != nil && o.claimPooledSandbox(childCtx, pooledNode, pooled, sbxRequest) {
+		node = pooledNode
+		claimed = true
+		executionID = sbxRequest.Sandbox.ExecutionId
+	}
+
 	if isResume && clientID != nil {
 		telemetry.ReportEvent(childCtx, "Placing sandbox on the node where the snapshot was taken")
 
@@ -141,9 +142,14 @@ func (o *Orchestrator) CreateSandbox(
 		}
 	}
 
+	placement := placementRequest{
+		buildID:        build.ID.String(),
+		baseTemplateID: baseTemplateID,
+	}
+
 	attempt := 1
 	nodesExcluded := make(map[string]*Node)
-	for {
+	for !claimed {
 		select {
 		case <-childCtx.Done():
 			return nil, &api.APIError{
@@ -164,7 +170,7 @@ func (o *Orchestrator) CreateSandbox(
 		}
 
 		if node == nil {
-			node, err = o.getLeastBusyNode(childCtx, nodesExcluded)
+			node, err = o.getLeastBusyNode(childCtx, nodesExcluded, placement)
 			if err != nil {
 				telemetry.ReportError(childCtx, "failed to get least busy node", err)
 
@@ -182,10 +188,12 @@ func (o *Orchestrator) CreateSandbox(
 			CPUs:      build.Vcpu,
 		})
 
-		_, err = node.Client.Sandbox.Create(childCtx, sbxRequest)
+		res, err := node.Client.Sandbox.Create(childCtx, sbxRequest)
 		// The request is done, we will either add it to the cache or remove it from the node
 		if err == nil {
-			// The sandbox was created successfully
+			// The sandbox was created successfully, the sandboxes from the templates without the balloon device keep the whole memory
+			sbxRequest.Sandbox.MemoryTargetMb = res.GetMemoryTargetMb()
+
 			break
 		}
 
@@ -194,7 +202,7 @@ func (o *Orchestrator) CreateSandbox(
 		log.Printf("failed to create sandbox '%s' on node '%s', attempt #%d: %v", sandboxID, node.Info.ID, attempt, utils.UnwrapGRPCError(err))
 
 		// The node is not available, try again with another node
-		node.createFails.Add(1)
+		node.reportCreateFail()
 		nodesExcluded[node.Info.ID] = node
 		node = nil
 		attempt += 1
@@ -202,6 +210,7 @@ func (o *Orchestrator) CreateSandbox(
 
 	// The build should be cached on the node now
 	node.InsertBuild(build.ID.String())
+	node.InsertTemplate(baseTemplateID)
 
 	// The sandbox was created successfully, the resources will be counted in cache
 	defer node.sbxsInProgress.Remove(sandboxID)
@@ -235,6 +244,7 @@ func (o *Orchestrator) CreateSandbox(
 		build.Vcpu,
 		*build.TotalDiskSizeMb,
 		build.RamMb,
+		sbxRequest.Sandbox.GetMemoryTargetMb(),
 		build.KernelVersion,
 		build.FirecrackerVersion,
 		*build.EnvdVersion,
@@ -242,6 +252,10 @@ func (o *Orchestrator) CreateSandbox(
 		autoPause,
 		envdAuthToken,
 		baseTemplateID,
+		rateLimits,
+		networkPolicy,
+		portAccess,
+		isResume,
 	)
 
 	cacheErr := o.instanceCache.Add(childCtx, instanceInfo, true)
@@ -260,11 +274,57 @@ func (o *Orchestrator) CreateSandbox(
 		}
 	}
 
+	if isResume {
+		// The snapshot of the running sandbox mustn't expire
+		err = o.dbClient.SetSnapshotResumed(childCtx, sandboxID, team.Team.ID)
+		if err != nil {
+			telemetry.ReportError(ctx, "error setting snapshot resumed", err)
+		}
+	}
+
 	return &sbx, nil
 }
 
-// getLeastBusyNode returns the least busy node, if there are no eligible nodes, it tries until one is available or the context timeouts
-func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded map[string]*Node) (leastBusyNode *Node, err error) {
+// reservationError converts the error from the team sandbox reservation to the API error.
+func reservationError(sandboxID string, team authcache.AuthTeamInfo, err error) *api.APIError {
+	var limitErr *instance.ErrSandboxLimitExceeded
+	var quotaErr *instance.ErrQuotaExceeded
+	var alreadyErr *instance.ErrAlreadyBeingStarted
+
+	switch {
+	case errors.As(err, &limitErr):
+		return &api.APIError{
+			Code: http.StatusTooManyRequests,
+			ClientMsg: fmt.Sprintf(
+				"you have reached the maximum number of concurrent E2B sandboxes (%d). If you need more, "+
+					"please contact us at 'https://e2b.dev/docs/getting-help'", team.Tier.ConcurrentInstances),
+			Err: fmt.Errorf("team '%s' has reached the maximum number of instances (%d)", team.Team.ID, team.Tier.ConcurrentInstances),
+		}
+	case errors.As(err, &quotaErr):
+		return &api.APIError{
+			Code:      http.StatusTooManyRequests,
+			ClientMsg: quotaExceededMessage(quotaErr),
+			Err:       fmt.Errorf("team '%s' has reached its resource quota: %w", team.Team.ID, err),
+		}
+	case errors.As(err, &alreadyErr):
+		zap.L().Warn("sandbox already being started", logger.WithSandboxID(sandboxID), zap.Error(err))
+		return &api.APIError{
+			Code:      http.StatusConflict,
+			ClientMsg: fmt.Sprintf("Sandbox %s is already being started", sandboxID),
+			Err:       err,
+		}
+	default:
+		zap.L().Error("failed to reserve sandbox for team", logger.WithSandboxID(sandboxID), zap.Error(err))
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: fmt.Sprintf("Failed to create sandbox: %s", err),
+			Err:       err,
+		}
+	}
+}
+
+// getLeastBusyNode returns the best scored node for the sandbox, if there are no eligible nodes, it tries until one is available or the context timeouts
+func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded map[string]*Node, sbx placementRequest) (leastBusyNode *Node, err error) {
 	ctx, cancel := context.WithTimeout(parentCtx, leastBusyNodeTimeout)
 	defer cancel()
 
@@ -272,7 +332,7 @@ func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded
 	defer childSpan.End()
 
 	// Try to find a node without waiting
-	leastBusyNode, err = o.findLeastBusyNode(nodesExcluded)
+	leastBusyNode, err = o.findLeastBusyNode(nodesExcluded, sbx)
 	if err == nil {
 		return leastBusyNode, nil
 	}
@@ -285,7 +345,7 @@ func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded
 			return nil, childCtx.Err()
 		case <-ticker.C:
 			// If no node is available, wait for a bit and try again
-			leastBusyNode, err = o.findLeastBusyNode(nodesExcluded)
+			leastBusyNode, err = o.findLeastBusyNode(nodesExcluded, sbx)
 			if err == nil {
 				return leastBusyNode, nil
 			}
@@ -293,9 +353,10 @@ func (o *Orchestrator) getLeastBusyNode(parentCtx context.Context, nodesExcluded
 	}
 }
 
-// findLeastBusyNode finds the least busy node that is ready and not in the excluded list
+// findLeastBusyNode finds the best scored node for the sandbox that is ready and not in the excluded list
 // if no node is available, returns an error
-func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node) (leastBusyNode *Node, err error) {
+func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node, sbx placementRequest) (leastBusyNode *Node, err error) {
+	candidates := make([]*Node, 0, o.nodes.Count())
 	for _, node := range o.nodes.Items() {
 		// The node might be nil if it was removed from the list while iterating
 		if node == nil {
@@ -317,16 +378,10 @@ func (o *Orchestrator) findLeastBusyNode(nodesExcluded map[string]*Node) (leastB
 			continue
 		}
 
-		cpuUsage := int64(0)
-		for _, sbx := range node.sbxsInProgress.Items() {
-			cpuUsage += sbx.CPUs
-		}
-
-		if leastBusyNode == nil || (node.CPUUsage.Load()+cpuUsage) < leastBusyNode.CPUUsage.Load() {
-			leastBusyNode = node
-		}
+		candidates = append(candidates, node)
 	}
 
+	leastBusyNode = pickNode(candidates, o.placement, sbx)
 	if leastBusyNode != nil {
 		return leastBusyNode, nil
 	}
@@ -0,0 +1,241 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// ForkedSandbox identifies a new sandbox started from the fork of a running sandbox.
+type ForkedSandbox struct {
+	SandboxID       string
+	EnvdAccessToken *string
+}
+
+// ForkSandbox takes a live snapshot of the running sandbox and starts the children from it on the same node.
+// The source sandbox keeps running. Either all the children are started or none of them.
+func (o *Orchestrator) ForkSandbox(
+	ctx context.Context,
+	source *instance.InstanceInfo,
+	team authcache.AuthTeamInfo,
+	children []ForkedSandbox,
+	metadata map[string]string,
+	timeout time.Duration,
+	autoPause bool,
+) ([]*api.Sandbox, *api.APIError) {
+	childCtx, childSpan := o.tracer.Start(ctx, "fork-sandbox")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(source.Instance.SandboxID),
+		attribute.Int("fork.children", len(children)),
+	)
+
+	// Check if team has enough free sandboxes and resources for all the children
+	resources := instance.Resources{VCPU: source.VCpu, RAMMB: source.MemoryMB()}
+	for _, child := range children {
+		releaseTeamSandboxReservation, err := o.instanceCache.ReserveWithQuota(child.SandboxID, team.Team.ID, resources, teamQuota(team.Tier))
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "failed to reserve sandbox for team", err)
+
+			return nil, reservationError(child.SandboxID, team, err)
+		}
+
+		defer releaseTeamSandboxReservation()
+	}
+
+	telemetry.ReportEvent(childCtx, "Reserved sandboxes for team")
+
+	node := o.GetNode(source.Instance.ClientID)
+	if node == nil || node.Status() != api.NodeStatusReady {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("node '%s' of the sandbox '%s' is not available", source.Instance.ClientID, source.Instance.SandboxID),
+		}
+	}
+
+	features, err := sandbox.NewVersionInfo(source.FirecrackerVersion)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to get build information for the sandbox",
+			Err:       fmt.Errorf("failed to get features for firecracker version '%s': %w", source.FirecrackerVersion, err),
+		}
+	}
+
+	envBuild, err := o.dbClient.NewForkBuild(
+		childCtx,
+		NewSnapshotInfo(source),
+		team.Team.ID,
+	)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating fork build", err)
+
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("error creating fork build: %w", err),
+		}
+	}
+
+	startTime := time.Now()
+	endTime := startTime.Add(timeout)
+
+	networkPolicy := source.GetNetworkPolicy()
+
+	executionIDs := make([]string, len(children))
+	requests := make([]*orchestrator.SandboxCreateRequest, len(children))
+	for i, child := range children {
+		// Unique ID for the execution (from start/resume to stop/pause)
+		executionIDs[i] = uuid.New().String()
+
+		requests[i] = &orchestrator.SandboxCreateRequest{
+			Sandbox: &orchestrator.SandboxConfig{
+				BaseTemplateId:     source.BaseTemplateID,
+				TemplateId:         *envBuild.EnvID,
+				Alias:              source.Instance.Alias,
+				TeamId:             team.Team.ID.String(),
+				BuildId:            envBuild.ID.String(),
+				SandboxId:          child.SandboxID,
+				ExecutionId:        executionIDs[i],
+				KernelVersion:      source.KernelVersion,
+				FirecrackerVersion: source.FirecrackerVersion,
+				EnvdVersion:        source.Instance.EnvdVersion,
+				Metadata:           metadata,
+				EnvdAccessToken:    child.EnvdAccessToken,
+				MaxSandboxLength:   team.Tier.MaxLengthHours,
+				HugePages:          features.HasHugePages(),
+				RamMb:              source.RamMB,
+				MemoryTargetMb:     source.MemoryTargetMB,
+				Vcpu:               source.VCpu,
+				TotalDiskSizeMb:    source.TotalDiskSizeMB,
+				Snapshot:           true,
+				AutoPause:          &autoPause,
+				RateLimits:         source.RateLimits,
+				NetworkPolicy:      networkPolicy,
+				PortAccess:         source.PortAccess,
+			},
+			StartTime: timestamppb.New(startTime),
+			EndTime:   timestamppb.New(endTime),
+		}
+
+		// To prevent creating a lot of sandboxes at once on the same node
+		node.sbxsInProgress.Insert(child.SandboxID, &sbxInProgress{
+			MiBMemory: source.RamMB,
+			CPUs:      source.VCpu,
+		})
+		// The sandbox was either created and the resources will be counted in cache, or it failed
+		defer node.sbxsInProgress.Remove(child.SandboxID)
+	}
+
+	_, err = node.Client.Sandbox.Fork(childCtx, &orchestrator.SandboxForkRequest{
+		SandboxId:  source.Instance.SandboxID,
+		TemplateId: *envBuild.EnvID,
+		BuildId:    envBuild.ID.String(),
+		Children:   requests,
+	})
+	if err != nil {
+		err = utils.UnwrapGRPCError(err)
+		telemetry.ReportCriticalError(ctx, "failed to fork sandbox", err)
+
+		statusErr := o.dbClient.EnvBuildSetStatus(context.WithoutCancel(ctx), *envBuild.EnvID, envBuild.ID, envbuild.StatusFailed)
+		if statusErr != nil {
+			telemetry.ReportError(ctx, "error setting fork build status", statusErr)
+		}
+
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Failed to fork sandbox",
+			Err:       fmt.Errorf("failed to fork sandbox '%s' on node '%s': %w", source.Instance.SandboxID, node.Info.ID, err),
+		}
+	}
+
+	// The build is ready on the node, it's usable anywhere else only after the snapshot is uploaded
+	go o.finishSnapshotBuild(node, envBuild)
+
+	// The build should be cached on the node now
+	node.InsertBuild(envBuild.ID.String())
+
+	telemetry.SetAttributes(childCtx, attribute.String("node.id", node.Info.ID))
+	telemetry.ReportEvent(childCtx, "Forked sandbox")
+
+	// This is to compensate for the time it takes to start the instances
+	// Otherwise it could cause the instances to expire before user has a chance to use them
+	startTime = time.Now()
+	endTime = startTime.Add(timeout)
+
+	sandboxes := make([]*api.Sandbox, 0, len(children))
+	for i, child := range children {
+		sbx := api.Sandbox{
+			ClientID:        node.Info.ID,
+			SandboxID:       child.SandboxID,
+			TemplateID:      *envBuild.EnvID,
+			Alias:           source.Instance.Alias,
+			EnvdVersion:     source.Instance.EnvdVersion,
+			EnvdAccessToken: child.EnvdAccessToken,
+		}
+
+		instanceInfo := instance.NewInstanceInfo(
+			&sbx,
+			executionIDs[i],
+			&team.Team.ID,
+			&envBuild.ID,
+			metadata,
+			time.Duration(team.Tier.MaxLengthHours)*time.Hour,
+			startTime,
+			endTime,
+			source.VCpu,
+			source.TotalDiskSizeMB,
+			source.RamMB,
+			source.MemoryTargetMB,
+			source.KernelVersion,
+			source.FirecrackerVersion,
+			source.Instance.EnvdVersion,
+			node.Info,
+			autoPause,
+			child.EnvdAccessToken,
+			source.BaseTemplateID,
+			source.RateLimits,
+			networkPolicy,
+			source.PortAccess,
+			false,
+		)
+
+		cacheErr := o.instanceCache.Add(childCtx, instanceInfo, true)
+		if cacheErr != nil {
+			telemetry.ReportError(ctx, "error when adding instance to cache", cacheErr)
+
+			for _, c := range children {
+				deleted := o.DeleteInstance(childCtx, c.SandboxID, false)
+				if !deleted {
+					telemetry.ReportEvent(ctx, "instance wasn't found in cache when deleting")
+				}
+			}
+
+			return nil, &api.APIError{
+				Code:      http.StatusInternalServerError,
+				ClientMsg: "Failed to fork sandbox",
+				Err:       fmt.Errorf("error when adding instance to cache: %w", cacheErr),
+			}
+		}
+
+		sandboxes = append(sandboxes, &sbx)
+	}
+
+	return sandboxes, nil
+}
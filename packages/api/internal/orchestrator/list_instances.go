@@ -15,41 +15,61 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 )
 
-func (o *Orchestrator) getSandboxes(ctx context.Context, node *nNode.NodeInfo) ([]*instance.InstanceInfo, error) {
+// getSandboxes returns the sandboxes running on the node, the pooled sandboxes which weren't claimed yet
+// and the total memory reclaimed from the sandboxes by the balloon devices.
+func (o *Orchestrator) getSandboxes(ctx context.Context, node *nNode.NodeInfo) ([]*instance.InstanceInfo, []*pooledSandbox, int64, error) {
 	childCtx, childSpan := o.tracer.Start(ctx, "get-sandboxes-from-orchestrator")
 	defer childSpan.End()
 
 	client, err := o.GetClient(node.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get GRPC client: %w", err)
+		return nil, nil, 0, fmt.Errorf("failed to get GRPC client: %w", err)
 	}
 
 	res, err := client.Sandbox.List(childCtx, &empty.Empty{})
 
 	err = utils.UnwrapGRPCError(err)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list sandboxes: %w", err)
+		return nil, nil, 0, fmt.Errorf("failed to list sandboxes: %w", err)
 	}
 
 	sandboxes := res.GetSandboxes()
 
 	sandboxesInfo := make([]*instance.InstanceInfo, 0, len(sandboxes))
+	pooled := make([]*pooledSandbox, 0)
+	var memoryReclaimedMiB int64
 
 	for _, sbx := range sandboxes {
 		config := sbx.GetConfig()
 
 		if config == nil {
-			return nil, fmt.Errorf("sandbox config is nil when listing sandboxes: %#v", sbx)
+			return nil, nil, 0, fmt.Errorf("sandbox config is nil when listing sandboxes: %#v", sbx)
 		}
 
 		teamID, parseErr := uuid.Parse(config.TeamId)
 		if parseErr != nil {
-			return nil, fmt.Errorf("failed to parse team ID '%s' for job: %w", config.TeamId, parseErr)
+			return nil, nil, 0, fmt.Errorf("failed to parse team ID '%s' for job: %w", config.TeamId, parseErr)
 		}
 
 		buildID, parseErr := uuid.Parse(config.BuildId)
 		if parseErr != nil {
-			return nil, fmt.Errorf("failed to parse build ID '%s' for job: %w", config.BuildId, err)
+			return nil, nil, 0, fmt.Errorf("failed to parse build ID '%s' for job: %w", config.BuildId, err)
+		}
+
+		// Pooled sandboxes aren't running sandboxes until they are claimed
+		if config.GetPooled() {
+			pooled = append(pooled, &pooledSandbox{
+				sandboxID:   config.SandboxId,
+				executionID: config.ExecutionId,
+				teamID:      teamID,
+				templateID:  config.TemplateId,
+				buildID:     config.BuildId,
+				cpus:        config.Vcpu,
+				ramMiB:      config.RamMb,
+				created:     sbx.StartTime.AsTime(),
+			})
+
+			continue
 		}
 
 		autoPause := instance.InstanceAutoPauseDefault
@@ -57,6 +77,8 @@ func (o *Orchestrator) getSandboxes(ctx context.Context, node *nNode.NodeInfo) (
 			autoPause = *config.AutoPause
 		}
 
+		memoryReclaimedMiB += sbx.GetMemoryReclaimedMb()
+
 		// TODO: Temporary workaround, until all orchestrators report this
 		if config.ExecutionId == "" {
 			config.ExecutionId = uuid.New().String()
@@ -81,6 +103,7 @@ func (o *Orchestrator) getSandboxes(ctx context.Context, node *nNode.NodeInfo) (
 				config.Vcpu,
 				config.TotalDiskSizeMb,
 				config.RamMb,
+				config.GetMemoryTargetMb(),
 				config.KernelVersion,
 				config.FirecrackerVersion,
 				config.EnvdVersion,
@@ -88,11 +111,15 @@ func (o *Orchestrator) getSandboxes(ctx context.Context, node *nNode.NodeInfo) (
 				autoPause,
 				config.EnvdAccessToken,
 				config.BaseTemplateId,
+				config.RateLimits,
+				config.NetworkPolicy,
+				config.PortAccess,
+				false,
 			),
 		)
 	}
 
-	return sandboxesInfo, nil
+	return sandboxesInfo, pooled, memoryReclaimedMiB, nil
 }
 
 // GetSandboxes returns all instances for a given node.
@@ -0,0 +1,24 @@
+package orchestrator
+
+import (
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+// memoryTargetMB returns the memory the sandbox started from the build is sized down to by the balloon device,
+// 0 means the whole memory of the build.
+func memoryTargetMB(build queries.EnvBuild) int64 {
+	if build.MemoryTargetMb == nil || *build.MemoryTargetMb <= 0 || *build.MemoryTargetMb >= build.RamMb {
+		return 0
+	}
+
+	return *build.MemoryTargetMb
+}
+
+// SandboxMemoryMB returns the memory of the sandbox started from the build, the memory reclaimed by the balloon device isn't counted.
+func SandboxMemoryMB(build queries.EnvBuild) int64 {
+	if target := memoryTargetMB(build); target > 0 {
+		return target
+	}
+
+	return build.RamMb
+}
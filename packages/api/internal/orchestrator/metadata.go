@@ -0,0 +1,99 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// MergeMetadata returns the metadata with the update applied, the keys set to nil are removed.
+// The original metadata isn't modified.
+func MergeMetadata(metadata map[string]string, update map[string]*string) map[string]string {
+	merged := maps.Clone(metadata)
+	if merged == nil {
+		merged = make(map[string]string, len(update))
+	}
+
+	for key, value := range update {
+		if value == nil {
+			delete(merged, key)
+
+			continue
+		}
+
+		merged[key] = *value
+	}
+
+	return merged
+}
+
+// SplitMetadataUpdate returns the keys set and the keys removed by the update.
+func SplitMetadataUpdate(update map[string]*string) (set map[string]string, removed []string) {
+	set = make(map[string]string, len(update))
+	removed = make([]string, 0)
+
+	for key, value := range update {
+		if value == nil {
+			removed = append(removed, key)
+
+			continue
+		}
+
+		set[key] = *value
+	}
+
+	return set, removed
+}
+
+// UpdateMetadata merges the update into the metadata of the running sandbox on its node and in the cache.
+// The updates of the sandbox are serialized, so the concurrent updates aren't lost. It returns the merged metadata.
+func (o *Orchestrator) UpdateMetadata(ctx context.Context, sbx *instance.InstanceInfo, update map[string]*string) (map[string]string, *api.APIError) {
+	childCtx, childSpan := o.tracer.Start(ctx, "update-sandbox-metadata",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.Instance.SandboxID),
+		),
+	)
+	defer childSpan.End()
+
+	unlock := sbx.LockMetadataUpdate()
+	defer unlock()
+
+	metadata := MergeMetadata(sbx.GetMetadata(), update)
+
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when updating sandbox metadata",
+			Err:       fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err),
+		}
+	}
+
+	_, err = client.Sandbox.UpdateMetadata(childCtx, &orchestrator.SandboxUpdateMetadataRequest{
+		SandboxId: sbx.Instance.SandboxID,
+		Metadata:  metadata,
+	})
+	if err != nil {
+		return nil, &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when updating sandbox metadata",
+			Err:       fmt.Errorf("failed to update metadata of sandbox '%s': %w", sbx.Instance.SandboxID, utils.UnwrapGRPCError(err)),
+		}
+	}
+
+	sbx.SetMetadata(metadata)
+
+	telemetry.ReportEvent(childCtx, "Updated sandbox metadata")
+
+	return metadata, nil
+}
@@ -0,0 +1,76 @@
+package orchestrator
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+)
+
+func TestMergeMetadata(t *testing.T) {
+	value := func(v string) *string { return &v }
+
+	original := map[string]string{"job": "1", "stage": "build", "owner": "alice"}
+
+	merged := MergeMetadata(original, map[string]*string{
+		"stage":   value("test"),
+		"owner":   nil,
+		"missing": nil,
+		"new":     value("value"),
+	})
+
+	assert.Equal(t, map[string]string{"job": "1", "stage": "test", "new": "value"}, merged)
+	assert.Equal(t, map[string]string{"job": "1", "stage": "build", "owner": "alice"}, original, "the original metadata must not be modified")
+}
+
+func TestMergeMetadata_NoMetadata(t *testing.T) {
+	value := "1"
+
+	assert.Equal(t, map[string]string{"job": "1"}, MergeMetadata(nil, map[string]*string{"job": &value}))
+	assert.Empty(t, MergeMetadata(nil, nil))
+}
+
+func TestSplitMetadataUpdate(t *testing.T) {
+	value := "test"
+
+	set, removed := SplitMetadataUpdate(map[string]*string{"stage": &value, "owner": nil})
+
+	assert.Equal(t, map[string]string{"stage": "test"}, set)
+	assert.Equal(t, []string{"owner"}, removed)
+
+	set, removed = SplitMetadataUpdate(nil)
+
+	assert.Empty(t, set)
+	assert.NotNil(t, removed, "the removed keys are passed as an SQL array")
+}
+
+func TestUpdateMetadata_ConcurrentUpdates(t *testing.T) {
+	o, n, _ := newWarmPoolOrchestrator(t)
+
+	sbx := &instance.InstanceInfo{Instance: &api.Sandbox{SandboxID: "sandbox-id", ClientID: n.Info.ID}}
+	sbx.SetMetadata(map[string]string{"job": "1"})
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			value := strconv.Itoa(i)
+			_, apiErr := o.UpdateMetadata(context.Background(), sbx, map[string]*string{"key-" + value: &value})
+			assert.Nil(t, apiErr)
+		}()
+	}
+	wg.Wait()
+
+	// None of the concurrent updates is lost
+	metadata := sbx.GetMetadata()
+	assert.Len(t, metadata, 21)
+	assert.Equal(t, "1", metadata["job"])
+	assert.Equal(t, "7", metadata["key-7"])
+}
@@ -17,6 +17,11 @@ func (o *Orchestrator) setupMetrics(meterProvider metric.MeterProvider) (metric.
 		return nil, fmt.Errorf("failed to create orchestrators gauge: %w", err)
 	}
 
+	warmPoolGauge, err := telemetry.GetGaugeInt(meter, telemetry.WarmPoolReadyGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create warm pool gauge: %w", err)
+	}
+
 	registration, err := meter.RegisterCallback(
 		func(ctx context.Context, obs metric.Observer) error {
 			for _, node := range o.nodes.Items() {
@@ -26,8 +31,22 @@ func (o *Orchestrator) setupMetrics(meterProvider metric.MeterProvider) (metric.
 				))
 			}
 
+			ready := make(map[warmPoolKey]int64)
+			for _, node := range o.nodes.Items() {
+				for _, sbx := range node.pooled.Items() {
+					ready[sbx.key()]++
+				}
+			}
+
+			for key, count := range ready {
+				obs.ObserveInt64(warmPoolGauge, count, metric.WithAttributes(
+					attribute.String("team.id", key.teamID.String()),
+					attribute.String("template.id", key.templateID),
+				))
+			}
+
 			return nil
-		}, gauge)
+		}, gauge, warmPoolGauge)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register orchestrators gauge: %w", err)
 	}
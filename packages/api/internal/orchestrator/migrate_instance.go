@@ -0,0 +1,58 @@
+package orchestrator
+
+import (
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+)
+
+// MigrationTarget returns the node the sandbox should be migrated to.
+// When the target isn't requested, the best scored ready node other than the current one is picked.
+func (o *Orchestrator) MigrationTarget(sbx *instance.InstanceInfo, targetNodeID *string) (string, error) {
+	source := sbx.Instance.ClientID
+
+	if targetNodeID != nil {
+		if *targetNodeID == source {
+			return "", fmt.Errorf("sandbox '%s' is already running on node '%s'", sbx.Instance.SandboxID, source)
+		}
+
+		target := o.GetNode(*targetNodeID)
+		if target == nil {
+			return "", fmt.Errorf("node '%s' not found", *targetNodeID)
+		}
+
+		if target.Status() != api.NodeStatusReady {
+			return "", fmt.Errorf("node '%s' is not ready", *targetNodeID)
+		}
+
+		return target.Info.ID, nil
+	}
+
+	nodesExcluded := make(map[string]*Node)
+	if node := o.GetNode(source); node != nil {
+		nodesExcluded[source] = node
+	}
+
+	target, err := o.findLeastBusyNode(nodesExcluded, placementRequest{
+		buildID:        sbx.BuildID.String(),
+		baseTemplateID: sbx.BaseTemplateID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to find node to migrate sandbox '%s' to: %w", sbx.Instance.SandboxID, err)
+	}
+
+	return target.Info.ID, nil
+}
+
+// GetNodeSandboxes returns the sandboxes running on the node.
+func (o *Orchestrator) GetNodeSandboxes(nodeID string) []*instance.InstanceInfo {
+	sandboxes := make([]*instance.InstanceInfo, 0)
+	for _, sbx := range o.instanceCache.Items() {
+		if sbx.Instance.ClientID == nodeID {
+			sandboxes = append(sandboxes, sbx)
+		}
+	}
+
+	return sandboxes
+}
@@ -0,0 +1,178 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const maxDomainLength = 253
+
+// NetworkPolicyFromAPI validates the network policy from the request and converts it for the orchestrator.
+func NetworkPolicyFromAPI(policy *api.SandboxNetworkPolicy) (*orchestrator.SandboxNetworkPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	result := &orchestrator.SandboxNetworkPolicy{
+		AllowedCidrs:   deref(policy.AllowedCidrs),
+		DeniedCidrs:    deref(policy.DeniedCidrs),
+		AllowedDomains: deref(policy.AllowedDomains),
+		DeniedDomains:  deref(policy.DeniedDomains),
+		AllowedPorts:   ports(deref(policy.AllowedPorts)),
+		DeniedPorts:    ports(deref(policy.DeniedPorts)),
+	}
+
+	for _, cidr := range slices.Concat(result.GetAllowedCidrs(), result.GetDeniedCidrs()) {
+		if err := validateCIDR(cidr); err != nil {
+			return nil, err
+		}
+	}
+
+	// The allowed addresses override the denied ones, allowing all of them would make the denied ones useless
+	for _, cidr := range result.GetAllowedCidrs() {
+		if strings.HasSuffix(cidr, "/0") {
+			return nil, fmt.Errorf("CIDR '%s' can't be allowed, it includes all addresses", cidr)
+		}
+	}
+
+	for _, domain := range slices.Concat(result.GetAllowedDomains(), result.GetDeniedDomains()) {
+		if domain == "" || len(domain) > maxDomainLength || strings.ContainsAny(domain, "/:* ") {
+			return nil, fmt.Errorf("invalid domain '%s'", domain)
+		}
+	}
+
+	for _, port := range slices.Concat(result.GetAllowedPorts(), result.GetDeniedPorts()) {
+		if port == 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid port %d", port)
+		}
+	}
+
+	return result, nil
+}
+
+// NetworkPolicyFromSnapshot converts the network policy stored with the sandbox snapshot for the orchestrator.
+func NetworkPolicyFromSnapshot(policy *types.SandboxNetworkPolicy) *orchestrator.SandboxNetworkPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &orchestrator.SandboxNetworkPolicy{
+		AllowedCidrs:   policy.AllowedCIDRs,
+		DeniedCidrs:    policy.DeniedCIDRs,
+		AllowedDomains: policy.AllowedDomains,
+		DeniedDomains:  policy.DeniedDomains,
+		AllowedPorts:   policy.AllowedPorts,
+		DeniedPorts:    policy.DeniedPorts,
+	}
+}
+
+// snapshotNetworkPolicy converts the network policy of the sandbox so it can be stored with its snapshot.
+func snapshotNetworkPolicy(policy *orchestrator.SandboxNetworkPolicy) *types.SandboxNetworkPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &types.SandboxNetworkPolicy{
+		AllowedCIDRs:   policy.GetAllowedCidrs(),
+		DeniedCIDRs:    policy.GetDeniedCidrs(),
+		AllowedDomains: policy.GetAllowedDomains(),
+		DeniedDomains:  policy.GetDeniedDomains(),
+		AllowedPorts:   policy.GetAllowedPorts(),
+		DeniedPorts:    policy.GetDeniedPorts(),
+	}
+}
+
+func (o *Orchestrator) UpdateNetworkPolicy(ctx context.Context, sbx *instance.InstanceInfo, policy *orchestrator.SandboxNetworkPolicy) *api.APIError {
+	childCtx, childSpan := o.tracer.Start(ctx, "update-sandbox-network-policy",
+		trace.WithAttributes(
+			attribute.String("instance.id", sbx.Instance.SandboxID),
+		),
+	)
+	defer childSpan.End()
+
+	client, err := o.GetClient(sbx.Instance.ClientID)
+	if err != nil {
+		return &api.APIError{
+			Code:      http.StatusInternalServerError,
+			ClientMsg: "Error when updating sandbox network policy",
+			Err:       fmt.Errorf("failed to get client '%s': %w", sbx.Instance.ClientID, err),
+		}
+	}
+
+	_, err = client.Sandbox.UpdateNetworkPolicy(childCtx, &orchestrator.SandboxUpdateNetworkPolicyRequest{
+		SandboxId:     sbx.Instance.SandboxID,
+		NetworkPolicy: policy,
+	})
+	if err != nil {
+		code := http.StatusInternalServerError
+		clientMsg := "Error when updating sandbox network policy"
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			code = http.StatusBadRequest
+			clientMsg = st.Message()
+		}
+
+		return &api.APIError{
+			Code:      code,
+			ClientMsg: clientMsg,
+			Err:       fmt.Errorf("failed to update network policy of sandbox '%s': %w", sbx.Instance.SandboxID, utils.UnwrapGRPCError(err)),
+		}
+	}
+
+	sbx.SetNetworkPolicy(policy)
+
+	telemetry.ReportEvent(childCtx, "Updated sandbox network policy")
+
+	return nil
+}
+
+func validateCIDR(cidr string) error {
+	if strings.Contains(cidr, "/") {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil || !prefix.Addr().Is4() {
+			return fmt.Errorf("invalid IPv4 CIDR '%s'", cidr)
+		}
+
+		return nil
+	}
+
+	addr, err := netip.ParseAddr(cidr)
+	if err != nil || !addr.Is4() {
+		return fmt.Errorf("invalid IPv4 address '%s'", cidr)
+	}
+
+	return nil
+}
+
+func ports(values []api.NetworkPort) []uint32 {
+	result := make([]uint32, 0, len(values))
+	for _, value := range values {
+		// Negative ports wrap around and are rejected by the validation
+		result = append(result, uint32(value))
+	}
+
+	return result
+}
+
+func deref[T any](values *[]T) []T {
+	if values == nil {
+		return nil
+	}
+
+	return *values
+}
@@ -29,7 +29,9 @@ type sbxInProgress struct {
 type Node struct {
 	CPUUsage atomic.Int64
 	RamUsage atomic.Int64
-	Client   *grpclient.GRPCClient
+	// RamReclaimed is the memory in MiB reclaimed from the sandboxes on the node by the balloon devices.
+	RamReclaimed atomic.Int64
+	Client       *grpclient.GRPCClient
 
 	Info           *node.NodeInfo
 	orchestratorID string
@@ -39,10 +41,34 @@ type Node struct {
 	statusMu       sync.RWMutex
 
 	sbxsInProgress *smap.Map[*sbxInProgress]
+	// pooled are the pre-warmed sandboxes on the node which weren't claimed yet.
+	pooled *smap.Map[*pooledSandbox]
 
 	buildCache *ttlcache.Cache[string, interface{}]
+	// templateCache are the templates the recently started sandboxes on the node are based on.
+	templateCache *ttlcache.Cache[string, interface{}]
 
-	createFails atomic.Uint64
+	createFails       atomic.Uint64
+	recentCreateFails *recentFailures
+}
+
+// usage returns the CPUs and memory in MiB used on the node, including the sandboxes being started and the pooled ones.
+// The memory reclaimed from the sandboxes by the balloon devices isn't counted.
+func (n *Node) usage() (cpus int64, ramMiB int64) {
+	cpus = n.CPUUsage.Load()
+	ramMiB = n.RamUsage.Load() - n.RamReclaimed.Load()
+
+	for _, sbx := range n.sbxsInProgress.Items() {
+		cpus += sbx.CPUs
+		ramMiB += sbx.MiBMemory
+	}
+
+	for _, sbx := range n.pooled.Items() {
+		cpus += sbx.cpus
+		ramMiB += sbx.ramMiB
+	}
+
+	return cpus, ramMiB
 }
 
 func (n *Node) Status() api.NodeStatus {
@@ -180,15 +206,15 @@ func (o *Orchestrator) GetNodeDetail(nodeID string) *api.NodeDetail {
 	for _, sbx := range o.instanceCache.Items() {
 		if sbx.Instance.ClientID == nodeID {
 			var metadata *api.SandboxMetadata
-			if sbx.Metadata != nil {
-				meta := api.SandboxMetadata(sbx.Metadata)
+			if sbxMetadata := sbx.GetMetadata(); sbxMetadata != nil {
+				meta := api.SandboxMetadata(sbxMetadata)
 				metadata = &meta
 			}
 			node.Sandboxes = append(node.Sandboxes, api.ListedSandbox{
 				Alias:      sbx.Instance.Alias,
 				ClientID:   nodeID,
 				CpuCount:   api.CPUCount(sbx.VCpu),
-				MemoryMB:   api.MemoryMB(sbx.RamMB),
+				MemoryMB:   api.MemoryMB(sbx.MemoryMB()),
 				EndAt:      sbx.GetEndTime(),
 				Metadata:   metadata,
 				SandboxID:  sbx.Instance.SandboxID,
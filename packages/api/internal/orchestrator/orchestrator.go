@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync/atomic"
 	"time"
 
 	nomadapi "github.com/hashicorp/nomad/api"
@@ -18,6 +19,8 @@ import (
 	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
 	"github.com/e2b-dev/infra/packages/api/internal/dns"
 	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/api/internal/usage"
+	"github.com/e2b-dev/infra/packages/api/internal/webhooks"
 	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
 	"github.com/e2b-dev/infra/packages/shared/pkg/db"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
@@ -43,6 +46,11 @@ type Orchestrator struct {
 	dbClient            *db.DB
 	tel                 *telemetry.Client
 	metricsRegistration metric.Registration
+	placement           placementScorer
+	warmPoolTargets     atomic.Pointer[map[warmPoolKey]WarmPoolTarget]
+	warmPoolClaims      metric.Int64Counter
+	webhooks            *webhooks.Dispatcher
+	usage               *usage.Recorder
 }
 
 func New(
@@ -53,6 +61,8 @@ func New(
 	posthogClient *analyticscollector.PosthogClient,
 	redisClient redis.UniversalClient,
 	dbClient *db.DB,
+	webhookDispatcher *webhooks.Dispatcher,
+	usageRecorder *usage.Recorder,
 ) (*Orchestrator, error) {
 	analyticsInstance, err := analyticscollector.NewAnalytics()
 	if err != nil {
@@ -72,6 +82,12 @@ func New(
 		Timeout: nodeHealthCheckTimeout,
 	}
 
+	warmPoolClaims, err := telemetry.GetCounter(tel.MeterProvider.Meter("api.orchestrator"), telemetry.WarmPoolClaimCounterMeterName)
+	if err != nil {
+		zap.L().Error("Failed to create warm pool claims counter", zap.Error(err))
+		return nil, fmt.Errorf("failed to create warm pool claims counter: %w", err)
+	}
+
 	o := Orchestrator{
 		httpClient:  httpClient,
 		analytics:   analyticsInstance,
@@ -81,6 +97,11 @@ func New(
 		dns:         dnsServer,
 		dbClient:    dbClient,
 		tel:         tel,
+		placement:   placementScorerFromEnv(),
+
+		warmPoolClaims: warmPoolClaims,
+		webhooks:       webhookDispatcher,
+		usage:          usageRecorder,
 	}
 
 	cache := instance.NewCache(
@@ -90,6 +111,7 @@ func New(
 		o.getDeleteInstanceFunction(ctx, posthogClient, cacheHookTimeout),
 	)
 
+	cache.SetPooledUsage(o.pooledUsage)
 	o.instanceCache = cache
 
 	if env.IsLocal() {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gogo/status"
 	"github.com/google/uuid"
@@ -32,23 +33,16 @@ func (o *Orchestrator) PauseInstance(
 	ctx, span := o.tracer.Start(ctx, "pause-sandbox")
 	defer span.End()
 
-	snapshotConfig := &db.SnapshotInfo{
-		BaseTemplateID:     sbx.Instance.TemplateID,
-		SandboxID:          sbx.Instance.SandboxID,
-		SandboxStartedAt:   sbx.StartTime,
-		VCPU:               sbx.VCpu,
-		RAMMB:              sbx.RamMB,
-		TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
-		Metadata:           sbx.Metadata,
-		KernelVersion:      sbx.KernelVersion,
-		FirecrackerVersion: sbx.FirecrackerVersion,
-		EnvdVersion:        sbx.Instance.EnvdVersion,
-		EnvdSecured:        sbx.EnvdAccessToken != nil,
-	}
+	snapshotInfo := NewSnapshotInfo(sbx)
+
+	pausedAt := time.Now()
+	snapshotInfo.PausedAt = &pausedAt
+	snapshotInfo.RetentionHours = sbx.GetPausedRetentionHours()
+	snapshotInfo.Migration = sbx.IsMigrating()
 
 	envBuild, err := o.dbClient.NewSnapshotBuild(
 		ctx,
-		snapshotConfig,
+		snapshotInfo,
 		teamID,
 	)
 	if err != nil {
@@ -80,6 +74,37 @@ func (o *Orchestrator) PauseInstance(
 	return nil
 }
 
+// NewSnapshotInfo returns the configuration of the running sandbox stored with its snapshot builds.
+func NewSnapshotInfo(sbx *instance.InstanceInfo) *db.SnapshotInfo {
+	info := &db.SnapshotInfo{
+		BaseTemplateID:     sbx.BaseTemplateID,
+		SandboxID:          sbx.Instance.SandboxID,
+		SandboxStartedAt:   sbx.StartTime,
+		VCPU:               sbx.VCpu,
+		RAMMB:              sbx.RamMB,
+		MemoryTargetMB:     sbx.MemoryTargetMB,
+		TotalDiskSizeMB:    sbx.TotalDiskSizeMB,
+		Metadata:           sbx.GetMetadata(),
+		KernelVersion:      sbx.KernelVersion,
+		FirecrackerVersion: sbx.FirecrackerVersion,
+		EnvdVersion:        sbx.Instance.EnvdVersion,
+		EnvdSecured:        sbx.EnvdAccessToken != nil,
+		NetworkPolicy:      snapshotNetworkPolicy(sbx.GetNetworkPolicy()),
+		PortAccess:         snapshotPortAccess(sbx.PortAccess),
+		SourceTemplateID:   sbx.Instance.TemplateID,
+		SourceBuildID:      sbx.BuildID,
+	}
+
+	if sbx.RateLimits != nil {
+		info.NetworkBandwidthMiBps = sbx.RateLimits.GetNetworkBandwidth() >> shiftFromMiBToBytes
+		info.NetworkOps = sbx.RateLimits.GetNetworkOps()
+		info.DiskBandwidthMiBps = sbx.RateLimits.GetDiskBandwidth() >> shiftFromMiBToBytes
+		info.DiskIops = sbx.RateLimits.GetDiskOps()
+	}
+
+	return info
+}
+
 func snapshotInstance(ctx context.Context, orch *Orchestrator, sbx *instance.InstanceInfo, templateID, buildID string) error {
 	_, childSpan := orch.tracer.Start(ctx, "snapshot-instance")
 	defer childSpan.End()
@@ -0,0 +1,203 @@
+package orchestrator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+)
+
+const (
+	// PlacementStrategyEnv selects how the nodes for new sandboxes are picked.
+	PlacementStrategyEnv = "SANDBOX_PLACEMENT_STRATEGY"
+
+	// PlacementStrategyLeastBusy picks the node with the least CPUs and memory committed.
+	PlacementStrategyLeastBusy = "least-busy"
+	// PlacementStrategyBuildCache prefers the nodes which already have the template layers cached,
+	// weighed against the node load, the sandboxes being started and the recent start failures.
+	PlacementStrategyBuildCache = "build-cache"
+
+	// recentCreateFailsWindow is the time the failed sandbox starts are counted against the node.
+	recentCreateFailsWindow = 5 * time.Minute
+
+	// templateCacheExpiration is the time a template is considered cached on the node after the last sandbox started from it.
+	templateCacheExpiration = 10 * time.Minute
+
+	// leastBusyRamMiBPerCPU is the memory weighed the same as one CPU by the least busy placement,
+	// it's the memory per CPU of the cluster nodes.
+	leastBusyRamMiBPerCPU = 4096
+)
+
+// placementRequest is the sandbox being placed.
+type placementRequest struct {
+	buildID string
+	// baseTemplateID is the template the layers of the build are based on.
+	baseTemplateID string
+}
+
+// nodeStats is the state of the node the placement is decided on.
+type nodeStats struct {
+	cpus   int64
+	ramMiB int64
+
+	inProgress        int
+	recentCreateFails int
+
+	buildCached        bool
+	baseTemplateCached bool
+}
+
+// placementScorer scores the nodes for the sandbox, the node with the lowest score is picked.
+type placementScorer interface {
+	score(stats nodeStats) float64
+}
+
+func newPlacementScorer(strategy string) (placementScorer, error) {
+	switch strategy {
+	case PlacementStrategyLeastBusy:
+		return leastBusyScorer{}, nil
+	case PlacementStrategyBuildCache:
+		return defaultBuildCacheScorer, nil
+	default:
+		return nil, fmt.Errorf("unknown placement strategy '%s'", strategy)
+	}
+}
+
+// placementScorerFromEnv returns the scorer selected by the environment, it falls back to the least busy placement.
+func placementScorerFromEnv() placementScorer {
+	strategy := env.GetEnv(PlacementStrategyEnv, PlacementStrategyLeastBusy)
+
+	scorer, err := newPlacementScorer(strategy)
+	if err != nil {
+		zap.L().Error("Invalid placement strategy, using the least busy placement", zap.Error(err))
+
+		return leastBusyScorer{}
+	}
+
+	zap.L().Info("Using sandbox placement strategy", zap.String("strategy", strategy))
+
+	return scorer
+}
+
+type leastBusyScorer struct{}
+
+func (leastBusyScorer) score(stats nodeStats) float64 {
+	// The memory reclaimed by the balloon devices isn't part of the stats, so the nodes with the inflated balloons are preferred.
+	return float64(stats.cpus) + float64(stats.ramMiB)/leastBusyRamMiBPerCPU
+}
+
+// buildCacheScorer weighs the node load against the cost of fetching the template layers.
+// The weights are in the units of committed CPUs.
+type buildCacheScorer struct {
+	perCPU              float64
+	perRamGiB           float64
+	perInProgress       float64
+	perRecentCreateFail float64
+	buildCached         float64
+	baseTemplateCached  float64
+}
+
+var defaultBuildCacheScorer = buildCacheScorer{
+	perCPU:              1,
+	perRamGiB:           0.5,
+	perInProgress:       2,
+	perRecentCreateFail: 4,
+	buildCached:         8,
+	baseTemplateCached:  4,
+}
+
+func (s buildCacheScorer) score(stats nodeStats) float64 {
+	score := s.perCPU*float64(stats.cpus) +
+		s.perRamGiB*float64(stats.ramMiB)/1024 +
+		s.perInProgress*float64(stats.inProgress) +
+		s.perRecentCreateFail*float64(stats.recentCreateFails)
+
+	switch {
+	case stats.buildCached:
+		score -= s.buildCached
+	case stats.baseTemplateCached:
+		score -= s.baseTemplateCached
+	}
+
+	return score
+}
+
+// pickNode returns the node with the lowest score, nil if there are no nodes.
+func pickNode(nodes []*Node, scorer placementScorer, sbx placementRequest) *Node {
+	var best *Node
+	var bestScore float64
+
+	for _, node := range nodes {
+		score := scorer.score(node.stats(sbx))
+		if best == nil || score < bestScore {
+			best = node
+			bestScore = score
+		}
+	}
+
+	return best
+}
+
+func (n *Node) stats(sbx placementRequest) nodeStats {
+	cpus, ramMiB := n.usage()
+
+	return nodeStats{
+		cpus:               cpus,
+		ramMiB:             ramMiB,
+		inProgress:         n.sbxsInProgress.Count(),
+		recentCreateFails:  n.recentCreateFails.count(time.Now()),
+		buildCached:        sbx.buildID != "" && n.buildCache.Has(sbx.buildID),
+		baseTemplateCached: sbx.baseTemplateID != "" && n.templateCache.Has(sbx.baseTemplateID),
+	}
+}
+
+// reportCreateFail records the failed sandbox start on the node.
+func (n *Node) reportCreateFail() {
+	n.createFails.Add(1)
+	n.recentCreateFails.add(time.Now())
+}
+
+// InsertTemplate marks the layers of the template as cached on the node.
+func (n *Node) InsertTemplate(templateID string) {
+	if templateID == "" {
+		return
+	}
+
+	n.templateCache.Set(templateID, struct{}{}, templateCacheExpiration)
+}
+
+// recentFailures counts the failures in the last window.
+type recentFailures struct {
+	window time.Duration
+
+	mu    sync.Mutex
+	times []time.Time
+}
+
+func newRecentFailures(window time.Duration) *recentFailures {
+	return &recentFailures{window: window}
+}
+
+func (r *recentFailures) add(t time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.times = append(r.times, t)
+}
+
+func (r *recentFailures) count(now time.Time) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The failures are added in order, drop the ones which are out of the window
+	i := 0
+	for i < len(r.times) && now.Sub(r.times[i]) > r.window {
+		i++
+	}
+	r.times = r.times[i:]
+
+	return len(r.times)
+}
@@ -0,0 +1,218 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+const (
+	testBuildID        = "build-id"
+	testBaseTemplateID = "base-template-id"
+)
+
+type fakeNode struct {
+	id                string
+	cpus              int64
+	ramMiB            int64
+	ramReclaimedMiB   int64
+	inProgress        int
+	recentCreateFails int
+	builds            []string
+	templates         []string
+}
+
+func newFakeCluster(fakes ...fakeNode) []*Node {
+	nodes := make([]*Node, 0, len(fakes))
+	for _, fake := range fakes {
+		n := &Node{
+			Info:              &node.NodeInfo{ID: fake.id},
+			sbxsInProgress:    smap.New[*sbxInProgress](),
+			pooled:            smap.New[*pooledSandbox](),
+			buildCache:        ttlcache.New[string, interface{}](),
+			templateCache:     ttlcache.New[string, interface{}](),
+			recentCreateFails: newRecentFailures(recentCreateFailsWindow),
+		}
+
+		n.CPUUsage.Store(fake.cpus)
+		n.RamUsage.Store(fake.ramMiB)
+		n.RamReclaimed.Store(fake.ramReclaimedMiB)
+
+		for i := range fake.inProgress {
+			n.sbxsInProgress.Insert(string(rune('a'+i)), &sbxInProgress{})
+		}
+
+		for range fake.recentCreateFails {
+			n.reportCreateFail()
+		}
+
+		for _, build := range fake.builds {
+			n.InsertBuild(build)
+		}
+
+		for _, template := range fake.templates {
+			n.InsertTemplate(template)
+		}
+
+		nodes = append(nodes, n)
+	}
+
+	return nodes
+}
+
+func pickNodeID(t *testing.T, nodes []*Node, scorer placementScorer) string {
+	t.Helper()
+
+	picked := pickNode(nodes, scorer, placementRequest{buildID: testBuildID, baseTemplateID: testBaseTemplateID})
+	require.NotNil(t, picked)
+
+	return picked.Info.ID
+}
+
+func TestPickNode_NoNodes(t *testing.T) {
+	assert.Nil(t, pickNode(nil, defaultBuildCacheScorer, placementRequest{buildID: testBuildID}))
+}
+
+func TestLeastBusyScorer(t *testing.T) {
+	t.Run("picks the node with the least CPUs", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "busy", cpus: 16},
+			fakeNode{id: "idle", cpus: 4, ramMiB: 16 * 1024},
+		)
+
+		assert.Equal(t, "idle", pickNodeID(t, nodes, leastBusyScorer{}))
+	})
+
+	t.Run("weighs memory against CPUs", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "memory-heavy", cpus: 4, ramMiB: 64 * 1024},
+			fakeNode{id: "cpu-heavy", cpus: 8, ramMiB: 8 * 1024},
+		)
+
+		assert.Equal(t, "cpu-heavy", pickNodeID(t, nodes, leastBusyScorer{}))
+	})
+
+	t.Run("doesn't count the reclaimed memory", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "ballooned", cpus: 8, ramMiB: 64 * 1024, ramReclaimedMiB: 48 * 1024},
+			fakeNode{id: "full", cpus: 8, ramMiB: 32 * 1024},
+		)
+
+		assert.Equal(t, "ballooned", pickNodeID(t, nodes, leastBusyScorer{}))
+	})
+
+	t.Run("breaks CPU ties with memory", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "more-memory", cpus: 8, ramMiB: 8 * 1024},
+			fakeNode{id: "less-memory", cpus: 8, ramMiB: 4 * 1024},
+		)
+
+		assert.Equal(t, "less-memory", pickNodeID(t, nodes, leastBusyScorer{}))
+	})
+
+	t.Run("ignores the build cache", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "cached", cpus: 6, builds: []string{testBuildID}},
+			fakeNode{id: "idle", cpus: 4},
+		)
+
+		assert.Equal(t, "idle", pickNodeID(t, nodes, leastBusyScorer{}))
+	})
+}
+
+func TestBuildCacheScorer(t *testing.T) {
+	t.Run("prefers the node with the build cached", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "idle", cpus: 4},
+			fakeNode{id: "cached", cpus: 8, builds: []string{testBuildID}},
+		)
+
+		assert.Equal(t, "cached", pickNodeID(t, nodes, defaultBuildCacheScorer))
+	})
+
+	t.Run("prefers the build over the base template", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "template", cpus: 4, templates: []string{testBaseTemplateID}},
+			fakeNode{id: "build", cpus: 4, builds: []string{testBuildID}},
+		)
+
+		assert.Equal(t, "build", pickNodeID(t, nodes, defaultBuildCacheScorer))
+	})
+
+	t.Run("prefers the base template over no cache", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "empty", cpus: 4},
+			fakeNode{id: "template", cpus: 6, templates: []string{testBaseTemplateID}},
+		)
+
+		assert.Equal(t, "template", pickNodeID(t, nodes, defaultBuildCacheScorer))
+	})
+
+	t.Run("doesn't overload the cached node", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "cached", cpus: 64, builds: []string{testBuildID}},
+			fakeNode{id: "idle", cpus: 4},
+		)
+
+		assert.Equal(t, "idle", pickNodeID(t, nodes, defaultBuildCacheScorer))
+	})
+
+	t.Run("avoids the node with sandboxes being started", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "starting", cpus: 4, inProgress: 3},
+			fakeNode{id: "idle", cpus: 6},
+		)
+
+		assert.Equal(t, "idle", pickNodeID(t, nodes, defaultBuildCacheScorer))
+	})
+
+	t.Run("avoids the node with recent failures", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "failing", cpus: 4, builds: []string{testBuildID}, recentCreateFails: 3},
+			fakeNode{id: "healthy", cpus: 4},
+		)
+
+		assert.Equal(t, "healthy", pickNodeID(t, nodes, defaultBuildCacheScorer))
+	})
+
+	t.Run("prefers the node with less memory committed", func(t *testing.T) {
+		nodes := newFakeCluster(
+			fakeNode{id: "more-memory", cpus: 4, ramMiB: 32 * 1024},
+			fakeNode{id: "less-memory", cpus: 4, ramMiB: 8 * 1024},
+		)
+
+		assert.Equal(t, "less-memory", pickNodeID(t, nodes, defaultBuildCacheScorer))
+	})
+}
+
+func TestNewPlacementScorer(t *testing.T) {
+	scorer, err := newPlacementScorer(PlacementStrategyLeastBusy)
+	require.NoError(t, err)
+	assert.Equal(t, leastBusyScorer{}, scorer)
+
+	scorer, err = newPlacementScorer(PlacementStrategyBuildCache)
+	require.NoError(t, err)
+	assert.Equal(t, defaultBuildCacheScorer, scorer)
+
+	_, err = newPlacementScorer("random")
+	require.Error(t, err)
+}
+
+func TestRecentFailures(t *testing.T) {
+	now := time.Now()
+
+	failures := newRecentFailures(time.Minute)
+	failures.add(now.Add(-2 * time.Minute))
+	failures.add(now.Add(-30 * time.Second))
+	failures.add(now)
+
+	assert.Equal(t, 2, failures.count(now))
+	assert.Equal(t, 1, failures.count(now.Add(45*time.Second)))
+	assert.Equal(t, 0, failures.count(now.Add(2*time.Minute)))
+}
@@ -0,0 +1,55 @@
+package orchestrator
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
+)
+
+// PortAccessFromAPI validates the public ports from the request and converts them for the orchestrator.
+// When the public ports aren't set, all ports of the sandbox are accessible.
+func PortAccessFromAPI(publicPorts *[]api.NetworkPort) (*orchestrator.SandboxPortAccess, error) {
+	if publicPorts == nil {
+		return nil, nil
+	}
+
+	result := &orchestrator.SandboxPortAccess{
+		PublicPorts: ports(*publicPorts),
+	}
+
+	for _, port := range result.GetPublicPorts() {
+		if port == 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid port %d", port)
+		}
+	}
+
+	slices.Sort(result.PublicPorts)
+	result.PublicPorts = slices.Compact(result.PublicPorts)
+
+	return result, nil
+}
+
+// PortAccessFromSnapshot converts the port access stored with the sandbox snapshot for the orchestrator.
+func PortAccessFromSnapshot(access *types.SandboxPortAccess) *orchestrator.SandboxPortAccess {
+	if access == nil {
+		return nil
+	}
+
+	return &orchestrator.SandboxPortAccess{
+		PublicPorts: access.PublicPorts,
+	}
+}
+
+// snapshotPortAccess converts the port access of the sandbox so it can be stored with its snapshot.
+func snapshotPortAccess(access *orchestrator.SandboxPortAccess) *types.SandboxPortAccess {
+	if access == nil {
+		return nil
+	}
+
+	return &types.SandboxPortAccess{
+		PublicPorts: access.GetPublicPorts(),
+	}
+}
@@ -0,0 +1,35 @@
+package orchestrator
+
+import (
+	"fmt"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+// teamQuota returns the limits of the running sandboxes of the team.
+func teamQuota(tier *models.Tier) instance.Quota {
+	return instance.Quota{
+		Instances: tier.ConcurrentInstances,
+		VCPU:      tier.ConcurrentVcpus,
+		RAMMB:     tier.ConcurrentRAMMB,
+	}
+}
+
+// quotaExceededMessage lists the current usage of the team against its quota.
+func quotaExceededMessage(err *instance.ErrQuotaExceeded) string {
+	return fmt.Sprintf(
+		"you have reached the resource quota of your team: vCPUs %d in use + %d requested (limit %s), memory %d MiB in use + %d MiB requested (limit %s). "+
+			"If you need more, please contact us at 'https://e2b.dev/docs/getting-help'",
+		err.Usage.VCPU, err.Requested.VCPU, quotaLimit(err.Quota.VCPU, ""),
+		err.Usage.RAMMB, err.Requested.RAMMB, quotaLimit(err.Quota.RAMMB, " MiB"),
+	)
+}
+
+func quotaLimit(limit int64, unit string) string {
+	if limit <= 0 {
+		return "unlimited"
+	}
+
+	return fmt.Sprintf("%d%s", limit, unit)
+}
@@ -0,0 +1,32 @@
+package orchestrator
+
+import (
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+const shiftFromMiBToBytes = 20
+
+// sandboxRateLimits returns the rate limits of the sandbox, the build can override the team tier defaults up to the tier limits.
+func sandboxRateLimits(tier *models.Tier, build queries.EnvBuild) *orchestrator.SandboxRateLimits {
+	return &orchestrator.SandboxRateLimits{
+		NetworkBandwidth: rateLimit(tier.NetworkBandwidthMibps, build.NetworkBandwidthMibps) << shiftFromMiBToBytes,
+		NetworkOps:       rateLimit(tier.NetworkOps, build.NetworkOps),
+		DiskBandwidth:    rateLimit(tier.DiskBandwidthMibps, build.DiskBandwidthMibps) << shiftFromMiBToBytes,
+		DiskOps:          rateLimit(tier.DiskIops, build.DiskIops),
+	}
+}
+
+// rateLimit returns the override if it's set and not higher than the tier limit, 0 means unlimited.
+func rateLimit(tierLimit int64, override *int64) int64 {
+	if override == nil || *override <= 0 {
+		return tierLimit
+	}
+
+	if tierLimit > 0 && *override > tierLimit {
+		return tierLimit
+	}
+
+	return *override
+}
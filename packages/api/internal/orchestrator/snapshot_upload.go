@@ -0,0 +1,66 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+// snapshotUploadTimeout is the time the upload of the live snapshot can take before the build is marked as failed.
+const snapshotUploadTimeout = 30 * time.Minute
+
+// finishSnapshotBuild waits until the node uploads the live snapshot taken by Fork or Checkpoint
+// and only then marks the build as successful, so it isn't resumed on other nodes before the files are in the storage.
+func (o *Orchestrator) finishSnapshotBuild(node *Node, envBuild *models.EnvBuild) {
+	ctx, cancel := context.WithTimeout(context.Background(), snapshotUploadTimeout)
+	defer cancel()
+
+	status := envbuild.StatusSuccess
+
+	_, err := node.Client.Sandbox.WaitForUpload(ctx, &orchestrator.SandboxWaitForUploadRequest{
+		BuildId: envBuild.ID.String(),
+	})
+	if err != nil {
+		zap.L().Error("Error uploading snapshot",
+			logger.WithBuildID(envBuild.ID.String()),
+			logger.WithTemplateID(*envBuild.EnvID),
+			zap.Error(utils.UnwrapGRPCError(err)),
+		)
+
+		status = envbuild.StatusFailed
+	}
+
+	err = o.dbClient.EnvBuildSetStatus(ctx, *envBuild.EnvID, envBuild.ID, status)
+	if err != nil {
+		zap.L().Error("Error setting snapshot build status",
+			logger.WithBuildID(envBuild.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// WaitForSnapshotUpload waits until the node uploads the snapshot of the paused sandbox,
+// the sandbox can be resumed on the other nodes only after that.
+func (o *Orchestrator) WaitForSnapshotUpload(ctx context.Context, nodeID string, buildID string) error {
+	node := o.GetNode(nodeID)
+	if node == nil {
+		return fmt.Errorf("node '%s' not found", nodeID)
+	}
+
+	_, err := node.Client.Sandbox.WaitForUpload(ctx, &orchestrator.SandboxWaitForUploadRequest{
+		BuildId: buildID,
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading snapshot build '%s': %w", buildID, utils.UnwrapGRPCError(err))
+	}
+
+	return nil
+}
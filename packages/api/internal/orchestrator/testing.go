@@ -0,0 +1,34 @@
+package orchestrator
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+// NewWithSandboxes returns the orchestrator without any node which knows only the running sandboxes,
+// it's used by the tests of the handlers looking the sandboxes up.
+func NewWithSandboxes(ctx context.Context, sandboxes ...*instance.InstanceInfo) *Orchestrator {
+	o := &Orchestrator{
+		nodes:  smap.New[*Node](),
+		tracer: tracenoop.NewTracerProvider().Tracer(""),
+	}
+
+	o.instanceCache = instance.NewCache(
+		ctx,
+		noop.NewMeterProvider(),
+		func(*instance.InstanceInfo, bool) error { return nil },
+		func(*instance.InstanceInfo) error { return nil },
+	)
+	o.instanceCache.SetPooledUsage(o.pooledUsage)
+
+	for _, sbx := range sandboxes {
+		o.instanceCache.Set(sbx.Instance.SandboxID, sbx, false)
+	}
+
+	return o
+}
@@ -0,0 +1,364 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/api/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/api/internal/utils"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/id"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const (
+	// warmPoolMaxCreatesPerRefill limits how many pooled sandboxes are started at once, so refilling doesn't starve the user requests.
+	warmPoolMaxCreatesPerRefill = 10
+	// warmPoolSandboxMaxAge is the time after which an unclaimed pooled sandbox is replaced by a fresh one.
+	warmPoolSandboxMaxAge = time.Hour
+
+	pooledSandboxIDPrefix = "pool-"
+)
+
+// WarmPoolTarget is the number of pooled sandboxes of the build kept for the team.
+type WarmPoolTarget struct {
+	Team  authcache.AuthTeamInfo
+	Build queries.EnvBuild
+	Size  int
+}
+
+type warmPoolKey struct {
+	teamID     uuid.UUID
+	templateID string
+}
+
+func (t WarmPoolTarget) key() warmPoolKey {
+	return warmPoolKey{teamID: t.Team.Team.ID, templateID: *t.Build.EnvID}
+}
+
+// pooledSandbox is a pre-warmed sandbox running on a node, waiting to be claimed.
+type pooledSandbox struct {
+	sandboxID   string
+	executionID string
+	teamID      uuid.UUID
+	templateID  string
+	buildID     string
+	cpus        int64
+	ramMiB      int64
+	created     time.Time
+}
+
+func (p *pooledSandbox) key() warmPoolKey {
+	return warmPoolKey{teamID: p.teamID, templateID: p.templateID}
+}
+
+// syncPooled updates the pooled sandboxes with the ones listed on the node.
+// Sandboxes created after the listing started are kept, they might not have been listed yet.
+func (n *Node) syncPooled(listed []*pooledSandbox, listedAt time.Time) {
+	ids := make(map[string]struct{}, len(listed))
+	for _, sbx := range listed {
+		ids[sbx.sandboxID] = struct{}{}
+		n.pooled.InsertIfAbsent(sbx.sandboxID, sbx)
+	}
+
+	for sandboxID, sbx := range n.pooled.Items() {
+		if _, ok := ids[sandboxID]; ok || sbx.created.After(listedAt) {
+			continue
+		}
+
+		n.pooled.RemoveCb(sandboxID, func(_ string, v *pooledSandbox, exists bool) bool {
+			return exists && v == sbx
+		})
+	}
+}
+
+// SetWarmPools sets the warm pools the new sandboxes of the teams are claimed from.
+func (o *Orchestrator) SetWarmPools(targets []WarmPoolTarget) {
+	byKey := make(map[warmPoolKey]WarmPoolTarget, len(targets))
+	for _, target := range targets {
+		byKey[target.key()] = target
+	}
+
+	o.warmPoolTargets.Store(&byKey)
+}
+
+// RefillWarmPools starts the missing pooled sandboxes of the warm pools and removes the pooled sandboxes which are no longer needed.
+func (o *Orchestrator) RefillWarmPools(ctx context.Context) {
+	ctx, span := o.tracer.Start(ctx, "refill-warm-pools")
+	defer span.End()
+
+	var byKey map[warmPoolKey]WarmPoolTarget
+	if targets := o.warmPoolTargets.Load(); targets != nil {
+		byKey = *targets
+	}
+
+	ready := make(map[warmPoolKey]int)
+	for _, node := range o.nodes.Items() {
+		for _, sbx := range node.pooled.Items() {
+			target, ok := byKey[sbx.key()]
+			if !ok || target.Build.ID.String() != sbx.buildID || time.Since(sbx.created) > warmPoolSandboxMaxAge {
+				o.deletePooledSandbox(ctx, node, sbx)
+
+				continue
+			}
+
+			ready[sbx.key()]++
+		}
+	}
+
+	created := 0
+	for key, target := range byKey {
+		for missing := target.Size - ready[key]; missing > 0 && created < warmPoolMaxCreatesPerRefill; missing-- {
+			err := o.createPooledSandbox(ctx, target)
+			if err != nil {
+				zap.L().Error("Error creating pooled sandbox", zap.String("template_id", key.templateID), zap.Error(err))
+
+				break
+			}
+
+			created++
+		}
+	}
+}
+
+func (o *Orchestrator) createPooledSandbox(ctx context.Context, target WarmPoolTarget) error {
+	features, err := sandbox.NewVersionInfo(target.Build.FirecrackerVersion)
+	if err != nil {
+		return fmt.Errorf("failed to get features for firecracker version '%s': %w", target.Build.FirecrackerVersion, err)
+	}
+
+	node, err := o.findLeastBusyNode(nil, placementRequest{
+		buildID:        target.Build.ID.String(),
+		baseTemplateID: BaseTemplateID(target.Build),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get node: %w", err)
+	}
+
+	sandboxID := pooledSandboxIDPrefix + id.Generate()
+
+	// The pooled sandboxes count against the team quota, the pool isn't refilled above it
+	resources := instance.Resources{VCPU: target.Build.Vcpu, RAMMB: target.Build.RamMb}
+	release, err := o.instanceCache.ReserveWithQuota(sandboxID, target.Team.Team.ID, resources, teamQuota(target.Team.Tier))
+	if err != nil {
+		return fmt.Errorf("failed to reserve pooled sandbox: %w", err)
+	}
+	// The reservation is released after the sandbox is added to the pool, from then on it's counted as pooled
+	defer release()
+
+	sbx := &pooledSandbox{
+		sandboxID:   sandboxID,
+		executionID: uuid.New().String(),
+		teamID:      target.Team.Team.ID,
+		templateID:  *target.Build.EnvID,
+		buildID:     target.Build.ID.String(),
+		cpus:        target.Build.Vcpu,
+		ramMiB:      target.Build.RamMb,
+		created:     time.Now(),
+	}
+
+	node.sbxsInProgress.Insert(sbx.sandboxID, &sbxInProgress{
+		MiBMemory: sbx.ramMiB,
+		CPUs:      sbx.cpus,
+	})
+	defer node.sbxsInProgress.Remove(sbx.sandboxID)
+
+	autoPause := false
+	_, err = node.Client.Sandbox.Create(ctx, &orchestrator.SandboxCreateRequest{
+		Sandbox: &orchestrator.SandboxConfig{
+			TemplateId:         sbx.templateID,
+			BaseTemplateId:     BaseTemplateID(target.Build),
+			TeamId:             sbx.teamID.String(),
+			BuildId:            sbx.buildID,
+			SandboxId:          sbx.sandboxID,
+			ExecutionId:        sbx.executionID,
+			KernelVersion:      target.Build.KernelVersion,
+			FirecrackerVersion: target.Build.FirecrackerVersion,
+			EnvdVersion:        *target.Build.EnvdVersion,
+			MaxSandboxLength:   target.Team.Tier.MaxLengthHours,
+			HugePages:          features.HasHugePages(),
+			RamMb:              target.Build.RamMb,
+			Vcpu:               target.Build.Vcpu,
+			AutoPause:          &autoPause,
+			RateLimits:         sandboxRateLimits(target.Team.Tier, target.Build),
+			Pooled:             true,
+		},
+		StartTime: timestamppb.New(sbx.created),
+		EndTime:   timestamppb.New(sbx.created.Add(warmPoolSandboxMaxAge)),
+	})
+	if err != nil {
+		node.reportCreateFail()
+
+		return fmt.Errorf("failed to create pooled sandbox on node '%s': %w", node.Info.ID, utils.UnwrapGRPCError(err))
+	}
+
+	// The node sync keeps the pooled sandboxes created after it listed the node
+	sbx.created = time.Now()
+
+	node.InsertBuild(sbx.buildID)
+	node.InsertTemplate(BaseTemplateID(target.Build))
+	node.pooled.Insert(sbx.sandboxID, sbx)
+
+	return nil
+}
+
+func (o *Orchestrator) deletePooledSandbox(ctx context.Context, node *Node, sbx *pooledSandbox) {
+	removed := node.pooled.RemoveCb(sbx.sandboxID, func(_ string, v *pooledSandbox, exists bool) bool {
+		return exists && v == sbx
+	})
+	if !removed {
+		return
+	}
+
+	_, err := node.Client.Sandbox.Delete(ctx, &orchestrator.SandboxDeleteRequest{SandboxId: sbx.sandboxID})
+	if err != nil {
+		zap.L().Error("Error deleting pooled sandbox", logger.WithSandboxID(sbx.sandboxID), zap.Error(utils.UnwrapGRPCError(err)))
+	}
+}
+
+// takeWarmPoolSandbox removes a pooled sandbox of the build from the warm pool of the team, so it can be claimed.
+// It's taken before the new sandbox is reserved, the pooled sandbox is already counted against the team quota.
+// It returns nil when the team doesn't have the warm pool for the template or there is no pooled sandbox ready.
+func (o *Orchestrator) takeWarmPoolSandbox(ctx context.Context, teamID uuid.UUID, templateID, buildID string) (*Node, *pooledSandbox) {
+	key := warmPoolKey{teamID: teamID, templateID: templateID}
+
+	targets := o.warmPoolTargets.Load()
+	if targets == nil {
+		return nil, nil
+	}
+
+	if _, ok := (*targets)[key]; !ok {
+		return nil, nil
+	}
+
+	node, pooled := o.takePooledSandbox(key, buildID)
+	if node == nil {
+		o.recordWarmPoolClaim(ctx, templateID, false)
+	}
+
+	return node, pooled
+}
+
+// returnPooledSandbox puts the taken pooled sandbox back to the warm pool, when the sandbox claiming it couldn't be started.
+func returnPooledSandbox(node *Node, pooled *pooledSandbox) {
+	node.pooled.InsertIfAbsent(pooled.sandboxID, pooled)
+}
+
+// claimPooledSandbox assigns the pooled sandbox taken from the warm pool to the sandbox from the request.
+// The execution ID of the request is replaced by the one of the pooled sandbox.
+// The memory target of the request is replaced by the one applied to the claimed sandbox.
+// It returns false when the claim failed, the node stops the pooled sandbox then.
+func (o *Orchestrator) claimPooledSandbox(ctx context.Context, node *Node, pooled *pooledSandbox, sbxRequest *orchestrator.SandboxCreateRequest) bool {
+	config := sbxRequest.GetSandbox()
+
+	node.sbxsInProgress.Insert(config.SandboxId, &sbxInProgress{
+		MiBMemory: pooled.ramMiB,
+		CPUs:      pooled.cpus,
+	})
+
+	executionID := config.ExecutionId
+	config.ExecutionId = pooled.executionID
+
+	res, err := node.Client.Sandbox.Claim(ctx, &orchestrator.SandboxClaimRequest{
+		PooledSandboxId: pooled.sandboxID,
+		Sandbox:         config,
+		StartTime:       sbxRequest.StartTime,
+		EndTime:         sbxRequest.EndTime,
+	})
+
+	o.recordWarmPoolClaim(ctx, pooled.templateID, err == nil)
+
+	if err != nil {
+		zap.L().Error("Error claiming pooled sandbox", logger.WithSandboxID(pooled.sandboxID), zap.Error(utils.UnwrapGRPCError(err)))
+
+		node.sbxsInProgress.Remove(config.SandboxId)
+		config.ExecutionId = executionID
+
+		return false
+	}
+
+	config.MemoryTargetMb = res.GetMemoryTargetMb()
+
+	telemetry.ReportEvent(ctx, "Claimed pooled sandbox", attribute.String("pooled_sandbox.id", pooled.sandboxID))
+
+	return true
+}
+
+func (o *Orchestrator) recordWarmPoolClaim(ctx context.Context, templateID string, hit bool) {
+	o.warmPoolClaims.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("template.id", templateID),
+		attribute.Bool("hit", hit),
+	))
+}
+
+// takePooledSandbox removes a pooled sandbox of the build from the first ready node which has one.
+func (o *Orchestrator) takePooledSandbox(key warmPoolKey, buildID string) (*Node, *pooledSandbox) {
+	for _, node := range o.nodes.Items() {
+		if node == nil || node.Status() != api.NodeStatusReady {
+			continue
+		}
+
+		for _, sbx := range node.pooled.Items() {
+			if sbx.key() != key || sbx.buildID != buildID {
+				continue
+			}
+
+			taken := node.pooled.RemoveCb(sbx.sandboxID, func(_ string, v *pooledSandbox, exists bool) bool {
+				return exists && v == sbx
+			})
+			if taken {
+				return node, sbx
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// pooledUsage returns the number of the pooled sandboxes of the team and their resources.
+func (o *Orchestrator) pooledUsage(teamID uuid.UUID) (int64, instance.Resources) {
+	count := int64(0)
+	usage := instance.Resources{}
+
+	for _, node := range o.nodes.Items() {
+		for _, sbx := range node.pooled.Items() {
+			if sbx.teamID != teamID {
+				continue
+			}
+
+			count++
+			usage.VCPU += sbx.cpus
+			usage.RAMMB += sbx.ramMiB
+		}
+	}
+
+	return count, usage
+}
+
+// WarmPoolReady returns the number of pooled sandboxes of the template ready to be claimed by the team.
+func (o *Orchestrator) WarmPoolReady(teamID uuid.UUID, templateID string) int {
+	key := warmPoolKey{teamID: teamID, templateID: templateID}
+
+	ready := 0
+	for _, node := range o.nodes.Items() {
+		for _, sbx := range node.pooled.Items() {
+			if sbx.key() == key {
+				ready++
+			}
+		}
+	}
+
+	return ready
+}
@@ -0,0 +1,340 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	authcache "github.com/e2b-dev/infra/packages/api/internal/cache/auth"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	grpclient "github.com/e2b-dev/infra/packages/api/internal/grpc"
+	"github.com/e2b-dev/infra/packages/api/internal/node"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
+)
+
+// fakeSandboxClient records the sandboxes created, claimed and deleted on the node.
+type fakeSandboxClient struct {
+	orchestrator.SandboxServiceClient
+
+	mu       sync.Mutex
+	created  []*orchestrator.SandboxConfig
+	claimed  []string
+	deleted  []string
+	claimErr error
+	// noBalloon makes the sandboxes keep the whole memory, as the ones from the templates without the balloon device
+	noBalloon bool
+}
+
+// memoryTargetMB returns the memory target the node applies to the sandbox.
+func (f *fakeSandboxClient) memoryTargetMB(config *orchestrator.SandboxConfig) int64 {
+	if f.noBalloon {
+		return 0
+	}
+
+	return config.GetMemoryTargetMb()
+}
+
+func (f *fakeSandboxClient) Create(_ context.Context, in *orchestrator.SandboxCreateRequest, _ ...grpc.CallOption) (*orchestrator.SandboxCreateResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.created = append(f.created, in.GetSandbox())
+
+	return &orchestrator.SandboxCreateResponse{MemoryTargetMb: f.memoryTargetMB(in.GetSandbox())}, nil
+}
+
+func (f *fakeSandboxClient) Claim(_ context.Context, in *orchestrator.SandboxClaimRequest, _ ...grpc.CallOption) (*orchestrator.SandboxCreateResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.claimErr != nil {
+		return nil, f.claimErr
+	}
+
+	f.claimed = append(f.claimed, in.GetPooledSandboxId())
+
+	return &orchestrator.SandboxCreateResponse{MemoryTargetMb: f.memoryTargetMB(in.GetSandbox())}, nil
+}
+
+func (f *fakeSandboxClient) Delete(_ context.Context, in *orchestrator.SandboxDeleteRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.deleted = append(f.deleted, in.GetSandboxId())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeSandboxClient) UpdateMetadata(context.Context, *orchestrator.SandboxUpdateMetadataRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func newWarmPoolOrchestrator(t *testing.T) (*Orchestrator, *Node, *fakeSandboxClient) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// The connection is never used, the node status only checks its state
+	conn, err := grpc.NewClient("passthrough:///node", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	client := &fakeSandboxClient{}
+	n := &Node{
+		Info:              &node.NodeInfo{ID: "node-id"},
+		Client:            &grpclient.GRPCClient{Sandbox: client, Connection: conn},
+		status:            api.NodeStatusReady,
+		sbxsInProgress:    smap.New[*sbxInProgress](),
+		pooled:            smap.New[*pooledSandbox](),
+		buildCache:        ttlcache.New[string, interface{}](),
+		templateCache:     ttlcache.New[string, interface{}](),
+		recentCreateFails: newRecentFailures(recentCreateFailsWindow),
+	}
+
+	warmPoolClaims, err := noop.NewMeterProvider().Meter("").Int64Counter("")
+	require.NoError(t, err)
+
+	o := &Orchestrator{
+		instanceCache:  instance.NewCache(ctx, noop.NewMeterProvider(), nil, nil),
+		nodes:          smap.New[*Node](),
+		tracer:         tracenoop.NewTracerProvider().Tracer(""),
+		placement:      leastBusyScorer{},
+		warmPoolClaims: warmPoolClaims,
+	}
+	o.instanceCache.SetPooledUsage(o.pooledUsage)
+	o.nodes.Insert(n.Info.ID, n)
+
+	return o, n, client
+}
+
+func newWarmPoolTarget(size int, concurrentInstances int64) WarmPoolTarget {
+	templateID := "template-id"
+	envdVersion := "0.2.0"
+
+	return WarmPoolTarget{
+		Team: authcache.AuthTeamInfo{
+			Team: &models.Team{ID: uuid.New()},
+			Tier: &models.Tier{ConcurrentInstances: concurrentInstances},
+		},
+		Build: queries.EnvBuild{
+			ID:                 uuid.New(),
+			EnvID:              &templateID,
+			Vcpu:               2,
+			RamMb:              512,
+			FirecrackerVersion: "v1.10.1_1fcdaec",
+			EnvdVersion:        &envdVersion,
+		},
+		Size: size,
+	}
+}
+
+func refillWarmPools(o *Orchestrator, targets []WarmPoolTarget) {
+	o.SetWarmPools(targets)
+	o.RefillWarmPools(context.Background())
+}
+
+func TestRefillWarmPools(t *testing.T) {
+	t.Run("fills the pool to its size", func(t *testing.T) {
+		o, n, client := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(3, 10)
+
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		assert.Len(t, client.created, 3)
+		assert.Equal(t, 3, n.pooled.Count())
+		assert.Zero(t, n.sbxsInProgress.Count())
+		assert.Equal(t, 3, o.WarmPoolReady(target.Team.Team.ID, *target.Build.EnvID))
+
+		for _, config := range client.created {
+			assert.True(t, config.GetPooled())
+		}
+
+		// The full pool isn't refilled
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		assert.Len(t, client.created, 3)
+	})
+
+	t.Run("doesn't fill the pool above the team quota", func(t *testing.T) {
+		o, n, client := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(3, 2)
+
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		assert.Len(t, client.created, 2)
+		assert.Equal(t, 2, n.pooled.Count())
+
+		// The pooled sandboxes hold the team quota
+		_, err := o.instanceCache.ReserveWithQuota("sandbox-id", target.Team.Team.ID, instance.Resources{}, teamQuota(target.Team.Tier))
+		assert.IsType(t, &instance.ErrSandboxLimitExceeded{}, err)
+	})
+
+	t.Run("replaces the pooled sandboxes of the previous build", func(t *testing.T) {
+		o, n, client := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(1, 10)
+
+		refillWarmPools(o, []WarmPoolTarget{target})
+		require.Len(t, client.created, 1)
+
+		target.Build.ID = uuid.New()
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		assert.Equal(t, []string{client.created[0].GetSandboxId()}, client.deleted)
+		assert.Len(t, client.created, 2)
+		assert.Equal(t, 1, n.pooled.Count())
+	})
+
+	t.Run("setting the pools doesn't fill them", func(t *testing.T) {
+		o, n, client := newWarmPoolOrchestrator(t)
+
+		o.SetWarmPools([]WarmPoolTarget{newWarmPoolTarget(3, 10)})
+
+		assert.Empty(t, client.created)
+		assert.Zero(t, n.pooled.Count())
+	})
+
+	t.Run("removes the pooled sandboxes without the pool", func(t *testing.T) {
+		o, n, client := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(2, 10)
+
+		refillWarmPools(o, []WarmPoolTarget{target})
+		refillWarmPools(o, nil)
+
+		assert.Len(t, client.deleted, 2)
+		assert.Zero(t, n.pooled.Count())
+	})
+}
+
+func TestClaimWarmPoolSandbox(t *testing.T) {
+	newRequest := func(target WarmPoolTarget) *orchestrator.SandboxCreateRequest {
+		return &orchestrator.SandboxCreateRequest{
+			Sandbox: &orchestrator.SandboxConfig{
+				SandboxId:   "sandbox-id",
+				ExecutionId: "execution-id",
+				TemplateId:  *target.Build.EnvID,
+				BuildId:     target.Build.ID.String(),
+				TeamId:      target.Team.Team.ID.String(),
+			},
+		}
+	}
+
+	t.Run("claims the pooled sandbox", func(t *testing.T) {
+		o, n, client := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(1, 10)
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		pooledNode, pooled := o.takeWarmPoolSandbox(context.Background(), target.Team.Team.ID, *target.Build.EnvID, target.Build.ID.String())
+		require.NotNil(t, pooled)
+		assert.Zero(t, n.pooled.Count())
+
+		request := newRequest(target)
+		ok := o.claimPooledSandbox(context.Background(), pooledNode, pooled, request)
+
+		assert.True(t, ok)
+		assert.Equal(t, []string{pooled.sandboxID}, client.claimed)
+		assert.Equal(t, pooled.executionID, request.GetSandbox().GetExecutionId())
+	})
+
+	t.Run("keeps the memory target applied to the claimed sandbox", func(t *testing.T) {
+		o, _, _ := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(1, 10)
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		pooledNode, pooled := o.takeWarmPoolSandbox(context.Background(), target.Team.Team.ID, *target.Build.EnvID, target.Build.ID.String())
+		require.NotNil(t, pooled)
+
+		request := newRequest(target)
+		request.Sandbox.MemoryTargetMb = 256
+		ok := o.claimPooledSandbox(context.Background(), pooledNode, pooled, request)
+
+		assert.True(t, ok)
+		assert.Equal(t, int64(256), request.GetSandbox().GetMemoryTargetMb())
+	})
+
+	t.Run("clears the memory target of the sandbox without the balloon device", func(t *testing.T) {
+		o, _, client := newWarmPoolOrchestrator(t)
+		client.noBalloon = true
+		target := newWarmPoolTarget(1, 10)
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		pooledNode, pooled := o.takeWarmPoolSandbox(context.Background(), target.Team.Team.ID, *target.Build.EnvID, target.Build.ID.String())
+		require.NotNil(t, pooled)
+
+		request := newRequest(target)
+		request.Sandbox.MemoryTargetMb = 256
+		ok := o.claimPooledSandbox(context.Background(), pooledNode, pooled, request)
+
+		assert.True(t, ok)
+		// The sandbox keeps the whole memory and is accounted so
+		assert.Zero(t, request.GetSandbox().GetMemoryTargetMb())
+	})
+
+	t.Run("misses when the pool is empty", func(t *testing.T) {
+		o, _, _ := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(0, 10)
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		pooledNode, pooled := o.takeWarmPoolSandbox(context.Background(), target.Team.Team.ID, *target.Build.EnvID, target.Build.ID.String())
+
+		assert.Nil(t, pooledNode)
+		assert.Nil(t, pooled)
+	})
+
+	t.Run("misses the pooled sandbox of another build", func(t *testing.T) {
+		o, n, _ := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(1, 10)
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		_, pooled := o.takeWarmPoolSandbox(context.Background(), target.Team.Team.ID, *target.Build.EnvID, uuid.NewString())
+
+		assert.Nil(t, pooled)
+		assert.Equal(t, 1, n.pooled.Count())
+	})
+
+	t.Run("falls back to the create when the claim fails", func(t *testing.T) {
+		o, n, client := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(1, 10)
+		refillWarmPools(o, []WarmPoolTarget{target})
+		client.claimErr = errors.New("claim failed")
+
+		pooledNode, pooled := o.takeWarmPoolSandbox(context.Background(), target.Team.Team.ID, *target.Build.EnvID, target.Build.ID.String())
+		require.NotNil(t, pooled)
+
+		request := newRequest(target)
+		ok := o.claimPooledSandbox(context.Background(), pooledNode, pooled, request)
+
+		assert.False(t, ok)
+		// The request is created as a new sandbox with its own execution ID
+		assert.Equal(t, "execution-id", request.GetSandbox().GetExecutionId())
+		assert.Zero(t, n.sbxsInProgress.Count())
+	})
+
+	t.Run("returns the pooled sandbox when the reservation fails", func(t *testing.T) {
+		o, n, _ := newWarmPoolOrchestrator(t)
+		target := newWarmPoolTarget(1, 10)
+		refillWarmPools(o, []WarmPoolTarget{target})
+
+		pooledNode, pooled := o.takeWarmPoolSandbox(context.Background(), target.Team.Team.ID, *target.Build.EnvID, target.Build.ID.String())
+		require.NotNil(t, pooled)
+
+		returnPooledSandbox(pooledNode, pooled)
+
+		assert.Equal(t, 1, n.pooled.Count())
+	})
+}
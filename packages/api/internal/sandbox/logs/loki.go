@@ -0,0 +1,98 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	loki "github.com/grafana/loki/pkg/logcli/client"
+	"github.com/grafana/loki/pkg/loghttp"
+	"github.com/grafana/loki/pkg/util/unmarshal"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+// lokiTailLimit is the maximum number of logs Loki returns from before the tail started.
+const lokiTailLimit = 1_000
+
+type LokiProvider struct {
+	client *loki.DefaultClient
+}
+
+func NewLokiProvider(client *loki.DefaultClient) *LokiProvider {
+	return &LokiProvider{client: client}
+}
+
+func (l *LokiProvider) Tail(ctx context.Context, query Query) (<-chan Entry, error) {
+	conn, err := l.client.LiveTailQueryConn(lokiQuery(query), 0, lokiTailLimit, query.Start, true)
+	if err != nil {
+		return nil, fmt.Errorf("error when tailing logs for sandbox '%s': %w", query.SandboxID, err)
+	}
+
+	// Closing the connection unblocks the reader below
+	go func() {
+		<-ctx.Done()
+
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		conn.Close()
+	}()
+
+	entries := make(chan Entry)
+
+	go func() {
+		defer close(entries)
+		defer conn.Close()
+
+		for {
+			var res loghttp.TailResponse
+
+			err := unmarshal.ReadTailResponseJSON(&res, conn)
+			if err != nil {
+				if ctx.Err() == nil && !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+					zap.L().Warn("Error when tailing sandbox logs", logger.WithSandboxID(query.SandboxID), zap.Error(err))
+				}
+
+				return
+			}
+
+			for _, stream := range res.Streams {
+				for _, entry := range stream.Entries {
+					select {
+					case entries <- Entry{
+						Timestamp: entry.Timestamp,
+						Line:      entry.Line,
+						Level:     stream.Labels["level"],
+						Category:  stream.Labels["category"],
+					}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return entries, nil
+}
+
+func lokiQuery(query Query) string {
+	// Sanitize the values
+	// https://grafana.com/blog/2021/01/05/how-to-escape-special-characters-with-lokis-logql/
+	sanitize := func(value string) string {
+		return strings.ReplaceAll(value, "`", "")
+	}
+
+	category := "category!=\"metrics\""
+	if query.Category != "" {
+		category = fmt.Sprintf("category=`%s`", sanitize(query.Category))
+	}
+
+	q := fmt.Sprintf("{teamID=`%s`, sandboxID=`%s`, %s}", sanitize(query.TeamID), sanitize(query.SandboxID), category)
+	if query.Level != "" {
+		q += fmt.Sprintf(" | json level=\"level\" | level=~\"%s\"", strings.Join(levelsFrom(query.Level), "|"))
+	}
+
+	return q
+}
@@ -0,0 +1,19 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLokiQuery(t *testing.T) {
+	assert.Equal(t,
+		"{teamID=`team-id`, sandboxID=`sandbox-id`, category!=\"metrics\"}",
+		lokiQuery(Query{TeamID: "team-id", SandboxID: "sandbox`-id"}),
+	)
+
+	assert.Equal(t,
+		"{teamID=`team-id`, sandboxID=`sandbox-id`, category=`process`} | json level=\"level\" | level=~\"error|fatal|panic\"",
+		lokiQuery(Query{TeamID: "team-id", SandboxID: "sandbox-id", Level: "error", Category: "process"}),
+	)
+}
@@ -0,0 +1,204 @@
+package logs
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// memoryHistorySize is the number of logs kept for each sandbox.
+	memoryHistorySize = 1_000
+	// memorySubscriberBuffer is the number of logs buffered for a subscriber, slower subscribers are disconnected.
+	memorySubscriberBuffer = 256
+)
+
+type memorySubscriber struct {
+	query   Query
+	entries chan Entry
+}
+
+type memorySandboxLogs struct {
+	history     []Entry
+	subscribers map[*memorySubscriber]struct{}
+}
+
+// MemoryProvider keeps the recent sandbox logs in memory, it's used for local development without Loki.
+type MemoryProvider struct {
+	mu        sync.Mutex
+	sandboxes map[string]*memorySandboxLogs
+}
+
+func NewMemoryProvider() *MemoryProvider {
+	return &MemoryProvider{sandboxes: make(map[string]*memorySandboxLogs)}
+}
+
+func memoryKey(teamID, sandboxID string) string {
+	return teamID + "/" + sandboxID
+}
+
+func (m *MemoryProvider) sandbox(key string) *memorySandboxLogs {
+	sbx, ok := m.sandboxes[key]
+	if !ok {
+		sbx = &memorySandboxLogs{subscribers: make(map[*memorySubscriber]struct{})}
+		m.sandboxes[key] = sbx
+	}
+
+	return sbx
+}
+
+// Push adds the log of the sandbox and sends it to the subscribers.
+func (m *MemoryProvider) Push(teamID, sandboxID string, entry Entry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sbx := m.sandbox(memoryKey(teamID, sandboxID))
+
+	sbx.history = append(sbx.history, entry)
+	if len(sbx.history) > memoryHistorySize {
+		sbx.history = sbx.history[len(sbx.history)-memoryHistorySize:]
+	}
+
+	for sub := range sbx.subscribers {
+		if !sub.query.matches(entry) {
+			continue
+		}
+
+		select {
+		case sub.entries <- entry:
+		default:
+			// The subscriber can resume from the last log it received
+			close(sub.entries)
+			delete(sbx.subscribers, sub)
+		}
+	}
+}
+
+func (m *MemoryProvider) Tail(ctx context.Context, query Query) (<-chan Entry, error) {
+	key := memoryKey(query.TeamID, query.SandboxID)
+	sub := &memorySubscriber{query: query, entries: make(chan Entry, memorySubscriberBuffer)}
+
+	m.mu.Lock()
+	sbx := m.sandbox(key)
+
+	history := make([]Entry, 0)
+	for _, entry := range sbx.history {
+		if query.matches(entry) {
+			history = append(history, entry)
+		}
+	}
+
+	sbx.subscribers[sub] = struct{}{}
+	m.mu.Unlock()
+
+	entries := make(chan Entry)
+
+	go func() {
+		defer close(entries)
+		defer m.unsubscribe(key, sub)
+
+		for _, entry := range history {
+			select {
+			case entries <- entry:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for {
+			select {
+			case entry, ok := <-sub.entries:
+				if !ok {
+					return
+				}
+
+				select {
+				case entries <- entry:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return entries, nil
+}
+
+func (m *MemoryProvider) unsubscribe(key string, sub *memorySubscriber) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sbx, ok := m.sandboxes[key]
+	if !ok {
+		return
+	}
+
+	delete(sbx.subscribers, sub)
+	if len(sbx.history) == 0 && len(sbx.subscribers) == 0 {
+		delete(m.sandboxes, key)
+	}
+}
+
+// Core returns the logger core pushing the logs of the sandbox logger to the provider.
+// The logs without the team and sandbox fields aren't kept.
+func (m *MemoryProvider) Core() zapcore.Core {
+	return &memoryCore{LevelEnabler: zapcore.DebugLevel, provider: m}
+}
+
+type memoryCore struct {
+	zapcore.LevelEnabler
+
+	provider *MemoryProvider
+	fields   []zapcore.Field
+}
+
+func (c *memoryCore) With(fields []zapcore.Field) zapcore.Core {
+	return &memoryCore{
+		LevelEnabler: c.LevelEnabler,
+		provider:     c.provider,
+		fields:       append(c.fields[:len(c.fields):len(c.fields)], fields...),
+	}
+}
+
+func (c *memoryCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+
+	return ce
+}
+
+func (c *memoryCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, field := range c.fields {
+		field.AddTo(enc)
+	}
+
+	for _, field := range fields {
+		field.AddTo(enc)
+	}
+
+	teamID, _ := enc.Fields["team.id"].(string)
+	sandboxID, _ := enc.Fields["sandbox.id"].(string)
+	if teamID == "" || sandboxID == "" {
+		return nil
+	}
+
+	category, _ := enc.Fields["category"].(string)
+
+	c.provider.Push(teamID, sandboxID, Entry{
+		Timestamp: entry.Time,
+		Line:      entry.Message,
+		Level:     entry.Level.String(),
+		Category:  category,
+	})
+
+	return nil
+}
+
+func (c *memoryCore) Sync() error {
+	return nil
+}
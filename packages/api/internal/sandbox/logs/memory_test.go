@@ -0,0 +1,121 @@
+package logs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+const (
+	testTeamID    = "team-id"
+	testSandboxID = "sandbox-id"
+)
+
+func receive(t *testing.T, entries <-chan Entry) Entry {
+	t.Helper()
+
+	select {
+	case entry, ok := <-entries:
+		require.True(t, ok, "the tail was closed")
+
+		return entry
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the log")
+
+		return Entry{}
+	}
+}
+
+func TestMemoryProvider_Tail(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	now := time.Now()
+
+	provider := NewMemoryProvider()
+	provider.Push(testTeamID, testSandboxID, Entry{Timestamp: now.Add(-2 * time.Minute), Line: "too old", Level: "info"})
+	provider.Push(testTeamID, testSandboxID, Entry{Timestamp: now.Add(-time.Minute), Line: "history", Level: "info"})
+	provider.Push(testTeamID, "other-sandbox", Entry{Timestamp: now, Line: "other sandbox", Level: "info"})
+
+	entries, err := provider.Tail(ctx, Query{TeamID: testTeamID, SandboxID: testSandboxID, Start: now.Add(-90 * time.Second)})
+	require.NoError(t, err)
+
+	assert.Equal(t, "history", receive(t, entries).Line)
+
+	provider.Push(testTeamID, testSandboxID, Entry{Timestamp: now, Line: "metrics", Level: "info", Category: "metrics"})
+	provider.Push(testTeamID, testSandboxID, Entry{Timestamp: now, Line: "live", Level: "info"})
+
+	assert.Equal(t, "live", receive(t, entries).Line)
+
+	cancel()
+
+	for range entries {
+	}
+}
+
+func TestMemoryProvider_TailFilters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	now := time.Now()
+
+	provider := NewMemoryProvider()
+
+	entries, err := provider.Tail(ctx, Query{TeamID: testTeamID, SandboxID: testSandboxID, Start: now, Level: "warn", Category: "process"})
+	require.NoError(t, err)
+
+	provider.Push(testTeamID, testSandboxID, Entry{Timestamp: now, Line: "info", Level: "info", Category: "process"})
+	provider.Push(testTeamID, testSandboxID, Entry{Timestamp: now, Line: "other category", Level: "error", Category: "default"})
+	provider.Push(testTeamID, testSandboxID, Entry{Timestamp: now, Line: "error", Level: "error", Category: "process"})
+
+	assert.Equal(t, "error", receive(t, entries).Line)
+}
+
+func TestMemoryProvider_SlowSubscriber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	now := time.Now()
+
+	provider := NewMemoryProvider()
+
+	entries, err := provider.Tail(ctx, Query{TeamID: testTeamID, SandboxID: testSandboxID, Start: now})
+	require.NoError(t, err)
+
+	for range memorySubscriberBuffer + 2 {
+		provider.Push(testTeamID, testSandboxID, Entry{Timestamp: now, Line: "log", Level: "info"})
+	}
+
+	received := 0
+	for range entries {
+		received++
+	}
+
+	assert.LessOrEqual(t, received, memorySubscriberBuffer+1)
+}
+
+func TestMemoryProvider_Core(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	provider := NewMemoryProvider()
+
+	entries, err := provider.Tail(ctx, Query{TeamID: testTeamID, SandboxID: testSandboxID, Start: time.Now().Add(-time.Minute)})
+	require.NoError(t, err)
+
+	sbxLogger := zap.New(provider.Core()).With(logger.WithTeamID(testTeamID), logger.WithSandboxID(testSandboxID))
+	sbxLogger.Info("metrics", zap.String("category", "metrics"))
+	zap.New(provider.Core()).Info("without sandbox")
+	sbxLogger.Warn("process log", zap.String("category", "process"))
+
+	entry := receive(t, entries)
+	assert.Equal(t, "process log", entry.Line)
+	assert.Equal(t, "warn", entry.Level)
+	assert.Equal(t, "process", entry.Category)
+}
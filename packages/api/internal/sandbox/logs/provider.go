@@ -0,0 +1,64 @@
+package logs
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// levels are the log levels ordered by severity.
+var levels = []string{"debug", "info", "warn", "error", "fatal", "panic"}
+
+// Entry is a single sandbox log.
+type Entry struct {
+	Timestamp time.Time
+	Line      string
+	Level     string
+	Category  string
+}
+
+// Query selects the sandbox logs to tail.
+type Query struct {
+	TeamID    string
+	SandboxID string
+	// Start is the timestamp of the oldest log returned.
+	Start time.Time
+	// Level is the lowest level of the returned logs, all levels are returned when empty.
+	Level string
+	// Category of the returned logs, all categories except the metrics are returned when empty.
+	Category string
+}
+
+// Provider tails the sandbox logs.
+type Provider interface {
+	// Tail returns the logs since the start of the query followed by the new ones as they arrive.
+	// The channel is closed when the context is done or when the tail can't continue,
+	// the caller can resume from the timestamp of the last received log.
+	Tail(ctx context.Context, query Query) (<-chan Entry, error)
+}
+
+// levelsFrom returns the levels at least as severe as the level.
+func levelsFrom(level string) []string {
+	i := slices.Index(levels, level)
+	if i < 0 {
+		return levels
+	}
+
+	return levels[i:]
+}
+
+func (q Query) matches(entry Entry) bool {
+	if entry.Timestamp.Before(q.Start) {
+		return false
+	}
+
+	if q.Category == "" {
+		if entry.Category == "metrics" {
+			return false
+		}
+	} else if entry.Category != q.Category {
+		return false
+	}
+
+	return q.Level == "" || slices.Contains(levelsFrom(q.Level), entry.Level)
+}
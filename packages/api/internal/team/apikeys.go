@@ -13,7 +13,14 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
-func CreateAPIKey(ctx context.Context, db *db.DB, teamID uuid.UUID, userID uuid.UUID, name string) (*models.TeamAPIKey, error) {
+// APIKeyRestrictions limit what the API key can be used for, the zero value creates a key with full access to the team.
+type APIKeyRestrictions struct {
+	Scopes           []string
+	AllowedTemplates []string
+	ExpiresAt        *time.Time
+}
+
+func CreateAPIKey(ctx context.Context, db *db.DB, teamID uuid.UUID, userID uuid.UUID, name string, restrictions APIKeyRestrictions) (*models.TeamAPIKey, error) {
 	teamApiKey, err := keys.GenerateKey(keys.ApiKeyPrefix)
 	if err != nil {
 		telemetry.ReportCriticalError(ctx, "error when generating team API key", err)
@@ -21,7 +28,7 @@ func CreateAPIKey(ctx context.Context, db *db.DB, teamID uuid.UUID, userID uuid.
 		return nil, fmt.Errorf("error when generating team API key: %w", err)
 	}
 
-	apiKey, err := db.Client.TeamAPIKey.
+	apiKeyCreate := db.Client.TeamAPIKey.
 		Create().
 		SetTeamID(teamID).
 		SetCreatedBy(userID).
@@ -34,7 +41,17 @@ func CreateAPIKey(ctx context.Context, db *db.DB, teamID uuid.UUID, userID uuid.
 		SetAPIKeyMaskPrefix(teamApiKey.Masked.MaskedValuePrefix).
 		SetAPIKeyMaskSuffix(teamApiKey.Masked.MaskedValueSuffix).
 		SetName(name).
-		Save(ctx)
+		SetNillableExpiresAt(restrictions.ExpiresAt)
+
+	if len(restrictions.Scopes) > 0 {
+		apiKeyCreate.SetScopes(restrictions.Scopes)
+	}
+
+	if len(restrictions.AllowedTemplates) > 0 {
+		apiKeyCreate.SetAllowedTemplates(restrictions.AllowedTemplates)
+	}
+
+	apiKey, err := apiKeyCreate.Save(ctx)
 	if err != nil {
 		telemetry.ReportCriticalError(ctx, "error when creating API key", err)
 
@@ -0,0 +1,382 @@
+package template_manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+const (
+	buildEventsInterval   = time.Second
+	buildEventsBufferSize = 100
+	// buildEventsMaxFailures is the number of the consecutive failed polls after which the build events stream ends with an error.
+	buildEventsMaxFailures = 10
+)
+
+type BuildEventType string
+
+const (
+	BuildEventState BuildEventType = "state"
+	BuildEventPhase BuildEventType = "phase"
+	BuildEventLog   BuildEventType = "log"
+	BuildEventError BuildEventType = "error"
+)
+
+// BuildState is the state of the build reported to the clients, it's derived from the build status and the build phases.
+type BuildState string
+
+const (
+	BuildStateWaiting      BuildState = "waiting"
+	BuildStateQueued       BuildState = "queued"
+	BuildStateBuilding     BuildState = "building"
+	BuildStateSnapshotting BuildState = "snapshotting"
+	BuildStateUploaded     BuildState = "uploaded"
+	BuildStateFailed       BuildState = "failed"
+	BuildStateCancelled    BuildState = "cancelled"
+)
+
+type BuildEvent struct {
+	Type      BuildEventType
+	Timestamp time.Time
+
+	// State is set for the state events.
+	State BuildState
+
+	// Phase is set for the phase events, the Duration is set when the phase finished.
+	Phase    templatemanagergrpc.TemplateBuildPhaseType
+	Duration *time.Duration
+
+	// Line is set for the log events, the LogsOffset is the offset of the next build log.
+	Line       string
+	LogsOffset int32
+
+	// Error is set for the error event, it's the last event of the stream.
+	Error string
+}
+
+// buildEventsStream keeps track of the events already sent to the client.
+type buildEventsStream struct {
+	state          BuildState
+	phasesStarted  int
+	phasesFinished int
+	logsOffset     int32
+}
+
+// buildEventsSnapshot is the state of the build known to the build events poller.
+type buildEventsSnapshot struct {
+	// polled is set once the build was read for the first time.
+	polled bool
+	status envbuild.Status
+	phases []*templatemanagergrpc.TemplateBuildPhase
+	// logs are all the build logs received so far, they are only appended to.
+	logs []string
+
+	// finished is set when the build finished or the polling failed, err is set for the latter.
+	finished bool
+	err      error
+}
+
+// buildEventsPoller polls the build once for all the clients streaming its events.
+type buildEventsPoller struct {
+	mu       sync.Mutex
+	snapshot buildEventsSnapshot
+	// updated is closed and replaced with every new snapshot.
+	updated chan struct{}
+
+	subscribers int
+	cancel      context.CancelFunc
+}
+
+func (p *buildEventsPoller) current() (buildEventsSnapshot, <-chan struct{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.snapshot, p.updated
+}
+
+func (p *buildEventsPoller) update(snapshot buildEventsSnapshot) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.snapshot = snapshot
+	close(p.updated)
+	p.updated = make(chan struct{})
+}
+
+// StreamBuildEvents streams the state changes, phases and logs of the build until the build finishes or the context is cancelled.
+// The stream ends with an error event when the build can't be read.
+func (tm *TemplateManager) StreamBuildEvents(ctx context.Context, buildID uuid.UUID, templateID string, clusterID *uuid.UUID, logsOffset int32) <-chan BuildEvent {
+	events := make(chan BuildEvent, buildEventsBufferSize)
+
+	go func() {
+		defer close(events)
+
+		poller := tm.subscribeBuildEvents(buildID, templateID, clusterID)
+		defer tm.unsubscribeBuildEvents(buildID, poller)
+
+		stream := &buildEventsStream{logsOffset: logsOffset}
+		for {
+			snapshot, updated := poller.current()
+
+			for _, event := range stream.events(snapshot) {
+				select {
+				case <-ctx.Done():
+					return
+				case events <- event:
+				}
+			}
+
+			if snapshot.finished {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-updated:
+			}
+		}
+	}()
+
+	return events
+}
+
+// subscribeBuildEvents returns the poller of the build, the poller is started for the first subscriber.
+func (tm *TemplateManager) subscribeBuildEvents(buildID uuid.UUID, templateID string, clusterID *uuid.UUID) *buildEventsPoller {
+	tm.buildEventsLock.Lock()
+	defer tm.buildEventsLock.Unlock()
+
+	poller, ok := tm.buildEventsPollers[buildID]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		poller = &buildEventsPoller{
+			updated: make(chan struct{}),
+			cancel:  cancel,
+		}
+		tm.buildEventsPollers[buildID] = poller
+
+		go tm.pollBuildEvents(ctx, poller, buildID, templateID, clusterID)
+	}
+
+	poller.subscribers++
+
+	return poller
+}
+
+// unsubscribeBuildEvents stops the poller of the build when its last subscriber leaves.
+func (tm *TemplateManager) unsubscribeBuildEvents(buildID uuid.UUID, poller *buildEventsPoller) {
+	tm.buildEventsLock.Lock()
+	defer tm.buildEventsLock.Unlock()
+
+	poller.subscribers--
+	if poller.subscribers > 0 {
+		return
+	}
+
+	poller.cancel()
+	delete(tm.buildEventsPollers, buildID)
+}
+
+// pollBuildEvents polls the build until it finishes, the polling fails repeatedly or the context is cancelled.
+func (tm *TemplateManager) pollBuildEvents(ctx context.Context, poller *buildEventsPoller, buildID uuid.UUID, templateID string, clusterID *uuid.UUID) {
+	buildLogger := zap.L().With(logger.WithTemplateID(templateID), logger.WithBuildID(buildID.String()))
+
+	ticker := time.NewTicker(buildEventsInterval)
+	defer ticker.Stop()
+
+	snapshot := buildEventsSnapshot{}
+	failures := 0
+	for {
+		err := tm.pollBuildSnapshot(ctx, &snapshot, buildID, templateID, clusterID)
+		if err != nil {
+			failures++
+			buildLogger.Error("Error polling template build for build events", zap.Error(err), zap.Int("failures", failures))
+
+			if failures >= buildEventsMaxFailures {
+				snapshot.finished = true
+				snapshot.err = err
+			}
+		} else {
+			failures = 0
+		}
+
+		poller.update(snapshot)
+		if snapshot.finished {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollBuildSnapshot reads the build status, phases and new logs into the snapshot.
+func (tm *TemplateManager) pollBuildSnapshot(
+	ctx context.Context,
+	snapshot *buildEventsSnapshot,
+	buildID uuid.UUID,
+	templateID string,
+	clusterID *uuid.UUID,
+) error {
+	buildLogger := zap.L().With(logger.WithTemplateID(templateID), logger.WithBuildID(buildID.String()))
+
+	// The build status is updated by the build status sync
+	buildInfo, err := tm.buildCache.Get(ctx, buildID, templateID)
+	if err != nil {
+		return fmt.Errorf("failed to get template build: %w", err)
+	}
+
+	snapshot.polled = true
+	snapshot.status = buildInfo.BuildStatus
+
+	started := buildInfo.BuildStatus != envbuild.StatusWaiting && buildInfo.BuildStatus != envbuild.StatusQueued
+	if !started {
+		return nil
+	}
+
+	// The builder can already be gone for the finished builds, the phases are then reported without the durations
+	status, err := tm.GetStatus(ctx, buildID, templateID, clusterID, buildInfo.ClusterNodeID)
+	if err != nil {
+		buildLogger.Debug("Error getting template build phases", zap.Error(err))
+	} else {
+		snapshot.phases = status.GetPhases()
+	}
+
+	offset := int32(len(snapshot.logs))
+	logs, err := tm.GetLogs(ctx, buildID, templateID, clusterID, buildInfo.ClusterNodeID, &offset)
+	if err != nil {
+		buildLogger.Error("Error getting template build logs for build events", zap.Error(err))
+	} else {
+		snapshot.logs = append(snapshot.logs, logs...)
+	}
+
+	snapshot.finished = isFinalBuildState(buildState(snapshot.status, snapshot.phases))
+
+	return nil
+}
+
+// events returns the events of the snapshot not yet sent to the client.
+func (s *buildEventsStream) events(snapshot buildEventsSnapshot) []BuildEvent {
+	var events []BuildEvent
+
+	if snapshot.polled {
+		state := buildState(snapshot.status, snapshot.phases)
+		finished := isFinalBuildState(state)
+
+		if !finished {
+			events = append(events, s.stateEvents(state)...)
+		}
+
+		events = append(events, s.phaseEvents(snapshot.phases)...)
+
+		if int(s.logsOffset) < len(snapshot.logs) {
+			events = append(events, s.logEvents(snapshot.logs[s.logsOffset:])...)
+		}
+
+		// The final state goes after the last logs, the client can close the stream right after it
+		if finished {
+			events = append(events, s.stateEvents(state)...)
+		}
+	}
+
+	if snapshot.err != nil {
+		events = append(events, BuildEvent{Type: BuildEventError, Timestamp: time.Now(), Error: "error when getting the template build"})
+	}
+
+	return events
+}
+
+func (s *buildEventsStream) stateEvents(state BuildState) []BuildEvent {
+	if s.state == state {
+		return nil
+	}
+
+	s.state = state
+
+	return []BuildEvent{{Type: BuildEventState, Timestamp: time.Now(), State: state}}
+}
+
+// phaseEvents returns the events for the phases that started or finished since the last call, the phases start and finish in order.
+func (s *buildEventsStream) phaseEvents(phases []*templatemanagergrpc.TemplateBuildPhase) []BuildEvent {
+	var events []BuildEvent
+
+	for i, phase := range phases {
+		if i >= s.phasesStarted {
+			events = append(events, BuildEvent{
+				Type:      BuildEventPhase,
+				Timestamp: phase.GetStartedAt().AsTime(),
+				Phase:     phase.GetPhase(),
+			})
+			s.phasesStarted = i + 1
+		}
+
+		if i >= s.phasesFinished && phase.GetFinishedAt() != nil {
+			duration := phase.GetFinishedAt().AsTime().Sub(phase.GetStartedAt().AsTime())
+			events = append(events, BuildEvent{
+				Type:      BuildEventPhase,
+				Timestamp: phase.GetFinishedAt().AsTime(),
+				Phase:     phase.GetPhase(),
+				Duration:  &duration,
+			})
+			s.phasesFinished = i + 1
+		}
+	}
+
+	return events
+}
+
+func (s *buildEventsStream) logEvents(logs []string) []BuildEvent {
+	events := make([]BuildEvent, 0, len(logs))
+
+	for _, line := range logs {
+		s.logsOffset++
+		events = append(events, BuildEvent{
+			Type:       BuildEventLog,
+			Timestamp:  time.Now(),
+			Line:       line,
+			LogsOffset: s.logsOffset,
+		})
+	}
+
+	return events
+}
+
+// buildState derives the build state from the build status, the running build is snapshotting once it reaches the snapshot phase.
+func buildState(status envbuild.Status, phases []*templatemanagergrpc.TemplateBuildPhase) BuildState {
+	switch status {
+	case envbuild.StatusWaiting:
+		return BuildStateWaiting
+	case envbuild.StatusQueued:
+		return BuildStateQueued
+	case envbuild.StatusUploaded, envbuild.StatusSuccess:
+		return BuildStateUploaded
+	case envbuild.StatusFailed:
+		return BuildStateFailed
+	case envbuild.StatusCancelled:
+		return BuildStateCancelled
+	}
+
+	if len(phases) > 0 {
+		switch phases[len(phases)-1].GetPhase() {
+		case templatemanagergrpc.TemplateBuildPhaseType_Snapshot, templatemanagergrpc.TemplateBuildPhaseType_Upload:
+			return BuildStateSnapshotting
+		}
+	}
+
+	return BuildStateBuilding
+}
+
+func isFinalBuildState(state BuildState) bool {
+	return state == BuildStateUploaded || state == BuildStateFailed || state == BuildStateCancelled
+}
@@ -0,0 +1,164 @@
+package template_manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	templatemanagergrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+func buildPhase(phase templatemanagergrpc.TemplateBuildPhaseType, startedAt time.Time, duration time.Duration) *templatemanagergrpc.TemplateBuildPhase {
+	p := &templatemanagergrpc.TemplateBuildPhase{
+		Phase:     phase,
+		StartedAt: timestamppb.New(startedAt),
+	}
+
+	if duration > 0 {
+		p.FinishedAt = timestamppb.New(startedAt.Add(duration))
+	}
+
+	return p
+}
+
+func TestBuildState(t *testing.T) {
+	now := time.Now()
+	provision := buildPhase(templatemanagergrpc.TemplateBuildPhaseType_Provision, now, 0)
+	snapshot := buildPhase(templatemanagergrpc.TemplateBuildPhaseType_Snapshot, now, 0)
+
+	assert.Equal(t, BuildStateWaiting, buildState(envbuild.StatusWaiting, nil))
+	assert.Equal(t, BuildStateQueued, buildState(envbuild.StatusQueued, nil))
+	assert.Equal(t, BuildStateBuilding, buildState(envbuild.StatusBuilding, nil))
+	assert.Equal(t, BuildStateBuilding, buildState(envbuild.StatusBuilding, []*templatemanagergrpc.TemplateBuildPhase{provision}))
+	assert.Equal(t, BuildStateSnapshotting, buildState(envbuild.StatusBuilding, []*templatemanagergrpc.TemplateBuildPhase{provision, snapshot}))
+	assert.Equal(t, BuildStateUploaded, buildState(envbuild.StatusUploaded, []*templatemanagergrpc.TemplateBuildPhase{provision, snapshot}))
+	assert.Equal(t, BuildStateFailed, buildState(envbuild.StatusFailed, []*templatemanagergrpc.TemplateBuildPhase{provision}))
+	assert.Equal(t, BuildStateCancelled, buildState(envbuild.StatusCancelled, nil))
+}
+
+func TestBuildEventsStreamPhaseEvents(t *testing.T) {
+	now := time.Now()
+	stream := &buildEventsStream{}
+
+	base := buildPhase(templatemanagergrpc.TemplateBuildPhaseType_Base, now, 0)
+	events := stream.phaseEvents([]*templatemanagergrpc.TemplateBuildPhase{base})
+	require.Len(t, events, 1)
+	assert.Equal(t, templatemanagergrpc.TemplateBuildPhaseType_Base, events[0].Phase)
+	assert.Nil(t, events[0].Duration)
+
+	// The unchanged phases aren't sent again
+	assert.Empty(t, stream.phaseEvents([]*templatemanagergrpc.TemplateBuildPhase{base}))
+
+	base = buildPhase(templatemanagergrpc.TemplateBuildPhaseType_Base, now, 3*time.Second)
+	provision := buildPhase(templatemanagergrpc.TemplateBuildPhaseType_Provision, now.Add(3*time.Second), 0)
+	events = stream.phaseEvents([]*templatemanagergrpc.TemplateBuildPhase{base, provision})
+	require.Len(t, events, 2)
+	assert.Equal(t, templatemanagergrpc.TemplateBuildPhaseType_Base, events[0].Phase)
+	require.NotNil(t, events[0].Duration)
+	assert.Equal(t, 3*time.Second, *events[0].Duration)
+	assert.Equal(t, templatemanagergrpc.TemplateBuildPhaseType_Provision, events[1].Phase)
+	assert.Nil(t, events[1].Duration)
+}
+
+func TestBuildEventsStreamStateAndLogEvents(t *testing.T) {
+	stream := &buildEventsStream{logsOffset: 2}
+
+	assert.Len(t, stream.stateEvents(BuildStateBuilding), 1)
+	assert.Empty(t, stream.stateEvents(BuildStateBuilding))
+	assert.Len(t, stream.stateEvents(BuildStateSnapshotting), 1)
+
+	events := stream.logEvents([]string{"line 3", "line 4"})
+	require.Len(t, events, 2)
+	assert.Equal(t, int32(3), events[0].LogsOffset)
+	assert.Equal(t, int32(4), events[1].LogsOffset)
+	assert.Equal(t, int32(4), stream.logsOffset)
+}
+
+func TestBuildEventsStreamEvents(t *testing.T) {
+	t.Run("not polled yet", func(t *testing.T) {
+		stream := &buildEventsStream{}
+		assert.Empty(t, stream.events(buildEventsSnapshot{}))
+	})
+
+	t.Run("logs after the offset and the final state last", func(t *testing.T) {
+		stream := &buildEventsStream{logsOffset: 1}
+		snapshot := buildEventsSnapshot{polled: true, status: envbuild.StatusBuilding, logs: []string{"line 1", "line 2"}}
+
+		events := stream.events(snapshot)
+		require.Len(t, events, 2)
+		assert.Equal(t, BuildStateBuilding, events[0].State)
+		assert.Equal(t, "line 2", events[1].Line)
+
+		snapshot.status = envbuild.StatusUploaded
+		snapshot.logs = append(snapshot.logs, "line 3")
+		snapshot.finished = true
+
+		events = stream.events(snapshot)
+		require.Len(t, events, 2)
+		assert.Equal(t, "line 3", events[0].Line)
+		assert.Equal(t, BuildStateUploaded, events[1].State)
+	})
+
+	t.Run("error is the last event", func(t *testing.T) {
+		stream := &buildEventsStream{}
+		snapshot := buildEventsSnapshot{finished: true, err: errors.New("database unavailable")}
+
+		events := stream.events(snapshot)
+		require.Len(t, events, 1)
+		assert.Equal(t, BuildEventError, events[0].Type)
+		assert.NotEmpty(t, events[0].Error)
+	})
+}
+
+func TestStreamBuildEventsSharesPoller(t *testing.T) {
+	buildID := uuid.New()
+
+	// The registered poller isn't polling, the snapshots are set by the test
+	poller := &buildEventsPoller{updated: make(chan struct{}), cancel: func() {}}
+	tm := &TemplateManager{buildEventsPollers: map[uuid.UUID]*buildEventsPoller{buildID: poller}}
+
+	first := tm.StreamBuildEvents(t.Context(), buildID, "template-id", nil, 0)
+	second := tm.StreamBuildEvents(t.Context(), buildID, "template-id", nil, 1)
+
+	require.Eventually(t, func() bool {
+		tm.buildEventsLock.Lock()
+		defer tm.buildEventsLock.Unlock()
+
+		return poller.subscribers == 2
+	}, time.Second, time.Millisecond)
+
+	poller.update(buildEventsSnapshot{polled: true, status: envbuild.StatusFailed, logs: []string{"line 1", "line 2"}, finished: true})
+
+	collect := func(events <-chan BuildEvent) []BuildEvent {
+		var received []BuildEvent
+		for event := range events {
+			received = append(received, event)
+		}
+
+		return received
+	}
+
+	firstEvents := collect(first)
+	require.Len(t, firstEvents, 3)
+	assert.Equal(t, "line 1", firstEvents[0].Line)
+	assert.Equal(t, BuildStateFailed, firstEvents[2].State)
+
+	secondEvents := collect(second)
+	require.Len(t, secondEvents, 2)
+	assert.Equal(t, "line 2", secondEvents[0].Line)
+	assert.Equal(t, BuildStateFailed, secondEvents[1].State)
+
+	// The poller is removed with its last subscriber
+	require.Eventually(t, func() bool {
+		tm.buildEventsLock.Lock()
+		defer tm.buildEventsLock.Unlock()
+
+		return len(tm.buildEventsPollers) == 0
+	}, time.Second, time.Millisecond)
+}
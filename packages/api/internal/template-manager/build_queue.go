@@ -0,0 +1,299 @@
+package template_manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/edge"
+	"github.com/e2b-dev/infra/packages/db/queries"
+	infogrpc "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator-info"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+const (
+	buildQueueInterval  = 5 * time.Second
+	buildQueueBatchSize = 100
+	buildStartTimeout   = 30 * time.Second
+)
+
+var ErrBuildNotWaiting = errors.New("build is not in waiting state")
+
+// EnqueueBuild adds the waiting build to the build queue, the build is started by the queue dispatcher.
+func (tm *TemplateManager) EnqueueBuild(ctx context.Context, templateID string, buildID uuid.UUID, priority int64) error {
+	rows, err := tm.sqlcDB.EnqueueTemplateBuild(ctx, queries.EnqueueTemplateBuildParams{
+		BuildID:  buildID,
+		Priority: priority,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enqueue template build '%s': %w", buildID, err)
+	}
+
+	if rows == 0 {
+		return ErrBuildNotWaiting
+	}
+
+	tm.buildCache.SetStatus(buildID, envbuild.StatusQueued, "build queued")
+	zap.L().Info("Template build queued", logger.WithTemplateID(templateID), logger.WithBuildID(buildID.String()), zap.Int64("priority", priority))
+
+	return nil
+}
+
+// QueuePosition returns the position of the queued build in the build queue, starting from 1.
+// The position is approximate, the team build limits can make the builds start in a different order.
+func (tm *TemplateManager) QueuePosition(ctx context.Context, buildID uuid.UUID) (int64, error) {
+	return tm.sqlcDB.GetTemplateBuildQueuePosition(ctx, buildID)
+}
+
+func (tm *TemplateManager) BuildQueuePeriodicalDispatch(ctx context.Context) {
+	ticker := time.NewTicker(buildQueueInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := tm.dispatchQueuedBuilds(ctx)
+			if err != nil {
+				zap.L().Error("Error dispatching queued template builds", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (tm *TemplateManager) dispatchQueuedBuilds(ctx context.Context) error {
+	dbCtx, dbCtxCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer dbCtxCancel()
+
+	queued, err := tm.sqlcDB.GetQueuedTemplateBuilds(dbCtx, buildQueueBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get queued builds: %w", err)
+	}
+
+	if len(queued) == 0 {
+		return nil
+	}
+
+	running, err := tm.sqlcDB.GetRunningTemplateBuilds(dbCtx)
+	if err != nil {
+		return fmt.Errorf("failed to get running builds: %w", err)
+	}
+
+	builds := scheduleBuilds(queued, running, tm.builderCapacity)
+
+	// Start the builds concurrently so a slow builder doesn't hold the others back,
+	// the next dispatch waits for them to avoid starting the same builds twice.
+	wg := sync.WaitGroup{}
+	for _, b := range builds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tm.startQueuedBuild(ctx, b)
+		}()
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// builderCapacity returns the builds capacity reported by the builder of the build.
+// It returns false when the builder isn't healthy, draining builders only finish their builds.
+func (tm *TemplateManager) builderCapacity(clusterID *uuid.UUID, nodeID *string) (edge.BuildsCapacity, bool) {
+	if clusterID == nil || nodeID == nil {
+		return tm.GetLocalClientBuildsCapacity(), tm.GetLocalClientStatus() == infogrpc.ServiceInfoStatus_OrchestratorHealthy
+	}
+
+	cluster, ok := tm.edgePool.GetClusterById(*clusterID)
+	if !ok {
+		return edge.BuildsCapacity{}, false
+	}
+
+	node, err := cluster.GetTemplateBuilderByID(*nodeID)
+	if err != nil {
+		return edge.BuildsCapacity{}, false
+	}
+
+	return node.GetBuildsCapacity(), node.GetStatus() == infogrpc.ServiceInfoStatus_OrchestratorHealthy
+}
+
+func (tm *TemplateManager) startQueuedBuild(ctx context.Context, b queries.GetQueuedTemplateBuildsRow) {
+	build := b.EnvBuild
+	templateID := *build.EnvID
+	buildLogger := zap.L().With(logger.WithTemplateID(templateID), logger.WithBuildID(build.ID.String()))
+
+	// Keep the periodical status sync away from the build until it's created on the builder
+	if tm.createInProcessingQueue(build.ID, templateID) {
+		return
+	}
+
+	startCtx, startCtxCancel := context.WithTimeout(ctx, buildStartTimeout)
+	defer startCtxCancel()
+
+	claimed, err := tm.sqlcDB.ClaimQueuedTemplateBuild(startCtx, build.ID)
+	if err != nil || claimed == 0 {
+		// The build was cancelled or picked by another dispatcher in the meantime
+		tm.removeFromProcessingQueue(build.ID)
+		if err != nil {
+			buildLogger.Error("Error claiming queued template build", zap.Error(err))
+		}
+
+		return
+	}
+
+	tm.buildCache.SetStatus(build.ID, envbuild.StatusBuilding, "starting build")
+
+	var startCmd string
+	if build.StartCmd != nil {
+		startCmd = *build.StartCmd
+	}
+
+	var readyCmd string
+	if build.ReadyCmd != nil {
+		readyCmd = *build.ReadyCmd
+	}
+
+	err = tm.CreateTemplate(
+		tm.tracer,
+		startCtx,
+		templateID,
+		build.ID,
+		build.KernelVersion,
+		build.FirecrackerVersion,
+		startCmd,
+		build.Vcpu,
+		build.FreeDiskSizeMb,
+		build.RamMb,
+		readyCmd,
+		b.ClusterID,
+		build.ClusterNodeID,
+	)
+	tm.removeFromProcessingQueue(build.ID)
+	if errors.Is(err, ErrBuilderNoCapacity) {
+		// The builder got full since its last capacity report, return the build to the queue
+		buildLogger.Info("Builder has no capacity for the queued template build, returning it to the queue")
+
+		_, releaseErr := tm.sqlcDB.ReleaseClaimedTemplateBuild(context.WithoutCancel(startCtx), build.ID)
+		if releaseErr != nil {
+			buildLogger.Error("Error returning template build to the queue", zap.Error(releaseErr))
+		} else {
+			tm.buildCache.SetStatus(build.ID, envbuild.StatusQueued, "build queued")
+			return
+		}
+	}
+
+	if err != nil {
+		buildLogger.Error("Error starting queued template build", zap.Error(err))
+
+		statusErr := tm.SetStatus(context.WithoutCancel(startCtx), templateID, build.ID, envbuild.StatusFailed, fmt.Sprintf("error when building env: %s", err))
+		if statusErr != nil {
+			buildLogger.Error("Error when setting build status", zap.Error(statusErr))
+		}
+
+		return
+	}
+
+	buildLogger.Info("Started queued template build")
+
+	// Do not wait for global build sync trigger it immediately
+	go func() {
+		err := tm.BuildStatusSync(ctx, build.ID, templateID, b.ClusterID, build.ClusterNodeID)
+		if err != nil {
+			buildLogger.Error("Error syncing build status", zap.Error(err))
+		}
+
+		// Invalidate the cache
+		tm.templateCache.Invalidate(templateID)
+	}()
+}
+
+// scheduleBuilds returns the queued builds which can be started now in the order they should be started.
+//
+// The builds with higher priority go first. Among the builds with the same priority the teams with fewer running builds
+// go first, so a single team can't take over the builders, and the builds of a team are started in the order they were queued.
+// The builds over the team concurrent builds limit, for unavailable builders and for builders without capacity are skipped.
+func scheduleBuilds(
+	queued []queries.GetQueuedTemplateBuildsRow,
+	running []queries.GetRunningTemplateBuildsRow,
+	builderCapacity func(clusterID *uuid.UUID, nodeID *string) (edge.BuildsCapacity, bool),
+) []queries.GetQueuedTemplateBuildsRow {
+	teamRunning := make(map[uuid.UUID]int64)
+	builderRunning := make(map[string]int64)
+	for _, r := range running {
+		teamRunning[r.TeamID]++
+		builderRunning[builderKey(r.ClusterNodeID)]++
+	}
+
+	builderMax := make(map[string]int64)
+	eligible := func(b queries.GetQueuedTemplateBuildsRow) bool {
+		if b.ConcurrentTemplateBuilds > 0 && teamRunning[b.TeamID] >= b.ConcurrentTemplateBuilds {
+			return false
+		}
+
+		key := builderKey(b.EnvBuild.ClusterNodeID)
+		if _, checked := builderMax[key]; !checked {
+			capacity, ok := builderCapacity(b.ClusterID, b.EnvBuild.ClusterNodeID)
+			if !ok {
+				capacity = edge.BuildsCapacity{}
+			}
+
+			builderMax[key] = capacity.Max
+			// The builder report can miss the builds started since, the database can miss the builds of other services
+			builderRunning[key] = max(builderRunning[key], capacity.Running)
+		}
+
+		return builderRunning[key] < builderMax[key]
+	}
+
+	// The queued builds are ordered by priority and queue time
+	remaining := append([]queries.GetQueuedTemplateBuildsRow(nil), queued...)
+	scheduled := make([]queries.GetQueuedTemplateBuildsRow, 0)
+	for {
+		next := -1
+		for i, b := range remaining {
+			if !eligible(b) {
+				continue
+			}
+
+			if next == -1 {
+				next = i
+				continue
+			}
+
+			best := remaining[next]
+			if b.EnvBuild.Priority < best.EnvBuild.Priority {
+				break
+			}
+
+			if teamRunning[b.TeamID] < teamRunning[best.TeamID] {
+				next = i
+			}
+		}
+
+		if next == -1 {
+			return scheduled
+		}
+
+		b := remaining[next]
+		scheduled = append(scheduled, b)
+		teamRunning[b.TeamID]++
+		builderRunning[builderKey(b.EnvBuild.ClusterNodeID)]++
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+}
+
+// builderKey identifies the builder the build runs on, the local template manager has an empty key.
+func builderKey(clusterNodeID *string) string {
+	if clusterNodeID == nil {
+		return ""
+	}
+
+	return *clusterNodeID
+}
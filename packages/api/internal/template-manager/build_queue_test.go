@@ -0,0 +1,126 @@
+package template_manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/e2b-dev/infra/packages/api/internal/edge"
+	"github.com/e2b-dev/infra/packages/db/queries"
+)
+
+func queuedBuild(teamID uuid.UUID, priority int64, concurrentBuilds int64, nodeID *string) queries.GetQueuedTemplateBuildsRow {
+	queuedAt := time.Now()
+
+	return queries.GetQueuedTemplateBuildsRow{
+		EnvBuild: queries.EnvBuild{
+			ID:            uuid.New(),
+			Priority:      priority,
+			QueuedAt:      &queuedAt,
+			ClusterNodeID: nodeID,
+		},
+		TeamID:                   teamID,
+		ConcurrentTemplateBuilds: concurrentBuilds,
+	}
+}
+
+func buildIDs(builds []queries.GetQueuedTemplateBuildsRow) []uuid.UUID {
+	ids := make([]uuid.UUID, len(builds))
+	for i, b := range builds {
+		ids[i] = b.EnvBuild.ID
+	}
+
+	return ids
+}
+
+// builders returns the capacity of healthy builders running no builds.
+func builders(maxBuilds int64) func(*uuid.UUID, *string) (edge.BuildsCapacity, bool) {
+	return func(*uuid.UUID, *string) (edge.BuildsCapacity, bool) {
+		return edge.BuildsCapacity{Max: maxBuilds}, true
+	}
+}
+
+func TestScheduleBuilds(t *testing.T) {
+	teamA := uuid.New()
+	teamB := uuid.New()
+
+	t.Run("higher priority goes first", func(t *testing.T) {
+		low := queuedBuild(teamA, 0, 0, nil)
+		high := queuedBuild(teamB, 10, 0, nil)
+
+		// The queue is ordered by priority
+		scheduled := scheduleBuilds([]queries.GetQueuedTemplateBuildsRow{high, low}, nil, builders(10))
+		assert.Equal(t, []uuid.UUID{high.EnvBuild.ID, low.EnvBuild.ID}, buildIDs(scheduled))
+	})
+
+	t.Run("teams with fewer running builds go first", func(t *testing.T) {
+		a1 := queuedBuild(teamA, 0, 0, nil)
+		a2 := queuedBuild(teamA, 0, 0, nil)
+		b1 := queuedBuild(teamB, 0, 0, nil)
+
+		running := []queries.GetRunningTemplateBuildsRow{{ID: uuid.New(), TeamID: teamA}}
+
+		scheduled := scheduleBuilds([]queries.GetQueuedTemplateBuildsRow{a1, a2, b1}, running, builders(10))
+		assert.Equal(t, []uuid.UUID{b1.EnvBuild.ID, a1.EnvBuild.ID, a2.EnvBuild.ID}, buildIDs(scheduled))
+	})
+
+	t.Run("team concurrent builds limit", func(t *testing.T) {
+		a1 := queuedBuild(teamA, 0, 2, nil)
+		a2 := queuedBuild(teamA, 0, 2, nil)
+		b1 := queuedBuild(teamB, 0, 0, nil)
+
+		running := []queries.GetRunningTemplateBuildsRow{{ID: uuid.New(), TeamID: teamA}}
+
+		scheduled := scheduleBuilds([]queries.GetQueuedTemplateBuildsRow{a1, a2, b1}, running, builders(10))
+		assert.Equal(t, []uuid.UUID{b1.EnvBuild.ID, a1.EnvBuild.ID}, buildIDs(scheduled))
+	})
+
+	t.Run("builder capacity", func(t *testing.T) {
+		node := "node-1"
+		a1 := queuedBuild(teamA, 0, 0, &node)
+		a2 := queuedBuild(teamA, 0, 0, &node)
+		b1 := queuedBuild(teamB, 0, 0, nil)
+
+		running := []queries.GetRunningTemplateBuildsRow{{ID: uuid.New(), TeamID: teamB, ClusterNodeID: &node}}
+
+		scheduled := scheduleBuilds([]queries.GetQueuedTemplateBuildsRow{a1, a2, b1}, running, builders(2))
+		assert.Equal(t, []uuid.UUID{a1.EnvBuild.ID, b1.EnvBuild.ID}, buildIDs(scheduled))
+	})
+
+	t.Run("unavailable builder", func(t *testing.T) {
+		node := "draining-node"
+		a1 := queuedBuild(teamA, 10, 0, &node)
+		b1 := queuedBuild(teamB, 0, 0, nil)
+
+		capacity := func(_ *uuid.UUID, nodeID *string) (edge.BuildsCapacity, bool) {
+			return edge.BuildsCapacity{Max: 10}, nodeID == nil
+		}
+
+		scheduled := scheduleBuilds([]queries.GetQueuedTemplateBuildsRow{a1, b1}, nil, capacity)
+		assert.Equal(t, []uuid.UUID{b1.EnvBuild.ID}, buildIDs(scheduled))
+	})
+
+	t.Run("builder reported capacity", func(t *testing.T) {
+		small := "small-node"
+		large := "large-node"
+		a1 := queuedBuild(teamA, 0, 0, &small)
+		a2 := queuedBuild(teamA, 0, 0, &small)
+		b1 := queuedBuild(teamB, 0, 0, &large)
+		b2 := queuedBuild(teamB, 0, 0, &large)
+		b3 := queuedBuild(teamB, 0, 0, &large)
+
+		capacity := func(_ *uuid.UUID, nodeID *string) (edge.BuildsCapacity, bool) {
+			if *nodeID == small {
+				// The builder runs a build the database doesn't know about yet
+				return edge.BuildsCapacity{Running: 1, Max: 2}, true
+			}
+
+			return edge.BuildsCapacity{Max: 2}, true
+		}
+
+		scheduled := scheduleBuilds([]queries.GetQueuedTemplateBuildsRow{a1, a2, b1, b2, b3}, nil, capacity)
+		assert.Equal(t, []uuid.UUID{a1.EnvBuild.ID, b1.EnvBuild.ID, b2.EnvBuild.ID}, buildIDs(scheduled))
+	})
+}
@@ -12,7 +12,9 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	templatecache "github.com/e2b-dev/infra/packages/api/internal/cache/templates"
 	"github.com/e2b-dev/infra/packages/api/internal/edge"
@@ -43,9 +45,17 @@ type TemplateManager struct {
 	lokiClient *loki.DefaultClient
 	sqlcDB     *sqlcdb.Client
 
+	// templateCache is invalidated when the build of the template finishes.
+	templateCache *templatecache.TemplateCache
+
 	localClient       *grpclient.GRPCClient
 	localClientMutex  sync.RWMutex
 	localClientStatus infogrpc.ServiceInfoStatus
+	localClientBuilds edge.BuildsCapacity
+
+	// buildEventsPollers are shared by all the clients streaming the events of the same build.
+	buildEventsLock    sync.Mutex
+	buildEventsPollers map[uuid.UUID]*buildEventsPoller
 }
 
 type DeleteBuild struct {
@@ -62,7 +72,13 @@ const (
 
 var ErrLocalTemplateManagerNotAvailable = errors.New("local template manager is not available")
 
-func New(ctx context.Context, tracer trace.Tracer, tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider, db *db.DB, sqlcDB *sqlcdb.Client, edgePool *edge.Pool, lokiClient *loki.DefaultClient, buildCache *templatecache.TemplatesBuildCache) (*TemplateManager, error) {
+var (
+	ErrBuildNotFound     = errors.New("build not found on the template manager")
+	ErrBuildNotRunning   = errors.New("build is not running")
+	ErrBuilderNoCapacity = errors.New("builder is running the maximum of builds")
+)
+
+func New(ctx context.Context, tracer trace.Tracer, tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider, db *db.DB, sqlcDB *sqlcdb.Client, edgePool *edge.Pool, lokiClient *loki.DefaultClient, buildCache *templatecache.TemplatesBuildCache, templateCache *templatecache.TemplateCache) (*TemplateManager, error) {
 	client, err := createClient(tracerProvider, meterProvider)
 	if err != nil {
 		return nil, fmt.Errorf("failed to establish GRPC connection: %w", err)
@@ -77,12 +93,16 @@ func New(ctx context.Context, tracer trace.Tracer, tracerProvider trace.TracerPr
 		edgePool:   edgePool,
 		lokiClient: lokiClient,
 
+		templateCache: templateCache,
+
 		localClient:       client,
 		localClientMutex:  sync.RWMutex{},
 		localClientStatus: infogrpc.ServiceInfoStatus_OrchestratorUnhealthy,
 
 		lock:       sync.Mutex{},
 		processing: make(map[uuid.UUID]processingBuilds),
+
+		buildEventsPollers: make(map[uuid.UUID]*buildEventsPoller),
 	}
 
 	// Periodically check for local template manager health status
@@ -206,6 +226,40 @@ func (tm *TemplateManager) DeleteBuild(ctx context.Context, t trace.Tracer, buil
 	return nil
 }
 
+// CancelBuild stops the running build on the template manager.
+func (tm *TemplateManager) CancelBuild(ctx context.Context, buildID uuid.UUID, templateID string, clusterID *uuid.UUID, clusterNodeID *string) error {
+	ctx, span := tm.tracer.Start(ctx, "cancel-template-build",
+		trace.WithAttributes(
+			telemetry.WithBuildID(buildID.String()),
+		),
+	)
+	defer span.End()
+
+	client, clientMd, _, err := tm.getBuilderClient(clusterID, clusterNodeID, false)
+	if err != nil {
+		return fmt.Errorf("failed to get builder edgeHttpClient: %w", err)
+	}
+
+	reqCtx := metadata.NewOutgoingContext(ctx, clientMd)
+	_, err = client.Template.TemplateBuildCancel(
+		reqCtx, &templatemanagergrpc.TemplateBuildCancelRequest{
+			BuildID:    buildID.String(),
+			TemplateID: templateID,
+		},
+	)
+
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return ErrBuildNotFound
+	case codes.FailedPrecondition:
+		return ErrBuildNotRunning
+	default:
+		return fmt.Errorf("failed to cancel env build '%s': %w", buildID, utils.UnwrapGRPCError(err))
+	}
+}
+
 func (tm *TemplateManager) DeleteBuilds(ctx context.Context, builds []DeleteBuild) error {
 	for _, build := range builds {
 		err := tm.DeleteBuild(ctx, tm.tracer, build.BuildID, build.TemplateID, build.ClusterID, build.ClusterNodeID)
@@ -275,6 +329,10 @@ func (tm *TemplateManager) CreateTemplate(t trace.Tracer, ctx context.Context, t
 		},
 	)
 
+	if status.Code(err) == codes.ResourceExhausted {
+		return ErrBuilderNoCapacity
+	}
+
 	if err != nil {
 		zap.L().Error("发送模板创建请求失败", 
 			zap.String("templateID", templateID), 
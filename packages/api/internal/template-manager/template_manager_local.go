@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/e2b-dev/infra/packages/api/internal/edge"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	orchestratorinfo "github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator-info"
 )
@@ -54,6 +55,21 @@ func (tm *TemplateManager) localClientHealthSync(ctx context.Context) {
 		zap.String("templateManagerHost", templateManagerHost), 
 		zap.String("status", res.ServiceStatus.String()))
 	tm.setLocalClientStatus(res.ServiceStatus)
+	tm.setLocalClientBuildsCapacity(edge.BuildsCapacity{Running: res.MetricBuildsRunning, Max: res.MetricBuildsMax})
+}
+
+func (tm *TemplateManager) setLocalClientBuildsCapacity(c edge.BuildsCapacity) {
+	tm.localClientMutex.Lock()
+	defer tm.localClientMutex.Unlock()
+
+	tm.localClientBuilds = c
+}
+
+func (tm *TemplateManager) GetLocalClientBuildsCapacity() edge.BuildsCapacity {
+	tm.localClientMutex.RLock()
+	defer tm.localClientMutex.RUnlock()
+
+	return tm.localClientBuilds
 }
 
 func (tm *TemplateManager) setLocalClientStatus(s orchestratorinfo.ServiceInfoStatus) {
@@ -190,6 +190,19 @@ func TestPollBuildStatus_dispatchBasedOnStatus(t *testing.T) {
 			wantCompleteState: false,
 			wantErr:           true,
 		},
+		{
+			name: "should handle cancelled status",
+			fields: fields{
+				templateManagerClient: &fakeTemplateManagerClient{},
+			},
+			args: args{
+				status: &templatemanagergrpc.TemplateBuildStatusResponse{
+					Status: templatemanagergrpc.TemplateBuildState_Cancelled,
+				},
+			},
+			wantCompleteState: true,
+			wantErr:           false,
+		},
 		{
 			name: "should not send to done channel for building status",
 			fields: fields{
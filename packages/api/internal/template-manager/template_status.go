@@ -163,6 +163,12 @@ func (c *PollBuildStatus) dispatchBasedOnStatus(ctx context.Context, status *tem
 			return errors.Wrap(err, "error when setting build status"), false
 		}
 		return nil, true
+	case templatemanagergrpc.TemplateBuildState_Cancelled:
+		err := c.client.SetStatus(ctx, c.templateID, c.buildID, envbuild.StatusCancelled, "template build was cancelled")
+		if err != nil {
+			return errors.Wrap(err, "error when setting build status"), false
+		}
+		return nil, true
 	case templatemanagergrpc.TemplateBuildState_Completed:
 		// build completed
 		meta := status.GetMetadata()
@@ -0,0 +1,99 @@
+package usage
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/chmodels"
+)
+
+const recordTimeout = 10 * time.Second
+
+// store is the part of the ClickHouse store the recorder uses.
+type store interface {
+	InsertSandboxUsage(ctx context.Context, usage chmodels.SandboxUsage) error
+}
+
+// Recorder writes the sandbox lifecycle events with the resources used by each run to ClickHouse.
+// The recorder without a store doesn't record anything, so the API can run without ClickHouse.
+type Recorder struct {
+	store store
+	now   func() time.Time
+}
+
+func NewRecorder(store store) *Recorder {
+	return &Recorder{store: store, now: time.Now}
+}
+
+// Started records the start or the resume of the sandbox.
+func (r *Recorder) Started(ctx context.Context, info *instance.InstanceInfo) {
+	if r == nil {
+		return
+	}
+
+	eventType := chmodels.SandboxUsageStart
+	if info.IsResume {
+		eventType = chmodels.SandboxUsageResume
+	}
+
+	r.record(ctx, newUsage(info, eventType, r.now()))
+}
+
+// Stopped records the end of the sandbox's run, with the resources it used since it was started or resumed.
+func (r *Recorder) Stopped(ctx context.Context, info *instance.InstanceInfo, stopTime time.Time, paused bool) {
+	eventType := chmodels.SandboxUsageStop
+	if paused {
+		eventType = chmodels.SandboxUsagePause
+	}
+
+	u := newUsage(info, eventType, stopTime)
+
+	duration := max(stopTime.Sub(info.StartTime).Seconds(), 0)
+	u.DurationSeconds = duration
+	u.VCPUSeconds = float64(info.VCpu) * duration
+	u.RAMMiBSeconds = float64(info.MemoryMB()) * duration
+	u.DiskMiBSeconds = float64(info.TotalDiskSizeMB) * duration
+
+	r.record(ctx, u)
+}
+
+func (r *Recorder) record(ctx context.Context, u chmodels.SandboxUsage) {
+	if r == nil || r.store == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, recordTimeout)
+	defer cancel()
+
+	err := r.store.InsertSandboxUsage(ctx, u)
+	if err != nil {
+		zap.L().Error("Error recording sandbox usage", logger.WithSandboxID(u.SandboxID), logger.WithTeamID(u.TeamID), zap.String("event_type", u.EventType), zap.Error(err))
+	}
+}
+
+func newUsage(info *instance.InstanceInfo, eventType chmodels.SandboxUsageEventType, timestamp time.Time) chmodels.SandboxUsage {
+	u := chmodels.SandboxUsage{
+		Timestamp:   timestamp.UTC(),
+		EventType:   string(eventType),
+		SandboxID:   info.Instance.SandboxID,
+		ExecutionID: info.ExecutionID,
+		TemplateID:  info.Instance.TemplateID,
+		VCPUCount:   uint32(max(info.VCpu, 0)),
+		RAMMiB:      uint64(max(info.MemoryMB(), 0)),
+		DiskMiB:     uint64(max(info.TotalDiskSizeMB, 0)),
+	}
+
+	if info.TeamID != nil {
+		u.TeamID = info.TeamID.String()
+	}
+
+	if info.BuildID != nil {
+		u.BuildID = info.BuildID.String()
+	}
+
+	return u
+}
@@ -0,0 +1,86 @@
+package usage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/chmodels"
+)
+
+type fakeStore struct {
+	usage []chmodels.SandboxUsage
+}
+
+func (s *fakeStore) InsertSandboxUsage(_ context.Context, usage chmodels.SandboxUsage) error {
+	s.usage = append(s.usage, usage)
+
+	return nil
+}
+
+func newTestInstance(startTime time.Time, isResume bool) *instance.InstanceInfo {
+	teamID := uuid.New()
+	buildID := uuid.New()
+
+	return &instance.InstanceInfo{
+		Instance:        &api.Sandbox{SandboxID: "sandbox-id", TemplateID: "template-id"},
+		ExecutionID:     "execution-id",
+		TeamID:          &teamID,
+		BuildID:         &buildID,
+		StartTime:       startTime,
+		VCpu:            2,
+		RamMB:           512,
+		TotalDiskSizeMB: 1024,
+		IsResume:        isResume,
+	}
+}
+
+func TestRecorder_Started(t *testing.T) {
+	store := &fakeStore{}
+	r := NewRecorder(store)
+
+	r.Started(context.Background(), newTestInstance(time.Now(), false))
+	r.Started(context.Background(), newTestInstance(time.Now(), true))
+
+	require.Len(t, store.usage, 2)
+	assert.Equal(t, string(chmodels.SandboxUsageStart), store.usage[0].EventType)
+	assert.Equal(t, string(chmodels.SandboxUsageResume), store.usage[1].EventType)
+	assert.Zero(t, store.usage[0].VCPUSeconds)
+}
+
+func TestRecorder_Stopped(t *testing.T) {
+	store := &fakeStore{}
+	r := NewRecorder(store)
+
+	start := time.Now()
+	info := newTestInstance(start, false)
+
+	r.Stopped(context.Background(), info, start.Add(90*time.Second), true)
+
+	require.Len(t, store.usage, 1)
+	u := store.usage[0]
+
+	assert.Equal(t, string(chmodels.SandboxUsagePause), u.EventType)
+	assert.Equal(t, info.TeamID.String(), u.TeamID)
+	assert.Equal(t, info.BuildID.String(), u.BuildID)
+	assert.Equal(t, "template-id", u.TemplateID)
+	assert.InDelta(t, 90, u.DurationSeconds, 0.001)
+	assert.InDelta(t, 180, u.VCPUSeconds, 0.001)
+	assert.InDelta(t, 512*90, u.RAMMiBSeconds, 0.001)
+	assert.InDelta(t, 1024*90, u.DiskMiBSeconds, 0.001)
+}
+
+func TestRecorder_WithoutStore(t *testing.T) {
+	var nilRecorder *Recorder
+
+	assert.NotPanics(t, func() {
+		NewRecorder(nil).Started(context.Background(), newTestInstance(time.Now(), false))
+		nilRecorder.Stopped(context.Background(), newTestInstance(time.Now(), false), time.Now(), false)
+	})
+}
@@ -111,6 +111,7 @@ func MultiErrorHandler(me openapi3.MultiError) error {
 
 		var teamForbidden *db.TeamForbiddenError
 		var teamBlocked *db.TeamBlockedError
+		var apiKeyForbidden *auth.APIKeyForbiddenError
 		// Return only the first non-missing authorization header error (if possible)
 		for _, errW := range unwrapped {
 			if errors.Is(errW, auth.ErrNoAuthHeader) {
@@ -125,6 +126,10 @@ func MultiErrorHandler(me openapi3.MultiError) error {
 				return fmt.Errorf("%s%s", blockedErrPrefix, err.Error())
 			}
 
+			if errors.As(errW, &apiKeyForbidden) {
+				return fmt.Errorf("%s%s", forbiddenErrPrefix, apiKeyForbidden.Error())
+			}
+
 			err = errW
 			break
 		}
@@ -21,7 +21,12 @@ type PaginatedSandbox struct {
 }
 
 func (p *PaginatedSandbox) GenerateCursor() string {
-	cursor := fmt.Sprintf("%s__%s", p.PaginationTimestamp.Format(time.RFC3339Nano), p.SandboxID)
+	return EncodeCursor(p.PaginationTimestamp, p.SandboxID)
+}
+
+// EncodeCursor creates the pagination cursor parsed by ParseCursor.
+func EncodeCursor(timestamp time.Time, id string) string {
+	cursor := fmt.Sprintf("%s__%s", timestamp.Format(time.RFC3339Nano), id)
 	return base64.URLEncoding.EncodeToString([]byte(cursor))
 }
 
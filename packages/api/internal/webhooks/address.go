@@ -0,0 +1,102 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+var ErrDisallowedAddress = errors.New("webhooks can't be delivered to loopback, private, shared, link-local or unspecified addresses")
+
+// disallowedPrefixes are the internal IPv4 ranges not covered by the netip checks,
+// the IPv4-mapped IPv6 addresses are unmapped before they are checked.
+var disallowedPrefixes = []netip.Prefix{
+	// Shared address space used by the carrier-grade NAT and the cloud provider networks
+	netip.MustParsePrefix("100.64.0.0/10"),
+	// "This network", the addresses other than 0.0.0.0 can still reach the local host on some systems
+	netip.MustParsePrefix("0.0.0.0/8"),
+}
+
+// ValidateURL checks the webhook URL is an absolute http or https URL which doesn't point to an internal address.
+// The hostnames are resolved only when the event is delivered, the resolved addresses are checked when dialing.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("invalid webhook URL '%s', it must be an absolute http or https URL", rawURL)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrDisallowedAddress
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err == nil && isDisallowedAddress(addr) {
+		return ErrDisallowedAddress
+	}
+
+	return nil
+}
+
+func isDisallowedAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	for _, prefix := range disallowedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified()
+}
+
+// dialControl rejects the connections to the disallowed addresses,
+// it checks the address the hostname resolved to, so it can't be changed by the DNS after the URL was validated.
+func dialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address '%s': %w", address, err)
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("invalid address '%s': %w", address, err)
+	}
+
+	if isDisallowedAddress(addr) {
+		return ErrDisallowedAddress
+	}
+
+	return nil
+}
+
+// newHTTPClient returns the client for the deliveries, it doesn't follow the redirects and connects only to the public addresses.
+func newHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: deliveryTimeout,
+		Control: dialControl,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   deliveryTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateURL(t *testing.T) {
+	t.Run("accepts the public URLs", func(t *testing.T) {
+		for _, rawURL := range []string{
+			"https://example.com/webhook",
+			"http://example.com:8080/webhook",
+			"https://93.184.216.34/webhook",
+			"https://100.128.0.1/webhook",
+			"https://[2606:2800:220:1:248:1893:25c8:1946]/webhook",
+		} {
+			assert.NoError(t, ValidateURL(rawURL), rawURL)
+		}
+	})
+
+	t.Run("rejects the invalid URLs", func(t *testing.T) {
+		for _, rawURL := range []string{
+			"example.com/webhook",
+			"ftp://example.com/webhook",
+			"https:///webhook",
+			"://",
+		} {
+			assert.Error(t, ValidateURL(rawURL), rawURL)
+		}
+	})
+
+	t.Run("rejects the internal addresses", func(t *testing.T) {
+		for _, rawURL := range []string{
+			"http://localhost/webhook",
+			"http://api.localhost/webhook",
+			"http://127.0.0.1:8080/webhook",
+			"http://10.0.0.1/webhook",
+			"http://172.16.0.1/webhook",
+			"http://192.168.1.1/webhook",
+			"http://169.254.169.254/latest/meta-data",
+			"http://0.0.0.0/webhook",
+			"http://0.1.2.3/webhook",
+			"http://100.64.0.1/webhook",
+			"http://100.127.255.254/webhook",
+			"http://[::1]/webhook",
+			"http://[::]/webhook",
+			"http://[fd00::1]/webhook",
+			"http://[fe80::1]/webhook",
+			"http://[::ffff:127.0.0.1]/webhook",
+			"http://[::ffff:100.64.0.1]/webhook",
+			"http://[::ffff:0.1.2.3]/webhook",
+		} {
+			assert.ErrorIs(t, ValidateURL(rawURL), ErrDisallowedAddress, rawURL)
+		}
+	})
+}
+
+func TestDialControl(t *testing.T) {
+	assert.NoError(t, dialControl("tcp4", "93.184.216.34:443", nil))
+	assert.NoError(t, dialControl("tcp6", "[2606:2800:220:1:248:1893:25c8:1946]:443", nil))
+
+	assert.ErrorIs(t, dialControl("tcp4", "127.0.0.1:80", nil), ErrDisallowedAddress)
+	assert.ErrorIs(t, dialControl("tcp4", "169.254.169.254:80", nil), ErrDisallowedAddress)
+	assert.ErrorIs(t, dialControl("tcp4", "10.1.2.3:443", nil), ErrDisallowedAddress)
+	assert.ErrorIs(t, dialControl("tcp6", "[::1]:443", nil), ErrDisallowedAddress)
+	assert.ErrorIs(t, dialControl("tcp4", "100.100.100.200:80", nil), ErrDisallowedAddress)
+	assert.ErrorIs(t, dialControl("tcp6", "[::ffff:100.64.0.1]:443", nil), ErrDisallowedAddress)
+
+	assert.Error(t, dialControl("tcp4", "example.com", nil))
+}
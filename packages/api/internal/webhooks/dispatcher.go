@@ -0,0 +1,231 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+const (
+	deliveryTimeout = 10 * time.Second
+	maxAttempts     = 5
+	initialBackoff  = 2 * time.Second
+	maxBackoff      = 2 * time.Minute
+
+	// queueSize is the number of events waiting for the delivery, the new events are dropped when the queue is full.
+	queueSize = 10_000
+	workers   = 8
+	// deliveryWorkers is the number of deliveries in progress, the retried deliveries hold the worker while waiting for the backoff.
+	deliveryWorkers = 64
+)
+
+// store is the part of the database the dispatcher uses.
+type store interface {
+	GetEnabledWebhooks(ctx context.Context, teamID uuid.UUID) ([]*models.Webhook, error)
+	CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+}
+
+// Dispatcher delivers the events to the webhooks of the teams, the failed deliveries are retried with an exponential backoff.
+type Dispatcher struct {
+	store      store
+	httpClient *http.Client
+	queue      chan Event
+	deliveries chan pendingDelivery
+	backoff    func(attempt int) time.Duration
+}
+
+// pendingDelivery is the event waiting to be delivered to a single webhook.
+type pendingDelivery struct {
+	webhook *models.Webhook
+	event   Event
+	body    []byte
+}
+
+func NewDispatcher(ctx context.Context, store store) *Dispatcher {
+	d := newDispatcher(store, newHTTPClient(), exponentialBackoff)
+	d.start(ctx)
+
+	return d
+}
+
+func newDispatcher(store store, httpClient *http.Client, backoff func(attempt int) time.Duration) *Dispatcher {
+	return &Dispatcher{
+		store:      store,
+		httpClient: httpClient,
+		queue:      make(chan Event, queueSize),
+		deliveries: make(chan pendingDelivery),
+		backoff:    backoff,
+	}
+}
+
+func exponentialBackoff(attempt int) time.Duration {
+	return min(initialBackoff<<(attempt-1), maxBackoff)
+}
+
+func (d *Dispatcher) start(ctx context.Context) {
+	for range workers {
+		go d.run(ctx)
+	}
+
+	for range deliveryWorkers {
+		go d.runDeliveries(ctx)
+	}
+}
+
+// Emit queues the event for the delivery, it doesn't block.
+func (d *Dispatcher) Emit(event Event) {
+	if d == nil {
+		return
+	}
+
+	select {
+	case d.queue <- event:
+	default:
+		zap.L().Error("Webhook event queue is full, dropping the event", logger.WithSandboxID(event.Sandbox.SandboxID), zap.String("event_type", string(event.Type)))
+	}
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-d.queue:
+			d.dispatch(ctx, event)
+		}
+	}
+}
+
+func (d *Dispatcher) runDeliveries(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-d.deliveries:
+			d.deliver(ctx, job.webhook, job.event, job.body)
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, event Event) {
+	webhooks, err := d.store.GetEnabledWebhooks(ctx, event.teamID)
+	if err != nil {
+		zap.L().Error("Error getting webhooks", logger.WithTeamID(event.teamID.String()), zap.Error(err))
+
+		return
+	}
+
+	var body []byte
+	for _, w := range webhooks {
+		if !slices.Contains(w.Events, string(event.Type)) {
+			continue
+		}
+
+		if body == nil {
+			body, err = json.Marshal(event)
+			if err != nil {
+				zap.L().Error("Error marshalling webhook event", zap.Error(err))
+
+				return
+			}
+		}
+
+		// Wait for a free delivery worker, the events are queued in the meantime
+		select {
+		case <-ctx.Done():
+			return
+		case d.deliveries <- pendingDelivery{webhook: w, event: event, body: body}:
+		}
+	}
+}
+
+// deliver sends the event to the webhook until it's accepted or the attempts run out, every attempt is recorded.
+func (d *Dispatcher) deliver(ctx context.Context, w *models.Webhook, event Event, body []byte) {
+	for attempt := 1; ; attempt++ {
+		statusCode, err := d.send(ctx, w, event, body)
+
+		delivery := &models.WebhookDelivery{
+			WebhookID: w.ID,
+			EventID:   event.ID,
+			EventType: string(event.Type),
+			SandboxID: event.Sandbox.SandboxID,
+			Attempt:   int32(attempt),
+			Success:   err == nil,
+		}
+
+		if statusCode != 0 {
+			code := int32(statusCode)
+			delivery.StatusCode = &code
+		}
+
+		if err != nil {
+			msg := err.Error()
+			delivery.Error = &msg
+		}
+
+		recordErr := d.store.CreateWebhookDelivery(ctx, delivery)
+		if recordErr != nil {
+			zap.L().Error("Error recording webhook delivery", zap.String("webhook_id", w.ID.String()), zap.Error(recordErr))
+		}
+
+		if err == nil {
+			return
+		}
+
+		if attempt == maxAttempts {
+			zap.L().Warn("Giving up delivering webhook event", zap.String("webhook_id", w.ID.String()), zap.String("event_id", event.ID.String()), zap.Error(err))
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.backoff(attempt)):
+		}
+	}
+}
+
+// send makes a single delivery attempt, it returns the status code if the webhook responded.
+func (d *Dispatcher) send(ctx context.Context, w *models.Webhook, event Event, body []byte) (int, error) {
+	timestamp := time.Now().Unix()
+
+	signature, err := Sign(w.Secret, timestamp, body)
+	if err != nil {
+		return 0, fmt.Errorf("error signing the event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("error creating the request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, signature)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(EventIDHeader, event.ID.String())
+	req.Header.Set(EventTypeHeader, string(event.Type))
+
+	res, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error sending the request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
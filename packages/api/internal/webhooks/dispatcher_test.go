@@ -0,0 +1,225 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+const testSecret = "test-secret"
+
+type fakeStore struct {
+	webhooks []*models.Webhook
+
+	mu         sync.Mutex
+	deliveries []*models.WebhookDelivery
+}
+
+func (s *fakeStore) GetEnabledWebhooks(context.Context, uuid.UUID) ([]*models.Webhook, error) {
+	return s.webhooks, nil
+}
+
+func (s *fakeStore) CreateWebhookDelivery(_ context.Context, delivery *models.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deliveries = append(s.deliveries, delivery)
+
+	return nil
+}
+
+func (s *fakeStore) recorded() []*models.WebhookDelivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*models.WebhookDelivery(nil), s.deliveries...)
+}
+
+func newTestEvent(eventType api.WebhookEventType) Event {
+	return Event{
+		ID:        uuid.New(),
+		Type:      eventType,
+		Timestamp: time.Now(),
+		Sandbox:   SandboxEventData{SandboxID: "sandbox-id", TemplateID: "template-id", ClientID: "node-id"},
+		teamID:    uuid.New(),
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	body := []byte(`{"type":"sandbox.killed"}`)
+
+	signature, err := Sign(testSecret, 1700000000, body)
+	require.NoError(t, err)
+
+	assert.True(t, Verify(testSecret, 1700000000, body, signature))
+	assert.False(t, Verify("other-secret", 1700000000, body, signature))
+	assert.False(t, Verify(testSecret, 1700000001, body, signature))
+	assert.False(t, Verify(testSecret, 1700000000, []byte(`{"type":"sandbox.paused"}`), signature))
+}
+
+func TestDispatcher_DeliversSignedEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan Event, 1)
+	server := httptest.NewServer(NewReceiver(testSecret, func(event Event) {
+		received <- event
+	}))
+	defer server.Close()
+
+	store := &fakeStore{webhooks: []*models.Webhook{
+		{ID: uuid.New(), URL: server.URL, Secret: testSecret, Events: []string{string(api.SandboxKilled)}},
+		{ID: uuid.New(), URL: server.URL, Secret: testSecret, Events: []string{string(api.SandboxPaused)}},
+	}}
+
+	d := newDispatcher(store, server.Client(), func(int) time.Duration { return time.Millisecond })
+	d.start(ctx)
+
+	event := newTestEvent(api.SandboxKilled)
+	d.Emit(event)
+
+	select {
+	case got := <-received:
+		assert.Equal(t, event.ID, got.ID)
+		assert.Equal(t, api.SandboxKilled, got.Type)
+		assert.Equal(t, "sandbox-id", got.Sandbox.SandboxID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the delivery")
+	}
+
+	require.Eventually(t, func() bool { return len(store.recorded()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	delivery := store.recorded()[0]
+	assert.True(t, delivery.Success)
+	assert.Equal(t, int32(1), delivery.Attempt)
+	assert.Equal(t, int32(http.StatusNoContent), *delivery.StatusCode)
+}
+
+func TestDispatcher_RetriesFailedDelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store := &fakeStore{webhooks: []*models.Webhook{
+		{ID: uuid.New(), URL: server.URL, Secret: testSecret, Events: []string{string(api.SandboxCreated)}},
+	}}
+
+	d := newDispatcher(store, server.Client(), func(int) time.Duration { return time.Millisecond })
+	d.start(ctx)
+
+	d.Emit(newTestEvent(api.SandboxCreated))
+
+	require.Eventually(t, func() bool { return len(store.recorded()) == 3 }, 5*time.Second, 10*time.Millisecond)
+
+	deliveries := store.recorded()
+	for i, delivery := range deliveries {
+		assert.Equal(t, int32(i+1), delivery.Attempt)
+		assert.Equal(t, i == 2, delivery.Success)
+	}
+
+	assert.Equal(t, int32(http.StatusInternalServerError), *deliveries[0].StatusCode)
+	assert.NotNil(t, deliveries[0].Error)
+}
+
+func TestDispatcher_GivesUpAfterMaxAttempts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	store := &fakeStore{webhooks: []*models.Webhook{
+		{ID: uuid.New(), URL: server.URL, Secret: testSecret, Events: []string{string(api.SandboxTimedOut)}},
+	}}
+
+	d := newDispatcher(store, server.Client(), func(int) time.Duration { return time.Millisecond })
+	d.start(ctx)
+
+	d.Emit(newTestEvent(api.SandboxTimedOut))
+
+	require.Eventually(t, func() bool { return len(store.recorded()) == maxAttempts }, 5*time.Second, 10*time.Millisecond)
+
+	// No more attempts are made
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, store.recorded(), maxAttempts)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	assert.Equal(t, initialBackoff, exponentialBackoff(1))
+	assert.Equal(t, 2*initialBackoff, exponentialBackoff(2))
+	assert.Equal(t, maxBackoff, exponentialBackoff(20))
+}
+
+func TestReceiver_RejectsInvalidSignature(t *testing.T) {
+	receiver := NewReceiver(testSecret, func(Event) {
+		t.Fatal("the event shouldn't be accepted")
+	})
+
+	timestamp := time.Now().Unix()
+	body := []byte(`{"type":"sandbox.killed"}`)
+
+	signature, err := Sign("other-secret", timestamp, body)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, signature)
+
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestDispatcher_RejectsInternalAddresses(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store := &fakeStore{webhooks: []*models.Webhook{
+		{ID: uuid.New(), URL: server.URL, Secret: testSecret, Events: []string{string(api.SandboxCreated)}},
+	}}
+
+	// The test server listens on the loopback, like an internal service would
+	d := newDispatcher(store, newHTTPClient(), func(int) time.Duration { return time.Millisecond })
+	d.start(ctx)
+
+	d.Emit(newTestEvent(api.SandboxCreated))
+
+	require.Eventually(t, func() bool { return len(store.recorded()) == maxAttempts }, 5*time.Second, 10*time.Millisecond)
+
+	assert.Zero(t, calls.Load())
+	assert.Contains(t, *store.recorded()[0].Error, ErrDisallowedAddress.Error())
+}
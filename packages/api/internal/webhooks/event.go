@@ -0,0 +1,72 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/api/internal/api"
+	"github.com/e2b-dev/infra/packages/api/internal/cache/instance"
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
+)
+
+const (
+	// SignatureHeader is the hex encoded HMAC-SHA256 of the "<timestamp>.<body>" signed with the webhook secret.
+	SignatureHeader = "E2B-Signature"
+	// TimestampHeader is the unix time of the delivery, receivers should reject old deliveries to prevent replays.
+	TimestampHeader = "E2B-Timestamp"
+	EventIDHeader   = "E2B-Event-ID"
+	EventTypeHeader = "E2B-Event-Type"
+)
+
+// SandboxEventData is the sandbox the event is about.
+type SandboxEventData struct {
+	SandboxID  string            `json:"sandboxID"`
+	TemplateID string            `json:"templateID"`
+	Alias      *string           `json:"alias,omitempty"`
+	ClientID   string            `json:"clientID"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+// Event is the payload delivered to the webhooks.
+type Event struct {
+	ID        uuid.UUID            `json:"id"`
+	Type      api.WebhookEventType `json:"type"`
+	Timestamp time.Time            `json:"timestamp"`
+	Sandbox   SandboxEventData     `json:"sandbox"`
+
+	teamID uuid.UUID
+}
+
+func NewSandboxEvent(eventType api.WebhookEventType, sbx *instance.InstanceInfo) Event {
+	return Event{
+		ID:        uuid.New(),
+		Type:      eventType,
+		Timestamp: time.Now(),
+		Sandbox: SandboxEventData{
+			SandboxID:  sbx.Instance.SandboxID,
+			TemplateID: sbx.Instance.TemplateID,
+			Alias:      sbx.Instance.Alias,
+			ClientID:   sbx.Instance.ClientID,
+			Metadata:   sbx.GetMetadata(),
+		},
+		teamID: *sbx.TeamID,
+	}
+}
+
+// Sign returns the signature of the delivery body sent at the timestamp.
+func Sign(secret string, timestamp int64, body []byte) (string, error) {
+	return keys.NewHMACSHA256Hashing([]byte(secret)).Hash([]byte(fmt.Sprintf("%d.%s", timestamp, body)))
+}
+
+// Verify checks the signature of the delivery body sent at the timestamp.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	expected, err := Sign(secret, timestamp, body)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,68 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// receiverMaxAge is the maximum age of the delivery accepted by the receiver.
+const receiverMaxAge = 5 * time.Minute
+
+// Receiver is a webhook endpoint verifying the signed deliveries, it's used for testing the webhooks locally.
+type Receiver struct {
+	secret  string
+	onEvent func(event Event)
+}
+
+func NewReceiver(secret string, onEvent func(event Event)) *Receiver {
+	return &Receiver{secret: secret, onEvent: onEvent}
+}
+
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, "error reading the body", http.StatusBadRequest)
+
+		return
+	}
+
+	timestamp, err := strconv.ParseInt(req.Header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid timestamp", http.StatusBadRequest)
+
+		return
+	}
+
+	if age := time.Since(time.Unix(timestamp, 0)); age > receiverMaxAge || age < -receiverMaxAge {
+		http.Error(w, "delivery is too old", http.StatusBadRequest)
+
+		return
+	}
+
+	if !Verify(r.secret, timestamp, body, req.Header.Get(SignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+
+		return
+	}
+
+	var event Event
+	err = json.Unmarshal(body, &event)
+	if err != nil {
+		http.Error(w, "invalid event", http.StatusBadRequest)
+
+		return
+	}
+
+	r.onEvent(event)
+
+	w.WriteHeader(http.StatusNoContent)
+}
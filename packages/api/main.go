@@ -34,6 +34,8 @@ import (
 	customMiddleware "github.com/e2b-dev/infra/packages/api/internal/middleware"
 	metricsMiddleware "github.com/e2b-dev/infra/packages/api/internal/middleware/otel/metrics"
 	tracingMiddleware "github.com/e2b-dev/infra/packages/api/internal/middleware/otel/tracing"
+	"github.com/e2b-dev/infra/packages/api/internal/middleware/ratelimit"
+	sandboxlogs "github.com/e2b-dev/infra/packages/api/internal/sandbox/logs"
 	"github.com/e2b-dev/infra/packages/api/internal/utils"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
 	l "github.com/e2b-dev/infra/packages/shared/pkg/logger"
@@ -68,11 +70,13 @@ func NewGinServer(ctx context.Context, tel *telemetry.Client, logger *zap.Logger
 	r := gin.New()
 
 	r.Use(
+		customMiddleware.RequestID(),
 		// We use custom otel gin middleware because we want to log 4xx errors in the otel
 		customMiddleware.ExcludeRoutes(
 			tracingMiddleware.Middleware(tel.TracerProvider, serviceName),
 			"/health",
 			"/sandboxes/:sandboxID/refreshes",
+			"/sandboxes/:sandboxID/logs/stream",
 			"/templates/:templateID/builds/:buildID/logs",
 			"/templates/:templateID/builds/:buildID/status",
 		),
@@ -82,6 +86,9 @@ func NewGinServer(ctx context.Context, tel *telemetry.Client, logger *zap.Logger
 			"/sandboxes/:sandboxID",
 			"/sandboxes/:sandboxID/pause",
 			"/sandboxes/:sandboxID/resume",
+			"/sandboxes/:sandboxID/fork",
+			"/sandboxes/:sandboxID/checkpoints",
+			"/sandboxes/:sandboxID/checkpoints/:checkpointID/restore",
 		),
 		gin.Recovery(),
 	)
@@ -100,6 +107,9 @@ func NewGinServer(ctx context.Context, tel *telemetry.Client, logger *zap.Logger
 		// Supabase headers
 		"X-Supabase-Token",
 		"X-Supabase-Team",
+		// Request ID header
+		"X-Request-ID",
+		"Idempotency-Key",
 		// Custom headers sent from SDK
 		"browser",
 		"lang",
@@ -162,9 +172,12 @@ func NewGinServer(ctx context.Context, tel *telemetry.Client, logger *zap.Logger
 			},
 			"/health",
 			"/sandboxes/:sandboxID/refreshes",
+			"/sandboxes/:sandboxID/logs/stream",
 			"/templates/:templateID/builds/:buildID/logs",
 			"/templates/:templateID/builds/:buildID/status",
 		),
+		// Rate limiting must be executed after authorization as the limits are per team.
+		ratelimit.Middleware(apiStore.RateLimiter, tel.MeterProvider),
 	)
 
 	// We now register our store above as the handler for the interface
@@ -247,6 +260,15 @@ func run() int {
 		},
 	)
 	defer sbxLoggerExternal.Sync()
+
+	// Without Loki the sandbox logs are streamed from memory, they are kept as the sandbox logger writes them
+	var memorySandboxLogs *sandboxlogs.MemoryProvider
+	if os.Getenv("LOKI_ADDRESS") == "" {
+		memorySandboxLogs = sandboxlogs.NewMemoryProvider()
+		sbxLoggerExternal = sbxLoggerExternal.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(core, memorySandboxLogs.Core())
+		}))
+	}
 	sbxlogger.SetSandboxLoggerExternal(sbxLoggerExternal)
 
 	sbxLoggerInternal := sbxlogger.NewLogger(
@@ -334,7 +356,7 @@ func run() int {
 	// Create an instance of our handler which satisfies the generated interface
 	//  (use the outer context rather than the signal handling
 	//   context so it doesn't exit first.)
-	apiStore := handlers.NewAPIStore(ctx, tel)
+	apiStore := handlers.NewAPIStore(ctx, tel, memorySandboxLogs)
 	cleanupFns = append(cleanupFns, apiStore.Close)
 
 	// pass the signal context so that handlers know when shutdown is happening.
@@ -15,6 +15,7 @@ import (
 
 	orchestratorspool "github.com/e2b-dev/infra/packages/proxy/internal/edge/pool"
 	"github.com/e2b-dev/infra/packages/proxy/internal/edge/sandboxes"
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
 	l "github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	reverseproxy "github.com/e2b-dev/infra/packages/shared/pkg/proxy"
 	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/pool"
@@ -82,25 +83,26 @@ func dnsResolution(sandboxId string, logger *zap.Logger) (string, error) {
 	return node, nil
 }
 
-func catalogResolution(sandboxId string, catalog sandboxes.SandboxesCatalog, orchestrators *orchestratorspool.OrchestratorsPool) (string, error) {
+// catalogResolution returns the IP of the node running the sandbox and the ID of the sandbox execution.
+func catalogResolution(sandboxId string, catalog sandboxes.SandboxesCatalog, orchestrators *orchestratorspool.OrchestratorsPool) (string, string, error) {
 	s, err := catalog.GetSandbox(sandboxId)
 	if err != nil {
 		if errors.Is(err, sandboxes.ErrSandboxNotFound) {
-			return "", ErrNodeNotFound
+			return "", "", ErrNodeNotFound
 		}
 
-		return "", fmt.Errorf("failed to get sandbox from catalog: %w", err)
+		return "", "", fmt.Errorf("failed to get sandbox from catalog: %w", err)
 	}
 
 	o, ok := orchestrators.GetOrchestrator(s.OrchestratorId)
 	if !ok {
-		return "", errors.New("orchestrator not found")
+		return "", "", errors.New("orchestrator not found")
 	}
 
-	return o.Ip, nil
+	return o.Ip, s.ExecutionId, nil
 }
 
-func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port uint, catalog sandboxes.SandboxesCatalog, orchestrators *orchestratorspool.OrchestratorsPool, useCatalogResolution bool, useDnsResolution bool) (*reverseproxy.Proxy, error) {
+func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port uint, catalog sandboxes.SandboxesCatalog, orchestrators *orchestratorspool.OrchestratorsPool, useCatalogResolution bool, useDnsResolution bool, portAccessTokenSigner *keys.PortAccessTokenSigner) (*reverseproxy.Proxy, error) {
 	if !useCatalogResolution && !useDnsResolution {
 		return nil, errors.New("catalog resolution and DNS resolution are both disabled, at least one must be enabled")
 	}
@@ -122,9 +124,10 @@ func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port
 			)
 
 			var nodeIP string
+			var executionId string
 
 			if useCatalogResolution {
-				nodeIP, err = catalogResolution(sandboxId, catalog, orchestrators)
+				nodeIP, executionId, err = catalogResolution(sandboxId, catalog, orchestrators)
 				if err != nil {
 					if !errors.Is(err, ErrNodeNotFound) {
 						logger.Warn("failed to resolve node ip with Redis resolution", zap.Error(err))
@@ -154,6 +157,16 @@ func NewClientProxy(meterProvider metric.MeterProvider, serviceName string, port
 				}
 			}
 
+			// The public ports of the sandbox are only known to the orchestrator,
+			// here we reject the requests with invalid tokens early and the orchestrator proxy checks the rest.
+			// The tokens are bound to the sandbox execution, which isn't known when the node is resolved by DNS.
+			if executionId != "" {
+				err = reverseproxy.CheckPortAccess(r, portAccessTokenSigner, sandboxId, executionId, port, true)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			logger.Debug("Proxying request", zap.String("node_ip", nodeIP))
 
 			return &pool.Destination{
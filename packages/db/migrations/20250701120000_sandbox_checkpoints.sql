@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."checkpoints" (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sandbox_id      TEXT NOT NULL,
+    team_id         UUID NOT NULL REFERENCES "public"."teams"(id) ON DELETE CASCADE,
+    name            TEXT NOT NULL,
+    env_build_id    UUID NOT NULL,
+    parent_build_id UUID NULL,
+    CONSTRAINT "checkpoints_env_builds_checkpoints" FOREIGN KEY ("env_build_id") REFERENCES "public"."env_builds" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+ALTER TABLE "public"."checkpoints" ENABLE ROW LEVEL SECURITY;
+
+CREATE UNIQUE INDEX IF NOT EXISTS checkpoint_team_id_sandbox_id_name
+    ON "public"."checkpoints" (team_id, sandbox_id, name);
+
+ALTER TABLE "public"."tiers"
+    ADD COLUMN IF NOT EXISTS max_checkpoints BIGINT NOT NULL DEFAULT 10;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers" DROP COLUMN IF EXISTS max_checkpoints;
+
+DROP TABLE IF EXISTS "public"."checkpoints";
+-- +goose StatementEnd
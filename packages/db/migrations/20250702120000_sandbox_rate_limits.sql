@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    ADD COLUMN IF NOT EXISTS network_bandwidth_mibps BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS network_ops BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS disk_bandwidth_mibps BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS disk_iops BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "public"."env_builds"
+    ADD COLUMN IF NOT EXISTS network_bandwidth_mibps BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS network_ops BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS disk_bandwidth_mibps BIGINT NULL,
+    ADD COLUMN IF NOT EXISTS disk_iops BIGINT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."env_builds"
+    DROP COLUMN IF EXISTS network_bandwidth_mibps,
+    DROP COLUMN IF EXISTS network_ops,
+    DROP COLUMN IF EXISTS disk_bandwidth_mibps,
+    DROP COLUMN IF EXISTS disk_iops;
+
+ALTER TABLE "public"."tiers"
+    DROP COLUMN IF EXISTS network_bandwidth_mibps,
+    DROP COLUMN IF EXISTS network_ops,
+    DROP COLUMN IF EXISTS disk_bandwidth_mibps,
+    DROP COLUMN IF EXISTS disk_iops;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    ADD COLUMN IF NOT EXISTS network_policy jsonb NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."snapshots"
+    DROP COLUMN IF EXISTS network_policy;
+-- +goose StatementEnd
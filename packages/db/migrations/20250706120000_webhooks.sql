@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."webhooks" (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    team_id    UUID NOT NULL,
+    url        TEXT NOT NULL,
+    secret     TEXT NOT NULL,
+    events     JSONB NOT NULL,
+    enabled    BOOLEAN NOT NULL DEFAULT true,
+    CONSTRAINT "webhooks_teams_webhooks" FOREIGN KEY ("team_id") REFERENCES "public"."teams" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+ALTER TABLE "public"."webhooks" ENABLE ROW LEVEL SECURITY;
+
+CREATE INDEX IF NOT EXISTS webhook_team_id
+    ON "public"."webhooks" (team_id);
+
+CREATE TABLE IF NOT EXISTS "public"."webhook_deliveries" (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    webhook_id  UUID NOT NULL,
+    event_id    UUID NOT NULL,
+    event_type  TEXT NOT NULL,
+    sandbox_id  TEXT NOT NULL,
+    attempt     INTEGER NOT NULL,
+    success     BOOLEAN NOT NULL,
+    status_code INTEGER NULL,
+    error       TEXT NULL,
+    CONSTRAINT "webhook_deliveries_webhooks_deliveries" FOREIGN KEY ("webhook_id") REFERENCES "public"."webhooks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+ALTER TABLE "public"."webhook_deliveries" ENABLE ROW LEVEL SECURITY;
+
+CREATE INDEX IF NOT EXISTS webhookdelivery_webhook_id_created_at
+    ON "public"."webhook_deliveries" (webhook_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."webhook_deliveries";
+DROP TABLE IF EXISTS "public"."webhooks";
+-- +goose StatementEnd
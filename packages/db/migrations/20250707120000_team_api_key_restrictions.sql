@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."team_api_keys"
+    ADD COLUMN IF NOT EXISTS expires_at        TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS scopes            JSONB NULL,
+    ADD COLUMN IF NOT EXISTS allowed_templates JSONB NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."team_api_keys"
+    DROP COLUMN IF EXISTS allowed_templates,
+    DROP COLUMN IF EXISTS scopes,
+    DROP COLUMN IF EXISTS expires_at;
+-- +goose StatementEnd
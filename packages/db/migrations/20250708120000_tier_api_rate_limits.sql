@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    ADD COLUMN IF NOT EXISTS api_requests_per_second BIGINT NOT NULL DEFAULT 20,
+    ADD COLUMN IF NOT EXISTS api_requests_burst BIGINT NOT NULL DEFAULT 100;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    DROP COLUMN IF EXISTS api_requests_per_second,
+    DROP COLUMN IF EXISTS api_requests_burst;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."audit_logs" (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    team_id       UUID NULL,
+    action        TEXT NOT NULL,
+    actor_type    TEXT NOT NULL,
+    actor_id      TEXT NULL,
+    resource_type TEXT NOT NULL,
+    resource_id   TEXT NOT NULL,
+    ip            TEXT NULL,
+    request_id    TEXT NULL,
+    metadata      JSONB NULL
+);
+ALTER TABLE "public"."audit_logs" ENABLE ROW LEVEL SECURITY;
+
+CREATE INDEX IF NOT EXISTS auditlog_team_id_created_at
+    ON "public"."audit_logs" (team_id, created_at);
+
+-- The audit log is append-only
+CREATE OR REPLACE FUNCTION "public"."audit_logs_append_only"() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_logs_append_only
+    BEFORE UPDATE OR DELETE ON "public"."audit_logs"
+    FOR EACH ROW EXECUTE FUNCTION "public"."audit_logs_append_only"();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."audit_logs";
+DROP FUNCTION IF EXISTS "public"."audit_logs_append_only"();
+-- +goose StatementEnd
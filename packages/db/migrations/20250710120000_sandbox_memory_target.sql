@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."env_builds"
+    ADD COLUMN IF NOT EXISTS memory_target_mb BIGINT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."env_builds"
+    DROP COLUMN IF EXISTS memory_target_mb;
+-- +goose StatementEnd
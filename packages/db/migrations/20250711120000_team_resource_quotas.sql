@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    ADD COLUMN IF NOT EXISTS concurrent_vcpus BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS concurrent_ram_mb BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS paused_storage_mb BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    DROP COLUMN IF EXISTS concurrent_vcpus,
+    DROP COLUMN IF EXISTS concurrent_ram_mb,
+    DROP COLUMN IF EXISTS paused_storage_mb;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    ADD COLUMN IF NOT EXISTS paused_retention_hours BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "public"."snapshots"
+    ADD COLUMN IF NOT EXISTS paused_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS retention_hours BIGINT NULL;
+
+-- The existing snapshots are kept for the retention from their creation
+UPDATE "public"."snapshots"
+    SET paused_at = created_at
+    WHERE paused_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS snapshot_paused_at
+    ON "public"."snapshots" (paused_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "public"."snapshot_paused_at";
+
+ALTER TABLE "public"."snapshots"
+    DROP COLUMN IF EXISTS paused_at,
+    DROP COLUMN IF EXISTS retention_hours;
+
+ALTER TABLE "public"."tiers"
+    DROP COLUMN IF EXISTS paused_retention_hours;
+-- +goose StatementEnd
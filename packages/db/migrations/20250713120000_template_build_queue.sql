@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."tiers"
+    ADD COLUMN IF NOT EXISTS concurrent_template_builds BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS build_priority BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "public"."env_builds"
+    ADD COLUMN IF NOT EXISTS priority BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS queued_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_env_builds_queue
+    ON "public"."env_builds" (priority DESC, queued_at)
+    WHERE status = 'queued';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "public"."idx_env_builds_queue";
+
+ALTER TABLE "public"."env_builds"
+    DROP COLUMN IF EXISTS priority,
+    DROP COLUMN IF EXISTS queued_at;
+
+ALTER TABLE "public"."tiers"
+    DROP COLUMN IF EXISTS concurrent_template_builds,
+    DROP COLUMN IF EXISTS build_priority;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."env_builds"
+    ADD COLUMN IF NOT EXISTS base_env_id TEXT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "public"."env_builds"
+    DROP COLUMN IF EXISTS base_env_id;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "public"."env_build_tags" (
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    env_id     TEXT NOT NULL,
+    tag        TEXT NOT NULL,
+    build_id   UUID NOT NULL,
+    CONSTRAINT "env_build_tags_envs_build_tags" FOREIGN KEY ("env_id") REFERENCES "public"."envs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    -- The tagged build can't be deleted until the tag is moved or removed
+    CONSTRAINT "env_build_tags_env_builds_tags" FOREIGN KEY ("build_id") REFERENCES "public"."env_builds" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+ALTER TABLE "public"."env_build_tags" ENABLE ROW LEVEL SECURITY;
+
+CREATE UNIQUE INDEX IF NOT EXISTS env_build_tag_env_id_tag
+    ON "public"."env_build_tags" (env_id, tag);
+
+CREATE INDEX IF NOT EXISTS env_build_tag_build_id
+    ON "public"."env_build_tags" (build_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."env_build_tags";
+-- +goose StatementEnd
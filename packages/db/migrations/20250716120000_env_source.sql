@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."envs"
+    ADD COLUMN IF NOT EXISTS source_env_id TEXT NULL,
+    ADD COLUMN IF NOT EXISTS source_sandbox_id TEXT NULL;
+
+COMMENT ON COLUMN "public"."envs"."source_env_id" IS 'Env the snapshot of the sandbox was taken from, its builds are the base of the env builds';
+COMMENT ON COLUMN "public"."envs"."source_sandbox_id" IS 'Sandbox the env holds the state of for the envs derived from it, the env is removed once it is not used';
+
+CREATE INDEX IF NOT EXISTS envs_source_env_id
+    ON "public"."envs" (source_env_id);
+
+CREATE INDEX IF NOT EXISTS envs_source_sandbox_id
+    ON "public"."envs" (source_sandbox_id)
+    WHERE source_sandbox_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "public"."envs_source_sandbox_id";
+DROP INDEX IF EXISTS "public"."envs_source_env_id";
+
+ALTER TABLE "public"."envs"
+    DROP COLUMN IF EXISTS source_env_id,
+    DROP COLUMN IF EXISTS source_sandbox_id;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "public"."env_builds"
+    ADD COLUMN IF NOT EXISTS checkpoint BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS parent_build_id UUID NULL;
+
+CREATE INDEX IF NOT EXISTS env_builds_parent_build_id
+    ON "public"."env_builds" (parent_build_id)
+    WHERE parent_build_id IS NOT NULL;
+
+UPDATE "public"."env_builds" eb
+SET checkpoint = true
+WHERE EXISTS (
+    SELECT 1 FROM "public"."checkpoints" c WHERE c.env_build_id = eb.id
+);
+
+-- The lineage of the existing snapshot builds isn't known, every build is assumed to be based on the previous one
+UPDATE "public"."env_builds" eb
+SET parent_build_id = lineage.prev_id
+FROM (
+    SELECT b.id, LAG(b.id) OVER (PARTITION BY b.env_id ORDER BY b.created_at) AS prev_id
+    FROM "public"."env_builds" b
+    WHERE EXISTS (SELECT 1 FROM "public"."snapshots" s WHERE s.env_id = b.env_id)
+) lineage
+WHERE eb.id = lineage.id AND lineage.prev_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "public"."env_builds_parent_build_id";
+
+ALTER TABLE "public"."env_builds"
+    DROP COLUMN IF EXISTS checkpoint,
+    DROP COLUMN IF EXISTS parent_build_id;
+-- +goose StatementEnd
@@ -0,0 +1,4 @@
+-- name: CancelNotStartedTemplateBuild :execrows
+UPDATE "public"."env_builds"
+SET status = 'cancelled', finished_at = now(), updated_at = now()
+WHERE id = @build_id AND status IN ('waiting', 'queued');
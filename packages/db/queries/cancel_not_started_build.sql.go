@@ -0,0 +1,26 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: cancel_not_started_build.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const cancelNotStartedTemplateBuild = `-- name: CancelNotStartedTemplateBuild :execrows
+UPDATE "public"."env_builds"
+SET status = 'cancelled', finished_at = now(), updated_at = now()
+WHERE id = $1 AND status IN ('waiting', 'queued')
+`
+
+func (q *Queries) CancelNotStartedTemplateBuild(ctx context.Context, buildID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelNotStartedTemplateBuild, buildID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
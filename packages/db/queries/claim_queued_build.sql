@@ -0,0 +1,4 @@
+-- name: ClaimQueuedTemplateBuild :execrows
+UPDATE "public"."env_builds"
+SET status = 'building', updated_at = now()
+WHERE id = @build_id AND status = 'queued';
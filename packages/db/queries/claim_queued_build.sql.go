@@ -0,0 +1,26 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: claim_queued_build.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const claimQueuedTemplateBuild = `-- name: ClaimQueuedTemplateBuild :execrows
+UPDATE "public"."env_builds"
+SET status = 'building', updated_at = now()
+WHERE id = $1 AND status = 'queued'
+`
+
+func (q *Queries) ClaimQueuedTemplateBuild(ctx context.Context, buildID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, claimQueuedTemplateBuild, buildID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
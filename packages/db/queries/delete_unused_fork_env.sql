@@ -0,0 +1,9 @@
+-- name: DeleteUnusedForkEnv :many
+DELETE FROM "public"."envs" e
+WHERE
+    e.id = @env_id
+    AND e.source_sandbox_id IS NOT NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM "public"."envs" d WHERE d.source_env_id = e.id
+    )
+RETURNING e.source_env_id;
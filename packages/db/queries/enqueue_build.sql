@@ -0,0 +1,4 @@
+-- name: EnqueueTemplateBuild :execrows
+UPDATE "public"."env_builds"
+SET status = 'queued', priority = @priority, queued_at = now(), updated_at = now()
+WHERE id = @build_id AND status = 'waiting';
@@ -0,0 +1,31 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: enqueue_build.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const enqueueTemplateBuild = `-- name: EnqueueTemplateBuild :execrows
+UPDATE "public"."env_builds"
+SET status = 'queued', priority = $1, queued_at = now(), updated_at = now()
+WHERE id = $2 AND status = 'waiting'
+`
+
+type EnqueueTemplateBuildParams struct {
+	Priority int64
+	BuildID  uuid.UUID
+}
+
+func (q *Queries) EnqueueTemplateBuild(ctx context.Context, arg EnqueueTemplateBuildParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueTemplateBuild, arg.Priority, arg.BuildID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
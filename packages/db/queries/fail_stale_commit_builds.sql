@@ -0,0 +1,7 @@
+-- name: FailStaleCommitBuilds :many
+-- The builds of the templates created from sandboxes are finished by the API replica which created them,
+-- they are left snapshotting when the replica stops during the commit.
+UPDATE "public"."env_builds"
+SET status = 'failed', finished_at = now(), updated_at = now()
+WHERE status = 'snapshotting' AND base_env_id IS NOT NULL AND created_at < @created_before
+RETURNING id, env_id;
@@ -0,0 +1,47 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: fail_stale_commit_builds.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const failStaleCommitBuilds = `-- name: FailStaleCommitBuilds :many
+UPDATE "public"."env_builds"
+SET status = 'failed', finished_at = now(), updated_at = now()
+WHERE status = 'snapshotting' AND base_env_id IS NOT NULL AND created_at < $1
+RETURNING id, env_id
+`
+
+type FailStaleCommitBuildsRow struct {
+	ID    uuid.UUID
+	EnvID *string
+}
+
+// The builds of the templates created from sandboxes are finished by the API replica which created them,
+// they are left snapshotting when the replica stops during the commit.
+func (q *Queries) FailStaleCommitBuilds(ctx context.Context, createdBefore time.Time) ([]FailStaleCommitBuildsRow, error) {
+	rows, err := q.db.Query(ctx, failStaleCommitBuilds, createdBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FailStaleCommitBuildsRow
+	for rows.Next() {
+		var i FailStaleCommitBuildsRow
+		if err := rows.Scan(&i.ID, &i.EnvID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,6 @@
+-- name: GetTemplateBuildQueuePosition :one
+SELECT (COUNT(q.id) + 1)::bigint AS position
+FROM "public"."env_builds" b
+JOIN "public"."env_builds" q ON q.status = 'queued'
+    AND (q.priority > b.priority OR (q.priority = b.priority AND q.queued_at < b.queued_at))
+WHERE b.id = @build_id;
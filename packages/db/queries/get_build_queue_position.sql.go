@@ -0,0 +1,27 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: get_build_queue_position.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const getTemplateBuildQueuePosition = `-- name: GetTemplateBuildQueuePosition :one
+SELECT (COUNT(q.id) + 1)::bigint AS position
+FROM "public"."env_builds" b
+JOIN "public"."env_builds" q ON q.status = 'queued'
+    AND (q.priority > b.priority OR (q.priority = b.priority AND q.queued_at < b.queued_at))
+WHERE b.id = $1
+`
+
+func (q *Queries) GetTemplateBuildQueuePosition(ctx context.Context, buildID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, getTemplateBuildQueuePosition, buildID)
+	var position int64
+	err := row.Scan(&position)
+	return position, err
+}
@@ -0,0 +1,11 @@
+-- name: GetCheckpoint :one
+SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, sqlc.embed(c), sqlc.embed(s), sqlc.embed(eb)
+FROM "public"."checkpoints" c
+JOIN "public"."env_builds" eb ON eb.id = c.env_build_id
+JOIN "public"."snapshots" s ON s.env_id = eb.env_id
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(alias ORDER BY alias) AS aliases
+    FROM "public"."env_aliases"
+    WHERE env_id = s.base_env_id
+) ea ON TRUE
+WHERE c.id = @checkpoint_id AND c.sandbox_id = @sandbox_id AND c.team_id = @team_id AND eb.status = 'success';
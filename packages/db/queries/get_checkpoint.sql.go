@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: get_checkpoint.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const getCheckpoint = `-- name: GetCheckpoint :one
+SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, c.id, c.created_at, c.sandbox_id, c.team_id, c.name, c.env_build_id, c.parent_build_id, s.created_at, s.env_id, s.sandbox_id, s.id, s.metadata, s.base_env_id, s.sandbox_started_at, s.env_secure, s.network_policy, s.port_access, s.paused_at, s.retention_hours, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.network_bandwidth_mibps, eb.network_ops, eb.disk_bandwidth_mibps, eb.disk_iops, eb.memory_target_mb, eb.priority, eb.queued_at, eb.base_env_id, eb.checkpoint, eb.parent_build_id
+FROM "public"."checkpoints" c
+JOIN "public"."env_builds" eb ON eb.id = c.env_build_id
+JOIN "public"."snapshots" s ON s.env_id = eb.env_id
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(alias ORDER BY alias) AS aliases
+    FROM "public"."env_aliases"
+    WHERE env_id = s.base_env_id
+) ea ON TRUE
+WHERE c.id = $1 AND c.sandbox_id = $2 AND c.team_id = $3 AND eb.status = 'success'
+`
+
+type GetCheckpointParams struct {
+	CheckpointID uuid.UUID
+	SandboxID    string
+	TeamID       uuid.UUID
+}
+
+type GetCheckpointRow struct {
+	Aliases    []string
+	Checkpoint Checkpoint
+	Snapshot   Snapshot
+	EnvBuild   EnvBuild
+}
+
+func (q *Queries) GetCheckpoint(ctx context.Context, arg GetCheckpointParams) (GetCheckpointRow, error) {
+	row := q.db.QueryRow(ctx, getCheckpoint, arg.CheckpointID, arg.SandboxID, arg.TeamID)
+	var i GetCheckpointRow
+	err := row.Scan(
+		&i.Aliases,
+		&i.Checkpoint.ID,
+		&i.Checkpoint.CreatedAt,
+		&i.Checkpoint.SandboxID,
+		&i.Checkpoint.TeamID,
+		&i.Checkpoint.Name,
+		&i.Checkpoint.EnvBuildID,
+		&i.Checkpoint.ParentBuildID,
+		&i.Snapshot.CreatedAt,
+		&i.Snapshot.EnvID,
+		&i.Snapshot.SandboxID,
+		&i.Snapshot.ID,
+		&i.Snapshot.Metadata,
+		&i.Snapshot.BaseEnvID,
+		&i.Snapshot.SandboxStartedAt,
+		&i.Snapshot.EnvSecure,
+		&i.Snapshot.NetworkPolicy,
+		&i.Snapshot.PortAccess,
+		&i.Snapshot.PausedAt,
+		&i.Snapshot.RetentionHours,
+		&i.EnvBuild.ID,
+		&i.EnvBuild.CreatedAt,
+		&i.EnvBuild.UpdatedAt,
+		&i.EnvBuild.FinishedAt,
+		&i.EnvBuild.Status,
+		&i.EnvBuild.Dockerfile,
+		&i.EnvBuild.StartCmd,
+		&i.EnvBuild.Vcpu,
+		&i.EnvBuild.RamMb,
+		&i.EnvBuild.FreeDiskSizeMb,
+		&i.EnvBuild.TotalDiskSizeMb,
+		&i.EnvBuild.KernelVersion,
+		&i.EnvBuild.FirecrackerVersion,
+		&i.EnvBuild.EnvID,
+		&i.EnvBuild.EnvdVersion,
+		&i.EnvBuild.ReadyCmd,
+		&i.EnvBuild.ClusterNodeID,
+		&i.EnvBuild.NetworkBandwidthMibps,
+		&i.EnvBuild.NetworkOps,
+		&i.EnvBuild.DiskBandwidthMibps,
+		&i.EnvBuild.DiskIops,
+		&i.EnvBuild.MemoryTargetMb,
+		&i.EnvBuild.Priority,
+		&i.EnvBuild.QueuedAt,
+		&i.EnvBuild.BaseEnvID,
+		&i.EnvBuild.Checkpoint,
+		&i.EnvBuild.ParentBuildID,
+	)
+	return i, err
+}
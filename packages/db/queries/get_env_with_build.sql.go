@@ -18,7 +18,7 @@ WITH s AS NOT MATERIALIZED (
     SELECT $1 as env_id
 )
 
-SELECT e.id, e.created_at, e.updated_at, e.public, e.build_count, e.spawn_count, e.last_spawned_at, e.team_id, e.created_by, e.cluster_id, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, aliases
+SELECT e.id, e.created_at, e.updated_at, e.public, e.build_count, e.spawn_count, e.last_spawned_at, e.team_id, e.created_by, e.cluster_id, e.source_env_id, e.source_sandbox_id, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.network_bandwidth_mibps, eb.network_ops, eb.disk_bandwidth_mibps, eb.disk_iops, eb.memory_target_mb, eb.priority, eb.queued_at, eb.base_env_id, eb.checkpoint, eb.parent_build_id, aliases
 FROM s
 JOIN public.envs AS e ON e.id = s.env_id
 JOIN public.env_builds AS eb ON eb.env_id = e.id
@@ -53,6 +53,8 @@ func (q *Queries) GetEnvWithBuild(ctx context.Context, aliasOrEnvID string) (Get
 		&i.Env.TeamID,
 		&i.Env.CreatedBy,
 		&i.Env.ClusterID,
+		&i.Env.SourceEnvID,
+		&i.Env.SourceSandboxID,
 		&i.EnvBuild.ID,
 		&i.EnvBuild.CreatedAt,
 		&i.EnvBuild.UpdatedAt,
@@ -70,6 +72,16 @@ func (q *Queries) GetEnvWithBuild(ctx context.Context, aliasOrEnvID string) (Get
 		&i.EnvBuild.EnvdVersion,
 		&i.EnvBuild.ReadyCmd,
 		&i.EnvBuild.ClusterNodeID,
+		&i.EnvBuild.NetworkBandwidthMibps,
+		&i.EnvBuild.NetworkOps,
+		&i.EnvBuild.DiskBandwidthMibps,
+		&i.EnvBuild.DiskIops,
+		&i.EnvBuild.MemoryTargetMb,
+		&i.EnvBuild.Priority,
+		&i.EnvBuild.QueuedAt,
+		&i.EnvBuild.BaseEnvID,
+		&i.EnvBuild.Checkpoint,
+		&i.EnvBuild.ParentBuildID,
 		&i.Aliases,
 	)
 	return i, err
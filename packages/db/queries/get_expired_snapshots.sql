@@ -0,0 +1,12 @@
+-- name: GetExpiredSnapshots :many
+SELECT s.sandbox_id, e.team_id, t.cluster_id
+FROM "public"."snapshots" s
+JOIN "public"."envs" e ON e.id = s.env_id
+JOIN "public"."teams" t ON t.id = e.team_id
+JOIN "public"."tiers" ti ON ti.id = t.tier
+WHERE
+    s.paused_at IS NOT NULL
+    AND COALESCE(s.retention_hours, ti.paused_retention_hours) > 0
+    AND s.paused_at + make_interval(hours => COALESCE(s.retention_hours, ti.paused_retention_hours)::int) < now()
+ORDER BY s.paused_at
+LIMIT $1;
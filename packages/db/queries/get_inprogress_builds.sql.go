@@ -10,7 +10,7 @@ import (
 )
 
 const getInProgressTemplateBuilds = `-- name: GetInProgressTemplateBuilds :many
-SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, e.id, e.created_at, e.updated_at, e.public, e.build_count, e.spawn_count, e.last_spawned_at, e.team_id, e.created_by, e.cluster_id, b.id, b.created_at, b.updated_at, b.finished_at, b.status, b.dockerfile, b.start_cmd, b.vcpu, b.ram_mb, b.free_disk_size_mb, b.total_disk_size_mb, b.kernel_version, b.firecracker_version, b.env_id, b.envd_version, b.ready_cmd, b.cluster_node_id
+SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, e.id, e.created_at, e.updated_at, e.public, e.build_count, e.spawn_count, e.last_spawned_at, e.team_id, e.created_by, e.cluster_id, e.source_env_id, e.source_sandbox_id, b.id, b.created_at, b.updated_at, b.finished_at, b.status, b.dockerfile, b.start_cmd, b.vcpu, b.ram_mb, b.free_disk_size_mb, b.total_disk_size_mb, b.kernel_version, b.firecracker_version, b.env_id, b.envd_version, b.ready_cmd, b.cluster_node_id, b.network_bandwidth_mibps, b.network_ops, b.disk_bandwidth_mibps, b.disk_iops, b.memory_target_mb, b.priority, b.queued_at, b.base_env_id, b.checkpoint, b.parent_build_id
 FROM public.env_builds b
 JOIN public.envs e ON e.id = b.env_id
 JOIN public.teams t ON e.team_id = t.id
@@ -53,6 +53,8 @@ func (q *Queries) GetInProgressTemplateBuilds(ctx context.Context) ([]GetInProgr
 			&i.Env.TeamID,
 			&i.Env.CreatedBy,
 			&i.Env.ClusterID,
+			&i.Env.SourceEnvID,
+			&i.Env.SourceSandboxID,
 			&i.EnvBuild.ID,
 			&i.EnvBuild.CreatedAt,
 			&i.EnvBuild.UpdatedAt,
@@ -70,6 +72,16 @@ func (q *Queries) GetInProgressTemplateBuilds(ctx context.Context) ([]GetInProgr
 			&i.EnvBuild.EnvdVersion,
 			&i.EnvBuild.ReadyCmd,
 			&i.EnvBuild.ClusterNodeID,
+			&i.EnvBuild.NetworkBandwidthMibps,
+			&i.EnvBuild.NetworkOps,
+			&i.EnvBuild.DiskBandwidthMibps,
+			&i.EnvBuild.DiskIops,
+			&i.EnvBuild.MemoryTargetMb,
+			&i.EnvBuild.Priority,
+			&i.EnvBuild.QueuedAt,
+			&i.EnvBuild.BaseEnvID,
+			&i.EnvBuild.Checkpoint,
+			&i.EnvBuild.ParentBuildID,
 		); err != nil {
 			return nil, err
 		}
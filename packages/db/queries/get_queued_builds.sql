@@ -0,0 +1,17 @@
+-- name: GetQueuedTemplateBuilds :many
+-- The builds of each team are capped to the team concurrent builds limit before the batch limit is applied,
+-- so a single team with many queued builds can't push the other teams out of the batch.
+SELECT sqlc.embed(b), q.team_id, q.cluster_id, q.concurrent_template_builds
+FROM (
+    SELECT qb.id, e.team_id, t.cluster_id, ti.concurrent_template_builds,
+        row_number() OVER (PARTITION BY e.team_id ORDER BY qb.priority DESC, qb.queued_at) AS team_position
+    FROM "public"."env_builds" qb
+    JOIN "public"."envs" e ON e.id = qb.env_id
+    JOIN "public"."teams" t ON t.id = e.team_id
+    JOIN "public"."tiers" ti ON ti.id = t.tier
+    WHERE qb.status = 'queued'
+) q
+JOIN "public"."env_builds" b ON b.id = q.id
+WHERE q.concurrent_template_builds <= 0 OR q.team_position <= q.concurrent_template_builds
+ORDER BY b.priority DESC, b.queued_at
+LIMIT $1;
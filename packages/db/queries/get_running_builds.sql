@@ -0,0 +1,5 @@
+-- name: GetRunningTemplateBuilds :many
+SELECT b.id, e.team_id, b.cluster_node_id
+FROM "public"."env_builds" b
+JOIN "public"."envs" e ON e.id = b.env_id
+WHERE b.status = 'building';
@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: get_running_builds.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const getRunningTemplateBuilds = `-- name: GetRunningTemplateBuilds :many
+SELECT b.id, e.team_id, b.cluster_node_id
+FROM "public"."env_builds" b
+JOIN "public"."envs" e ON e.id = b.env_id
+WHERE b.status = 'building'
+`
+
+type GetRunningTemplateBuildsRow struct {
+	ID            uuid.UUID
+	TeamID        uuid.UUID
+	ClusterNodeID *string
+}
+
+func (q *Queries) GetRunningTemplateBuilds(ctx context.Context) ([]GetRunningTemplateBuildsRow, error) {
+	rows, err := q.db.Query(ctx, getRunningTemplateBuilds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRunningTemplateBuildsRow
+	for rows.Next() {
+		var i GetRunningTemplateBuildsRow
+		if err := rows.Scan(&i.ID, &i.TeamID, &i.ClusterNodeID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -13,6 +13,7 @@ JOIN LATERAL (
     WHERE
         eb.env_id = s.env_id
         AND eb.status = 'success'
+        AND NOT eb.checkpoint
     ORDER BY eb.created_at DESC
     LIMIT 1
 ) eb ON TRUE
@@ -14,7 +14,7 @@ import (
 )
 
 const getSnapshotsWithCursor = `-- name: GetSnapshotsWithCursor :many
-SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, s.created_at, s.env_id, s.sandbox_id, s.id, s.metadata, s.base_env_id, s.sandbox_started_at, s.env_secure, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id
+SELECT COALESCE(ea.aliases, ARRAY[]::text[])::text[] AS aliases, s.created_at, s.env_id, s.sandbox_id, s.id, s.metadata, s.base_env_id, s.sandbox_started_at, s.env_secure, s.network_policy, s.port_access, s.paused_at, s.retention_hours, eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.network_bandwidth_mibps, eb.network_ops, eb.disk_bandwidth_mibps, eb.disk_iops, eb.memory_target_mb, eb.priority, eb.queued_at, eb.base_env_id, eb.checkpoint, eb.parent_build_id
 FROM "public"."snapshots" s
 JOIN "public"."envs" e ON e.id = s.env_id
 LEFT JOIN LATERAL (
@@ -23,11 +23,12 @@ LEFT JOIN LATERAL (
     WHERE env_id = s.base_env_id
 ) ea ON TRUE
 JOIN LATERAL (
-    SELECT eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id
+    SELECT eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.network_bandwidth_mibps, eb.network_ops, eb.disk_bandwidth_mibps, eb.disk_iops, eb.memory_target_mb, eb.priority, eb.queued_at, eb.base_env_id, eb.checkpoint, eb.parent_build_id
     FROM "public"."env_builds" eb
     WHERE
         eb.env_id = s.env_id
         AND eb.status = 'success'
+        AND NOT eb.checkpoint
     ORDER BY eb.created_at DESC
     LIMIT 1
 ) eb ON TRUE
@@ -85,6 +86,10 @@ func (q *Queries) GetSnapshotsWithCursor(ctx context.Context, arg GetSnapshotsWi
 			&i.Snapshot.BaseEnvID,
 			&i.Snapshot.SandboxStartedAt,
 			&i.Snapshot.EnvSecure,
+			&i.Snapshot.NetworkPolicy,
+			&i.Snapshot.PortAccess,
+			&i.Snapshot.PausedAt,
+			&i.Snapshot.RetentionHours,
 			&i.EnvBuild.ID,
 			&i.EnvBuild.CreatedAt,
 			&i.EnvBuild.UpdatedAt,
@@ -102,6 +107,16 @@ func (q *Queries) GetSnapshotsWithCursor(ctx context.Context, arg GetSnapshotsWi
 			&i.EnvBuild.EnvdVersion,
 			&i.EnvBuild.ReadyCmd,
 			&i.EnvBuild.ClusterNodeID,
+			&i.EnvBuild.NetworkBandwidthMibps,
+			&i.EnvBuild.NetworkOps,
+			&i.EnvBuild.DiskBandwidthMibps,
+			&i.EnvBuild.DiskIops,
+			&i.EnvBuild.MemoryTargetMb,
+			&i.EnvBuild.Priority,
+			&i.EnvBuild.QueuedAt,
+			&i.EnvBuild.BaseEnvID,
+			&i.EnvBuild.Checkpoint,
+			&i.EnvBuild.ParentBuildID,
 		); err != nil {
 			return nil, err
 		}
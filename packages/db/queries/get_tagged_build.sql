@@ -0,0 +1,5 @@
+-- name: GetTaggedEnvBuild :one
+SELECT sqlc.embed(eb)
+FROM "public"."env_build_tags" t
+JOIN "public"."env_builds" eb ON eb.id = t.build_id
+WHERE t.env_id = @env_id AND t.tag = @tag AND eb.status = 'uploaded';
@@ -0,0 +1,61 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: get_tagged_build.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const getTaggedEnvBuild = `-- name: GetTaggedEnvBuild :one
+SELECT eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.network_bandwidth_mibps, eb.network_ops, eb.disk_bandwidth_mibps, eb.disk_iops, eb.memory_target_mb, eb.priority, eb.queued_at, eb.base_env_id, eb.checkpoint, eb.parent_build_id
+FROM "public"."env_build_tags" t
+JOIN "public"."env_builds" eb ON eb.id = t.build_id
+WHERE t.env_id = $1 AND t.tag = $2 AND eb.status = 'uploaded'
+`
+
+type GetTaggedEnvBuildParams struct {
+	EnvID string
+	Tag   string
+}
+
+type GetTaggedEnvBuildRow struct {
+	EnvBuild EnvBuild
+}
+
+func (q *Queries) GetTaggedEnvBuild(ctx context.Context, arg GetTaggedEnvBuildParams) (GetTaggedEnvBuildRow, error) {
+	row := q.db.QueryRow(ctx, getTaggedEnvBuild, arg.EnvID, arg.Tag)
+	var i GetTaggedEnvBuildRow
+	err := row.Scan(
+		&i.EnvBuild.ID,
+		&i.EnvBuild.CreatedAt,
+		&i.EnvBuild.UpdatedAt,
+		&i.EnvBuild.FinishedAt,
+		&i.EnvBuild.Status,
+		&i.EnvBuild.Dockerfile,
+		&i.EnvBuild.StartCmd,
+		&i.EnvBuild.Vcpu,
+		&i.EnvBuild.RamMb,
+		&i.EnvBuild.FreeDiskSizeMb,
+		&i.EnvBuild.TotalDiskSizeMb,
+		&i.EnvBuild.KernelVersion,
+		&i.EnvBuild.FirecrackerVersion,
+		&i.EnvBuild.EnvID,
+		&i.EnvBuild.EnvdVersion,
+		&i.EnvBuild.ReadyCmd,
+		&i.EnvBuild.ClusterNodeID,
+		&i.EnvBuild.NetworkBandwidthMibps,
+		&i.EnvBuild.NetworkOps,
+		&i.EnvBuild.DiskBandwidthMibps,
+		&i.EnvBuild.DiskIops,
+		&i.EnvBuild.MemoryTargetMb,
+		&i.EnvBuild.Priority,
+		&i.EnvBuild.QueuedAt,
+		&i.EnvBuild.BaseEnvID,
+		&i.EnvBuild.Checkpoint,
+		&i.EnvBuild.ParentBuildID,
+	)
+	return i, err
+}
@@ -0,0 +1,16 @@
+-- name: GetTeamSnapshotStorage :one
+SELECT COALESCE(SUM(eb.ram_mb + COALESCE(eb.total_disk_size_mb, 0)), 0)::bigint AS storage_mb
+FROM "public"."snapshots" s
+JOIN "public"."envs" e ON e.id = s.env_id
+JOIN LATERAL (
+    SELECT eb.ram_mb, eb.total_disk_size_mb
+    FROM "public"."env_builds" eb
+    WHERE
+        eb.env_id = s.env_id
+        AND eb.status = 'success'
+    ORDER BY eb.created_at DESC
+    LIMIT 1
+) eb ON TRUE
+WHERE
+    e.team_id = @team_id
+    AND s.sandbox_id <> @exclude_sandbox_id;
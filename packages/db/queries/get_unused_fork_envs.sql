@@ -0,0 +1,12 @@
+-- name: GetUnusedForkEnvs :many
+SELECT e.id, e.team_id, t.cluster_id
+FROM "public"."envs" e
+JOIN "public"."teams" t ON t.id = e.team_id
+WHERE
+    e.source_sandbox_id IS NOT NULL
+    AND e.created_at < @created_before
+    AND NOT EXISTS (
+        SELECT 1 FROM "public"."envs" d WHERE d.source_env_id = e.id
+    )
+ORDER BY e.created_at
+LIMIT @max_envs;
@@ -0,0 +1,4 @@
+-- name: GetUploadedEnvBuild :one
+SELECT sqlc.embed(eb)
+FROM "public"."env_builds" eb
+WHERE eb.env_id = @env_id AND eb.id = @build_id AND eb.status = 'uploaded';
@@ -0,0 +1,62 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: get_uploaded_build.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const getUploadedEnvBuild = `-- name: GetUploadedEnvBuild :one
+SELECT eb.id, eb.created_at, eb.updated_at, eb.finished_at, eb.status, eb.dockerfile, eb.start_cmd, eb.vcpu, eb.ram_mb, eb.free_disk_size_mb, eb.total_disk_size_mb, eb.kernel_version, eb.firecracker_version, eb.env_id, eb.envd_version, eb.ready_cmd, eb.cluster_node_id, eb.network_bandwidth_mibps, eb.network_ops, eb.disk_bandwidth_mibps, eb.disk_iops, eb.memory_target_mb, eb.priority, eb.queued_at, eb.base_env_id, eb.checkpoint, eb.parent_build_id
+FROM "public"."env_builds" eb
+WHERE eb.env_id = $1 AND eb.id = $2 AND eb.status = 'uploaded'
+`
+
+type GetUploadedEnvBuildParams struct {
+	EnvID   *string
+	BuildID uuid.UUID
+}
+
+type GetUploadedEnvBuildRow struct {
+	EnvBuild EnvBuild
+}
+
+func (q *Queries) GetUploadedEnvBuild(ctx context.Context, arg GetUploadedEnvBuildParams) (GetUploadedEnvBuildRow, error) {
+	row := q.db.QueryRow(ctx, getUploadedEnvBuild, arg.EnvID, arg.BuildID)
+	var i GetUploadedEnvBuildRow
+	err := row.Scan(
+		&i.EnvBuild.ID,
+		&i.EnvBuild.CreatedAt,
+		&i.EnvBuild.UpdatedAt,
+		&i.EnvBuild.FinishedAt,
+		&i.EnvBuild.Status,
+		&i.EnvBuild.Dockerfile,
+		&i.EnvBuild.StartCmd,
+		&i.EnvBuild.Vcpu,
+		&i.EnvBuild.RamMb,
+		&i.EnvBuild.FreeDiskSizeMb,
+		&i.EnvBuild.TotalDiskSizeMb,
+		&i.EnvBuild.KernelVersion,
+		&i.EnvBuild.FirecrackerVersion,
+		&i.EnvBuild.EnvID,
+		&i.EnvBuild.EnvdVersion,
+		&i.EnvBuild.ReadyCmd,
+		&i.EnvBuild.ClusterNodeID,
+		&i.EnvBuild.NetworkBandwidthMibps,
+		&i.EnvBuild.NetworkOps,
+		&i.EnvBuild.DiskBandwidthMibps,
+		&i.EnvBuild.DiskIops,
+		&i.EnvBuild.MemoryTargetMb,
+		&i.EnvBuild.Priority,
+		&i.EnvBuild.QueuedAt,
+		&i.EnvBuild.BaseEnvID,
+		&i.EnvBuild.Checkpoint,
+		&i.EnvBuild.ParentBuildID,
+	)
+	return i, err
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/e2b-dev/infra/packages/db/types"
+	schematypes "github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -27,6 +28,30 @@ type AccessToken struct {
 	AccessTokenMaskSuffix *string
 }
 
+type AuditLog struct {
+	ID           uuid.UUID
+	CreatedAt    time.Time
+	TeamID       *uuid.UUID
+	Action       string
+	ActorType    string
+	ActorID      *string
+	ResourceType string
+	ResourceID   string
+	Ip           *string
+	RequestID    *string
+	Metadata     types.JSONBStringMap
+}
+
+type Checkpoint struct {
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	SandboxID     string
+	TeamID        uuid.UUID
+	Name          string
+	EnvBuildID    uuid.UUID
+	ParentBuildID *uuid.UUID
+}
+
 type Cluster struct {
 	ID          uuid.UUID
 	Endpoint    string
@@ -47,6 +72,10 @@ type Env struct {
 	TeamID        uuid.UUID
 	CreatedBy     *uuid.UUID
 	ClusterID     *uuid.UUID
+	// Env the snapshot of the sandbox was taken from, its builds are the base of the env builds
+	SourceEnvID *string
+	// Sandbox the env holds the state of for the envs derived from it, the env is removed once it is not used
+	SourceSandboxID *string
 }
 
 type EnvAlias struct {
@@ -56,23 +85,42 @@ type EnvAlias struct {
 }
 
 type EnvBuild struct {
-	ID                 uuid.UUID
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
-	FinishedAt         *time.Time
-	Status             string
-	Dockerfile         *string
-	StartCmd           *string
-	Vcpu               int64
-	RamMb              int64
-	FreeDiskSizeMb     int64
-	TotalDiskSizeMb    *int64
-	KernelVersion      string
-	FirecrackerVersion string
-	EnvID              *string
-	EnvdVersion        *string
-	ReadyCmd           *string
-	ClusterNodeID      *string
+	ID                    uuid.UUID
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	FinishedAt            *time.Time
+	Status                string
+	Dockerfile            *string
+	StartCmd              *string
+	Vcpu                  int64
+	RamMb                 int64
+	FreeDiskSizeMb        int64
+	TotalDiskSizeMb       *int64
+	KernelVersion         string
+	FirecrackerVersion    string
+	EnvID                 *string
+	EnvdVersion           *string
+	ReadyCmd              *string
+	ClusterNodeID         *string
+	NetworkBandwidthMibps *int64
+	NetworkOps            *int64
+	DiskBandwidthMibps    *int64
+	DiskIops              *int64
+	MemoryTargetMb        *int64
+	Priority              int64
+	QueuedAt              *time.Time
+	BaseEnvID             *string
+	Checkpoint            bool
+	ParentBuildID         *uuid.UUID
+}
+
+type EnvBuildTag struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	EnvID     string
+	Tag       string
+	BuildID   uuid.UUID
 }
 
 type Snapshot struct {
@@ -84,6 +132,10 @@ type Snapshot struct {
 	BaseEnvID        string
 	SandboxStartedAt pgtype.Timestamptz
 	EnvSecure        bool
+	NetworkPolicy    *schematypes.SandboxNetworkPolicy
+	PortAccess       *schematypes.SandboxPortAccess
+	PausedAt         *time.Time
+	RetentionHours   *int64
 }
 
 type Team struct {
@@ -114,6 +166,9 @@ type TeamApiKey struct {
 	ApiKeyLength     *int32
 	ApiKeyMaskPrefix *string
 	ApiKeyMaskSuffix *string
+	ExpiresAt        *time.Time
+	Scopes           types.JSONBStringMap
+	AllowedTemplates types.JSONBStringMap
 }
 
 type Tier struct {
@@ -121,10 +176,23 @@ type Tier struct {
 	Name   string
 	DiskMb int64
 	// The number of instances the team can run concurrently
-	ConcurrentInstances int64
-	MaxLengthHours      int64
-	MaxVcpu             int64
-	MaxRamMb            int64
+	ConcurrentInstances      int64
+	MaxLengthHours           int64
+	MaxVcpu                  int64
+	MaxRamMb                 int64
+	MaxCheckpoints           int64
+	NetworkBandwidthMibps    int64
+	NetworkOps               int64
+	DiskBandwidthMibps       int64
+	DiskIops                 int64
+	ApiRequestsPerSecond     int64
+	ApiRequestsBurst         int64
+	ConcurrentVcpus          int64
+	ConcurrentRamMb          int64
+	PausedStorageMb          int64
+	PausedRetentionHours     int64
+	ConcurrentTemplateBuilds int64
+	BuildPriority            int64
 }
 
 type UsersTeam struct {
@@ -135,3 +203,36 @@ type UsersTeam struct {
 	AddedBy   *uuid.UUID
 	CreatedAt pgtype.Timestamp
 }
+
+type WarmPool struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	TeamID    uuid.UUID
+	EnvID     string
+	Size      int32
+}
+
+type Webhook struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	TeamID    uuid.UUID
+	Url       string
+	Secret    string
+	Events    []byte
+	Enabled   bool
+}
+
+type WebhookDelivery struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	WebhookID  uuid.UUID
+	EventID    uuid.UUID
+	EventType  string
+	SandboxID  string
+	Attempt    int32
+	Success    bool
+	StatusCode *int32
+	Error      *string
+}
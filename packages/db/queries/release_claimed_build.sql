@@ -0,0 +1,5 @@
+-- name: ReleaseClaimedTemplateBuild :execrows
+-- Returns the claimed build back to the queue keeping its queue position.
+UPDATE "public"."env_builds"
+SET status = 'queued', updated_at = now()
+WHERE id = @build_id AND status = 'building';
@@ -0,0 +1,27 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: release_claimed_build.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const releaseClaimedTemplateBuild = `-- name: ReleaseClaimedTemplateBuild :execrows
+UPDATE "public"."env_builds"
+SET status = 'queued', updated_at = now()
+WHERE id = $1 AND status = 'building'
+`
+
+// Returns the claimed build back to the queue keeping its queue position.
+func (q *Queries) ReleaseClaimedTemplateBuild(ctx context.Context, buildID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, releaseClaimedTemplateBuild, buildID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -12,7 +12,7 @@ import (
 )
 
 const getTeamsWithUsersTeamsWithTier = `-- name: GetTeamsWithUsersTeamsWithTier :many
-SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, ut.id, ut.user_id, ut.team_id, ut.is_default, ut.added_by, ut.created_at, tier.id, tier.name, tier.disk_mb, tier.concurrent_instances, tier.max_length_hours, tier.max_vcpu, tier.max_ram_mb
+SELECT t.id, t.created_at, t.is_blocked, t.name, t.tier, t.email, t.is_banned, t.blocked_reason, t.cluster_id, ut.id, ut.user_id, ut.team_id, ut.is_default, ut.added_by, ut.created_at, tier.id, tier.name, tier.disk_mb, tier.concurrent_instances, tier.max_length_hours, tier.max_vcpu, tier.max_ram_mb, tier.max_checkpoints, tier.network_bandwidth_mibps, tier.network_ops, tier.disk_bandwidth_mibps, tier.disk_iops, tier.api_requests_per_second, tier.api_requests_burst, tier.concurrent_vcpus, tier.concurrent_ram_mb, tier.paused_storage_mb, tier.paused_retention_hours, tier.concurrent_template_builds, tier.build_priority
 FROM "public"."teams" t
 JOIN "public"."tiers" tier ON t.tier = tier.id
 JOIN "public"."users_teams" ut ON ut.team_id = t.id
@@ -57,6 +57,19 @@ func (q *Queries) GetTeamsWithUsersTeamsWithTier(ctx context.Context, userID uui
 			&i.Tier.MaxLengthHours,
 			&i.Tier.MaxVcpu,
 			&i.Tier.MaxRamMb,
+			&i.Tier.MaxCheckpoints,
+			&i.Tier.NetworkBandwidthMibps,
+			&i.Tier.NetworkOps,
+			&i.Tier.DiskBandwidthMibps,
+			&i.Tier.DiskIops,
+			&i.Tier.ApiRequestsPerSecond,
+			&i.Tier.ApiRequestsBurst,
+			&i.Tier.ConcurrentVcpus,
+			&i.Tier.ConcurrentRamMb,
+			&i.Tier.PausedStorageMb,
+			&i.Tier.PausedRetentionHours,
+			&i.Tier.ConcurrentTemplateBuilds,
+			&i.Tier.BuildPriority,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,2 @@
+-- name: TryAdvisoryXactLock :one
+SELECT pg_try_advisory_xact_lock(@lock_id::bigint)::boolean AS locked;
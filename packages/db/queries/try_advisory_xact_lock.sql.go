@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: try_advisory_xact_lock.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const tryAdvisoryXactLock = `-- name: TryAdvisoryXactLock :one
+SELECT pg_try_advisory_xact_lock($1::bigint)::boolean AS locked
+`
+
+func (q *Queries) TryAdvisoryXactLock(ctx context.Context, lockID int64) (bool, error) {
+	row := q.db.QueryRow(ctx, tryAdvisoryXactLock, lockID)
+	var locked bool
+	err := row.Scan(&locked)
+	return locked, err
+}
@@ -0,0 +1,7 @@
+-- name: UpdateSnapshotMetadata :one
+-- The update is merged into the current metadata in a single statement, so the concurrent updates aren't lost.
+UPDATE "public"."snapshots" s
+SET metadata = (COALESCE(s.metadata, '{}'::jsonb) || COALESCE(sqlc.narg(metadata)::jsonb, '{}'::jsonb)) - @removed_keys::text[]
+FROM "public"."envs" e
+WHERE s.env_id = e.id AND s.sandbox_id = @sandbox_id AND e.team_id = @team_id
+RETURNING s.metadata;
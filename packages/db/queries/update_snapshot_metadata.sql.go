@@ -0,0 +1,41 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: update_snapshot_metadata.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/e2b-dev/infra/packages/db/types"
+	"github.com/google/uuid"
+)
+
+const updateSnapshotMetadata = `-- name: UpdateSnapshotMetadata :one
+UPDATE "public"."snapshots" s
+SET metadata = (COALESCE(s.metadata, '{}'::jsonb) || COALESCE($1::jsonb, '{}'::jsonb)) - $2::text[]
+FROM "public"."envs" e
+WHERE s.env_id = e.id AND s.sandbox_id = $3 AND e.team_id = $4
+RETURNING s.metadata
+`
+
+type UpdateSnapshotMetadataParams struct {
+	Metadata    types.JSONBStringMap
+	RemovedKeys []string
+	SandboxID   string
+	TeamID      uuid.UUID
+}
+
+// The update is merged into the current metadata in a single statement, so the concurrent updates aren't lost.
+func (q *Queries) UpdateSnapshotMetadata(ctx context.Context, arg UpdateSnapshotMetadataParams) (types.JSONBStringMap, error) {
+	row := q.db.QueryRow(ctx, updateSnapshotMetadata,
+		arg.Metadata,
+		arg.RemovedKeys,
+		arg.SandboxID,
+		arg.TeamID,
+	)
+	var metadata types.JSONBStringMap
+	err := row.Scan(&metadata)
+	return metadata, err
+}
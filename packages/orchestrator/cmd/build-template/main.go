@@ -74,7 +74,7 @@ func buildTemplate(parentCtx context.Context, kernelVersion, fcVersion, template
 	// to propagate information about sandbox routing.
 	sandboxes := smap.New[*sandbox.Sandbox]()
 
-	sandboxProxy, err := proxy.NewSandboxProxy(noop.MeterProvider{}, proxyPort, sandboxes)
+	sandboxProxy, err := proxy.NewSandboxProxy(noop.MeterProvider{}, proxyPort, sandboxes, nil)
 	if err != nil {
 		logger.Fatal("failed to create sandbox proxy", zap.Error(err))
 	}
@@ -49,6 +49,8 @@ type SandboxObserver struct {
 	cpuUsed     metric.Float64ObservableGauge
 	memoryTotal metric.Int64ObservableGauge
 	memoryUsed  metric.Int64ObservableGauge
+	// Memory held by the balloon device, it's not available to the guest
+	memoryReclaimed metric.Int64ObservableGauge
 }
 
 func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandboxMetricsExportPeriod time.Duration, sandboxes *smap.Map[*sandbox.Sandbox]) (*SandboxObserver, error) {
@@ -92,6 +94,11 @@ func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandbox
 		return nil, fmt.Errorf("failed to create memory used gauge: %w", err)
 	}
 
+	memoryReclaimed, err := telemetry.GetGaugeInt(meter, telemetry.SandboxRamReclaimedGaugeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create memory reclaimed gauge: %w", err)
+	}
+
 	so := &SandboxObserver{
 		exportInterval: sandboxMetricsExportPeriod,
 		meterExporter:  externalMeterExporter,
@@ -101,6 +108,8 @@ func NewSandboxObserver(ctx context.Context, commitSHA, clientID string, sandbox
 		cpuUsed:        cpuUsed,
 		memoryTotal:    memoryTotal,
 		memoryUsed:     memoryUsed,
+
+		memoryReclaimed: memoryReclaimed,
 	}
 
 	registration, err := so.startObserving()
@@ -151,6 +160,7 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 					// Save as bytes for the future, so we can return more accurate values
 					o.ObserveInt64(so.memoryTotal, sbxMetrics.MemTotalMiB<<shiftFromMiBToBytes, attributes)
 					o.ObserveInt64(so.memoryUsed, sbxMetrics.MemUsedMiB<<shiftFromMiBToBytes, attributes)
+					o.ObserveInt64(so.memoryReclaimed, sbxMetrics.BalloonActualMiB<<shiftFromMiBToBytes, attributes)
 
 					// Log warnings if memory or CPU usage exceeds thresholds
 					// Round percentage to 2 decimal places
@@ -179,7 +189,7 @@ func (so *SandboxObserver) startObserving() (metric.Registration, error) {
 			}
 
 			return nil
-		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed)
+		}, so.cpuTotal, so.cpuUsed, so.memoryTotal, so.memoryUsed, so.memoryReclaimed)
 	if err != nil {
 		return nil, err
 	}
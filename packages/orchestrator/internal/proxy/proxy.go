@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"time"
 
 	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
+	"github.com/e2b-dev/infra/packages/shared/pkg/consts"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	reverse_proxy "github.com/e2b-dev/infra/packages/shared/pkg/proxy"
 	"github.com/e2b-dev/infra/packages/shared/pkg/proxy/pool"
@@ -29,7 +33,17 @@ type SandboxProxy struct {
 	proxy *reverse_proxy.Proxy
 }
 
-func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *smap.Map[*sandbox.Sandbox]) (*SandboxProxy, error) {
+// isPublicPort checks if the port is accessible without a port access token.
+// The envd port is always public, envd has its own access token.
+func isPublicPort(access *orchestrator.SandboxPortAccess, port uint64) bool {
+	if access == nil || port == uint64(consts.DefaultEnvdServerPort) {
+		return true
+	}
+
+	return slices.Contains(access.GetPublicPorts(), uint32(port))
+}
+
+func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *smap.Map[*sandbox.Sandbox], portAccessTokenSigner *keys.PortAccessTokenSigner) (*SandboxProxy, error) {
 	proxy := reverse_proxy.New(
 		port,
 		idleTimeout,
@@ -44,6 +58,14 @@ func NewSandboxProxy(meterProvider metric.MeterProvider, port uint, sandboxes *s
 				return nil, reverse_proxy.NewErrSandboxNotFound(sandboxId)
 			}
 
+			err = reverse_proxy.CheckPortAccess(r, portAccessTokenSigner, sandboxId, sbx.Config.ExecutionId, port, isPublicPort(sbx.Config.GetPortAccess(), port))
+			if err != nil {
+				return nil, err
+			}
+
+			// The token is only meant for the proxy, the services in the sandbox shouldn't receive it
+			r.Header.Del(reverse_proxy.PortAccessTokenHeader)
+
 			url := &url.URL{
 				Scheme: "http",
 				Host:   fmt.Sprintf("%s:%d", sbx.Slot.HostIPString(), port),
@@ -0,0 +1,79 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+)
+
+// ErrBalloonNotAttached is returned for the sandboxes from the templates built without the balloon device,
+// the template has to be rebuilt for the memory to be reclaimed.
+var ErrBalloonNotAttached = errors.New("sandbox doesn't have the balloon device")
+
+// SetMemoryTarget inflates the balloon device so the guest is left with the memoryMB of memory.
+// The balloon deflates on its own when the guest is under memory pressure.
+func (s *Sandbox) SetMemoryTarget(ctx context.Context, tracer trace.Tracer, memoryMB int64) error {
+	ramMB := s.GetConfig().RamMb
+	if memoryMB <= 0 || memoryMB > ramMB {
+		return fmt.Errorf("memory target %d MiB is out of range (1-%d MiB)", memoryMB, ramMB)
+	}
+
+	hasBalloon, err := s.process.HasBalloon(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check balloon: %w", err)
+	}
+
+	if !hasBalloon {
+		return ErrBalloonNotAttached
+	}
+
+	amountMiB := ramMB - memoryMB
+
+	err = s.process.SetBalloon(ctx, tracer, amountMiB)
+	if err != nil {
+		return fmt.Errorf("failed to set balloon: %w", err)
+	}
+
+	s.memoryReclaimedMiB.Store(amountMiB)
+
+	return nil
+}
+
+// applyMemoryTarget sizes the memory of the started sandbox down to the memory target from the config.
+// The sandboxes without the balloon device keep the whole memory and the target is cleared from the config.
+func (s *Sandbox) applyMemoryTarget(ctx context.Context, tracer trace.Tracer) error {
+	config := s.GetConfig()
+
+	target := config.GetMemoryTargetMb()
+	if target <= 0 || target >= config.RamMb {
+		return nil
+	}
+
+	err := s.SetMemoryTarget(ctx, tracer, target)
+	if errors.Is(err, ErrBalloonNotAttached) {
+		zap.L().Warn("sandbox doesn't have the balloon device, keeping the whole memory",
+			logger.WithSandboxID(config.SandboxId),
+			zap.Int64("memory_target_mb", target),
+			zap.Int64("ram_mb", config.RamMb),
+		)
+
+		s.UpdateConfig(func(config *orchestrator.SandboxConfig) {
+			config.MemoryTargetMb = 0
+		})
+
+		return nil
+	}
+
+	return err
+}
+
+// MemoryReclaimedMiB returns the last known amount of memory held by the balloon device.
+func (s *Sandbox) MemoryReclaimedMiB() int64 {
+	return s.memoryReclaimedMiB.Load()
+}
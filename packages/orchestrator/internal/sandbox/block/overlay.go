@@ -3,6 +3,7 @@ package block
 import (
 	"errors"
 	"fmt"
+	"io"
 	"sync/atomic"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
@@ -53,6 +54,15 @@ func (o *Overlay) EjectCache() (*Cache, error) {
 	return o.cache, nil
 }
 
+// ExportCacheToDiff exports the blocks written to the overlay without ejecting the cache.
+func (o *Overlay) ExportCacheToDiff(out io.Writer) (*header.DiffMetadata, error) {
+	if o.cacheEjected.Load() {
+		return nil, fmt.Errorf("cache already ejected")
+	}
+
+	return o.cache.ExportToDiff(out)
+}
+
 // This method will not be very optimal if the length is not the same as the block size, because we cannot be just exposing the cache slice,
 // but creating and copying the bytes from the cache and device to the new slice.
 //
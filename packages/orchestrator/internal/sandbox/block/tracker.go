@@ -18,6 +18,9 @@ type TrackedSliceDevice struct {
 	dirty       *bitset.BitSet
 	dirtyMu     sync.Mutex
 	empty       []byte
+
+	accessTracking atomic.Bool
+	accessed       *bitset.BitSet
 }
 
 func NewTrackedSliceDevice(blockSize int64, device ReadonlyDevice) (*TrackedSliceDevice, error) {
@@ -43,6 +46,41 @@ func (t *TrackedSliceDevice) Disable() error {
 	return nil
 }
 
+// StartTracking starts recording which blocks are requested while still serving the real data.
+// Unlike Disable, the VM can keep running after the tracking is stopped.
+func (t *TrackedSliceDevice) StartTracking() error {
+	size, err := t.data.Size()
+	if err != nil {
+		return fmt.Errorf("failed to get device size: %w", err)
+	}
+
+	t.dirtyMu.Lock()
+	t.accessed = bitset.New(uint(header.TotalBlocks(size, t.blockSize)))
+	t.dirtyMu.Unlock()
+
+	t.accessTracking.Store(true)
+
+	return nil
+}
+
+// StopTracking stops recording the requested blocks and returns which blocks were not requested since StartTracking.
+// When called after a paused VM was snapshotted, these are the blocks that were already present in the VM memory.
+func (t *TrackedSliceDevice) StopTracking() *bitset.BitSet {
+	t.accessTracking.Store(false)
+
+	t.dirtyMu.Lock()
+	defer t.dirtyMu.Unlock()
+
+	if t.accessed == nil {
+		return nil
+	}
+
+	dirty := t.accessed.Complement()
+	t.accessed = nil
+
+	return dirty
+}
+
 func (t *TrackedSliceDevice) Slice(off int64, length int64) ([]byte, error) {
 	if t.nilTracking.Load() {
 		t.dirtyMu.Lock()
@@ -52,6 +90,14 @@ func (t *TrackedSliceDevice) Slice(off int64, length int64) ([]byte, error) {
 		return t.empty, nil
 	}
 
+	if t.accessTracking.Load() {
+		t.dirtyMu.Lock()
+		if t.accessed != nil {
+			t.accessed.Set(uint(header.BlockIdx(off, t.blockSize)))
+		}
+		t.dirtyMu.Unlock()
+	}
+
 	return t.data.Slice(off, length)
 }
 
@@ -0,0 +1,73 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const testBlockSize = int64(4096)
+
+type sliceDevice struct {
+	data []byte
+}
+
+func (d *sliceDevice) ReadAt(p []byte, off int64) (int, error) {
+	return copy(p, d.data[off:]), nil
+}
+
+func (d *sliceDevice) Close() error {
+	return nil
+}
+
+func (d *sliceDevice) Slice(off, length int64) ([]byte, error) {
+	return d.data[off : off+length], nil
+}
+
+func (d *sliceDevice) Size() (int64, error) {
+	return int64(len(d.data)), nil
+}
+
+func (d *sliceDevice) BlockSize() int64 {
+	return testBlockSize
+}
+
+func (d *sliceDevice) Header() *header.Header {
+	return nil
+}
+
+func TestTrackedSliceDevice_Tracking(t *testing.T) {
+	data := make([]byte, 4*testBlockSize)
+	data[testBlockSize] = 1
+
+	device, err := NewTrackedSliceDevice(testBlockSize, &sliceDevice{data: data})
+	require.NoError(t, err)
+
+	// Requests before the tracking started are not recorded
+	_, err = device.Slice(0, testBlockSize)
+	require.NoError(t, err)
+
+	require.NoError(t, device.StartTracking())
+
+	b, err := device.Slice(testBlockSize, testBlockSize)
+	require.NoError(t, err)
+	// The real data is served while tracking
+	assert.Equal(t, byte(1), b[0])
+
+	dirty := device.StopTracking()
+	require.NotNil(t, dirty)
+
+	assert.Equal(t, uint(3), dirty.Count())
+	assert.True(t, dirty.Test(0))
+	assert.False(t, dirty.Test(1))
+	assert.True(t, dirty.Test(2))
+	assert.True(t, dirty.Test(3))
+
+	// Requests after the tracking stopped are not recorded
+	_, err = device.Slice(2*testBlockSize, testBlockSize)
+	require.NoError(t, err)
+	assert.Nil(t, device.StopTracking())
+}
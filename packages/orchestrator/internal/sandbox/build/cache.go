@@ -112,6 +112,22 @@ func (s *DiffStore) Add(d Diff) {
 	s.cache.Set(d.CacheKey(), d, ttlcache.DefaultTTL)
 }
 
+// Remove schedules the deletion of the diff, the deletion is delayed the same way as for the evicted diffs.
+func (s *DiffStore) Remove(d Diff) {
+	key := d.CacheKey()
+	if !s.cache.Has(key) || s.isBeingDeleted(key) {
+		return
+	}
+
+	size, err := d.FileSize()
+	if err != nil {
+		zap.L().Warn("failed to get size of removed item from cache", zap.Error(err))
+		size = fallbackDiffSize
+	}
+
+	s.scheduleDelete(key, size)
+}
+
 func (s *DiffStore) Has(d Diff) bool {
 	return s.cache.Has(d.CacheKey())
 }
@@ -276,3 +276,40 @@ func TestDiffStoreOldestFromCache(t *testing.T) {
 	found = store.Has(diff3)
 	assert.True(t, found)
 }
+
+func TestDiffStoreRemove(t *testing.T) {
+	cachePath := createTempDir(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ttl := 60 * time.Second
+	delay := 1 * time.Second
+	store, err := NewDiffStore(
+		ctx,
+		cachePath,
+		ttl,
+		delay,
+		100.0,
+	)
+	t.Cleanup(store.Close)
+	assert.NoError(t, err)
+
+	diff := newDiff(t, cachePath, "build-test-id", Rootfs, blockSize)
+	store.Add(diff)
+
+	store.Remove(diff)
+
+	// The removal is delayed
+	assert.True(t, store.Has(diff))
+	assert.True(t, store.isBeingDeleted(diff.CacheKey()))
+
+	time.Sleep(delay + time.Second)
+
+	assert.False(t, store.Has(diff))
+	assert.False(t, store.isBeingDeleted(diff.CacheKey()))
+
+	cachePathOfDiff, err := diff.CachePath()
+	assert.NoError(t, err)
+	_, err = os.Stat(cachePathOfDiff)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,75 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/fc"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+const claimEnvdInitTimeout = 10 * time.Second
+
+// Claim assigns the pooled sandbox to the new config.
+// The env vars and access token are injected via envd init, the guest metadata is updated with the new sandbox ID
+// and the rate limits and network policy of the config are applied. The resources of the VM can't be changed,
+// the caller must check they match the config.
+// The execution ID of the pooled sandbox is kept, it identifies the sandbox resources and proxy connections.
+func (s *Sandbox) Claim(ctx context.Context, tracer trace.Tracer, config *orchestrator.SandboxConfig, startAt, endAt time.Time, traceID string) error {
+	ctx, childSpan := tracer.Start(ctx, "sandbox-claim")
+	defer childSpan.End()
+
+	if !s.Config.GetPooled() {
+		return fmt.Errorf("sandbox '%s' is not pooled", s.Config.SandboxId)
+	}
+
+	initCtx, cancel := context.WithTimeout(ctx, claimEnvdInitTimeout)
+	defer cancel()
+
+	err := s.initEnvd(initCtx, tracer, config.EnvVars, config.EnvdAccessToken)
+	if err != nil {
+		return fmt.Errorf("failed to init envd: %w", err)
+	}
+
+	err = s.process.SetMmds(ctx, tracer, &fc.MmdsMetadata{
+		SandboxId:            config.SandboxId,
+		TemplateId:           config.TemplateId,
+		LogsCollectorAddress: os.Getenv("LOGS_COLLECTOR_PUBLIC_IP"),
+		TraceId:              traceID,
+		TeamId:               config.TeamId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set mmds: %w", err)
+	}
+
+	// The pooled sandbox was started with the limits of the team tier at the time, they could have changed since
+	err = s.process.SetRateLimits(ctx, tracer, rateLimits(config))
+	if err != nil {
+		return fmt.Errorf("failed to set rate limits: %w", err)
+	}
+
+	if config.GetNetworkPolicy() != nil {
+		err = s.UpdateNetworkPolicy(ctx, tracer, config.GetNetworkPolicy())
+		if err != nil {
+			return err
+		}
+	}
+
+	config.ExecutionId = s.GetConfig().ExecutionId
+	config.Pooled = false
+
+	s.setConfig(config)
+	s.StartedAt = startAt
+	s.EndAt = endAt
+
+	err = s.applyMemoryTarget(ctx, tracer)
+	if err != nil {
+		return fmt.Errorf("failed to apply memory target: %w", err)
+	}
+
+	return nil
+}
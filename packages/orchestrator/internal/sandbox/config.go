@@ -0,0 +1,59 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+// GetConfig returns the current config of the sandbox.
+// The config is replaced as a whole when it changes, the returned one must not be modified.
+func (m *Metadata) GetConfig() *orchestrator.SandboxConfig {
+	m.configMu.RLock()
+	defer m.configMu.RUnlock()
+
+	return m.Config
+}
+
+// UpdateConfig replaces the config of the sandbox with its copy changed by the update.
+// The updates are serialized and the readers holding the previous config don't see the change.
+func (m *Metadata) UpdateConfig(update func(config *orchestrator.SandboxConfig)) {
+	m.configMu.Lock()
+	defer m.configMu.Unlock()
+
+	config := proto.Clone(m.Config).(*orchestrator.SandboxConfig)
+	update(config)
+
+	m.Config = config
+}
+
+// setConfig replaces the whole config of the sandbox.
+func (m *Metadata) setConfig(config *orchestrator.SandboxConfig) {
+	m.configMu.Lock()
+	defer m.configMu.Unlock()
+
+	m.Config = config
+}
+
+// UpdateNetworkPolicy applies the network policy to the sandbox firewall and keeps it in the config,
+// so it is reported to the API and stored with the snapshots.
+func (s *Sandbox) UpdateNetworkPolicy(ctx context.Context, tracer trace.Tracer, policy *orchestrator.SandboxNetworkPolicy) error {
+	// The concurrent updates must leave the same policy in the firewall and in the config
+	s.networkPolicyMu.Lock()
+	defer s.networkPolicyMu.Unlock()
+
+	err := s.Slot.UpdateNetworkPolicy(ctx, tracer, policy)
+	if err != nil {
+		return fmt.Errorf("failed to update network policy: %w", err)
+	}
+
+	s.UpdateConfig(func(config *orchestrator.SandboxConfig) {
+		config.NetworkPolicy = policy
+	})
+
+	return nil
+}
@@ -28,6 +28,15 @@ func (r *RootfsDiffCreator) process(ctx context.Context, out io.Writer) (*header
 	return r.rootfs.ExportDiff(ctx, out, r.stopHook)
 }
 
+// LiveRootfsDiffCreator exports the rootfs diff without stopping the sandbox.
+type LiveRootfsDiffCreator struct {
+	rootfs rootfs.Provider
+}
+
+func (r *LiveRootfsDiffCreator) process(ctx context.Context, out io.Writer) (*header.DiffMetadata, error) {
+	return r.rootfs.ExportLiveDiff(ctx, out)
+}
+
 type MemoryDiffCreator struct {
 	tracer     trace.Tracer
 	memfile    *storage.TemporaryMemfile
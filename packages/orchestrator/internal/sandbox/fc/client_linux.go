@@ -5,8 +5,10 @@ package fc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/firecracker-microvm/firecracker-go-sdk"
 	"github.com/go-openapi/strfmt"
@@ -163,7 +165,7 @@ func (c *apiClient) setBootSource(ctx context.Context, kernelArgs string, kernel
 	return nil
 }
 
-func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error {
+func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string, rateLimiter *models.RateLimiter) error {
 	rootfs := "rootfs"
 	ioEngine := "Async"
 	isRootDevice := true
@@ -176,6 +178,7 @@ func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error
 			IsRootDevice: &isRootDevice,
 			IsReadOnly:   false,
 			IoEngine:     &ioEngine,
+			RateLimiter:  rateLimiter,
 		},
 	}
 
@@ -187,14 +190,16 @@ func (c *apiClient) setRootfsDrive(ctx context.Context, rootfsPath string) error
 	return nil
 }
 
-func (c *apiClient) setNetworkInterface(ctx context.Context, ifaceID string, tapName string, tapMac string) error {
+func (c *apiClient) setNetworkInterface(ctx context.Context, ifaceID string, tapName string, tapMac string, rateLimiter *models.RateLimiter) error {
 	networkConfig := operations.PutGuestNetworkInterfaceByIDParams{
 		Context: ctx,
 		IfaceID: ifaceID,
 		Body: &models.NetworkInterface{
-			IfaceID:     &ifaceID,
-			GuestMac:    tapMac,
-			HostDevName: &tapName,
+			IfaceID:       &ifaceID,
+			GuestMac:      tapMac,
+			HostDevName:   &tapName,
+			RxRateLimiter: rateLimiter,
+			TxRateLimiter: rateLimiter,
 		},
 	}
 
@@ -265,3 +270,109 @@ func (c *apiClient) startVM(ctx context.Context) error {
 
 	return nil
 }
+
+func (c *apiClient) setBalloon(ctx context.Context, amountMiB int64, statsInterval time.Duration) error {
+	deflateOnOom := true
+	balloonConfig := operations.PutBalloonParams{
+		Context: ctx,
+		Body: &models.Balloon{
+			AmountMib:             &amountMiB,
+			DeflateOnOom:          &deflateOnOom,
+			StatsPollingIntervals: int64(statsInterval.Seconds()),
+		},
+	}
+
+	_, err := c.client.Operations.PutBalloon(&balloonConfig)
+	if err != nil {
+		return fmt.Errorf("error setting fc balloon config: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) updateBalloon(ctx context.Context, amountMiB int64) error {
+	balloonConfig := operations.PatchBalloonParams{
+		Context: ctx,
+		Body: &models.BalloonUpdate{
+			AmountMib: &amountMiB,
+		},
+	}
+
+	_, err := c.client.Operations.PatchBalloon(&balloonConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc balloon: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) balloonStats(ctx context.Context) (*models.BalloonStats, error) {
+	statsParams := operations.DescribeBalloonStatsParams{
+		Context: ctx,
+	}
+
+	stats, err := c.client.Operations.DescribeBalloonStats(&statsParams)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fc balloon stats: %w", err)
+	}
+
+	return stats.Payload, nil
+}
+
+// hasBalloon checks if the VM has the balloon device, Firecracker rejects the request if it isn't configured.
+func (c *apiClient) hasBalloon(ctx context.Context) (bool, error) {
+	configParams := operations.DescribeBalloonConfigParams{
+		Context: ctx,
+	}
+
+	_, err := c.client.Operations.DescribeBalloonConfig(&configParams)
+
+	var notConfigured *operations.DescribeBalloonConfigBadRequest
+	if errors.As(err, &notConfigured) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("error getting fc balloon config: %w", err)
+	}
+
+	return true, nil
+}
+
+func (c *apiClient) updateRootfsDriveRateLimiter(ctx context.Context, rateLimiter *models.RateLimiter) error {
+	rootfs := "rootfs"
+	driveConfig := operations.PatchGuestDriveByIDParams{
+		Context: ctx,
+		DriveID: rootfs,
+		Body: &models.PartialDrive{
+			DriveID:     &rootfs,
+			RateLimiter: rateLimiter,
+		},
+	}
+
+	_, err := c.client.Operations.PatchGuestDriveByID(&driveConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc drive rate limiter: %w", err)
+	}
+
+	return nil
+}
+
+func (c *apiClient) updateNetworkInterfaceRateLimiter(ctx context.Context, ifaceID string, rateLimiter *models.RateLimiter) error {
+	networkConfig := operations.PatchGuestNetworkInterfaceByIDParams{
+		Context: ctx,
+		IfaceID: ifaceID,
+		Body: &models.PartialNetworkInterface{
+			IfaceID:       &ifaceID,
+			RxRateLimiter: rateLimiter,
+			TxRateLimiter: rateLimiter,
+		},
+	}
+
+	_, err := c.client.Operations.PatchGuestNetworkInterfaceByID(&networkConfig)
+	if err != nil {
+		return fmt.Errorf("error updating fc network rate limiter: %w", err)
+	}
+
+	return nil
+}
@@ -5,8 +5,10 @@ package fc
 
 import (
 	"context"
+	"time"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
 )
 
 type apiClient struct{}
@@ -34,3 +36,27 @@ func (c *apiClient) pauseVM(ctx context.Context) error {
 func (c *apiClient) createSnapshot(ctx context.Context, snapfilePath string, memfilePath string) error {
 	return nil
 }
+
+func (c *apiClient) setBalloon(ctx context.Context, amountMiB int64, statsInterval time.Duration) error {
+	return nil
+}
+
+func (c *apiClient) updateBalloon(ctx context.Context, amountMiB int64) error {
+	return nil
+}
+
+func (c *apiClient) balloonStats(ctx context.Context) (*models.BalloonStats, error) {
+	return nil, nil
+}
+
+func (c *apiClient) hasBalloon(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (c *apiClient) updateRootfsDriveRateLimiter(ctx context.Context, rateLimiter *models.RateLimiter) error {
+	return nil
+}
+
+func (c *apiClient) updateNetworkInterfaceRateLimiter(ctx context.Context, ifaceID string, rateLimiter *models.RateLimiter) error {
+	return nil
+}
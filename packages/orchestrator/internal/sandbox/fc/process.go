@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"syscall"
 	txtTemplate "text/template"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -19,6 +20,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/socket"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -34,6 +36,9 @@ ip netns exec {{ .namespaceID }} {{ .firecrackerPath }} --api-sock {{ .firecrack
 
 var startScriptTemplate = txtTemplate.Must(txtTemplate.New("fc-start").Parse(startScript))
 
+// balloonStatsInterval is how often the guest reports the balloon statistics to Firecracker.
+const balloonStatsInterval = 5 * time.Second
+
 type ProcessOptions struct {
 	// InitScriptPath is the path to the init script that will be executed inside the VM on kernel start.
 	InitScriptPath string
@@ -242,6 +247,7 @@ func (p *Process) Create(
 	vCPUCount int64,
 	memoryMB int64,
 	hugePages bool,
+	rateLimits RateLimits,
 	options ProcessOptions,
 ) error {
 	childCtx, childSpan := tracer.Start(ctx, "create-fc")
@@ -312,7 +318,7 @@ func (p *Process) Create(
 		return fmt.Errorf("error symlinking rootfs: %w", err)
 	}
 
-	err = p.client.setRootfsDrive(childCtx, p.buildRootfsPath)
+	err = p.client.setRootfsDrive(childCtx, p.buildRootfsPath, rateLimits.disk())
 	if err != nil {
 		fcStopErr := p.Stop()
 
@@ -321,7 +327,7 @@ func (p *Process) Create(
 	telemetry.ReportEvent(childCtx, "set fc drivers config")
 
 	// Network
-	err = p.client.setNetworkInterface(childCtx, p.slot.VpeerName(), p.slot.TapName(), p.slot.TapMAC())
+	err = p.client.setNetworkInterface(childCtx, p.slot.VpeerName(), p.slot.TapName(), p.slot.TapMAC(), rateLimits.network())
 	if err != nil {
 		fcStopErr := p.Stop()
 
@@ -337,6 +343,15 @@ func (p *Process) Create(
 	}
 	telemetry.ReportEvent(childCtx, "set fc machine config")
 
+	// The balloon is attached deflated, so the device is part of the snapshot and the memory can be reclaimed later
+	err = p.client.setBalloon(childCtx, 0, balloonStatsInterval)
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error setting fc balloon config: %w", err), fcStopErr)
+	}
+	telemetry.ReportEvent(childCtx, "set fc balloon config")
+
 	err = p.client.startVM(childCtx)
 	if err != nil {
 		fcStopErr := p.Stop()
@@ -355,6 +370,7 @@ func (p *Process) Resume(
 	uffdSocketPath string,
 	snapfile template.File,
 	uffdReady chan struct{},
+	rateLimits RateLimits,
 ) error {
 	childCtx, childSpan := tracer.Start(ctx, "resume-fc")
 	defer childSpan.End()
@@ -398,6 +414,21 @@ func (p *Process) Resume(
 		return errors.Join(fmt.Errorf("error resuming vm: %w", err), fcStopErr)
 	}
 
+	// The rate limiters stored in the snapshot are replaced with the limits of the sandbox
+	err = p.client.updateRootfsDriveRateLimiter(childCtx, rateLimits.disk())
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error setting drive rate limiter: %w", err), fcStopErr)
+	}
+
+	err = p.client.updateNetworkInterfaceRateLimiter(childCtx, p.slot.VpeerName(), rateLimits.network())
+	if err != nil {
+		fcStopErr := p.Stop()
+
+		return errors.Join(fmt.Errorf("error setting network rate limiter: %w", err), fcStopErr)
+	}
+
 	err = p.client.setMmds(childCtx, mmdsMetadata)
 	if err != nil {
 		fcStopErr := p.Stop()
@@ -442,6 +473,59 @@ func (p *Process) Pause(ctx context.Context, tracer trace.Tracer) error {
 	return p.client.pauseVM(ctx)
 }
 
+// Unpause resumes the vCPUs of a VM that was paused via Pause.
+func (p *Process) Unpause(ctx context.Context, tracer trace.Tracer) error {
+	ctx, childSpan := tracer.Start(ctx, "unpause-fc")
+	defer childSpan.End()
+
+	return p.client.resumeVM(ctx)
+}
+
+// SetBalloon inflates or deflates the balloon device to the amount of memory in MiB reclaimed from the guest.
+func (p *Process) SetBalloon(ctx context.Context, tracer trace.Tracer, amountMiB int64) error {
+	ctx, childSpan := tracer.Start(ctx, "set-balloon-fc")
+	defer childSpan.End()
+
+	return p.client.updateBalloon(ctx, amountMiB)
+}
+
+// SetRateLimits replaces the rate limiters of the rootfs drive and the network interface of the running VM.
+func (p *Process) SetRateLimits(ctx context.Context, tracer trace.Tracer, rateLimits RateLimits) error {
+	childCtx, childSpan := tracer.Start(ctx, "set-fc-rate-limits")
+	defer childSpan.End()
+
+	err := p.client.updateRootfsDriveRateLimiter(childCtx, rateLimits.disk())
+	if err != nil {
+		return fmt.Errorf("error setting drive rate limiter: %w", err)
+	}
+
+	err = p.client.updateNetworkInterfaceRateLimiter(childCtx, p.slot.VpeerName(), rateLimits.network())
+	if err != nil {
+		return fmt.Errorf("error setting network rate limiter: %w", err)
+	}
+
+	return nil
+}
+
+// SetMmds replaces the metadata available to the guest via MMDS.
+func (p *Process) SetMmds(ctx context.Context, tracer trace.Tracer, mmdsMetadata *MmdsMetadata) error {
+	ctx, childSpan := tracer.Start(ctx, "set-mmds-fc")
+	defer childSpan.End()
+
+	return p.client.setMmds(ctx, mmdsMetadata)
+}
+
+// BalloonStats returns the balloon device statistics, the VM needs to be started with the balloon device.
+func (p *Process) BalloonStats(ctx context.Context) (*models.BalloonStats, error) {
+	return p.client.balloonStats(ctx)
+}
+
+// HasBalloon checks if the VM has the balloon device. The device can only be attached before the VM boots,
+// so the VMs resumed from the snapshots of the templates built before the balloon was added don't have it.
+func (p *Process) HasBalloon(ctx context.Context) (bool, error) {
+	return p.client.hasBalloon(ctx)
+}
+
 // CreateSnapshot VM needs to be paused before creating a snapshot.
 func (p *Process) CreateSnapshot(ctx context.Context, tracer trace.Tracer, snapfilePath string, memfilePath string) error {
 	ctx, childSpan := tracer.Start(ctx, "create-snapshot-fc")
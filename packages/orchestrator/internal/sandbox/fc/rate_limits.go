@@ -0,0 +1,43 @@
+package fc
+
+import (
+	"github.com/e2b-dev/infra/packages/shared/pkg/fc/models"
+)
+
+// rateLimitRefillTimeMs is the interval in which the token buckets are fully refilled.
+const rateLimitRefillTimeMs = 1000
+
+// RateLimits of the sandbox rootfs drive and network interface, 0 means unlimited.
+type RateLimits struct {
+	NetworkBandwidth int64 // bytes per second
+	NetworkOps       int64 // packets per second
+	DiskBandwidth    int64 // bytes per second
+	DiskOps          int64 // operations per second
+}
+
+func (r RateLimits) network() *models.RateLimiter {
+	return rateLimiter(r.NetworkBandwidth, r.NetworkOps)
+}
+
+func (r RateLimits) disk() *models.RateLimiter {
+	return rateLimiter(r.DiskBandwidth, r.DiskOps)
+}
+
+// rateLimiter returns the limiter with both token buckets set, the bucket with zero size is disabled in Firecracker.
+// This way the limits from the snapshot are always overwritten.
+func rateLimiter(bandwidth, ops int64) *models.RateLimiter {
+	return &models.RateLimiter{
+		Bandwidth: tokenBucket(max(bandwidth, 0)),
+		Ops:       tokenBucket(max(ops, 0)),
+	}
+}
+
+func tokenBucket(perSecond int64) *models.TokenBucket {
+	size := perSecond
+	refillTime := int64(rateLimitRefillTimeMs)
+
+	return &models.TokenBucket{
+		Size:       &size,
+		RefillTime: &refillTime,
+	}
+}
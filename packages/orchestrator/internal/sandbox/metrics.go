@@ -16,6 +16,10 @@ type Metrics struct {
 	CPUUsedPercent float64 `json:"cpu_used_pct"`  // Percent rounded to 2 decimal places
 	MemTotalMiB    int64   `json:"mem_total_mib"` // Total virtual memory in MiB
 	MemUsedMiB     int64   `json:"mem_used_mib"`  // Used virtual memory in MiB
+
+	// Balloon device stats reported by Firecracker (not by envd), zero if the sandbox doesn't have the balloon device.
+	BalloonTargetMiB int64 `json:"-"` // Memory the balloon aims to hold in MiB
+	BalloonActualMiB int64 `json:"-"` // Memory the balloon holds in MiB
 }
 
 func (c *Checks) GetMetrics(timeout time.Duration) (*Metrics, error) {
@@ -50,5 +54,14 @@ func (c *Checks) GetMetrics(timeout time.Duration) (*Metrics, error) {
 		return nil, err
 	}
 
+	// Sandboxes from templates built without the balloon device don't have the stats
+	stats, err := c.sandbox.process.BalloonStats(ctx)
+	if err == nil && stats != nil && stats.TargetMib != nil && stats.ActualMib != nil {
+		m.BalloonTargetMiB = *stats.TargetMib
+		m.BalloonActualMiB = *stats.ActualMib
+
+		c.sandbox.memoryReclaimedMiB.Store(m.BalloonActualMiB)
+	}
+
 	return &m, nil
 }
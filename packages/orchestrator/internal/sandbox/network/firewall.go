@@ -1,12 +1,16 @@
 package network
 
 import (
+	"encoding/binary"
 	"fmt"
+	"math"
 	"net/netip"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
 	"github.com/google/nftables/expr"
 	"github.com/ngrok/firewall_toolkit/pkg/expressions"
 	"github.com/ngrok/firewall_toolkit/pkg/rule"
@@ -14,7 +18,10 @@ import (
 )
 
 const (
-	tableName = "slot-firewall"
+	tableName      = "slot-firewall"
+	portsChainName = "ALLOWED_PORTS"
+
+	allInternetCIDR = "0.0.0.0/0"
 )
 
 var blockedRanges = []string{
@@ -25,12 +32,15 @@ var blockedRanges = []string{
 }
 
 type Firewall struct {
-	conn         *nftables.Conn
-	table        *nftables.Table
-	chain        *nftables.Chain
-	blockSet     set.Set
-	allowSet     set.Set
-	tapInterface string
+	conn            *nftables.Conn
+	table           *nftables.Table
+	chain           *nftables.Chain
+	portsChain      *nftables.Chain
+	blockSet        set.Set
+	allowSet        set.Set
+	deniedPortsSet  set.Set
+	allowedPortsSet set.Set
+	tapInterface    string
 }
 
 func NewFirewall(tapIf string) (*Firewall, error) {
@@ -53,6 +63,12 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 		Policy:   &acceptPolicy,
 	})
 
+	// Chain restricting the destination ports, it is filled only when the policy allows specific ports
+	portsChain := conn.AddChain(&nftables.Chain{
+		Name:  portsChainName,
+		Table: table,
+	})
+
 	// Create block-set and allow-set
 	blockSet, err := set.New(conn, table, "filtered_blocklist", nftables.TypeIPAddr)
 	if err != nil {
@@ -62,14 +78,25 @@ func NewFirewall(tapIf string) (*Firewall, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new allow set: %w", err)
 	}
+	deniedPortsSet, err := set.New(conn, table, "filtered_denied_ports", nftables.TypeInetService)
+	if err != nil {
+		return nil, fmt.Errorf("new denied ports set: %w", err)
+	}
+	allowedPortsSet, err := set.New(conn, table, "filtered_allowed_ports", nftables.TypeInetService)
+	if err != nil {
+		return nil, fmt.Errorf("new allowed ports set: %w", err)
+	}
 
 	fw := &Firewall{
-		conn:         conn,
-		table:        table,
-		chain:        chain,
-		blockSet:     blockSet,
-		allowSet:     allowSet,
-		tapInterface: tapIf,
+		conn:            conn,
+		table:           table,
+		chain:           chain,
+		portsChain:      portsChain,
+		blockSet:        blockSet,
+		allowSet:        allowSet,
+		deniedPortsSet:  deniedPortsSet,
+		allowedPortsSet: allowedPortsSet,
+		tapInterface:    tapIf,
 	}
 
 	// Add firewall rules to the chain
@@ -129,6 +156,32 @@ func (fw *Firewall) installRules() error {
 		),
 	})
 
+	// Drop anything to the denied ports
+	for _, proto := range []expressions.TransportProto{expressions.TCP, expressions.UDP} {
+		exprs, err := rule.Build(
+			expr.VerdictDrop,
+			rule.TransportProtocol(proto),
+			rule.DestinationPortSet(fw.deniedPortsSet.Set()),
+		)
+		if err != nil {
+			return fmt.Errorf("build rule for denied ports: %w", err)
+		}
+		fw.conn.AddRule(&nftables.Rule{
+			Table: fw.table, Chain: fw.chain,
+			Exprs: append(ifaceMatch,
+				exprs...,
+			),
+		})
+	}
+
+	// Restrict the destination ports if the policy allows only some of them
+	fw.conn.AddRule(&nftables.Rule{
+		Table: fw.table, Chain: fw.chain,
+		Exprs: append(ifaceMatch,
+			&expr.Verdict{Kind: expr.VerdictJump, Chain: fw.portsChain.Name},
+		),
+	})
+
 	// Drop anything in blockSet
 	fw.conn.AddRule(&nftables.Rule{
 		Table: fw.table, Chain: fw.chain,
@@ -208,6 +261,121 @@ func (fw *Firewall) AddAllowedIP(cidr string) error {
 	return nil
 }
 
+// SetPolicy replaces the custom rules with the given policy.
+// Allowed CIDRs take precedence over the denied ones and the ports restrictions, they can't include the internal ranges.
+func (fw *Firewall) SetPolicy(allowedCIDRs, deniedCIDRs []string, allowedPorts, deniedPorts []uint32) error {
+	blocked := append(slices.Clone(blockedRanges), deniedCIDRs...)
+	if err := fw.setAddresses(fw.blockSet, blocked); err != nil {
+		return fmt.Errorf("set block set: %w", err)
+	}
+
+	allowed := append(initialAllowedRanges(), withoutInternalRanges(allowedCIDRs)...)
+	if err := fw.setAddresses(fw.allowSet, allowed); err != nil {
+		return fmt.Errorf("set allow set: %w", err)
+	}
+
+	if err := fw.setPorts(fw.deniedPortsSet, deniedPorts); err != nil {
+		return fmt.Errorf("set denied ports set: %w", err)
+	}
+
+	if err := fw.setPorts(fw.allowedPortsSet, allowedPorts); err != nil {
+		return fmt.Errorf("set allowed ports set: %w", err)
+	}
+
+	fw.conn.FlushChain(fw.portsChain)
+	if len(allowedPorts) > 0 {
+		for _, proto := range []expressions.TransportProto{expressions.TCP, expressions.UDP} {
+			fw.conn.AddRule(&nftables.Rule{
+				Table: fw.table, Chain: fw.portsChain,
+				Exprs: []expr.Any{
+					expressions.Meta(expr.MetaKeyL4PROTO, 1),
+					expressions.Equals([]byte{byte(proto)}, 1),
+					expressions.DestinationPort(1),
+					&expr.Lookup{
+						SourceRegister: 1,
+						SetName:        fw.allowedPortsSet.Set().Name,
+						SetID:          fw.allowedPortsSet.Set().ID,
+						Invert:         true,
+					},
+					expressions.Drop(),
+				},
+			})
+		}
+	}
+
+	if err := fw.conn.Flush(); err != nil {
+		return fmt.Errorf("flush policy changes: %w", err)
+	}
+
+	return nil
+}
+
+// setAddresses replaces the content of the address set, nested CIDRs are merged as the interval set can't contain overlapping elements.
+func (fw *Firewall) setAddresses(s set.Set, cidrs []string) error {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := ParsePolicyCIDR(cidr)
+		if err != nil {
+			return err
+		}
+
+		prefixes = append(prefixes, prefix)
+	}
+
+	elements := make([]nftables.SetElement, 0, 2*len(prefixes))
+	for _, prefix := range mergePrefixes(prefixes) {
+		start := prefix.Addr().As4()
+		elements = append(elements, nftables.SetElement{Key: start[:]})
+
+		// The interval ending with 255.255.255.255 is open, its end would overflow
+		end := uint64(binary.BigEndian.Uint32(start[:])) + uint64(1)<<(32-prefix.Bits())
+		if end <= math.MaxUint32 {
+			elements = append(elements, nftables.SetElement{
+				Key:         binary.BigEndian.AppendUint32(nil, uint32(end)),
+				IntervalEnd: true,
+			})
+		}
+	}
+
+	fw.conn.FlushSet(s.Set())
+	if len(elements) == 0 {
+		return nil
+	}
+
+	return fw.conn.SetAddElements(s.Set(), elements)
+}
+
+// setPorts replaces the content of the ports set.
+func (fw *Firewall) setPorts(s set.Set, ports []uint32) error {
+	ports = slices.Clone(ports)
+	slices.Sort(ports)
+	ports = slices.Compact(ports)
+
+	elements := make([]nftables.SetElement, 0, 2*len(ports))
+	for _, port := range ports {
+		if port == 0 || port > math.MaxUint16 {
+			return fmt.Errorf("invalid port %d", port)
+		}
+
+		elements = append(elements, nftables.SetElement{Key: binaryutil.BigEndian.PutUint16(uint16(port))})
+
+		// The interval of the last port is open, its end would overflow
+		if port < math.MaxUint16 {
+			elements = append(elements, nftables.SetElement{
+				Key:         binaryutil.BigEndian.PutUint16(uint16(port + 1)),
+				IntervalEnd: true,
+			})
+		}
+	}
+
+	fw.conn.FlushSet(s.Set())
+	if len(elements) == 0 {
+		return nil
+	}
+
+	return fw.conn.SetAddElements(s.Set(), elements)
+}
+
 func (fw *Firewall) ResetAllCustom() error {
 	if err := fw.ResetBlockedCustom(); err != nil {
 		return fmt.Errorf("clear block set: %w", err)
@@ -215,10 +383,22 @@ func (fw *Firewall) ResetAllCustom() error {
 	if err := fw.ResetAllowedCustom(); err != nil {
 		return fmt.Errorf("clear allow set: %w", err)
 	}
+	if err := fw.resetPortsCustom(); err != nil {
+		return fmt.Errorf("clear ports sets: %w", err)
+	}
 
 	return nil
 }
 
+// resetPortsCustom removes all the ports restrictions.
+func (fw *Firewall) resetPortsCustom() error {
+	fw.conn.FlushSet(fw.deniedPortsSet.Set())
+	fw.conn.FlushSet(fw.allowedPortsSet.Set())
+	fw.conn.FlushChain(fw.portsChain)
+
+	return fw.conn.Flush()
+}
+
 // ResetBlockedCustom resets the block set back to original ranges.
 func (fw *Firewall) ResetBlockedCustom() error {
 	initData, err := set.AddressStringsToSetData(blockedRanges)
@@ -234,6 +414,17 @@ func (fw *Firewall) ResetBlockedCustom() error {
 
 // ResetAllowedCustom resets allow set back to original ranges.
 func (fw *Firewall) ResetAllowedCustom() error {
+	initData, err := set.AddressStringsToSetData(initialAllowedRanges())
+	if err != nil {
+		return fmt.Errorf("parse initial allow CIDRs: %w", err)
+	}
+	if err := fw.allowSet.ClearAndAddElements(fw.conn, initData); err != nil {
+		return err
+	}
+	return fw.conn.Flush()
+}
+
+func initialAllowedRanges() []string {
 	initIps := make([]string, 0)
 
 	// Allow Logs Collector IP for logs
@@ -242,12 +433,5 @@ func (fw *Firewall) ResetAllowedCustom() error {
 		initIps = append(initIps, ip)
 	}
 
-	initData, err := set.AddressStringsToSetData(initIps)
-	if err != nil {
-		return fmt.Errorf("parse initial allow CIDRs: %w", err)
-	}
-	if err := fw.allowSet.ClearAndAddElements(fw.conn, initData); err != nil {
-		return err
-	}
-	return fw.conn.Flush()
+	return initIps
 }
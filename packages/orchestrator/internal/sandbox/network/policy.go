@@ -0,0 +1,311 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+const (
+	policyDomainsRefreshInterval = 30 * time.Second
+	policyDomainResolveTimeout   = 5 * time.Second
+)
+
+// ParsePolicyCIDR parses an IPv4 address or CIDR from the network policy.
+func ParsePolicyCIDR(cidr string) (netip.Prefix, error) {
+	if strings.Contains(cidr, "/") {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR '%s': %w", cidr, err)
+		}
+
+		if !prefix.Addr().Is4() {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR '%s': only IPv4 is supported", cidr)
+		}
+
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid IP address '%s': %w", cidr, err)
+	}
+
+	if !addr.Is4() {
+		return netip.Prefix{}, fmt.Errorf("invalid IP address '%s': only IPv4 is supported", cidr)
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// ValidatePolicy checks that the addresses and ports of the network policy are valid.
+// The whole internet can't be allowed, the allowed addresses would override all the denied ones.
+func ValidatePolicy(policy *orchestrator.SandboxNetworkPolicy) error {
+	for _, cidr := range policy.GetAllowedCidrs() {
+		prefix, err := ParsePolicyCIDR(cidr)
+		if err != nil {
+			return err
+		}
+
+		if prefix.Bits() == 0 {
+			return fmt.Errorf("CIDR '%s' can't be allowed, it includes all addresses", cidr)
+		}
+	}
+
+	for _, cidr := range policy.GetDeniedCidrs() {
+		if _, err := ParsePolicyCIDR(cidr); err != nil {
+			return err
+		}
+	}
+
+	for _, port := range slices.Concat(policy.GetAllowedPorts(), policy.GetDeniedPorts()) {
+		if port == 0 || port > 65535 {
+			return fmt.Errorf("invalid port %d", port)
+		}
+	}
+
+	return nil
+}
+
+// mergePrefixes removes the prefixes that are duplicated or nested in other prefixes.
+// CIDR blocks either nest or don't overlap at all, so comparing with the last kept prefix is enough.
+func mergePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	sorted := slices.Clone(prefixes)
+	slices.SortFunc(sorted, func(a, b netip.Prefix) int {
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c
+		}
+
+		return a.Bits() - b.Bits()
+	})
+
+	merged := make([]netip.Prefix, 0, len(sorted))
+	for _, prefix := range sorted {
+		if len(merged) > 0 && merged[len(merged)-1].Overlaps(prefix) {
+			continue
+		}
+
+		merged = append(merged, prefix)
+	}
+
+	return merged
+}
+
+// withoutInternalRanges removes the allowed CIDRs overlapping the internal ranges, sandboxes must never reach them.
+func withoutInternalRanges(cidrs []string) []string {
+	filtered := make([]string, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		prefix, err := ParsePolicyCIDR(cidr)
+		if err != nil {
+			// Keep the invalid CIDR so setting the firewall fails on it
+			filtered = append(filtered, cidr)
+
+			continue
+		}
+
+		internal := slices.ContainsFunc(blockedRanges, func(blocked string) bool {
+			return netip.MustParsePrefix(blocked).Overlaps(prefix)
+		})
+		if internal {
+			zap.L().Warn("skipping allowed CIDR overlapping internal ranges", zap.String("cidr", cidr))
+
+			continue
+		}
+
+		filtered = append(filtered, cidr)
+	}
+
+	return filtered
+}
+
+// resolveDomains resolves the IPv4 addresses of the domains.
+// The previously resolved addresses are kept for the domains that fail to resolve.
+func resolveDomains(ctx context.Context, domains []string, previous map[string][]string) map[string][]string {
+	resolved := make(map[string][]string, len(domains))
+
+	for _, domain := range domains {
+		resolveCtx, cancel := context.WithTimeout(ctx, policyDomainResolveTimeout)
+		addrs, err := net.DefaultResolver.LookupNetIP(resolveCtx, "ip4", domain)
+		cancel()
+		if err != nil {
+			zap.L().Warn("failed to resolve network policy domain", zap.String("domain", domain), zap.Error(err))
+			resolved[domain] = previous[domain]
+
+			continue
+		}
+
+		ips := make([]string, 0, len(addrs))
+		for _, addr := range addrs {
+			ips = append(ips, addr.Unmap().String())
+		}
+
+		resolved[domain] = ips
+	}
+
+	return resolved
+}
+
+func isEmptyPolicy(policy *orchestrator.SandboxNetworkPolicy) bool {
+	return len(policy.GetAllowedCidrs()) == 0 &&
+		len(policy.GetDeniedCidrs()) == 0 &&
+		len(policy.GetAllowedDomains()) == 0 &&
+		len(policy.GetDeniedDomains()) == 0 &&
+		len(policy.GetAllowedPorts()) == 0 &&
+		len(policy.GetDeniedPorts()) == 0
+}
+
+func policyDomains(policy *orchestrator.SandboxNetworkPolicy) []string {
+	return slices.Concat(policy.GetAllowedDomains(), policy.GetDeniedDomains())
+}
+
+// UpdateNetworkPolicy replaces the egress policy of the slot at runtime.
+func (s *Slot) UpdateNetworkPolicy(ctx context.Context, tracer trace.Tracer, policy *orchestrator.SandboxNetworkPolicy) error {
+	_, span := tracer.Start(ctx, "slot-network-policy-update", trace.WithAttributes(
+		attribute.String("namespace_id", s.NamespaceID()),
+	))
+	defer span.End()
+
+	if err := ValidatePolicy(policy); err != nil {
+		return err
+	}
+
+	s.policyMu.Lock()
+	defer s.policyMu.Unlock()
+
+	s.firewallCustomRules.Store(true)
+
+	return s.setPolicy(ctx, s.allowInternet, policy)
+}
+
+// setPolicy applies the policy to the firewall and (re)starts the refresh of its domains.
+// The caller must hold the policyMu.
+func (s *Slot) setPolicy(ctx context.Context, allowInternet bool, policy *orchestrator.SandboxNetworkPolicy) error {
+	s.stopPolicyRefresh()
+
+	s.allowInternet = allowInternet
+	s.policy = policy
+	s.policyResolved = resolveDomains(ctx, policyDomains(policy), nil)
+
+	err := s.applyPolicy()
+	if err != nil {
+		return err
+	}
+
+	if len(policyDomains(policy)) > 0 {
+		refreshCtx, cancel := context.WithCancel(context.Background())
+		s.stopRefresh = cancel
+
+		go s.refreshPolicyDomains(refreshCtx)
+	}
+
+	return nil
+}
+
+// stopPolicyRefresh stops the refresh of the policy domains.
+// The caller must hold the policyMu.
+func (s *Slot) stopPolicyRefresh() {
+	if s.stopRefresh != nil {
+		s.stopRefresh()
+		s.stopRefresh = nil
+	}
+}
+
+// refreshPolicyDomains periodically resolves the policy domains again, so the firewall follows their DNS changes.
+func (s *Slot) refreshPolicyDomains(ctx context.Context) {
+	ticker := time.NewTicker(policyDomainsRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.policyMu.Lock()
+		domains := policyDomains(s.policy)
+		previous := s.policyResolved
+		s.policyMu.Unlock()
+
+		resolved := resolveDomains(ctx, domains, previous)
+
+		s.policyMu.Lock()
+		// The policy could have been replaced or reset while resolving
+		if ctx.Err() != nil {
+			s.policyMu.Unlock()
+
+			return
+		}
+
+		s.policyResolved = resolved
+		err := s.applyPolicy()
+		s.policyMu.Unlock()
+		if err != nil {
+			zap.L().Error("failed to refresh network policy", zap.String("namespace_id", s.NamespaceID()), zap.Error(err))
+		}
+	}
+}
+
+// policyAddresses returns the allowed and denied addresses of the policy with its resolved domains.
+// The allowed addresses take precedence over the denied ones in the firewall, so when the internet access is disabled
+// on the node they are dropped, the policy can't open the sandbox to the internet.
+func policyAddresses(policy *orchestrator.SandboxNetworkPolicy, resolved map[string][]string, allowInternet bool) (allowed, denied []string) {
+	denied = slices.Clone(policy.GetDeniedCidrs())
+	for _, domain := range policy.GetDeniedDomains() {
+		denied = append(denied, resolved[domain]...)
+	}
+
+	if !allowInternet {
+		if len(policy.GetAllowedCidrs()) > 0 || len(policy.GetAllowedDomains()) > 0 {
+			zap.L().Warn("ignoring allowed addresses of the network policy, internet access is disabled")
+		}
+
+		return nil, append(denied, allInternetCIDR)
+	}
+
+	allowed = slices.Clone(policy.GetAllowedCidrs())
+	for _, domain := range policy.GetAllowedDomains() {
+		allowed = append(allowed, resolved[domain]...)
+	}
+
+	return allowed, denied
+}
+
+// applyPolicy sets the firewall of the slot to the current policy with the resolved domains.
+// The caller must hold the policyMu.
+func (s *Slot) applyPolicy() error {
+	allowed, denied := policyAddresses(s.policy, s.policyResolved, s.allowInternet)
+
+	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
+	if err != nil {
+		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
+	}
+	defer n.Close()
+
+	err = n.Do(func(_ ns.NetNS) error {
+		err := s.Firewall.SetPolicy(allowed, denied, s.policy.GetAllowedPorts(), s.policy.GetDeniedPorts())
+		if err != nil {
+			return fmt.Errorf("error setting firewall rules: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err)
+	}
+
+	return nil
+}
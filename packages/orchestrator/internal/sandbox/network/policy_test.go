@@ -0,0 +1,114 @@
+package network
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+)
+
+func TestParsePolicyCIDR(t *testing.T) {
+	prefix, err := ParsePolicyCIDR("1.2.3.4")
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("1.2.3.4/32"), prefix)
+
+	prefix, err = ParsePolicyCIDR("1.2.3.4/16")
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("1.2.0.0/16"), prefix)
+
+	_, err = ParsePolicyCIDR("::1")
+	assert.Error(t, err)
+
+	_, err = ParsePolicyCIDR("example.com")
+	assert.Error(t, err)
+}
+
+func TestMergePrefixes(t *testing.T) {
+	merged := mergePrefixes([]netip.Prefix{
+		netip.MustParsePrefix("10.1.0.0/16"),
+		netip.MustParsePrefix("8.8.8.8/32"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("8.8.8.8/32"),
+		netip.MustParsePrefix("11.0.0.0/8"),
+	})
+
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("8.8.8.8/32"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("11.0.0.0/8"),
+	}, merged)
+
+	merged = mergePrefixes([]netip.Prefix{
+		netip.MustParsePrefix("1.1.1.1/32"),
+		netip.MustParsePrefix("0.0.0.0/0"),
+	})
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")}, merged)
+}
+
+func TestWithoutInternalRanges(t *testing.T) {
+	filtered := withoutInternalRanges([]string{
+		"1.1.1.1",
+		"10.0.0.1",
+		"169.254.169.254/32",
+		"0.0.0.0/0",
+		"192.0.0.0/8",
+		"invalid",
+	})
+
+	assert.Equal(t, []string{"1.1.1.1", "invalid"}, filtered)
+}
+
+func TestValidatePolicy(t *testing.T) {
+	err := ValidatePolicy(&orchestrator.SandboxNetworkPolicy{
+		AllowedCidrs: []string{"1.1.1.1", "8.8.0.0/16"},
+		DeniedCidrs:  []string{"0.0.0.0/0"},
+		AllowedPorts: []uint32{443},
+	})
+	require.NoError(t, err)
+
+	err = ValidatePolicy(&orchestrator.SandboxNetworkPolicy{AllowedCidrs: []string{"0.0.0.0/0"}})
+	require.Error(t, err)
+
+	err = ValidatePolicy(&orchestrator.SandboxNetworkPolicy{DeniedCidrs: []string{"::/0"}})
+	require.Error(t, err)
+
+	err = ValidatePolicy(&orchestrator.SandboxNetworkPolicy{AllowedPorts: []uint32{70000}})
+	require.Error(t, err)
+}
+
+func TestPolicyAddresses(t *testing.T) {
+	policy := &orchestrator.SandboxNetworkPolicy{
+		AllowedCidrs:   []string{"1.1.1.1"},
+		AllowedDomains: []string{"allowed.example.com"},
+		DeniedCidrs:    []string{"0.0.0.0/0"},
+		DeniedDomains:  []string{"denied.example.com"},
+	}
+	resolved := map[string][]string{
+		"allowed.example.com": {"2.2.2.2/32"},
+		"denied.example.com":  {"3.3.3.3/32"},
+	}
+
+	t.Run("allows the policy addresses when internet is allowed", func(t *testing.T) {
+		allowed, denied := policyAddresses(policy, resolved, true)
+
+		assert.Equal(t, []string{"1.1.1.1", "2.2.2.2/32"}, allowed)
+		assert.Equal(t, []string{"0.0.0.0/0", "3.3.3.3/32"}, denied)
+	})
+
+	t.Run("drops the allowed addresses when internet is disabled", func(t *testing.T) {
+		allowed, denied := policyAddresses(policy, resolved, false)
+
+		assert.Empty(t, allowed)
+		assert.Equal(t, []string{"0.0.0.0/0", "3.3.3.3/32", allInternetCIDR}, denied)
+	})
+
+	t.Run("denies the internet without a policy when internet is disabled", func(t *testing.T) {
+		allowed, denied := policyAddresses(nil, nil, false)
+
+		assert.Empty(t, allowed)
+		assert.Equal(t, []string{allInternetCIDR}, denied)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
 
@@ -112,7 +113,7 @@ func (p *Pool) populate(ctx context.Context) error {
 	}
 }
 
-func (p *Pool) Get(ctx context.Context, tracer trace.Tracer, allowInternet bool) (*Slot, error) {
+func (p *Pool) Get(ctx context.Context, tracer trace.Tracer, allowInternet bool, policy *orchestrator.SandboxNetworkPolicy) (*Slot, error) {
 	var slot *Slot
 
 	select {
@@ -133,9 +134,12 @@ func (p *Pool) Get(ctx context.Context, tracer trace.Tracer, allowInternet bool)
 		}
 	}
 
-	err := slot.ConfigureInternet(ctx, tracer, allowInternet)
+	err := slot.ConfigureInternet(ctx, tracer, allowInternet, policy)
 	if err != nil {
-		return nil, fmt.Errorf("error setting slot internet access: %w", err)
+		// Return the slot to the pool, so the custom rules are reset
+		returnErr := p.Return(ctx, tracer, slot)
+
+		return nil, errors.Join(fmt.Errorf("error setting slot internet access: %w", err), returnErr)
 	}
 
 	return slot, nil
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net"
 	"path/filepath"
+	"sync"
 	"sync/atomic"
 
 	"github.com/containernetworking/plugins/pkg/ns"
@@ -14,6 +15,7 @@ import (
 	netutils "k8s.io/utils/net"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 )
 
 const (
@@ -59,6 +61,13 @@ type Slot struct {
 	// firewallCustomRules is used to track if custom firewall rules are set for the slot and need a cleanup.
 	firewallCustomRules atomic.Bool
 
+	// policyMu guards the egress policy of the slot and the refresh of its domains.
+	policyMu       sync.Mutex
+	allowInternet  bool
+	policy         *orchestrator.SandboxNetworkPolicy
+	policyResolved map[string][]string
+	stopRefresh    context.CancelFunc
+
 	vPeerIp net.IP
 	vEthIp  net.IP
 	vrtMask net.IPMask
@@ -234,39 +243,30 @@ func (s *Slot) CloseFirewall() error {
 	return nil
 }
 
-func (s *Slot) ConfigureInternet(ctx context.Context, tracer trace.Tracer, allowInternet bool) (e error) {
+func (s *Slot) ConfigureInternet(ctx context.Context, tracer trace.Tracer, allowInternet bool, policy *orchestrator.SandboxNetworkPolicy) (e error) {
 	_, span := tracer.Start(ctx, "slot-internet-configure", trace.WithAttributes(
 		attribute.String("namespace_id", s.NamespaceID()),
 		attribute.Bool("allow_internet", allowInternet),
 	))
 	defer span.End()
 
-	if allowInternet {
-		// Internet access is allowed by default.
-		return nil
+	if err := ValidatePolicy(policy); err != nil {
+		return err
 	}
 
-	s.firewallCustomRules.Store(true)
-
-	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
-	if err != nil {
-		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
-	}
-	defer n.Close()
+	s.policyMu.Lock()
+	defer s.policyMu.Unlock()
 
-	err = n.Do(func(_ ns.NetNS) error {
-		err = s.Firewall.AddBlockedIP("0.0.0.0/0")
-		if err != nil {
-			return fmt.Errorf("error setting firewall rules: %w", err)
-		}
+	if allowInternet && isEmptyPolicy(policy) {
+		// Internet access is allowed by default, keep it so the policy can be updated later.
+		s.allowInternet = true
 
 		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("failed execution in network namespace '%s': %w", s.NamespaceID(), err)
 	}
 
-	return nil
+	s.firewallCustomRules.Store(true)
+
+	return s.setPolicy(ctx, allowInternet, policy)
 }
 
 func (s *Slot) ResetInternet(ctx context.Context, tracer trace.Tracer) error {
@@ -279,6 +279,14 @@ func (s *Slot) ResetInternet(ctx context.Context, tracer trace.Tracer) error {
 		return nil
 	}
 
+	s.policyMu.Lock()
+	defer s.policyMu.Unlock()
+
+	s.stopPolicyRefresh()
+	s.allowInternet = false
+	s.policy = nil
+	s.policyResolved = nil
+
 	n, err := ns.GetNS(filepath.Join(netNamespacesDir, s.NamespaceID()))
 	if err != nil {
 		return fmt.Errorf("failed to get slot network namespace '%s': %w", s.NamespaceID(), err)
@@ -12,4 +12,7 @@ type Provider interface {
 	Close(ctx context.Context) error
 	Path() (string, error)
 	ExportDiff(ctx context.Context, out io.Writer, stopSandbox func(context.Context) error) (*header.DiffMetadata, error)
+	// ExportLiveDiff exports the diff without releasing the device, so the sandbox can continue running afterward.
+	// The VM must be paused while exporting.
+	ExportLiveDiff(ctx context.Context, out io.Writer) (*header.DiffMetadata, error)
 }
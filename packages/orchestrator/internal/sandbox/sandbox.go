@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -47,7 +49,10 @@ type Resources struct {
 }
 
 type Metadata struct {
-	Config    *orchestrator.SandboxConfig
+	// configMu guards the replacement of the config, use GetConfig and UpdateConfig when it can change concurrently.
+	configMu sync.RWMutex
+	Config   *orchestrator.SandboxConfig
+
 	StartedAt time.Time
 	EndAt     time.Time
 }
@@ -63,9 +68,28 @@ type Sandbox struct {
 
 	template template.Template
 
+	// snapshotMu serializes the pause and live snapshot of the sandbox.
+	snapshotMu sync.Mutex
+	// networkPolicyMu serializes the network policy updates.
+	networkPolicyMu sync.Mutex
+
+	// memoryReclaimedMiB is the memory held by the balloon device.
+	memoryReclaimedMiB atomic.Int64
+
 	Checks *Checks
 }
 
+func rateLimits(config *orchestrator.SandboxConfig) fc.RateLimits {
+	limits := config.GetRateLimits()
+
+	return fc.RateLimits{
+		NetworkBandwidth: limits.GetNetworkBandwidth(),
+		NetworkOps:       limits.GetNetworkOps(),
+		DiskBandwidth:    limits.GetDiskBandwidth(),
+		DiskOps:          limits.GetDiskOps(),
+	}
+}
+
 func (m *Metadata) LoggerMetadata() sbxlogger.SandboxMetadata {
 	return sbxlogger.SandboxMetadata{
 		SandboxID:  m.Config.SandboxId,
@@ -96,7 +120,7 @@ func CreateSandbox(
 
 	cleanup := NewCleanup()
 
-	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet)
+	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet, config.GetNetworkPolicy())
 	defer func() {
 		// Ensure the slot is received from chan so the slot is cleaned up properly in cleanup
 		<-ipsCh
@@ -180,6 +204,7 @@ func CreateSandbox(
 		config.Vcpu,
 		config.RamMb,
 		config.HugePages,
+		rateLimits(config),
 		processOptions,
 	)
 	if err != nil {
@@ -257,7 +282,7 @@ func ResumeSandbox(
 		return nil, cleanup, fmt.Errorf("failed to get template snapshot data: %w", err)
 	}
 
-	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet)
+	ipsCh := getNetworkSlotAsync(childCtx, tracer, networkPool, cleanup, allowInternet, config.GetNetworkPolicy())
 	defer func() {
 		// Ensure the slot is received from chan so the slot is cleaned up properly in cleanup
 		<-ipsCh
@@ -367,6 +392,7 @@ func ResumeSandbox(
 		fcUffdPath,
 		snapfile,
 		fcUffd.Ready(),
+		rateLimits(config),
 	)
 	if fcStartErr != nil {
 		return nil, cleanup, fmt.Errorf("failed to start FC: %w", fcStartErr)
@@ -421,6 +447,11 @@ func ResumeSandbox(
 		return nil, cleanup, fmt.Errorf("failed to wait for sandbox start: %w", err)
 	}
 
+	err = sbx.applyMemoryTarget(ctx, tracer)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to apply memory target: %w", err)
+	}
+
 	go sbx.Checks.Start()
 
 	return sbx, cleanup, nil
@@ -482,6 +513,9 @@ func (s *Sandbox) Pause(
 	childCtx, childSpan := tracer.Start(ctx, "sandbox-snapshot")
 	defer childSpan.End()
 
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
 	buildID, err := uuid.Parse(snapshotTemplateFiles.BuildId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse build id: %w", err)
@@ -578,6 +612,132 @@ func (s *Sandbox) Pause(
 	}, nil
 }
 
+// Snapshot creates a snapshot of the running sandbox the same way as Pause,
+// but the VM is resumed after the snapshot is taken and the sandbox keeps running.
+func (s *Sandbox) Snapshot(
+	ctx context.Context,
+	tracer trace.Tracer,
+	snapshotTemplateFiles *storage.TemplateCacheFiles,
+) (*Snapshot, error) {
+	childCtx, childSpan := tracer.Start(ctx, "sandbox-live-snapshot")
+	defer childSpan.End()
+
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
+	buildID, err := uuid.Parse(snapshotTemplateFiles.BuildId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse build id: %w", err)
+	}
+
+	if err := s.process.Pause(childCtx, tracer); err != nil {
+		return nil, fmt.Errorf("failed to pause VM: %w", err)
+	}
+
+	unpaused := false
+	unpause := func() error {
+		if unpaused {
+			return nil
+		}
+		unpaused = true
+
+		// Use a separate context, the sandbox has to be resumed even if the request was canceled.
+		unpauseCtx, cancel := context.WithTimeout(context.WithoutCancel(childCtx), defaultEnvdTimeout)
+		defer cancel()
+
+		return s.process.Unpause(unpauseCtx, tracer)
+	}
+	defer func() {
+		if err := unpause(); err != nil {
+			sbxlogger.I(s).Error("failed to resume VM after snapshot", zap.Error(err))
+		}
+	}()
+
+	// Pages requested by FC during the snapshot were not in the VM memory before,
+	// so they are served from the original memfile and are not part of the diff.
+	if err := s.memory.StartTracking(); err != nil {
+		return nil, fmt.Errorf("failed to start memory tracking: %w", err)
+	}
+
+	// Snapfile is not closed as it's returned and cached for later use (like resume)
+	snapfile := template.NewLocalFileLink(snapshotTemplateFiles.CacheSnapfilePath())
+	memfile, err := storage.AcquireTmpMemfile(childCtx, buildID.String())
+	if err != nil {
+		s.memory.StopTracking()
+
+		return nil, fmt.Errorf("failed to acquire memfile snapshot: %w", err)
+	}
+	// Close the file even if an error occurs
+	defer memfile.Close()
+
+	err = s.process.CreateSnapshot(
+		childCtx,
+		tracer,
+		snapfile.Path(),
+		memfile.Path(),
+	)
+	dirtyPages := s.memory.StopTracking()
+	if err != nil {
+		return nil, fmt.Errorf("error creating snapshot: %w", err)
+	}
+
+	originalMemfile, err := s.template.Memfile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original memfile: %w", err)
+	}
+	originalRootfs, err := s.template.Rootfs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get original rootfs: %w", err)
+	}
+
+	// The rootfs has to be exported while the VM is still paused to be consistent with the memory snapshot.
+	rootfsDiff, rootfsDiffHeader, err := pauseProcessRootfs(
+		childCtx,
+		tracer,
+		buildID,
+		originalRootfs.Header(),
+		&LiveRootfsDiffCreator{
+			rootfs: s.rootfs,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while post processing: %w", err)
+	}
+
+	if err := unpause(); err != nil {
+		return nil, fmt.Errorf("failed to resume VM: %w", err)
+	}
+
+	telemetry.ReportEvent(childCtx, "resumed VM after snapshot")
+
+	memfileDiff, memfileDiffHeader, err := pauseProcessMemory(
+		childCtx,
+		tracer,
+		buildID,
+		originalMemfile.Header(),
+		&MemoryDiffCreator{
+			tracer:     tracer,
+			memfile:    memfile,
+			dirtyPages: dirtyPages,
+			blockSize:  originalMemfile.BlockSize(),
+			doneHook: func(ctx context.Context) error {
+				return memfile.Close()
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while post processing: %w", err)
+	}
+
+	return &Snapshot{
+		Snapfile:          snapfile,
+		MemfileDiff:       memfileDiff,
+		MemfileDiffHeader: memfileDiffHeader,
+		RootfsDiff:        rootfsDiff,
+		RootfsDiffHeader:  rootfsDiffHeader,
+	}, nil
+}
+
 type Snapshot struct {
 	MemfileDiff       build.Diff
 	MemfileDiffHeader *header.Header
@@ -723,6 +883,7 @@ func getNetworkSlotAsync(
 	networkPool *network.Pool,
 	cleanup *Cleanup,
 	allowInternet bool,
+	networkPolicy *orchestrator.SandboxNetworkPolicy,
 ) chan networkSlotRes {
 	networkCtx, networkSpan := tracer.Start(ctx, "get-network-slot")
 	defer networkSpan.End()
@@ -732,7 +893,7 @@ func getNetworkSlotAsync(
 	go func() {
 		defer close(r)
 
-		ips, err := networkPool.Get(networkCtx, tracer, allowInternet)
+		ips, err := networkPool.Get(networkCtx, tracer, allowInternet, networkPolicy)
 		if err != nil {
 			r <- networkSlotRes{nil, fmt.Errorf("failed to get network slot: %w", err)}
 			return
@@ -161,3 +161,17 @@ func (c *Cache) AddSnapshot(
 
 	return nil
 }
+
+// RemoveSnapshot removes the snapshot added by AddSnapshot from the cache, the local files of the snapshot are deleted with it.
+func (c *Cache) RemoveSnapshot(files *storage.TemplateFiles, memfileDiff build.Diff, rootfsDiff build.Diff) {
+	c.cache.Delete(files.CacheKey())
+
+	for _, diff := range []build.Diff{memfileDiff, rootfsDiff} {
+		switch diff.(type) {
+		case *build.NoDiff:
+			continue
+		default:
+			c.buildStore.Remove(diff)
+		}
+	}
+}
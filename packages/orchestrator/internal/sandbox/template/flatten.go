@@ -0,0 +1,91 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+// flattenChunkSize is a multiple of both the rootfs and the memfile (huge page) block size.
+const flattenChunkSize = 4 * 1024 * 1024
+
+// Flatten copies the data of all the layers of the device to the out writer,
+// so the new build doesn't depend on the builds the device was created from.
+// The empty blocks aren't copied, the returned header maps the rest of the device to the new build.
+func Flatten(ctx context.Context, device block.ReadonlyDevice, buildID uuid.UUID, out io.Writer) (*header.Header, error) {
+	source := device.Header()
+
+	mappings := make([]*header.BuildMap, 0, len(source.Mapping))
+	buf := make([]byte, flattenChunkSize)
+
+	var storageOffset uint64
+	for _, m := range source.Mapping {
+		if m.BuildId == uuid.Nil {
+			mappings = appendMapping(mappings, &header.BuildMap{
+				Offset:  m.Offset,
+				Length:  m.Length,
+				BuildId: uuid.Nil,
+			})
+
+			continue
+		}
+
+		for off := m.Offset; off < m.Offset+m.Length; off += flattenChunkSize {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			chunk := buf[:min(flattenChunkSize, m.Offset+m.Length-off)]
+
+			_, err := device.ReadAt(chunk, int64(off))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read data at %d: %w", off, err)
+			}
+
+			_, err = out.Write(chunk)
+			if err != nil {
+				return nil, fmt.Errorf("failed to write data at %d: %w", off, err)
+			}
+		}
+
+		mappings = appendMapping(mappings, &header.BuildMap{
+			Offset:             m.Offset,
+			Length:             m.Length,
+			BuildId:            buildID,
+			BuildStorageOffset: storageOffset,
+		})
+
+		storageOffset += m.Length
+	}
+
+	// The base build is kept, the snapfile references the rootfs of the base build.
+	metadata := &header.Metadata{
+		Version:     source.Metadata.Version,
+		BlockSize:   source.Metadata.BlockSize,
+		Size:        source.Metadata.Size,
+		Generation:  0,
+		BuildId:     buildID,
+		BaseBuildId: source.Metadata.BaseBuildId,
+	}
+
+	return header.NewHeader(metadata, mappings), nil
+}
+
+// appendMapping merges the mapping with the previous one when they are adjacent and map to the same build.
+func appendMapping(mappings []*header.BuildMap, m *header.BuildMap) []*header.BuildMap {
+	if len(mappings) > 0 {
+		last := mappings[len(mappings)-1]
+		if last.BuildId == m.BuildId && (m.BuildId == uuid.Nil || last.BuildStorageOffset+last.Length == m.BuildStorageOffset) {
+			last.Length += m.Length
+
+			return mappings
+		}
+	}
+
+	return append(mappings, m)
+}
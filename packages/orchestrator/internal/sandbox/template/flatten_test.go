@@ -0,0 +1,99 @@
+package template
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+)
+
+const testBlockSize = 4096
+
+// fakeDevice returns the byte of the offset divided by the block size for each of the offsets.
+type fakeDevice struct {
+	header *header.Header
+}
+
+func (d *fakeDevice) ReadAt(p []byte, off int64) (int, error) {
+	for i := range p {
+		p[i] = byte((off + int64(i)) / testBlockSize)
+	}
+
+	return len(p), nil
+}
+
+func (d *fakeDevice) Close() error {
+	return nil
+}
+
+func (d *fakeDevice) Slice(off, length int64) ([]byte, error) {
+	p := make([]byte, length)
+	_, err := d.ReadAt(p, off)
+
+	return p, err
+}
+
+func (d *fakeDevice) Size() (int64, error) {
+	return int64(d.header.Metadata.Size), nil
+}
+
+func (d *fakeDevice) BlockSize() int64 {
+	return testBlockSize
+}
+
+func (d *fakeDevice) Header() *header.Header {
+	return d.header
+}
+
+func TestFlatten(t *testing.T) {
+	baseBuildID := uuid.New()
+	diffBuildID := uuid.New()
+	newBuildID := uuid.New()
+
+	metadata := header.NewTemplateMetadata(baseBuildID, testBlockSize, 5*testBlockSize).NextGeneration(diffBuildID)
+	device := &fakeDevice{header: header.NewHeader(metadata, []*header.BuildMap{
+		{Offset: 0, Length: testBlockSize, BuildId: baseBuildID, BuildStorageOffset: 0},
+		{Offset: testBlockSize, Length: testBlockSize, BuildId: diffBuildID, BuildStorageOffset: 0},
+		{Offset: 2 * testBlockSize, Length: testBlockSize, BuildId: uuid.Nil},
+		{Offset: 3 * testBlockSize, Length: testBlockSize, BuildId: uuid.Nil},
+		{Offset: 4 * testBlockSize, Length: testBlockSize, BuildId: baseBuildID, BuildStorageOffset: 4 * testBlockSize},
+	})}
+
+	var out bytes.Buffer
+	h, err := Flatten(context.Background(), device, newBuildID, &out)
+	require.NoError(t, err)
+
+	assert.Equal(t, newBuildID, h.Metadata.BuildId)
+	assert.Equal(t, baseBuildID, h.Metadata.BaseBuildId)
+	assert.Equal(t, uint64(0), h.Metadata.Generation)
+	assert.Equal(t, metadata.Size, h.Metadata.Size)
+
+	assert.Equal(t, []*header.BuildMap{
+		{Offset: 0, Length: 2 * testBlockSize, BuildId: newBuildID, BuildStorageOffset: 0},
+		{Offset: 2 * testBlockSize, Length: 2 * testBlockSize, BuildId: uuid.Nil},
+		{Offset: 4 * testBlockSize, Length: testBlockSize, BuildId: newBuildID, BuildStorageOffset: 2 * testBlockSize},
+	}, h.Mapping)
+
+	// The empty blocks aren't stored
+	data := out.Bytes()
+	require.Len(t, data, 3*testBlockSize)
+	assert.Equal(t, byte(0), data[0])
+	assert.Equal(t, byte(1), data[testBlockSize])
+	assert.Equal(t, byte(4), data[2*testBlockSize])
+}
+
+func TestFlattenCancelled(t *testing.T) {
+	buildID := uuid.New()
+	device := &fakeDevice{header: header.NewHeader(header.NewTemplateMetadata(buildID, testBlockSize, testBlockSize), nil)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Flatten(ctx, device, uuid.New(), &bytes.Buffer{})
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -55,6 +55,14 @@ func (u *Uffd) Dirty() *bitset.BitSet {
 	return u.memfile.Dirty()
 }
 
+func (u *Uffd) StartTracking() error {
+	return u.memfile.StartTracking()
+}
+
+func (u *Uffd) StopTracking() *bitset.BitSet {
+	return u.memfile.StopTracking()
+}
+
 func New(memfile block.ReadonlyDevice, socketPath string, blockSize int64) (*Uffd, error) {
 	pRead, pWrite, err := os.Pipe()
 	if err != nil {
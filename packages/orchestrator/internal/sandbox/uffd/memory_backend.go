@@ -6,6 +6,9 @@ type MemoryBackend interface {
 	Disable() error
 	Dirty() *bitset.BitSet
 
+	StartTracking() error
+	StopTracking() *bitset.BitSet
+
 	Start(sandboxId string) error
 	Stop() error
 	Ready() chan struct{}
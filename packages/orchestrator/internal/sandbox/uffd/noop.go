@@ -34,6 +34,14 @@ func (m *NoopMemory) Dirty() *bitset.BitSet {
 	return m.dirty
 }
 
+func (m *NoopMemory) StartTracking() error {
+	return nil
+}
+
+func (m *NoopMemory) StopTracking() *bitset.BitSet {
+	return m.dirty.Clone()
+}
+
 func (m *NoopMemory) Start(sandboxId string) error {
 	return nil
 }
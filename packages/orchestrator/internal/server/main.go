@@ -21,6 +21,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
 type server struct {
@@ -36,6 +37,8 @@ type server struct {
 	devicePool    *nbd.DevicePool
 	persistence   storage.StorageProvider
 	featureFlags  *featureflags.Client
+	// uploads tracks the uploads of the live snapshots taken by Fork and Checkpoint by build ID.
+	uploads *smap.Map[*utils.SetOnce[struct{}]]
 }
 
 type Service struct {
@@ -89,6 +92,7 @@ func New(
 		devicePool:    devicePool,
 		persistence:   persistence,
 		featureFlags:  featureFlags,
+		uploads:       smap.New[*utils.SetOnce[struct{}]](),
 	}
 
 	meter := tel.MeterProvider.Meter("orchestrator.sandbox")
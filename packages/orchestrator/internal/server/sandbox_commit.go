@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/block"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/template"
+	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
+	"github.com/e2b-dev/infra/packages/shared/pkg/storage/header"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+// Commit stores the snapshot of the running sandbox or of the paused sandbox as a build of a new template.
+// The layers of the snapshot are flattened, so the new template doesn't depend on the builds the sandbox was started from.
+func (s *server) Commit(ctx context.Context, in *orchestrator.SandboxCommitRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-commit")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		telemetry.WithTemplateID(in.TemplateId),
+		telemetry.WithBuildID(in.BuildId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	buildID, err := uuid.Parse(in.BuildId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid build id: %s", err)
+	}
+
+	kernelVersion := in.KernelVersion
+	firecrackerVersion := in.FirecrackerVersion
+
+	var source template.Template
+	if in.SandboxId != "" {
+		sbx, ok := s.sandboxes.Get(in.SandboxId)
+		if !ok {
+			telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+			return nil, status.Error(codes.NotFound, "sandbox not found")
+		}
+
+		kernelVersion = sbx.Config.KernelVersion
+		firecrackerVersion = sbx.Config.FirecrackerVersion
+
+		// The live snapshot is only kept in the local template cache, the flattened build is uploaded instead.
+		snapshot, snapshotTemplateFiles, err := s.addSandboxSnapshot(ctx, sbx, in.TemplateId, uuid.NewString())
+		if err != nil {
+			return nil, err
+		}
+
+		// Nothing is started from the snapshot, it's removed once the flattened build is uploaded
+		defer s.templateCache.RemoveSnapshot(snapshotTemplateFiles.TemplateFiles, snapshot.MemfileDiff, snapshot.RootfsDiff)
+
+		source, err = s.templateCache.GetTemplate(
+			snapshotTemplateFiles.TemplateId,
+			snapshotTemplateFiles.BuildId,
+			kernelVersion,
+			firecrackerVersion,
+		)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "error getting sandbox snapshot", err)
+
+			return nil, status.Errorf(codes.Internal, "error getting sandbox snapshot: %s", err)
+		}
+	} else {
+		source, err = s.templateCache.GetTemplate(
+			in.SourceTemplateId,
+			in.SourceBuildId,
+			kernelVersion,
+			firecrackerVersion,
+		)
+		if err != nil {
+			telemetry.ReportCriticalError(ctx, "error getting sandbox snapshot", err)
+
+			return nil, status.Errorf(codes.Internal, "error getting sandbox snapshot: %s", err)
+		}
+	}
+
+	templateFiles, err := storage.NewTemplateFiles(
+		in.TemplateId,
+		in.BuildId,
+		kernelVersion,
+		firecrackerVersion,
+	).NewTemplateCacheFiles()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating template files", err)
+
+		return nil, status.Errorf(codes.Internal, "error creating template files: %s", err)
+	}
+
+	err = s.uploadFlattenedTemplate(ctx, source, buildID, templateFiles)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error committing sandbox snapshot", err)
+
+		return nil, status.Errorf(codes.Internal, "error committing sandbox snapshot: %s", err)
+	}
+
+	zap.L().Info("Committed sandbox snapshot to template",
+		logger.WithSandboxID(in.SandboxId),
+		logger.WithTemplateID(in.TemplateId),
+		logger.WithBuildID(in.BuildId),
+	)
+
+	return &emptypb.Empty{}, nil
+}
+
+// uploadFlattenedTemplate flattens the memfile and the rootfs of the source template and uploads them with the snapfile as the new build.
+func (s *server) uploadFlattenedTemplate(ctx context.Context, source template.Template, buildID uuid.UUID, files *storage.TemplateCacheFiles) error {
+	memfile, err := source.Memfile()
+	if err != nil {
+		return fmt.Errorf("failed to get memfile: %w", err)
+	}
+
+	rootfs, err := source.Rootfs()
+	if err != nil {
+		return fmt.Errorf("failed to get rootfs: %w", err)
+	}
+
+	snapfile, err := source.Snapfile()
+	if err != nil {
+		return fmt.Errorf("failed to get snapfile: %w", err)
+	}
+
+	memfilePath := files.CacheMemfilePath()
+	defer os.Remove(memfilePath)
+
+	memfileHeader, err := flattenToFile(ctx, memfile, buildID, memfilePath)
+	if err != nil {
+		return fmt.Errorf("failed to flatten memfile: %w", err)
+	}
+
+	telemetry.ReportEvent(ctx, "flattened memfile")
+
+	rootfsPath := files.CacheRootfsPath()
+	defer os.Remove(rootfsPath)
+
+	rootfsHeader, err := flattenToFile(ctx, rootfs, buildID, rootfsPath)
+	if err != nil {
+		return fmt.Errorf("failed to flatten rootfs: %w", err)
+	}
+
+	telemetry.ReportEvent(ctx, "flattened rootfs")
+
+	err = <-storage.NewTemplateBuild(
+		memfileHeader,
+		rootfsHeader,
+		s.persistence,
+		files.TemplateFiles,
+	).Upload(
+		ctx,
+		snapfile.Path(),
+		&memfilePath,
+		&rootfsPath,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upload template: %w", err)
+	}
+
+	telemetry.ReportEvent(ctx, "uploaded flattened template")
+
+	return nil
+}
+
+func flattenToFile(ctx context.Context, device block.ReadonlyDevice, buildID uuid.UUID, path string) (h *header.Header, e error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file: %w", err)
+	}
+
+	defer func() {
+		e = errors.Join(e, f.Close())
+	}()
+
+	return template.Flatten(ctx, device, buildID, f)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/launchdarkly/go-sdk-common/v3/ldcontext"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -18,16 +19,21 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/config"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/build"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/sandbox/network"
 	featureflags "github.com/e2b-dev/infra/packages/shared/pkg/feature-flags"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	sbxlogger "github.com/e2b-dev/infra/packages/shared/pkg/logger/sandbox"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+	"github.com/e2b-dev/infra/packages/shared/pkg/utils"
 )
 
 const (
 	requestTimeout = 60 * time.Second
+
+	// uploadResultExpiration is how long the result of the live snapshot upload is kept for WaitForUpload.
+	uploadResultExpiration = 10 * time.Minute
 )
 
 func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreateRequest) (*orchestrator.SandboxCreateResponse, error) {
@@ -50,6 +56,37 @@ func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreate
 		req.Sandbox.ExecutionId = uuid.New().String()
 	}
 
+	if err := network.ValidatePolicy(req.Sandbox.GetNetworkPolicy()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid network policy: %s", err)
+	}
+
+	if err := validateMemoryTarget(req.Sandbox.GetMemoryTargetMb(), req.Sandbox.GetRamMb()); err != nil {
+		return nil, err
+	}
+
+	sbx, err := s.startSandbox(childCtx, req, childSpan.SpanContext().TraceID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &orchestrator.SandboxCreateResponse{
+		ClientId:       s.info.ClientId,
+		MemoryTargetMb: sbx.GetConfig().GetMemoryTargetMb(),
+	}, nil
+}
+
+// validateMemoryTarget checks the memory target fits the memory of the snapshot, the balloon can only reclaim memory.
+func validateMemoryTarget(memoryTargetMB, ramMB int64) error {
+	if memoryTargetMB < 0 || memoryTargetMB > ramMB {
+		return status.Errorf(codes.InvalidArgument, "memory target %d MiB is out of range (0-%d MiB)", memoryTargetMB, ramMB)
+	}
+
+	return nil
+}
+
+// startSandbox resumes the sandbox from the template cache and registers it in the sandboxes map.
+// The sandbox is cleaned up and removed from the map when it exits.
+func (s *server) startSandbox(ctx context.Context, req *orchestrator.SandboxCreateRequest, traceID string) (*sandbox.Sandbox, error) {
 	flagCtx := ldcontext.NewBuilder(featureflags.MetricsWriteFlagName).SetString("sandbox_id", req.Sandbox.SandboxId).Build()
 	metricsWriteFlag, flagErr := s.featureFlags.Ld.BoolVariation(featureflags.MetricsWriteFlagName, flagCtx, featureflags.MetricsWriteDefault)
 	if flagErr != nil {
@@ -57,12 +94,12 @@ func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreate
 	}
 
 	sbx, cleanup, err := sandbox.ResumeSandbox(
-		childCtx,
+		ctx,
 		s.tracer,
 		s.networkPool,
 		s.templateCache,
 		req.Sandbox,
-		childSpan.SpanContext().TraceID().String(),
+		traceID,
 		req.StartTime.AsTime(),
 		req.EndTime.AsTime(),
 		req.Sandbox.BaseTemplateId,
@@ -98,7 +135,8 @@ func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreate
 		// Remove the sandbox from cache only if the cleanup IDs match.
 		// This prevents us from accidentally removing started sandbox (via resume) from the cache if cleanup is taking longer than the request timeout.
 		// This could have caused the "invisible" sandboxes that are not in orchestrator or API, but are still on client.
-		s.sandboxes.RemoveCb(req.Sandbox.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
+		// The sandbox ID changes when a pooled sandbox is claimed, the config holds the current one.
+		s.sandboxes.RemoveCb(sbx.Config.SandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
 			if !exists {
 				return false
 			}
@@ -116,11 +154,94 @@ func (s *server) Create(ctxConn context.Context, req *orchestrator.SandboxCreate
 		sbxlogger.E(sbx).Info("Sandbox killed")
 	}()
 
+	return sbx, nil
+}
+
+// Claim assigns a pooled sandbox to the sandbox config from the request.
+// The pooled sandbox is registered under the new sandbox ID, the old ID is no longer reachable.
+func (s *server) Claim(ctxConn context.Context, req *orchestrator.SandboxClaimRequest) (*orchestrator.SandboxCreateResponse, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	defer cancel()
+
+	childCtx, childSpan := s.tracer.Start(ctx, "sandbox-claim")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithTemplateID(req.Sandbox.TemplateId),
+		telemetry.WithSandboxID(req.Sandbox.SandboxId),
+		attribute.String("pooled_sandbox.id", req.PooledSandboxId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	sbx, ok := s.sandboxes.Get(req.PooledSandboxId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "pooled sandbox '%s' not found", req.PooledSandboxId)
+	}
+
+	if !sbx.Config.GetPooled() {
+		return nil, status.Errorf(codes.FailedPrecondition, "sandbox '%s' is not pooled", req.PooledSandboxId)
+	}
+
+	if !pooledMatches(sbx.Config, req.Sandbox) {
+		return nil, status.Errorf(codes.FailedPrecondition, "pooled sandbox '%s' doesn't match the sandbox config", req.PooledSandboxId)
+	}
+
+	if err := network.ValidatePolicy(req.Sandbox.GetNetworkPolicy()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid network policy: %s", err)
+	}
+
+	if err := validateMemoryTarget(req.Sandbox.GetMemoryTargetMb(), sbx.Config.GetRamMb()); err != nil {
+		return nil, err
+	}
+
+	// Take the pooled sandbox out of the map first, so it can't be claimed twice.
+	claimed := s.sandboxes.RemoveCb(req.PooledSandboxId, func(_ string, v *sandbox.Sandbox, exists bool) bool {
+		return exists && v == sbx
+	})
+	if !claimed {
+		return nil, status.Errorf(codes.NotFound, "pooled sandbox '%s' was already claimed", req.PooledSandboxId)
+	}
+
+	err := sbx.Claim(
+		childCtx,
+		s.tracer,
+		req.Sandbox,
+		req.StartTime.AsTime(),
+		req.EndTime.AsTime(),
+		childSpan.SpanContext().TraceID().String(),
+	)
+	if err != nil {
+		sbxlogger.I(sbx).Error("failed to claim pooled sandbox, stopping it", zap.Error(err))
+
+		stopErr := sbx.Stop(ctx)
+		if stopErr != nil {
+			sbxlogger.I(sbx).Error("error stopping pooled sandbox", zap.Error(stopErr))
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to claim pooled sandbox: %s", err)
+	}
+
+	s.sandboxes.Insert(req.Sandbox.SandboxId, sbx)
+
 	return &orchestrator.SandboxCreateResponse{
-		ClientId: s.info.ClientId,
+		ClientId:       s.info.ClientId,
+		MemoryTargetMb: sbx.GetConfig().GetMemoryTargetMb(),
 	}, nil
 }
 
+// pooledMatches checks the pooled sandbox can be claimed for the config, the fields fixed when the VM is started must be the same.
+func pooledMatches(pooled, config *orchestrator.SandboxConfig) bool {
+	return pooled.GetTemplateId() == config.GetTemplateId() &&
+		pooled.GetBuildId() == config.GetBuildId() &&
+		pooled.GetTeamId() == config.GetTeamId() &&
+		pooled.GetVcpu() == config.GetVcpu() &&
+		pooled.GetRamMb() == config.GetRamMb() &&
+		pooled.GetHugePages() == config.GetHugePages() &&
+		pooled.GetKernelVersion() == config.GetKernelVersion() &&
+		pooled.GetFirecrackerVersion() == config.GetFirecrackerVersion() &&
+		pooled.GetEnvdVersion() == config.GetEnvdVersion()
+}
+
 func (s *server) Update(ctx context.Context, req *orchestrator.SandboxUpdateRequest) (*emptypb.Empty, error) {
 	ctx, childSpan := s.tracer.Start(ctx, "sandbox-update")
 	defer childSpan.End()
@@ -155,15 +276,18 @@ func (s *server) List(ctx context.Context, _ *emptypb.Empty) (*orchestrator.Sand
 			continue
 		}
 
-		if sbx.Config == nil {
+		config := sbx.GetConfig()
+		if config == nil {
 			continue
 		}
 
 		sandboxes = append(sandboxes, &orchestrator.RunningSandbox{
-			Config:    sbx.Config,
+			Config:    config,
 			ClientId:  s.info.ClientId,
 			StartTime: timestamppb.New(sbx.StartedAt),
 			EndTime:   timestamppb.New(sbx.EndAt),
+
+			MemoryReclaimedMb: sbx.MemoryReclaimedMiB(),
 		})
 	}
 
@@ -280,58 +404,367 @@ func (s *server) Pause(ctx context.Context, in *orchestrator.SandboxPauseRequest
 
 	telemetry.ReportEvent(ctx, "added snapshot to template cache")
 
-	go func() {
-		var memfilePath *string
+	// The paused sandbox can be resumed on the other nodes only after the upload, the migration waits for it.
+	s.trackUpload(in.BuildId, func() error {
+		return s.uploadSnapshot(sbx, snapshot, snapshotTemplateFiles)
+	})
 
-		switch r := snapshot.MemfileDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			memfileLocalPath, err := r.CachePath()
-			if err != nil {
-				sbxlogger.I(sbx).Error("error getting memfile diff path", zap.Error(err))
+	return &emptypb.Empty{}, nil
+}
 
-				return
-			}
+func (s *server) Fork(ctxConn context.Context, in *orchestrator.SandboxForkRequest) (*orchestrator.SandboxForkResponse, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	defer cancel()
 
-			memfilePath = &memfileLocalPath
-		}
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-fork")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		telemetry.WithBuildID(in.BuildId),
+		attribute.String("client.id", s.info.ClientId),
+		attribute.Int("fork.children", len(in.Children)),
+	)
+
+	if len(in.Children) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one child sandbox is required")
+	}
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	err := s.snapshotSandbox(ctx, sbx, in.TemplateId, in.BuildId)
+	if err != nil {
+		return nil, err
+	}
 
-		var rootfsPath *string
+	children := make([]*sandbox.Sandbox, len(in.Children))
 
-		switch r := snapshot.RootfsDiff.(type) {
-		case *build.NoDiff:
-			break
-		default:
-			rootfsLocalPath, err := r.CachePath()
+	var g errgroup.Group
+	for i, child := range in.Children {
+		g.Go(func() error {
+			childSbx, err := s.startSandbox(ctx, child, childSpan.SpanContext().TraceID().String())
 			if err != nil {
-				sbxlogger.I(sbx).Error("error getting rootfs diff path", zap.Error(err))
+				return err
+			}
+
+			children[i] = childSbx
+
+			return nil
+		})
+	}
 
-				return
+	err = g.Wait()
+	if err != nil {
+		// The fork is all or nothing, stop the children that were already started.
+		for _, childSbx := range children {
+			if childSbx == nil {
+				continue
 			}
 
-			rootfsPath = &rootfsLocalPath
+			s.sandboxes.Remove(childSbx.Config.SandboxId)
+
+			stopErr := childSbx.Stop(ctx)
+			if stopErr != nil {
+				sbxlogger.I(childSbx).Error("error stopping forked sandbox", zap.Error(stopErr))
+			}
 		}
 
-		b := storage.NewTemplateBuild(
-			snapshot.MemfileDiffHeader,
-			snapshot.RootfsDiffHeader,
-			s.persistence,
-			snapshotTemplateFiles.TemplateFiles,
-		)
-
-		err = <-b.Upload(
-			context.Background(),
-			snapshot.Snapfile.Path(),
-			memfilePath,
-			rootfsPath,
-		)
-		if err != nil {
-			sbxlogger.I(sbx).Error("error uploading sandbox snapshot", zap.Error(err))
+		telemetry.ReportCriticalError(ctx, "error starting forked sandboxes", err, telemetry.WithSandboxID(in.SandboxId))
+
+		return nil, err
+	}
+
+	telemetry.ReportEvent(ctx, "started forked sandboxes")
+
+	return &orchestrator.SandboxForkResponse{
+		ClientId: s.info.ClientId,
+	}, nil
+}
+
+func (s *server) Checkpoint(ctxConn context.Context, in *orchestrator.SandboxCheckpointRequest) (*emptypb.Empty, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-checkpoint")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		telemetry.WithBuildID(in.BuildId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	err := s.snapshotSandbox(ctx, sbx, in.TemplateId, in.BuildId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) SetMemoryTarget(ctxConn context.Context, in *orchestrator.SandboxSetMemoryTargetRequest) (*emptypb.Empty, error) {
+	ctx, cancel := context.WithTimeoutCause(ctxConn, requestTimeout, fmt.Errorf("request timed out"))
+	defer cancel()
+
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-set-memory-target")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		attribute.Int64("memory.target_mb", in.MemoryMb),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	if in.MemoryMb <= 0 || in.MemoryMb > sbx.Config.RamMb {
+		return nil, status.Errorf(codes.InvalidArgument, "memory target must be between 1 and %d MiB", sbx.Config.RamMb)
+	}
+
+	err := sbx.SetMemoryTarget(ctx, s.tracer, in.MemoryMb)
+	if errors.Is(err, sandbox.ErrBalloonNotAttached) {
+		return nil, status.Errorf(codes.FailedPrecondition, "sandbox '%s' doesn't support memory ballooning, rebuild its template to enable it", in.SandboxId)
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error setting memory target", err)
+
+		return nil, status.Errorf(codes.Internal, "error setting memory target: %s", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) UpdateNetworkPolicy(ctx context.Context, in *orchestrator.SandboxUpdateNetworkPolicyRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-update-network-policy")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
 
-			return
+	if err := network.ValidatePolicy(in.NetworkPolicy); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid network policy: %s", err)
+	}
+
+	err := sbx.UpdateNetworkPolicy(ctx, s.tracer, in.NetworkPolicy)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error updating network policy", err)
+
+		return nil, status.Errorf(codes.Internal, "error updating network policy: %s", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) UpdateMetadata(ctx context.Context, in *orchestrator.SandboxUpdateMetadataRequest) (*emptypb.Empty, error) {
+	_, childSpan := s.tracer.Start(ctx, "sandbox-update-metadata")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithSandboxID(in.SandboxId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	sbx, ok := s.sandboxes.Get(in.SandboxId)
+	if !ok {
+		telemetry.ReportCriticalError(ctx, "sandbox not found", nil)
+
+		return nil, status.Error(codes.NotFound, "sandbox not found")
+	}
+
+	// The metadata is kept in the config, so it is reported to the API when it syncs the sandboxes
+	sbx.UpdateConfig(func(config *orchestrator.SandboxConfig) {
+		config.Metadata = in.Metadata
+	})
+
+	return &emptypb.Empty{}, nil
+}
+
+// snapshotSandbox takes a live snapshot of the running sandbox, adds it to the template cache and uploads it in the background.
+func (s *server) snapshotSandbox(ctx context.Context, sbx *sandbox.Sandbox, templateID, buildID string) error {
+	snapshot, snapshotTemplateFiles, err := s.addSandboxSnapshot(ctx, sbx, templateID, buildID)
+	if err != nil {
+		return err
+	}
+
+	// The snapshot is uploaded so the sandboxes started from it can be paused and resumed on other nodes later.
+	s.trackUpload(buildID, func() error {
+		return s.uploadSnapshot(sbx, snapshot, snapshotTemplateFiles)
+	})
+
+	return nil
+}
+
+// trackUpload runs the upload of the build in the background, its result can be waited for with WaitForUpload.
+func (s *server) trackUpload(buildID string, uploadFn func() error) {
+	upload := utils.NewSetOnce[struct{}]()
+	s.uploads.Insert(buildID, upload)
+
+	go func() {
+		err := uploadFn()
+		if err != nil {
+			upload.SetError(err)
+		} else {
+			upload.SetValue(struct{}{})
 		}
+
+		time.AfterFunc(uploadResultExpiration, func() {
+			s.uploads.Remove(buildID)
+		})
 	}()
+}
+
+// WaitForUpload waits until the snapshot taken by Pause, Fork or Checkpoint is uploaded,
+// the build can be used on the other nodes only after that.
+func (s *server) WaitForUpload(ctx context.Context, in *orchestrator.SandboxWaitForUploadRequest) (*emptypb.Empty, error) {
+	ctx, childSpan := s.tracer.Start(ctx, "sandbox-wait-for-upload")
+	defer childSpan.End()
+
+	childSpan.SetAttributes(
+		telemetry.WithBuildID(in.BuildId),
+		attribute.String("client.id", s.info.ClientId),
+	)
+
+	upload, ok := s.uploads.Get(in.BuildId)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "upload not found")
+	}
+
+	_, err := upload.WaitWithContext(ctx)
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error uploading snapshot", err)
+
+		return nil, status.Errorf(codes.Internal, "error uploading snapshot: %s", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// addSandboxSnapshot takes a live snapshot of the sandbox and adds it to the template cache.
+func (s *server) addSandboxSnapshot(ctx context.Context, sbx *sandbox.Sandbox, templateID, buildID string) (*sandbox.Snapshot, *storage.TemplateCacheFiles, error) {
+	snapshotTemplateFiles, err := storage.NewTemplateFiles(
+		templateID,
+		buildID,
+		sbx.Config.KernelVersion,
+		sbx.Config.FirecrackerVersion,
+	).NewTemplateCacheFiles()
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error creating template files", err)
+
+		return nil, nil, status.Errorf(codes.Internal, "error creating template files: %s", err)
+	}
+
+	snapshot, err := sbx.Snapshot(ctx, s.tracer, snapshotTemplateFiles)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error snapshotting sandbox", err, telemetry.WithSandboxID(sbx.Config.SandboxId))
+
+		return nil, nil, status.Errorf(codes.Internal, "error snapshotting sandbox '%s': %s", sbx.Config.SandboxId, err)
+	}
+
+	err = s.templateCache.AddSnapshot(
+		snapshotTemplateFiles.TemplateId,
+		snapshotTemplateFiles.BuildId,
+		snapshotTemplateFiles.KernelVersion,
+		snapshotTemplateFiles.FirecrackerVersion,
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		snapshot.Snapfile,
+		snapshot.MemfileDiff,
+		snapshot.RootfsDiff,
+	)
+	if err != nil {
+		telemetry.ReportCriticalError(ctx, "error adding snapshot to template cache", err)
+
+		return nil, nil, status.Errorf(codes.Internal, "error adding snapshot to template cache: %s", err)
+	}
+
+	telemetry.ReportEvent(ctx, "added snapshot to template cache")
+
+	return snapshot, snapshotTemplateFiles, nil
+}
+
+// uploadSnapshot uploads the snapshot files to the persistent storage.
+func (s *server) uploadSnapshot(sbx *sandbox.Sandbox, snapshot *sandbox.Snapshot, snapshotTemplateFiles *storage.TemplateCacheFiles) error {
+	var memfilePath *string
+
+	switch r := snapshot.MemfileDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		memfileLocalPath, err := r.CachePath()
+		if err != nil {
+			sbxlogger.I(sbx).Error("error getting memfile diff path", zap.Error(err))
+
+			return err
+		}
+
+		memfilePath = &memfileLocalPath
+	}
+
+	var rootfsPath *string
+
+	switch r := snapshot.RootfsDiff.(type) {
+	case *build.NoDiff:
+		break
+	default:
+		rootfsLocalPath, err := r.CachePath()
+		if err != nil {
+			sbxlogger.I(sbx).Error("error getting rootfs diff path", zap.Error(err))
+
+			return err
+		}
+
+		rootfsPath = &rootfsLocalPath
+	}
+
+	b := storage.NewTemplateBuild(
+		snapshot.MemfileDiffHeader,
+		snapshot.RootfsDiffHeader,
+		s.persistence,
+		snapshotTemplateFiles.TemplateFiles,
+	)
+
+	err := <-b.Upload(
+		context.Background(),
+		snapshot.Snapfile.Path(),
+		memfilePath,
+		rootfsPath,
+	)
+	if err != nil {
+		sbxlogger.I(sbx).Error("error uploading sandbox snapshot", zap.Error(err))
+
+		return err
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -85,3 +87,122 @@ func Test_server_List(t *testing.T) {
 		})
 	}
 }
+
+func Test_server_Claim(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     *orchestrator.SandboxConfig
+		req      *orchestrator.SandboxClaimRequest
+		wantCode codes.Code
+	}{
+		{
+			name: "should fail when the pooled sandbox doesn't exist",
+			req: &orchestrator.SandboxClaimRequest{
+				PooledSandboxId: "pooled-id",
+				Sandbox:         &orchestrator.SandboxConfig{SandboxId: "sandbox-id", TemplateId: "template-id"},
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "should fail when the sandbox isn't pooled",
+			data: &orchestrator.SandboxConfig{SandboxId: "pooled-id", TemplateId: "template-id"},
+			req: &orchestrator.SandboxClaimRequest{
+				PooledSandboxId: "pooled-id",
+				Sandbox:         &orchestrator.SandboxConfig{SandboxId: "sandbox-id", TemplateId: "template-id"},
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "should fail when the build doesn't match",
+			data: &orchestrator.SandboxConfig{SandboxId: "pooled-id", TemplateId: "template-id", BuildId: "build-id", Pooled: true},
+			req: &orchestrator.SandboxClaimRequest{
+				PooledSandboxId: "pooled-id",
+				Sandbox:         &orchestrator.SandboxConfig{SandboxId: "sandbox-id", TemplateId: "template-id", BuildId: "other-build-id"},
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "should fail when the resources don't match",
+			data: &orchestrator.SandboxConfig{SandboxId: "pooled-id", TemplateId: "template-id", BuildId: "build-id", Vcpu: 2, RamMb: 512, Pooled: true},
+			req: &orchestrator.SandboxClaimRequest{
+				PooledSandboxId: "pooled-id",
+				Sandbox:         &orchestrator.SandboxConfig{SandboxId: "sandbox-id", TemplateId: "template-id", BuildId: "build-id", Vcpu: 4, RamMb: 512},
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "should fail when the memory target is above the memory of the sandbox",
+			data: &orchestrator.SandboxConfig{SandboxId: "pooled-id", TemplateId: "template-id", BuildId: "build-id", RamMb: 512, Pooled: true},
+			req: &orchestrator.SandboxClaimRequest{
+				PooledSandboxId: "pooled-id",
+				Sandbox:         &orchestrator.SandboxConfig{SandboxId: "sandbox-id", TemplateId: "template-id", BuildId: "build-id", RamMb: 512, MemoryTargetMb: 1024},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &server{
+				sandboxes: smap.New[*sandbox.Sandbox](),
+				tracer:    noop.NewTracerProvider().Tracer(""),
+				info:      &service.ServiceInfo{},
+			}
+			if tt.data != nil {
+				s.sandboxes.Insert(tt.data.SandboxId, &sandbox.Sandbox{Metadata: &sandbox.Metadata{Config: tt.data}})
+			}
+
+			_, err := s.Claim(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("server.Claim() error = %v, want code %v", err, tt.wantCode)
+			}
+
+			if tt.data != nil {
+				if _, ok := s.sandboxes.Get(tt.data.SandboxId); !ok {
+					t.Errorf("server.Claim() removed the pooled sandbox")
+				}
+			}
+		})
+	}
+}
+
+func Test_server_UpdateMetadata(t *testing.T) {
+	s := &server{
+		sandboxes: smap.New[*sandbox.Sandbox](),
+		tracer:    noop.NewTracerProvider().Tracer(""),
+		info:      &service.ServiceInfo{},
+	}
+
+	sbx := &sandbox.Sandbox{
+		Metadata: &sandbox.Metadata{
+			Config: &orchestrator.SandboxConfig{
+				SandboxId: "sandbox-id",
+				Metadata:  map[string]string{"stage": "build"},
+			},
+		},
+	}
+	s.sandboxes.Insert("sandbox-id", sbx)
+
+	previous := sbx.GetConfig()
+
+	_, err := s.UpdateMetadata(context.Background(), &orchestrator.SandboxUpdateMetadataRequest{
+		SandboxId: "sandbox-id",
+		Metadata:  map[string]string{"stage": "test"},
+	})
+	if err != nil {
+		t.Fatalf("server.UpdateMetadata() error = %v", err)
+	}
+
+	if got := sbx.GetConfig().GetMetadata(); !reflect.DeepEqual(got, map[string]string{"stage": "test"}) {
+		t.Errorf("sandbox metadata = %v, want the updated metadata", got)
+	}
+
+	// The config read before the update isn't modified
+	if got := previous.GetMetadata(); !reflect.DeepEqual(got, map[string]string{"stage": "build"}) {
+		t.Errorf("previous config metadata = %v, want the original metadata", got)
+	}
+
+	_, err = s.UpdateMetadata(context.Background(), &orchestrator.SandboxUpdateMetadataRequest{SandboxId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("server.UpdateMetadata() error = %v, want NotFound", err)
+	}
+}
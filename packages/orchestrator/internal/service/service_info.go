@@ -13,17 +13,26 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 )
 
+// BuildsCapacity reports the builds capacity of the template builder.
+type BuildsCapacity interface {
+	BuildsRunning() int64
+	BuildsMax() int64
+}
+
 type Server struct {
 	orchestratorinfo.UnimplementedInfoServiceServer
 
 	info      *ServiceInfo
 	sandboxes *smap.Map[*sandbox.Sandbox]
+	builds    BuildsCapacity
 }
 
-func NewInfoService(_ context.Context, grpc *grpc.Server, info *ServiceInfo, sandboxes *smap.Map[*sandbox.Sandbox]) *Server {
+// NewInfoService registers the info service, builds is nil when the service doesn't run the template builder.
+func NewInfoService(_ context.Context, grpc *grpc.Server, info *ServiceInfo, sandboxes *smap.Map[*sandbox.Sandbox], builds BuildsCapacity) *Server {
 	s := &Server{
 		info:      info,
 		sandboxes: sandboxes,
+		builds:    builds,
 	}
 
 	orchestratorinfo.RegisterInfoServiceServer(grpc, s)
@@ -43,6 +52,13 @@ func (s *Server) ServiceInfo(_ context.Context, _ *emptypb.Empty) (*orchestrator
 		metricDiskMb += item.Config.TotalDiskSizeMb
 	}
 
+	metricBuildsRunning := int64(0)
+	metricBuildsMax := int64(0)
+	if s.builds != nil {
+		metricBuildsRunning = s.builds.BuildsRunning()
+		metricBuildsMax = s.builds.BuildsMax()
+	}
+
 	return &orchestratorinfo.ServiceInfoResponse{
 		NodeId:        info.ClientId,
 		ServiceId:     info.ServiceId,
@@ -58,6 +74,8 @@ func (s *Server) ServiceInfo(_ context.Context, _ *emptypb.Empty) (*orchestrator
 		MetricMemoryUsedMb:     metricMemoryUsedMb,
 		MetricDiskMb:           metricDiskMb,
 		MetricSandboxesRunning: int64(s.sandboxes.Count()),
+		MetricBuildsRunning:    metricBuildsRunning,
+		MetricBuildsMax:        metricBuildsMax,
 	}, nil
 }
 
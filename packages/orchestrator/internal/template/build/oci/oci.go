@@ -119,7 +119,8 @@ func ExtractToExt4(ctx context.Context, tracer trace.Tracer, postProcessor *writ
 		return fmt.Errorf("error mounting ext4 filesystem: %w", err)
 	}
 	defer func() {
-		if unmountErr := ext4.Unmount(ctx, tracer, tmpMount); unmountErr != nil {
+		// Unmount even when the build was cancelled
+		if unmountErr := ext4.Unmount(context.WithoutCancel(ctx), tracer, tmpMount); unmountErr != nil {
 			zap.L().Error("error unmounting ext4 filesystem", zap.Error(unmountErr))
 		}
 	}()
@@ -188,7 +189,8 @@ func unpackRootfs(ctx context.Context, tracer trace.Tracer, postProcessor *write
 		return fmt.Errorf("while mounting overlayfs with layers: %w", err)
 	}
 	defer func() {
-		if unmountErr := ext4.Unmount(ctx, tracer, mountPath); unmountErr != nil {
+		// Unmount even when the build was cancelled
+		if unmountErr := ext4.Unmount(context.WithoutCancel(ctx), tracer, mountPath); unmountErr != nil {
 			zap.L().Error("error unmounting overlayfs mount point", zap.Error(unmountErr))
 		}
 	}()
@@ -226,7 +228,7 @@ func listFiles(ctx context.Context, tracer trace.Tracer, dir string) ([]string,
 
 // copyFiles uses rsync to copy files from the source directory to the destination directory.
 func copyFiles(ctx context.Context, tracer trace.Tracer, src, dest string) error {
-	_, childSpan := tracer.Start(ctx, "copy-files")
+	ctx, childSpan := tracer.Start(ctx, "copy-files")
 	defer childSpan.End()
 
 	// Does the following:
@@ -240,7 +242,7 @@ func copyFiles(ctx context.Context, tracer trace.Tracer, src, dest string) error
 	//
 	// --whole-file: Copy files without using the delta algorithm, which is faster for local copies
 	// --inplace: Update destination files in place, no need to create temporary files
-	cmd := exec.Command("rsync", "-aH", "--whole-file", "--inplace", src+"/", dest)
+	cmd := exec.CommandContext(ctx, "rsync", "-aH", "--whole-file", "--inplace", src+"/", dest)
 	if out, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("while copying files from %s to %s: %w: %s", src, dest, err, string(out))
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/oci"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/writer"
 	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
 )
@@ -76,7 +77,7 @@ func (r *Rootfs) createExt4Filesystem(ctx context.Context, tracer trace.Tracer,
 		}
 	}()
 
-	postProcessor.WriteMsg("Requesting Docker Image")
+	postProcessor.StartPhase(template_manager.TemplateBuildPhaseType_Base, "Requesting Docker Image")
 
 	img, err := oci.GetImage(childCtx, tracer, r.artifactRegistry, r.template.TemplateId, r.template.BuildId)
 	if err != nil {
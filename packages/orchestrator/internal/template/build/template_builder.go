@@ -27,6 +27,7 @@ import (
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/template"
 	artifactsregistry "github.com/e2b-dev/infra/packages/shared/pkg/artifacts-registry"
 	"github.com/e2b-dev/infra/packages/shared/pkg/env"
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/smap"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
@@ -55,7 +56,8 @@ const (
 	configurationTimeout = 5 * time.Minute
 	waitEnvdTimeout      = 60 * time.Second
 
-	cleanupTimeout = time.Second * 10
+	cleanupTimeout        = time.Second * 10
+	sandboxCleanupTimeout = time.Minute
 )
 
 func NewBuilder(
@@ -107,7 +109,7 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 	defer childSpan.End()
 
 	logsWriter := template.BuildLogsWriter
-	postProcessor := writer.NewPostProcessor(ctx, logsWriter)
+	postProcessor := writer.NewPostProcessor(ctx, logsWriter, template.BuildPhaseHook)
 	go postProcessor.Start()
 	defer func() {
 		postProcessor.Stop(e)
@@ -155,7 +157,7 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 	defer localTemplate.Close()
 
 	// Provision sandbox with systemd and other vital parts
-	postProcessor.WriteMsg("Provisioning sandbox template")
+	postProcessor.StartPhase(template_manager.TemplateBuildPhaseType_Provision, "Provisioning sandbox template")
 	// Just a symlink to the rootfs build file, so when the COW cache deletes the underlying file (here symlink),
 	// it will not delete the rootfs file. We use the rootfs again later on to start the sandbox template.
 	rootfsProvisionPath := filepath.Join(templateBuildDir, rootfsProvisionLink)
@@ -169,6 +171,11 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 		return nil, fmt.Errorf("error provisioning sandbox: %w", err)
 	}
 
+	// The integrity check doesn't take the context, stop here if the build was cancelled during provisioning
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("error provisioning sandbox: %w", err)
+	}
+
 	// Check the rootfs filesystem corruption
 	ext4Check, err := ext4.CheckIntegrity(rootfsPath, true)
 	if err != nil {
@@ -193,7 +200,7 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 	}
 
 	// Create sandbox for building template
-	postProcessor.WriteMsg("Creating sandbox template")
+	postProcessor.StartPhase(template_manager.TemplateBuildPhaseType_Configure, "Creating sandbox template")
 	sbx, cleanup, err := sandbox.CreateSandbox(
 		ctx,
 		b.tracer,
@@ -211,7 +218,10 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 		config.AllowSandboxInternet,
 	)
 	defer func() {
-		cleanupErr := cleanup.Run(ctx)
+		cleanupCtx, cancel := sandboxCleanupContext(ctx)
+		defer cancel()
+
+		cleanupErr := cleanup.Run(cleanupCtx)
 		if cleanupErr != nil {
 			b.logger.Error("Error cleaning up sandbox", zap.Error(cleanupErr))
 		}
@@ -322,7 +332,7 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 	}
 
 	// Pause sandbox
-	postProcessor.WriteMsg("Pausing sandbox template")
+	postProcessor.StartPhase(template_manager.TemplateBuildPhaseType_Snapshot, "Pausing sandbox template")
 	snapshot, err := sbx.Pause(
 		ctx,
 		b.tracer,
@@ -333,7 +343,7 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 	}
 
 	// Upload
-	postProcessor.WriteMsg("Uploading template")
+	postProcessor.StartPhase(template_manager.TemplateBuildPhaseType_Upload, "Uploading template")
 	uploadErrCh := b.uploadTemplate(
 		ctx,
 		template.TemplateFiles,
@@ -351,6 +361,12 @@ func (b *TemplateBuilder) Build(ctx context.Context, template *TemplateConfig) (
 	}, nil
 }
 
+// sandboxCleanupContext returns a context for releasing the build sandbox resources (network slot, NBD devices, etc.),
+// which are released even when the build context was cancelled.
+func sandboxCleanupContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), sandboxCleanupTimeout)
+}
+
 func (b *TemplateBuilder) uploadTemplate(
 	ctx context.Context,
 	templateFiles *storage.TemplateFiles,
@@ -458,7 +474,10 @@ func (b *TemplateBuilder) provisionSandbox(
 		true,
 	)
 	defer func() {
-		cleanupErr := cleanup.Run(ctx)
+		cleanupCtx, cancel := sandboxCleanupContext(ctx)
+		defer cancel()
+
+		cleanupErr := cleanup.Run(cleanupCtx)
 		if cleanupErr != nil {
 			e = fmt.Errorf("error cleaning up sandbox: %w", cleanupErr)
 		}
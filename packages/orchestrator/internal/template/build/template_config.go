@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/writer"
 	"github.com/e2b-dev/infra/packages/shared/pkg/grpc/orchestrator"
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
@@ -31,6 +32,9 @@ type TemplateConfig struct {
 	// Path to the directory where the temporary files for the build are stored.
 	BuildLogsWriter io.Writer
 
+	// Called when the build enters a new phase, can be nil.
+	BuildPhaseHook writer.PhaseHook
+
 	// Real size of the rootfs after building the template.
 	rootfsSize int64
 
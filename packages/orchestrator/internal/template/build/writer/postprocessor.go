@@ -6,15 +6,21 @@ import (
 	"io"
 	"sync"
 	"time"
+
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 )
 
 const tickerInterval = 5 * time.Second
 
+// PhaseHook is called when the build enters a new phase.
+type PhaseHook func(phase template_manager.TemplateBuildPhaseType)
+
 type PostProcessor struct {
 	errChan chan error
 	ctx     context.Context
 	writer  io.Writer
 	ticker  *time.Ticker
+	onPhase PhaseHook
 
 	stopOnce sync.Once
 }
@@ -57,17 +63,27 @@ func (p *PostProcessor) WriteMsg(message string) {
 	p.writer.Write([]byte(prefixWithTimestamp(message + "\n")))
 }
 
+// StartPhase writes the message and reports the new build phase to the phase hook.
+func (p *PostProcessor) StartPhase(phase template_manager.TemplateBuildPhaseType, message string) {
+	p.WriteMsg(message)
+
+	if p.onPhase != nil {
+		p.onPhase(phase)
+	}
+}
+
 func (p *PostProcessor) Write(b []byte) (n int, err error) {
 	p.ticker.Reset(tickerInterval)
 	return p.writer.Write([]byte(prefixWithTimestamp(string(b))))
 }
 
-func NewPostProcessor(ctx context.Context, writer io.Writer) *PostProcessor {
+func NewPostProcessor(ctx context.Context, writer io.Writer, onPhase PhaseHook) *PostProcessor {
 	return &PostProcessor{
 		ctx:     ctx,
 		writer:  writer,
 		errChan: make(chan error, 1),
 		ticker:  time.NewTicker(tickerInterval),
+		onPhase: onPhase,
 	}
 }
 
@@ -3,10 +3,13 @@ package writer
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
 	"time"
+
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 )
 
 // test writer that stores the written data
@@ -84,3 +87,28 @@ func TestPostProcessor_Start(t *testing.T) {
 		})
 	}
 }
+
+func TestPostProcessor_StartPhase(t *testing.T) {
+	tw := &testWriter{}
+
+	var phases []template_manager.TemplateBuildPhaseType
+	p := NewPostProcessor(context.TODO(), tw, func(phase template_manager.TemplateBuildPhaseType) {
+		phases = append(phases, phase)
+	})
+
+	p.StartPhase(template_manager.TemplateBuildPhaseType_Provision, "Provisioning sandbox template")
+	p.StartPhase(template_manager.TemplateBuildPhaseType_Snapshot, "Pausing sandbox template")
+
+	expected := []template_manager.TemplateBuildPhaseType{
+		template_manager.TemplateBuildPhaseType_Provision,
+		template_manager.TemplateBuildPhaseType_Snapshot,
+	}
+	if !slices.Equal(phases, expected) {
+		t.Errorf("expected phases %v, got %v", expected, phases)
+	}
+
+	logs := string(tw.Data())
+	if !strings.Contains(logs, "Pausing sandbox template") {
+		t.Errorf("expected data to contain the phase message, got %s", logs)
+	}
+}
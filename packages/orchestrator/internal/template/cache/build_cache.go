@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/jellydator/ttlcache/v3"
 	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
@@ -19,9 +21,15 @@ const (
 	buildInfoExpiration = time.Minute * 10 // 10 minutes
 )
 
+var (
+	ErrBuildNotRunning   = errors.New("build is not running")
+	ErrBuildLimitReached = errors.New("running builds limit reached")
+)
+
 type BuildInfo struct {
 	status    template_manager.TemplateBuildState
 	metadata  *template_manager.TemplateBuildMetadata
+	phases    []*template_manager.TemplateBuildPhase
 	mu        sync.RWMutex
 	ctx       context.Context
 	ctxCancel context.CancelFunc
@@ -41,6 +49,13 @@ func (b *BuildInfo) IsFailed() bool {
 	return b.status == template_manager.TemplateBuildState_Failed
 }
 
+func (b *BuildInfo) IsCancelled() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.status == template_manager.TemplateBuildState_Cancelled
+}
+
 func (b *BuildInfo) GetMetadata() *template_manager.TemplateBuildMetadata {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
@@ -55,6 +70,65 @@ func (b *BuildInfo) GetStatus() template_manager.TemplateBuildState {
 	return b.status
 }
 
+// GetPhases returns the phases the build went through, the last one can be still in progress.
+func (b *BuildInfo) GetPhases() []*template_manager.TemplateBuildPhase {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	phases := make([]*template_manager.TemplateBuildPhase, len(b.phases))
+	for i, p := range b.phases {
+		phases[i] = &template_manager.TemplateBuildPhase{
+			Phase:      p.Phase,
+			StartedAt:  p.StartedAt,
+			FinishedAt: p.FinishedAt,
+		}
+	}
+
+	return phases
+}
+
+// StartPhase finishes the current phase of the running build and starts the new one.
+func (b *BuildInfo) StartPhase(phase template_manager.TemplateBuildPhaseType) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.status != template_manager.TemplateBuildState_Building {
+		return
+	}
+
+	now := timestamppb.Now()
+	b.finishPhase(now)
+	b.phases = append(b.phases, &template_manager.TemplateBuildPhase{
+		Phase:     phase,
+		StartedAt: now,
+	})
+}
+
+// finishPhase finishes the phase in progress, the caller must hold the lock.
+func (b *BuildInfo) finishPhase(finishedAt *timestamppb.Timestamp) {
+	if len(b.phases) == 0 {
+		return
+	}
+
+	last := b.phases[len(b.phases)-1]
+	if last.FinishedAt == nil {
+		last.FinishedAt = finishedAt
+	}
+}
+
+// setStatus sets the final status of the build and finishes the phase in progress.
+func (b *BuildInfo) setStatus(status template_manager.TemplateBuildState, metadata *template_manager.TemplateBuildMetadata) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.status = status
+	if metadata != nil {
+		b.metadata = metadata
+	}
+
+	b.finishPhase(timestamppb.Now())
+}
+
 func (b *BuildInfo) GetContext() context.Context {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
@@ -71,34 +145,45 @@ func (b *BuildInfo) Cancel() {
 
 type BuildCache struct {
 	cache *ttlcache.Cache[string, *BuildInfo]
+	// maxRunning is the number of builds that can run at once, zero means no limit.
+	maxRunning int
 
 	mu sync.Mutex
 }
 
-func NewBuildCache(meterProvider metric.MeterProvider) *BuildCache {
+func NewBuildCache(meterProvider metric.MeterProvider, maxRunning int) *BuildCache {
 	meter := meterProvider.Meter("orchestrator.cache.build")
 
-	cache := ttlcache.New(ttlcache.WithTTL[string, *BuildInfo](buildInfoExpiration))
-	_, err := telemetry.GetObservableUpDownCounter(meter, telemetry.BuildCounterMeterName, func(ctx context.Context, observer metric.Int64Observer) error {
-		items := utils.MapValues(cache.Items())
-
-		// Filter running builds
-		runningCount := len(utils.Filter(items, func(item *ttlcache.Item[string, *BuildInfo]) bool {
-			return item != nil && item.Value() != nil && item.Value().IsRunning()
-		}))
+	c := &BuildCache{
+		cache:      ttlcache.New(ttlcache.WithTTL[string, *BuildInfo](buildInfoExpiration)),
+		maxRunning: maxRunning,
+	}
 
-		observer.Observe(int64(runningCount))
+	_, err := telemetry.GetObservableUpDownCounter(meter, telemetry.BuildCounterMeterName, func(ctx context.Context, observer metric.Int64Observer) error {
+		observer.Observe(int64(c.RunningCount()))
 		return nil
 	})
 	if err != nil {
 		zap.L().Error("error creating counter", zap.Error(err), zap.Any("counter_name", telemetry.BuildCounterMeterName))
 	}
 
-	go cache.Start()
+	go c.cache.Start()
 
-	return &BuildCache{
-		cache: cache,
-	}
+	return c
+}
+
+// RunningCount returns the number of builds that are still running.
+func (c *BuildCache) RunningCount() int {
+	items := utils.MapValues(c.cache.Items())
+
+	return len(utils.Filter(items, func(item *ttlcache.Item[string, *BuildInfo]) bool {
+		return item != nil && item.Value() != nil && item.Value().IsRunning()
+	}))
+}
+
+// MaxRunning returns the number of builds that can run at once, zero means no limit.
+func (c *BuildCache) MaxRunning() int {
+	return c.maxRunning
 }
 
 // Get returns the build info.
@@ -117,6 +202,7 @@ func (c *BuildCache) Get(buildID string) (*BuildInfo, error) {
 }
 
 // Create creates a new build if it doesn't exist in the cache or the build was already finished.
+// It returns ErrBuildLimitReached when the limit of the running builds is reached.
 func (c *BuildCache) Create(buildID string) (*BuildInfo, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -126,6 +212,10 @@ func (c *BuildCache) Create(buildID string) (*BuildInfo, error) {
 		return nil, fmt.Errorf("build %s already exists in cache", buildID)
 	}
 
+	if c.maxRunning > 0 && c.RunningCount() >= c.maxRunning {
+		return nil, ErrBuildLimitReached
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	info := &BuildInfo{
@@ -149,8 +239,11 @@ func (c *BuildCache) SetSucceeded(buildID string, metadata *template_manager.Tem
 		return fmt.Errorf("build %s not found in cache: %w", buildID, err)
 	}
 
-	item.status = template_manager.TemplateBuildState_Completed
-	item.metadata = metadata
+	if item.IsCancelled() {
+		return fmt.Errorf("build %s was cancelled", buildID)
+	}
+
+	item.setStatus(template_manager.TemplateBuildState_Completed, metadata)
 	return nil
 }
 
@@ -163,7 +256,31 @@ func (c *BuildCache) SetFailed(buildID string) error {
 		return fmt.Errorf("build %s not found in cache: %w", buildID, err)
 	}
 
-	item.status = template_manager.TemplateBuildState_Failed
+	if item.IsCancelled() {
+		return fmt.Errorf("build %s was cancelled", buildID)
+	}
+
+	item.setStatus(template_manager.TemplateBuildState_Failed, nil)
+	return nil
+}
+
+// SetCancelled marks the running build as cancelled and cancels its context.
+func (c *BuildCache) SetCancelled(buildID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, err := c.Get(buildID)
+	if err != nil {
+		return fmt.Errorf("build %s not found in cache: %w", buildID, err)
+	}
+
+	if !item.IsRunning() {
+		return fmt.Errorf("build %s is not running: %w", buildID, ErrBuildNotRunning)
+	}
+
+	item.setStatus(template_manager.TemplateBuildState_Cancelled, nil)
+	item.Cancel()
+
 	return nil
 }
 
@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	template_manager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+)
+
+func TestBuildCacheSetCancelled(t *testing.T) {
+	c := NewBuildCache(noop.NewMeterProvider(), 0)
+
+	info, err := c.Create("build-id")
+	require.NoError(t, err)
+
+	err = c.SetCancelled("build-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, template_manager.TemplateBuildState_Cancelled, info.GetStatus())
+	assert.ErrorIs(t, info.GetContext().Err(), context.Canceled)
+
+	// The cancelled state isn't overwritten by the finishing build
+	assert.Error(t, c.SetFailed("build-id"))
+	assert.Error(t, c.SetSucceeded("build-id", &template_manager.TemplateBuildMetadata{}))
+	assert.Equal(t, template_manager.TemplateBuildState_Cancelled, info.GetStatus())
+}
+
+func TestBuildCacheSetCancelledNotRunning(t *testing.T) {
+	c := NewBuildCache(noop.NewMeterProvider(), 0)
+
+	_, err := c.Create("build-id")
+	require.NoError(t, err)
+	require.NoError(t, c.SetFailed("build-id"))
+
+	err = c.SetCancelled("build-id")
+	assert.ErrorIs(t, err, ErrBuildNotRunning)
+
+	err = c.SetCancelled("unknown-build-id")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrBuildNotRunning)
+}
+
+func TestBuildInfoPhases(t *testing.T) {
+	c := NewBuildCache(noop.NewMeterProvider(), 0)
+
+	info, err := c.Create("build-id")
+	require.NoError(t, err)
+
+	info.StartPhase(template_manager.TemplateBuildPhaseType_Base)
+	info.StartPhase(template_manager.TemplateBuildPhaseType_Provision)
+
+	phases := info.GetPhases()
+	require.Len(t, phases, 2)
+	assert.Equal(t, template_manager.TemplateBuildPhaseType_Base, phases[0].GetPhase())
+	assert.NotNil(t, phases[0].GetFinishedAt())
+	assert.Equal(t, template_manager.TemplateBuildPhaseType_Provision, phases[1].GetPhase())
+	assert.Nil(t, phases[1].GetFinishedAt())
+
+	// The finished build closes the phase in progress and doesn't start new ones
+	require.NoError(t, c.SetFailed("build-id"))
+	info.StartPhase(template_manager.TemplateBuildPhaseType_Configure)
+
+	phases = info.GetPhases()
+	require.Len(t, phases, 2)
+	assert.NotNil(t, phases[1].GetFinishedAt())
+}
+
+func TestBuildCacheCreateLimit(t *testing.T) {
+	c := NewBuildCache(noop.NewMeterProvider(), 1)
+
+	_, err := c.Create("build-1")
+	require.NoError(t, err)
+
+	_, err = c.Create("build-2")
+	assert.ErrorIs(t, err, ErrBuildLimitReached)
+
+	// The finished build frees the capacity
+	require.NoError(t, c.SetFailed("build-1"))
+	_, err = c.Create("build-2")
+	require.NoError(t, err)
+	assert.Equal(t, 1, c.RunningCount())
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/cache"
+	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
+	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
+	"github.com/e2b-dev/infra/packages/shared/pkg/telemetry"
+)
+
+const cancelCleanupTimeout = time.Minute
+
+func (s *ServerStore) TemplateBuildCancel(ctx context.Context, in *templatemanager.TemplateBuildCancelRequest) (*emptypb.Empty, error) {
+	_, childSpan := s.tracer.Start(ctx, "template-cancel-request", trace.WithAttributes(
+		telemetry.WithTemplateID(in.TemplateID),
+		telemetry.WithBuildID(in.BuildID),
+	))
+	defer childSpan.End()
+
+	if in.TemplateID == "" || in.BuildID == "" {
+		return nil, status.Error(codes.InvalidArgument, "template id and build id are required fields")
+	}
+
+	if _, err := s.buildCache.Get(in.BuildID); err != nil {
+		return nil, status.Errorf(codes.NotFound, "build '%s' not found", in.BuildID)
+	}
+
+	// The build goroutine stops on the cancelled context, releases the build sandbox and removes the partial files.
+	err := s.buildCache.SetCancelled(in.BuildID)
+	if errors.Is(err, cache.ErrBuildNotRunning) {
+		return nil, status.Errorf(codes.FailedPrecondition, "build '%s' is not running", in.BuildID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error cancelling build '%s': %s", in.BuildID, err)
+	}
+
+	zap.L().Info("Cancelled running template build", logger.WithTemplateID(in.TemplateID), logger.WithBuildID(in.BuildID))
+	telemetry.ReportEvent(ctx, "cancelled template build")
+
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,10 +10,13 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build"
 	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/build/writer"
+	"github.com/e2b-dev/infra/packages/orchestrator/internal/template/cache"
 	templatemanager "github.com/e2b-dev/infra/packages/shared/pkg/grpc/template-manager"
 	"github.com/e2b-dev/infra/packages/shared/pkg/logger"
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
@@ -63,10 +67,16 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 	}
 
 	buildInfo, err := s.buildCache.Create(config.BuildID)
+	if errors.Is(err, cache.ErrBuildLimitReached) {
+		return nil, status.Errorf(codes.ResourceExhausted, "builder is running the maximum of %d builds", s.buildCache.MaxRunning())
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("error while creating build cache: %w", err)
 	}
 
+	template.BuildPhaseHook = buildInfo.StartPhase
+
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
@@ -83,6 +93,11 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 		// This is a temporary ~fix for the CLI to load most of the logs before finishing the template build
 		// Ideally we should wait in the CLI for the last log message
 		time.Sleep(8 * time.Second)
+		if buildInfo.IsCancelled() {
+			s.reportBuildCancelled(buildContext, template)
+			return
+		}
+
 		if err != nil {
 			s.reportBuildFailed(buildContext, template, err)
 			return
@@ -91,6 +106,12 @@ func (s *ServerStore) TemplateCreate(ctx context.Context, templateRequest *templ
 		buildMetadata := &templatemanager.TemplateBuildMetadata{RootfsSizeKey: int32(template.RootfsSizeMB()), EnvdVersionKey: res.EnvdVersion}
 		err = s.buildCache.SetSucceeded(template.BuildId, buildMetadata)
 		if err != nil {
+			// The build could have been cancelled right after it finished
+			if buildInfo.IsCancelled() {
+				s.reportBuildCancelled(buildContext, template)
+				return
+			}
+
 			s.reportBuildFailed(buildContext, template, fmt.Errorf("error while setting build state to succeeded: %w", err))
 			return
 		}
@@ -110,3 +131,16 @@ func (s *ServerStore) reportBuildFailed(ctx context.Context, config *build.Templ
 
 	telemetry.ReportEvent(ctx, "Environment built failed")
 }
+
+// reportBuildCancelled removes the files the cancelled build may have already uploaded.
+func (s *ServerStore) reportBuildCancelled(ctx context.Context, config *build.TemplateConfig) {
+	removeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cancelCleanupTimeout)
+	defer cancel()
+
+	err := s.templateStorage.Remove(removeCtx, config.BuildId)
+	if err != nil {
+		telemetry.ReportError(ctx, "error while removing files of cancelled build", err)
+	}
+
+	telemetry.ReportEvent(ctx, "Environment build cancelled")
+}
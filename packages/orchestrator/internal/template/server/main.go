@@ -26,6 +26,12 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/storage"
 )
 
+const (
+	// defaultMaxBuilds is the number of builds the template builder runs at once.
+	defaultMaxBuilds = 4
+	maxBuildsEnv     = "TEMPLATE_BUILDER_MAX_BUILDS"
+)
+
 type ServerStore struct {
 	templatemanager.UnimplementedTemplateServiceServer
 	tracer            trace.Tracer
@@ -63,8 +69,17 @@ func New(
 		return nil, fmt.Errorf("error getting artifacts registry provider: %v", err)
 	}
 
+	maxBuilds, err := env.GetEnvAsInt(maxBuildsEnv, defaultMaxBuilds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", maxBuildsEnv, err)
+	}
+
+	if maxBuilds <= 0 {
+		return nil, fmt.Errorf("%s must be positive, got %d", maxBuildsEnv, maxBuilds)
+	}
+
 	templateStorage := template.NewStorage(persistence)
-	buildCache := cache.NewBuildCache(meterProvider)
+	buildCache := cache.NewBuildCache(meterProvider, maxBuilds)
 	builder := build.NewBuilder(
 		logger,
 		buildLogger,
@@ -95,6 +110,16 @@ func New(
 	return store, nil
 }
 
+// BuildsRunning returns the number of the builds running on the template builder.
+func (s *ServerStore) BuildsRunning() int64 {
+	return int64(s.buildCache.RunningCount())
+}
+
+// BuildsMax returns the number of the builds the template builder can run at once.
+func (s *ServerStore) BuildsMax() int64 {
+	return int64(s.buildCache.MaxRunning())
+}
+
 func (s *ServerStore) Close(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
@@ -20,5 +20,6 @@ func (s *ServerStore) TemplateBuildStatus(ctx context.Context, in *template_mana
 	return &template_manager.TemplateBuildStatusResponse{
 		Status:   buildInfo.GetStatus(),
 		Metadata: buildInfo.GetMetadata(),
+		Phases:   buildInfo.GetPhases(),
 	}, nil
 }
@@ -8,6 +8,7 @@ require (
 	connectrpc.com/connect v1.18.1
 	entgo.io/ent v0.12.5
 	github.com/ClickHouse/clickhouse-go/v2 v2.33.1
+	github.com/DATA-DOG/go-sqlmock v1.5.2
 	github.com/aws/aws-sdk-go-v2 v1.36.3
 	github.com/aws/aws-sdk-go-v2/config v1.29.14
 	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.17.74
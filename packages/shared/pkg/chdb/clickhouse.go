@@ -22,6 +22,10 @@ type Store interface {
 	// Metrics queries
 	InsertMetrics(ctx context.Context, metrics chmodels.Metrics) error
 	QueryMetrics(ctx context.Context, sandboxID, teamID string, start int64, limit int) ([]chmodels.Metrics, error)
+
+	// Usage queries
+	InsertSandboxUsage(ctx context.Context, usage chmodels.SandboxUsage) error
+	QueryTeamUsage(ctx context.Context, teamID string, from, to time.Time, groupBy UsageGroupBy) ([]chmodels.TeamUsage, error)
 }
 
 type ClickHouseStore struct {
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS sandbox_usage (
+	timestamp DateTime64(3, 'UTC'),
+	event_type LowCardinality(String),
+	team_id String,
+	sandbox_id String,
+	execution_id String,
+	template_id String,
+	build_id String,
+	vcpu_count UInt32,
+	ram_mib UInt64,
+	disk_mib UInt64,
+	duration_seconds Float64,
+	vcpu_seconds Float64,
+	ram_mib_seconds Float64,
+	disk_mib_seconds Float64
+) Engine MergeTree()
+ PARTITION BY toYYYYMM(timestamp)
+ ORDER BY (team_id, timestamp);
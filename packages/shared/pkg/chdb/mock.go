@@ -2,6 +2,7 @@ package chdb
 
 import (
 	"context"
+	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 
@@ -33,3 +34,11 @@ func (m *MockStore) InsertMetrics(ctx context.Context, metrics chmodels.Metrics)
 func (m *MockStore) QueryMetrics(ctx context.Context, sandboxID, teamID string, start int64, limit int) ([]chmodels.Metrics, error) {
 	return nil, nil
 }
+
+func (m *MockStore) InsertSandboxUsage(ctx context.Context, usage chmodels.SandboxUsage) error {
+	return nil
+}
+
+func (m *MockStore) QueryTeamUsage(ctx context.Context, teamID string, from, to time.Time, groupBy UsageGroupBy) ([]chmodels.TeamUsage, error) {
+	return nil, nil
+}
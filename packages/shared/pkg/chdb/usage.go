@@ -0,0 +1,74 @@
+package chdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/chmodels"
+)
+
+type UsageGroupBy string
+
+const (
+	UsageGroupByTemplate UsageGroupBy = "template"
+	UsageGroupByDay      UsageGroupBy = "day"
+)
+
+func (c *ClickHouseStore) InsertSandboxUsage(ctx context.Context, usage chmodels.SandboxUsage) error {
+	batch, err := c.Conn.PrepareBatch(ctx, "INSERT INTO sandbox_usage")
+	if err != nil {
+		return err
+	}
+	err = batch.AppendStruct(&usage)
+	if err != nil {
+		batch.Abort()
+		return fmt.Errorf("failed to append sandbox usage struct to clickhouse batcher: %w", err)
+	}
+
+	return batch.Send()
+}
+
+// QueryTeamUsage sums the usage of the runs of the team's sandboxes that ended in [from, to).
+func (c *ClickHouseStore) QueryTeamUsage(ctx context.Context, teamID string, from, to time.Time, groupBy UsageGroupBy) ([]chmodels.TeamUsage, error) {
+	var key string
+	switch groupBy {
+	case UsageGroupByTemplate:
+		key = "template_id"
+	case UsageGroupByDay:
+		key = "toDate(timestamp)"
+	default:
+		return nil, fmt.Errorf("unknown usage grouping '%s'", groupBy)
+	}
+
+	query := fmt.Sprintf(`SELECT %s AS usage_key, count() AS runs, sum(duration_seconds), sum(vcpu_seconds), sum(ram_mib_seconds), sum(disk_mib_seconds)
+FROM sandbox_usage
+WHERE team_id = (?) AND timestamp >= (?) AND timestamp < (?) AND event_type IN ('stop', 'pause')
+GROUP BY usage_key
+ORDER BY usage_key`, key)
+
+	rows, err := c.Query(ctx, query, teamID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var usage []chmodels.TeamUsage
+	for rows.Next() {
+		var u chmodels.TeamUsage
+
+		dest := []any{&u.Runs, &u.DurationSeconds, &u.VCPUSeconds, &u.RAMMiBSeconds, &u.DiskMiBSeconds}
+		if groupBy == UsageGroupByDay {
+			dest = append([]any{&u.Day}, dest...)
+		} else {
+			dest = append([]any{&u.TemplateID}, dest...)
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		usage = append(usage, u)
+	}
+
+	return usage, rows.Err()
+}
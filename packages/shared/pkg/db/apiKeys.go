@@ -39,28 +39,31 @@ func validateTeamUsage(team *models.Team) error {
 	return nil
 }
 
-func (db *DB) GetTeamAuth(ctx context.Context, apiKey string) (*models.Team, *models.Tier, error) {
+// GetTeamAuth returns the team and tier of the API key, the key is returned too as it can restrict the access to the team.
+func (db *DB) GetTeamAuth(ctx context.Context, apiKey string) (*models.Team, *models.Tier, *models.TeamAPIKey, error) {
 	result, err := db.
 		Client.
 		TeamAPIKey.
 		Query().
-		WithTeam().
 		Where(teamapikey.APIKey(apiKey)).
-		QueryTeam().
-		WithTeamTier().
+		WithTeam(func(query *models.TeamQuery) {
+			query.WithTeamTier()
+		}).
 		Only(ctx)
 	if err != nil {
 		errMsg := fmt.Errorf("failed to get team from API key: %w", err)
 
-		return nil, nil, errMsg
+		return nil, nil, nil, errMsg
 	}
 
-	err = validateTeamUsage(result)
+	team := result.Edges.Team
+
+	err = validateTeamUsage(team)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return result, result.Edges.TeamTier, nil
+	return team, team.Edges.TeamTier, result, nil
 }
 
 func (db *DB) GetUserID(ctx context.Context, token string) (*uuid.UUID, error) {
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/auditlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// AuditLogsFilter selects the audit logs of the team, the zero values don't filter.
+type AuditLogsFilter struct {
+	Actions []string
+	Start   *time.Time
+	End     *time.Time
+
+	// The logs are returned from the newest, the cursor is the last log of the previous page.
+	CursorTime *time.Time
+	CursorID   uuid.UUID
+
+	Limit int
+}
+
+// CreateAuditLog appends the record to the audit log.
+func (db *DB) CreateAuditLog(ctx context.Context, log *models.AuditLog) error {
+	err := db.
+		Client.
+		AuditLog.
+		Create().
+		SetNillableTeamID(log.TeamID).
+		SetAction(log.Action).
+		SetActorType(log.ActorType).
+		SetNillableActorID(log.ActorID).
+		SetResourceType(log.ResourceType).
+		SetResourceID(log.ResourceID).
+		SetNillableIP(log.IP).
+		SetNillableRequestID(log.RequestID).
+		SetMetadata(log.Metadata).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	return nil
+}
+
+// GetAuditLogs returns the audit logs of the team from the newest.
+func (db *DB) GetAuditLogs(ctx context.Context, teamID uuid.UUID, filter AuditLogsFilter) ([]*models.AuditLog, error) {
+	predicates := []predicate.AuditLog{auditlog.TeamID(teamID)}
+
+	if len(filter.Actions) > 0 {
+		predicates = append(predicates, auditlog.ActionIn(filter.Actions...))
+	}
+
+	if filter.Start != nil {
+		predicates = append(predicates, auditlog.CreatedAtGTE(*filter.Start))
+	}
+
+	if filter.End != nil {
+		predicates = append(predicates, auditlog.CreatedAtLT(*filter.End))
+	}
+
+	if filter.CursorTime != nil {
+		predicates = append(predicates, auditlog.Or(
+			auditlog.CreatedAtLT(*filter.CursorTime),
+			auditlog.And(
+				auditlog.CreatedAt(*filter.CursorTime),
+				auditlog.IDLT(filter.CursorID),
+			),
+		))
+	}
+
+	logs, err := db.
+		Client.
+		AuditLog.
+		Query().
+		Where(predicates...).
+		Order(models.Desc(auditlog.FieldCreatedAt), models.Desc(auditlog.FieldID)).
+		Limit(filter.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit logs: %w", err)
+	}
+
+	return logs, nil
+}
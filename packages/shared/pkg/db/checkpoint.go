@@ -0,0 +1,226 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+// CreateCheckpoint stores a named checkpoint pointing to the snapshot build of the sandbox.
+// The parentBuildID is the build the sandbox was running from when the checkpoint was taken.
+func (db *DB) CreateCheckpoint(
+	ctx context.Context,
+	sandboxID string,
+	teamID uuid.UUID,
+	name string,
+	buildID uuid.UUID,
+	parentBuildID *uuid.UUID,
+) (*models.Checkpoint, error) {
+	c, err := db.
+		Client.
+		Checkpoint.
+		Create().
+		SetSandboxID(sandboxID).
+		SetTeamID(teamID).
+		SetName(name).
+		SetEnvBuildID(buildID).
+		SetNillableParentBuildID(parentBuildID).
+		Save(ctx)
+	if models.IsConstraintError(err) {
+		return nil, CheckpointAlreadyExists{}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint '%s' for '%s': %w", name, sandboxID, err)
+	}
+
+	return c, nil
+}
+
+// CheckpointNameExists checks if the sandbox already has a checkpoint with the name.
+func (db *DB) CheckpointNameExists(ctx context.Context, sandboxID string, teamID uuid.UUID, name string) (bool, error) {
+	exists, err := db.
+		Client.
+		Checkpoint.
+		Query().
+		Where(
+			checkpoint.SandboxID(sandboxID),
+			checkpoint.TeamID(teamID),
+			checkpoint.Name(name),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check checkpoint '%s' for '%s': %w", name, sandboxID, err)
+	}
+
+	return exists, nil
+}
+
+// GetCheckpoints returns the checkpoints of the sandbox, oldest first.
+func (db *DB) GetCheckpoints(ctx context.Context, sandboxID string, teamID uuid.UUID) ([]*models.Checkpoint, error) {
+	checkpoints, err := db.
+		Client.
+		Checkpoint.
+		Query().
+		Where(
+			checkpoint.SandboxID(sandboxID),
+			checkpoint.TeamID(teamID),
+		).
+		Order(models.Asc(checkpoint.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoints for '%s': %w", sandboxID, err)
+	}
+
+	return checkpoints, nil
+}
+
+// DeleteCheckpoint removes the checkpoint and returns the checkpoint builds of the sandbox nothing depends on anymore.
+// The snapshot builds are layered on top of the build the sandbox was running from, so a checkpoint build can only be
+// removed once no other build is based on it and the sandbox isn't running from it. Otherwise, it's removed later,
+// when another checkpoint of the sandbox is deleted, or with the sandbox.
+func (db *DB) DeleteCheckpoint(
+	ctx context.Context,
+	checkpointID uuid.UUID,
+	sandboxID string,
+	teamID uuid.UUID,
+	runningBuildID *uuid.UUID,
+) ([]*models.EnvBuild, error) {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	c, err := tx.
+		Checkpoint.
+		Query().
+		Where(
+			checkpoint.ID(checkpointID),
+			checkpoint.SandboxID(sandboxID),
+			checkpoint.TeamID(teamID),
+		).
+		WithBuild().
+		Only(ctx)
+	if models.IsNotFound(err) {
+		return nil, CheckpointNotFound{}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoint '%s': %w", checkpointID, err)
+	}
+
+	err = tx.Checkpoint.DeleteOne(c).Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete checkpoint '%s': %w", checkpointID, err)
+	}
+
+	envID := *c.Edges.Build.EnvID
+
+	builds, err := tx.
+		EnvBuild.
+		Query().
+		Where(envbuild.EnvID(envID)).
+		WithCheckpoints().
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get builds of '%s': %w", sandboxID, err)
+	}
+
+	buildIDs := make([]uuid.UUID, 0, len(builds))
+	for _, b := range builds {
+		buildIDs = append(buildIDs, b.ID)
+	}
+
+	// Forks and templates created from the sandbox are based on its builds too
+	derived, err := tx.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.ParentBuildIDIn(buildIDs...),
+			envbuild.EnvIDNEQ(envID),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get builds derived from '%s': %w", sandboxID, err)
+	}
+
+	referenced := make(map[uuid.UUID]bool, len(derived))
+	for _, b := range derived {
+		referenced[*b.ParentBuildID] = true
+	}
+
+	orphaned := orphanedCheckpointBuilds(builds, referenced, runningBuildID)
+	for _, b := range orphaned {
+		err = tx.EnvBuild.DeleteOne(b).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete checkpoint build '%s': %w", b.ID, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return orphaned, nil
+}
+
+// orphanedCheckpointBuilds returns the checkpoint builds of the sandbox that can be removed,
+// they don't have any checkpoint or tag, the sandbox isn't running from them and no other build is based on them.
+// The tagged builds are also protected by the foreign key of the tag, so they're skipped instead of failing the deletion.
+// Removing a build can release the build it's based on, so it's repeated until nothing else can be removed.
+func orphanedCheckpointBuilds(builds []*models.EnvBuild, referenced map[uuid.UUID]bool, runningBuildID *uuid.UUID) []*models.EnvBuild {
+	children := make(map[uuid.UUID]int, len(builds))
+	for _, b := range builds {
+		if b.ParentBuildID != nil {
+			children[*b.ParentBuildID]++
+		}
+	}
+
+	removed := make(map[uuid.UUID]bool)
+	orphaned := make([]*models.EnvBuild, 0)
+
+	for changed := true; changed; {
+		changed = false
+
+		for _, b := range builds {
+			if removed[b.ID] || !b.Checkpoint {
+				continue
+			}
+
+			if len(b.Edges.Checkpoints) > 0 || len(b.Edges.Tags) > 0 {
+				continue
+			}
+
+			if referenced[b.ID] || children[b.ID] > 0 {
+				continue
+			}
+
+			if runningBuildID != nil && *runningBuildID == b.ID {
+				continue
+			}
+
+			// The files are still being uploaded
+			if b.Status == envbuild.StatusSnapshotting {
+				continue
+			}
+
+			removed[b.ID] = true
+			orphaned = append(orphaned, b)
+			changed = true
+
+			if b.ParentBuildID != nil {
+				children[*b.ParentBuildID]--
+			}
+		}
+	}
+
+	return orphaned
+}
@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+func testBuild(checkpoint bool, parent *models.EnvBuild) *models.EnvBuild {
+	b := &models.EnvBuild{
+		ID:         uuid.New(),
+		Status:     envbuild.StatusSuccess,
+		Checkpoint: checkpoint,
+	}
+
+	if parent != nil {
+		b.ParentBuildID = &parent.ID
+	}
+
+	return b
+}
+
+func withCheckpoint(b *models.EnvBuild) *models.EnvBuild {
+	b.Edges.Checkpoints = []*models.Checkpoint{{ID: uuid.New(), EnvBuildID: b.ID}}
+
+	return b
+}
+
+func withTag(b *models.EnvBuild) *models.EnvBuild {
+	b.Edges.Tags = []*models.EnvBuildTag{{ID: uuid.New(), Tag: "stable", BuildID: b.ID}}
+
+	return b
+}
+
+func buildIDs(builds []*models.EnvBuild) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(builds))
+	for _, b := range builds {
+		ids = append(ids, b.ID)
+	}
+
+	return ids
+}
+
+func TestOrphanedCheckpointBuilds(t *testing.T) {
+	t.Run("removes the checkpoint build without a checkpoint", func(t *testing.T) {
+		paused := testBuild(false, nil)
+		deleted := testBuild(true, paused)
+		kept := withCheckpoint(testBuild(true, paused))
+
+		orphaned := orphanedCheckpointBuilds([]*models.EnvBuild{paused, deleted, kept}, nil, nil)
+
+		assert.Equal(t, []uuid.UUID{deleted.ID}, buildIDs(orphaned))
+	})
+
+	t.Run("keeps the tagged build", func(t *testing.T) {
+		deleted := withTag(testBuild(true, nil))
+
+		orphaned := orphanedCheckpointBuilds([]*models.EnvBuild{deleted}, nil, nil)
+
+		assert.Empty(t, orphaned)
+	})
+
+	t.Run("keeps the build the sandbox is running from", func(t *testing.T) {
+		deleted := testBuild(true, nil)
+
+		orphaned := orphanedCheckpointBuilds([]*models.EnvBuild{deleted}, nil, &deleted.ID)
+
+		assert.Empty(t, orphaned)
+	})
+
+	t.Run("keeps the build a later snapshot is based on", func(t *testing.T) {
+		deleted := testBuild(true, nil)
+		// The sandbox was restored from the checkpoint and paused again
+		paused := testBuild(false, deleted)
+
+		orphaned := orphanedCheckpointBuilds([]*models.EnvBuild{deleted, paused}, nil, nil)
+
+		assert.Empty(t, orphaned)
+	})
+
+	t.Run("keeps the build a fork or template is based on", func(t *testing.T) {
+		deleted := testBuild(true, nil)
+
+		orphaned := orphanedCheckpointBuilds([]*models.EnvBuild{deleted}, map[uuid.UUID]bool{deleted.ID: true}, nil)
+
+		assert.Empty(t, orphaned)
+	})
+
+	t.Run("keeps the build still being uploaded", func(t *testing.T) {
+		deleted := testBuild(true, nil)
+		deleted.Status = envbuild.StatusSnapshotting
+
+		orphaned := orphanedCheckpointBuilds([]*models.EnvBuild{deleted}, nil, nil)
+
+		assert.Empty(t, orphaned)
+	})
+
+	t.Run("never removes the pause builds", func(t *testing.T) {
+		paused := testBuild(false, nil)
+
+		orphaned := orphanedCheckpointBuilds([]*models.EnvBuild{paused}, nil, nil)
+
+		assert.Empty(t, orphaned)
+	})
+
+	t.Run("removes the chain of checkpoint builds released by the removal", func(t *testing.T) {
+		first := testBuild(true, nil)
+		// The sandbox was restored from the first checkpoint, then checkpointed again
+		second := testBuild(true, first)
+		third := testBuild(true, second)
+
+		orphaned := orphanedCheckpointBuilds([]*models.EnvBuild{first, second, third}, nil, nil)
+
+		assert.ElementsMatch(t, []uuid.UUID{first.ID, second.ID, third.ID}, buildIDs(orphaned))
+	})
+
+	t.Run("stops at the checkpoint build which still has a checkpoint", func(t *testing.T) {
+		first := withCheckpoint(testBuild(true, nil))
+		second := testBuild(true, first)
+
+		orphaned := orphanedCheckpointBuilds([]*models.EnvBuild{first, second}, nil, nil)
+
+		assert.Equal(t, []uuid.UUID{second.ID}, buildIDs(orphaned))
+	})
+}
+
+// expectCheckpointBuilds expects the checkpoint to be deleted and the builds of the sandbox to be read, the build has the tag when tagged is set.
+func expectCheckpointBuilds(mock sqlmock.Sqlmock, checkpointID uuid.UUID, build uuid.UUID, tagged bool) {
+	now := time.Now()
+	envID := "snapshot-env"
+	parentBuildID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FROM "public"."checkpoints"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "env_build_id"}).AddRow(checkpointID, build))
+	mock.ExpectQuery(`SELECT .* FROM "public"."env_builds"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "env_id"}).AddRow(build, envID))
+	mock.ExpectExec(`DELETE FROM "public"."checkpoints"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .* FROM "public"."env_builds" WHERE "public"."env_builds"."env_id" = \$1`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "env_id", "status", "checkpoint", "parent_build_id"}).
+			AddRow(build, now, envID, envbuild.StatusSuccess, true, parentBuildID))
+	mock.ExpectQuery(`SELECT .* FROM "public"."checkpoints"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "env_build_id"}))
+
+	tags := sqlmock.NewRows([]string{"id", "env_id", "tag", "build_id"})
+	if tagged {
+		tags.AddRow(uuid.New(), envID, "stable", build)
+	}
+	mock.ExpectQuery(`SELECT .* FROM "public"."env_build_tags"`).
+		WillReturnRows(tags)
+
+	mock.ExpectQuery(`SELECT .* FROM "public"."env_builds" WHERE "public"."env_builds"."parent_build_id" IN`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+}
+
+func TestDeleteCheckpointTaggedBuild(t *testing.T) {
+	t.Run("keeps the tagged checkpoint build", func(t *testing.T) {
+		db, mock := newMockDB(t)
+
+		checkpointID := uuid.New()
+		expectCheckpointBuilds(mock, checkpointID, uuid.New(), true)
+		mock.ExpectCommit()
+
+		orphaned, err := db.DeleteCheckpoint(context.Background(), checkpointID, "sandbox-id", uuid.New(), nil)
+		require.NoError(t, err)
+
+		assert.Empty(t, orphaned)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("the build tagged concurrently is protected by the foreign key", func(t *testing.T) {
+		db, mock := newMockDB(t)
+
+		checkpointID := uuid.New()
+		expectCheckpointBuilds(mock, checkpointID, uuid.New(), false)
+		mock.ExpectExec(`DELETE FROM "public"."env_builds"`).
+			WillReturnError(errors.New(`pq: update or delete on table "env_builds" violates foreign key constraint "env_build_tags_env_builds_tags" on table "env_build_tags"`))
+		// The checkpoint deletion is rolled back with it
+		mock.ExpectRollback()
+
+		_, err := db.DeleteCheckpoint(context.Background(), checkpointID, "sandbox-id", uuid.New(), nil)
+		require.Error(t, err)
+
+		assert.True(t, models.IsConstraintError(err))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
+)
+
+// SetEnvBuildTag points the tag of the template to the build, the tag is created if it doesn't exist.
+// Only the finished builds of the template can be tagged.
+func (db *DB) SetEnvBuildTag(ctx context.Context, envID string, tag string, buildID uuid.UUID) (*models.EnvBuildTag, error) {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	exists, err := tx.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.ID(buildID),
+			envbuild.EnvID(envID),
+			envbuild.StatusEQ(envbuild.StatusUploaded),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check build '%s': %w", buildID, err)
+	}
+
+	if !exists {
+		return nil, TemplateBuildNotFound{}
+	}
+
+	err = tx.
+		EnvBuildTag.
+		Create().
+		SetEnvID(envID).
+		SetTag(tag).
+		SetBuildID(buildID).
+		OnConflictColumns(envbuildtag.FieldEnvID, envbuildtag.FieldTag).
+		Update(func(u *models.EnvBuildTagUpsert) {
+			u.SetBuildID(buildID)
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set tag '%s' of '%s': %w", tag, envID, err)
+	}
+
+	t, err := tx.
+		EnvBuildTag.
+		Query().
+		Where(
+			envbuildtag.EnvID(envID),
+			envbuildtag.Tag(tag),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag '%s' of '%s': %w", tag, envID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return t, nil
+}
+
+// DeleteEnvBuildTag removes the tag of the template, the build isn't affected.
+func (db *DB) DeleteEnvBuildTag(ctx context.Context, envID string, tag string) error {
+	deleted, err := db.
+		Client.
+		EnvBuildTag.
+		Delete().
+		Where(
+			envbuildtag.EnvID(envID),
+			envbuildtag.Tag(tag),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag '%s' of '%s': %w", tag, envID, err)
+	}
+
+	if deleted == 0 {
+		return TemplateTagNotFound{}
+	}
+
+	return nil
+}
+
+// GetEnvBuildsWithTags returns the finished builds of the template with their tags, the newest builds first.
+func (db *DB) GetEnvBuildsWithTags(ctx context.Context, envID string) ([]*models.EnvBuild, error) {
+	builds, err := db.
+		Client.
+		EnvBuild.
+		Query().
+		Where(
+			envbuild.EnvID(envID),
+			envbuild.StatusEQ(envbuild.StatusUploaded),
+		).
+		WithTags().
+		Order(models.Desc(envbuild.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get builds of '%s': %w", envID, err)
+	}
+
+	return builds, nil
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+)
+
+func TestSetEnvBuildTag(t *testing.T) {
+	envID := "template-id"
+
+	t.Run("rejects the build which isn't uploaded or is of another template", func(t *testing.T) {
+		db, mock := newMockDB(t)
+
+		buildID := uuid.New()
+
+		mock.ExpectBegin()
+		// Only the uploaded builds of the template are found
+		mock.ExpectQuery(`SELECT .* FROM "public"."env_builds" WHERE \("public"."env_builds"."id" = \$1 AND "public"."env_builds"."env_id" = \$2\) AND "public"."env_builds"."status" = \$3`).
+			WithArgs(buildID, envID, envbuild.StatusUploaded).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		_, err := db.SetEnvBuildTag(context.Background(), envID, "stable", buildID)
+		require.ErrorIs(t, err, TemplateBuildNotFound{})
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("points the tag to the uploaded build", func(t *testing.T) {
+		db, mock := newMockDB(t)
+
+		buildID := uuid.New()
+		now := time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT .* FROM "public"."env_builds"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(buildID))
+		mock.ExpectQuery(`INSERT INTO "public"."env_build_tags" .* ON CONFLICT \("env_id", "tag"\) DO UPDATE SET "build_id" = \$6`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+		mock.ExpectQuery(`SELECT .* FROM "public"."env_build_tags"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "env_id", "tag", "build_id"}).
+				AddRow(uuid.New(), now, now, envID, "stable", buildID))
+		mock.ExpectCommit()
+
+		tag, err := db.SetEnvBuildTag(context.Background(), envID, "stable", buildID)
+		require.NoError(t, err)
+
+		assert.Equal(t, "stable", tag.Tag)
+		assert.Equal(t, buildID, tag.BuildID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
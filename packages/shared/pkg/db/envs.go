@@ -170,6 +170,27 @@ func (db *DB) GetEnvBuild(ctx context.Context, buildID uuid.UUID) (build *models
 	return dbBuild, nil
 }
 
+// GetForkEnv returns the env with its builds if it only holds the state of a sandbox for the envs derived from it.
+func (db *DB) GetForkEnv(ctx context.Context, envID string) (*models.Env, error) {
+	e, err := db.
+		Client.
+		Env.
+		Query().
+		Where(
+			env.ID(envID),
+			env.SourceSandboxIDNotNil(),
+		).
+		WithBuilds().
+		Only(ctx)
+	if models.IsNotFound(err) {
+		return nil, EnvNotFound{}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get fork env '%s': %w", envID, err)
+	}
+
+	return e, nil
+}
+
 func (db *DB) CheckBaseEnvHasSnapshots(ctx context.Context, envID string) (result bool, err error) {
 	result, err = db.Client.Snapshot.Query().Where(snapshot.BaseEnvID(envID)).Exist(ctx)
 	if err != nil {
@@ -186,7 +207,8 @@ func (db *DB) FinishEnvBuild(
 	totalDiskSizeMB int64,
 	envdVersion string,
 ) error {
-	err := db.Client.EnvBuild.Update().Where(envbuild.ID(buildID), envbuild.EnvID(envID)).
+	// The cancelled build stays cancelled even if it managed to finish before the cancellation
+	err := db.Client.EnvBuild.Update().Where(envbuild.ID(buildID), envbuild.EnvID(envID), envbuild.StatusNEQ(envbuild.StatusCancelled)).
 		SetFinishedAt(time.Now()).
 		SetTotalDiskSizeMB(totalDiskSizeMB).
 		SetStatus(envbuild.StatusUploaded).
@@ -205,7 +227,8 @@ func (db *DB) EnvBuildSetStatus(
 	buildID uuid.UUID,
 	status envbuild.Status,
 ) error {
-	err := db.Client.EnvBuild.Update().Where(envbuild.ID(buildID), envbuild.EnvID(envID)).
+	// The cancelled build is final, the late status updates of the build are ignored
+	err := db.Client.EnvBuild.Update().Where(envbuild.ID(buildID), envbuild.EnvID(envID), envbuild.StatusNEQ(envbuild.StatusCancelled)).
 		SetStatus(status).SetFinishedAt(time.Now()).Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to set template build status %s for '%s': %w", status, buildID, err)
@@ -1,5 +1,7 @@
 package db
 
+import "fmt"
+
 type ErrNotFound error
 
 type TemplateNotFound struct{ ErrNotFound }
@@ -14,6 +16,12 @@ func (TemplateBuildNotFound) Error() string {
 	return "Template build not found"
 }
 
+type TemplateTagNotFound struct{ ErrNotFound }
+
+func (TemplateTagNotFound) Error() string {
+	return "Template tag not found"
+}
+
 type SnapshotNotFound struct{ ErrNotFound }
 
 func (SnapshotNotFound) Error() string {
@@ -31,3 +39,38 @@ type EnvNotFound struct{ ErrNotFound }
 func (EnvNotFound) Error() string {
 	return "Env not found"
 }
+
+type CheckpointNotFound struct{ ErrNotFound }
+
+func (CheckpointNotFound) Error() string {
+	return "Checkpoint not found"
+}
+
+type CheckpointAlreadyExists struct{}
+
+func (CheckpointAlreadyExists) Error() string {
+	return "Checkpoint with the same name already exists"
+}
+
+type TemplateAliasAlreadyUsed struct{}
+
+func (TemplateAliasAlreadyUsed) Error() string {
+	return "Template alias is already used"
+}
+
+// PausedStorageQuotaExceeded is returned when the snapshot of the paused sandbox doesn't fit the paused storage quota of the team.
+type PausedStorageQuotaExceeded struct {
+	UsedMB      int64
+	RequestedMB int64
+	LimitMB     int64
+}
+
+func (e PausedStorageQuotaExceeded) Error() string {
+	return fmt.Sprintf("Paused storage quota exceeded: %d MiB in use + %d MiB requested (limit %d MiB)", e.UsedMB, e.RequestedMB, e.LimitMB)
+}
+
+type WebhookNotFound struct{ ErrNotFound }
+
+func (WebhookNotFound) Error() string {
+	return "Webhook not found"
+}
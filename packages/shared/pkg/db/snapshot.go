@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/id"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 )
 
 type SnapshotInfo struct {
@@ -26,6 +30,47 @@ type SnapshotInfo struct {
 	FirecrackerVersion string
 	EnvdVersion        string
 	EnvdSecured        bool
+
+	// Rate limits of the sandbox, 0 means unlimited
+	NetworkBandwidthMiBps int64
+	NetworkOps            int64
+	DiskBandwidthMiBps    int64
+	DiskIops              int64
+
+	// Memory the sandbox is sized down to by the balloon device, 0 means the whole RAMMB
+	MemoryTargetMB int64
+
+	NetworkPolicy *types.SandboxNetworkPolicy
+	PortAccess    *types.SandboxPortAccess
+
+	// Set when the sandbox is paused, the snapshot is deleted after the retention of the tier or the override
+	PausedAt       *time.Time
+	RetentionHours *int64
+
+	// Set when the sandbox is paused only to be resumed on another node, the transient snapshot isn't counted against the paused storage quota
+	Migration bool
+
+	// Env and build the sandbox was started from, the snapshot builds are based on its builds
+	SourceTemplateID string
+	SourceBuildID    *uuid.UUID
+}
+
+// sourceEnvID returns nil when the env the sandbox was started from isn't known.
+func sourceEnvID(snapshotConfig *SnapshotInfo) *string {
+	if snapshotConfig.SourceTemplateID == "" {
+		return nil
+	}
+
+	return &snapshotConfig.SourceTemplateID
+}
+
+// limitOverride returns nil for the unlimited value, so the build doesn't override the tier limit.
+func limitOverride(limit int64) *int64 {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &limit
 }
 
 // Check if there exists snapshot with the ID, if yes then return a new
@@ -34,6 +79,26 @@ func (db *DB) NewSnapshotBuild(
 	ctx context.Context,
 	snapshotConfig *SnapshotInfo,
 	teamID uuid.UUID,
+) (*models.EnvBuild, error) {
+	return db.newSnapshotBuild(ctx, snapshotConfig, teamID, false)
+}
+
+// NewCheckpointBuild creates a new build of the sandbox snapshot env for the checkpoint of the running sandbox.
+// The snapshot of the sandbox is only created if it doesn't exist yet, the existing one isn't updated,
+// and the build is marked as a checkpoint, so the sandbox isn't resumed from it.
+func (db *DB) NewCheckpointBuild(
+	ctx context.Context,
+	snapshotConfig *SnapshotInfo,
+	teamID uuid.UUID,
+) (*models.EnvBuild, error) {
+	return db.newSnapshotBuild(ctx, snapshotConfig, teamID, true)
+}
+
+func (db *DB) newSnapshotBuild(
+	ctx context.Context,
+	snapshotConfig *SnapshotInfo,
+	teamID uuid.UUID,
+	checkpoint bool,
 ) (*models.EnvBuild, error) {
 	tx, err := db.Client.BeginTx(ctx, nil)
 	if err != nil {
@@ -41,6 +106,13 @@ func (db *DB) NewSnapshotBuild(
 	}
 	defer tx.Rollback()
 
+	if !checkpoint && !snapshotConfig.Migration {
+		err = checkPausedStorageQuota(ctx, tx, snapshotConfig, teamID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	s, err := tx.
 		Snapshot.
 		Query().
@@ -68,6 +140,7 @@ func (db *DB) NewSnapshotBuild(
 			SetPublic(false).
 			SetNillableCreatedBy(nil).
 			SetTeamID(teamID).
+			SetNillableSourceEnvID(sourceEnvID(snapshotConfig)).
 			SetID(envID).
 			Save(ctx)
 		if err != nil {
@@ -83,19 +156,49 @@ func (db *DB) NewSnapshotBuild(
 			SetMetadata(snapshotConfig.Metadata).
 			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt).
 			SetEnvSecure(snapshotConfig.EnvdSecured).
+			SetNetworkPolicy(snapshotConfig.NetworkPolicy).
+			SetPortAccess(snapshotConfig.PortAccess).
+			SetNillablePausedAt(snapshotConfig.PausedAt).
+			SetNillableRetentionHours(snapshotConfig.RetentionHours).
 			Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
+	} else if checkpoint {
+		e = s.Edges.Env
 	} else {
 		e = s.Edges.Env
-		// Update existing snapshot with new metadata and pause time
-		err = tx.
+		// Update existing snapshot with new metadata, network policy, port access and pause time
+		update := tx.
 			Snapshot.
 			UpdateOne(s).
 			SetMetadata(snapshotConfig.Metadata).
-			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt).
-			Exec(ctx)
+			SetSandboxStartedAt(snapshotConfig.SandboxStartedAt)
+		if snapshotConfig.PausedAt != nil {
+			update.SetPausedAt(*snapshotConfig.PausedAt)
+		} else {
+			update.ClearPausedAt()
+		}
+
+		if snapshotConfig.RetentionHours != nil {
+			update.SetRetentionHours(*snapshotConfig.RetentionHours)
+		} else {
+			update.ClearRetentionHours()
+		}
+
+		if snapshotConfig.NetworkPolicy != nil {
+			update.SetNetworkPolicy(snapshotConfig.NetworkPolicy)
+		} else {
+			update.ClearNetworkPolicy()
+		}
+
+		if snapshotConfig.PortAccess != nil {
+			update.SetPortAccess(snapshotConfig.PortAccess)
+		} else {
+			update.ClearPortAccess()
+		}
+
+		err = update.Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to update snapshot '%s': %w", snapshotConfig.SandboxID, err)
 		}
@@ -113,6 +216,13 @@ func (db *DB) NewSnapshotBuild(
 		SetEnvdVersion(snapshotConfig.EnvdVersion).
 		SetStatus(envbuild.StatusSnapshotting).
 		SetTotalDiskSizeMB(snapshotConfig.TotalDiskSizeMB).
+		SetNillableParentBuildID(snapshotConfig.SourceBuildID).
+		SetNillableNetworkBandwidthMibps(limitOverride(snapshotConfig.NetworkBandwidthMiBps)).
+		SetNillableNetworkOps(limitOverride(snapshotConfig.NetworkOps)).
+		SetNillableDiskBandwidthMibps(limitOverride(snapshotConfig.DiskBandwidthMiBps)).
+		SetNillableDiskIops(limitOverride(snapshotConfig.DiskIops)).
+		SetNillableMemoryTargetMB(limitOverride(snapshotConfig.MemoryTargetMB)).
+		SetCheckpoint(checkpoint).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create env build '%s': %w", snapshotConfig.SandboxID, err)
@@ -126,6 +236,74 @@ func (db *DB) NewSnapshotBuild(
 	return b, nil
 }
 
+// checkPausedStorageQuota checks the snapshot of the sandbox fits the paused storage quota of the team.
+// The team is locked until the transaction ends, so the concurrent pauses of its sandboxes are checked one after another,
+// the snapshots being paused are counted too. The snapshot is counted as the whole memory and disk of the sandbox,
+// it replaces the previous snapshot of the same sandbox.
+func checkPausedStorageQuota(ctx context.Context, tx *models.Tx, snapshotConfig *SnapshotInfo, teamID uuid.UUID) error {
+	tierID, err := tx.
+		Team.
+		Query().
+		Where(team.ID(teamID)).
+		Select(team.FieldTier).
+		Modify(func(s *sql.Selector) {
+			s.ForUpdate()
+		}).
+		String(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock team '%s': %w", teamID, err)
+	}
+
+	t, err := tx.Tier.Get(ctx, tierID)
+	if err != nil {
+		return fmt.Errorf("failed to get tier '%s': %w", tierID, err)
+	}
+
+	if t.PausedStorageMB <= 0 {
+		return nil
+	}
+
+	usedMB, err := tx.
+		Snapshot.
+		Query().
+		Where(
+			snapshot.SandboxIDNEQ(snapshotConfig.SandboxID),
+			snapshot.HasEnvWith(env.TeamID(teamID)),
+		).
+		Modify(func(s *sql.Selector) {
+			b := sql.Table(envbuild.Table).Schema(models.DefaultSchemaConfig.EnvBuild)
+			// The last build of the snapshot, the one being paused or the stored one
+			last := sql.Dialect(s.Dialect()).
+				Select(fmt.Sprintf("%s + COALESCE(%s, 0)", b.C(envbuild.FieldRAMMB), b.C(envbuild.FieldTotalDiskSizeMB))).
+				From(b).
+				Where(sql.And(
+					sql.ColumnsEQ(b.C(envbuild.FieldEnvID), s.C(snapshot.FieldEnvID)),
+					sql.In(b.C(envbuild.FieldStatus), envbuild.StatusSuccess.String(), envbuild.StatusSnapshotting.String()),
+				)).
+				OrderBy(sql.Desc(b.C(envbuild.FieldCreatedAt))).
+				Limit(1)
+
+			s.Select().AppendSelectExprAs(sql.ExprFunc(func(builder *sql.Builder) {
+				builder.WriteString("COALESCE(SUM((").Join(last).WriteString(")), 0)::bigint")
+			}), "storage_mb")
+		}).
+		Int(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get paused storage of team '%s': %w", teamID, err)
+	}
+
+	requestedMB := snapshotConfig.RAMMB + snapshotConfig.TotalDiskSizeMB
+	if int64(usedMB)+requestedMB > t.PausedStorageMB {
+		return PausedStorageQuotaExceeded{
+			UsedMB:      int64(usedMB),
+			RequestedMB: requestedMB,
+			LimitMB:     t.PausedStorageMB,
+		}
+	}
+
+	return nil
+}
+
 func (db *DB) GetSnapshotBuilds(ctx context.Context, sandboxID string, teamID uuid.UUID) (
 	*models.Env,
 	[]*models.EnvBuild,
@@ -154,3 +332,216 @@ func (db *DB) GetSnapshotBuilds(ctx context.Context, sandboxID string, teamID uu
 
 	return e, e.Edges.Builds, nil
 }
+
+// NewForkBuild creates a new env with a single build for the live snapshot of the forked sandbox.
+// The env doesn't have any snapshot attached, so it's shared by all the forked sandboxes.
+// It's marked with the source sandbox, so it's removed once no sandbox runs from it and no env is derived from it.
+func (db *DB) NewForkBuild(
+	ctx context.Context,
+	snapshotConfig *SnapshotInfo,
+	teamID uuid.UUID,
+) (*models.EnvBuild, error) {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	e, err := tx.
+		Env.
+		Create().
+		SetPublic(false).
+		SetNillableCreatedBy(nil).
+		SetTeamID(teamID).
+		SetNillableSourceEnvID(sourceEnvID(snapshotConfig)).
+		SetSourceSandboxID(snapshotConfig.SandboxID).
+		SetID(id.Generate()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create env for fork of '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
+	b, err := tx.
+		EnvBuild.
+		Create().
+		SetEnv(e).
+		SetVcpu(snapshotConfig.VCPU).
+		SetRAMMB(snapshotConfig.RAMMB).
+		SetFreeDiskSizeMB(0).
+		SetKernelVersion(snapshotConfig.KernelVersion).
+		SetFirecrackerVersion(snapshotConfig.FirecrackerVersion).
+		SetEnvdVersion(snapshotConfig.EnvdVersion).
+		SetStatus(envbuild.StatusSnapshotting).
+		SetTotalDiskSizeMB(snapshotConfig.TotalDiskSizeMB).
+		SetNillableParentBuildID(snapshotConfig.SourceBuildID).
+		SetNillableNetworkBandwidthMibps(limitOverride(snapshotConfig.NetworkBandwidthMiBps)).
+		SetNillableNetworkOps(limitOverride(snapshotConfig.NetworkOps)).
+		SetNillableDiskBandwidthMibps(limitOverride(snapshotConfig.DiskBandwidthMiBps)).
+		SetNillableDiskIops(limitOverride(snapshotConfig.DiskIops)).
+		SetNillableMemoryTargetMB(limitOverride(snapshotConfig.MemoryTargetMB)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create env build for fork of '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return b, nil
+}
+
+// NewCommitBuild creates a new template with the build the sandbox snapshot is stored under, the optional alias is claimed for the template.
+func (db *DB) NewCommitBuild(
+	ctx context.Context,
+	snapshotConfig *SnapshotInfo,
+	teamID uuid.UUID,
+	clusterID *uuid.UUID,
+	createdBy *uuid.UUID,
+	alias string,
+) (*models.EnvBuild, error) {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if alias != "" {
+		envExists, err := tx.Env.Query().Where(env.ID(alias)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check template '%s': %w", alias, err)
+		}
+
+		aliasExists, err := tx.EnvAlias.Query().Where(envalias.ID(alias)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check alias '%s': %w", alias, err)
+		}
+
+		if envExists || aliasExists {
+			return nil, TemplateAliasAlreadyUsed{}
+		}
+	}
+
+	e, err := tx.
+		Env.
+		Create().
+		SetPublic(false).
+		SetNillableCreatedBy(createdBy).
+		SetTeamID(teamID).
+		SetNillableClusterID(clusterID).
+		SetNillableSourceEnvID(sourceEnvID(snapshotConfig)).
+		SetID(id.Generate()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create env for template of '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
+	b, err := tx.
+		EnvBuild.
+		Create().
+		SetEnv(e).
+		SetVcpu(snapshotConfig.VCPU).
+		SetRAMMB(snapshotConfig.RAMMB).
+		SetFreeDiskSizeMB(0).
+		SetKernelVersion(snapshotConfig.KernelVersion).
+		SetFirecrackerVersion(snapshotConfig.FirecrackerVersion).
+		SetEnvdVersion(snapshotConfig.EnvdVersion).
+		SetStatus(envbuild.StatusSnapshotting).
+		SetTotalDiskSizeMB(snapshotConfig.TotalDiskSizeMB).
+		SetNillableParentBuildID(snapshotConfig.SourceBuildID).
+		SetBaseEnvID(snapshotConfig.BaseTemplateID).
+		SetNillableNetworkBandwidthMibps(limitOverride(snapshotConfig.NetworkBandwidthMiBps)).
+		SetNillableNetworkOps(limitOverride(snapshotConfig.NetworkOps)).
+		SetNillableDiskBandwidthMibps(limitOverride(snapshotConfig.DiskBandwidthMiBps)).
+		SetNillableDiskIops(limitOverride(snapshotConfig.DiskIops)).
+		SetNillableMemoryTargetMB(limitOverride(snapshotConfig.MemoryTargetMB)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create env build for template of '%s': %w", snapshotConfig.SandboxID, err)
+	}
+
+	if alias != "" {
+		err = tx.
+			EnvAlias.
+			Create().
+			SetEnvID(e.ID).
+			SetIsRenamable(true).
+			SetID(alias).
+			Exec(ctx)
+		// The alias was claimed by a concurrent request since the check above
+		if models.IsConstraintError(err) {
+			return nil, TemplateAliasAlreadyUsed{}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create alias '%s': %w", alias, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return b, nil
+}
+
+// SetSnapshotResumed clears the pause time of the snapshot, the snapshot of a running sandbox doesn't expire.
+func (db *DB) SetSnapshotResumed(ctx context.Context, sandboxID string, teamID uuid.UUID) error {
+	err := db.
+		Client.
+		Snapshot.
+		Update().
+		Where(
+			snapshot.SandboxID(sandboxID),
+			snapshot.HasEnvWith(env.TeamID(teamID)),
+		).
+		ClearPausedAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set snapshot '%s' resumed: %w", sandboxID, err)
+	}
+
+	return nil
+}
+
+// DeleteSnapshotEnv deletes the snapshot env of the sandbox and reports whether its builds can be removed.
+// When other envs are derived from the snapshot (the sandbox was forked), only the snapshot is deleted
+// and the env is kept with its builds until the derived envs are removed.
+func (db *DB) DeleteSnapshotEnv(ctx context.Context, envID string, sandboxID string) (bool, error) {
+	tx, err := db.Client.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	derived, err := tx.Env.Query().Where(env.SourceEnvID(envID)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check envs derived from '%s': %w", envID, err)
+	}
+
+	if derived {
+		_, err = tx.Snapshot.Delete().Where(snapshot.EnvID(envID)).Exec(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to delete snapshot of '%s': %w", sandboxID, err)
+		}
+
+		err = tx.Env.UpdateOneID(envID).SetSourceSandboxID(sandboxID).Exec(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to update env '%s': %w", envID, err)
+		}
+	} else {
+		_, err = tx.Env.Delete().Where(env.ID(envID)).Exec(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to delete env '%s': %w", envID, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return !derived, nil
+}
@@ -0,0 +1,223 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+)
+
+func newMockDB(t *testing.T) (*DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	drv := entsql.OpenDB(dialect.Postgres, sqlDB)
+	client := models.NewClient(models.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+
+	return &DB{Client: client}, mock
+}
+
+// expectPausedStorageQuota expects the team to be locked and its tier with the paused storage quota to be read.
+func expectPausedStorageQuota(mock sqlmock.Sqlmock, limitMB int64) {
+	mock.ExpectQuery(`SELECT "teams"."tier" FROM "public"."teams" WHERE "public"."teams"."id" = \$1 FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"tier"}).AddRow("base"))
+	mock.ExpectQuery(`SELECT .* FROM "public"."tiers" WHERE "public"."tiers"."id" = \$1`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "paused_storage_mb"}).AddRow("base", limitMB))
+}
+
+func TestNewCheckpointBuildKeepsSnapshot(t *testing.T) {
+	db, mock := newMockDB(t)
+
+	teamID := uuid.New()
+	envID := "snapshot-env"
+	parentBuildID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FROM "public"."snapshots"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "env_id", "sandbox_id", "base_env_id", "sandbox_started_at", "env_secure"}).
+			AddRow(uuid.New(), now, envID, "sandbox-id", "base-env", now, false))
+	mock.ExpectQuery(`SELECT .* FROM "public"."envs"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "team_id", "public", "build_count", "spawn_count"}).
+			AddRow(envID, now, now, teamID, false, 1, 0))
+	// The existing snapshot isn't updated, only the checkpoint build is added
+	mock.ExpectQuery(`INSERT INTO "public"."env_builds"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+	mock.ExpectCommit()
+
+	b, err := db.NewCheckpointBuild(context.Background(), &SnapshotInfo{
+		SandboxID:     "sandbox-id",
+		SourceBuildID: &parentBuildID,
+	}, teamID)
+	require.NoError(t, err)
+
+	assert.True(t, b.Checkpoint)
+	assert.Equal(t, &parentBuildID, b.ParentBuildID)
+	assert.Equal(t, envID, *b.EnvID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNewSnapshotBuildUpdatesSnapshot(t *testing.T) {
+	db, mock := newMockDB(t)
+
+	teamID := uuid.New()
+	envID := "snapshot-env"
+	pausedAt := time.Now()
+
+	mock.ExpectBegin()
+	expectPausedStorageQuota(mock, 0)
+	mock.ExpectQuery(`SELECT .* FROM "public"."snapshots"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "env_id", "sandbox_id", "base_env_id", "sandbox_started_at", "env_secure"}).
+			AddRow(uuid.New(), pausedAt, envID, "sandbox-id", "base-env", pausedAt, false))
+	mock.ExpectQuery(`SELECT .* FROM "public"."envs"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "team_id", "public", "build_count", "spawn_count"}).
+			AddRow(envID, pausedAt, pausedAt, teamID, false, 1, 0))
+	mock.ExpectExec(`UPDATE "public"."snapshots" SET .*"paused_at"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .* FROM "public"."snapshots" WHERE "id" = \$1`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "env_id", "sandbox_id"}).
+			AddRow(uuid.New(), envID, "sandbox-id"))
+	mock.ExpectQuery(`INSERT INTO "public"."env_builds"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+	mock.ExpectCommit()
+
+	b, err := db.NewSnapshotBuild(context.Background(), &SnapshotInfo{
+		SandboxID: "sandbox-id",
+		PausedAt:  &pausedAt,
+	}, teamID)
+	require.NoError(t, err)
+
+	assert.False(t, b.Checkpoint)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNewSnapshotBuildPausedStorageQuota(t *testing.T) {
+	newSnapshot := func() *SnapshotInfo {
+		return &SnapshotInfo{
+			SandboxID:       "sandbox-id",
+			RAMMB:           512,
+			TotalDiskSizeMB: 1024,
+		}
+	}
+
+	t.Run("rejects the snapshot over the quota", func(t *testing.T) {
+		db, mock := newMockDB(t)
+
+		mock.ExpectBegin()
+		expectPausedStorageQuota(mock, 4096)
+		// The last stored or being paused build of the other snapshots of the team is counted
+		mock.ExpectQuery(`SELECT \(COALESCE\(SUM\(\(SELECT "public"."env_builds"."ram_mb" \+ COALESCE\("public"."env_builds"."total_disk_size_mb", 0\) FROM "public"."env_builds" `+
+			`WHERE "public"."env_builds"."env_id" = "public"."snapshots"."env_id" AND "public"."env_builds"."status" IN \(\$1, \$2\) `+
+			`ORDER BY "public"."env_builds"."created_at" DESC LIMIT 1\)\), 0\)::bigint\) AS "storage_mb" FROM "public"."snapshots" WHERE "public"."snapshots"."sandbox_id" <> \$3`).
+			WithArgs("success", "snapshotting", "sandbox-id", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"storage_mb"}).AddRow(3072))
+		mock.ExpectRollback()
+
+		_, err := db.NewSnapshotBuild(context.Background(), newSnapshot(), uuid.New())
+
+		var quotaErr PausedStorageQuotaExceeded
+		require.ErrorAs(t, err, &quotaErr)
+		assert.Equal(t, PausedStorageQuotaExceeded{UsedMB: 3072, RequestedMB: 1536, LimitMB: 4096}, quotaErr)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("creates the snapshot within the quota", func(t *testing.T) {
+		db, mock := newMockDB(t)
+
+		mock.ExpectBegin()
+		expectPausedStorageQuota(mock, 4096)
+		mock.ExpectQuery(`SELECT \(COALESCE\(SUM`).
+			WillReturnRows(sqlmock.NewRows([]string{"storage_mb"}).AddRow(2560))
+		mock.ExpectQuery(`SELECT .* FROM "public"."snapshots"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectExec(`INSERT INTO "public"."envs"`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`INSERT INTO "public"."snapshots"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+		mock.ExpectQuery(`INSERT INTO "public"."env_builds"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+		mock.ExpectCommit()
+
+		_, err := db.NewSnapshotBuild(context.Background(), newSnapshot(), uuid.New())
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("doesn't limit the migration snapshot", func(t *testing.T) {
+		db, mock := newMockDB(t)
+
+		// The team isn't locked and its paused storage isn't counted
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT .* FROM "public"."snapshots"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectExec(`INSERT INTO "public"."envs"`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`INSERT INTO "public"."snapshots"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+		mock.ExpectQuery(`INSERT INTO "public"."env_builds"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+		mock.ExpectCommit()
+
+		snapshot := newSnapshot()
+		snapshot.Migration = true
+
+		_, err := db.NewSnapshotBuild(context.Background(), snapshot, uuid.New())
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewCommitBuildAliasAlreadyUsed(t *testing.T) {
+	snapshot := &SnapshotInfo{
+		SandboxID:      "sandbox-id",
+		BaseTemplateID: "base-env",
+	}
+
+	t.Run("rejects an existing alias", func(t *testing.T) {
+		db, mock := newMockDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT .* FROM "public"."envs"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(`SELECT .* FROM "public"."env_aliases"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("my-template"))
+		mock.ExpectRollback()
+
+		_, err := db.NewCommitBuild(context.Background(), snapshot, uuid.New(), nil, nil, "my-template")
+		require.ErrorIs(t, err, TemplateAliasAlreadyUsed{})
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects an alias claimed concurrently", func(t *testing.T) {
+		db, mock := newMockDB(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT .* FROM "public"."envs"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectQuery(`SELECT .* FROM "public"."env_aliases"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectExec(`INSERT INTO "public"."envs"`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`INSERT INTO "public"."env_builds"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+		mock.ExpectExec(`INSERT INTO "public"."env_aliases"`).
+			WillReturnError(errors.New(`pq: duplicate key value violates unique constraint "env_aliases_pkey"`))
+		mock.ExpectRollback()
+
+		_, err := db.NewCommitBuild(context.Background(), snapshot, uuid.New(), nil, nil, "my-template")
+		require.ErrorIs(t, err, TemplateAliasAlreadyUsed{})
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+)
+
+// GetTeamWithTier returns the team with its tier, the usage of the team isn't validated.
+func (db *DB) GetTeamWithTier(ctx context.Context, teamID uuid.UUID) (*models.Team, *models.Tier, error) {
+	result, err := db.
+		Client.
+		Team.
+		Query().
+		Where(team.ID(teamID)).
+		WithTeamTier().
+		Only(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get team '%s': %w", teamID, err)
+	}
+
+	return result, result.Edges.TeamTier, nil
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+)
+
+// SetWarmPoolSize sets the number of pre-warmed sandboxes of the template kept for the team, size 0 removes the pool.
+func (db *DB) SetWarmPoolSize(ctx context.Context, teamID uuid.UUID, envID string, size int32) error {
+	if size == 0 {
+		_, err := db.
+			Client.
+			WarmPool.
+			Delete().
+			Where(
+				warmpool.TeamID(teamID),
+				warmpool.EnvID(envID),
+			).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete warm pool of '%s': %w", envID, err)
+		}
+
+		return nil
+	}
+
+	err := db.
+		Client.
+		WarmPool.
+		Create().
+		SetTeamID(teamID).
+		SetEnvID(envID).
+		SetSize(size).
+		OnConflictColumns(warmpool.FieldTeamID, warmpool.FieldEnvID).
+		Update(func(u *models.WarmPoolUpsert) {
+			u.SetSize(size)
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set warm pool of '%s': %w", envID, err)
+	}
+
+	return nil
+}
+
+// GetWarmPoolSize returns the number of pre-warmed sandboxes of the template kept for the team.
+func (db *DB) GetWarmPoolSize(ctx context.Context, teamID uuid.UUID, envID string) (int32, error) {
+	pool, err := db.
+		Client.
+		WarmPool.
+		Query().
+		Where(
+			warmpool.TeamID(teamID),
+			warmpool.EnvID(envID),
+		).
+		Only(ctx)
+	if models.IsNotFound(err) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to get warm pool of '%s': %w", envID, err)
+	}
+
+	return pool.Size, nil
+}
+
+// GetWarmPools returns all the configured warm pools with their teams and tiers.
+func (db *DB) GetWarmPools(ctx context.Context) ([]*models.WarmPool, error) {
+	pools, err := db.
+		Client.
+		WarmPool.
+		Query().
+		Where(warmpool.SizeGT(0)).
+		WithTeam(func(q *models.TeamQuery) {
+			q.WithTeamTier()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get warm pools: %w", err)
+	}
+
+	return pools, nil
+}
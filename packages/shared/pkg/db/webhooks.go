@@ -0,0 +1,178 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/models"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhook"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
+)
+
+// CreateWebhook registers the endpoint receiving the events of the team.
+func (db *DB) CreateWebhook(ctx context.Context, teamID uuid.UUID, url, secret string, events []string) (*models.Webhook, error) {
+	w, err := db.
+		Client.
+		Webhook.
+		Create().
+		SetTeamID(teamID).
+		SetURL(url).
+		SetSecret(secret).
+		SetEvents(events).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return w, nil
+}
+
+// GetWebhooks returns all the webhooks of the team.
+func (db *DB) GetWebhooks(ctx context.Context, teamID uuid.UUID) ([]*models.Webhook, error) {
+	webhooks, err := db.
+		Client.
+		Webhook.
+		Query().
+		Where(webhook.TeamID(teamID)).
+		Order(models.Asc(webhook.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// GetWebhook returns the webhook of the team.
+func (db *DB) GetWebhook(ctx context.Context, teamID, webhookID uuid.UUID) (*models.Webhook, error) {
+	w, err := db.
+		Client.
+		Webhook.
+		Query().
+		Where(
+			webhook.ID(webhookID),
+			webhook.TeamID(teamID),
+		).
+		Only(ctx)
+	if models.IsNotFound(err) {
+		return nil, WebhookNotFound{}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook '%s': %w", webhookID, err)
+	}
+
+	return w, nil
+}
+
+// UpdateWebhook updates the set fields of the webhook of the team.
+func (db *DB) UpdateWebhook(ctx context.Context, teamID, webhookID uuid.UUID, url *string, events []string, enabled *bool) (*models.Webhook, error) {
+	update := db.
+		Client.
+		Webhook.
+		Update().
+		Where(
+			webhook.ID(webhookID),
+			webhook.TeamID(teamID),
+		).
+		SetNillableURL(url).
+		SetNillableEnabled(enabled).
+		SetUpdatedAt(time.Now())
+
+	if events != nil {
+		update.SetEvents(events)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook '%s': %w", webhookID, err)
+	}
+
+	if updated == 0 {
+		return nil, WebhookNotFound{}
+	}
+
+	return db.GetWebhook(ctx, teamID, webhookID)
+}
+
+// DeleteWebhook removes the webhook of the team with its delivery log.
+func (db *DB) DeleteWebhook(ctx context.Context, teamID, webhookID uuid.UUID) error {
+	deleted, err := db.
+		Client.
+		Webhook.
+		Delete().
+		Where(
+			webhook.ID(webhookID),
+			webhook.TeamID(teamID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook '%s': %w", webhookID, err)
+	}
+
+	if deleted == 0 {
+		return WebhookNotFound{}
+	}
+
+	return nil
+}
+
+// GetEnabledWebhooks returns the enabled webhooks of the team, the events aren't filtered.
+func (db *DB) GetEnabledWebhooks(ctx context.Context, teamID uuid.UUID) ([]*models.Webhook, error) {
+	webhooks, err := db.
+		Client.
+		Webhook.
+		Query().
+		Where(
+			webhook.TeamID(teamID),
+			webhook.Enabled(true),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enabled webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// CreateWebhookDelivery records the attempt to deliver the event to the webhook.
+func (db *DB) CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	err := db.
+		Client.
+		WebhookDelivery.
+		Create().
+		SetWebhookID(delivery.WebhookID).
+		SetEventID(delivery.EventID).
+		SetEventType(delivery.EventType).
+		SetSandboxID(delivery.SandboxID).
+		SetAttempt(delivery.Attempt).
+		SetSuccess(delivery.Success).
+		SetNillableStatusCode(delivery.StatusCode).
+		SetNillableError(delivery.Error).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveries returns the latest delivery attempts of the webhook, the newest first.
+func (db *DB) GetWebhookDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*models.WebhookDelivery, error) {
+	deliveries, err := db.
+		Client.
+		WebhookDelivery.
+		Query().
+		Where(webhookdelivery.WebhookID(webhookID)).
+		Order(models.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
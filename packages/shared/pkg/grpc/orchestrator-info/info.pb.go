@@ -134,6 +134,9 @@ type ServiceInfoResponse struct {
 	MetricMemoryUsedMb     int64                  `protobuf:"varint,102,opt,name=metric_memory_used_mb,json=metricMemoryUsedMb,proto3" json:"metric_memory_used_mb,omitempty"`
 	MetricDiskMb           int64                  `protobuf:"varint,103,opt,name=metric_disk_mb,json=metricDiskMb,proto3" json:"metric_disk_mb,omitempty"`
 	MetricSandboxesRunning int64                  `protobuf:"varint,104,opt,name=metric_sandboxes_running,json=metricSandboxesRunning,proto3" json:"metric_sandboxes_running,omitempty"`
+	// builds capacity, zero for services without the template builder role
+	MetricBuildsRunning int64 `protobuf:"varint,105,opt,name=metric_builds_running,json=metricBuildsRunning,proto3" json:"metric_builds_running,omitempty"`
+	MetricBuildsMax     int64 `protobuf:"varint,106,opt,name=metric_builds_max,json=metricBuildsMax,proto3" json:"metric_builds_max,omitempty"`
 }
 
 func (x *ServiceInfoResponse) Reset() {
@@ -245,6 +248,20 @@ func (x *ServiceInfoResponse) GetMetricSandboxesRunning() int64 {
 	return 0
 }
 
+func (x *ServiceInfoResponse) GetMetricBuildsRunning() int64 {
+	if x != nil {
+		return x.MetricBuildsRunning
+	}
+	return 0
+}
+
+func (x *ServiceInfoResponse) GetMetricBuildsMax() int64 {
+	if x != nil {
+		return x.MetricBuildsMax
+	}
+	return 0
+}
+
 type ServiceStatusChangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -299,7 +316,7 @@ var file_info_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
 	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x04, 0x0a, 0x13, 0x53,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x04, 0x0a, 0x13, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
@@ -332,7 +349,13 @@ var file_info_proto_rawDesc = []byte{
 	0x73, 0x6b, 0x4d, 0x62, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x73,
 	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
 	0x18, 0x68, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x61,
-	0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x57,
+	0x6e, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x32,
+	0x0a, 0x15, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x5f,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x69, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x4d, 0x61, 0x78, 0x22, 0x57,
 	0x0a, 0x1a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
 	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0e,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
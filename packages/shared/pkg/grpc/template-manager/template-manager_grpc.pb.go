@@ -29,6 +29,8 @@ type TemplateServiceClient interface {
 	TemplateBuildStatus(ctx context.Context, in *TemplateStatusRequest, opts ...grpc.CallOption) (*TemplateBuildStatusResponse, error)
 	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(ctx context.Context, in *TemplateBuildDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TemplateBuildCancel is a gRPC service that stops a running template build and removes its partial files
+	TemplateBuildCancel(ctx context.Context, in *TemplateBuildCancelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// todo (2025-05): this is deprecated, please use InfoService that is used for both orchestrator and template manager
 	HealthStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatusResponse, error)
 }
@@ -68,6 +70,15 @@ func (c *templateServiceClient) TemplateBuildDelete(ctx context.Context, in *Tem
 	return out, nil
 }
 
+func (c *templateServiceClient) TemplateBuildCancel(ctx context.Context, in *TemplateBuildCancelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/TemplateService/TemplateBuildCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *templateServiceClient) HealthStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatusResponse, error) {
 	out := new(HealthStatusResponse)
 	err := c.cc.Invoke(ctx, "/TemplateService/HealthStatus", in, out, opts...)
@@ -87,6 +98,8 @@ type TemplateServiceServer interface {
 	TemplateBuildStatus(context.Context, *TemplateStatusRequest) (*TemplateBuildStatusResponse, error)
 	// TemplateBuildDelete is a gRPC service that deletes files associated with a template build
 	TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error)
+	// TemplateBuildCancel is a gRPC service that stops a running template build and removes its partial files
+	TemplateBuildCancel(context.Context, *TemplateBuildCancelRequest) (*emptypb.Empty, error)
 	// todo (2025-05): this is deprecated, please use InfoService that is used for both orchestrator and template manager
 	HealthStatus(context.Context, *emptypb.Empty) (*HealthStatusResponse, error)
 	mustEmbedUnimplementedTemplateServiceServer()
@@ -105,6 +118,9 @@ func (UnimplementedTemplateServiceServer) TemplateBuildStatus(context.Context, *
 func (UnimplementedTemplateServiceServer) TemplateBuildDelete(context.Context, *TemplateBuildDeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildDelete not implemented")
 }
+func (UnimplementedTemplateServiceServer) TemplateBuildCancel(context.Context, *TemplateBuildCancelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateBuildCancel not implemented")
+}
 func (UnimplementedTemplateServiceServer) HealthStatus(context.Context, *emptypb.Empty) (*HealthStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthStatus not implemented")
 }
@@ -175,6 +191,24 @@ func _TemplateService_TemplateBuildDelete_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TemplateService_TemplateBuildCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateBuildCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).TemplateBuildCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TemplateService/TemplateBuildCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).TemplateBuildCancel(ctx, req.(*TemplateBuildCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TemplateService_HealthStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -212,6 +246,10 @@ var TemplateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TemplateBuildDelete",
 			Handler:    _TemplateService_TemplateBuildDelete_Handler,
 		},
+		{
+			MethodName: "TemplateBuildCancel",
+			Handler:    _TemplateService_TemplateBuildCancel_Handler,
+		},
 		{
 			MethodName: "HealthStatus",
 			Handler:    _TemplateService_HealthStatus_Handler,
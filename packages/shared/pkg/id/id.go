@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/dchest/uniuri"
+	"github.com/google/uuid"
 )
 
 var caseInsensitiveAlphabet = []byte("abcdefghijklmnopqrstuvwxyz1234567890")
@@ -27,3 +28,69 @@ func CleanEnvID(envID string) (string, error) {
 
 	return cleanedEnvID, nil
 }
+
+// LatestTag resolves to the latest successful build of the template, it can't be set manually.
+const LatestTag = "latest"
+
+var tagRegex = regexp.MustCompile("^[a-z0-9][a-z0-9-_.]*$")
+
+// TemplateRef references the template by its ID or alias, optionally pinned to a tag or a build.
+type TemplateRef struct {
+	AliasOrEnvID string
+	// Tag is empty when the reference isn't pinned to a tag.
+	Tag string
+	// BuildID is nil when the reference isn't pinned to a build.
+	BuildID *uuid.UUID
+}
+
+// ParseTemplateRef parses the template reference in the "template", "template:tag" or "template@buildID" form.
+func ParseTemplateRef(ref string) (TemplateRef, error) {
+	ref = strings.TrimSpace(ref)
+
+	aliasOrEnvID, buildID, pinnedToBuild := strings.Cut(ref, "@")
+	if pinnedToBuild {
+		cleanedEnvID, err := CleanEnvID(aliasOrEnvID)
+		if err != nil {
+			return TemplateRef{}, err
+		}
+
+		parsedBuildID, err := uuid.Parse(buildID)
+		if err != nil {
+			return TemplateRef{}, fmt.Errorf("invalid build ID: %s", buildID)
+		}
+
+		return TemplateRef{AliasOrEnvID: cleanedEnvID, BuildID: &parsedBuildID}, nil
+	}
+
+	aliasOrEnvID, tag, tagged := strings.Cut(ref, ":")
+
+	cleanedEnvID, err := CleanEnvID(aliasOrEnvID)
+	if err != nil {
+		return TemplateRef{}, err
+	}
+
+	if !tagged {
+		return TemplateRef{AliasOrEnvID: cleanedEnvID}, nil
+	}
+
+	cleanedTag, err := CleanTag(tag)
+	if err != nil {
+		return TemplateRef{}, err
+	}
+
+	// The latest tag is the same as the reference without the tag
+	if cleanedTag == LatestTag {
+		cleanedTag = ""
+	}
+
+	return TemplateRef{AliasOrEnvID: cleanedEnvID, Tag: cleanedTag}, nil
+}
+
+func CleanTag(tag string) (string, error) {
+	cleanedTag := strings.ToLower(strings.TrimSpace(tag))
+	if !tagRegex.MatchString(cleanedTag) {
+		return "", fmt.Errorf("invalid tag: %s", tag)
+	}
+
+	return cleanedTag, nil
+}
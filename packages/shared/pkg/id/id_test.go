@@ -0,0 +1,39 @@
+package id
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTemplateRef(t *testing.T) {
+	buildID := uuid.New()
+
+	ref, err := ParseTemplateRef("My-Template")
+	require.NoError(t, err)
+	assert.Equal(t, TemplateRef{AliasOrEnvID: "my-template"}, ref)
+
+	ref, err = ParseTemplateRef("my-template:Stable")
+	require.NoError(t, err)
+	assert.Equal(t, TemplateRef{AliasOrEnvID: "my-template", Tag: "stable"}, ref)
+
+	ref, err = ParseTemplateRef("my-template:v1.2")
+	require.NoError(t, err)
+	assert.Equal(t, TemplateRef{AliasOrEnvID: "my-template", Tag: "v1.2"}, ref)
+
+	// The latest tag is the default reference
+	ref, err = ParseTemplateRef("my-template:latest")
+	require.NoError(t, err)
+	assert.Equal(t, TemplateRef{AliasOrEnvID: "my-template"}, ref)
+
+	ref, err = ParseTemplateRef("my-template@" + buildID.String())
+	require.NoError(t, err)
+	assert.Equal(t, TemplateRef{AliasOrEnvID: "my-template", BuildID: &buildID}, ref)
+
+	for _, invalid := range []string{"", "my-template:", "my-template:.hidden", "my-template@build", "my template", "my-template:stable:canary"} {
+		_, err = ParseTemplateRef(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -1,6 +1,7 @@
 package keys
 
 const (
-	ApiKeyPrefix      = "e2b_"
-	AccessTokenPrefix = "sk_e2b_"
+	ApiKeyPrefix        = "e2b_"
+	AccessTokenPrefix   = "sk_e2b_"
+	WebhookSecretPrefix = "whsec_"
 )
@@ -0,0 +1,69 @@
+package keys
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PortAccessTokenSeedEnv is the environment variable with the seed shared by the services signing and validating the port access tokens.
+const PortAccessTokenSeedEnv = "SANDBOX_PORT_ACCESS_TOKEN_SEED"
+
+// PortAccessTokenSigner signs and validates the tokens granting access to the non-public ports of a sandbox.
+type PortAccessTokenSigner struct {
+	hasher *HMACSha256Hashing
+}
+
+func NewPortAccessTokenSigner(seed []byte) *PortAccessTokenSigner {
+	return &PortAccessTokenSigner{
+		hasher: NewHMACSHA256Hashing(seed),
+	}
+}
+
+// NewPortAccessTokenSignerFromEnv returns nil when the seed isn't configured.
+func NewPortAccessTokenSignerFromEnv() *PortAccessTokenSigner {
+	seed := os.Getenv(PortAccessTokenSeedEnv)
+	if seed == "" {
+		return nil
+	}
+
+	return NewPortAccessTokenSigner([]byte(seed))
+}
+
+// Sign returns the token granting access to the port of the sandbox execution until the expiry.
+// The token is the unix expiry followed by the signature, so it can be validated without storing it.
+func (s *PortAccessTokenSigner) Sign(sandboxID, executionID string, port uint64, expiresAt time.Time) (string, error) {
+	signature, err := s.hasher.Hash(portAccessTokenContent(sandboxID, executionID, port, expiresAt.Unix()))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d.%s", expiresAt.Unix(), signature), nil
+}
+
+// Verify reports whether the token was signed for the port of the sandbox execution and hasn't expired.
+func (s *PortAccessTokenSigner) Verify(sandboxID, executionID string, port uint64, token string) bool {
+	rawExpiresAt, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+
+	expiresAt, err := strconv.ParseInt(rawExpiresAt, 10, 64)
+	if err != nil || !time.Now().Before(time.Unix(expiresAt, 0)) {
+		return false
+	}
+
+	expected, err := s.hasher.Hash(portAccessTokenContent(sandboxID, executionID, port, expiresAt))
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func portAccessTokenContent(sandboxID, executionID string, port uint64, expiresAt int64) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%d:%d", sandboxID, executionID, port, expiresAt))
+}
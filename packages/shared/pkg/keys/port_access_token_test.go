@@ -0,0 +1,55 @@
+package keys
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortAccessTokenSigner(t *testing.T) {
+	signer := NewPortAccessTokenSigner([]byte("test-seed"))
+
+	token, err := signer.Sign("sandbox-id", "execution-id", 8080, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	t.Run("succeeds: same sandbox execution and port", func(t *testing.T) {
+		assert.True(t, signer.Verify("sandbox-id", "execution-id", 8080, token))
+	})
+
+	t.Run("fails: different port", func(t *testing.T) {
+		assert.False(t, signer.Verify("sandbox-id", "execution-id", 8081, token))
+	})
+
+	t.Run("fails: different sandbox", func(t *testing.T) {
+		assert.False(t, signer.Verify("other-sandbox-id", "execution-id", 8080, token))
+	})
+
+	t.Run("fails: different execution", func(t *testing.T) {
+		assert.False(t, signer.Verify("sandbox-id", "other-execution-id", 8080, token))
+	})
+
+	t.Run("fails: different seed", func(t *testing.T) {
+		assert.False(t, NewPortAccessTokenSigner([]byte("other-seed")).Verify("sandbox-id", "execution-id", 8080, token))
+	})
+
+	t.Run("fails: empty token", func(t *testing.T) {
+		assert.False(t, signer.Verify("sandbox-id", "execution-id", 8080, ""))
+	})
+
+	t.Run("fails: expired token", func(t *testing.T) {
+		expired, err := signer.Sign("sandbox-id", "execution-id", 8080, time.Now().Add(-time.Second))
+		require.NoError(t, err)
+
+		assert.False(t, signer.Verify("sandbox-id", "execution-id", 8080, expired))
+	})
+
+	t.Run("fails: extended expiry", func(t *testing.T) {
+		_, signature, _ := strings.Cut(token, ".")
+		extended := strings.Join([]string{"9999999999", signature}, ".")
+
+		assert.False(t, signer.Verify("sandbox-id", "execution-id", 8080, extended))
+	})
+}
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/google/uuid"
+)
+
+// Checkpoint is the model entity for the Checkpoint schema.
+type Checkpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// SandboxID holds the value of the "sandbox_id" field.
+	SandboxID string `json:"sandbox_id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// EnvBuildID holds the value of the "env_build_id" field.
+	EnvBuildID uuid.UUID `json:"env_build_id,omitempty"`
+	// Build the sandbox was running from when the checkpoint was created
+	ParentBuildID *uuid.UUID `json:"parent_build_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CheckpointQuery when eager-loading is set.
+	Edges        CheckpointEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CheckpointEdges holds the relations/edges for other nodes in the graph.
+type CheckpointEdges struct {
+	// Build holds the value of the build edge.
+	Build *EnvBuild `json:"build,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BuildOrErr returns the Build value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CheckpointEdges) BuildOrErr() (*EnvBuild, error) {
+	if e.loadedTypes[0] {
+		if e.Build == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: envbuild.Label}
+		}
+		return e.Build, nil
+	}
+	return nil, &NotLoadedError{edge: "build"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Checkpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checkpoint.FieldParentBuildID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case checkpoint.FieldSandboxID, checkpoint.FieldName:
+			values[i] = new(sql.NullString)
+		case checkpoint.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case checkpoint.FieldID, checkpoint.FieldTeamID, checkpoint.FieldEnvBuildID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Checkpoint fields.
+func (c *Checkpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checkpoint.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case checkpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case checkpoint.FieldSandboxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sandbox_id", values[i])
+			} else if value.Valid {
+				c.SandboxID = value.String
+			}
+		case checkpoint.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				c.TeamID = *value
+			}
+		case checkpoint.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case checkpoint.FieldEnvBuildID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field env_build_id", values[i])
+			} else if value != nil {
+				c.EnvBuildID = *value
+			}
+		case checkpoint.FieldParentBuildID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_build_id", values[i])
+			} else if value.Valid {
+				c.ParentBuildID = new(uuid.UUID)
+				*c.ParentBuildID = *value.S.(*uuid.UUID)
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Checkpoint.
+// This includes values selected through modifiers, order, etc.
+func (c *Checkpoint) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryBuild queries the "build" edge of the Checkpoint entity.
+func (c *Checkpoint) QueryBuild() *EnvBuildQuery {
+	return NewCheckpointClient(c.config).QueryBuild(c)
+}
+
+// Update returns a builder for updating this Checkpoint.
+// Note that you need to call Checkpoint.Unwrap() before calling this method if this Checkpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Checkpoint) Update() *CheckpointUpdateOne {
+	return NewCheckpointClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Checkpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Checkpoint) Unwrap() *Checkpoint {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("models: Checkpoint is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Checkpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("Checkpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("sandbox_id=")
+	builder.WriteString(c.SandboxID)
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("env_build_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.EnvBuildID))
+	builder.WriteString(", ")
+	if v := c.ParentBuildID; v != nil {
+		builder.WriteString("parent_build_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Checkpoints is a parsable slice of Checkpoint.
+type Checkpoints []*Checkpoint
@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the checkpoint type in the database.
+	Label = "checkpoint"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldSandboxID holds the string denoting the sandbox_id field in the database.
+	FieldSandboxID = "sandbox_id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEnvBuildID holds the string denoting the env_build_id field in the database.
+	FieldEnvBuildID = "env_build_id"
+	// FieldParentBuildID holds the string denoting the parent_build_id field in the database.
+	FieldParentBuildID = "parent_build_id"
+	// EdgeBuild holds the string denoting the build edge name in mutations.
+	EdgeBuild = "build"
+	// Table holds the table name of the checkpoint in the database.
+	Table = "checkpoints"
+	// BuildTable is the table that holds the build relation/edge.
+	BuildTable = "checkpoints"
+	// BuildInverseTable is the table name for the EnvBuild entity.
+	// It exists in this package in order to avoid circular dependency with the "envbuild" package.
+	BuildInverseTable = "env_builds"
+	// BuildColumn is the table column denoting the build relation/edge.
+	BuildColumn = "env_build_id"
+)
+
+// Columns holds all SQL columns for checkpoint fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldSandboxID,
+	FieldTeamID,
+	FieldName,
+	FieldEnvBuildID,
+	FieldParentBuildID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Checkpoint queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// BySandboxID orders the results by the sandbox_id field.
+func BySandboxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSandboxID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEnvBuildID orders the results by the env_build_id field.
+func ByEnvBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvBuildID, opts...).ToFunc()
+}
+
+// ByParentBuildID orders the results by the parent_build_id field.
+func ByParentBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentBuildID, opts...).ToFunc()
+}
+
+// ByBuildField orders the results by build field.
+func ByBuildField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBuildStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBuildStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BuildInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BuildTable, BuildColumn),
+	)
+}
@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SandboxID applies equality check predicate on the "sandbox_id" field. It's identical to SandboxIDEQ.
+func SandboxID(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldSandboxID, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldTeamID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldName, v))
+}
+
+// EnvBuildID applies equality check predicate on the "env_build_id" field. It's identical to EnvBuildIDEQ.
+func EnvBuildID(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldEnvBuildID, v))
+}
+
+// ParentBuildID applies equality check predicate on the "parent_build_id" field. It's identical to ParentBuildIDEQ.
+func ParentBuildID(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldParentBuildID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// SandboxIDEQ applies the EQ predicate on the "sandbox_id" field.
+func SandboxIDEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldSandboxID, v))
+}
+
+// SandboxIDNEQ applies the NEQ predicate on the "sandbox_id" field.
+func SandboxIDNEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldSandboxID, v))
+}
+
+// SandboxIDIn applies the In predicate on the "sandbox_id" field.
+func SandboxIDIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldSandboxID, vs...))
+}
+
+// SandboxIDNotIn applies the NotIn predicate on the "sandbox_id" field.
+func SandboxIDNotIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldSandboxID, vs...))
+}
+
+// SandboxIDGT applies the GT predicate on the "sandbox_id" field.
+func SandboxIDGT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldSandboxID, v))
+}
+
+// SandboxIDGTE applies the GTE predicate on the "sandbox_id" field.
+func SandboxIDGTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldSandboxID, v))
+}
+
+// SandboxIDLT applies the LT predicate on the "sandbox_id" field.
+func SandboxIDLT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldSandboxID, v))
+}
+
+// SandboxIDLTE applies the LTE predicate on the "sandbox_id" field.
+func SandboxIDLTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldSandboxID, v))
+}
+
+// SandboxIDContains applies the Contains predicate on the "sandbox_id" field.
+func SandboxIDContains(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContains(FieldSandboxID, v))
+}
+
+// SandboxIDHasPrefix applies the HasPrefix predicate on the "sandbox_id" field.
+func SandboxIDHasPrefix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasPrefix(FieldSandboxID, v))
+}
+
+// SandboxIDHasSuffix applies the HasSuffix predicate on the "sandbox_id" field.
+func SandboxIDHasSuffix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasSuffix(FieldSandboxID, v))
+}
+
+// SandboxIDEqualFold applies the EqualFold predicate on the "sandbox_id" field.
+func SandboxIDEqualFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEqualFold(FieldSandboxID, v))
+}
+
+// SandboxIDContainsFold applies the ContainsFold predicate on the "sandbox_id" field.
+func SandboxIDContainsFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContainsFold(FieldSandboxID, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldTeamID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnvBuildIDEQ applies the EQ predicate on the "env_build_id" field.
+func EnvBuildIDEQ(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldEnvBuildID, v))
+}
+
+// EnvBuildIDNEQ applies the NEQ predicate on the "env_build_id" field.
+func EnvBuildIDNEQ(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldEnvBuildID, v))
+}
+
+// EnvBuildIDIn applies the In predicate on the "env_build_id" field.
+func EnvBuildIDIn(vs ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldEnvBuildID, vs...))
+}
+
+// EnvBuildIDNotIn applies the NotIn predicate on the "env_build_id" field.
+func EnvBuildIDNotIn(vs ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldEnvBuildID, vs...))
+}
+
+// ParentBuildIDEQ applies the EQ predicate on the "parent_build_id" field.
+func ParentBuildIDEQ(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldParentBuildID, v))
+}
+
+// ParentBuildIDNEQ applies the NEQ predicate on the "parent_build_id" field.
+func ParentBuildIDNEQ(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldParentBuildID, v))
+}
+
+// ParentBuildIDIn applies the In predicate on the "parent_build_id" field.
+func ParentBuildIDIn(vs ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldParentBuildID, vs...))
+}
+
+// ParentBuildIDNotIn applies the NotIn predicate on the "parent_build_id" field.
+func ParentBuildIDNotIn(vs ...uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldParentBuildID, vs...))
+}
+
+// ParentBuildIDGT applies the GT predicate on the "parent_build_id" field.
+func ParentBuildIDGT(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldParentBuildID, v))
+}
+
+// ParentBuildIDGTE applies the GTE predicate on the "parent_build_id" field.
+func ParentBuildIDGTE(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldParentBuildID, v))
+}
+
+// ParentBuildIDLT applies the LT predicate on the "parent_build_id" field.
+func ParentBuildIDLT(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldParentBuildID, v))
+}
+
+// ParentBuildIDLTE applies the LTE predicate on the "parent_build_id" field.
+func ParentBuildIDLTE(v uuid.UUID) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldParentBuildID, v))
+}
+
+// ParentBuildIDIsNil applies the IsNil predicate on the "parent_build_id" field.
+func ParentBuildIDIsNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIsNull(FieldParentBuildID))
+}
+
+// ParentBuildIDNotNil applies the NotNil predicate on the "parent_build_id" field.
+func ParentBuildIDNotNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotNull(FieldParentBuildID))
+}
+
+// HasBuild applies the HasEdge predicate on the "build" edge.
+func HasBuild() predicate.Checkpoint {
+	return predicate.Checkpoint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BuildTable, BuildColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBuildWith applies the HasEdge predicate on the "build" edge with a given conditions (other predicates).
+func HasBuildWith(preds ...predicate.EnvBuild) predicate.Checkpoint {
+	return predicate.Checkpoint(func(s *sql.Selector) {
+		step := newBuildStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Checkpoint) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Checkpoint) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Checkpoint) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.NotPredicates(p))
+}
@@ -0,0 +1,41 @@
+package chmodels
+
+import "time"
+
+type SandboxUsageEventType string
+
+const (
+	SandboxUsageStart  SandboxUsageEventType = "start"
+	SandboxUsageResume SandboxUsageEventType = "resume"
+	SandboxUsageStop   SandboxUsageEventType = "stop"
+	SandboxUsagePause  SandboxUsageEventType = "pause"
+)
+
+// SandboxUsage is a lifecycle event of the sandbox, the stop and pause events carry the usage of the run that ended.
+type SandboxUsage struct {
+	Timestamp       time.Time `ch:"timestamp"`
+	EventType       string    `ch:"event_type"`
+	TeamID          string    `ch:"team_id"`
+	SandboxID       string    `ch:"sandbox_id"`
+	ExecutionID     string    `ch:"execution_id"`
+	TemplateID      string    `ch:"template_id"`
+	BuildID         string    `ch:"build_id"`
+	VCPUCount       uint32    `ch:"vcpu_count"`
+	RAMMiB          uint64    `ch:"ram_mib"`
+	DiskMiB         uint64    `ch:"disk_mib"`
+	DurationSeconds float64   `ch:"duration_seconds"`
+	VCPUSeconds     float64   `ch:"vcpu_seconds"`
+	RAMMiBSeconds   float64   `ch:"ram_mib_seconds"`
+	DiskMiBSeconds  float64   `ch:"disk_mib_seconds"`
+}
+
+// TeamUsage is the usage of the team aggregated by the template or the day.
+type TeamUsage struct {
+	TemplateID      string    `ch:"template_id"`
+	Day             time.Time `ch:"day"`
+	Runs            uint64    `ch:"runs"`
+	DurationSeconds float64   `ch:"duration_seconds"`
+	VCPUSeconds     float64   `ch:"vcpu_seconds"`
+	RAMMiBSeconds   float64   `ch:"ram_mib_seconds"`
+	DiskMiBSeconds  float64   `ch:"disk_mib_seconds"`
+}
@@ -13,16 +13,22 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/auditlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/cluster"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhook"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -83,17 +89,23 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			accesstoken.Table: accesstoken.ValidColumn,
-			cluster.Table:     cluster.ValidColumn,
-			env.Table:         env.ValidColumn,
-			envalias.Table:    envalias.ValidColumn,
-			envbuild.Table:    envbuild.ValidColumn,
-			snapshot.Table:    snapshot.ValidColumn,
-			team.Table:        team.ValidColumn,
-			teamapikey.Table:  teamapikey.ValidColumn,
-			tier.Table:        tier.ValidColumn,
-			user.Table:        user.ValidColumn,
-			usersteams.Table:  usersteams.ValidColumn,
+			accesstoken.Table:     accesstoken.ValidColumn,
+			auditlog.Table:        auditlog.ValidColumn,
+			checkpoint.Table:      checkpoint.ValidColumn,
+			cluster.Table:         cluster.ValidColumn,
+			env.Table:             env.ValidColumn,
+			envalias.Table:        envalias.ValidColumn,
+			envbuild.Table:        envbuild.ValidColumn,
+			envbuildtag.Table:     envbuildtag.ValidColumn,
+			snapshot.Table:        snapshot.ValidColumn,
+			team.Table:            team.ValidColumn,
+			teamapikey.Table:      teamapikey.ValidColumn,
+			tier.Table:            tier.ValidColumn,
+			user.Table:            user.ValidColumn,
+			usersteams.Table:      usersteams.ValidColumn,
+			warmpool.Table:        warmpool.ValidColumn,
+			webhook.Table:         webhook.ValidColumn,
+			webhookdelivery.Table: webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
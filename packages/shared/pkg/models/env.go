@@ -38,6 +38,10 @@ type Env struct {
 	LastSpawnedAt time.Time `json:"last_spawned_at,omitempty"`
 	// ClusterID holds the value of the "cluster_id" field.
 	ClusterID *uuid.UUID `json:"cluster_id,omitempty"`
+	// Env the snapshot of the sandbox was taken from, its builds are the base of the env builds
+	SourceEnvID *string `json:"source_env_id,omitempty"`
+	// Sandbox the env holds the state of for the envs derived from it, the env is removed once it is not used
+	SourceSandboxID *string `json:"source_sandbox_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvQuery when eager-loading is set.
 	Edges        EnvEdges `json:"edges"`
@@ -56,9 +60,13 @@ type EnvEdges struct {
 	Builds []*EnvBuild `json:"builds,omitempty"`
 	// Snapshots holds the value of the snapshots edge.
 	Snapshots []*Snapshot `json:"snapshots,omitempty"`
+	// WarmPools holds the value of the warm_pools edge.
+	WarmPools []*WarmPool `json:"warm_pools,omitempty"`
+	// BuildTags holds the value of the build_tags edge.
+	BuildTags []*EnvBuildTag `json:"build_tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // TeamOrErr returns the Team value or an error if the edge
@@ -114,6 +122,24 @@ func (e EnvEdges) SnapshotsOrErr() ([]*Snapshot, error) {
 	return nil, &NotLoadedError{edge: "snapshots"}
 }
 
+// WarmPoolsOrErr returns the WarmPools value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvEdges) WarmPoolsOrErr() ([]*WarmPool, error) {
+	if e.loadedTypes[5] {
+		return e.WarmPools, nil
+	}
+	return nil, &NotLoadedError{edge: "warm_pools"}
+}
+
+// BuildTagsOrErr returns the BuildTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvEdges) BuildTagsOrErr() ([]*EnvBuildTag, error) {
+	if e.loadedTypes[6] {
+		return e.BuildTags, nil
+	}
+	return nil, &NotLoadedError{edge: "build_tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Env) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -125,7 +151,7 @@ func (*Env) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case env.FieldBuildCount, env.FieldSpawnCount:
 			values[i] = new(sql.NullInt64)
-		case env.FieldID:
+		case env.FieldID, env.FieldSourceEnvID, env.FieldSourceSandboxID:
 			values[i] = new(sql.NullString)
 		case env.FieldCreatedAt, env.FieldUpdatedAt, env.FieldLastSpawnedAt:
 			values[i] = new(sql.NullTime)
@@ -208,6 +234,20 @@ func (e *Env) assignValues(columns []string, values []any) error {
 				e.ClusterID = new(uuid.UUID)
 				*e.ClusterID = *value.S.(*uuid.UUID)
 			}
+		case env.FieldSourceEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_env_id", values[i])
+			} else if value.Valid {
+				e.SourceEnvID = new(string)
+				*e.SourceEnvID = value.String
+			}
+		case env.FieldSourceSandboxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_sandbox_id", values[i])
+			} else if value.Valid {
+				e.SourceSandboxID = new(string)
+				*e.SourceSandboxID = value.String
+			}
 		default:
 			e.selectValues.Set(columns[i], values[i])
 		}
@@ -246,6 +286,16 @@ func (e *Env) QuerySnapshots() *SnapshotQuery {
 	return NewEnvClient(e.config).QuerySnapshots(e)
 }
 
+// QueryWarmPools queries the "warm_pools" edge of the Env entity.
+func (e *Env) QueryWarmPools() *WarmPoolQuery {
+	return NewEnvClient(e.config).QueryWarmPools(e)
+}
+
+// QueryBuildTags queries the "build_tags" edge of the Env entity.
+func (e *Env) QueryBuildTags() *EnvBuildTagQuery {
+	return NewEnvClient(e.config).QueryBuildTags(e)
+}
+
 // Update returns a builder for updating this Env.
 // Note that you need to call Env.Unwrap() before calling this method if this Env
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -299,6 +349,16 @@ func (e *Env) String() string {
 		builder.WriteString("cluster_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := e.SourceEnvID; v != nil {
+		builder.WriteString("source_env_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := e.SourceSandboxID; v != nil {
+		builder.WriteString("source_sandbox_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
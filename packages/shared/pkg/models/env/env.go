@@ -32,6 +32,10 @@ const (
 	FieldLastSpawnedAt = "last_spawned_at"
 	// FieldClusterID holds the string denoting the cluster_id field in the database.
 	FieldClusterID = "cluster_id"
+	// FieldSourceEnvID holds the string denoting the source_env_id field in the database.
+	FieldSourceEnvID = "source_env_id"
+	// FieldSourceSandboxID holds the string denoting the source_sandbox_id field in the database.
+	FieldSourceSandboxID = "source_sandbox_id"
 	// EdgeTeam holds the string denoting the team edge name in mutations.
 	EdgeTeam = "team"
 	// EdgeCreator holds the string denoting the creator edge name in mutations.
@@ -42,6 +46,10 @@ const (
 	EdgeBuilds = "builds"
 	// EdgeSnapshots holds the string denoting the snapshots edge name in mutations.
 	EdgeSnapshots = "snapshots"
+	// EdgeWarmPools holds the string denoting the warm_pools edge name in mutations.
+	EdgeWarmPools = "warm_pools"
+	// EdgeBuildTags holds the string denoting the build_tags edge name in mutations.
+	EdgeBuildTags = "build_tags"
 	// EnvAliasFieldID holds the string denoting the ID field of the EnvAlias.
 	EnvAliasFieldID = "alias"
 	// Table holds the table name of the env in the database.
@@ -81,6 +89,20 @@ const (
 	SnapshotsInverseTable = "snapshots"
 	// SnapshotsColumn is the table column denoting the snapshots relation/edge.
 	SnapshotsColumn = "env_id"
+	// WarmPoolsTable is the table that holds the warm_pools relation/edge.
+	WarmPoolsTable = "warm_pools"
+	// WarmPoolsInverseTable is the table name for the WarmPool entity.
+	// It exists in this package in order to avoid circular dependency with the "warmpool" package.
+	WarmPoolsInverseTable = "warm_pools"
+	// WarmPoolsColumn is the table column denoting the warm_pools relation/edge.
+	WarmPoolsColumn = "env_id"
+	// BuildTagsTable is the table that holds the build_tags relation/edge.
+	BuildTagsTable = "env_build_tags"
+	// BuildTagsInverseTable is the table name for the EnvBuildTag entity.
+	// It exists in this package in order to avoid circular dependency with the "envbuildtag" package.
+	BuildTagsInverseTable = "env_build_tags"
+	// BuildTagsColumn is the table column denoting the build_tags relation/edge.
+	BuildTagsColumn = "env_id"
 )
 
 // Columns holds all SQL columns for env fields.
@@ -95,6 +117,8 @@ var Columns = []string{
 	FieldSpawnCount,
 	FieldLastSpawnedAt,
 	FieldClusterID,
+	FieldSourceEnvID,
+	FieldSourceSandboxID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -171,6 +195,16 @@ func ByClusterID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClusterID, opts...).ToFunc()
 }
 
+// BySourceEnvID orders the results by the source_env_id field.
+func BySourceEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceEnvID, opts...).ToFunc()
+}
+
+// BySourceSandboxID orders the results by the source_sandbox_id field.
+func BySourceSandboxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceSandboxID, opts...).ToFunc()
+}
+
 // ByTeamField orders the results by team field.
 func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -226,6 +260,34 @@ func BySnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByWarmPoolsCount orders the results by warm_pools count.
+func ByWarmPoolsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWarmPoolsStep(), opts...)
+	}
+}
+
+// ByWarmPools orders the results by warm_pools terms.
+func ByWarmPools(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWarmPoolsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBuildTagsCount orders the results by build_tags count.
+func ByBuildTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBuildTagsStep(), opts...)
+	}
+}
+
+// ByBuildTags orders the results by build_tags terms.
+func ByBuildTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBuildTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTeamStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -261,3 +323,17 @@ func newSnapshotsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
 	)
 }
+func newWarmPoolsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WarmPoolsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WarmPoolsTable, WarmPoolsColumn),
+	)
+}
+func newBuildTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BuildTagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BuildTagsTable, BuildTagsColumn),
+	)
+}
@@ -112,6 +112,16 @@ func ClusterID(v uuid.UUID) predicate.Env {
 	return predicate.Env(sql.FieldEQ(FieldClusterID, v))
 }
 
+// SourceEnvID applies equality check predicate on the "source_env_id" field. It's identical to SourceEnvIDEQ.
+func SourceEnvID(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldSourceEnvID, v))
+}
+
+// SourceSandboxID applies equality check predicate on the "source_sandbox_id" field. It's identical to SourceSandboxIDEQ.
+func SourceSandboxID(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldSourceSandboxID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Env {
 	return predicate.Env(sql.FieldEQ(FieldCreatedAt, v))
@@ -432,6 +442,156 @@ func ClusterIDNotNil() predicate.Env {
 	return predicate.Env(sql.FieldNotNull(FieldClusterID))
 }
 
+// SourceEnvIDEQ applies the EQ predicate on the "source_env_id" field.
+func SourceEnvIDEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDNEQ applies the NEQ predicate on the "source_env_id" field.
+func SourceEnvIDNEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDIn applies the In predicate on the "source_env_id" field.
+func SourceEnvIDIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldSourceEnvID, vs...))
+}
+
+// SourceEnvIDNotIn applies the NotIn predicate on the "source_env_id" field.
+func SourceEnvIDNotIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldSourceEnvID, vs...))
+}
+
+// SourceEnvIDGT applies the GT predicate on the "source_env_id" field.
+func SourceEnvIDGT(v string) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDGTE applies the GTE predicate on the "source_env_id" field.
+func SourceEnvIDGTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDLT applies the LT predicate on the "source_env_id" field.
+func SourceEnvIDLT(v string) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDLTE applies the LTE predicate on the "source_env_id" field.
+func SourceEnvIDLTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDContains applies the Contains predicate on the "source_env_id" field.
+func SourceEnvIDContains(v string) predicate.Env {
+	return predicate.Env(sql.FieldContains(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDHasPrefix applies the HasPrefix predicate on the "source_env_id" field.
+func SourceEnvIDHasPrefix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasPrefix(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDHasSuffix applies the HasSuffix predicate on the "source_env_id" field.
+func SourceEnvIDHasSuffix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasSuffix(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDIsNil applies the IsNil predicate on the "source_env_id" field.
+func SourceEnvIDIsNil() predicate.Env {
+	return predicate.Env(sql.FieldIsNull(FieldSourceEnvID))
+}
+
+// SourceEnvIDNotNil applies the NotNil predicate on the "source_env_id" field.
+func SourceEnvIDNotNil() predicate.Env {
+	return predicate.Env(sql.FieldNotNull(FieldSourceEnvID))
+}
+
+// SourceEnvIDEqualFold applies the EqualFold predicate on the "source_env_id" field.
+func SourceEnvIDEqualFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldEqualFold(FieldSourceEnvID, v))
+}
+
+// SourceEnvIDContainsFold applies the ContainsFold predicate on the "source_env_id" field.
+func SourceEnvIDContainsFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldContainsFold(FieldSourceEnvID, v))
+}
+
+// SourceSandboxIDEQ applies the EQ predicate on the "source_sandbox_id" field.
+func SourceSandboxIDEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldEQ(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDNEQ applies the NEQ predicate on the "source_sandbox_id" field.
+func SourceSandboxIDNEQ(v string) predicate.Env {
+	return predicate.Env(sql.FieldNEQ(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDIn applies the In predicate on the "source_sandbox_id" field.
+func SourceSandboxIDIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldIn(FieldSourceSandboxID, vs...))
+}
+
+// SourceSandboxIDNotIn applies the NotIn predicate on the "source_sandbox_id" field.
+func SourceSandboxIDNotIn(vs ...string) predicate.Env {
+	return predicate.Env(sql.FieldNotIn(FieldSourceSandboxID, vs...))
+}
+
+// SourceSandboxIDGT applies the GT predicate on the "source_sandbox_id" field.
+func SourceSandboxIDGT(v string) predicate.Env {
+	return predicate.Env(sql.FieldGT(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDGTE applies the GTE predicate on the "source_sandbox_id" field.
+func SourceSandboxIDGTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldGTE(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDLT applies the LT predicate on the "source_sandbox_id" field.
+func SourceSandboxIDLT(v string) predicate.Env {
+	return predicate.Env(sql.FieldLT(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDLTE applies the LTE predicate on the "source_sandbox_id" field.
+func SourceSandboxIDLTE(v string) predicate.Env {
+	return predicate.Env(sql.FieldLTE(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDContains applies the Contains predicate on the "source_sandbox_id" field.
+func SourceSandboxIDContains(v string) predicate.Env {
+	return predicate.Env(sql.FieldContains(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDHasPrefix applies the HasPrefix predicate on the "source_sandbox_id" field.
+func SourceSandboxIDHasPrefix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasPrefix(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDHasSuffix applies the HasSuffix predicate on the "source_sandbox_id" field.
+func SourceSandboxIDHasSuffix(v string) predicate.Env {
+	return predicate.Env(sql.FieldHasSuffix(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDIsNil applies the IsNil predicate on the "source_sandbox_id" field.
+func SourceSandboxIDIsNil() predicate.Env {
+	return predicate.Env(sql.FieldIsNull(FieldSourceSandboxID))
+}
+
+// SourceSandboxIDNotNil applies the NotNil predicate on the "source_sandbox_id" field.
+func SourceSandboxIDNotNil() predicate.Env {
+	return predicate.Env(sql.FieldNotNull(FieldSourceSandboxID))
+}
+
+// SourceSandboxIDEqualFold applies the EqualFold predicate on the "source_sandbox_id" field.
+func SourceSandboxIDEqualFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldEqualFold(FieldSourceSandboxID, v))
+}
+
+// SourceSandboxIDContainsFold applies the ContainsFold predicate on the "source_sandbox_id" field.
+func SourceSandboxIDContainsFold(v string) predicate.Env {
+	return predicate.Env(sql.FieldContainsFold(FieldSourceSandboxID, v))
+}
+
 // HasTeam applies the HasEdge predicate on the "team" edge.
 func HasTeam() predicate.Env {
 	return predicate.Env(func(s *sql.Selector) {
@@ -577,6 +737,64 @@ func HasSnapshotsWith(preds ...predicate.Snapshot) predicate.Env {
 	})
 }
 
+// HasWarmPools applies the HasEdge predicate on the "warm_pools" edge.
+func HasWarmPools() predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WarmPoolsTable, WarmPoolsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.WarmPool
+		step.Edge.Schema = schemaConfig.WarmPool
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarmPoolsWith applies the HasEdge predicate on the "warm_pools" edge with a given conditions (other predicates).
+func HasWarmPoolsWith(preds ...predicate.WarmPool) predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := newWarmPoolsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.WarmPool
+		step.Edge.Schema = schemaConfig.WarmPool
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBuildTags applies the HasEdge predicate on the "build_tags" edge.
+func HasBuildTags() predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BuildTagsTable, BuildTagsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuildTag
+		step.Edge.Schema = schemaConfig.EnvBuildTag
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBuildTagsWith applies the HasEdge predicate on the "build_tags" edge with a given conditions (other predicates).
+func HasBuildTagsWith(preds ...predicate.EnvBuildTag) predicate.Env {
+	return predicate.Env(func(s *sql.Selector) {
+		step := newBuildTagsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuildTag
+		step.Edge.Schema = schemaConfig.EnvBuildTag
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Env) predicate.Env {
 	return predicate.Env(sql.AndPredicates(predicates...))
@@ -15,9 +15,11 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
 	"github.com/google/uuid"
 )
 
@@ -139,6 +141,34 @@ func (ec *EnvCreate) SetNillableClusterID(u *uuid.UUID) *EnvCreate {
 	return ec
 }
 
+// SetSourceEnvID sets the "source_env_id" field.
+func (ec *EnvCreate) SetSourceEnvID(s string) *EnvCreate {
+	ec.mutation.SetSourceEnvID(s)
+	return ec
+}
+
+// SetNillableSourceEnvID sets the "source_env_id" field if the given value is not nil.
+func (ec *EnvCreate) SetNillableSourceEnvID(s *string) *EnvCreate {
+	if s != nil {
+		ec.SetSourceEnvID(*s)
+	}
+	return ec
+}
+
+// SetSourceSandboxID sets the "source_sandbox_id" field.
+func (ec *EnvCreate) SetSourceSandboxID(s string) *EnvCreate {
+	ec.mutation.SetSourceSandboxID(s)
+	return ec
+}
+
+// SetNillableSourceSandboxID sets the "source_sandbox_id" field if the given value is not nil.
+func (ec *EnvCreate) SetNillableSourceSandboxID(s *string) *EnvCreate {
+	if s != nil {
+		ec.SetSourceSandboxID(*s)
+	}
+	return ec
+}
+
 // SetID sets the "id" field.
 func (ec *EnvCreate) SetID(s string) *EnvCreate {
 	ec.mutation.SetID(s)
@@ -214,6 +244,36 @@ func (ec *EnvCreate) AddSnapshots(s ...*Snapshot) *EnvCreate {
 	return ec.AddSnapshotIDs(ids...)
 }
 
+// AddWarmPoolIDs adds the "warm_pools" edge to the WarmPool entity by IDs.
+func (ec *EnvCreate) AddWarmPoolIDs(ids ...uuid.UUID) *EnvCreate {
+	ec.mutation.AddWarmPoolIDs(ids...)
+	return ec
+}
+
+// AddWarmPools adds the "warm_pools" edges to the WarmPool entity.
+func (ec *EnvCreate) AddWarmPools(w ...*WarmPool) *EnvCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ec.AddWarmPoolIDs(ids...)
+}
+
+// AddBuildTagIDs adds the "build_tags" edge to the EnvBuildTag entity by IDs.
+func (ec *EnvCreate) AddBuildTagIDs(ids ...uuid.UUID) *EnvCreate {
+	ec.mutation.AddBuildTagIDs(ids...)
+	return ec
+}
+
+// AddBuildTags adds the "build_tags" edges to the EnvBuildTag entity.
+func (ec *EnvCreate) AddBuildTags(e ...*EnvBuildTag) *EnvCreate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ec.AddBuildTagIDs(ids...)
+}
+
 // Mutation returns the EnvMutation object of the builder.
 func (ec *EnvCreate) Mutation() *EnvMutation {
 	return ec.mutation
@@ -355,6 +415,14 @@ func (ec *EnvCreate) createSpec() (*Env, *sqlgraph.CreateSpec) {
 		_spec.SetField(env.FieldClusterID, field.TypeUUID, value)
 		_node.ClusterID = &value
 	}
+	if value, ok := ec.mutation.SourceEnvID(); ok {
+		_spec.SetField(env.FieldSourceEnvID, field.TypeString, value)
+		_node.SourceEnvID = &value
+	}
+	if value, ok := ec.mutation.SourceSandboxID(); ok {
+		_spec.SetField(env.FieldSourceSandboxID, field.TypeString, value)
+		_node.SourceSandboxID = &value
+	}
 	if nodes := ec.mutation.TeamIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -442,6 +510,40 @@ func (ec *EnvCreate) createSpec() (*Env, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ec.mutation.WarmPoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.WarmPoolsTable,
+			Columns: []string{env.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ec.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.BuildTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.BuildTagsTable,
+			Columns: []string{env.BuildTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ec.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -620,6 +722,42 @@ func (u *EnvUpsert) ClearClusterID() *EnvUpsert {
 	return u
 }
 
+// SetSourceEnvID sets the "source_env_id" field.
+func (u *EnvUpsert) SetSourceEnvID(v string) *EnvUpsert {
+	u.Set(env.FieldSourceEnvID, v)
+	return u
+}
+
+// UpdateSourceEnvID sets the "source_env_id" field to the value that was provided on create.
+func (u *EnvUpsert) UpdateSourceEnvID() *EnvUpsert {
+	u.SetExcluded(env.FieldSourceEnvID)
+	return u
+}
+
+// ClearSourceEnvID clears the value of the "source_env_id" field.
+func (u *EnvUpsert) ClearSourceEnvID() *EnvUpsert {
+	u.SetNull(env.FieldSourceEnvID)
+	return u
+}
+
+// SetSourceSandboxID sets the "source_sandbox_id" field.
+func (u *EnvUpsert) SetSourceSandboxID(v string) *EnvUpsert {
+	u.Set(env.FieldSourceSandboxID, v)
+	return u
+}
+
+// UpdateSourceSandboxID sets the "source_sandbox_id" field to the value that was provided on create.
+func (u *EnvUpsert) UpdateSourceSandboxID() *EnvUpsert {
+	u.SetExcluded(env.FieldSourceSandboxID)
+	return u
+}
+
+// ClearSourceSandboxID clears the value of the "source_sandbox_id" field.
+func (u *EnvUpsert) ClearSourceSandboxID() *EnvUpsert {
+	u.SetNull(env.FieldSourceSandboxID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -818,6 +956,48 @@ func (u *EnvUpsertOne) ClearClusterID() *EnvUpsertOne {
 	})
 }
 
+// SetSourceEnvID sets the "source_env_id" field.
+func (u *EnvUpsertOne) SetSourceEnvID(v string) *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.SetSourceEnvID(v)
+	})
+}
+
+// UpdateSourceEnvID sets the "source_env_id" field to the value that was provided on create.
+func (u *EnvUpsertOne) UpdateSourceEnvID() *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.UpdateSourceEnvID()
+	})
+}
+
+// ClearSourceEnvID clears the value of the "source_env_id" field.
+func (u *EnvUpsertOne) ClearSourceEnvID() *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.ClearSourceEnvID()
+	})
+}
+
+// SetSourceSandboxID sets the "source_sandbox_id" field.
+func (u *EnvUpsertOne) SetSourceSandboxID(v string) *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.SetSourceSandboxID(v)
+	})
+}
+
+// UpdateSourceSandboxID sets the "source_sandbox_id" field to the value that was provided on create.
+func (u *EnvUpsertOne) UpdateSourceSandboxID() *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.UpdateSourceSandboxID()
+	})
+}
+
+// ClearSourceSandboxID clears the value of the "source_sandbox_id" field.
+func (u *EnvUpsertOne) ClearSourceSandboxID() *EnvUpsertOne {
+	return u.Update(func(s *EnvUpsert) {
+		s.ClearSourceSandboxID()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1183,6 +1363,48 @@ func (u *EnvUpsertBulk) ClearClusterID() *EnvUpsertBulk {
 	})
 }
 
+// SetSourceEnvID sets the "source_env_id" field.
+func (u *EnvUpsertBulk) SetSourceEnvID(v string) *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.SetSourceEnvID(v)
+	})
+}
+
+// UpdateSourceEnvID sets the "source_env_id" field to the value that was provided on create.
+func (u *EnvUpsertBulk) UpdateSourceEnvID() *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.UpdateSourceEnvID()
+	})
+}
+
+// ClearSourceEnvID clears the value of the "source_env_id" field.
+func (u *EnvUpsertBulk) ClearSourceEnvID() *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.ClearSourceEnvID()
+	})
+}
+
+// SetSourceSandboxID sets the "source_sandbox_id" field.
+func (u *EnvUpsertBulk) SetSourceSandboxID(v string) *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.SetSourceSandboxID(v)
+	})
+}
+
+// UpdateSourceSandboxID sets the "source_sandbox_id" field to the value that was provided on create.
+func (u *EnvUpsertBulk) UpdateSourceSandboxID() *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.UpdateSourceSandboxID()
+	})
+}
+
+// ClearSourceSandboxID clears the value of the "source_sandbox_id" field.
+func (u *EnvUpsertBulk) ClearSourceSandboxID() *EnvUpsertBulk {
+	return u.Update(func(s *EnvUpsert) {
+		s.ClearSourceSandboxID()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -14,11 +14,13 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
 	"github.com/google/uuid"
 )
 
@@ -34,6 +36,8 @@ type EnvQuery struct {
 	withEnvAliases *EnvAliasQuery
 	withBuilds     *EnvBuildQuery
 	withSnapshots  *SnapshotQuery
+	withWarmPools  *WarmPoolQuery
+	withBuildTags  *EnvBuildTagQuery
 	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -196,6 +200,56 @@ func (eq *EnvQuery) QuerySnapshots() *SnapshotQuery {
 	return query
 }
 
+// QueryWarmPools chains the current query on the "warm_pools" edge.
+func (eq *EnvQuery) QueryWarmPools() *WarmPoolQuery {
+	query := (&WarmPoolClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(env.Table, env.FieldID, selector),
+			sqlgraph.To(warmpool.Table, warmpool.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, env.WarmPoolsTable, env.WarmPoolsColumn),
+		)
+		schemaConfig := eq.schemaConfig
+		step.To.Schema = schemaConfig.WarmPool
+		step.Edge.Schema = schemaConfig.WarmPool
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBuildTags chains the current query on the "build_tags" edge.
+func (eq *EnvQuery) QueryBuildTags() *EnvBuildTagQuery {
+	query := (&EnvBuildTagClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(env.Table, env.FieldID, selector),
+			sqlgraph.To(envbuildtag.Table, envbuildtag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, env.BuildTagsTable, env.BuildTagsColumn),
+		)
+		schemaConfig := eq.schemaConfig
+		step.To.Schema = schemaConfig.EnvBuildTag
+		step.Edge.Schema = schemaConfig.EnvBuildTag
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Env entity from the query.
 // Returns a *NotFoundError when no Env was found.
 func (eq *EnvQuery) First(ctx context.Context) (*Env, error) {
@@ -393,6 +447,8 @@ func (eq *EnvQuery) Clone() *EnvQuery {
 		withEnvAliases: eq.withEnvAliases.Clone(),
 		withBuilds:     eq.withBuilds.Clone(),
 		withSnapshots:  eq.withSnapshots.Clone(),
+		withWarmPools:  eq.withWarmPools.Clone(),
+		withBuildTags:  eq.withBuildTags.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
@@ -454,6 +510,28 @@ func (eq *EnvQuery) WithSnapshots(opts ...func(*SnapshotQuery)) *EnvQuery {
 	return eq
 }
 
+// WithWarmPools tells the query-builder to eager-load the nodes that are connected to
+// the "warm_pools" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvQuery) WithWarmPools(opts ...func(*WarmPoolQuery)) *EnvQuery {
+	query := (&WarmPoolClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withWarmPools = query
+	return eq
+}
+
+// WithBuildTags tells the query-builder to eager-load the nodes that are connected to
+// the "build_tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvQuery) WithBuildTags(opts ...func(*EnvBuildTagQuery)) *EnvQuery {
+	query := (&EnvBuildTagClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withBuildTags = query
+	return eq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -532,12 +610,14 @@ func (eq *EnvQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Env, err
 	var (
 		nodes       = []*Env{}
 		_spec       = eq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			eq.withTeam != nil,
 			eq.withCreator != nil,
 			eq.withEnvAliases != nil,
 			eq.withBuilds != nil,
 			eq.withSnapshots != nil,
+			eq.withWarmPools != nil,
+			eq.withBuildTags != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -596,6 +676,20 @@ func (eq *EnvQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Env, err
 			return nil, err
 		}
 	}
+	if query := eq.withWarmPools; query != nil {
+		if err := eq.loadWarmPools(ctx, query, nodes,
+			func(n *Env) { n.Edges.WarmPools = []*WarmPool{} },
+			func(n *Env, e *WarmPool) { n.Edges.WarmPools = append(n.Edges.WarmPools, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withBuildTags; query != nil {
+		if err := eq.loadBuildTags(ctx, query, nodes,
+			func(n *Env) { n.Edges.BuildTags = []*EnvBuildTag{} },
+			func(n *Env, e *EnvBuildTag) { n.Edges.BuildTags = append(n.Edges.BuildTags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -753,6 +847,66 @@ func (eq *EnvQuery) loadSnapshots(ctx context.Context, query *SnapshotQuery, nod
 	}
 	return nil
 }
+func (eq *EnvQuery) loadWarmPools(ctx context.Context, query *WarmPoolQuery, nodes []*Env, init func(*Env), assign func(*Env, *WarmPool)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Env)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(warmpool.FieldEnvID)
+	}
+	query.Where(predicate.WarmPool(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(env.WarmPoolsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "env_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EnvQuery) loadBuildTags(ctx context.Context, query *EnvBuildTagQuery, nodes []*Env, init func(*Env), assign func(*Env, *EnvBuildTag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Env)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(envbuildtag.FieldEnvID)
+	}
+	query.Where(predicate.EnvBuildTag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(env.BuildTagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "env_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (eq *EnvQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
@@ -14,11 +14,13 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
 	"github.com/google/uuid"
 )
 
@@ -180,6 +182,46 @@ func (eu *EnvUpdate) ClearClusterID() *EnvUpdate {
 	return eu
 }
 
+// SetSourceEnvID sets the "source_env_id" field.
+func (eu *EnvUpdate) SetSourceEnvID(s string) *EnvUpdate {
+	eu.mutation.SetSourceEnvID(s)
+	return eu
+}
+
+// SetNillableSourceEnvID sets the "source_env_id" field if the given value is not nil.
+func (eu *EnvUpdate) SetNillableSourceEnvID(s *string) *EnvUpdate {
+	if s != nil {
+		eu.SetSourceEnvID(*s)
+	}
+	return eu
+}
+
+// ClearSourceEnvID clears the value of the "source_env_id" field.
+func (eu *EnvUpdate) ClearSourceEnvID() *EnvUpdate {
+	eu.mutation.ClearSourceEnvID()
+	return eu
+}
+
+// SetSourceSandboxID sets the "source_sandbox_id" field.
+func (eu *EnvUpdate) SetSourceSandboxID(s string) *EnvUpdate {
+	eu.mutation.SetSourceSandboxID(s)
+	return eu
+}
+
+// SetNillableSourceSandboxID sets the "source_sandbox_id" field if the given value is not nil.
+func (eu *EnvUpdate) SetNillableSourceSandboxID(s *string) *EnvUpdate {
+	if s != nil {
+		eu.SetSourceSandboxID(*s)
+	}
+	return eu
+}
+
+// ClearSourceSandboxID clears the value of the "source_sandbox_id" field.
+func (eu *EnvUpdate) ClearSourceSandboxID() *EnvUpdate {
+	eu.mutation.ClearSourceSandboxID()
+	return eu
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (eu *EnvUpdate) SetTeam(t *Team) *EnvUpdate {
 	return eu.SetTeamID(t.ID)
@@ -249,6 +291,36 @@ func (eu *EnvUpdate) AddSnapshots(s ...*Snapshot) *EnvUpdate {
 	return eu.AddSnapshotIDs(ids...)
 }
 
+// AddWarmPoolIDs adds the "warm_pools" edge to the WarmPool entity by IDs.
+func (eu *EnvUpdate) AddWarmPoolIDs(ids ...uuid.UUID) *EnvUpdate {
+	eu.mutation.AddWarmPoolIDs(ids...)
+	return eu
+}
+
+// AddWarmPools adds the "warm_pools" edges to the WarmPool entity.
+func (eu *EnvUpdate) AddWarmPools(w ...*WarmPool) *EnvUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eu.AddWarmPoolIDs(ids...)
+}
+
+// AddBuildTagIDs adds the "build_tags" edge to the EnvBuildTag entity by IDs.
+func (eu *EnvUpdate) AddBuildTagIDs(ids ...uuid.UUID) *EnvUpdate {
+	eu.mutation.AddBuildTagIDs(ids...)
+	return eu
+}
+
+// AddBuildTags adds the "build_tags" edges to the EnvBuildTag entity.
+func (eu *EnvUpdate) AddBuildTags(e ...*EnvBuildTag) *EnvUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.AddBuildTagIDs(ids...)
+}
+
 // Mutation returns the EnvMutation object of the builder.
 func (eu *EnvUpdate) Mutation() *EnvMutation {
 	return eu.mutation
@@ -329,6 +401,48 @@ func (eu *EnvUpdate) RemoveSnapshots(s ...*Snapshot) *EnvUpdate {
 	return eu.RemoveSnapshotIDs(ids...)
 }
 
+// ClearWarmPools clears all "warm_pools" edges to the WarmPool entity.
+func (eu *EnvUpdate) ClearWarmPools() *EnvUpdate {
+	eu.mutation.ClearWarmPools()
+	return eu
+}
+
+// RemoveWarmPoolIDs removes the "warm_pools" edge to WarmPool entities by IDs.
+func (eu *EnvUpdate) RemoveWarmPoolIDs(ids ...uuid.UUID) *EnvUpdate {
+	eu.mutation.RemoveWarmPoolIDs(ids...)
+	return eu
+}
+
+// RemoveWarmPools removes "warm_pools" edges to WarmPool entities.
+func (eu *EnvUpdate) RemoveWarmPools(w ...*WarmPool) *EnvUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eu.RemoveWarmPoolIDs(ids...)
+}
+
+// ClearBuildTags clears all "build_tags" edges to the EnvBuildTag entity.
+func (eu *EnvUpdate) ClearBuildTags() *EnvUpdate {
+	eu.mutation.ClearBuildTags()
+	return eu
+}
+
+// RemoveBuildTagIDs removes the "build_tags" edge to EnvBuildTag entities by IDs.
+func (eu *EnvUpdate) RemoveBuildTagIDs(ids ...uuid.UUID) *EnvUpdate {
+	eu.mutation.RemoveBuildTagIDs(ids...)
+	return eu
+}
+
+// RemoveBuildTags removes "build_tags" edges to EnvBuildTag entities.
+func (eu *EnvUpdate) RemoveBuildTags(e ...*EnvBuildTag) *EnvUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return eu.RemoveBuildTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (eu *EnvUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
@@ -412,6 +526,18 @@ func (eu *EnvUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if eu.mutation.ClusterIDCleared() {
 		_spec.ClearField(env.FieldClusterID, field.TypeUUID)
 	}
+	if value, ok := eu.mutation.SourceEnvID(); ok {
+		_spec.SetField(env.FieldSourceEnvID, field.TypeString, value)
+	}
+	if eu.mutation.SourceEnvIDCleared() {
+		_spec.ClearField(env.FieldSourceEnvID, field.TypeString)
+	}
+	if value, ok := eu.mutation.SourceSandboxID(); ok {
+		_spec.SetField(env.FieldSourceSandboxID, field.TypeString, value)
+	}
+	if eu.mutation.SourceSandboxIDCleared() {
+		_spec.ClearField(env.FieldSourceSandboxID, field.TypeString)
+	}
 	if eu.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -618,6 +744,102 @@ func (eu *EnvUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if eu.mutation.WarmPoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.WarmPoolsTable,
+			Columns: []string{env.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = eu.schemaConfig.WarmPool
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedWarmPoolsIDs(); len(nodes) > 0 && !eu.mutation.WarmPoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.WarmPoolsTable,
+			Columns: []string{env.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = eu.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.WarmPoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.WarmPoolsTable,
+			Columns: []string{env.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = eu.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.BuildTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.BuildTagsTable,
+			Columns: []string{env.BuildTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = eu.schemaConfig.EnvBuildTag
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedBuildTagsIDs(); len(nodes) > 0 && !eu.mutation.BuildTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.BuildTagsTable,
+			Columns: []string{env.BuildTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = eu.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.BuildTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.BuildTagsTable,
+			Columns: []string{env.BuildTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = eu.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = eu.schemaConfig.Env
 	ctx = internal.NewSchemaConfigContext(ctx, eu.schemaConfig)
 	_spec.AddModifiers(eu.modifiers...)
@@ -786,6 +1008,46 @@ func (euo *EnvUpdateOne) ClearClusterID() *EnvUpdateOne {
 	return euo
 }
 
+// SetSourceEnvID sets the "source_env_id" field.
+func (euo *EnvUpdateOne) SetSourceEnvID(s string) *EnvUpdateOne {
+	euo.mutation.SetSourceEnvID(s)
+	return euo
+}
+
+// SetNillableSourceEnvID sets the "source_env_id" field if the given value is not nil.
+func (euo *EnvUpdateOne) SetNillableSourceEnvID(s *string) *EnvUpdateOne {
+	if s != nil {
+		euo.SetSourceEnvID(*s)
+	}
+	return euo
+}
+
+// ClearSourceEnvID clears the value of the "source_env_id" field.
+func (euo *EnvUpdateOne) ClearSourceEnvID() *EnvUpdateOne {
+	euo.mutation.ClearSourceEnvID()
+	return euo
+}
+
+// SetSourceSandboxID sets the "source_sandbox_id" field.
+func (euo *EnvUpdateOne) SetSourceSandboxID(s string) *EnvUpdateOne {
+	euo.mutation.SetSourceSandboxID(s)
+	return euo
+}
+
+// SetNillableSourceSandboxID sets the "source_sandbox_id" field if the given value is not nil.
+func (euo *EnvUpdateOne) SetNillableSourceSandboxID(s *string) *EnvUpdateOne {
+	if s != nil {
+		euo.SetSourceSandboxID(*s)
+	}
+	return euo
+}
+
+// ClearSourceSandboxID clears the value of the "source_sandbox_id" field.
+func (euo *EnvUpdateOne) ClearSourceSandboxID() *EnvUpdateOne {
+	euo.mutation.ClearSourceSandboxID()
+	return euo
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (euo *EnvUpdateOne) SetTeam(t *Team) *EnvUpdateOne {
 	return euo.SetTeamID(t.ID)
@@ -855,6 +1117,36 @@ func (euo *EnvUpdateOne) AddSnapshots(s ...*Snapshot) *EnvUpdateOne {
 	return euo.AddSnapshotIDs(ids...)
 }
 
+// AddWarmPoolIDs adds the "warm_pools" edge to the WarmPool entity by IDs.
+func (euo *EnvUpdateOne) AddWarmPoolIDs(ids ...uuid.UUID) *EnvUpdateOne {
+	euo.mutation.AddWarmPoolIDs(ids...)
+	return euo
+}
+
+// AddWarmPools adds the "warm_pools" edges to the WarmPool entity.
+func (euo *EnvUpdateOne) AddWarmPools(w ...*WarmPool) *EnvUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return euo.AddWarmPoolIDs(ids...)
+}
+
+// AddBuildTagIDs adds the "build_tags" edge to the EnvBuildTag entity by IDs.
+func (euo *EnvUpdateOne) AddBuildTagIDs(ids ...uuid.UUID) *EnvUpdateOne {
+	euo.mutation.AddBuildTagIDs(ids...)
+	return euo
+}
+
+// AddBuildTags adds the "build_tags" edges to the EnvBuildTag entity.
+func (euo *EnvUpdateOne) AddBuildTags(e ...*EnvBuildTag) *EnvUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.AddBuildTagIDs(ids...)
+}
+
 // Mutation returns the EnvMutation object of the builder.
 func (euo *EnvUpdateOne) Mutation() *EnvMutation {
 	return euo.mutation
@@ -935,6 +1227,48 @@ func (euo *EnvUpdateOne) RemoveSnapshots(s ...*Snapshot) *EnvUpdateOne {
 	return euo.RemoveSnapshotIDs(ids...)
 }
 
+// ClearWarmPools clears all "warm_pools" edges to the WarmPool entity.
+func (euo *EnvUpdateOne) ClearWarmPools() *EnvUpdateOne {
+	euo.mutation.ClearWarmPools()
+	return euo
+}
+
+// RemoveWarmPoolIDs removes the "warm_pools" edge to WarmPool entities by IDs.
+func (euo *EnvUpdateOne) RemoveWarmPoolIDs(ids ...uuid.UUID) *EnvUpdateOne {
+	euo.mutation.RemoveWarmPoolIDs(ids...)
+	return euo
+}
+
+// RemoveWarmPools removes "warm_pools" edges to WarmPool entities.
+func (euo *EnvUpdateOne) RemoveWarmPools(w ...*WarmPool) *EnvUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return euo.RemoveWarmPoolIDs(ids...)
+}
+
+// ClearBuildTags clears all "build_tags" edges to the EnvBuildTag entity.
+func (euo *EnvUpdateOne) ClearBuildTags() *EnvUpdateOne {
+	euo.mutation.ClearBuildTags()
+	return euo
+}
+
+// RemoveBuildTagIDs removes the "build_tags" edge to EnvBuildTag entities by IDs.
+func (euo *EnvUpdateOne) RemoveBuildTagIDs(ids ...uuid.UUID) *EnvUpdateOne {
+	euo.mutation.RemoveBuildTagIDs(ids...)
+	return euo
+}
+
+// RemoveBuildTags removes "build_tags" edges to EnvBuildTag entities.
+func (euo *EnvUpdateOne) RemoveBuildTags(e ...*EnvBuildTag) *EnvUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return euo.RemoveBuildTagIDs(ids...)
+}
+
 // Where appends a list predicates to the EnvUpdate builder.
 func (euo *EnvUpdateOne) Where(ps ...predicate.Env) *EnvUpdateOne {
 	euo.mutation.Where(ps...)
@@ -1048,6 +1382,18 @@ func (euo *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 	if euo.mutation.ClusterIDCleared() {
 		_spec.ClearField(env.FieldClusterID, field.TypeUUID)
 	}
+	if value, ok := euo.mutation.SourceEnvID(); ok {
+		_spec.SetField(env.FieldSourceEnvID, field.TypeString, value)
+	}
+	if euo.mutation.SourceEnvIDCleared() {
+		_spec.ClearField(env.FieldSourceEnvID, field.TypeString)
+	}
+	if value, ok := euo.mutation.SourceSandboxID(); ok {
+		_spec.SetField(env.FieldSourceSandboxID, field.TypeString, value)
+	}
+	if euo.mutation.SourceSandboxIDCleared() {
+		_spec.ClearField(env.FieldSourceSandboxID, field.TypeString)
+	}
 	if euo.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1254,6 +1600,102 @@ func (euo *EnvUpdateOne) sqlSave(ctx context.Context) (_node *Env, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if euo.mutation.WarmPoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.WarmPoolsTable,
+			Columns: []string{env.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = euo.schemaConfig.WarmPool
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedWarmPoolsIDs(); len(nodes) > 0 && !euo.mutation.WarmPoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.WarmPoolsTable,
+			Columns: []string{env.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = euo.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.WarmPoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.WarmPoolsTable,
+			Columns: []string{env.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = euo.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.BuildTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.BuildTagsTable,
+			Columns: []string{env.BuildTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = euo.schemaConfig.EnvBuildTag
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedBuildTagsIDs(); len(nodes) > 0 && !euo.mutation.BuildTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.BuildTagsTable,
+			Columns: []string{env.BuildTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = euo.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.BuildTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   env.BuildTagsTable,
+			Columns: []string{env.BuildTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = euo.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = euo.schemaConfig.Env
 	ctx = internal.NewSchemaConfigContext(ctx, euo.schemaConfig)
 	_spec.AddModifiers(euo.modifiers...)
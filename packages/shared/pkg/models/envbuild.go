@@ -51,6 +51,26 @@ type EnvBuild struct {
 	EnvdVersion *string `json:"envd_version,omitempty"`
 	// ClusterNodeID holds the value of the "cluster_node_id" field.
 	ClusterNodeID *string `json:"cluster_node_id,omitempty"`
+	// Overrides the tier network bandwidth limit
+	NetworkBandwidthMibps *int64 `json:"network_bandwidth_mibps,omitempty"`
+	// Overrides the tier network packets per second limit
+	NetworkOps *int64 `json:"network_ops,omitempty"`
+	// Overrides the tier disk bandwidth limit
+	DiskBandwidthMibps *int64 `json:"disk_bandwidth_mibps,omitempty"`
+	// Overrides the tier disk operations per second limit
+	DiskIops *int64 `json:"disk_iops,omitempty"`
+	// Memory the sandbox is sized down to by the balloon device, the whole ram_mb when not set
+	MemoryTargetMB *int64 `json:"memory_target_mb,omitempty"`
+	// Priority of the build in the build queue, the builds with higher priority are started first
+	Priority int64 `json:"priority,omitempty"`
+	// Time the build was added to the build queue
+	QueuedAt *time.Time `json:"queued_at,omitempty"`
+	// Template the snapshot of the build was originally started from, set for the templates created from sandboxes
+	BaseEnvID *string `json:"base_env_id,omitempty"`
+	// The build is a checkpoint of a running sandbox, the sandbox isn't resumed from it
+	Checkpoint bool `json:"checkpoint,omitempty"`
+	// Build the sandbox was running from when the snapshot was taken, the snapshot files are layered on top of it
+	ParentBuildID *uuid.UUID `json:"parent_build_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvBuildQuery when eager-loading is set.
 	Edges        EnvBuildEdges `json:"edges"`
@@ -61,9 +81,13 @@ type EnvBuild struct {
 type EnvBuildEdges struct {
 	// Env holds the value of the env edge.
 	Env *Env `json:"env,omitempty"`
+	// Checkpoints holds the value of the checkpoints edge.
+	Checkpoints []*Checkpoint `json:"checkpoints,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*EnvBuildTag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // EnvOrErr returns the Env value or an error if the edge
@@ -79,16 +103,38 @@ func (e EnvBuildEdges) EnvOrErr() (*Env, error) {
 	return nil, &NotLoadedError{edge: "env"}
 }
 
+// CheckpointsOrErr returns the Checkpoints value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvBuildEdges) CheckpointsOrErr() ([]*Checkpoint, error) {
+	if e.loadedTypes[1] {
+		return e.Checkpoints, nil
+	}
+	return nil, &NotLoadedError{edge: "checkpoints"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvBuildEdges) TagsOrErr() ([]*EnvBuildTag, error) {
+	if e.loadedTypes[2] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*EnvBuild) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case envbuild.FieldVcpu, envbuild.FieldRAMMB, envbuild.FieldFreeDiskSizeMB, envbuild.FieldTotalDiskSizeMB:
+		case envbuild.FieldParentBuildID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case envbuild.FieldCheckpoint:
+			values[i] = new(sql.NullBool)
+		case envbuild.FieldVcpu, envbuild.FieldRAMMB, envbuild.FieldFreeDiskSizeMB, envbuild.FieldTotalDiskSizeMB, envbuild.FieldNetworkBandwidthMibps, envbuild.FieldNetworkOps, envbuild.FieldDiskBandwidthMibps, envbuild.FieldDiskIops, envbuild.FieldMemoryTargetMB, envbuild.FieldPriority:
 			values[i] = new(sql.NullInt64)
-		case envbuild.FieldEnvID, envbuild.FieldStatus, envbuild.FieldDockerfile, envbuild.FieldStartCmd, envbuild.FieldReadyCmd, envbuild.FieldKernelVersion, envbuild.FieldFirecrackerVersion, envbuild.FieldEnvdVersion, envbuild.FieldClusterNodeID:
+		case envbuild.FieldEnvID, envbuild.FieldStatus, envbuild.FieldDockerfile, envbuild.FieldStartCmd, envbuild.FieldReadyCmd, envbuild.FieldKernelVersion, envbuild.FieldFirecrackerVersion, envbuild.FieldEnvdVersion, envbuild.FieldClusterNodeID, envbuild.FieldBaseEnvID:
 			values[i] = new(sql.NullString)
-		case envbuild.FieldCreatedAt, envbuild.FieldUpdatedAt, envbuild.FieldFinishedAt:
+		case envbuild.FieldCreatedAt, envbuild.FieldUpdatedAt, envbuild.FieldFinishedAt, envbuild.FieldQueuedAt:
 			values[i] = new(sql.NullTime)
 		case envbuild.FieldID:
 			values[i] = new(uuid.UUID)
@@ -217,6 +263,74 @@ func (eb *EnvBuild) assignValues(columns []string, values []any) error {
 				eb.ClusterNodeID = new(string)
 				*eb.ClusterNodeID = value.String
 			}
+		case envbuild.FieldNetworkBandwidthMibps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field network_bandwidth_mibps", values[i])
+			} else if value.Valid {
+				eb.NetworkBandwidthMibps = new(int64)
+				*eb.NetworkBandwidthMibps = value.Int64
+			}
+		case envbuild.FieldNetworkOps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field network_ops", values[i])
+			} else if value.Valid {
+				eb.NetworkOps = new(int64)
+				*eb.NetworkOps = value.Int64
+			}
+		case envbuild.FieldDiskBandwidthMibps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disk_bandwidth_mibps", values[i])
+			} else if value.Valid {
+				eb.DiskBandwidthMibps = new(int64)
+				*eb.DiskBandwidthMibps = value.Int64
+			}
+		case envbuild.FieldDiskIops:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disk_iops", values[i])
+			} else if value.Valid {
+				eb.DiskIops = new(int64)
+				*eb.DiskIops = value.Int64
+			}
+		case envbuild.FieldMemoryTargetMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_target_mb", values[i])
+			} else if value.Valid {
+				eb.MemoryTargetMB = new(int64)
+				*eb.MemoryTargetMB = value.Int64
+			}
+		case envbuild.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				eb.Priority = value.Int64
+			}
+		case envbuild.FieldQueuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field queued_at", values[i])
+			} else if value.Valid {
+				eb.QueuedAt = new(time.Time)
+				*eb.QueuedAt = value.Time
+			}
+		case envbuild.FieldBaseEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_env_id", values[i])
+			} else if value.Valid {
+				eb.BaseEnvID = new(string)
+				*eb.BaseEnvID = value.String
+			}
+		case envbuild.FieldCheckpoint:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field checkpoint", values[i])
+			} else if value.Valid {
+				eb.Checkpoint = value.Bool
+			}
+		case envbuild.FieldParentBuildID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_build_id", values[i])
+			} else if value.Valid {
+				eb.ParentBuildID = new(uuid.UUID)
+				*eb.ParentBuildID = *value.S.(*uuid.UUID)
+			}
 		default:
 			eb.selectValues.Set(columns[i], values[i])
 		}
@@ -235,6 +349,16 @@ func (eb *EnvBuild) QueryEnv() *EnvQuery {
 	return NewEnvBuildClient(eb.config).QueryEnv(eb)
 }
 
+// QueryCheckpoints queries the "checkpoints" edge of the EnvBuild entity.
+func (eb *EnvBuild) QueryCheckpoints() *CheckpointQuery {
+	return NewEnvBuildClient(eb.config).QueryCheckpoints(eb)
+}
+
+// QueryTags queries the "tags" edge of the EnvBuild entity.
+func (eb *EnvBuild) QueryTags() *EnvBuildTagQuery {
+	return NewEnvBuildClient(eb.config).QueryTags(eb)
+}
+
 // Update returns a builder for updating this EnvBuild.
 // Note that you need to call EnvBuild.Unwrap() before calling this method if this EnvBuild
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -321,6 +445,52 @@ func (eb *EnvBuild) String() string {
 		builder.WriteString("cluster_node_id=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := eb.NetworkBandwidthMibps; v != nil {
+		builder.WriteString("network_bandwidth_mibps=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := eb.NetworkOps; v != nil {
+		builder.WriteString("network_ops=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := eb.DiskBandwidthMibps; v != nil {
+		builder.WriteString("disk_bandwidth_mibps=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := eb.DiskIops; v != nil {
+		builder.WriteString("disk_iops=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := eb.MemoryTargetMB; v != nil {
+		builder.WriteString("memory_target_mb=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", eb.Priority))
+	builder.WriteString(", ")
+	if v := eb.QueuedAt; v != nil {
+		builder.WriteString("queued_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := eb.BaseEnvID; v != nil {
+		builder.WriteString("base_env_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("checkpoint=")
+	builder.WriteString(fmt.Sprintf("%v", eb.Checkpoint))
+	builder.WriteString(", ")
+	if v := eb.ParentBuildID; v != nil {
+		builder.WriteString("parent_build_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -47,8 +47,32 @@ const (
 	FieldEnvdVersion = "envd_version"
 	// FieldClusterNodeID holds the string denoting the cluster_node_id field in the database.
 	FieldClusterNodeID = "cluster_node_id"
+	// FieldNetworkBandwidthMibps holds the string denoting the network_bandwidth_mibps field in the database.
+	FieldNetworkBandwidthMibps = "network_bandwidth_mibps"
+	// FieldNetworkOps holds the string denoting the network_ops field in the database.
+	FieldNetworkOps = "network_ops"
+	// FieldDiskBandwidthMibps holds the string denoting the disk_bandwidth_mibps field in the database.
+	FieldDiskBandwidthMibps = "disk_bandwidth_mibps"
+	// FieldDiskIops holds the string denoting the disk_iops field in the database.
+	FieldDiskIops = "disk_iops"
+	// FieldMemoryTargetMB holds the string denoting the memory_target_mb field in the database.
+	FieldMemoryTargetMB = "memory_target_mb"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldQueuedAt holds the string denoting the queued_at field in the database.
+	FieldQueuedAt = "queued_at"
+	// FieldBaseEnvID holds the string denoting the base_env_id field in the database.
+	FieldBaseEnvID = "base_env_id"
+	// FieldCheckpoint holds the string denoting the checkpoint field in the database.
+	FieldCheckpoint = "checkpoint"
+	// FieldParentBuildID holds the string denoting the parent_build_id field in the database.
+	FieldParentBuildID = "parent_build_id"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
+	// EdgeCheckpoints holds the string denoting the checkpoints edge name in mutations.
+	EdgeCheckpoints = "checkpoints"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
 	// Table holds the table name of the envbuild in the database.
 	Table = "env_builds"
 	// EnvTable is the table that holds the env relation/edge.
@@ -58,6 +82,20 @@ const (
 	EnvInverseTable = "envs"
 	// EnvColumn is the table column denoting the env relation/edge.
 	EnvColumn = "env_id"
+	// CheckpointsTable is the table that holds the checkpoints relation/edge.
+	CheckpointsTable = "checkpoints"
+	// CheckpointsInverseTable is the table name for the Checkpoint entity.
+	// It exists in this package in order to avoid circular dependency with the "checkpoint" package.
+	CheckpointsInverseTable = "checkpoints"
+	// CheckpointsColumn is the table column denoting the checkpoints relation/edge.
+	CheckpointsColumn = "env_build_id"
+	// TagsTable is the table that holds the tags relation/edge.
+	TagsTable = "env_build_tags"
+	// TagsInverseTable is the table name for the EnvBuildTag entity.
+	// It exists in this package in order to avoid circular dependency with the "envbuildtag" package.
+	TagsInverseTable = "env_build_tags"
+	// TagsColumn is the table column denoting the tags relation/edge.
+	TagsColumn = "build_id"
 )
 
 // Columns holds all SQL columns for envbuild fields.
@@ -79,6 +117,16 @@ var Columns = []string{
 	FieldFirecrackerVersion,
 	FieldEnvdVersion,
 	FieldClusterNodeID,
+	FieldNetworkBandwidthMibps,
+	FieldNetworkOps,
+	FieldDiskBandwidthMibps,
+	FieldDiskIops,
+	FieldMemoryTargetMB,
+	FieldPriority,
+	FieldQueuedAt,
+	FieldBaseEnvID,
+	FieldCheckpoint,
+	FieldParentBuildID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -100,6 +148,10 @@ var (
 	DefaultKernelVersion string
 	// DefaultFirecrackerVersion holds the default value on creation for the "firecracker_version" field.
 	DefaultFirecrackerVersion string
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int64
+	// DefaultCheckpoint holds the default value on creation for the "checkpoint" field.
+	DefaultCheckpoint bool
 )
 
 // Status defines the type for the "status" enum field.
@@ -111,11 +163,13 @@ const DefaultStatus = StatusWaiting
 // Status values.
 const (
 	StatusWaiting      Status = "waiting"
+	StatusQueued       Status = "queued"
 	StatusBuilding     Status = "building"
 	StatusSnapshotting Status = "snapshotting"
 	StatusFailed       Status = "failed"
 	StatusSuccess      Status = "success"
 	StatusUploaded     Status = "uploaded"
+	StatusCancelled    Status = "cancelled"
 )
 
 func (s Status) String() string {
@@ -125,7 +179,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusWaiting, StatusBuilding, StatusSnapshotting, StatusFailed, StatusSuccess, StatusUploaded:
+	case StatusWaiting, StatusQueued, StatusBuilding, StatusSnapshotting, StatusFailed, StatusSuccess, StatusUploaded, StatusCancelled:
 		return nil
 	default:
 		return fmt.Errorf("envbuild: invalid enum value for status field: %q", s)
@@ -220,12 +274,90 @@ func ByClusterNodeID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClusterNodeID, opts...).ToFunc()
 }
 
+// ByNetworkBandwidthMibps orders the results by the network_bandwidth_mibps field.
+func ByNetworkBandwidthMibps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkBandwidthMibps, opts...).ToFunc()
+}
+
+// ByNetworkOps orders the results by the network_ops field.
+func ByNetworkOps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkOps, opts...).ToFunc()
+}
+
+// ByDiskBandwidthMibps orders the results by the disk_bandwidth_mibps field.
+func ByDiskBandwidthMibps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiskBandwidthMibps, opts...).ToFunc()
+}
+
+// ByDiskIops orders the results by the disk_iops field.
+func ByDiskIops(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiskIops, opts...).ToFunc()
+}
+
+// ByMemoryTargetMB orders the results by the memory_target_mb field.
+func ByMemoryTargetMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryTargetMB, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByQueuedAt orders the results by the queued_at field.
+func ByQueuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueuedAt, opts...).ToFunc()
+}
+
+// ByBaseEnvID orders the results by the base_env_id field.
+func ByBaseEnvID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseEnvID, opts...).ToFunc()
+}
+
+// ByCheckpoint orders the results by the checkpoint field.
+func ByCheckpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckpoint, opts...).ToFunc()
+}
+
+// ByParentBuildID orders the results by the parent_build_id field.
+func ByParentBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentBuildID, opts...).ToFunc()
+}
+
 // ByEnvField orders the results by env field.
 func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newEnvStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCheckpointsCount orders the results by checkpoints count.
+func ByCheckpointsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCheckpointsStep(), opts...)
+	}
+}
+
+// ByCheckpoints orders the results by checkpoints terms.
+func ByCheckpoints(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCheckpointsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEnvStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -233,3 +365,17 @@ func newEnvStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, EnvTable, EnvColumn),
 	)
 }
+func newCheckpointsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CheckpointsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CheckpointsTable, CheckpointsColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+	)
+}
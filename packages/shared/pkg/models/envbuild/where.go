@@ -132,6 +132,56 @@ func ClusterNodeID(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldClusterNodeID, v))
 }
 
+// NetworkBandwidthMibps applies equality check predicate on the "network_bandwidth_mibps" field. It's identical to NetworkBandwidthMibpsEQ.
+func NetworkBandwidthMibps(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkOps applies equality check predicate on the "network_ops" field. It's identical to NetworkOpsEQ.
+func NetworkOps(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldNetworkOps, v))
+}
+
+// DiskBandwidthMibps applies equality check predicate on the "disk_bandwidth_mibps" field. It's identical to DiskBandwidthMibpsEQ.
+func DiskBandwidthMibps(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldDiskBandwidthMibps, v))
+}
+
+// DiskIops applies equality check predicate on the "disk_iops" field. It's identical to DiskIopsEQ.
+func DiskIops(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldDiskIops, v))
+}
+
+// MemoryTargetMB applies equality check predicate on the "memory_target_mb" field. It's identical to MemoryTargetMBEQ.
+func MemoryTargetMB(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldMemoryTargetMB, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldPriority, v))
+}
+
+// QueuedAt applies equality check predicate on the "queued_at" field. It's identical to QueuedAtEQ.
+func QueuedAt(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldQueuedAt, v))
+}
+
+// BaseEnvID applies equality check predicate on the "base_env_id" field. It's identical to BaseEnvIDEQ.
+func BaseEnvID(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldBaseEnvID, v))
+}
+
+// Checkpoint applies equality check predicate on the "checkpoint" field. It's identical to CheckpointEQ.
+func Checkpoint(v bool) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldCheckpoint, v))
+}
+
+// ParentBuildID applies equality check predicate on the "parent_build_id" field. It's identical to ParentBuildIDEQ.
+func ParentBuildID(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldParentBuildID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldEQ(FieldCreatedAt, v))
@@ -1032,6 +1082,481 @@ func ClusterNodeIDContainsFold(v string) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.FieldContainsFold(FieldClusterNodeID, v))
 }
 
+// NetworkBandwidthMibpsEQ applies the EQ predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsNEQ applies the NEQ predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsNEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsIn applies the In predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldNetworkBandwidthMibps, vs...))
+}
+
+// NetworkBandwidthMibpsNotIn applies the NotIn predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsNotIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldNetworkBandwidthMibps, vs...))
+}
+
+// NetworkBandwidthMibpsGT applies the GT predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsGT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsGTE applies the GTE predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsGTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsLT applies the LT predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsLT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsLTE applies the LTE predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsLTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsIsNil applies the IsNil predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldNetworkBandwidthMibps))
+}
+
+// NetworkBandwidthMibpsNotNil applies the NotNil predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldNetworkBandwidthMibps))
+}
+
+// NetworkOpsEQ applies the EQ predicate on the "network_ops" field.
+func NetworkOpsEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldNetworkOps, v))
+}
+
+// NetworkOpsNEQ applies the NEQ predicate on the "network_ops" field.
+func NetworkOpsNEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldNetworkOps, v))
+}
+
+// NetworkOpsIn applies the In predicate on the "network_ops" field.
+func NetworkOpsIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldNetworkOps, vs...))
+}
+
+// NetworkOpsNotIn applies the NotIn predicate on the "network_ops" field.
+func NetworkOpsNotIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldNetworkOps, vs...))
+}
+
+// NetworkOpsGT applies the GT predicate on the "network_ops" field.
+func NetworkOpsGT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldNetworkOps, v))
+}
+
+// NetworkOpsGTE applies the GTE predicate on the "network_ops" field.
+func NetworkOpsGTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldNetworkOps, v))
+}
+
+// NetworkOpsLT applies the LT predicate on the "network_ops" field.
+func NetworkOpsLT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldNetworkOps, v))
+}
+
+// NetworkOpsLTE applies the LTE predicate on the "network_ops" field.
+func NetworkOpsLTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldNetworkOps, v))
+}
+
+// NetworkOpsIsNil applies the IsNil predicate on the "network_ops" field.
+func NetworkOpsIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldNetworkOps))
+}
+
+// NetworkOpsNotNil applies the NotNil predicate on the "network_ops" field.
+func NetworkOpsNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldNetworkOps))
+}
+
+// DiskBandwidthMibpsEQ applies the EQ predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsNEQ applies the NEQ predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsNEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsIn applies the In predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldDiskBandwidthMibps, vs...))
+}
+
+// DiskBandwidthMibpsNotIn applies the NotIn predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsNotIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldDiskBandwidthMibps, vs...))
+}
+
+// DiskBandwidthMibpsGT applies the GT predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsGT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsGTE applies the GTE predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsGTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsLT applies the LT predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsLT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsLTE applies the LTE predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsLTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsIsNil applies the IsNil predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldDiskBandwidthMibps))
+}
+
+// DiskBandwidthMibpsNotNil applies the NotNil predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldDiskBandwidthMibps))
+}
+
+// DiskIopsEQ applies the EQ predicate on the "disk_iops" field.
+func DiskIopsEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldDiskIops, v))
+}
+
+// DiskIopsNEQ applies the NEQ predicate on the "disk_iops" field.
+func DiskIopsNEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldDiskIops, v))
+}
+
+// DiskIopsIn applies the In predicate on the "disk_iops" field.
+func DiskIopsIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldDiskIops, vs...))
+}
+
+// DiskIopsNotIn applies the NotIn predicate on the "disk_iops" field.
+func DiskIopsNotIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldDiskIops, vs...))
+}
+
+// DiskIopsGT applies the GT predicate on the "disk_iops" field.
+func DiskIopsGT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldDiskIops, v))
+}
+
+// DiskIopsGTE applies the GTE predicate on the "disk_iops" field.
+func DiskIopsGTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldDiskIops, v))
+}
+
+// DiskIopsLT applies the LT predicate on the "disk_iops" field.
+func DiskIopsLT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldDiskIops, v))
+}
+
+// DiskIopsLTE applies the LTE predicate on the "disk_iops" field.
+func DiskIopsLTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldDiskIops, v))
+}
+
+// DiskIopsIsNil applies the IsNil predicate on the "disk_iops" field.
+func DiskIopsIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldDiskIops))
+}
+
+// DiskIopsNotNil applies the NotNil predicate on the "disk_iops" field.
+func DiskIopsNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldDiskIops))
+}
+
+// MemoryTargetMBEQ applies the EQ predicate on the "memory_target_mb" field.
+func MemoryTargetMBEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldMemoryTargetMB, v))
+}
+
+// MemoryTargetMBNEQ applies the NEQ predicate on the "memory_target_mb" field.
+func MemoryTargetMBNEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldMemoryTargetMB, v))
+}
+
+// MemoryTargetMBIn applies the In predicate on the "memory_target_mb" field.
+func MemoryTargetMBIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldMemoryTargetMB, vs...))
+}
+
+// MemoryTargetMBNotIn applies the NotIn predicate on the "memory_target_mb" field.
+func MemoryTargetMBNotIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldMemoryTargetMB, vs...))
+}
+
+// MemoryTargetMBGT applies the GT predicate on the "memory_target_mb" field.
+func MemoryTargetMBGT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldMemoryTargetMB, v))
+}
+
+// MemoryTargetMBGTE applies the GTE predicate on the "memory_target_mb" field.
+func MemoryTargetMBGTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldMemoryTargetMB, v))
+}
+
+// MemoryTargetMBLT applies the LT predicate on the "memory_target_mb" field.
+func MemoryTargetMBLT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldMemoryTargetMB, v))
+}
+
+// MemoryTargetMBLTE applies the LTE predicate on the "memory_target_mb" field.
+func MemoryTargetMBLTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldMemoryTargetMB, v))
+}
+
+// MemoryTargetMBIsNil applies the IsNil predicate on the "memory_target_mb" field.
+func MemoryTargetMBIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldMemoryTargetMB))
+}
+
+// MemoryTargetMBNotNil applies the NotNil predicate on the "memory_target_mb" field.
+func MemoryTargetMBNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldMemoryTargetMB))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int64) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldPriority, v))
+}
+
+// QueuedAtEQ applies the EQ predicate on the "queued_at" field.
+func QueuedAtEQ(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldQueuedAt, v))
+}
+
+// QueuedAtNEQ applies the NEQ predicate on the "queued_at" field.
+func QueuedAtNEQ(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldQueuedAt, v))
+}
+
+// QueuedAtIn applies the In predicate on the "queued_at" field.
+func QueuedAtIn(vs ...time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldQueuedAt, vs...))
+}
+
+// QueuedAtNotIn applies the NotIn predicate on the "queued_at" field.
+func QueuedAtNotIn(vs ...time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldQueuedAt, vs...))
+}
+
+// QueuedAtGT applies the GT predicate on the "queued_at" field.
+func QueuedAtGT(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldQueuedAt, v))
+}
+
+// QueuedAtGTE applies the GTE predicate on the "queued_at" field.
+func QueuedAtGTE(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldQueuedAt, v))
+}
+
+// QueuedAtLT applies the LT predicate on the "queued_at" field.
+func QueuedAtLT(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldQueuedAt, v))
+}
+
+// QueuedAtLTE applies the LTE predicate on the "queued_at" field.
+func QueuedAtLTE(v time.Time) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldQueuedAt, v))
+}
+
+// QueuedAtIsNil applies the IsNil predicate on the "queued_at" field.
+func QueuedAtIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldQueuedAt))
+}
+
+// QueuedAtNotNil applies the NotNil predicate on the "queued_at" field.
+func QueuedAtNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldQueuedAt))
+}
+
+// BaseEnvIDEQ applies the EQ predicate on the "base_env_id" field.
+func BaseEnvIDEQ(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDNEQ applies the NEQ predicate on the "base_env_id" field.
+func BaseEnvIDNEQ(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDIn applies the In predicate on the "base_env_id" field.
+func BaseEnvIDIn(vs ...string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldBaseEnvID, vs...))
+}
+
+// BaseEnvIDNotIn applies the NotIn predicate on the "base_env_id" field.
+func BaseEnvIDNotIn(vs ...string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldBaseEnvID, vs...))
+}
+
+// BaseEnvIDGT applies the GT predicate on the "base_env_id" field.
+func BaseEnvIDGT(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDGTE applies the GTE predicate on the "base_env_id" field.
+func BaseEnvIDGTE(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDLT applies the LT predicate on the "base_env_id" field.
+func BaseEnvIDLT(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDLTE applies the LTE predicate on the "base_env_id" field.
+func BaseEnvIDLTE(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDContains applies the Contains predicate on the "base_env_id" field.
+func BaseEnvIDContains(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldContains(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDHasPrefix applies the HasPrefix predicate on the "base_env_id" field.
+func BaseEnvIDHasPrefix(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldHasPrefix(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDHasSuffix applies the HasSuffix predicate on the "base_env_id" field.
+func BaseEnvIDHasSuffix(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldHasSuffix(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDIsNil applies the IsNil predicate on the "base_env_id" field.
+func BaseEnvIDIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldBaseEnvID))
+}
+
+// BaseEnvIDNotNil applies the NotNil predicate on the "base_env_id" field.
+func BaseEnvIDNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldBaseEnvID))
+}
+
+// BaseEnvIDEqualFold applies the EqualFold predicate on the "base_env_id" field.
+func BaseEnvIDEqualFold(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEqualFold(FieldBaseEnvID, v))
+}
+
+// BaseEnvIDContainsFold applies the ContainsFold predicate on the "base_env_id" field.
+func BaseEnvIDContainsFold(v string) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldContainsFold(FieldBaseEnvID, v))
+}
+
+// CheckpointEQ applies the EQ predicate on the "checkpoint" field.
+func CheckpointEQ(v bool) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldCheckpoint, v))
+}
+
+// CheckpointNEQ applies the NEQ predicate on the "checkpoint" field.
+func CheckpointNEQ(v bool) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldCheckpoint, v))
+}
+
+// ParentBuildIDEQ applies the EQ predicate on the "parent_build_id" field.
+func ParentBuildIDEQ(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldEQ(FieldParentBuildID, v))
+}
+
+// ParentBuildIDNEQ applies the NEQ predicate on the "parent_build_id" field.
+func ParentBuildIDNEQ(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNEQ(FieldParentBuildID, v))
+}
+
+// ParentBuildIDIn applies the In predicate on the "parent_build_id" field.
+func ParentBuildIDIn(vs ...uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIn(FieldParentBuildID, vs...))
+}
+
+// ParentBuildIDNotIn applies the NotIn predicate on the "parent_build_id" field.
+func ParentBuildIDNotIn(vs ...uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotIn(FieldParentBuildID, vs...))
+}
+
+// ParentBuildIDGT applies the GT predicate on the "parent_build_id" field.
+func ParentBuildIDGT(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGT(FieldParentBuildID, v))
+}
+
+// ParentBuildIDGTE applies the GTE predicate on the "parent_build_id" field.
+func ParentBuildIDGTE(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldGTE(FieldParentBuildID, v))
+}
+
+// ParentBuildIDLT applies the LT predicate on the "parent_build_id" field.
+func ParentBuildIDLT(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLT(FieldParentBuildID, v))
+}
+
+// ParentBuildIDLTE applies the LTE predicate on the "parent_build_id" field.
+func ParentBuildIDLTE(v uuid.UUID) predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldLTE(FieldParentBuildID, v))
+}
+
+// ParentBuildIDIsNil applies the IsNil predicate on the "parent_build_id" field.
+func ParentBuildIDIsNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldIsNull(FieldParentBuildID))
+}
+
+// ParentBuildIDNotNil applies the NotNil predicate on the "parent_build_id" field.
+func ParentBuildIDNotNil() predicate.EnvBuild {
+	return predicate.EnvBuild(sql.FieldNotNull(FieldParentBuildID))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.EnvBuild {
 	return predicate.EnvBuild(func(s *sql.Selector) {
@@ -1061,6 +1586,64 @@ func HasEnvWith(preds ...predicate.Env) predicate.EnvBuild {
 	})
 }
 
+// HasCheckpoints applies the HasEdge predicate on the "checkpoints" edge.
+func HasCheckpoints() predicate.EnvBuild {
+	return predicate.EnvBuild(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CheckpointsTable, CheckpointsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Checkpoint
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCheckpointsWith applies the HasEdge predicate on the "checkpoints" edge with a given conditions (other predicates).
+func HasCheckpointsWith(preds ...predicate.Checkpoint) predicate.EnvBuild {
+	return predicate.EnvBuild(func(s *sql.Selector) {
+		step := newCheckpointsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Checkpoint
+		step.Edge.Schema = schemaConfig.Checkpoint
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.EnvBuild {
+	return predicate.EnvBuild(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuildTag
+		step.Edge.Schema = schemaConfig.EnvBuildTag
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.EnvBuildTag) predicate.EnvBuild {
+	return predicate.EnvBuild(func(s *sql.Selector) {
+		step := newTagsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.EnvBuildTag
+		step.Edge.Schema = schemaConfig.EnvBuildTag
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.EnvBuild) predicate.EnvBuild {
 	return predicate.EnvBuild(sql.AndPredicates(predicates...))
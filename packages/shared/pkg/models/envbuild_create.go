@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
 	"github.com/google/uuid"
 )
 
@@ -225,6 +227,146 @@ func (ebc *EnvBuildCreate) SetNillableClusterNodeID(s *string) *EnvBuildCreate {
 	return ebc
 }
 
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (ebc *EnvBuildCreate) SetNetworkBandwidthMibps(i int64) *EnvBuildCreate {
+	ebc.mutation.SetNetworkBandwidthMibps(i)
+	return ebc
+}
+
+// SetNillableNetworkBandwidthMibps sets the "network_bandwidth_mibps" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableNetworkBandwidthMibps(i *int64) *EnvBuildCreate {
+	if i != nil {
+		ebc.SetNetworkBandwidthMibps(*i)
+	}
+	return ebc
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (ebc *EnvBuildCreate) SetNetworkOps(i int64) *EnvBuildCreate {
+	ebc.mutation.SetNetworkOps(i)
+	return ebc
+}
+
+// SetNillableNetworkOps sets the "network_ops" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableNetworkOps(i *int64) *EnvBuildCreate {
+	if i != nil {
+		ebc.SetNetworkOps(*i)
+	}
+	return ebc
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (ebc *EnvBuildCreate) SetDiskBandwidthMibps(i int64) *EnvBuildCreate {
+	ebc.mutation.SetDiskBandwidthMibps(i)
+	return ebc
+}
+
+// SetNillableDiskBandwidthMibps sets the "disk_bandwidth_mibps" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableDiskBandwidthMibps(i *int64) *EnvBuildCreate {
+	if i != nil {
+		ebc.SetDiskBandwidthMibps(*i)
+	}
+	return ebc
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (ebc *EnvBuildCreate) SetDiskIops(i int64) *EnvBuildCreate {
+	ebc.mutation.SetDiskIops(i)
+	return ebc
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableDiskIops(i *int64) *EnvBuildCreate {
+	if i != nil {
+		ebc.SetDiskIops(*i)
+	}
+	return ebc
+}
+
+// SetMemoryTargetMB sets the "memory_target_mb" field.
+func (ebc *EnvBuildCreate) SetMemoryTargetMB(i int64) *EnvBuildCreate {
+	ebc.mutation.SetMemoryTargetMB(i)
+	return ebc
+}
+
+// SetNillableMemoryTargetMB sets the "memory_target_mb" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableMemoryTargetMB(i *int64) *EnvBuildCreate {
+	if i != nil {
+		ebc.SetMemoryTargetMB(*i)
+	}
+	return ebc
+}
+
+// SetPriority sets the "priority" field.
+func (ebc *EnvBuildCreate) SetPriority(i int64) *EnvBuildCreate {
+	ebc.mutation.SetPriority(i)
+	return ebc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillablePriority(i *int64) *EnvBuildCreate {
+	if i != nil {
+		ebc.SetPriority(*i)
+	}
+	return ebc
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (ebc *EnvBuildCreate) SetQueuedAt(t time.Time) *EnvBuildCreate {
+	ebc.mutation.SetQueuedAt(t)
+	return ebc
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableQueuedAt(t *time.Time) *EnvBuildCreate {
+	if t != nil {
+		ebc.SetQueuedAt(*t)
+	}
+	return ebc
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (ebc *EnvBuildCreate) SetBaseEnvID(s string) *EnvBuildCreate {
+	ebc.mutation.SetBaseEnvID(s)
+	return ebc
+}
+
+// SetNillableBaseEnvID sets the "base_env_id" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableBaseEnvID(s *string) *EnvBuildCreate {
+	if s != nil {
+		ebc.SetBaseEnvID(*s)
+	}
+	return ebc
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (ebc *EnvBuildCreate) SetCheckpoint(b bool) *EnvBuildCreate {
+	ebc.mutation.SetCheckpoint(b)
+	return ebc
+}
+
+// SetNillableCheckpoint sets the "checkpoint" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableCheckpoint(b *bool) *EnvBuildCreate {
+	if b != nil {
+		ebc.SetCheckpoint(*b)
+	}
+	return ebc
+}
+
+// SetParentBuildID sets the "parent_build_id" field.
+func (ebc *EnvBuildCreate) SetParentBuildID(u uuid.UUID) *EnvBuildCreate {
+	ebc.mutation.SetParentBuildID(u)
+	return ebc
+}
+
+// SetNillableParentBuildID sets the "parent_build_id" field if the given value is not nil.
+func (ebc *EnvBuildCreate) SetNillableParentBuildID(u *uuid.UUID) *EnvBuildCreate {
+	if u != nil {
+		ebc.SetParentBuildID(*u)
+	}
+	return ebc
+}
+
 // SetID sets the "id" field.
 func (ebc *EnvBuildCreate) SetID(u uuid.UUID) *EnvBuildCreate {
 	ebc.mutation.SetID(u)
@@ -236,6 +378,36 @@ func (ebc *EnvBuildCreate) SetEnv(e *Env) *EnvBuildCreate {
 	return ebc.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by IDs.
+func (ebc *EnvBuildCreate) AddCheckpointIDs(ids ...uuid.UUID) *EnvBuildCreate {
+	ebc.mutation.AddCheckpointIDs(ids...)
+	return ebc
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the Checkpoint entity.
+func (ebc *EnvBuildCreate) AddCheckpoints(c ...*Checkpoint) *EnvBuildCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebc.AddCheckpointIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the EnvBuildTag entity by IDs.
+func (ebc *EnvBuildCreate) AddTagIDs(ids ...uuid.UUID) *EnvBuildCreate {
+	ebc.mutation.AddTagIDs(ids...)
+	return ebc
+}
+
+// AddTags adds the "tags" edges to the EnvBuildTag entity.
+func (ebc *EnvBuildCreate) AddTags(e ...*EnvBuildTag) *EnvBuildCreate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ebc.AddTagIDs(ids...)
+}
+
 // Mutation returns the EnvBuildMutation object of the builder.
 func (ebc *EnvBuildCreate) Mutation() *EnvBuildMutation {
 	return ebc.mutation
@@ -291,6 +463,14 @@ func (ebc *EnvBuildCreate) defaults() {
 		v := envbuild.DefaultFirecrackerVersion
 		ebc.mutation.SetFirecrackerVersion(v)
 	}
+	if _, ok := ebc.mutation.Priority(); !ok {
+		v := envbuild.DefaultPriority
+		ebc.mutation.SetPriority(v)
+	}
+	if _, ok := ebc.mutation.Checkpoint(); !ok {
+		v := envbuild.DefaultCheckpoint
+		ebc.mutation.SetCheckpoint(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -324,6 +504,12 @@ func (ebc *EnvBuildCreate) check() error {
 	if _, ok := ebc.mutation.FirecrackerVersion(); !ok {
 		return &ValidationError{Name: "firecracker_version", err: errors.New(`models: missing required field "EnvBuild.firecracker_version"`)}
 	}
+	if _, ok := ebc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`models: missing required field "EnvBuild.priority"`)}
+	}
+	if _, ok := ebc.mutation.Checkpoint(); !ok {
+		return &ValidationError{Name: "checkpoint", err: errors.New(`models: missing required field "EnvBuild.checkpoint"`)}
+	}
 	return nil
 }
 
@@ -421,6 +607,46 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_spec.SetField(envbuild.FieldClusterNodeID, field.TypeString, value)
 		_node.ClusterNodeID = &value
 	}
+	if value, ok := ebc.mutation.NetworkBandwidthMibps(); ok {
+		_spec.SetField(envbuild.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+		_node.NetworkBandwidthMibps = &value
+	}
+	if value, ok := ebc.mutation.NetworkOps(); ok {
+		_spec.SetField(envbuild.FieldNetworkOps, field.TypeInt64, value)
+		_node.NetworkOps = &value
+	}
+	if value, ok := ebc.mutation.DiskBandwidthMibps(); ok {
+		_spec.SetField(envbuild.FieldDiskBandwidthMibps, field.TypeInt64, value)
+		_node.DiskBandwidthMibps = &value
+	}
+	if value, ok := ebc.mutation.DiskIops(); ok {
+		_spec.SetField(envbuild.FieldDiskIops, field.TypeInt64, value)
+		_node.DiskIops = &value
+	}
+	if value, ok := ebc.mutation.MemoryTargetMB(); ok {
+		_spec.SetField(envbuild.FieldMemoryTargetMB, field.TypeInt64, value)
+		_node.MemoryTargetMB = &value
+	}
+	if value, ok := ebc.mutation.Priority(); ok {
+		_spec.SetField(envbuild.FieldPriority, field.TypeInt64, value)
+		_node.Priority = value
+	}
+	if value, ok := ebc.mutation.QueuedAt(); ok {
+		_spec.SetField(envbuild.FieldQueuedAt, field.TypeTime, value)
+		_node.QueuedAt = &value
+	}
+	if value, ok := ebc.mutation.BaseEnvID(); ok {
+		_spec.SetField(envbuild.FieldBaseEnvID, field.TypeString, value)
+		_node.BaseEnvID = &value
+	}
+	if value, ok := ebc.mutation.Checkpoint(); ok {
+		_spec.SetField(envbuild.FieldCheckpoint, field.TypeBool, value)
+		_node.Checkpoint = value
+	}
+	if value, ok := ebc.mutation.ParentBuildID(); ok {
+		_spec.SetField(envbuild.FieldParentBuildID, field.TypeUUID, value)
+		_node.ParentBuildID = &value
+	}
 	if nodes := ebc.mutation.EnvIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -439,6 +665,40 @@ func (ebc *EnvBuildCreate) createSpec() (*EnvBuild, *sqlgraph.CreateSpec) {
 		_node.EnvID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ebc.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebc.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ebc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.TagsTable,
+			Columns: []string{envbuild.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebc.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -743,6 +1003,210 @@ func (u *EnvBuildUpsert) ClearClusterNodeID() *EnvBuildUpsert {
 	return u
 }
 
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (u *EnvBuildUpsert) SetNetworkBandwidthMibps(v int64) *EnvBuildUpsert {
+	u.Set(envbuild.FieldNetworkBandwidthMibps, v)
+	return u
+}
+
+// UpdateNetworkBandwidthMibps sets the "network_bandwidth_mibps" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateNetworkBandwidthMibps() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldNetworkBandwidthMibps)
+	return u
+}
+
+// AddNetworkBandwidthMibps adds v to the "network_bandwidth_mibps" field.
+func (u *EnvBuildUpsert) AddNetworkBandwidthMibps(v int64) *EnvBuildUpsert {
+	u.Add(envbuild.FieldNetworkBandwidthMibps, v)
+	return u
+}
+
+// ClearNetworkBandwidthMibps clears the value of the "network_bandwidth_mibps" field.
+func (u *EnvBuildUpsert) ClearNetworkBandwidthMibps() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldNetworkBandwidthMibps)
+	return u
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (u *EnvBuildUpsert) SetNetworkOps(v int64) *EnvBuildUpsert {
+	u.Set(envbuild.FieldNetworkOps, v)
+	return u
+}
+
+// UpdateNetworkOps sets the "network_ops" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateNetworkOps() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldNetworkOps)
+	return u
+}
+
+// AddNetworkOps adds v to the "network_ops" field.
+func (u *EnvBuildUpsert) AddNetworkOps(v int64) *EnvBuildUpsert {
+	u.Add(envbuild.FieldNetworkOps, v)
+	return u
+}
+
+// ClearNetworkOps clears the value of the "network_ops" field.
+func (u *EnvBuildUpsert) ClearNetworkOps() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldNetworkOps)
+	return u
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (u *EnvBuildUpsert) SetDiskBandwidthMibps(v int64) *EnvBuildUpsert {
+	u.Set(envbuild.FieldDiskBandwidthMibps, v)
+	return u
+}
+
+// UpdateDiskBandwidthMibps sets the "disk_bandwidth_mibps" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateDiskBandwidthMibps() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldDiskBandwidthMibps)
+	return u
+}
+
+// AddDiskBandwidthMibps adds v to the "disk_bandwidth_mibps" field.
+func (u *EnvBuildUpsert) AddDiskBandwidthMibps(v int64) *EnvBuildUpsert {
+	u.Add(envbuild.FieldDiskBandwidthMibps, v)
+	return u
+}
+
+// ClearDiskBandwidthMibps clears the value of the "disk_bandwidth_mibps" field.
+func (u *EnvBuildUpsert) ClearDiskBandwidthMibps() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldDiskBandwidthMibps)
+	return u
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *EnvBuildUpsert) SetDiskIops(v int64) *EnvBuildUpsert {
+	u.Set(envbuild.FieldDiskIops, v)
+	return u
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateDiskIops() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldDiskIops)
+	return u
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *EnvBuildUpsert) AddDiskIops(v int64) *EnvBuildUpsert {
+	u.Add(envbuild.FieldDiskIops, v)
+	return u
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (u *EnvBuildUpsert) ClearDiskIops() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldDiskIops)
+	return u
+}
+
+// SetMemoryTargetMB sets the "memory_target_mb" field.
+func (u *EnvBuildUpsert) SetMemoryTargetMB(v int64) *EnvBuildUpsert {
+	u.Set(envbuild.FieldMemoryTargetMB, v)
+	return u
+}
+
+// UpdateMemoryTargetMB sets the "memory_target_mb" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateMemoryTargetMB() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldMemoryTargetMB)
+	return u
+}
+
+// AddMemoryTargetMB adds v to the "memory_target_mb" field.
+func (u *EnvBuildUpsert) AddMemoryTargetMB(v int64) *EnvBuildUpsert {
+	u.Add(envbuild.FieldMemoryTargetMB, v)
+	return u
+}
+
+// ClearMemoryTargetMB clears the value of the "memory_target_mb" field.
+func (u *EnvBuildUpsert) ClearMemoryTargetMB() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldMemoryTargetMB)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *EnvBuildUpsert) SetPriority(v int64) *EnvBuildUpsert {
+	u.Set(envbuild.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdatePriority() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *EnvBuildUpsert) AddPriority(v int64) *EnvBuildUpsert {
+	u.Add(envbuild.FieldPriority, v)
+	return u
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (u *EnvBuildUpsert) SetQueuedAt(v time.Time) *EnvBuildUpsert {
+	u.Set(envbuild.FieldQueuedAt, v)
+	return u
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateQueuedAt() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldQueuedAt)
+	return u
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (u *EnvBuildUpsert) ClearQueuedAt() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldQueuedAt)
+	return u
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (u *EnvBuildUpsert) SetBaseEnvID(v string) *EnvBuildUpsert {
+	u.Set(envbuild.FieldBaseEnvID, v)
+	return u
+}
+
+// UpdateBaseEnvID sets the "base_env_id" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateBaseEnvID() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldBaseEnvID)
+	return u
+}
+
+// ClearBaseEnvID clears the value of the "base_env_id" field.
+func (u *EnvBuildUpsert) ClearBaseEnvID() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldBaseEnvID)
+	return u
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (u *EnvBuildUpsert) SetCheckpoint(v bool) *EnvBuildUpsert {
+	u.Set(envbuild.FieldCheckpoint, v)
+	return u
+}
+
+// UpdateCheckpoint sets the "checkpoint" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateCheckpoint() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldCheckpoint)
+	return u
+}
+
+// SetParentBuildID sets the "parent_build_id" field.
+func (u *EnvBuildUpsert) SetParentBuildID(v uuid.UUID) *EnvBuildUpsert {
+	u.Set(envbuild.FieldParentBuildID, v)
+	return u
+}
+
+// UpdateParentBuildID sets the "parent_build_id" field to the value that was provided on create.
+func (u *EnvBuildUpsert) UpdateParentBuildID() *EnvBuildUpsert {
+	u.SetExcluded(envbuild.FieldParentBuildID)
+	return u
+}
+
+// ClearParentBuildID clears the value of the "parent_build_id" field.
+func (u *EnvBuildUpsert) ClearParentBuildID() *EnvBuildUpsert {
+	u.SetNull(envbuild.FieldParentBuildID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1088,6 +1552,244 @@ func (u *EnvBuildUpsertOne) ClearClusterNodeID() *EnvBuildUpsertOne {
 	})
 }
 
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (u *EnvBuildUpsertOne) SetNetworkBandwidthMibps(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetNetworkBandwidthMibps(v)
+	})
+}
+
+// AddNetworkBandwidthMibps adds v to the "network_bandwidth_mibps" field.
+func (u *EnvBuildUpsertOne) AddNetworkBandwidthMibps(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddNetworkBandwidthMibps(v)
+	})
+}
+
+// UpdateNetworkBandwidthMibps sets the "network_bandwidth_mibps" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateNetworkBandwidthMibps() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateNetworkBandwidthMibps()
+	})
+}
+
+// ClearNetworkBandwidthMibps clears the value of the "network_bandwidth_mibps" field.
+func (u *EnvBuildUpsertOne) ClearNetworkBandwidthMibps() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearNetworkBandwidthMibps()
+	})
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (u *EnvBuildUpsertOne) SetNetworkOps(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetNetworkOps(v)
+	})
+}
+
+// AddNetworkOps adds v to the "network_ops" field.
+func (u *EnvBuildUpsertOne) AddNetworkOps(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddNetworkOps(v)
+	})
+}
+
+// UpdateNetworkOps sets the "network_ops" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateNetworkOps() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateNetworkOps()
+	})
+}
+
+// ClearNetworkOps clears the value of the "network_ops" field.
+func (u *EnvBuildUpsertOne) ClearNetworkOps() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearNetworkOps()
+	})
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (u *EnvBuildUpsertOne) SetDiskBandwidthMibps(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetDiskBandwidthMibps(v)
+	})
+}
+
+// AddDiskBandwidthMibps adds v to the "disk_bandwidth_mibps" field.
+func (u *EnvBuildUpsertOne) AddDiskBandwidthMibps(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddDiskBandwidthMibps(v)
+	})
+}
+
+// UpdateDiskBandwidthMibps sets the "disk_bandwidth_mibps" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateDiskBandwidthMibps() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateDiskBandwidthMibps()
+	})
+}
+
+// ClearDiskBandwidthMibps clears the value of the "disk_bandwidth_mibps" field.
+func (u *EnvBuildUpsertOne) ClearDiskBandwidthMibps() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearDiskBandwidthMibps()
+	})
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *EnvBuildUpsertOne) SetDiskIops(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetDiskIops(v)
+	})
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *EnvBuildUpsertOne) AddDiskIops(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddDiskIops(v)
+	})
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateDiskIops() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateDiskIops()
+	})
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (u *EnvBuildUpsertOne) ClearDiskIops() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearDiskIops()
+	})
+}
+
+// SetMemoryTargetMB sets the "memory_target_mb" field.
+func (u *EnvBuildUpsertOne) SetMemoryTargetMB(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetMemoryTargetMB(v)
+	})
+}
+
+// AddMemoryTargetMB adds v to the "memory_target_mb" field.
+func (u *EnvBuildUpsertOne) AddMemoryTargetMB(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddMemoryTargetMB(v)
+	})
+}
+
+// UpdateMemoryTargetMB sets the "memory_target_mb" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateMemoryTargetMB() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateMemoryTargetMB()
+	})
+}
+
+// ClearMemoryTargetMB clears the value of the "memory_target_mb" field.
+func (u *EnvBuildUpsertOne) ClearMemoryTargetMB() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearMemoryTargetMB()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *EnvBuildUpsertOne) SetPriority(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *EnvBuildUpsertOne) AddPriority(v int64) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdatePriority() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (u *EnvBuildUpsertOne) SetQueuedAt(v time.Time) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetQueuedAt(v)
+	})
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateQueuedAt() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateQueuedAt()
+	})
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (u *EnvBuildUpsertOne) ClearQueuedAt() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearQueuedAt()
+	})
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (u *EnvBuildUpsertOne) SetBaseEnvID(v string) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetBaseEnvID(v)
+	})
+}
+
+// UpdateBaseEnvID sets the "base_env_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateBaseEnvID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateBaseEnvID()
+	})
+}
+
+// ClearBaseEnvID clears the value of the "base_env_id" field.
+func (u *EnvBuildUpsertOne) ClearBaseEnvID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearBaseEnvID()
+	})
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (u *EnvBuildUpsertOne) SetCheckpoint(v bool) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetCheckpoint(v)
+	})
+}
+
+// UpdateCheckpoint sets the "checkpoint" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateCheckpoint() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateCheckpoint()
+	})
+}
+
+// SetParentBuildID sets the "parent_build_id" field.
+func (u *EnvBuildUpsertOne) SetParentBuildID(v uuid.UUID) *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetParentBuildID(v)
+	})
+}
+
+// UpdateParentBuildID sets the "parent_build_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertOne) UpdateParentBuildID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateParentBuildID()
+	})
+}
+
+// ClearParentBuildID clears the value of the "parent_build_id" field.
+func (u *EnvBuildUpsertOne) ClearParentBuildID() *EnvBuildUpsertOne {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearParentBuildID()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvBuildUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1600,6 +2302,244 @@ func (u *EnvBuildUpsertBulk) ClearClusterNodeID() *EnvBuildUpsertBulk {
 	})
 }
 
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (u *EnvBuildUpsertBulk) SetNetworkBandwidthMibps(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetNetworkBandwidthMibps(v)
+	})
+}
+
+// AddNetworkBandwidthMibps adds v to the "network_bandwidth_mibps" field.
+func (u *EnvBuildUpsertBulk) AddNetworkBandwidthMibps(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddNetworkBandwidthMibps(v)
+	})
+}
+
+// UpdateNetworkBandwidthMibps sets the "network_bandwidth_mibps" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateNetworkBandwidthMibps() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateNetworkBandwidthMibps()
+	})
+}
+
+// ClearNetworkBandwidthMibps clears the value of the "network_bandwidth_mibps" field.
+func (u *EnvBuildUpsertBulk) ClearNetworkBandwidthMibps() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearNetworkBandwidthMibps()
+	})
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (u *EnvBuildUpsertBulk) SetNetworkOps(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetNetworkOps(v)
+	})
+}
+
+// AddNetworkOps adds v to the "network_ops" field.
+func (u *EnvBuildUpsertBulk) AddNetworkOps(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddNetworkOps(v)
+	})
+}
+
+// UpdateNetworkOps sets the "network_ops" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateNetworkOps() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateNetworkOps()
+	})
+}
+
+// ClearNetworkOps clears the value of the "network_ops" field.
+func (u *EnvBuildUpsertBulk) ClearNetworkOps() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearNetworkOps()
+	})
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (u *EnvBuildUpsertBulk) SetDiskBandwidthMibps(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetDiskBandwidthMibps(v)
+	})
+}
+
+// AddDiskBandwidthMibps adds v to the "disk_bandwidth_mibps" field.
+func (u *EnvBuildUpsertBulk) AddDiskBandwidthMibps(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddDiskBandwidthMibps(v)
+	})
+}
+
+// UpdateDiskBandwidthMibps sets the "disk_bandwidth_mibps" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateDiskBandwidthMibps() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateDiskBandwidthMibps()
+	})
+}
+
+// ClearDiskBandwidthMibps clears the value of the "disk_bandwidth_mibps" field.
+func (u *EnvBuildUpsertBulk) ClearDiskBandwidthMibps() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearDiskBandwidthMibps()
+	})
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *EnvBuildUpsertBulk) SetDiskIops(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetDiskIops(v)
+	})
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *EnvBuildUpsertBulk) AddDiskIops(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddDiskIops(v)
+	})
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateDiskIops() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateDiskIops()
+	})
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (u *EnvBuildUpsertBulk) ClearDiskIops() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearDiskIops()
+	})
+}
+
+// SetMemoryTargetMB sets the "memory_target_mb" field.
+func (u *EnvBuildUpsertBulk) SetMemoryTargetMB(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetMemoryTargetMB(v)
+	})
+}
+
+// AddMemoryTargetMB adds v to the "memory_target_mb" field.
+func (u *EnvBuildUpsertBulk) AddMemoryTargetMB(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddMemoryTargetMB(v)
+	})
+}
+
+// UpdateMemoryTargetMB sets the "memory_target_mb" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateMemoryTargetMB() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateMemoryTargetMB()
+	})
+}
+
+// ClearMemoryTargetMB clears the value of the "memory_target_mb" field.
+func (u *EnvBuildUpsertBulk) ClearMemoryTargetMB() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearMemoryTargetMB()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *EnvBuildUpsertBulk) SetPriority(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *EnvBuildUpsertBulk) AddPriority(v int64) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdatePriority() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (u *EnvBuildUpsertBulk) SetQueuedAt(v time.Time) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetQueuedAt(v)
+	})
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateQueuedAt() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateQueuedAt()
+	})
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (u *EnvBuildUpsertBulk) ClearQueuedAt() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearQueuedAt()
+	})
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (u *EnvBuildUpsertBulk) SetBaseEnvID(v string) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetBaseEnvID(v)
+	})
+}
+
+// UpdateBaseEnvID sets the "base_env_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateBaseEnvID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateBaseEnvID()
+	})
+}
+
+// ClearBaseEnvID clears the value of the "base_env_id" field.
+func (u *EnvBuildUpsertBulk) ClearBaseEnvID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearBaseEnvID()
+	})
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (u *EnvBuildUpsertBulk) SetCheckpoint(v bool) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetCheckpoint(v)
+	})
+}
+
+// UpdateCheckpoint sets the "checkpoint" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateCheckpoint() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateCheckpoint()
+	})
+}
+
+// SetParentBuildID sets the "parent_build_id" field.
+func (u *EnvBuildUpsertBulk) SetParentBuildID(v uuid.UUID) *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.SetParentBuildID(v)
+	})
+}
+
+// UpdateParentBuildID sets the "parent_build_id" field to the value that was provided on create.
+func (u *EnvBuildUpsertBulk) UpdateParentBuildID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.UpdateParentBuildID()
+	})
+}
+
+// ClearParentBuildID clears the value of the "parent_build_id" field.
+func (u *EnvBuildUpsertBulk) ClearParentBuildID() *EnvBuildUpsertBulk {
+	return u.Update(func(s *EnvBuildUpsert) {
+		s.ClearParentBuildID()
+	})
+}
+
 // Exec executes the query.
 func (u *EnvBuildUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
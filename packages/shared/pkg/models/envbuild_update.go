@@ -11,10 +11,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
 )
 
 // EnvBuildUpdate is the builder for updating EnvBuild entities.
@@ -317,11 +320,271 @@ func (ebu *EnvBuildUpdate) ClearClusterNodeID() *EnvBuildUpdate {
 	return ebu
 }
 
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (ebu *EnvBuildUpdate) SetNetworkBandwidthMibps(i int64) *EnvBuildUpdate {
+	ebu.mutation.ResetNetworkBandwidthMibps()
+	ebu.mutation.SetNetworkBandwidthMibps(i)
+	return ebu
+}
+
+// SetNillableNetworkBandwidthMibps sets the "network_bandwidth_mibps" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableNetworkBandwidthMibps(i *int64) *EnvBuildUpdate {
+	if i != nil {
+		ebu.SetNetworkBandwidthMibps(*i)
+	}
+	return ebu
+}
+
+// AddNetworkBandwidthMibps adds i to the "network_bandwidth_mibps" field.
+func (ebu *EnvBuildUpdate) AddNetworkBandwidthMibps(i int64) *EnvBuildUpdate {
+	ebu.mutation.AddNetworkBandwidthMibps(i)
+	return ebu
+}
+
+// ClearNetworkBandwidthMibps clears the value of the "network_bandwidth_mibps" field.
+func (ebu *EnvBuildUpdate) ClearNetworkBandwidthMibps() *EnvBuildUpdate {
+	ebu.mutation.ClearNetworkBandwidthMibps()
+	return ebu
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (ebu *EnvBuildUpdate) SetNetworkOps(i int64) *EnvBuildUpdate {
+	ebu.mutation.ResetNetworkOps()
+	ebu.mutation.SetNetworkOps(i)
+	return ebu
+}
+
+// SetNillableNetworkOps sets the "network_ops" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableNetworkOps(i *int64) *EnvBuildUpdate {
+	if i != nil {
+		ebu.SetNetworkOps(*i)
+	}
+	return ebu
+}
+
+// AddNetworkOps adds i to the "network_ops" field.
+func (ebu *EnvBuildUpdate) AddNetworkOps(i int64) *EnvBuildUpdate {
+	ebu.mutation.AddNetworkOps(i)
+	return ebu
+}
+
+// ClearNetworkOps clears the value of the "network_ops" field.
+func (ebu *EnvBuildUpdate) ClearNetworkOps() *EnvBuildUpdate {
+	ebu.mutation.ClearNetworkOps()
+	return ebu
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (ebu *EnvBuildUpdate) SetDiskBandwidthMibps(i int64) *EnvBuildUpdate {
+	ebu.mutation.ResetDiskBandwidthMibps()
+	ebu.mutation.SetDiskBandwidthMibps(i)
+	return ebu
+}
+
+// SetNillableDiskBandwidthMibps sets the "disk_bandwidth_mibps" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableDiskBandwidthMibps(i *int64) *EnvBuildUpdate {
+	if i != nil {
+		ebu.SetDiskBandwidthMibps(*i)
+	}
+	return ebu
+}
+
+// AddDiskBandwidthMibps adds i to the "disk_bandwidth_mibps" field.
+func (ebu *EnvBuildUpdate) AddDiskBandwidthMibps(i int64) *EnvBuildUpdate {
+	ebu.mutation.AddDiskBandwidthMibps(i)
+	return ebu
+}
+
+// ClearDiskBandwidthMibps clears the value of the "disk_bandwidth_mibps" field.
+func (ebu *EnvBuildUpdate) ClearDiskBandwidthMibps() *EnvBuildUpdate {
+	ebu.mutation.ClearDiskBandwidthMibps()
+	return ebu
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (ebu *EnvBuildUpdate) SetDiskIops(i int64) *EnvBuildUpdate {
+	ebu.mutation.ResetDiskIops()
+	ebu.mutation.SetDiskIops(i)
+	return ebu
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableDiskIops(i *int64) *EnvBuildUpdate {
+	if i != nil {
+		ebu.SetDiskIops(*i)
+	}
+	return ebu
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (ebu *EnvBuildUpdate) AddDiskIops(i int64) *EnvBuildUpdate {
+	ebu.mutation.AddDiskIops(i)
+	return ebu
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (ebu *EnvBuildUpdate) ClearDiskIops() *EnvBuildUpdate {
+	ebu.mutation.ClearDiskIops()
+	return ebu
+}
+
+// SetMemoryTargetMB sets the "memory_target_mb" field.
+func (ebu *EnvBuildUpdate) SetMemoryTargetMB(i int64) *EnvBuildUpdate {
+	ebu.mutation.ResetMemoryTargetMB()
+	ebu.mutation.SetMemoryTargetMB(i)
+	return ebu
+}
+
+// SetNillableMemoryTargetMB sets the "memory_target_mb" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableMemoryTargetMB(i *int64) *EnvBuildUpdate {
+	if i != nil {
+		ebu.SetMemoryTargetMB(*i)
+	}
+	return ebu
+}
+
+// AddMemoryTargetMB adds i to the "memory_target_mb" field.
+func (ebu *EnvBuildUpdate) AddMemoryTargetMB(i int64) *EnvBuildUpdate {
+	ebu.mutation.AddMemoryTargetMB(i)
+	return ebu
+}
+
+// ClearMemoryTargetMB clears the value of the "memory_target_mb" field.
+func (ebu *EnvBuildUpdate) ClearMemoryTargetMB() *EnvBuildUpdate {
+	ebu.mutation.ClearMemoryTargetMB()
+	return ebu
+}
+
+// SetPriority sets the "priority" field.
+func (ebu *EnvBuildUpdate) SetPriority(i int64) *EnvBuildUpdate {
+	ebu.mutation.ResetPriority()
+	ebu.mutation.SetPriority(i)
+	return ebu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillablePriority(i *int64) *EnvBuildUpdate {
+	if i != nil {
+		ebu.SetPriority(*i)
+	}
+	return ebu
+}
+
+// AddPriority adds i to the "priority" field.
+func (ebu *EnvBuildUpdate) AddPriority(i int64) *EnvBuildUpdate {
+	ebu.mutation.AddPriority(i)
+	return ebu
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (ebu *EnvBuildUpdate) SetQueuedAt(t time.Time) *EnvBuildUpdate {
+	ebu.mutation.SetQueuedAt(t)
+	return ebu
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableQueuedAt(t *time.Time) *EnvBuildUpdate {
+	if t != nil {
+		ebu.SetQueuedAt(*t)
+	}
+	return ebu
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (ebu *EnvBuildUpdate) ClearQueuedAt() *EnvBuildUpdate {
+	ebu.mutation.ClearQueuedAt()
+	return ebu
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (ebu *EnvBuildUpdate) SetBaseEnvID(s string) *EnvBuildUpdate {
+	ebu.mutation.SetBaseEnvID(s)
+	return ebu
+}
+
+// SetNillableBaseEnvID sets the "base_env_id" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableBaseEnvID(s *string) *EnvBuildUpdate {
+	if s != nil {
+		ebu.SetBaseEnvID(*s)
+	}
+	return ebu
+}
+
+// ClearBaseEnvID clears the value of the "base_env_id" field.
+func (ebu *EnvBuildUpdate) ClearBaseEnvID() *EnvBuildUpdate {
+	ebu.mutation.ClearBaseEnvID()
+	return ebu
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (ebu *EnvBuildUpdate) SetCheckpoint(b bool) *EnvBuildUpdate {
+	ebu.mutation.SetCheckpoint(b)
+	return ebu
+}
+
+// SetNillableCheckpoint sets the "checkpoint" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableCheckpoint(b *bool) *EnvBuildUpdate {
+	if b != nil {
+		ebu.SetCheckpoint(*b)
+	}
+	return ebu
+}
+
+// SetParentBuildID sets the "parent_build_id" field.
+func (ebu *EnvBuildUpdate) SetParentBuildID(u uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.SetParentBuildID(u)
+	return ebu
+}
+
+// SetNillableParentBuildID sets the "parent_build_id" field if the given value is not nil.
+func (ebu *EnvBuildUpdate) SetNillableParentBuildID(u *uuid.UUID) *EnvBuildUpdate {
+	if u != nil {
+		ebu.SetParentBuildID(*u)
+	}
+	return ebu
+}
+
+// ClearParentBuildID clears the value of the "parent_build_id" field.
+func (ebu *EnvBuildUpdate) ClearParentBuildID() *EnvBuildUpdate {
+	ebu.mutation.ClearParentBuildID()
+	return ebu
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (ebu *EnvBuildUpdate) SetEnv(e *Env) *EnvBuildUpdate {
 	return ebu.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by IDs.
+func (ebu *EnvBuildUpdate) AddCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.AddCheckpointIDs(ids...)
+	return ebu
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the Checkpoint entity.
+func (ebu *EnvBuildUpdate) AddCheckpoints(c ...*Checkpoint) *EnvBuildUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebu.AddCheckpointIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the EnvBuildTag entity by IDs.
+func (ebu *EnvBuildUpdate) AddTagIDs(ids ...uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.AddTagIDs(ids...)
+	return ebu
+}
+
+// AddTags adds the "tags" edges to the EnvBuildTag entity.
+func (ebu *EnvBuildUpdate) AddTags(e ...*EnvBuildTag) *EnvBuildUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ebu.AddTagIDs(ids...)
+}
+
 // Mutation returns the EnvBuildMutation object of the builder.
 func (ebu *EnvBuildUpdate) Mutation() *EnvBuildMutation {
 	return ebu.mutation
@@ -333,6 +596,48 @@ func (ebu *EnvBuildUpdate) ClearEnv() *EnvBuildUpdate {
 	return ebu
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the Checkpoint entity.
+func (ebu *EnvBuildUpdate) ClearCheckpoints() *EnvBuildUpdate {
+	ebu.mutation.ClearCheckpoints()
+	return ebu
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to Checkpoint entities by IDs.
+func (ebu *EnvBuildUpdate) RemoveCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.RemoveCheckpointIDs(ids...)
+	return ebu
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to Checkpoint entities.
+func (ebu *EnvBuildUpdate) RemoveCheckpoints(c ...*Checkpoint) *EnvBuildUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebu.RemoveCheckpointIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the EnvBuildTag entity.
+func (ebu *EnvBuildUpdate) ClearTags() *EnvBuildUpdate {
+	ebu.mutation.ClearTags()
+	return ebu
+}
+
+// RemoveTagIDs removes the "tags" edge to EnvBuildTag entities by IDs.
+func (ebu *EnvBuildUpdate) RemoveTagIDs(ids ...uuid.UUID) *EnvBuildUpdate {
+	ebu.mutation.RemoveTagIDs(ids...)
+	return ebu
+}
+
+// RemoveTags removes "tags" edges to EnvBuildTag entities.
+func (ebu *EnvBuildUpdate) RemoveTags(e ...*EnvBuildTag) *EnvBuildUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ebu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ebu *EnvBuildUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ebu.sqlSave, ebu.mutation, ebu.hooks)
@@ -463,6 +768,78 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ebu.mutation.ClusterNodeIDCleared() {
 		_spec.ClearField(envbuild.FieldClusterNodeID, field.TypeString)
 	}
+	if value, ok := ebu.mutation.NetworkBandwidthMibps(); ok {
+		_spec.SetField(envbuild.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := ebu.mutation.AddedNetworkBandwidthMibps(); ok {
+		_spec.AddField(envbuild.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+	}
+	if ebu.mutation.NetworkBandwidthMibpsCleared() {
+		_spec.ClearField(envbuild.FieldNetworkBandwidthMibps, field.TypeInt64)
+	}
+	if value, ok := ebu.mutation.NetworkOps(); ok {
+		_spec.SetField(envbuild.FieldNetworkOps, field.TypeInt64, value)
+	}
+	if value, ok := ebu.mutation.AddedNetworkOps(); ok {
+		_spec.AddField(envbuild.FieldNetworkOps, field.TypeInt64, value)
+	}
+	if ebu.mutation.NetworkOpsCleared() {
+		_spec.ClearField(envbuild.FieldNetworkOps, field.TypeInt64)
+	}
+	if value, ok := ebu.mutation.DiskBandwidthMibps(); ok {
+		_spec.SetField(envbuild.FieldDiskBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := ebu.mutation.AddedDiskBandwidthMibps(); ok {
+		_spec.AddField(envbuild.FieldDiskBandwidthMibps, field.TypeInt64, value)
+	}
+	if ebu.mutation.DiskBandwidthMibpsCleared() {
+		_spec.ClearField(envbuild.FieldDiskBandwidthMibps, field.TypeInt64)
+	}
+	if value, ok := ebu.mutation.DiskIops(); ok {
+		_spec.SetField(envbuild.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := ebu.mutation.AddedDiskIops(); ok {
+		_spec.AddField(envbuild.FieldDiskIops, field.TypeInt64, value)
+	}
+	if ebu.mutation.DiskIopsCleared() {
+		_spec.ClearField(envbuild.FieldDiskIops, field.TypeInt64)
+	}
+	if value, ok := ebu.mutation.MemoryTargetMB(); ok {
+		_spec.SetField(envbuild.FieldMemoryTargetMB, field.TypeInt64, value)
+	}
+	if value, ok := ebu.mutation.AddedMemoryTargetMB(); ok {
+		_spec.AddField(envbuild.FieldMemoryTargetMB, field.TypeInt64, value)
+	}
+	if ebu.mutation.MemoryTargetMBCleared() {
+		_spec.ClearField(envbuild.FieldMemoryTargetMB, field.TypeInt64)
+	}
+	if value, ok := ebu.mutation.Priority(); ok {
+		_spec.SetField(envbuild.FieldPriority, field.TypeInt64, value)
+	}
+	if value, ok := ebu.mutation.AddedPriority(); ok {
+		_spec.AddField(envbuild.FieldPriority, field.TypeInt64, value)
+	}
+	if value, ok := ebu.mutation.QueuedAt(); ok {
+		_spec.SetField(envbuild.FieldQueuedAt, field.TypeTime, value)
+	}
+	if ebu.mutation.QueuedAtCleared() {
+		_spec.ClearField(envbuild.FieldQueuedAt, field.TypeTime)
+	}
+	if value, ok := ebu.mutation.BaseEnvID(); ok {
+		_spec.SetField(envbuild.FieldBaseEnvID, field.TypeString, value)
+	}
+	if ebu.mutation.BaseEnvIDCleared() {
+		_spec.ClearField(envbuild.FieldBaseEnvID, field.TypeString)
+	}
+	if value, ok := ebu.mutation.Checkpoint(); ok {
+		_spec.SetField(envbuild.FieldCheckpoint, field.TypeBool, value)
+	}
+	if value, ok := ebu.mutation.ParentBuildID(); ok {
+		_spec.SetField(envbuild.FieldParentBuildID, field.TypeUUID, value)
+	}
+	if ebu.mutation.ParentBuildIDCleared() {
+		_spec.ClearField(envbuild.FieldParentBuildID, field.TypeUUID)
+	}
 	if ebu.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -494,6 +871,102 @@ func (ebu *EnvBuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ebu.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebu.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !ebu.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebu.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ebu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.TagsTable,
+			Columns: []string{envbuild.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.EnvBuildTag
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !ebu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.TagsTable,
+			Columns: []string{envbuild.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.TagsTable,
+			Columns: []string{envbuild.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebu.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ebu.schemaConfig.EnvBuild
 	ctx = internal.NewSchemaConfigContext(ctx, ebu.schemaConfig)
 	_spec.AddModifiers(ebu.modifiers...)
@@ -804,11 +1277,271 @@ func (ebuo *EnvBuildUpdateOne) ClearClusterNodeID() *EnvBuildUpdateOne {
 	return ebuo
 }
 
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (ebuo *EnvBuildUpdateOne) SetNetworkBandwidthMibps(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.ResetNetworkBandwidthMibps()
+	ebuo.mutation.SetNetworkBandwidthMibps(i)
+	return ebuo
+}
+
+// SetNillableNetworkBandwidthMibps sets the "network_bandwidth_mibps" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableNetworkBandwidthMibps(i *int64) *EnvBuildUpdateOne {
+	if i != nil {
+		ebuo.SetNetworkBandwidthMibps(*i)
+	}
+	return ebuo
+}
+
+// AddNetworkBandwidthMibps adds i to the "network_bandwidth_mibps" field.
+func (ebuo *EnvBuildUpdateOne) AddNetworkBandwidthMibps(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.AddNetworkBandwidthMibps(i)
+	return ebuo
+}
+
+// ClearNetworkBandwidthMibps clears the value of the "network_bandwidth_mibps" field.
+func (ebuo *EnvBuildUpdateOne) ClearNetworkBandwidthMibps() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearNetworkBandwidthMibps()
+	return ebuo
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (ebuo *EnvBuildUpdateOne) SetNetworkOps(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.ResetNetworkOps()
+	ebuo.mutation.SetNetworkOps(i)
+	return ebuo
+}
+
+// SetNillableNetworkOps sets the "network_ops" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableNetworkOps(i *int64) *EnvBuildUpdateOne {
+	if i != nil {
+		ebuo.SetNetworkOps(*i)
+	}
+	return ebuo
+}
+
+// AddNetworkOps adds i to the "network_ops" field.
+func (ebuo *EnvBuildUpdateOne) AddNetworkOps(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.AddNetworkOps(i)
+	return ebuo
+}
+
+// ClearNetworkOps clears the value of the "network_ops" field.
+func (ebuo *EnvBuildUpdateOne) ClearNetworkOps() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearNetworkOps()
+	return ebuo
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (ebuo *EnvBuildUpdateOne) SetDiskBandwidthMibps(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.ResetDiskBandwidthMibps()
+	ebuo.mutation.SetDiskBandwidthMibps(i)
+	return ebuo
+}
+
+// SetNillableDiskBandwidthMibps sets the "disk_bandwidth_mibps" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableDiskBandwidthMibps(i *int64) *EnvBuildUpdateOne {
+	if i != nil {
+		ebuo.SetDiskBandwidthMibps(*i)
+	}
+	return ebuo
+}
+
+// AddDiskBandwidthMibps adds i to the "disk_bandwidth_mibps" field.
+func (ebuo *EnvBuildUpdateOne) AddDiskBandwidthMibps(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.AddDiskBandwidthMibps(i)
+	return ebuo
+}
+
+// ClearDiskBandwidthMibps clears the value of the "disk_bandwidth_mibps" field.
+func (ebuo *EnvBuildUpdateOne) ClearDiskBandwidthMibps() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearDiskBandwidthMibps()
+	return ebuo
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (ebuo *EnvBuildUpdateOne) SetDiskIops(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.ResetDiskIops()
+	ebuo.mutation.SetDiskIops(i)
+	return ebuo
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableDiskIops(i *int64) *EnvBuildUpdateOne {
+	if i != nil {
+		ebuo.SetDiskIops(*i)
+	}
+	return ebuo
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (ebuo *EnvBuildUpdateOne) AddDiskIops(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.AddDiskIops(i)
+	return ebuo
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (ebuo *EnvBuildUpdateOne) ClearDiskIops() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearDiskIops()
+	return ebuo
+}
+
+// SetMemoryTargetMB sets the "memory_target_mb" field.
+func (ebuo *EnvBuildUpdateOne) SetMemoryTargetMB(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.ResetMemoryTargetMB()
+	ebuo.mutation.SetMemoryTargetMB(i)
+	return ebuo
+}
+
+// SetNillableMemoryTargetMB sets the "memory_target_mb" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableMemoryTargetMB(i *int64) *EnvBuildUpdateOne {
+	if i != nil {
+		ebuo.SetMemoryTargetMB(*i)
+	}
+	return ebuo
+}
+
+// AddMemoryTargetMB adds i to the "memory_target_mb" field.
+func (ebuo *EnvBuildUpdateOne) AddMemoryTargetMB(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.AddMemoryTargetMB(i)
+	return ebuo
+}
+
+// ClearMemoryTargetMB clears the value of the "memory_target_mb" field.
+func (ebuo *EnvBuildUpdateOne) ClearMemoryTargetMB() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearMemoryTargetMB()
+	return ebuo
+}
+
+// SetPriority sets the "priority" field.
+func (ebuo *EnvBuildUpdateOne) SetPriority(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.ResetPriority()
+	ebuo.mutation.SetPriority(i)
+	return ebuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillablePriority(i *int64) *EnvBuildUpdateOne {
+	if i != nil {
+		ebuo.SetPriority(*i)
+	}
+	return ebuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (ebuo *EnvBuildUpdateOne) AddPriority(i int64) *EnvBuildUpdateOne {
+	ebuo.mutation.AddPriority(i)
+	return ebuo
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (ebuo *EnvBuildUpdateOne) SetQueuedAt(t time.Time) *EnvBuildUpdateOne {
+	ebuo.mutation.SetQueuedAt(t)
+	return ebuo
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableQueuedAt(t *time.Time) *EnvBuildUpdateOne {
+	if t != nil {
+		ebuo.SetQueuedAt(*t)
+	}
+	return ebuo
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (ebuo *EnvBuildUpdateOne) ClearQueuedAt() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearQueuedAt()
+	return ebuo
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (ebuo *EnvBuildUpdateOne) SetBaseEnvID(s string) *EnvBuildUpdateOne {
+	ebuo.mutation.SetBaseEnvID(s)
+	return ebuo
+}
+
+// SetNillableBaseEnvID sets the "base_env_id" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableBaseEnvID(s *string) *EnvBuildUpdateOne {
+	if s != nil {
+		ebuo.SetBaseEnvID(*s)
+	}
+	return ebuo
+}
+
+// ClearBaseEnvID clears the value of the "base_env_id" field.
+func (ebuo *EnvBuildUpdateOne) ClearBaseEnvID() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearBaseEnvID()
+	return ebuo
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (ebuo *EnvBuildUpdateOne) SetCheckpoint(b bool) *EnvBuildUpdateOne {
+	ebuo.mutation.SetCheckpoint(b)
+	return ebuo
+}
+
+// SetNillableCheckpoint sets the "checkpoint" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableCheckpoint(b *bool) *EnvBuildUpdateOne {
+	if b != nil {
+		ebuo.SetCheckpoint(*b)
+	}
+	return ebuo
+}
+
+// SetParentBuildID sets the "parent_build_id" field.
+func (ebuo *EnvBuildUpdateOne) SetParentBuildID(u uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.SetParentBuildID(u)
+	return ebuo
+}
+
+// SetNillableParentBuildID sets the "parent_build_id" field if the given value is not nil.
+func (ebuo *EnvBuildUpdateOne) SetNillableParentBuildID(u *uuid.UUID) *EnvBuildUpdateOne {
+	if u != nil {
+		ebuo.SetParentBuildID(*u)
+	}
+	return ebuo
+}
+
+// ClearParentBuildID clears the value of the "parent_build_id" field.
+func (ebuo *EnvBuildUpdateOne) ClearParentBuildID() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearParentBuildID()
+	return ebuo
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (ebuo *EnvBuildUpdateOne) SetEnv(e *Env) *EnvBuildUpdateOne {
 	return ebuo.SetEnvID(e.ID)
 }
 
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by IDs.
+func (ebuo *EnvBuildUpdateOne) AddCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.AddCheckpointIDs(ids...)
+	return ebuo
+}
+
+// AddCheckpoints adds the "checkpoints" edges to the Checkpoint entity.
+func (ebuo *EnvBuildUpdateOne) AddCheckpoints(c ...*Checkpoint) *EnvBuildUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebuo.AddCheckpointIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the EnvBuildTag entity by IDs.
+func (ebuo *EnvBuildUpdateOne) AddTagIDs(ids ...uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.AddTagIDs(ids...)
+	return ebuo
+}
+
+// AddTags adds the "tags" edges to the EnvBuildTag entity.
+func (ebuo *EnvBuildUpdateOne) AddTags(e ...*EnvBuildTag) *EnvBuildUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ebuo.AddTagIDs(ids...)
+}
+
 // Mutation returns the EnvBuildMutation object of the builder.
 func (ebuo *EnvBuildUpdateOne) Mutation() *EnvBuildMutation {
 	return ebuo.mutation
@@ -820,6 +1553,48 @@ func (ebuo *EnvBuildUpdateOne) ClearEnv() *EnvBuildUpdateOne {
 	return ebuo
 }
 
+// ClearCheckpoints clears all "checkpoints" edges to the Checkpoint entity.
+func (ebuo *EnvBuildUpdateOne) ClearCheckpoints() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearCheckpoints()
+	return ebuo
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to Checkpoint entities by IDs.
+func (ebuo *EnvBuildUpdateOne) RemoveCheckpointIDs(ids ...uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.RemoveCheckpointIDs(ids...)
+	return ebuo
+}
+
+// RemoveCheckpoints removes "checkpoints" edges to Checkpoint entities.
+func (ebuo *EnvBuildUpdateOne) RemoveCheckpoints(c ...*Checkpoint) *EnvBuildUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ebuo.RemoveCheckpointIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the EnvBuildTag entity.
+func (ebuo *EnvBuildUpdateOne) ClearTags() *EnvBuildUpdateOne {
+	ebuo.mutation.ClearTags()
+	return ebuo
+}
+
+// RemoveTagIDs removes the "tags" edge to EnvBuildTag entities by IDs.
+func (ebuo *EnvBuildUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *EnvBuildUpdateOne {
+	ebuo.mutation.RemoveTagIDs(ids...)
+	return ebuo
+}
+
+// RemoveTags removes "tags" edges to EnvBuildTag entities.
+func (ebuo *EnvBuildUpdateOne) RemoveTags(e ...*EnvBuildTag) *EnvBuildUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return ebuo.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the EnvBuildUpdate builder.
 func (ebuo *EnvBuildUpdateOne) Where(ps ...predicate.EnvBuild) *EnvBuildUpdateOne {
 	ebuo.mutation.Where(ps...)
@@ -980,6 +1755,78 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 	if ebuo.mutation.ClusterNodeIDCleared() {
 		_spec.ClearField(envbuild.FieldClusterNodeID, field.TypeString)
 	}
+	if value, ok := ebuo.mutation.NetworkBandwidthMibps(); ok {
+		_spec.SetField(envbuild.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := ebuo.mutation.AddedNetworkBandwidthMibps(); ok {
+		_spec.AddField(envbuild.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+	}
+	if ebuo.mutation.NetworkBandwidthMibpsCleared() {
+		_spec.ClearField(envbuild.FieldNetworkBandwidthMibps, field.TypeInt64)
+	}
+	if value, ok := ebuo.mutation.NetworkOps(); ok {
+		_spec.SetField(envbuild.FieldNetworkOps, field.TypeInt64, value)
+	}
+	if value, ok := ebuo.mutation.AddedNetworkOps(); ok {
+		_spec.AddField(envbuild.FieldNetworkOps, field.TypeInt64, value)
+	}
+	if ebuo.mutation.NetworkOpsCleared() {
+		_spec.ClearField(envbuild.FieldNetworkOps, field.TypeInt64)
+	}
+	if value, ok := ebuo.mutation.DiskBandwidthMibps(); ok {
+		_spec.SetField(envbuild.FieldDiskBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := ebuo.mutation.AddedDiskBandwidthMibps(); ok {
+		_spec.AddField(envbuild.FieldDiskBandwidthMibps, field.TypeInt64, value)
+	}
+	if ebuo.mutation.DiskBandwidthMibpsCleared() {
+		_spec.ClearField(envbuild.FieldDiskBandwidthMibps, field.TypeInt64)
+	}
+	if value, ok := ebuo.mutation.DiskIops(); ok {
+		_spec.SetField(envbuild.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := ebuo.mutation.AddedDiskIops(); ok {
+		_spec.AddField(envbuild.FieldDiskIops, field.TypeInt64, value)
+	}
+	if ebuo.mutation.DiskIopsCleared() {
+		_spec.ClearField(envbuild.FieldDiskIops, field.TypeInt64)
+	}
+	if value, ok := ebuo.mutation.MemoryTargetMB(); ok {
+		_spec.SetField(envbuild.FieldMemoryTargetMB, field.TypeInt64, value)
+	}
+	if value, ok := ebuo.mutation.AddedMemoryTargetMB(); ok {
+		_spec.AddField(envbuild.FieldMemoryTargetMB, field.TypeInt64, value)
+	}
+	if ebuo.mutation.MemoryTargetMBCleared() {
+		_spec.ClearField(envbuild.FieldMemoryTargetMB, field.TypeInt64)
+	}
+	if value, ok := ebuo.mutation.Priority(); ok {
+		_spec.SetField(envbuild.FieldPriority, field.TypeInt64, value)
+	}
+	if value, ok := ebuo.mutation.AddedPriority(); ok {
+		_spec.AddField(envbuild.FieldPriority, field.TypeInt64, value)
+	}
+	if value, ok := ebuo.mutation.QueuedAt(); ok {
+		_spec.SetField(envbuild.FieldQueuedAt, field.TypeTime, value)
+	}
+	if ebuo.mutation.QueuedAtCleared() {
+		_spec.ClearField(envbuild.FieldQueuedAt, field.TypeTime)
+	}
+	if value, ok := ebuo.mutation.BaseEnvID(); ok {
+		_spec.SetField(envbuild.FieldBaseEnvID, field.TypeString, value)
+	}
+	if ebuo.mutation.BaseEnvIDCleared() {
+		_spec.ClearField(envbuild.FieldBaseEnvID, field.TypeString)
+	}
+	if value, ok := ebuo.mutation.Checkpoint(); ok {
+		_spec.SetField(envbuild.FieldCheckpoint, field.TypeBool, value)
+	}
+	if value, ok := ebuo.mutation.ParentBuildID(); ok {
+		_spec.SetField(envbuild.FieldParentBuildID, field.TypeUUID, value)
+	}
+	if ebuo.mutation.ParentBuildIDCleared() {
+		_spec.ClearField(envbuild.FieldParentBuildID, field.TypeUUID)
+	}
 	if ebuo.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1011,6 +1858,102 @@ func (ebuo *EnvBuildUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuild, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ebuo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.Checkpoint
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebuo.mutation.RemovedCheckpointsIDs(); len(nodes) > 0 && !ebuo.mutation.CheckpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebuo.mutation.CheckpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.CheckpointsTable,
+			Columns: []string{envbuild.CheckpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.Checkpoint
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ebuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.TagsTable,
+			Columns: []string{envbuild.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.EnvBuildTag
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !ebuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.TagsTable,
+			Columns: []string{envbuild.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   envbuild.TagsTable,
+			Columns: []string{envbuild.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebuo.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ebuo.schemaConfig.EnvBuild
 	ctx = internal.NewSchemaConfigContext(ctx, ebuo.schemaConfig)
 	_spec.AddModifiers(ebuo.modifiers...)
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
+	"github.com/google/uuid"
+)
+
+// EnvBuildTag is the model entity for the EnvBuildTag schema.
+type EnvBuildTag struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// EnvID holds the value of the "env_id" field.
+	EnvID string `json:"env_id,omitempty"`
+	// Name of the tag, unique for the template
+	Tag string `json:"tag,omitempty"`
+	// Build the tag points to, the build can't be deleted while it's tagged
+	BuildID uuid.UUID `json:"build_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EnvBuildTagQuery when eager-loading is set.
+	Edges        EnvBuildTagEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EnvBuildTagEdges holds the relations/edges for other nodes in the graph.
+type EnvBuildTagEdges struct {
+	// Env holds the value of the env edge.
+	Env *Env `json:"env,omitempty"`
+	// Build holds the value of the build edge.
+	Build *EnvBuild `json:"build,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EnvOrErr returns the Env value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnvBuildTagEdges) EnvOrErr() (*Env, error) {
+	if e.loadedTypes[0] {
+		if e.Env == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: env.Label}
+		}
+		return e.Env, nil
+	}
+	return nil, &NotLoadedError{edge: "env"}
+}
+
+// BuildOrErr returns the Build value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnvBuildTagEdges) BuildOrErr() (*EnvBuild, error) {
+	if e.loadedTypes[1] {
+		if e.Build == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: envbuild.Label}
+		}
+		return e.Build, nil
+	}
+	return nil, &NotLoadedError{edge: "build"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EnvBuildTag) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case envbuildtag.FieldEnvID, envbuildtag.FieldTag:
+			values[i] = new(sql.NullString)
+		case envbuildtag.FieldCreatedAt, envbuildtag.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case envbuildtag.FieldID, envbuildtag.FieldBuildID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EnvBuildTag fields.
+func (ebt *EnvBuildTag) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case envbuildtag.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ebt.ID = *value
+			}
+		case envbuildtag.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ebt.CreatedAt = value.Time
+			}
+		case envbuildtag.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ebt.UpdatedAt = value.Time
+			}
+		case envbuildtag.FieldEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				ebt.EnvID = value.String
+			}
+		case envbuildtag.FieldTag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tag", values[i])
+			} else if value.Valid {
+				ebt.Tag = value.String
+			}
+		case envbuildtag.FieldBuildID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field build_id", values[i])
+			} else if value != nil {
+				ebt.BuildID = *value
+			}
+		default:
+			ebt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EnvBuildTag.
+// This includes values selected through modifiers, order, etc.
+func (ebt *EnvBuildTag) Value(name string) (ent.Value, error) {
+	return ebt.selectValues.Get(name)
+}
+
+// QueryEnv queries the "env" edge of the EnvBuildTag entity.
+func (ebt *EnvBuildTag) QueryEnv() *EnvQuery {
+	return NewEnvBuildTagClient(ebt.config).QueryEnv(ebt)
+}
+
+// QueryBuild queries the "build" edge of the EnvBuildTag entity.
+func (ebt *EnvBuildTag) QueryBuild() *EnvBuildQuery {
+	return NewEnvBuildTagClient(ebt.config).QueryBuild(ebt)
+}
+
+// Update returns a builder for updating this EnvBuildTag.
+// Note that you need to call EnvBuildTag.Unwrap() before calling this method if this EnvBuildTag
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ebt *EnvBuildTag) Update() *EnvBuildTagUpdateOne {
+	return NewEnvBuildTagClient(ebt.config).UpdateOne(ebt)
+}
+
+// Unwrap unwraps the EnvBuildTag entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ebt *EnvBuildTag) Unwrap() *EnvBuildTag {
+	_tx, ok := ebt.config.driver.(*txDriver)
+	if !ok {
+		panic("models: EnvBuildTag is not a transactional entity")
+	}
+	ebt.config.driver = _tx.drv
+	return ebt
+}
+
+// String implements the fmt.Stringer.
+func (ebt *EnvBuildTag) String() string {
+	var builder strings.Builder
+	builder.WriteString("EnvBuildTag(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ebt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ebt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ebt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(ebt.EnvID)
+	builder.WriteString(", ")
+	builder.WriteString("tag=")
+	builder.WriteString(ebt.Tag)
+	builder.WriteString(", ")
+	builder.WriteString("build_id=")
+	builder.WriteString(fmt.Sprintf("%v", ebt.BuildID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EnvBuildTags is a parsable slice of EnvBuildTag.
+type EnvBuildTags []*EnvBuildTag
@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
+	"github.com/google/uuid"
+)
+
+// EnvBuildTagCreate is the builder for creating a EnvBuildTag entity.
+type EnvBuildTagCreate struct {
+	config
+	mutation *EnvBuildTagMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ebtc *EnvBuildTagCreate) SetCreatedAt(t time.Time) *EnvBuildTagCreate {
+	ebtc.mutation.SetCreatedAt(t)
+	return ebtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ebtc *EnvBuildTagCreate) SetNillableCreatedAt(t *time.Time) *EnvBuildTagCreate {
+	if t != nil {
+		ebtc.SetCreatedAt(*t)
+	}
+	return ebtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ebtc *EnvBuildTagCreate) SetUpdatedAt(t time.Time) *EnvBuildTagCreate {
+	ebtc.mutation.SetUpdatedAt(t)
+	return ebtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ebtc *EnvBuildTagCreate) SetNillableUpdatedAt(t *time.Time) *EnvBuildTagCreate {
+	if t != nil {
+		ebtc.SetUpdatedAt(*t)
+	}
+	return ebtc
+}
+
+// SetEnvID sets the "env_id" field.
+func (ebtc *EnvBuildTagCreate) SetEnvID(s string) *EnvBuildTagCreate {
+	ebtc.mutation.SetEnvID(s)
+	return ebtc
+}
+
+// SetTag sets the "tag" field.
+func (ebtc *EnvBuildTagCreate) SetTag(s string) *EnvBuildTagCreate {
+	ebtc.mutation.SetTag(s)
+	return ebtc
+}
+
+// SetBuildID sets the "build_id" field.
+func (ebtc *EnvBuildTagCreate) SetBuildID(u uuid.UUID) *EnvBuildTagCreate {
+	ebtc.mutation.SetBuildID(u)
+	return ebtc
+}
+
+// SetID sets the "id" field.
+func (ebtc *EnvBuildTagCreate) SetID(u uuid.UUID) *EnvBuildTagCreate {
+	ebtc.mutation.SetID(u)
+	return ebtc
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (ebtc *EnvBuildTagCreate) SetEnv(e *Env) *EnvBuildTagCreate {
+	return ebtc.SetEnvID(e.ID)
+}
+
+// SetBuild sets the "build" edge to the EnvBuild entity.
+func (ebtc *EnvBuildTagCreate) SetBuild(e *EnvBuild) *EnvBuildTagCreate {
+	return ebtc.SetBuildID(e.ID)
+}
+
+// Mutation returns the EnvBuildTagMutation object of the builder.
+func (ebtc *EnvBuildTagCreate) Mutation() *EnvBuildTagMutation {
+	return ebtc.mutation
+}
+
+// Save creates the EnvBuildTag in the database.
+func (ebtc *EnvBuildTagCreate) Save(ctx context.Context) (*EnvBuildTag, error) {
+	ebtc.defaults()
+	return withHooks(ctx, ebtc.sqlSave, ebtc.mutation, ebtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ebtc *EnvBuildTagCreate) SaveX(ctx context.Context) *EnvBuildTag {
+	v, err := ebtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ebtc *EnvBuildTagCreate) Exec(ctx context.Context) error {
+	_, err := ebtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ebtc *EnvBuildTagCreate) ExecX(ctx context.Context) {
+	if err := ebtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ebtc *EnvBuildTagCreate) defaults() {
+	if _, ok := ebtc.mutation.CreatedAt(); !ok {
+		v := envbuildtag.DefaultCreatedAt()
+		ebtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ebtc.mutation.UpdatedAt(); !ok {
+		v := envbuildtag.DefaultUpdatedAt()
+		ebtc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ebtc *EnvBuildTagCreate) check() error {
+	if _, ok := ebtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "EnvBuildTag.created_at"`)}
+	}
+	if _, ok := ebtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`models: missing required field "EnvBuildTag.updated_at"`)}
+	}
+	if _, ok := ebtc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`models: missing required field "EnvBuildTag.env_id"`)}
+	}
+	if _, ok := ebtc.mutation.Tag(); !ok {
+		return &ValidationError{Name: "tag", err: errors.New(`models: missing required field "EnvBuildTag.tag"`)}
+	}
+	if _, ok := ebtc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build_id", err: errors.New(`models: missing required field "EnvBuildTag.build_id"`)}
+	}
+	if _, ok := ebtc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env", err: errors.New(`models: missing required edge "EnvBuildTag.env"`)}
+	}
+	if _, ok := ebtc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build", err: errors.New(`models: missing required edge "EnvBuildTag.build"`)}
+	}
+	return nil
+}
+
+func (ebtc *EnvBuildTagCreate) sqlSave(ctx context.Context) (*EnvBuildTag, error) {
+	if err := ebtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ebtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ebtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ebtc.mutation.id = &_node.ID
+	ebtc.mutation.done = true
+	return _node, nil
+}
+
+func (ebtc *EnvBuildTagCreate) createSpec() (*EnvBuildTag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnvBuildTag{config: ebtc.config}
+		_spec = sqlgraph.NewCreateSpec(envbuildtag.Table, sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = ebtc.schemaConfig.EnvBuildTag
+	_spec.OnConflict = ebtc.conflict
+	if id, ok := ebtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ebtc.mutation.CreatedAt(); ok {
+		_spec.SetField(envbuildtag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ebtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(envbuildtag.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ebtc.mutation.Tag(); ok {
+		_spec.SetField(envbuildtag.FieldTag, field.TypeString, value)
+		_node.Tag = value
+	}
+	if nodes := ebtc.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.EnvTable,
+			Columns: []string{envbuildtag.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ebtc.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ebtc.mutation.BuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.BuildTable,
+			Columns: []string{envbuildtag.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebtc.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BuildID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvBuildTag.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvBuildTagUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ebtc *EnvBuildTagCreate) OnConflict(opts ...sql.ConflictOption) *EnvBuildTagUpsertOne {
+	ebtc.conflict = opts
+	return &EnvBuildTagUpsertOne{
+		create: ebtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvBuildTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ebtc *EnvBuildTagCreate) OnConflictColumns(columns ...string) *EnvBuildTagUpsertOne {
+	ebtc.conflict = append(ebtc.conflict, sql.ConflictColumns(columns...))
+	return &EnvBuildTagUpsertOne{
+		create: ebtc,
+	}
+}
+
+type (
+	// EnvBuildTagUpsertOne is the builder for "upsert"-ing
+	//  one EnvBuildTag node.
+	EnvBuildTagUpsertOne struct {
+		create *EnvBuildTagCreate
+	}
+
+	// EnvBuildTagUpsert is the "OnConflict" setter.
+	EnvBuildTagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvBuildTagUpsert) SetUpdatedAt(v time.Time) *EnvBuildTagUpsert {
+	u.Set(envbuildtag.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvBuildTagUpsert) UpdateUpdatedAt() *EnvBuildTagUpsert {
+	u.SetExcluded(envbuildtag.FieldUpdatedAt)
+	return u
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *EnvBuildTagUpsert) SetEnvID(v string) *EnvBuildTagUpsert {
+	u.Set(envbuildtag.FieldEnvID, v)
+	return u
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *EnvBuildTagUpsert) UpdateEnvID() *EnvBuildTagUpsert {
+	u.SetExcluded(envbuildtag.FieldEnvID)
+	return u
+}
+
+// SetTag sets the "tag" field.
+func (u *EnvBuildTagUpsert) SetTag(v string) *EnvBuildTagUpsert {
+	u.Set(envbuildtag.FieldTag, v)
+	return u
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *EnvBuildTagUpsert) UpdateTag() *EnvBuildTagUpsert {
+	u.SetExcluded(envbuildtag.FieldTag)
+	return u
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *EnvBuildTagUpsert) SetBuildID(v uuid.UUID) *EnvBuildTagUpsert {
+	u.Set(envbuildtag.FieldBuildID, v)
+	return u
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *EnvBuildTagUpsert) UpdateBuildID() *EnvBuildTagUpsert {
+	u.SetExcluded(envbuildtag.FieldBuildID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EnvBuildTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(envbuildtag.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EnvBuildTagUpsertOne) UpdateNewValues() *EnvBuildTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(envbuildtag.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(envbuildtag.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvBuildTag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EnvBuildTagUpsertOne) Ignore() *EnvBuildTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvBuildTagUpsertOne) DoNothing() *EnvBuildTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvBuildTagCreate.OnConflict
+// documentation for more info.
+func (u *EnvBuildTagUpsertOne) Update(set func(*EnvBuildTagUpsert)) *EnvBuildTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvBuildTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvBuildTagUpsertOne) SetUpdatedAt(v time.Time) *EnvBuildTagUpsertOne {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvBuildTagUpsertOne) UpdateUpdatedAt() *EnvBuildTagUpsertOne {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *EnvBuildTagUpsertOne) SetEnvID(v string) *EnvBuildTagUpsertOne {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.SetEnvID(v)
+	})
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *EnvBuildTagUpsertOne) UpdateEnvID() *EnvBuildTagUpsertOne {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.UpdateEnvID()
+	})
+}
+
+// SetTag sets the "tag" field.
+func (u *EnvBuildTagUpsertOne) SetTag(v string) *EnvBuildTagUpsertOne {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.SetTag(v)
+	})
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *EnvBuildTagUpsertOne) UpdateTag() *EnvBuildTagUpsertOne {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.UpdateTag()
+	})
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *EnvBuildTagUpsertOne) SetBuildID(v uuid.UUID) *EnvBuildTagUpsertOne {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.SetBuildID(v)
+	})
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *EnvBuildTagUpsertOne) UpdateBuildID() *EnvBuildTagUpsertOne {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.UpdateBuildID()
+	})
+}
+
+// Exec executes the query.
+func (u *EnvBuildTagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for EnvBuildTagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvBuildTagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EnvBuildTagUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: EnvBuildTagUpsertOne.ID is not supported by MySQL driver. Use EnvBuildTagUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EnvBuildTagUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EnvBuildTagCreateBulk is the builder for creating many EnvBuildTag entities in bulk.
+type EnvBuildTagCreateBulk struct {
+	config
+	err      error
+	builders []*EnvBuildTagCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EnvBuildTag entities in the database.
+func (ebtcb *EnvBuildTagCreateBulk) Save(ctx context.Context) ([]*EnvBuildTag, error) {
+	if ebtcb.err != nil {
+		return nil, ebtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ebtcb.builders))
+	nodes := make([]*EnvBuildTag, len(ebtcb.builders))
+	mutators := make([]Mutator, len(ebtcb.builders))
+	for i := range ebtcb.builders {
+		func(i int, root context.Context) {
+			builder := ebtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnvBuildTagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ebtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ebtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ebtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ebtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ebtcb *EnvBuildTagCreateBulk) SaveX(ctx context.Context) []*EnvBuildTag {
+	v, err := ebtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ebtcb *EnvBuildTagCreateBulk) Exec(ctx context.Context) error {
+	_, err := ebtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ebtcb *EnvBuildTagCreateBulk) ExecX(ctx context.Context) {
+	if err := ebtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EnvBuildTag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EnvBuildTagUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ebtcb *EnvBuildTagCreateBulk) OnConflict(opts ...sql.ConflictOption) *EnvBuildTagUpsertBulk {
+	ebtcb.conflict = opts
+	return &EnvBuildTagUpsertBulk{
+		create: ebtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EnvBuildTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ebtcb *EnvBuildTagCreateBulk) OnConflictColumns(columns ...string) *EnvBuildTagUpsertBulk {
+	ebtcb.conflict = append(ebtcb.conflict, sql.ConflictColumns(columns...))
+	return &EnvBuildTagUpsertBulk{
+		create: ebtcb,
+	}
+}
+
+// EnvBuildTagUpsertBulk is the builder for "upsert"-ing
+// a bulk of EnvBuildTag nodes.
+type EnvBuildTagUpsertBulk struct {
+	create *EnvBuildTagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EnvBuildTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(envbuildtag.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EnvBuildTagUpsertBulk) UpdateNewValues() *EnvBuildTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(envbuildtag.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(envbuildtag.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EnvBuildTag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EnvBuildTagUpsertBulk) Ignore() *EnvBuildTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EnvBuildTagUpsertBulk) DoNothing() *EnvBuildTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EnvBuildTagCreateBulk.OnConflict
+// documentation for more info.
+func (u *EnvBuildTagUpsertBulk) Update(set func(*EnvBuildTagUpsert)) *EnvBuildTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EnvBuildTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EnvBuildTagUpsertBulk) SetUpdatedAt(v time.Time) *EnvBuildTagUpsertBulk {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EnvBuildTagUpsertBulk) UpdateUpdatedAt() *EnvBuildTagUpsertBulk {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *EnvBuildTagUpsertBulk) SetEnvID(v string) *EnvBuildTagUpsertBulk {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.SetEnvID(v)
+	})
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *EnvBuildTagUpsertBulk) UpdateEnvID() *EnvBuildTagUpsertBulk {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.UpdateEnvID()
+	})
+}
+
+// SetTag sets the "tag" field.
+func (u *EnvBuildTagUpsertBulk) SetTag(v string) *EnvBuildTagUpsertBulk {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.SetTag(v)
+	})
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *EnvBuildTagUpsertBulk) UpdateTag() *EnvBuildTagUpsertBulk {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.UpdateTag()
+	})
+}
+
+// SetBuildID sets the "build_id" field.
+func (u *EnvBuildTagUpsertBulk) SetBuildID(v uuid.UUID) *EnvBuildTagUpsertBulk {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.SetBuildID(v)
+	})
+}
+
+// UpdateBuildID sets the "build_id" field to the value that was provided on create.
+func (u *EnvBuildTagUpsertBulk) UpdateBuildID() *EnvBuildTagUpsertBulk {
+	return u.Update(func(s *EnvBuildTagUpsert) {
+		s.UpdateBuildID()
+	})
+}
+
+// Exec executes the query.
+func (u *EnvBuildTagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the EnvBuildTagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for EnvBuildTagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EnvBuildTagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+)
+
+// EnvBuildTagDelete is the builder for deleting a EnvBuildTag entity.
+type EnvBuildTagDelete struct {
+	config
+	hooks    []Hook
+	mutation *EnvBuildTagMutation
+}
+
+// Where appends a list predicates to the EnvBuildTagDelete builder.
+func (ebtd *EnvBuildTagDelete) Where(ps ...predicate.EnvBuildTag) *EnvBuildTagDelete {
+	ebtd.mutation.Where(ps...)
+	return ebtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ebtd *EnvBuildTagDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ebtd.sqlExec, ebtd.mutation, ebtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ebtd *EnvBuildTagDelete) ExecX(ctx context.Context) int {
+	n, err := ebtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ebtd *EnvBuildTagDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(envbuildtag.Table, sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID))
+	_spec.Node.Schema = ebtd.schemaConfig.EnvBuildTag
+	ctx = internal.NewSchemaConfigContext(ctx, ebtd.schemaConfig)
+	if ps := ebtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ebtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ebtd.mutation.done = true
+	return affected, err
+}
+
+// EnvBuildTagDeleteOne is the builder for deleting a single EnvBuildTag entity.
+type EnvBuildTagDeleteOne struct {
+	ebtd *EnvBuildTagDelete
+}
+
+// Where appends a list predicates to the EnvBuildTagDelete builder.
+func (ebtdo *EnvBuildTagDeleteOne) Where(ps ...predicate.EnvBuildTag) *EnvBuildTagDeleteOne {
+	ebtdo.ebtd.mutation.Where(ps...)
+	return ebtdo
+}
+
+// Exec executes the deletion query.
+func (ebtdo *EnvBuildTagDeleteOne) Exec(ctx context.Context) error {
+	n, err := ebtdo.ebtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{envbuildtag.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ebtdo *EnvBuildTagDeleteOne) ExecX(ctx context.Context) {
+	if err := ebtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
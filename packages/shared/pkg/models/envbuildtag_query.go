@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// EnvBuildTagQuery is the builder for querying EnvBuildTag entities.
+type EnvBuildTagQuery struct {
+	config
+	ctx        *QueryContext
+	order      []envbuildtag.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EnvBuildTag
+	withEnv    *EnvQuery
+	withBuild  *EnvBuildQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EnvBuildTagQuery builder.
+func (ebtq *EnvBuildTagQuery) Where(ps ...predicate.EnvBuildTag) *EnvBuildTagQuery {
+	ebtq.predicates = append(ebtq.predicates, ps...)
+	return ebtq
+}
+
+// Limit the number of records to be returned by this query.
+func (ebtq *EnvBuildTagQuery) Limit(limit int) *EnvBuildTagQuery {
+	ebtq.ctx.Limit = &limit
+	return ebtq
+}
+
+// Offset to start from.
+func (ebtq *EnvBuildTagQuery) Offset(offset int) *EnvBuildTagQuery {
+	ebtq.ctx.Offset = &offset
+	return ebtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ebtq *EnvBuildTagQuery) Unique(unique bool) *EnvBuildTagQuery {
+	ebtq.ctx.Unique = &unique
+	return ebtq
+}
+
+// Order specifies how the records should be ordered.
+func (ebtq *EnvBuildTagQuery) Order(o ...envbuildtag.OrderOption) *EnvBuildTagQuery {
+	ebtq.order = append(ebtq.order, o...)
+	return ebtq
+}
+
+// QueryEnv chains the current query on the "env" edge.
+func (ebtq *EnvBuildTagQuery) QueryEnv() *EnvQuery {
+	query := (&EnvClient{config: ebtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ebtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ebtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envbuildtag.Table, envbuildtag.FieldID, selector),
+			sqlgraph.To(env.Table, env.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, envbuildtag.EnvTable, envbuildtag.EnvColumn),
+		)
+		schemaConfig := ebtq.schemaConfig
+		step.To.Schema = schemaConfig.Env
+		step.Edge.Schema = schemaConfig.EnvBuildTag
+		fromU = sqlgraph.SetNeighbors(ebtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBuild chains the current query on the "build" edge.
+func (ebtq *EnvBuildTagQuery) QueryBuild() *EnvBuildQuery {
+	query := (&EnvBuildClient{config: ebtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ebtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ebtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(envbuildtag.Table, envbuildtag.FieldID, selector),
+			sqlgraph.To(envbuild.Table, envbuild.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, envbuildtag.BuildTable, envbuildtag.BuildColumn),
+		)
+		schemaConfig := ebtq.schemaConfig
+		step.To.Schema = schemaConfig.EnvBuild
+		step.Edge.Schema = schemaConfig.EnvBuildTag
+		fromU = sqlgraph.SetNeighbors(ebtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EnvBuildTag entity from the query.
+// Returns a *NotFoundError when no EnvBuildTag was found.
+func (ebtq *EnvBuildTagQuery) First(ctx context.Context) (*EnvBuildTag, error) {
+	nodes, err := ebtq.Limit(1).All(setContextOp(ctx, ebtq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{envbuildtag.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ebtq *EnvBuildTagQuery) FirstX(ctx context.Context) *EnvBuildTag {
+	node, err := ebtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EnvBuildTag ID from the query.
+// Returns a *NotFoundError when no EnvBuildTag ID was found.
+func (ebtq *EnvBuildTagQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ebtq.Limit(1).IDs(setContextOp(ctx, ebtq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{envbuildtag.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ebtq *EnvBuildTagQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ebtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EnvBuildTag entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EnvBuildTag entity is found.
+// Returns a *NotFoundError when no EnvBuildTag entities are found.
+func (ebtq *EnvBuildTagQuery) Only(ctx context.Context) (*EnvBuildTag, error) {
+	nodes, err := ebtq.Limit(2).All(setContextOp(ctx, ebtq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{envbuildtag.Label}
+	default:
+		return nil, &NotSingularError{envbuildtag.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ebtq *EnvBuildTagQuery) OnlyX(ctx context.Context) *EnvBuildTag {
+	node, err := ebtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EnvBuildTag ID in the query.
+// Returns a *NotSingularError when more than one EnvBuildTag ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ebtq *EnvBuildTagQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ebtq.Limit(2).IDs(setContextOp(ctx, ebtq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{envbuildtag.Label}
+	default:
+		err = &NotSingularError{envbuildtag.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ebtq *EnvBuildTagQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ebtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EnvBuildTags.
+func (ebtq *EnvBuildTagQuery) All(ctx context.Context) ([]*EnvBuildTag, error) {
+	ctx = setContextOp(ctx, ebtq.ctx, "All")
+	if err := ebtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EnvBuildTag, *EnvBuildTagQuery]()
+	return withInterceptors[[]*EnvBuildTag](ctx, ebtq, qr, ebtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ebtq *EnvBuildTagQuery) AllX(ctx context.Context) []*EnvBuildTag {
+	nodes, err := ebtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EnvBuildTag IDs.
+func (ebtq *EnvBuildTagQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ebtq.ctx.Unique == nil && ebtq.path != nil {
+		ebtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ebtq.ctx, "IDs")
+	if err = ebtq.Select(envbuildtag.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ebtq *EnvBuildTagQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ebtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ebtq *EnvBuildTagQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ebtq.ctx, "Count")
+	if err := ebtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ebtq, querierCount[*EnvBuildTagQuery](), ebtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ebtq *EnvBuildTagQuery) CountX(ctx context.Context) int {
+	count, err := ebtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ebtq *EnvBuildTagQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ebtq.ctx, "Exist")
+	switch _, err := ebtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ebtq *EnvBuildTagQuery) ExistX(ctx context.Context) bool {
+	exist, err := ebtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EnvBuildTagQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ebtq *EnvBuildTagQuery) Clone() *EnvBuildTagQuery {
+	if ebtq == nil {
+		return nil
+	}
+	return &EnvBuildTagQuery{
+		config:     ebtq.config,
+		ctx:        ebtq.ctx.Clone(),
+		order:      append([]envbuildtag.OrderOption{}, ebtq.order...),
+		inters:     append([]Interceptor{}, ebtq.inters...),
+		predicates: append([]predicate.EnvBuildTag{}, ebtq.predicates...),
+		withEnv:    ebtq.withEnv.Clone(),
+		withBuild:  ebtq.withBuild.Clone(),
+		// clone intermediate query.
+		sql:  ebtq.sql.Clone(),
+		path: ebtq.path,
+	}
+}
+
+// WithEnv tells the query-builder to eager-load the nodes that are connected to
+// the "env" edge. The optional arguments are used to configure the query builder of the edge.
+func (ebtq *EnvBuildTagQuery) WithEnv(opts ...func(*EnvQuery)) *EnvBuildTagQuery {
+	query := (&EnvClient{config: ebtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ebtq.withEnv = query
+	return ebtq
+}
+
+// WithBuild tells the query-builder to eager-load the nodes that are connected to
+// the "build" edge. The optional arguments are used to configure the query builder of the edge.
+func (ebtq *EnvBuildTagQuery) WithBuild(opts ...func(*EnvBuildQuery)) *EnvBuildTagQuery {
+	query := (&EnvBuildClient{config: ebtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ebtq.withBuild = query
+	return ebtq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EnvBuildTag.Query().
+//		GroupBy(envbuildtag.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (ebtq *EnvBuildTagQuery) GroupBy(field string, fields ...string) *EnvBuildTagGroupBy {
+	ebtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EnvBuildTagGroupBy{build: ebtq}
+	grbuild.flds = &ebtq.ctx.Fields
+	grbuild.label = envbuildtag.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.EnvBuildTag.Query().
+//		Select(envbuildtag.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ebtq *EnvBuildTagQuery) Select(fields ...string) *EnvBuildTagSelect {
+	ebtq.ctx.Fields = append(ebtq.ctx.Fields, fields...)
+	sbuild := &EnvBuildTagSelect{EnvBuildTagQuery: ebtq}
+	sbuild.label = envbuildtag.Label
+	sbuild.flds, sbuild.scan = &ebtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EnvBuildTagSelect configured with the given aggregations.
+func (ebtq *EnvBuildTagQuery) Aggregate(fns ...AggregateFunc) *EnvBuildTagSelect {
+	return ebtq.Select().Aggregate(fns...)
+}
+
+func (ebtq *EnvBuildTagQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ebtq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ebtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ebtq.ctx.Fields {
+		if !envbuildtag.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if ebtq.path != nil {
+		prev, err := ebtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ebtq.sql = prev
+	}
+	return nil
+}
+
+func (ebtq *EnvBuildTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EnvBuildTag, error) {
+	var (
+		nodes       = []*EnvBuildTag{}
+		_spec       = ebtq.querySpec()
+		loadedTypes = [2]bool{
+			ebtq.withEnv != nil,
+			ebtq.withBuild != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EnvBuildTag).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EnvBuildTag{config: ebtq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = ebtq.schemaConfig.EnvBuildTag
+	ctx = internal.NewSchemaConfigContext(ctx, ebtq.schemaConfig)
+	if len(ebtq.modifiers) > 0 {
+		_spec.Modifiers = ebtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ebtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ebtq.withEnv; query != nil {
+		if err := ebtq.loadEnv(ctx, query, nodes, nil,
+			func(n *EnvBuildTag, e *Env) { n.Edges.Env = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ebtq.withBuild; query != nil {
+		if err := ebtq.loadBuild(ctx, query, nodes, nil,
+			func(n *EnvBuildTag, e *EnvBuild) { n.Edges.Build = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ebtq *EnvBuildTagQuery) loadEnv(ctx context.Context, query *EnvQuery, nodes []*EnvBuildTag, init func(*EnvBuildTag), assign func(*EnvBuildTag, *Env)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*EnvBuildTag)
+	for i := range nodes {
+		fk := nodes[i].EnvID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(env.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "env_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ebtq *EnvBuildTagQuery) loadBuild(ctx context.Context, query *EnvBuildQuery, nodes []*EnvBuildTag, init func(*EnvBuildTag), assign func(*EnvBuildTag, *EnvBuild)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*EnvBuildTag)
+	for i := range nodes {
+		fk := nodes[i].BuildID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(envbuild.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "build_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ebtq *EnvBuildTagQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ebtq.querySpec()
+	_spec.Node.Schema = ebtq.schemaConfig.EnvBuildTag
+	ctx = internal.NewSchemaConfigContext(ctx, ebtq.schemaConfig)
+	if len(ebtq.modifiers) > 0 {
+		_spec.Modifiers = ebtq.modifiers
+	}
+	_spec.Node.Columns = ebtq.ctx.Fields
+	if len(ebtq.ctx.Fields) > 0 {
+		_spec.Unique = ebtq.ctx.Unique != nil && *ebtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ebtq.driver, _spec)
+}
+
+func (ebtq *EnvBuildTagQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(envbuildtag.Table, envbuildtag.Columns, sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID))
+	_spec.From = ebtq.sql
+	if unique := ebtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ebtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ebtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envbuildtag.FieldID)
+		for i := range fields {
+			if fields[i] != envbuildtag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ebtq.withEnv != nil {
+			_spec.Node.AddColumnOnce(envbuildtag.FieldEnvID)
+		}
+		if ebtq.withBuild != nil {
+			_spec.Node.AddColumnOnce(envbuildtag.FieldBuildID)
+		}
+	}
+	if ps := ebtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ebtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ebtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ebtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ebtq *EnvBuildTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ebtq.driver.Dialect())
+	t1 := builder.Table(envbuildtag.Table)
+	columns := ebtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = envbuildtag.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ebtq.sql != nil {
+		selector = ebtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ebtq.ctx.Unique != nil && *ebtq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(ebtq.schemaConfig.EnvBuildTag)
+	ctx = internal.NewSchemaConfigContext(ctx, ebtq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range ebtq.modifiers {
+		m(selector)
+	}
+	for _, p := range ebtq.predicates {
+		p(selector)
+	}
+	for _, p := range ebtq.order {
+		p(selector)
+	}
+	if offset := ebtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ebtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ebtq *EnvBuildTagQuery) Modify(modifiers ...func(s *sql.Selector)) *EnvBuildTagSelect {
+	ebtq.modifiers = append(ebtq.modifiers, modifiers...)
+	return ebtq.Select()
+}
+
+// EnvBuildTagGroupBy is the group-by builder for EnvBuildTag entities.
+type EnvBuildTagGroupBy struct {
+	selector
+	build *EnvBuildTagQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ebtgb *EnvBuildTagGroupBy) Aggregate(fns ...AggregateFunc) *EnvBuildTagGroupBy {
+	ebtgb.fns = append(ebtgb.fns, fns...)
+	return ebtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ebtgb *EnvBuildTagGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ebtgb.build.ctx, "GroupBy")
+	if err := ebtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvBuildTagQuery, *EnvBuildTagGroupBy](ctx, ebtgb.build, ebtgb, ebtgb.build.inters, v)
+}
+
+func (ebtgb *EnvBuildTagGroupBy) sqlScan(ctx context.Context, root *EnvBuildTagQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ebtgb.fns))
+	for _, fn := range ebtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ebtgb.flds)+len(ebtgb.fns))
+		for _, f := range *ebtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ebtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ebtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EnvBuildTagSelect is the builder for selecting fields of EnvBuildTag entities.
+type EnvBuildTagSelect struct {
+	*EnvBuildTagQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ebts *EnvBuildTagSelect) Aggregate(fns ...AggregateFunc) *EnvBuildTagSelect {
+	ebts.fns = append(ebts.fns, fns...)
+	return ebts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ebts *EnvBuildTagSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ebts.ctx, "Select")
+	if err := ebts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EnvBuildTagQuery, *EnvBuildTagSelect](ctx, ebts.EnvBuildTagQuery, ebts, ebts.inters, v)
+}
+
+func (ebts *EnvBuildTagSelect) sqlScan(ctx context.Context, root *EnvBuildTagQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ebts.fns))
+	for _, fn := range ebts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ebts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ebts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ebts *EnvBuildTagSelect) Modify(modifiers ...func(s *sql.Selector)) *EnvBuildTagSelect {
+	ebts.modifiers = append(ebts.modifiers, modifiers...)
+	return ebts
+}
@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/google/uuid"
+)
+
+// EnvBuildTagUpdate is the builder for updating EnvBuildTag entities.
+type EnvBuildTagUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EnvBuildTagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EnvBuildTagUpdate builder.
+func (ebtu *EnvBuildTagUpdate) Where(ps ...predicate.EnvBuildTag) *EnvBuildTagUpdate {
+	ebtu.mutation.Where(ps...)
+	return ebtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ebtu *EnvBuildTagUpdate) SetUpdatedAt(t time.Time) *EnvBuildTagUpdate {
+	ebtu.mutation.SetUpdatedAt(t)
+	return ebtu
+}
+
+// SetEnvID sets the "env_id" field.
+func (ebtu *EnvBuildTagUpdate) SetEnvID(s string) *EnvBuildTagUpdate {
+	ebtu.mutation.SetEnvID(s)
+	return ebtu
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (ebtu *EnvBuildTagUpdate) SetNillableEnvID(s *string) *EnvBuildTagUpdate {
+	if s != nil {
+		ebtu.SetEnvID(*s)
+	}
+	return ebtu
+}
+
+// SetTag sets the "tag" field.
+func (ebtu *EnvBuildTagUpdate) SetTag(s string) *EnvBuildTagUpdate {
+	ebtu.mutation.SetTag(s)
+	return ebtu
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (ebtu *EnvBuildTagUpdate) SetNillableTag(s *string) *EnvBuildTagUpdate {
+	if s != nil {
+		ebtu.SetTag(*s)
+	}
+	return ebtu
+}
+
+// SetBuildID sets the "build_id" field.
+func (ebtu *EnvBuildTagUpdate) SetBuildID(u uuid.UUID) *EnvBuildTagUpdate {
+	ebtu.mutation.SetBuildID(u)
+	return ebtu
+}
+
+// SetNillableBuildID sets the "build_id" field if the given value is not nil.
+func (ebtu *EnvBuildTagUpdate) SetNillableBuildID(u *uuid.UUID) *EnvBuildTagUpdate {
+	if u != nil {
+		ebtu.SetBuildID(*u)
+	}
+	return ebtu
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (ebtu *EnvBuildTagUpdate) SetEnv(e *Env) *EnvBuildTagUpdate {
+	return ebtu.SetEnvID(e.ID)
+}
+
+// SetBuild sets the "build" edge to the EnvBuild entity.
+func (ebtu *EnvBuildTagUpdate) SetBuild(e *EnvBuild) *EnvBuildTagUpdate {
+	return ebtu.SetBuildID(e.ID)
+}
+
+// Mutation returns the EnvBuildTagMutation object of the builder.
+func (ebtu *EnvBuildTagUpdate) Mutation() *EnvBuildTagMutation {
+	return ebtu.mutation
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (ebtu *EnvBuildTagUpdate) ClearEnv() *EnvBuildTagUpdate {
+	ebtu.mutation.ClearEnv()
+	return ebtu
+}
+
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (ebtu *EnvBuildTagUpdate) ClearBuild() *EnvBuildTagUpdate {
+	ebtu.mutation.ClearBuild()
+	return ebtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ebtu *EnvBuildTagUpdate) Save(ctx context.Context) (int, error) {
+	ebtu.defaults()
+	return withHooks(ctx, ebtu.sqlSave, ebtu.mutation, ebtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ebtu *EnvBuildTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := ebtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ebtu *EnvBuildTagUpdate) Exec(ctx context.Context) error {
+	_, err := ebtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ebtu *EnvBuildTagUpdate) ExecX(ctx context.Context) {
+	if err := ebtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ebtu *EnvBuildTagUpdate) defaults() {
+	if _, ok := ebtu.mutation.UpdatedAt(); !ok {
+		v := envbuildtag.UpdateDefaultUpdatedAt()
+		ebtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ebtu *EnvBuildTagUpdate) check() error {
+	if _, ok := ebtu.mutation.EnvID(); ebtu.mutation.EnvCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "EnvBuildTag.env"`)
+	}
+	if _, ok := ebtu.mutation.BuildID(); ebtu.mutation.BuildCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "EnvBuildTag.build"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ebtu *EnvBuildTagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnvBuildTagUpdate {
+	ebtu.modifiers = append(ebtu.modifiers, modifiers...)
+	return ebtu
+}
+
+func (ebtu *EnvBuildTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ebtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envbuildtag.Table, envbuildtag.Columns, sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID))
+	if ps := ebtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ebtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(envbuildtag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ebtu.mutation.Tag(); ok {
+		_spec.SetField(envbuildtag.FieldTag, field.TypeString, value)
+	}
+	if ebtu.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.EnvTable,
+			Columns: []string{envbuildtag.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ebtu.schemaConfig.EnvBuildTag
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebtu.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.EnvTable,
+			Columns: []string{envbuildtag.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ebtu.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ebtu.mutation.BuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.BuildTable,
+			Columns: []string{envbuildtag.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebtu.schemaConfig.EnvBuildTag
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebtu.mutation.BuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.BuildTable,
+			Columns: []string{envbuildtag.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebtu.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = ebtu.schemaConfig.EnvBuildTag
+	ctx = internal.NewSchemaConfigContext(ctx, ebtu.schemaConfig)
+	_spec.AddModifiers(ebtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ebtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envbuildtag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ebtu.mutation.done = true
+	return n, nil
+}
+
+// EnvBuildTagUpdateOne is the builder for updating a single EnvBuildTag entity.
+type EnvBuildTagUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EnvBuildTagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ebtuo *EnvBuildTagUpdateOne) SetUpdatedAt(t time.Time) *EnvBuildTagUpdateOne {
+	ebtuo.mutation.SetUpdatedAt(t)
+	return ebtuo
+}
+
+// SetEnvID sets the "env_id" field.
+func (ebtuo *EnvBuildTagUpdateOne) SetEnvID(s string) *EnvBuildTagUpdateOne {
+	ebtuo.mutation.SetEnvID(s)
+	return ebtuo
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (ebtuo *EnvBuildTagUpdateOne) SetNillableEnvID(s *string) *EnvBuildTagUpdateOne {
+	if s != nil {
+		ebtuo.SetEnvID(*s)
+	}
+	return ebtuo
+}
+
+// SetTag sets the "tag" field.
+func (ebtuo *EnvBuildTagUpdateOne) SetTag(s string) *EnvBuildTagUpdateOne {
+	ebtuo.mutation.SetTag(s)
+	return ebtuo
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (ebtuo *EnvBuildTagUpdateOne) SetNillableTag(s *string) *EnvBuildTagUpdateOne {
+	if s != nil {
+		ebtuo.SetTag(*s)
+	}
+	return ebtuo
+}
+
+// SetBuildID sets the "build_id" field.
+func (ebtuo *EnvBuildTagUpdateOne) SetBuildID(u uuid.UUID) *EnvBuildTagUpdateOne {
+	ebtuo.mutation.SetBuildID(u)
+	return ebtuo
+}
+
+// SetNillableBuildID sets the "build_id" field if the given value is not nil.
+func (ebtuo *EnvBuildTagUpdateOne) SetNillableBuildID(u *uuid.UUID) *EnvBuildTagUpdateOne {
+	if u != nil {
+		ebtuo.SetBuildID(*u)
+	}
+	return ebtuo
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (ebtuo *EnvBuildTagUpdateOne) SetEnv(e *Env) *EnvBuildTagUpdateOne {
+	return ebtuo.SetEnvID(e.ID)
+}
+
+// SetBuild sets the "build" edge to the EnvBuild entity.
+func (ebtuo *EnvBuildTagUpdateOne) SetBuild(e *EnvBuild) *EnvBuildTagUpdateOne {
+	return ebtuo.SetBuildID(e.ID)
+}
+
+// Mutation returns the EnvBuildTagMutation object of the builder.
+func (ebtuo *EnvBuildTagUpdateOne) Mutation() *EnvBuildTagMutation {
+	return ebtuo.mutation
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (ebtuo *EnvBuildTagUpdateOne) ClearEnv() *EnvBuildTagUpdateOne {
+	ebtuo.mutation.ClearEnv()
+	return ebtuo
+}
+
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (ebtuo *EnvBuildTagUpdateOne) ClearBuild() *EnvBuildTagUpdateOne {
+	ebtuo.mutation.ClearBuild()
+	return ebtuo
+}
+
+// Where appends a list predicates to the EnvBuildTagUpdate builder.
+func (ebtuo *EnvBuildTagUpdateOne) Where(ps ...predicate.EnvBuildTag) *EnvBuildTagUpdateOne {
+	ebtuo.mutation.Where(ps...)
+	return ebtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ebtuo *EnvBuildTagUpdateOne) Select(field string, fields ...string) *EnvBuildTagUpdateOne {
+	ebtuo.fields = append([]string{field}, fields...)
+	return ebtuo
+}
+
+// Save executes the query and returns the updated EnvBuildTag entity.
+func (ebtuo *EnvBuildTagUpdateOne) Save(ctx context.Context) (*EnvBuildTag, error) {
+	ebtuo.defaults()
+	return withHooks(ctx, ebtuo.sqlSave, ebtuo.mutation, ebtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ebtuo *EnvBuildTagUpdateOne) SaveX(ctx context.Context) *EnvBuildTag {
+	node, err := ebtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ebtuo *EnvBuildTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := ebtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ebtuo *EnvBuildTagUpdateOne) ExecX(ctx context.Context) {
+	if err := ebtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ebtuo *EnvBuildTagUpdateOne) defaults() {
+	if _, ok := ebtuo.mutation.UpdatedAt(); !ok {
+		v := envbuildtag.UpdateDefaultUpdatedAt()
+		ebtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ebtuo *EnvBuildTagUpdateOne) check() error {
+	if _, ok := ebtuo.mutation.EnvID(); ebtuo.mutation.EnvCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "EnvBuildTag.env"`)
+	}
+	if _, ok := ebtuo.mutation.BuildID(); ebtuo.mutation.BuildCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "EnvBuildTag.build"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ebtuo *EnvBuildTagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EnvBuildTagUpdateOne {
+	ebtuo.modifiers = append(ebtuo.modifiers, modifiers...)
+	return ebtuo
+}
+
+func (ebtuo *EnvBuildTagUpdateOne) sqlSave(ctx context.Context) (_node *EnvBuildTag, err error) {
+	if err := ebtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(envbuildtag.Table, envbuildtag.Columns, sqlgraph.NewFieldSpec(envbuildtag.FieldID, field.TypeUUID))
+	id, ok := ebtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "EnvBuildTag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ebtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, envbuildtag.FieldID)
+		for _, f := range fields {
+			if !envbuildtag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != envbuildtag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ebtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ebtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(envbuildtag.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ebtuo.mutation.Tag(); ok {
+		_spec.SetField(envbuildtag.FieldTag, field.TypeString, value)
+	}
+	if ebtuo.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.EnvTable,
+			Columns: []string{envbuildtag.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ebtuo.schemaConfig.EnvBuildTag
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebtuo.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.EnvTable,
+			Columns: []string{envbuildtag.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ebtuo.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ebtuo.mutation.BuildCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.BuildTable,
+			Columns: []string{envbuildtag.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebtuo.schemaConfig.EnvBuildTag
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ebtuo.mutation.BuildIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   envbuildtag.BuildTable,
+			Columns: []string{envbuildtag.BuildColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(envbuild.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = ebtuo.schemaConfig.EnvBuildTag
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = ebtuo.schemaConfig.EnvBuildTag
+	ctx = internal.NewSchemaConfigContext(ctx, ebtuo.schemaConfig)
+	_spec.AddModifiers(ebtuo.modifiers...)
+	_node = &EnvBuildTag{config: ebtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ebtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{envbuildtag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ebtuo.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,30 @@ func (f AccessTokenFunc) Mutate(ctx context.Context, m models.Mutation) (models.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.AccessTokenMutation", m)
 }
 
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *models.AuditLogMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.AuditLogMutation", m)
+}
+
+// The CheckpointFunc type is an adapter to allow the use of ordinary
+// function as Checkpoint mutator.
+type CheckpointFunc func(context.Context, *models.CheckpointMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CheckpointFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.CheckpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.CheckpointMutation", m)
+}
+
 // The ClusterFunc type is an adapter to allow the use of ordinary
 // function as Cluster mutator.
 type ClusterFunc func(context.Context, *models.ClusterMutation) (models.Value, error)
@@ -69,6 +93,18 @@ func (f EnvBuildFunc) Mutate(ctx context.Context, m models.Mutation) (models.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.EnvBuildMutation", m)
 }
 
+// The EnvBuildTagFunc type is an adapter to allow the use of ordinary
+// function as EnvBuildTag mutator.
+type EnvBuildTagFunc func(context.Context, *models.EnvBuildTagMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EnvBuildTagFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.EnvBuildTagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.EnvBuildTagMutation", m)
+}
+
 // The SnapshotFunc type is an adapter to allow the use of ordinary
 // function as Snapshot mutator.
 type SnapshotFunc func(context.Context, *models.SnapshotMutation) (models.Value, error)
@@ -141,6 +177,42 @@ func (f UsersTeamsFunc) Mutate(ctx context.Context, m models.Mutation) (models.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.UsersTeamsMutation", m)
 }
 
+// The WarmPoolFunc type is an adapter to allow the use of ordinary
+// function as WarmPool mutator.
+type WarmPoolFunc func(context.Context, *models.WarmPoolMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WarmPoolFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.WarmPoolMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.WarmPoolMutation", m)
+}
+
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *models.WebhookMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.WebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.WebhookMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *models.WebhookDeliveryMutation) (models.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m models.Mutation) (models.Value, error) {
+	if mv, ok := m.(*models.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *models.WebhookDeliveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, models.Mutation) bool
 
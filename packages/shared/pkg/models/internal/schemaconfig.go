@@ -7,17 +7,23 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	AccessToken string // AccessToken table.
-	Cluster     string // Cluster table.
-	Env         string // Env table.
-	EnvAlias    string // EnvAlias table.
-	EnvBuild    string // EnvBuild table.
-	Snapshot    string // Snapshot table.
-	Team        string // Team table.
-	TeamAPIKey  string // TeamAPIKey table.
-	Tier        string // Tier table.
-	User        string // User table.
-	UsersTeams  string // UsersTeams table.
+	AccessToken     string // AccessToken table.
+	AuditLog        string // AuditLog table.
+	Checkpoint      string // Checkpoint table.
+	Cluster         string // Cluster table.
+	Env             string // Env table.
+	EnvAlias        string // EnvAlias table.
+	EnvBuild        string // EnvBuild table.
+	EnvBuildTag     string // EnvBuildTag table.
+	Snapshot        string // Snapshot table.
+	Team            string // Team table.
+	TeamAPIKey      string // TeamAPIKey table.
+	Tier            string // Tier table.
+	User            string // User table.
+	UsersTeams      string // UsersTeams table.
+	WarmPool        string // WarmPool table.
+	Webhook         string // Webhook table.
+	WebhookDelivery string // WebhookDelivery table.
 }
 
 type schemaCtxKey struct{}
@@ -36,6 +36,64 @@ var (
 			},
 		},
 	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "team_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "action", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "actor_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "resource_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "resource_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "ip", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "request_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_team_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[1]},
+			},
+		},
+	}
+	// CheckpointsColumns holds the columns for the "checkpoints" table.
+	CheckpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "sandbox_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "team_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "parent_build_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "env_build_id", Type: field.TypeUUID},
+	}
+	// CheckpointsTable holds the schema information for the "checkpoints" table.
+	CheckpointsTable = &schema.Table{
+		Name:       "checkpoints",
+		Columns:    CheckpointsColumns,
+		PrimaryKey: []*schema.Column{CheckpointsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "checkpoints_env_builds_checkpoints",
+				Columns:    []*schema.Column{CheckpointsColumns[6]},
+				RefColumns: []*schema.Column{EnvBuildsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "checkpoint_team_id_sandbox_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{CheckpointsColumns[3], CheckpointsColumns[2], CheckpointsColumns[4]},
+			},
+		},
+	}
 	// ClustersColumns holds the columns for the "clusters" table.
 	ClustersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
@@ -59,6 +117,8 @@ var (
 		{Name: "spawn_count", Type: field.TypeInt64, Comment: "Number of times the env was spawned", Default: 0},
 		{Name: "last_spawned_at", Type: field.TypeTime, Nullable: true, Comment: "Timestamp of the last time the env was spawned"},
 		{Name: "cluster_id", Type: field.TypeUUID, Nullable: true, SchemaType: map[string]string{"postgres": "uuid"}},
+		{Name: "source_env_id", Type: field.TypeString, Nullable: true, Comment: "Env the snapshot of the sandbox was taken from, its builds are the base of the env builds", SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "source_sandbox_id", Type: field.TypeString, Nullable: true, Comment: "Sandbox the env holds the state of for the envs derived from it, the env is removed once it is not used", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "team_id", Type: field.TypeUUID},
 		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
 	}
@@ -70,13 +130,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "envs_teams_envs",
-				Columns:    []*schema.Column{EnvsColumns[8]},
+				Columns:    []*schema.Column{EnvsColumns[10]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "envs_users_created_envs",
-				Columns:    []*schema.Column{EnvsColumns[9]},
+				Columns:    []*schema.Column{EnvsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -108,7 +168,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "building", "snapshotting", "failed", "success", "uploaded"}, Default: "waiting", SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "queued", "building", "snapshotting", "failed", "success", "uploaded", "cancelled"}, Default: "waiting", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "dockerfile", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "start_cmd", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "ready_cmd", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
@@ -120,6 +180,16 @@ var (
 		{Name: "firecracker_version", Type: field.TypeString, Default: "v1.10.1_1fcdaec", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "envd_version", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "cluster_node_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "network_bandwidth_mibps", Type: field.TypeInt64, Nullable: true},
+		{Name: "network_ops", Type: field.TypeInt64, Nullable: true},
+		{Name: "disk_bandwidth_mibps", Type: field.TypeInt64, Nullable: true},
+		{Name: "disk_iops", Type: field.TypeInt64, Nullable: true},
+		{Name: "memory_target_mb", Type: field.TypeInt64, Nullable: true},
+		{Name: "priority", Type: field.TypeInt64, Default: "0"},
+		{Name: "queued_at", Type: field.TypeTime, Nullable: true},
+		{Name: "base_env_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "checkpoint", Type: field.TypeBool, Default: "false"},
+		{Name: "parent_build_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "env_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// EnvBuildsTable holds the schema information for the "env_builds" table.
@@ -130,10 +200,46 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "env_builds_envs_builds",
-				Columns:    []*schema.Column{EnvBuildsColumns[16]},
+				Columns:    []*schema.Column{EnvBuildsColumns[26]},
+				RefColumns: []*schema.Column{EnvsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// EnvBuildTagsColumns holds the columns for the "env_build_tags" table.
+	EnvBuildTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tag", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "build_id", Type: field.TypeUUID},
+	}
+	// EnvBuildTagsTable holds the schema information for the "env_build_tags" table.
+	EnvBuildTagsTable = &schema.Table{
+		Name:       "env_build_tags",
+		Columns:    EnvBuildTagsColumns,
+		PrimaryKey: []*schema.Column{EnvBuildTagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "env_build_tags_envs_build_tags",
+				Columns:    []*schema.Column{EnvBuildTagsColumns[4]},
 				RefColumns: []*schema.Column{EnvsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "env_build_tags_env_builds_tags",
+				Columns:    []*schema.Column{EnvBuildTagsColumns[5]},
+				RefColumns: []*schema.Column{EnvBuildsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "envbuildtag_env_id_tag",
+				Unique:  true,
+				Columns: []*schema.Column{EnvBuildTagsColumns[4], EnvBuildTagsColumns[3]},
+			},
 		},
 	}
 	// SnapshotsColumns holds the columns for the "snapshots" table.
@@ -145,6 +251,10 @@ var (
 		{Name: "metadata", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "sandbox_started_at", Type: field.TypeTime},
 		{Name: "env_secure", Type: field.TypeBool, Default: false},
+		{Name: "network_policy", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "port_access", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "paused_at", Type: field.TypeTime, Nullable: true},
+		{Name: "retention_hours", Type: field.TypeInt64, Nullable: true},
 		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// SnapshotsTable holds the schema information for the "snapshots" table.
@@ -155,11 +265,18 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "snapshots_envs_snapshots",
-				Columns:    []*schema.Column{SnapshotsColumns[7]},
+				Columns:    []*schema.Column{SnapshotsColumns[11]},
 				RefColumns: []*schema.Column{EnvsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "snapshot_paused_at",
+				Unique:  false,
+				Columns: []*schema.Column{SnapshotsColumns[9]},
+			},
+		},
 	}
 	// TeamsColumns holds the columns for the "teams" table.
 	TeamsColumns = []*schema.Column{
@@ -200,6 +317,9 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString, Default: "Unnamed API Key", SchemaType: map[string]string{"postgres": "text"}},
 		{Name: "last_used", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "allowed_templates", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "team_id", Type: field.TypeUUID},
 		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
 	}
@@ -211,13 +331,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "team_api_keys_teams_team_api_keys",
-				Columns:    []*schema.Column{TeamAPIKeysColumns[11]},
+				Columns:    []*schema.Column{TeamAPIKeysColumns[14]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "team_api_keys_users_created_api_keys",
-				Columns:    []*schema.Column{TeamAPIKeysColumns[12]},
+				Columns:    []*schema.Column{TeamAPIKeysColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -230,6 +350,21 @@ var (
 		{Name: "disk_mb", Type: field.TypeInt64, Default: "512"},
 		{Name: "concurrent_instances", Type: field.TypeInt64, Comment: "The number of instances the team can run concurrently"},
 		{Name: "max_length_hours", Type: field.TypeInt64},
+		{Name: "max_vcpu", Type: field.TypeInt64, Comment: "The maximum number of vCPUs of the templates and sandboxes", Default: "8"},
+		{Name: "max_ram_mb", Type: field.TypeInt64, Comment: "The maximum memory in MiB of the templates and sandboxes", Default: "8192"},
+		{Name: "concurrent_vcpus", Type: field.TypeInt64, Comment: "The number of vCPUs the running sandboxes of the team can use in total, 0 means unlimited", Default: "0"},
+		{Name: "concurrent_ram_mb", Type: field.TypeInt64, Comment: "The memory in MiB the running sandboxes of the team can use in total, 0 means unlimited", Default: "0"},
+		{Name: "paused_storage_mb", Type: field.TypeInt64, Comment: "The storage in MiB the paused sandbox snapshots of the team can use in total, 0 means unlimited", Default: "0"},
+		{Name: "paused_retention_hours", Type: field.TypeInt64, Comment: "How long the paused sandboxes of the team are kept before they are deleted, 0 means forever", Default: "0"},
+		{Name: "max_checkpoints", Type: field.TypeInt64, Comment: "The number of checkpoints kept per sandbox", Default: "10"},
+		{Name: "network_bandwidth_mibps", Type: field.TypeInt64, Comment: "Default network bandwidth limit of the sandboxes in MiB/s, 0 means unlimited", Default: "0"},
+		{Name: "network_ops", Type: field.TypeInt64, Comment: "Default network packets per second limit of the sandboxes, 0 means unlimited", Default: "0"},
+		{Name: "disk_bandwidth_mibps", Type: field.TypeInt64, Comment: "Default disk bandwidth limit of the sandboxes in MiB/s, 0 means unlimited", Default: "0"},
+		{Name: "disk_iops", Type: field.TypeInt64, Comment: "Default disk operations per second limit of the sandboxes, 0 means unlimited", Default: "0"},
+		{Name: "api_requests_per_second", Type: field.TypeInt64, Comment: "Number of API requests per second the team can make to each endpoint, 0 means unlimited", Default: "20"},
+		{Name: "api_requests_burst", Type: field.TypeInt64, Comment: "Number of API requests the team can make to each endpoint in a burst", Default: "100"},
+		{Name: "concurrent_template_builds", Type: field.TypeInt64, Comment: "The number of template builds the team can run concurrently, 0 means unlimited", Default: "0"},
+		{Name: "build_priority", Type: field.TypeInt64, Comment: "Priority of the template builds of the team in the build queue", Default: "0"},
 	}
 	// TiersTable holds the schema information for the "tiers" table.
 	TiersTable = &schema.Table{
@@ -282,25 +417,136 @@ var (
 			},
 		},
 	}
+	// WarmPoolsColumns holds the columns for the "warm_pools" table.
+	WarmPoolsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "size", Type: field.TypeInt32},
+		{Name: "env_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "team_id", Type: field.TypeUUID},
+	}
+	// WarmPoolsTable holds the schema information for the "warm_pools" table.
+	WarmPoolsTable = &schema.Table{
+		Name:       "warm_pools",
+		Columns:    WarmPoolsColumns,
+		PrimaryKey: []*schema.Column{WarmPoolsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "warm_pools_envs_warm_pools",
+				Columns:    []*schema.Column{WarmPoolsColumns[4]},
+				RefColumns: []*schema.Column{EnvsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "warm_pools_teams_warm_pools",
+				Columns:    []*schema.Column{WarmPoolsColumns[5]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "warmpool_team_id_env_id",
+				Unique:  true,
+				Columns: []*schema.Column{WarmPoolsColumns[5], WarmPoolsColumns[4]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "secret", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "events", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "enabled", Type: field.TypeBool, Default: "true"},
+		{Name: "team_id", Type: field.TypeUUID},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_teams_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[7]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_team_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[7]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true, Default: "gen_random_uuid()"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "sandbox_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "attempt", Type: field.TypeInt32},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "status_code", Type: field.TypeInt32, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[9]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[9], WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccessTokensTable,
+		AuditLogsTable,
+		CheckpointsTable,
 		ClustersTable,
 		EnvsTable,
 		EnvAliasesTable,
 		EnvBuildsTable,
+		EnvBuildTagsTable,
 		SnapshotsTable,
 		TeamsTable,
 		TeamAPIKeysTable,
 		TiersTable,
 		UsersTable,
 		UsersTeamsTable,
+		WarmPoolsTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
 	AccessTokensTable.ForeignKeys[0].RefTable = UsersTable
 	AccessTokensTable.Annotation = &entsql.Annotation{}
+	AuditLogsTable.Annotation = &entsql.Annotation{}
+	CheckpointsTable.ForeignKeys[0].RefTable = EnvBuildsTable
+	CheckpointsTable.Annotation = &entsql.Annotation{}
 	ClustersTable.Annotation = &entsql.Annotation{}
 	EnvsTable.ForeignKeys[0].RefTable = TeamsTable
 	EnvsTable.ForeignKeys[1].RefTable = UsersTable
@@ -311,6 +557,9 @@ func init() {
 	}
 	EnvBuildsTable.ForeignKeys[0].RefTable = EnvsTable
 	EnvBuildsTable.Annotation = &entsql.Annotation{}
+	EnvBuildTagsTable.ForeignKeys[0].RefTable = EnvsTable
+	EnvBuildTagsTable.ForeignKeys[1].RefTable = EnvBuildsTable
+	EnvBuildTagsTable.Annotation = &entsql.Annotation{}
 	SnapshotsTable.ForeignKeys[0].RefTable = EnvsTable
 	SnapshotsTable.Annotation = &entsql.Annotation{}
 	TeamsTable.ForeignKeys[0].RefTable = TiersTable
@@ -327,4 +576,11 @@ func init() {
 	UsersTeamsTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTeamsTable.ForeignKeys[1].RefTable = TeamsTable
 	UsersTeamsTable.Annotation = &entsql.Annotation{}
+	WarmPoolsTable.ForeignKeys[0].RefTable = EnvsTable
+	WarmPoolsTable.ForeignKeys[1].RefTable = TeamsTable
+	WarmPoolsTable.Annotation = &entsql.Annotation{}
+	WebhooksTable.ForeignKeys[0].RefTable = TeamsTable
+	WebhooksTable.Annotation = &entsql.Annotation{}
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	WebhookDeliveriesTable.Annotation = &entsql.Annotation{}
 }
@@ -12,10 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/auditlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/cluster"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
@@ -23,6 +26,10 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhook"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -35,17 +42,23 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAccessToken = "AccessToken"
-	TypeCluster     = "Cluster"
-	TypeEnv         = "Env"
-	TypeEnvAlias    = "EnvAlias"
-	TypeEnvBuild    = "EnvBuild"
-	TypeSnapshot    = "Snapshot"
-	TypeTeam        = "Team"
-	TypeTeamAPIKey  = "TeamAPIKey"
-	TypeTier        = "Tier"
-	TypeUser        = "User"
-	TypeUsersTeams  = "UsersTeams"
+	TypeAccessToken     = "AccessToken"
+	TypeAuditLog        = "AuditLog"
+	TypeCheckpoint      = "Checkpoint"
+	TypeCluster         = "Cluster"
+	TypeEnv             = "Env"
+	TypeEnvAlias        = "EnvAlias"
+	TypeEnvBuild        = "EnvBuild"
+	TypeEnvBuildTag     = "EnvBuildTag"
+	TypeSnapshot        = "Snapshot"
+	TypeTeam            = "Team"
+	TypeTeamAPIKey      = "TeamAPIKey"
+	TypeTier            = "Tier"
+	TypeUser            = "User"
+	TypeUsersTeams      = "UsersTeams"
+	TypeWarmPool        = "WarmPool"
+	TypeWebhook         = "Webhook"
+	TypeWebhookDelivery = "WebhookDelivery"
 )
 
 // AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
@@ -924,32 +937,39 @@ func (m *AccessTokenMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AccessToken edge %s", name)
 }
 
-// ClusterMutation represents an operation that mutates the Cluster nodes in the graph.
-type ClusterMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
-	endpoint      *string
-	endpoint_tls  *bool
-	token         *string
+	created_at    *time.Time
+	team_id       *uuid.UUID
+	action        *string
+	actor_type    *string
+	actor_id      *string
+	resource_type *string
+	resource_id   *string
+	ip            *string
+	request_id    *string
+	metadata      *map[string]string
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Cluster, error)
-	predicates    []predicate.Cluster
+	oldValue      func(context.Context) (*AuditLog, error)
+	predicates    []predicate.AuditLog
 }
 
-var _ ent.Mutation = (*ClusterMutation)(nil)
+var _ ent.Mutation = (*AuditLogMutation)(nil)
 
-// clusterOption allows management of the mutation configuration using functional options.
-type clusterOption func(*ClusterMutation)
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
 
-// newClusterMutation creates new mutation for the Cluster entity.
-func newClusterMutation(c config, op Op, opts ...clusterOption) *ClusterMutation {
-	m := &ClusterMutation{
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCluster,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -958,20 +978,20 @@ func newClusterMutation(c config, op Op, opts ...clusterOption) *ClusterMutation
 	return m
 }
 
-// withClusterID sets the ID field of the mutation.
-func withClusterID(id uuid.UUID) clusterOption {
-	return func(m *ClusterMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id uuid.UUID) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Cluster
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*Cluster, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Cluster.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -980,10 +1000,10 @@ func withClusterID(id uuid.UUID) clusterOption {
 	}
 }
 
-// withCluster sets the old Cluster of the mutation.
-func withCluster(node *Cluster) clusterOption {
-	return func(m *ClusterMutation) {
-		m.oldValue = func(context.Context) (*Cluster, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -992,7 +1012,7 @@ func withCluster(node *Cluster) clusterOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ClusterMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1000,7 +1020,7 @@ func (m ClusterMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ClusterMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -1010,14 +1030,14 @@ func (m ClusterMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Cluster entities.
-func (m *ClusterMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuditLog entities.
+func (m *AuditLogMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ClusterMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuditLogMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1028,7 +1048,7 @@ func (m *ClusterMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ClusterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1037,1112 +1057,1203 @@ func (m *ClusterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Cluster.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEndpoint sets the "endpoint" field.
-func (m *ClusterMutation) SetEndpoint(s string) {
-	m.endpoint = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Endpoint returns the value of the "endpoint" field in the mutation.
-func (m *ClusterMutation) Endpoint() (r string, exists bool) {
-	v := m.endpoint
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndpoint returns the old "endpoint" field's value of the Cluster entity.
-// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClusterMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Endpoint, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEndpoint resets all changes to the "endpoint" field.
-func (m *ClusterMutation) ResetEndpoint() {
-	m.endpoint = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetEndpointTLS sets the "endpoint_tls" field.
-func (m *ClusterMutation) SetEndpointTLS(b bool) {
-	m.endpoint_tls = &b
+// SetTeamID sets the "team_id" field.
+func (m *AuditLogMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
 }
 
-// EndpointTLS returns the value of the "endpoint_tls" field in the mutation.
-func (m *ClusterMutation) EndpointTLS() (r bool, exists bool) {
-	v := m.endpoint_tls
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *AuditLogMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndpointTLS returns the old "endpoint_tls" field's value of the Cluster entity.
-// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClusterMutation) OldEndpointTLS(ctx context.Context) (v bool, err error) {
+func (m *AuditLogMutation) OldTeamID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndpointTLS is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndpointTLS requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndpointTLS: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.EndpointTLS, nil
+	return oldValue.TeamID, nil
 }
 
-// ResetEndpointTLS resets all changes to the "endpoint_tls" field.
-func (m *ClusterMutation) ResetEndpointTLS() {
-	m.endpoint_tls = nil
+// ClearTeamID clears the value of the "team_id" field.
+func (m *AuditLogMutation) ClearTeamID() {
+	m.team_id = nil
+	m.clearedFields[auditlog.FieldTeamID] = struct{}{}
 }
 
-// SetToken sets the "token" field.
-func (m *ClusterMutation) SetToken(s string) {
-	m.token = &s
+// TeamIDCleared returns if the "team_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TeamIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTeamID]
+	return ok
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *ClusterMutation) Token() (r string, exists bool) {
-	v := m.token
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *AuditLogMutation) ResetTeamID() {
+	m.team_id = nil
+	delete(m.clearedFields, auditlog.FieldTeamID)
+}
+
+// SetAction sets the "action" field.
+func (m *AuditLogMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditLogMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the Cluster entity.
-// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ClusterMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.Action, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *ClusterMutation) ResetToken() {
-	m.token = nil
+// ResetAction resets all changes to the "action" field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// Where appends a list predicates to the ClusterMutation builder.
-func (m *ClusterMutation) Where(ps ...predicate.Cluster) {
-	m.predicates = append(m.predicates, ps...)
+// SetActorType sets the "actor_type" field.
+func (m *AuditLogMutation) SetActorType(s string) {
+	m.actor_type = &s
 }
 
-// WhereP appends storage-level predicates to the ClusterMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ClusterMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Cluster, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ActorType returns the value of the "actor_type" field in the mutation.
+func (m *AuditLogMutation) ActorType() (r string, exists bool) {
+	v := m.actor_type
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ClusterMutation) Op() Op {
-	return m.op
+// OldActorType returns the old "actor_type" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldActorType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorType: %w", err)
+	}
+	return oldValue.ActorType, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ClusterMutation) SetOp(op Op) {
-	m.op = op
+// ResetActorType resets all changes to the "actor_type" field.
+func (m *AuditLogMutation) ResetActorType() {
+	m.actor_type = nil
 }
 
-// Type returns the node type of this mutation (Cluster).
-func (m *ClusterMutation) Type() string {
-	return m.typ
+// SetActorID sets the "actor_id" field.
+func (m *AuditLogMutation) SetActorID(s string) {
+	m.actor_id = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ClusterMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.endpoint != nil {
-		fields = append(fields, cluster.FieldEndpoint)
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AuditLogMutation) ActorID() (r string, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
 	}
-	if m.endpoint_tls != nil {
-		fields = append(fields, cluster.FieldEndpointTLS)
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldActorID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
 	}
-	if m.token != nil {
-		fields = append(fields, cluster.FieldToken)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ClusterMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case cluster.FieldEndpoint:
-		return m.Endpoint()
-	case cluster.FieldEndpointTLS:
-		return m.EndpointTLS()
-	case cluster.FieldToken:
-		return m.Token()
-	}
-	return nil, false
+// ClearActorID clears the value of the "actor_id" field.
+func (m *AuditLogMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[auditlog.FieldActorID] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ClusterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case cluster.FieldEndpoint:
-		return m.OldEndpoint(ctx)
-	case cluster.FieldEndpointTLS:
-		return m.OldEndpointTLS(ctx)
-	case cluster.FieldToken:
-		return m.OldToken(ctx)
-	}
-	return nil, fmt.Errorf("unknown Cluster field %s", name)
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *AuditLogMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldActorID]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ClusterMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case cluster.FieldEndpoint:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndpoint(v)
-		return nil
-	case cluster.FieldEndpointTLS:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndpointTLS(v)
-		return nil
-	case cluster.FieldToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Cluster field %s", name)
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AuditLogMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, auditlog.FieldActorID)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ClusterMutation) AddedFields() []string {
-	return nil
+// SetResourceType sets the "resource_type" field.
+func (m *AuditLogMutation) SetResourceType(s string) {
+	m.resource_type = &s
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ClusterMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *AuditLogMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ClusterMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldResourceType returns the old "resource_type" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldResourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Cluster numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+	}
+	return oldValue.ResourceType, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ClusterMutation) ClearedFields() []string {
-	return nil
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *AuditLogMutation) ResetResourceType() {
+	m.resource_type = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ClusterMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetResourceID sets the "resource_id" field.
+func (m *AuditLogMutation) SetResourceID(s string) {
+	m.resource_id = &s
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ClusterMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Cluster nullable field %s", name)
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *AuditLogMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ClusterMutation) ResetField(name string) error {
-	switch name {
-	case cluster.FieldEndpoint:
-		m.ResetEndpoint()
-		return nil
-	case cluster.FieldEndpointTLS:
-		m.ResetEndpointTLS()
-		return nil
-	case cluster.FieldToken:
-		m.ResetToken()
-		return nil
+// OldResourceID returns the old "resource_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldResourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Cluster field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ClusterMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ClusterMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ClusterMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ClusterMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ClusterMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ClusterMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ClusterMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Cluster unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+	}
+	return oldValue.ResourceID, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ClusterMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Cluster edge %s", name)
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *AuditLogMutation) ResetResourceID() {
+	m.resource_id = nil
 }
 
-// EnvMutation represents an operation that mutates the Env nodes in the graph.
-type EnvMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	public             *bool
-	build_count        *int32
-	addbuild_count     *int32
-	spawn_count        *int64
-	addspawn_count     *int64
-	last_spawned_at    *time.Time
-	cluster_id         *uuid.UUID
-	clearedFields      map[string]struct{}
-	team               *uuid.UUID
-	clearedteam        bool
-	creator            *uuid.UUID
-	clearedcreator     bool
-	env_aliases        map[string]struct{}
-	removedenv_aliases map[string]struct{}
-	clearedenv_aliases bool
-	builds             map[uuid.UUID]struct{}
-	removedbuilds      map[uuid.UUID]struct{}
-	clearedbuilds      bool
-	snapshots          map[uuid.UUID]struct{}
-	removedsnapshots   map[uuid.UUID]struct{}
-	clearedsnapshots   bool
-	done               bool
-	oldValue           func(context.Context) (*Env, error)
-	predicates         []predicate.Env
+// SetIP sets the "ip" field.
+func (m *AuditLogMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-var _ ent.Mutation = (*EnvMutation)(nil)
-
-// envOption allows management of the mutation configuration using functional options.
-type envOption func(*EnvMutation)
-
-// newEnvMutation creates new mutation for the Env entity.
-func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
-	m := &EnvMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeEnv,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// IP returns the value of the "ip" field in the mutation.
+func (m *AuditLogMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withEnvID sets the ID field of the mutation.
-func withEnvID(id string) envOption {
-	return func(m *EnvMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Env
-		)
-		m.oldValue = func(ctx context.Context) (*Env, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Env.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldIP returns the old "ip" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldIP(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
-}
-
-// withEnv sets the old Env of the mutation.
-func withEnv(node *Env) envOption {
-	return func(m *EnvMutation) {
-		m.oldValue = func(context.Context) (*Env, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m EnvMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("models: mutation is not running in a transaction")
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.IP, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Env entities.
-func (m *EnvMutation) SetID(id string) {
-	m.id = &id
+// ClearIP clears the value of the "ip" field.
+func (m *AuditLogMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[auditlog.FieldIP] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *EnvMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *AuditLogMutation) IPCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldIP]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *EnvMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Env.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetIP resets all changes to the "ip" field.
+func (m *AuditLogMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, auditlog.FieldIP)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EnvMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetRequestID sets the "request_id" field.
+func (m *AuditLogMutation) SetRequestID(s string) {
+	m.request_id = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *AuditLogMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldRequestID returns the old "request_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldRequestID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.RequestID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearRequestID clears the value of the "request_id" field.
+func (m *AuditLogMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[auditlog.FieldRequestID] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EnvMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *AuditLogMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldRequestID]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *AuditLogMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, auditlog.FieldRequestID)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *AuditLogMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *AuditLogMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Metadata, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearMetadata clears the value of the "metadata" field.
+func (m *AuditLogMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[auditlog.FieldMetadata] = struct{}{}
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *EnvMutation) SetTeamID(u uuid.UUID) {
-	m.team = &u
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *AuditLogMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldMetadata]
+	return ok
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *EnvMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.team
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *AuditLogMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, auditlog.FieldMetadata)
 }
 
-// OldTeamID returns the old "team_id" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.TeamID, nil
+	m.Where(p...)
 }
 
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *EnvMutation) ResetTeamID() {
-	m.team = nil
+// Op returns the operation name.
+func (m *AuditLogMutation) Op() Op {
+	return m.op
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *EnvMutation) SetCreatedBy(u uuid.UUID) {
-	m.creator = &u
+// SetOp allows setting the mutation operation.
+func (m *AuditLogMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *EnvMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.creator
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
+	return m.typ
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	if m.team_id != nil {
+		fields = append(fields, auditlog.FieldTeamID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
 	}
-	return oldValue.CreatedBy, nil
-}
-
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *EnvMutation) ClearCreatedBy() {
-	m.creator = nil
-	m.clearedFields[env.FieldCreatedBy] = struct{}{}
-}
-
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *EnvMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[env.FieldCreatedBy]
-	return ok
-}
-
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *EnvMutation) ResetCreatedBy() {
-	m.creator = nil
-	delete(m.clearedFields, env.FieldCreatedBy)
+	if m.actor_type != nil {
+		fields = append(fields, auditlog.FieldActorType)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, auditlog.FieldActorID)
+	}
+	if m.resource_type != nil {
+		fields = append(fields, auditlog.FieldResourceType)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, auditlog.FieldResourceID)
+	}
+	if m.ip != nil {
+		fields = append(fields, auditlog.FieldIP)
+	}
+	if m.request_id != nil {
+		fields = append(fields, auditlog.FieldRequestID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, auditlog.FieldMetadata)
+	}
+	return fields
 }
 
-// SetPublic sets the "public" field.
-func (m *EnvMutation) SetPublic(b bool) {
-	m.public = &b
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditlog.FieldTeamID:
+		return m.TeamID()
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldActorType:
+		return m.ActorType()
+	case auditlog.FieldActorID:
+		return m.ActorID()
+	case auditlog.FieldResourceType:
+		return m.ResourceType()
+	case auditlog.FieldResourceID:
+		return m.ResourceID()
+	case auditlog.FieldIP:
+		return m.IP()
+	case auditlog.FieldRequestID:
+		return m.RequestID()
+	case auditlog.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
 }
 
-// Public returns the value of the "public" field in the mutation.
-func (m *EnvMutation) Public() (r bool, exists bool) {
-	v := m.public
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditlog.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case auditlog.FieldAction:
+		return m.OldAction(ctx)
+	case auditlog.FieldActorType:
+		return m.OldActorType(ctx)
+	case auditlog.FieldActorID:
+		return m.OldActorID(ctx)
+	case auditlog.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case auditlog.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case auditlog.FieldIP:
+		return m.OldIP(ctx)
+	case auditlog.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case auditlog.FieldMetadata:
+		return m.OldMetadata(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
-// OldPublic returns the old "public" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldPublic(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublic requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditlog.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case auditlog.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditlog.FieldActorType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorType(v)
+		return nil
+	case auditlog.FieldActorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case auditlog.FieldResourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case auditlog.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case auditlog.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case auditlog.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case auditlog.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
 	}
-	return oldValue.Public, nil
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
-// ResetPublic resets all changes to the "public" field.
-func (m *EnvMutation) ResetPublic() {
-	m.public = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditLogMutation) AddedFields() []string {
+	return nil
 }
 
-// SetBuildCount sets the "build_count" field.
-func (m *EnvMutation) SetBuildCount(i int32) {
-	m.build_count = &i
-	m.addbuild_count = nil
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// BuildCount returns the value of the "build_count" field in the mutation.
-func (m *EnvMutation) BuildCount() (r int32, exists bool) {
-	v := m.build_count
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
-// OldBuildCount returns the old "build_count" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldBuildCount(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuildCount is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldTeamID) {
+		fields = append(fields, auditlog.FieldTeamID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuildCount requires an ID field in the mutation")
+	if m.FieldCleared(auditlog.FieldActorID) {
+		fields = append(fields, auditlog.FieldActorID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuildCount: %w", err)
+	if m.FieldCleared(auditlog.FieldIP) {
+		fields = append(fields, auditlog.FieldIP)
 	}
-	return oldValue.BuildCount, nil
-}
-
-// AddBuildCount adds i to the "build_count" field.
-func (m *EnvMutation) AddBuildCount(i int32) {
-	if m.addbuild_count != nil {
-		*m.addbuild_count += i
-	} else {
-		m.addbuild_count = &i
+	if m.FieldCleared(auditlog.FieldRequestID) {
+		fields = append(fields, auditlog.FieldRequestID)
 	}
-}
-
-// AddedBuildCount returns the value that was added to the "build_count" field in this mutation.
-func (m *EnvMutation) AddedBuildCount() (r int32, exists bool) {
-	v := m.addbuild_count
-	if v == nil {
-		return
+	if m.FieldCleared(auditlog.FieldMetadata) {
+		fields = append(fields, auditlog.FieldMetadata)
 	}
-	return *v, true
+	return fields
 }
 
-// ResetBuildCount resets all changes to the "build_count" field.
-func (m *EnvMutation) ResetBuildCount() {
-	m.build_count = nil
-	m.addbuild_count = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetSpawnCount sets the "spawn_count" field.
-func (m *EnvMutation) SetSpawnCount(i int64) {
-	m.spawn_count = &i
-	m.addspawn_count = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldTeamID:
+		m.ClearTeamID()
+		return nil
+	case auditlog.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case auditlog.FieldIP:
+		m.ClearIP()
+		return nil
+	case auditlog.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	case auditlog.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
-// SpawnCount returns the value of the "spawn_count" field in the mutation.
-func (m *EnvMutation) SpawnCount() (r int64, exists bool) {
-	v := m.spawn_count
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case auditlog.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case auditlog.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditlog.FieldActorType:
+		m.ResetActorType()
+		return nil
+	case auditlog.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case auditlog.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case auditlog.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case auditlog.FieldIP:
+		m.ResetIP()
+		return nil
+	case auditlog.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case auditlog.FieldMetadata:
+		m.ResetMetadata()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
-// OldSpawnCount returns the old "spawn_count" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldSpawnCount(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpawnCount is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// CheckpointMutation represents an operation that mutates the Checkpoint nodes in the graph.
+type CheckpointMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	sandbox_id      *string
+	team_id         *uuid.UUID
+	name            *string
+	parent_build_id *uuid.UUID
+	clearedFields   map[string]struct{}
+	build           *uuid.UUID
+	clearedbuild    bool
+	done            bool
+	oldValue        func(context.Context) (*Checkpoint, error)
+	predicates      []predicate.Checkpoint
+}
+
+var _ ent.Mutation = (*CheckpointMutation)(nil)
+
+// checkpointOption allows management of the mutation configuration using functional options.
+type checkpointOption func(*CheckpointMutation)
+
+// newCheckpointMutation creates new mutation for the Checkpoint entity.
+func newCheckpointMutation(c config, op Op, opts ...checkpointOption) *CheckpointMutation {
+	m := &CheckpointMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCheckpoint,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpawnCount requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpawnCount: %w", err)
+	return m
+}
+
+// withCheckpointID sets the ID field of the mutation.
+func withCheckpointID(id uuid.UUID) checkpointOption {
+	return func(m *CheckpointMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Checkpoint
+		)
+		m.oldValue = func(ctx context.Context) (*Checkpoint, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Checkpoint.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.SpawnCount, nil
 }
 
-// AddSpawnCount adds i to the "spawn_count" field.
-func (m *EnvMutation) AddSpawnCount(i int64) {
-	if m.addspawn_count != nil {
-		*m.addspawn_count += i
-	} else {
-		m.addspawn_count = &i
+// withCheckpoint sets the old Checkpoint of the mutation.
+func withCheckpoint(node *Checkpoint) checkpointOption {
+	return func(m *CheckpointMutation) {
+		m.oldValue = func(context.Context) (*Checkpoint, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// AddedSpawnCount returns the value that was added to the "spawn_count" field in this mutation.
-func (m *EnvMutation) AddedSpawnCount() (r int64, exists bool) {
-	v := m.addspawn_count
-	if v == nil {
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CheckpointMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CheckpointMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Checkpoint entities.
+func (m *CheckpointMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CheckpointMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetSpawnCount resets all changes to the "spawn_count" field.
-func (m *EnvMutation) ResetSpawnCount() {
-	m.spawn_count = nil
-	m.addspawn_count = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CheckpointMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Checkpoint.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetLastSpawnedAt sets the "last_spawned_at" field.
-func (m *EnvMutation) SetLastSpawnedAt(t time.Time) {
-	m.last_spawned_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *CheckpointMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// LastSpawnedAt returns the value of the "last_spawned_at" field in the mutation.
-func (m *EnvMutation) LastSpawnedAt() (r time.Time, exists bool) {
-	v := m.last_spawned_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CheckpointMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSpawnedAt returns the old "last_spawned_at" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldLastSpawnedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CheckpointMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSpawnedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSpawnedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSpawnedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.LastSpawnedAt, nil
-}
-
-// ClearLastSpawnedAt clears the value of the "last_spawned_at" field.
-func (m *EnvMutation) ClearLastSpawnedAt() {
-	m.last_spawned_at = nil
-	m.clearedFields[env.FieldLastSpawnedAt] = struct{}{}
-}
-
-// LastSpawnedAtCleared returns if the "last_spawned_at" field was cleared in this mutation.
-func (m *EnvMutation) LastSpawnedAtCleared() bool {
-	_, ok := m.clearedFields[env.FieldLastSpawnedAt]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetLastSpawnedAt resets all changes to the "last_spawned_at" field.
-func (m *EnvMutation) ResetLastSpawnedAt() {
-	m.last_spawned_at = nil
-	delete(m.clearedFields, env.FieldLastSpawnedAt)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CheckpointMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetClusterID sets the "cluster_id" field.
-func (m *EnvMutation) SetClusterID(u uuid.UUID) {
-	m.cluster_id = &u
+// SetSandboxID sets the "sandbox_id" field.
+func (m *CheckpointMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
 }
 
-// ClusterID returns the value of the "cluster_id" field in the mutation.
-func (m *EnvMutation) ClusterID() (r uuid.UUID, exists bool) {
-	v := m.cluster_id
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *CheckpointMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClusterID returns the old "cluster_id" field's value of the Env entity.
-// If the Env object wasn't provided to the builder, the object is fetched from the database.
+// OldSandboxID returns the old "sandbox_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvMutation) OldClusterID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *CheckpointMutation) OldSandboxID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClusterID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClusterID requires an ID field in the mutation")
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClusterID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
 	}
-	return oldValue.ClusterID, nil
+	return oldValue.SandboxID, nil
 }
 
-// ClearClusterID clears the value of the "cluster_id" field.
-func (m *EnvMutation) ClearClusterID() {
-	m.cluster_id = nil
-	m.clearedFields[env.FieldClusterID] = struct{}{}
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *CheckpointMutation) ResetSandboxID() {
+	m.sandbox_id = nil
 }
 
-// ClusterIDCleared returns if the "cluster_id" field was cleared in this mutation.
-func (m *EnvMutation) ClusterIDCleared() bool {
-	_, ok := m.clearedFields[env.FieldClusterID]
-	return ok
+// SetTeamID sets the "team_id" field.
+func (m *CheckpointMutation) SetTeamID(u uuid.UUID) {
+	m.team_id = &u
 }
 
-// ResetClusterID resets all changes to the "cluster_id" field.
-func (m *EnvMutation) ResetClusterID() {
-	m.cluster_id = nil
-	delete(m.clearedFields, env.FieldClusterID)
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *CheckpointMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *EnvMutation) ClearTeam() {
-	m.clearedteam = true
-	m.clearedFields[env.FieldTeamID] = struct{}{}
+// OldTeamID returns the old "team_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *EnvMutation) TeamCleared() bool {
-	return m.clearedteam
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *CheckpointMutation) ResetTeamID() {
+	m.team_id = nil
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *EnvMutation) TeamIDs() (ids []uuid.UUID) {
-	if id := m.team; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetTeam resets all changes to the "team" edge.
-func (m *EnvMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
-}
-
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *EnvMutation) SetCreatorID(id uuid.UUID) {
-	m.creator = &id
-}
-
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *EnvMutation) ClearCreator() {
-	m.clearedcreator = true
-	m.clearedFields[env.FieldCreatedBy] = struct{}{}
-}
-
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *EnvMutation) CreatorCleared() bool {
-	return m.CreatedByCleared() || m.clearedcreator
+// SetName sets the "name" field.
+func (m *CheckpointMutation) SetName(s string) {
+	m.name = &s
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *EnvMutation) CreatorID() (id uuid.UUID, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// Name returns the value of the "name" field in the mutation.
+func (m *CheckpointMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *EnvMutation) CreatorIDs() (ids []uuid.UUID) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
+// OldName returns the old "name" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetCreator resets all changes to the "creator" edge.
-func (m *EnvMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
-}
-
-// AddEnvAliasIDs adds the "env_aliases" edge to the EnvAlias entity by ids.
-func (m *EnvMutation) AddEnvAliasIDs(ids ...string) {
-	if m.env_aliases == nil {
-		m.env_aliases = make(map[string]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.env_aliases[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
+	return oldValue.Name, nil
 }
 
-// ClearEnvAliases clears the "env_aliases" edge to the EnvAlias entity.
-func (m *EnvMutation) ClearEnvAliases() {
-	m.clearedenv_aliases = true
-}
-
-// EnvAliasesCleared reports if the "env_aliases" edge to the EnvAlias entity was cleared.
-func (m *EnvMutation) EnvAliasesCleared() bool {
-	return m.clearedenv_aliases
+// ResetName resets all changes to the "name" field.
+func (m *CheckpointMutation) ResetName() {
+	m.name = nil
 }
 
-// RemoveEnvAliasIDs removes the "env_aliases" edge to the EnvAlias entity by IDs.
-func (m *EnvMutation) RemoveEnvAliasIDs(ids ...string) {
-	if m.removedenv_aliases == nil {
-		m.removedenv_aliases = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.env_aliases, ids[i])
-		m.removedenv_aliases[ids[i]] = struct{}{}
-	}
+// SetEnvBuildID sets the "env_build_id" field.
+func (m *CheckpointMutation) SetEnvBuildID(u uuid.UUID) {
+	m.build = &u
 }
 
-// RemovedEnvAliases returns the removed IDs of the "env_aliases" edge to the EnvAlias entity.
-func (m *EnvMutation) RemovedEnvAliasesIDs() (ids []string) {
-	for id := range m.removedenv_aliases {
-		ids = append(ids, id)
+// EnvBuildID returns the value of the "env_build_id" field in the mutation.
+func (m *CheckpointMutation) EnvBuildID() (r uuid.UUID, exists bool) {
+	v := m.build
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// EnvAliasesIDs returns the "env_aliases" edge IDs in the mutation.
-func (m *EnvMutation) EnvAliasesIDs() (ids []string) {
-	for id := range m.env_aliases {
-		ids = append(ids, id)
+// OldEnvBuildID returns the old "env_build_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldEnvBuildID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvBuildID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetEnvAliases resets all changes to the "env_aliases" edge.
-func (m *EnvMutation) ResetEnvAliases() {
-	m.env_aliases = nil
-	m.clearedenv_aliases = false
-	m.removedenv_aliases = nil
-}
-
-// AddBuildIDs adds the "builds" edge to the EnvBuild entity by ids.
-func (m *EnvMutation) AddBuildIDs(ids ...uuid.UUID) {
-	if m.builds == nil {
-		m.builds = make(map[uuid.UUID]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvBuildID requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.builds[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvBuildID: %w", err)
 	}
+	return oldValue.EnvBuildID, nil
 }
 
-// ClearBuilds clears the "builds" edge to the EnvBuild entity.
-func (m *EnvMutation) ClearBuilds() {
-	m.clearedbuilds = true
+// ResetEnvBuildID resets all changes to the "env_build_id" field.
+func (m *CheckpointMutation) ResetEnvBuildID() {
+	m.build = nil
 }
 
-// BuildsCleared reports if the "builds" edge to the EnvBuild entity was cleared.
-func (m *EnvMutation) BuildsCleared() bool {
-	return m.clearedbuilds
+// SetParentBuildID sets the "parent_build_id" field.
+func (m *CheckpointMutation) SetParentBuildID(u uuid.UUID) {
+	m.parent_build_id = &u
 }
 
-// RemoveBuildIDs removes the "builds" edge to the EnvBuild entity by IDs.
-func (m *EnvMutation) RemoveBuildIDs(ids ...uuid.UUID) {
-	if m.removedbuilds == nil {
-		m.removedbuilds = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.builds, ids[i])
-		m.removedbuilds[ids[i]] = struct{}{}
+// ParentBuildID returns the value of the "parent_build_id" field in the mutation.
+func (m *CheckpointMutation) ParentBuildID() (r uuid.UUID, exists bool) {
+	v := m.parent_build_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedBuilds returns the removed IDs of the "builds" edge to the EnvBuild entity.
-func (m *EnvMutation) RemovedBuildsIDs() (ids []uuid.UUID) {
-	for id := range m.removedbuilds {
-		ids = append(ids, id)
+// OldParentBuildID returns the old "parent_build_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CheckpointMutation) OldParentBuildID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentBuildID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentBuildID: %w", err)
+	}
+	return oldValue.ParentBuildID, nil
 }
 
-// BuildsIDs returns the "builds" edge IDs in the mutation.
-func (m *EnvMutation) BuildsIDs() (ids []uuid.UUID) {
-	for id := range m.builds {
-		ids = append(ids, id)
-	}
-	return
+// ClearParentBuildID clears the value of the "parent_build_id" field.
+func (m *CheckpointMutation) ClearParentBuildID() {
+	m.parent_build_id = nil
+	m.clearedFields[checkpoint.FieldParentBuildID] = struct{}{}
 }
 
-// ResetBuilds resets all changes to the "builds" edge.
-func (m *EnvMutation) ResetBuilds() {
-	m.builds = nil
-	m.clearedbuilds = false
-	m.removedbuilds = nil
+// ParentBuildIDCleared returns if the "parent_build_id" field was cleared in this mutation.
+func (m *CheckpointMutation) ParentBuildIDCleared() bool {
+	_, ok := m.clearedFields[checkpoint.FieldParentBuildID]
+	return ok
 }
 
-// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by ids.
-func (m *EnvMutation) AddSnapshotIDs(ids ...uuid.UUID) {
-	if m.snapshots == nil {
-		m.snapshots = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.snapshots[ids[i]] = struct{}{}
-	}
+// ResetParentBuildID resets all changes to the "parent_build_id" field.
+func (m *CheckpointMutation) ResetParentBuildID() {
+	m.parent_build_id = nil
+	delete(m.clearedFields, checkpoint.FieldParentBuildID)
 }
 
-// ClearSnapshots clears the "snapshots" edge to the Snapshot entity.
-func (m *EnvMutation) ClearSnapshots() {
-	m.clearedsnapshots = true
+// SetBuildID sets the "build" edge to the EnvBuild entity by id.
+func (m *CheckpointMutation) SetBuildID(id uuid.UUID) {
+	m.build = &id
 }
 
-// SnapshotsCleared reports if the "snapshots" edge to the Snapshot entity was cleared.
-func (m *EnvMutation) SnapshotsCleared() bool {
-	return m.clearedsnapshots
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (m *CheckpointMutation) ClearBuild() {
+	m.clearedbuild = true
+	m.clearedFields[checkpoint.FieldEnvBuildID] = struct{}{}
 }
 
-// RemoveSnapshotIDs removes the "snapshots" edge to the Snapshot entity by IDs.
-func (m *EnvMutation) RemoveSnapshotIDs(ids ...uuid.UUID) {
-	if m.removedsnapshots == nil {
-		m.removedsnapshots = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.snapshots, ids[i])
-		m.removedsnapshots[ids[i]] = struct{}{}
-	}
+// BuildCleared reports if the "build" edge to the EnvBuild entity was cleared.
+func (m *CheckpointMutation) BuildCleared() bool {
+	return m.clearedbuild
 }
 
-// RemovedSnapshots returns the removed IDs of the "snapshots" edge to the Snapshot entity.
-func (m *EnvMutation) RemovedSnapshotsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsnapshots {
-		ids = append(ids, id)
+// BuildID returns the "build" edge ID in the mutation.
+func (m *CheckpointMutation) BuildID() (id uuid.UUID, exists bool) {
+	if m.build != nil {
+		return *m.build, true
 	}
 	return
 }
 
-// SnapshotsIDs returns the "snapshots" edge IDs in the mutation.
-func (m *EnvMutation) SnapshotsIDs() (ids []uuid.UUID) {
-	for id := range m.snapshots {
-		ids = append(ids, id)
+// BuildIDs returns the "build" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BuildID instead. It exists only for internal usage by the builders.
+func (m *CheckpointMutation) BuildIDs() (ids []uuid.UUID) {
+	if id := m.build; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSnapshots resets all changes to the "snapshots" edge.
-func (m *EnvMutation) ResetSnapshots() {
-	m.snapshots = nil
-	m.clearedsnapshots = false
-	m.removedsnapshots = nil
+// ResetBuild resets all changes to the "build" edge.
+func (m *CheckpointMutation) ResetBuild() {
+	m.build = nil
+	m.clearedbuild = false
 }
 
-// Where appends a list predicates to the EnvMutation builder.
-func (m *EnvMutation) Where(ps ...predicate.Env) {
+// Where appends a list predicates to the CheckpointMutation builder.
+func (m *CheckpointMutation) Where(ps ...predicate.Checkpoint) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CheckpointMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Env, len(ps))
+func (m *CheckpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Checkpoint, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2150,51 +2261,42 @@ func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvMutation) Op() Op {
+func (m *CheckpointMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvMutation) SetOp(op Op) {
+func (m *CheckpointMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Env).
-func (m *EnvMutation) Type() string {
+// Type returns the node type of this mutation (Checkpoint).
+func (m *CheckpointMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *CheckpointMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.created_at != nil {
-		fields = append(fields, env.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, env.FieldUpdatedAt)
-	}
-	if m.team != nil {
-		fields = append(fields, env.FieldTeamID)
-	}
-	if m.creator != nil {
-		fields = append(fields, env.FieldCreatedBy)
+		fields = append(fields, checkpoint.FieldCreatedAt)
 	}
-	if m.public != nil {
-		fields = append(fields, env.FieldPublic)
+	if m.sandbox_id != nil {
+		fields = append(fields, checkpoint.FieldSandboxID)
 	}
-	if m.build_count != nil {
-		fields = append(fields, env.FieldBuildCount)
+	if m.team_id != nil {
+		fields = append(fields, checkpoint.FieldTeamID)
 	}
-	if m.spawn_count != nil {
-		fields = append(fields, env.FieldSpawnCount)
+	if m.name != nil {
+		fields = append(fields, checkpoint.FieldName)
 	}
-	if m.last_spawned_at != nil {
-		fields = append(fields, env.FieldLastSpawnedAt)
+	if m.build != nil {
+		fields = append(fields, checkpoint.FieldEnvBuildID)
 	}
-	if m.cluster_id != nil {
-		fields = append(fields, env.FieldClusterID)
+	if m.parent_build_id != nil {
+		fields = append(fields, checkpoint.FieldParentBuildID)
 	}
 	return fields
 }
@@ -2202,26 +2304,20 @@ func (m *EnvMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvMutation) Field(name string) (ent.Value, bool) {
+func (m *CheckpointMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case env.FieldCreatedAt:
+	case checkpoint.FieldCreatedAt:
 		return m.CreatedAt()
-	case env.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case env.FieldTeamID:
+	case checkpoint.FieldSandboxID:
+		return m.SandboxID()
+	case checkpoint.FieldTeamID:
 		return m.TeamID()
-	case env.FieldCreatedBy:
-		return m.CreatedBy()
-	case env.FieldPublic:
-		return m.Public()
-	case env.FieldBuildCount:
-		return m.BuildCount()
-	case env.FieldSpawnCount:
-		return m.SpawnCount()
-	case env.FieldLastSpawnedAt:
-		return m.LastSpawnedAt()
-	case env.FieldClusterID:
-		return m.ClusterID()
+	case checkpoint.FieldName:
+		return m.Name()
+	case checkpoint.FieldEnvBuildID:
+		return m.EnvBuildID()
+	case checkpoint.FieldParentBuildID:
+		return m.ParentBuildID()
 	}
 	return nil, false
 }
@@ -2229,424 +2325,251 @@ func (m *EnvMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CheckpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case env.FieldCreatedAt:
+	case checkpoint.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case env.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case env.FieldTeamID:
+	case checkpoint.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case checkpoint.FieldTeamID:
 		return m.OldTeamID(ctx)
-	case env.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case env.FieldPublic:
-		return m.OldPublic(ctx)
-	case env.FieldBuildCount:
-		return m.OldBuildCount(ctx)
-	case env.FieldSpawnCount:
-		return m.OldSpawnCount(ctx)
-	case env.FieldLastSpawnedAt:
-		return m.OldLastSpawnedAt(ctx)
-	case env.FieldClusterID:
-		return m.OldClusterID(ctx)
+	case checkpoint.FieldName:
+		return m.OldName(ctx)
+	case checkpoint.FieldEnvBuildID:
+		return m.OldEnvBuildID(ctx)
+	case checkpoint.FieldParentBuildID:
+		return m.OldParentBuildID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Env field %s", name)
+	return nil, fmt.Errorf("unknown Checkpoint field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvMutation) SetField(name string, value ent.Value) error {
+func (m *CheckpointMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case env.FieldCreatedAt:
+	case checkpoint.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case env.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case checkpoint.FieldSandboxID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetSandboxID(v)
 		return nil
-	case env.FieldTeamID:
+	case checkpoint.FieldTeamID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTeamID(v)
 		return nil
-	case env.FieldCreatedBy:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case env.FieldPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPublic(v)
-		return nil
-	case env.FieldBuildCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBuildCount(v)
-		return nil
-	case env.FieldSpawnCount:
-		v, ok := value.(int64)
+	case checkpoint.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSpawnCount(v)
+		m.SetName(v)
 		return nil
-	case env.FieldLastSpawnedAt:
-		v, ok := value.(time.Time)
+	case checkpoint.FieldEnvBuildID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastSpawnedAt(v)
+		m.SetEnvBuildID(v)
 		return nil
-	case env.FieldClusterID:
+	case checkpoint.FieldParentBuildID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClusterID(v)
+		m.SetParentBuildID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Env field %s", name)
+	return fmt.Errorf("unknown Checkpoint field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvMutation) AddedFields() []string {
-	var fields []string
-	if m.addbuild_count != nil {
-		fields = append(fields, env.FieldBuildCount)
-	}
-	if m.addspawn_count != nil {
-		fields = append(fields, env.FieldSpawnCount)
-	}
-	return fields
+func (m *CheckpointMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case env.FieldBuildCount:
-		return m.AddedBuildCount()
-	case env.FieldSpawnCount:
-		return m.AddedSpawnCount()
-	}
+func (m *CheckpointMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvMutation) AddField(name string, value ent.Value) error {
+func (m *CheckpointMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case env.FieldBuildCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBuildCount(v)
-		return nil
-	case env.FieldSpawnCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSpawnCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Env numeric field %s", name)
+	return fmt.Errorf("unknown Checkpoint numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvMutation) ClearedFields() []string {
+func (m *CheckpointMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(env.FieldCreatedBy) {
-		fields = append(fields, env.FieldCreatedBy)
-	}
-	if m.FieldCleared(env.FieldLastSpawnedAt) {
-		fields = append(fields, env.FieldLastSpawnedAt)
-	}
-	if m.FieldCleared(env.FieldClusterID) {
-		fields = append(fields, env.FieldClusterID)
+	if m.FieldCleared(checkpoint.FieldParentBuildID) {
+		fields = append(fields, checkpoint.FieldParentBuildID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvMutation) FieldCleared(name string) bool {
+func (m *CheckpointMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvMutation) ClearField(name string) error {
+func (m *CheckpointMutation) ClearField(name string) error {
 	switch name {
-	case env.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case env.FieldLastSpawnedAt:
-		m.ClearLastSpawnedAt()
-		return nil
-	case env.FieldClusterID:
-		m.ClearClusterID()
+	case checkpoint.FieldParentBuildID:
+		m.ClearParentBuildID()
 		return nil
 	}
-	return fmt.Errorf("unknown Env nullable field %s", name)
+	return fmt.Errorf("unknown Checkpoint nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvMutation) ResetField(name string) error {
+func (m *CheckpointMutation) ResetField(name string) error {
 	switch name {
-	case env.FieldCreatedAt:
+	case checkpoint.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case env.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case checkpoint.FieldSandboxID:
+		m.ResetSandboxID()
 		return nil
-	case env.FieldTeamID:
+	case checkpoint.FieldTeamID:
 		m.ResetTeamID()
 		return nil
-	case env.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case env.FieldPublic:
-		m.ResetPublic()
-		return nil
-	case env.FieldBuildCount:
-		m.ResetBuildCount()
-		return nil
-	case env.FieldSpawnCount:
-		m.ResetSpawnCount()
+	case checkpoint.FieldName:
+		m.ResetName()
 		return nil
-	case env.FieldLastSpawnedAt:
-		m.ResetLastSpawnedAt()
+	case checkpoint.FieldEnvBuildID:
+		m.ResetEnvBuildID()
 		return nil
-	case env.FieldClusterID:
-		m.ResetClusterID()
+	case checkpoint.FieldParentBuildID:
+		m.ResetParentBuildID()
 		return nil
 	}
-	return fmt.Errorf("unknown Env field %s", name)
+	return fmt.Errorf("unknown Checkpoint field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.team != nil {
-		edges = append(edges, env.EdgeTeam)
-	}
-	if m.creator != nil {
-		edges = append(edges, env.EdgeCreator)
-	}
-	if m.env_aliases != nil {
-		edges = append(edges, env.EdgeEnvAliases)
-	}
-	if m.builds != nil {
-		edges = append(edges, env.EdgeBuilds)
-	}
-	if m.snapshots != nil {
-		edges = append(edges, env.EdgeSnapshots)
+func (m *CheckpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.build != nil {
+		edges = append(edges, checkpoint.EdgeBuild)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvMutation) AddedIDs(name string) []ent.Value {
+func (m *CheckpointMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case env.EdgeTeam:
-		if id := m.team; id != nil {
-			return []ent.Value{*id}
-		}
-	case env.EdgeCreator:
-		if id := m.creator; id != nil {
+	case checkpoint.EdgeBuild:
+		if id := m.build; id != nil {
 			return []ent.Value{*id}
 		}
-	case env.EdgeEnvAliases:
-		ids := make([]ent.Value, 0, len(m.env_aliases))
-		for id := range m.env_aliases {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeBuilds:
-		ids := make([]ent.Value, 0, len(m.builds))
-		for id := range m.builds {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeSnapshots:
-		ids := make([]ent.Value, 0, len(m.snapshots))
-		for id := range m.snapshots {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedenv_aliases != nil {
-		edges = append(edges, env.EdgeEnvAliases)
-	}
-	if m.removedbuilds != nil {
-		edges = append(edges, env.EdgeBuilds)
-	}
-	if m.removedsnapshots != nil {
-		edges = append(edges, env.EdgeSnapshots)
-	}
+func (m *CheckpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case env.EdgeEnvAliases:
-		ids := make([]ent.Value, 0, len(m.removedenv_aliases))
-		for id := range m.removedenv_aliases {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeBuilds:
-		ids := make([]ent.Value, 0, len(m.removedbuilds))
-		for id := range m.removedbuilds {
-			ids = append(ids, id)
-		}
-		return ids
-	case env.EdgeSnapshots:
-		ids := make([]ent.Value, 0, len(m.removedsnapshots))
-		for id := range m.removedsnapshots {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CheckpointMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedteam {
-		edges = append(edges, env.EdgeTeam)
-	}
-	if m.clearedcreator {
-		edges = append(edges, env.EdgeCreator)
-	}
-	if m.clearedenv_aliases {
-		edges = append(edges, env.EdgeEnvAliases)
-	}
-	if m.clearedbuilds {
-		edges = append(edges, env.EdgeBuilds)
-	}
-	if m.clearedsnapshots {
-		edges = append(edges, env.EdgeSnapshots)
+func (m *CheckpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbuild {
+		edges = append(edges, checkpoint.EdgeBuild)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvMutation) EdgeCleared(name string) bool {
+func (m *CheckpointMutation) EdgeCleared(name string) bool {
 	switch name {
-	case env.EdgeTeam:
-		return m.clearedteam
-	case env.EdgeCreator:
-		return m.clearedcreator
-	case env.EdgeEnvAliases:
-		return m.clearedenv_aliases
-	case env.EdgeBuilds:
-		return m.clearedbuilds
-	case env.EdgeSnapshots:
-		return m.clearedsnapshots
+	case checkpoint.EdgeBuild:
+		return m.clearedbuild
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvMutation) ClearEdge(name string) error {
+func (m *CheckpointMutation) ClearEdge(name string) error {
 	switch name {
-	case env.EdgeTeam:
-		m.ClearTeam()
-		return nil
-	case env.EdgeCreator:
-		m.ClearCreator()
+	case checkpoint.EdgeBuild:
+		m.ClearBuild()
 		return nil
 	}
-	return fmt.Errorf("unknown Env unique edge %s", name)
+	return fmt.Errorf("unknown Checkpoint unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvMutation) ResetEdge(name string) error {
+func (m *CheckpointMutation) ResetEdge(name string) error {
 	switch name {
-	case env.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case env.EdgeCreator:
-		m.ResetCreator()
-		return nil
-	case env.EdgeEnvAliases:
-		m.ResetEnvAliases()
-		return nil
-	case env.EdgeBuilds:
-		m.ResetBuilds()
-		return nil
-	case env.EdgeSnapshots:
-		m.ResetSnapshots()
+	case checkpoint.EdgeBuild:
+		m.ResetBuild()
 		return nil
 	}
-	return fmt.Errorf("unknown Env edge %s", name)
+	return fmt.Errorf("unknown Checkpoint edge %s", name)
 }
 
-// EnvAliasMutation represents an operation that mutates the EnvAlias nodes in the graph.
-type EnvAliasMutation struct {
+// ClusterMutation represents an operation that mutates the Cluster nodes in the graph.
+type ClusterMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *string
-	is_renamable  *bool
+	id            *uuid.UUID
+	endpoint      *string
+	endpoint_tls  *bool
+	token         *string
 	clearedFields map[string]struct{}
-	env           *string
-	clearedenv    bool
 	done          bool
-	oldValue      func(context.Context) (*EnvAlias, error)
-	predicates    []predicate.EnvAlias
+	oldValue      func(context.Context) (*Cluster, error)
+	predicates    []predicate.Cluster
 }
 
-var _ ent.Mutation = (*EnvAliasMutation)(nil)
+var _ ent.Mutation = (*ClusterMutation)(nil)
 
-// envaliasOption allows management of the mutation configuration using functional options.
-type envaliasOption func(*EnvAliasMutation)
+// clusterOption allows management of the mutation configuration using functional options.
+type clusterOption func(*ClusterMutation)
 
-// newEnvAliasMutation creates new mutation for the EnvAlias entity.
-func newEnvAliasMutation(c config, op Op, opts ...envaliasOption) *EnvAliasMutation {
-	m := &EnvAliasMutation{
+// newClusterMutation creates new mutation for the Cluster entity.
+func newClusterMutation(c config, op Op, opts ...clusterOption) *ClusterMutation {
+	m := &ClusterMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnvAlias,
+		typ:           TypeCluster,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2655,20 +2578,20 @@ func newEnvAliasMutation(c config, op Op, opts ...envaliasOption) *EnvAliasMutat
 	return m
 }
 
-// withEnvAliasID sets the ID field of the mutation.
-func withEnvAliasID(id string) envaliasOption {
-	return func(m *EnvAliasMutation) {
+// withClusterID sets the ID field of the mutation.
+func withClusterID(id uuid.UUID) clusterOption {
+	return func(m *ClusterMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EnvAlias
+			value *Cluster
 		)
-		m.oldValue = func(ctx context.Context) (*EnvAlias, error) {
+		m.oldValue = func(ctx context.Context) (*Cluster, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EnvAlias.Get(ctx, id)
+					value, err = m.Client().Cluster.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2677,10 +2600,10 @@ func withEnvAliasID(id string) envaliasOption {
 	}
 }
 
-// withEnvAlias sets the old EnvAlias of the mutation.
-func withEnvAlias(node *EnvAlias) envaliasOption {
-	return func(m *EnvAliasMutation) {
-		m.oldValue = func(context.Context) (*EnvAlias, error) {
+// withCluster sets the old Cluster of the mutation.
+func withCluster(node *Cluster) clusterOption {
+	return func(m *ClusterMutation) {
+		m.oldValue = func(context.Context) (*Cluster, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2689,7 +2612,7 @@ func withEnvAlias(node *EnvAlias) envaliasOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvAliasMutation) Client() *Client {
+func (m ClusterMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2697,7 +2620,7 @@ func (m EnvAliasMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvAliasMutation) Tx() (*Tx, error) {
+func (m ClusterMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -2707,14 +2630,14 @@ func (m EnvAliasMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EnvAlias entities.
-func (m *EnvAliasMutation) SetID(id string) {
+// operation is only accepted on creation of Cluster entities.
+func (m *ClusterMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvAliasMutation) ID() (id string, exists bool) {
+func (m *ClusterMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2725,129 +2648,138 @@ func (m *EnvAliasMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvAliasMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ClusterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EnvAlias.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Cluster.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *EnvAliasMutation) SetEnvID(s string) {
-	m.env = &s
+// SetEndpoint sets the "endpoint" field.
+func (m *ClusterMutation) SetEndpoint(s string) {
+	m.endpoint = &s
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *EnvAliasMutation) EnvID() (r string, exists bool) {
-	v := m.env
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *ClusterMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvID returns the old "env_id" field's value of the EnvAlias entity.
-// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpoint returns the old "endpoint" field's value of the Cluster entity.
+// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvAliasMutation) OldEnvID(ctx context.Context) (v string, err error) {
+func (m *ClusterMutation) OldEndpoint(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
 	}
-	return oldValue.EnvID, nil
+	return oldValue.Endpoint, nil
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *EnvAliasMutation) ResetEnvID() {
-	m.env = nil
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *ClusterMutation) ResetEndpoint() {
+	m.endpoint = nil
 }
 
-// SetIsRenamable sets the "is_renamable" field.
-func (m *EnvAliasMutation) SetIsRenamable(b bool) {
-	m.is_renamable = &b
+// SetEndpointTLS sets the "endpoint_tls" field.
+func (m *ClusterMutation) SetEndpointTLS(b bool) {
+	m.endpoint_tls = &b
 }
 
-// IsRenamable returns the value of the "is_renamable" field in the mutation.
-func (m *EnvAliasMutation) IsRenamable() (r bool, exists bool) {
-	v := m.is_renamable
+// EndpointTLS returns the value of the "endpoint_tls" field in the mutation.
+func (m *ClusterMutation) EndpointTLS() (r bool, exists bool) {
+	v := m.endpoint_tls
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsRenamable returns the old "is_renamable" field's value of the EnvAlias entity.
-// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpointTLS returns the old "endpoint_tls" field's value of the Cluster entity.
+// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvAliasMutation) OldIsRenamable(ctx context.Context) (v bool, err error) {
+func (m *ClusterMutation) OldEndpointTLS(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRenamable is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpointTLS is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRenamable requires an ID field in the mutation")
+		return v, errors.New("OldEndpointTLS requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRenamable: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpointTLS: %w", err)
 	}
-	return oldValue.IsRenamable, nil
+	return oldValue.EndpointTLS, nil
 }
 
-// ResetIsRenamable resets all changes to the "is_renamable" field.
-func (m *EnvAliasMutation) ResetIsRenamable() {
-	m.is_renamable = nil
+// ResetEndpointTLS resets all changes to the "endpoint_tls" field.
+func (m *ClusterMutation) ResetEndpointTLS() {
+	m.endpoint_tls = nil
 }
 
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *EnvAliasMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[envalias.FieldEnvID] = struct{}{}
+// SetToken sets the "token" field.
+func (m *ClusterMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *EnvAliasMutation) EnvCleared() bool {
-	return m.clearedenv
+// Token returns the value of the "token" field in the mutation.
+func (m *ClusterMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *EnvAliasMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
+// OldToken returns the old "token" field's value of the Cluster entity.
+// If the Cluster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClusterMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *EnvAliasMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
+// ResetToken resets all changes to the "token" field.
+func (m *ClusterMutation) ResetToken() {
+	m.token = nil
 }
 
-// Where appends a list predicates to the EnvAliasMutation builder.
-func (m *EnvAliasMutation) Where(ps ...predicate.EnvAlias) {
+// Where appends a list predicates to the ClusterMutation builder.
+func (m *ClusterMutation) Where(ps ...predicate.Cluster) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvAliasMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ClusterMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvAliasMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EnvAlias, len(ps))
+func (m *ClusterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Cluster, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2855,30 +2787,33 @@ func (m *EnvAliasMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvAliasMutation) Op() Op {
+func (m *ClusterMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvAliasMutation) SetOp(op Op) {
+func (m *ClusterMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EnvAlias).
-func (m *EnvAliasMutation) Type() string {
+// Type returns the node type of this mutation (Cluster).
+func (m *ClusterMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvAliasMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.env != nil {
-		fields = append(fields, envalias.FieldEnvID)
+func (m *ClusterMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.endpoint != nil {
+		fields = append(fields, cluster.FieldEndpoint)
 	}
-	if m.is_renamable != nil {
-		fields = append(fields, envalias.FieldIsRenamable)
+	if m.endpoint_tls != nil {
+		fields = append(fields, cluster.FieldEndpointTLS)
+	}
+	if m.token != nil {
+		fields = append(fields, cluster.FieldToken)
 	}
 	return fields
 }
@@ -2886,12 +2821,14 @@ func (m *EnvAliasMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvAliasMutation) Field(name string) (ent.Value, bool) {
+func (m *ClusterMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case envalias.FieldEnvID:
-		return m.EnvID()
-	case envalias.FieldIsRenamable:
-		return m.IsRenamable()
+	case cluster.FieldEndpoint:
+		return m.Endpoint()
+	case cluster.FieldEndpointTLS:
+		return m.EndpointTLS()
+	case cluster.FieldToken:
+		return m.Token()
 	}
 	return nil, false
 }
@@ -2899,212 +2836,207 @@ func (m *EnvAliasMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ClusterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case envalias.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case envalias.FieldIsRenamable:
-		return m.OldIsRenamable(ctx)
+	case cluster.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case cluster.FieldEndpointTLS:
+		return m.OldEndpointTLS(ctx)
+	case cluster.FieldToken:
+		return m.OldToken(ctx)
 	}
-	return nil, fmt.Errorf("unknown EnvAlias field %s", name)
+	return nil, fmt.Errorf("unknown Cluster field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvAliasMutation) SetField(name string, value ent.Value) error {
+func (m *ClusterMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case envalias.FieldEnvID:
+	case cluster.FieldEndpoint:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvID(v)
+		m.SetEndpoint(v)
 		return nil
-	case envalias.FieldIsRenamable:
+	case cluster.FieldEndpointTLS:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsRenamable(v)
+		m.SetEndpointTLS(v)
+		return nil
+	case cluster.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias field %s", name)
+	return fmt.Errorf("unknown Cluster field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvAliasMutation) AddedFields() []string {
+func (m *ClusterMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvAliasMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ClusterMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvAliasMutation) AddField(name string, value ent.Value) error {
+func (m *ClusterMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown EnvAlias numeric field %s", name)
+	return fmt.Errorf("unknown Cluster numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvAliasMutation) ClearedFields() []string {
+func (m *ClusterMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvAliasMutation) FieldCleared(name string) bool {
+func (m *ClusterMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvAliasMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown EnvAlias nullable field %s", name)
+func (m *ClusterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Cluster nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvAliasMutation) ResetField(name string) error {
+func (m *ClusterMutation) ResetField(name string) error {
 	switch name {
-	case envalias.FieldEnvID:
-		m.ResetEnvID()
+	case cluster.FieldEndpoint:
+		m.ResetEndpoint()
 		return nil
-	case envalias.FieldIsRenamable:
-		m.ResetIsRenamable()
+	case cluster.FieldEndpointTLS:
+		m.ResetEndpointTLS()
+		return nil
+	case cluster.FieldToken:
+		m.ResetToken()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvAlias field %s", name)
+	return fmt.Errorf("unknown Cluster field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvAliasMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.env != nil {
-		edges = append(edges, envalias.EdgeEnv)
-	}
+func (m *ClusterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvAliasMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case envalias.EdgeEnv:
-		if id := m.env; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ClusterMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvAliasMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ClusterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvAliasMutation) RemovedIDs(name string) []ent.Value {
+func (m *ClusterMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvAliasMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenv {
-		edges = append(edges, envalias.EdgeEnv)
-	}
+func (m *ClusterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvAliasMutation) EdgeCleared(name string) bool {
-	switch name {
-	case envalias.EdgeEnv:
-		return m.clearedenv
-	}
+func (m *ClusterMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvAliasMutation) ClearEdge(name string) error {
-	switch name {
-	case envalias.EdgeEnv:
-		m.ClearEnv()
-		return nil
-	}
-	return fmt.Errorf("unknown EnvAlias unique edge %s", name)
+func (m *ClusterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Cluster unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvAliasMutation) ResetEdge(name string) error {
-	switch name {
-	case envalias.EdgeEnv:
-		m.ResetEnv()
-		return nil
-	}
-	return fmt.Errorf("unknown EnvAlias edge %s", name)
+func (m *ClusterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Cluster edge %s", name)
 }
 
-// EnvBuildMutation represents an operation that mutates the EnvBuild nodes in the graph.
-type EnvBuildMutation struct {
+// EnvMutation represents an operation that mutates the Env nodes in the graph.
+type EnvMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	created_at            *time.Time
-	updated_at            *time.Time
-	finished_at           *time.Time
-	status                *envbuild.Status
-	dockerfile            *string
-	start_cmd             *string
-	ready_cmd             *string
-	vcpu                  *int64
-	addvcpu               *int64
-	ram_mb                *int64
-	addram_mb             *int64
-	free_disk_size_mb     *int64
-	addfree_disk_size_mb  *int64
-	total_disk_size_mb    *int64
-	addtotal_disk_size_mb *int64
-	kernel_version        *string
-	firecracker_version   *string
-	envd_version          *string
-	cluster_node_id       *string
-	clearedFields         map[string]struct{}
-	env                   *string
-	clearedenv            bool
-	done                  bool
-	oldValue              func(context.Context) (*EnvBuild, error)
-	predicates            []predicate.EnvBuild
+	op                 Op
+	typ                string
+	id                 *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	public             *bool
+	build_count        *int32
+	addbuild_count     *int32
+	spawn_count        *int64
+	addspawn_count     *int64
+	last_spawned_at    *time.Time
+	cluster_id         *uuid.UUID
+	source_env_id      *string
+	source_sandbox_id  *string
+	clearedFields      map[string]struct{}
+	team               *uuid.UUID
+	clearedteam        bool
+	creator            *uuid.UUID
+	clearedcreator     bool
+	env_aliases        map[string]struct{}
+	removedenv_aliases map[string]struct{}
+	clearedenv_aliases bool
+	builds             map[uuid.UUID]struct{}
+	removedbuilds      map[uuid.UUID]struct{}
+	clearedbuilds      bool
+	snapshots          map[uuid.UUID]struct{}
+	removedsnapshots   map[uuid.UUID]struct{}
+	clearedsnapshots   bool
+	warm_pools         map[uuid.UUID]struct{}
+	removedwarm_pools  map[uuid.UUID]struct{}
+	clearedwarm_pools  bool
+	build_tags         map[uuid.UUID]struct{}
+	removedbuild_tags  map[uuid.UUID]struct{}
+	clearedbuild_tags  bool
+	done               bool
+	oldValue           func(context.Context) (*Env, error)
+	predicates         []predicate.Env
 }
 
-var _ ent.Mutation = (*EnvBuildMutation)(nil)
+var _ ent.Mutation = (*EnvMutation)(nil)
 
-// envbuildOption allows management of the mutation configuration using functional options.
-type envbuildOption func(*EnvBuildMutation)
+// envOption allows management of the mutation configuration using functional options.
+type envOption func(*EnvMutation)
 
-// newEnvBuildMutation creates new mutation for the EnvBuild entity.
-func newEnvBuildMutation(c config, op Op, opts ...envbuildOption) *EnvBuildMutation {
-	m := &EnvBuildMutation{
+// newEnvMutation creates new mutation for the Env entity.
+func newEnvMutation(c config, op Op, opts ...envOption) *EnvMutation {
+	m := &EnvMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnvBuild,
+		typ:           TypeEnv,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3113,20 +3045,20 @@ func newEnvBuildMutation(c config, op Op, opts ...envbuildOption) *EnvBuildMutat
 	return m
 }
 
-// withEnvBuildID sets the ID field of the mutation.
-func withEnvBuildID(id uuid.UUID) envbuildOption {
-	return func(m *EnvBuildMutation) {
+// withEnvID sets the ID field of the mutation.
+func withEnvID(id string) envOption {
+	return func(m *EnvMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EnvBuild
+			value *Env
 		)
-		m.oldValue = func(ctx context.Context) (*EnvBuild, error) {
+		m.oldValue = func(ctx context.Context) (*Env, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EnvBuild.Get(ctx, id)
+					value, err = m.Client().Env.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3135,10 +3067,10 @@ func withEnvBuildID(id uuid.UUID) envbuildOption {
 	}
 }
 
-// withEnvBuild sets the old EnvBuild of the mutation.
-func withEnvBuild(node *EnvBuild) envbuildOption {
-	return func(m *EnvBuildMutation) {
-		m.oldValue = func(context.Context) (*EnvBuild, error) {
+// withEnv sets the old Env of the mutation.
+func withEnv(node *Env) envOption {
+	return func(m *EnvMutation) {
+		m.oldValue = func(context.Context) (*Env, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3147,7 +3079,7 @@ func withEnvBuild(node *EnvBuild) envbuildOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvBuildMutation) Client() *Client {
+func (m EnvMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3155,7 +3087,7 @@ func (m EnvBuildMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvBuildMutation) Tx() (*Tx, error) {
+func (m EnvMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -3165,14 +3097,14 @@ func (m EnvBuildMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EnvBuild entities.
-func (m *EnvBuildMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Env entities.
+func (m *EnvMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvBuildMutation) ID() (id uuid.UUID, exists bool) {
+func (m *EnvMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3183,28 +3115,28 @@ func (m *EnvBuildMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvBuildMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *EnvMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EnvBuild.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Env.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EnvBuildMutation) SetCreatedAt(t time.Time) {
+func (m *EnvMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvBuildMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *EnvMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3212,10 +3144,10 @@ func (m *EnvBuildMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3230,17 +3162,17 @@ func (m *EnvBuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvBuildMutation) ResetCreatedAt() {
+func (m *EnvMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *EnvBuildMutation) SetUpdatedAt(t time.Time) {
+func (m *EnvMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvBuildMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *EnvMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3248,10 +3180,10 @@ func (m *EnvBuildMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3266,735 +3198,785 @@ func (m *EnvBuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvBuildMutation) ResetUpdatedAt() {
+func (m *EnvMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetFinishedAt sets the "finished_at" field.
-func (m *EnvBuildMutation) SetFinishedAt(t time.Time) {
-	m.finished_at = &t
+// SetTeamID sets the "team_id" field.
+func (m *EnvMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
-// FinishedAt returns the value of the "finished_at" field in the mutation.
-func (m *EnvBuildMutation) FinishedAt() (r time.Time, exists bool) {
-	v := m.finished_at
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *EnvMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFinishedAt returns the old "finished_at" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *EnvMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.FinishedAt, nil
-}
-
-// ClearFinishedAt clears the value of the "finished_at" field.
-func (m *EnvBuildMutation) ClearFinishedAt() {
-	m.finished_at = nil
-	m.clearedFields[envbuild.FieldFinishedAt] = struct{}{}
-}
-
-// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
-func (m *EnvBuildMutation) FinishedAtCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldFinishedAt]
-	return ok
+	return oldValue.TeamID, nil
 }
 
-// ResetFinishedAt resets all changes to the "finished_at" field.
-func (m *EnvBuildMutation) ResetFinishedAt() {
-	m.finished_at = nil
-	delete(m.clearedFields, envbuild.FieldFinishedAt)
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *EnvMutation) ResetTeamID() {
+	m.team = nil
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *EnvBuildMutation) SetEnvID(s string) {
-	m.env = &s
+// SetCreatedBy sets the "created_by" field.
+func (m *EnvMutation) SetCreatedBy(u uuid.UUID) {
+	m.creator = &u
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *EnvBuildMutation) EnvID() (r string, exists bool) {
-	v := m.env
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *EnvMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.creator
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvID returns the old "env_id" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldEnvID(ctx context.Context) (v *string, err error) {
+func (m *EnvMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.EnvID, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ClearEnvID clears the value of the "env_id" field.
-func (m *EnvBuildMutation) ClearEnvID() {
-	m.env = nil
-	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *EnvMutation) ClearCreatedBy() {
+	m.creator = nil
+	m.clearedFields[env.FieldCreatedBy] = struct{}{}
 }
 
-// EnvIDCleared returns if the "env_id" field was cleared in this mutation.
-func (m *EnvBuildMutation) EnvIDCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldEnvID]
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *EnvMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[env.FieldCreatedBy]
 	return ok
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *EnvBuildMutation) ResetEnvID() {
-	m.env = nil
-	delete(m.clearedFields, envbuild.FieldEnvID)
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *EnvMutation) ResetCreatedBy() {
+	m.creator = nil
+	delete(m.clearedFields, env.FieldCreatedBy)
 }
 
-// SetStatus sets the "status" field.
-func (m *EnvBuildMutation) SetStatus(e envbuild.Status) {
-	m.status = &e
+// SetPublic sets the "public" field.
+func (m *EnvMutation) SetPublic(b bool) {
+	m.public = &b
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *EnvBuildMutation) Status() (r envbuild.Status, exists bool) {
-	v := m.status
+// Public returns the value of the "public" field in the mutation.
+func (m *EnvMutation) Public() (r bool, exists bool) {
+	v := m.public
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldPublic returns the old "public" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldStatus(ctx context.Context) (v envbuild.Status, err error) {
+func (m *EnvMutation) OldPublic(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublic is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldPublic requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublic: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Public, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *EnvBuildMutation) ResetStatus() {
-	m.status = nil
+// ResetPublic resets all changes to the "public" field.
+func (m *EnvMutation) ResetPublic() {
+	m.public = nil
 }
 
-// SetDockerfile sets the "dockerfile" field.
-func (m *EnvBuildMutation) SetDockerfile(s string) {
-	m.dockerfile = &s
+// SetBuildCount sets the "build_count" field.
+func (m *EnvMutation) SetBuildCount(i int32) {
+	m.build_count = &i
+	m.addbuild_count = nil
 }
 
-// Dockerfile returns the value of the "dockerfile" field in the mutation.
-func (m *EnvBuildMutation) Dockerfile() (r string, exists bool) {
-	v := m.dockerfile
+// BuildCount returns the value of the "build_count" field in the mutation.
+func (m *EnvMutation) BuildCount() (r int32, exists bool) {
+	v := m.build_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDockerfile returns the old "dockerfile" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldBuildCount returns the old "build_count" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldDockerfile(ctx context.Context) (v *string, err error) {
+func (m *EnvMutation) OldBuildCount(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDockerfile is only allowed on UpdateOne operations")
+		return v, errors.New("OldBuildCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDockerfile requires an ID field in the mutation")
+		return v, errors.New("OldBuildCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDockerfile: %w", err)
+		return v, fmt.Errorf("querying old value for OldBuildCount: %w", err)
 	}
-	return oldValue.Dockerfile, nil
+	return oldValue.BuildCount, nil
 }
 
-// ClearDockerfile clears the value of the "dockerfile" field.
-func (m *EnvBuildMutation) ClearDockerfile() {
-	m.dockerfile = nil
-	m.clearedFields[envbuild.FieldDockerfile] = struct{}{}
+// AddBuildCount adds i to the "build_count" field.
+func (m *EnvMutation) AddBuildCount(i int32) {
+	if m.addbuild_count != nil {
+		*m.addbuild_count += i
+	} else {
+		m.addbuild_count = &i
+	}
 }
 
-// DockerfileCleared returns if the "dockerfile" field was cleared in this mutation.
-func (m *EnvBuildMutation) DockerfileCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldDockerfile]
-	return ok
+// AddedBuildCount returns the value that was added to the "build_count" field in this mutation.
+func (m *EnvMutation) AddedBuildCount() (r int32, exists bool) {
+	v := m.addbuild_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDockerfile resets all changes to the "dockerfile" field.
-func (m *EnvBuildMutation) ResetDockerfile() {
-	m.dockerfile = nil
-	delete(m.clearedFields, envbuild.FieldDockerfile)
+// ResetBuildCount resets all changes to the "build_count" field.
+func (m *EnvMutation) ResetBuildCount() {
+	m.build_count = nil
+	m.addbuild_count = nil
 }
 
-// SetStartCmd sets the "start_cmd" field.
-func (m *EnvBuildMutation) SetStartCmd(s string) {
-	m.start_cmd = &s
+// SetSpawnCount sets the "spawn_count" field.
+func (m *EnvMutation) SetSpawnCount(i int64) {
+	m.spawn_count = &i
+	m.addspawn_count = nil
 }
 
-// StartCmd returns the value of the "start_cmd" field in the mutation.
-func (m *EnvBuildMutation) StartCmd() (r string, exists bool) {
-	v := m.start_cmd
+// SpawnCount returns the value of the "spawn_count" field in the mutation.
+func (m *EnvMutation) SpawnCount() (r int64, exists bool) {
+	v := m.spawn_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartCmd returns the old "start_cmd" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldSpawnCount returns the old "spawn_count" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldStartCmd(ctx context.Context) (v *string, err error) {
+func (m *EnvMutation) OldSpawnCount(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartCmd is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpawnCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartCmd requires an ID field in the mutation")
+		return v, errors.New("OldSpawnCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartCmd: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpawnCount: %w", err)
 	}
-	return oldValue.StartCmd, nil
+	return oldValue.SpawnCount, nil
 }
 
-// ClearStartCmd clears the value of the "start_cmd" field.
-func (m *EnvBuildMutation) ClearStartCmd() {
-	m.start_cmd = nil
-	m.clearedFields[envbuild.FieldStartCmd] = struct{}{}
+// AddSpawnCount adds i to the "spawn_count" field.
+func (m *EnvMutation) AddSpawnCount(i int64) {
+	if m.addspawn_count != nil {
+		*m.addspawn_count += i
+	} else {
+		m.addspawn_count = &i
+	}
 }
 
-// StartCmdCleared returns if the "start_cmd" field was cleared in this mutation.
-func (m *EnvBuildMutation) StartCmdCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldStartCmd]
-	return ok
+// AddedSpawnCount returns the value that was added to the "spawn_count" field in this mutation.
+func (m *EnvMutation) AddedSpawnCount() (r int64, exists bool) {
+	v := m.addspawn_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetStartCmd resets all changes to the "start_cmd" field.
-func (m *EnvBuildMutation) ResetStartCmd() {
-	m.start_cmd = nil
-	delete(m.clearedFields, envbuild.FieldStartCmd)
+// ResetSpawnCount resets all changes to the "spawn_count" field.
+func (m *EnvMutation) ResetSpawnCount() {
+	m.spawn_count = nil
+	m.addspawn_count = nil
 }
 
-// SetReadyCmd sets the "ready_cmd" field.
-func (m *EnvBuildMutation) SetReadyCmd(s string) {
-	m.ready_cmd = &s
+// SetLastSpawnedAt sets the "last_spawned_at" field.
+func (m *EnvMutation) SetLastSpawnedAt(t time.Time) {
+	m.last_spawned_at = &t
 }
 
-// ReadyCmd returns the value of the "ready_cmd" field in the mutation.
-func (m *EnvBuildMutation) ReadyCmd() (r string, exists bool) {
-	v := m.ready_cmd
+// LastSpawnedAt returns the value of the "last_spawned_at" field in the mutation.
+func (m *EnvMutation) LastSpawnedAt() (r time.Time, exists bool) {
+	v := m.last_spawned_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReadyCmd returns the old "ready_cmd" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldLastSpawnedAt returns the old "last_spawned_at" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldReadyCmd(ctx context.Context) (v *string, err error) {
+func (m *EnvMutation) OldLastSpawnedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReadyCmd is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastSpawnedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReadyCmd requires an ID field in the mutation")
+		return v, errors.New("OldLastSpawnedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReadyCmd: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastSpawnedAt: %w", err)
 	}
-	return oldValue.ReadyCmd, nil
+	return oldValue.LastSpawnedAt, nil
 }
 
-// ClearReadyCmd clears the value of the "ready_cmd" field.
-func (m *EnvBuildMutation) ClearReadyCmd() {
-	m.ready_cmd = nil
-	m.clearedFields[envbuild.FieldReadyCmd] = struct{}{}
+// ClearLastSpawnedAt clears the value of the "last_spawned_at" field.
+func (m *EnvMutation) ClearLastSpawnedAt() {
+	m.last_spawned_at = nil
+	m.clearedFields[env.FieldLastSpawnedAt] = struct{}{}
 }
 
-// ReadyCmdCleared returns if the "ready_cmd" field was cleared in this mutation.
-func (m *EnvBuildMutation) ReadyCmdCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldReadyCmd]
+// LastSpawnedAtCleared returns if the "last_spawned_at" field was cleared in this mutation.
+func (m *EnvMutation) LastSpawnedAtCleared() bool {
+	_, ok := m.clearedFields[env.FieldLastSpawnedAt]
 	return ok
 }
 
-// ResetReadyCmd resets all changes to the "ready_cmd" field.
-func (m *EnvBuildMutation) ResetReadyCmd() {
-	m.ready_cmd = nil
-	delete(m.clearedFields, envbuild.FieldReadyCmd)
+// ResetLastSpawnedAt resets all changes to the "last_spawned_at" field.
+func (m *EnvMutation) ResetLastSpawnedAt() {
+	m.last_spawned_at = nil
+	delete(m.clearedFields, env.FieldLastSpawnedAt)
 }
 
-// SetVcpu sets the "vcpu" field.
-func (m *EnvBuildMutation) SetVcpu(i int64) {
-	m.vcpu = &i
-	m.addvcpu = nil
+// SetClusterID sets the "cluster_id" field.
+func (m *EnvMutation) SetClusterID(u uuid.UUID) {
+	m.cluster_id = &u
 }
 
-// Vcpu returns the value of the "vcpu" field in the mutation.
-func (m *EnvBuildMutation) Vcpu() (r int64, exists bool) {
-	v := m.vcpu
+// ClusterID returns the value of the "cluster_id" field in the mutation.
+func (m *EnvMutation) ClusterID() (r uuid.UUID, exists bool) {
+	v := m.cluster_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVcpu returns the old "vcpu" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldClusterID returns the old "cluster_id" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldVcpu(ctx context.Context) (v int64, err error) {
+func (m *EnvMutation) OldClusterID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVcpu is only allowed on UpdateOne operations")
+		return v, errors.New("OldClusterID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVcpu requires an ID field in the mutation")
+		return v, errors.New("OldClusterID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVcpu: %w", err)
+		return v, fmt.Errorf("querying old value for OldClusterID: %w", err)
 	}
-	return oldValue.Vcpu, nil
+	return oldValue.ClusterID, nil
 }
 
-// AddVcpu adds i to the "vcpu" field.
-func (m *EnvBuildMutation) AddVcpu(i int64) {
-	if m.addvcpu != nil {
-		*m.addvcpu += i
-	} else {
-		m.addvcpu = &i
-	}
+// ClearClusterID clears the value of the "cluster_id" field.
+func (m *EnvMutation) ClearClusterID() {
+	m.cluster_id = nil
+	m.clearedFields[env.FieldClusterID] = struct{}{}
 }
 
-// AddedVcpu returns the value that was added to the "vcpu" field in this mutation.
-func (m *EnvBuildMutation) AddedVcpu() (r int64, exists bool) {
-	v := m.addvcpu
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClusterIDCleared returns if the "cluster_id" field was cleared in this mutation.
+func (m *EnvMutation) ClusterIDCleared() bool {
+	_, ok := m.clearedFields[env.FieldClusterID]
+	return ok
 }
 
-// ResetVcpu resets all changes to the "vcpu" field.
-func (m *EnvBuildMutation) ResetVcpu() {
-	m.vcpu = nil
-	m.addvcpu = nil
+// ResetClusterID resets all changes to the "cluster_id" field.
+func (m *EnvMutation) ResetClusterID() {
+	m.cluster_id = nil
+	delete(m.clearedFields, env.FieldClusterID)
 }
 
-// SetRAMMB sets the "ram_mb" field.
-func (m *EnvBuildMutation) SetRAMMB(i int64) {
-	m.ram_mb = &i
-	m.addram_mb = nil
+// SetSourceEnvID sets the "source_env_id" field.
+func (m *EnvMutation) SetSourceEnvID(s string) {
+	m.source_env_id = &s
 }
 
-// RAMMB returns the value of the "ram_mb" field in the mutation.
-func (m *EnvBuildMutation) RAMMB() (r int64, exists bool) {
-	v := m.ram_mb
+// SourceEnvID returns the value of the "source_env_id" field in the mutation.
+func (m *EnvMutation) SourceEnvID() (r string, exists bool) {
+	v := m.source_env_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRAMMB returns the old "ram_mb" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceEnvID returns the old "source_env_id" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldRAMMB(ctx context.Context) (v int64, err error) {
+func (m *EnvMutation) OldSourceEnvID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRAMMB is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceEnvID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRAMMB requires an ID field in the mutation")
+		return v, errors.New("OldSourceEnvID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRAMMB: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceEnvID: %w", err)
 	}
-	return oldValue.RAMMB, nil
+	return oldValue.SourceEnvID, nil
 }
 
-// AddRAMMB adds i to the "ram_mb" field.
-func (m *EnvBuildMutation) AddRAMMB(i int64) {
-	if m.addram_mb != nil {
-		*m.addram_mb += i
-	} else {
-		m.addram_mb = &i
-	}
+// ClearSourceEnvID clears the value of the "source_env_id" field.
+func (m *EnvMutation) ClearSourceEnvID() {
+	m.source_env_id = nil
+	m.clearedFields[env.FieldSourceEnvID] = struct{}{}
 }
 
-// AddedRAMMB returns the value that was added to the "ram_mb" field in this mutation.
-func (m *EnvBuildMutation) AddedRAMMB() (r int64, exists bool) {
-	v := m.addram_mb
-	if v == nil {
-		return
-	}
-	return *v, true
+// SourceEnvIDCleared returns if the "source_env_id" field was cleared in this mutation.
+func (m *EnvMutation) SourceEnvIDCleared() bool {
+	_, ok := m.clearedFields[env.FieldSourceEnvID]
+	return ok
 }
 
-// ResetRAMMB resets all changes to the "ram_mb" field.
-func (m *EnvBuildMutation) ResetRAMMB() {
-	m.ram_mb = nil
-	m.addram_mb = nil
+// ResetSourceEnvID resets all changes to the "source_env_id" field.
+func (m *EnvMutation) ResetSourceEnvID() {
+	m.source_env_id = nil
+	delete(m.clearedFields, env.FieldSourceEnvID)
 }
 
-// SetFreeDiskSizeMB sets the "free_disk_size_mb" field.
-func (m *EnvBuildMutation) SetFreeDiskSizeMB(i int64) {
-	m.free_disk_size_mb = &i
-	m.addfree_disk_size_mb = nil
+// SetSourceSandboxID sets the "source_sandbox_id" field.
+func (m *EnvMutation) SetSourceSandboxID(s string) {
+	m.source_sandbox_id = &s
 }
 
-// FreeDiskSizeMB returns the value of the "free_disk_size_mb" field in the mutation.
-func (m *EnvBuildMutation) FreeDiskSizeMB() (r int64, exists bool) {
-	v := m.free_disk_size_mb
+// SourceSandboxID returns the value of the "source_sandbox_id" field in the mutation.
+func (m *EnvMutation) SourceSandboxID() (r string, exists bool) {
+	v := m.source_sandbox_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFreeDiskSizeMB returns the old "free_disk_size_mb" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceSandboxID returns the old "source_sandbox_id" field's value of the Env entity.
+// If the Env object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFreeDiskSizeMB(ctx context.Context) (v int64, err error) {
+func (m *EnvMutation) OldSourceSandboxID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFreeDiskSizeMB is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceSandboxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFreeDiskSizeMB requires an ID field in the mutation")
+		return v, errors.New("OldSourceSandboxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFreeDiskSizeMB: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceSandboxID: %w", err)
 	}
-	return oldValue.FreeDiskSizeMB, nil
+	return oldValue.SourceSandboxID, nil
 }
 
-// AddFreeDiskSizeMB adds i to the "free_disk_size_mb" field.
-func (m *EnvBuildMutation) AddFreeDiskSizeMB(i int64) {
-	if m.addfree_disk_size_mb != nil {
-		*m.addfree_disk_size_mb += i
-	} else {
-		m.addfree_disk_size_mb = &i
-	}
+// ClearSourceSandboxID clears the value of the "source_sandbox_id" field.
+func (m *EnvMutation) ClearSourceSandboxID() {
+	m.source_sandbox_id = nil
+	m.clearedFields[env.FieldSourceSandboxID] = struct{}{}
 }
 
-// AddedFreeDiskSizeMB returns the value that was added to the "free_disk_size_mb" field in this mutation.
-func (m *EnvBuildMutation) AddedFreeDiskSizeMB() (r int64, exists bool) {
-	v := m.addfree_disk_size_mb
-	if v == nil {
-		return
-	}
-	return *v, true
+// SourceSandboxIDCleared returns if the "source_sandbox_id" field was cleared in this mutation.
+func (m *EnvMutation) SourceSandboxIDCleared() bool {
+	_, ok := m.clearedFields[env.FieldSourceSandboxID]
+	return ok
 }
 
-// ResetFreeDiskSizeMB resets all changes to the "free_disk_size_mb" field.
-func (m *EnvBuildMutation) ResetFreeDiskSizeMB() {
-	m.free_disk_size_mb = nil
-	m.addfree_disk_size_mb = nil
+// ResetSourceSandboxID resets all changes to the "source_sandbox_id" field.
+func (m *EnvMutation) ResetSourceSandboxID() {
+	m.source_sandbox_id = nil
+	delete(m.clearedFields, env.FieldSourceSandboxID)
 }
 
-// SetTotalDiskSizeMB sets the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) SetTotalDiskSizeMB(i int64) {
-	m.total_disk_size_mb = &i
-	m.addtotal_disk_size_mb = nil
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *EnvMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[env.FieldTeamID] = struct{}{}
 }
 
-// TotalDiskSizeMB returns the value of the "total_disk_size_mb" field in the mutation.
-func (m *EnvBuildMutation) TotalDiskSizeMB() (r int64, exists bool) {
-	v := m.total_disk_size_mb
-	if v == nil {
-		return
-	}
-	return *v, true
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *EnvMutation) TeamCleared() bool {
+	return m.clearedteam
 }
 
-// OldTotalDiskSizeMB returns the old "total_disk_size_mb" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldTotalDiskSizeMB(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalDiskSizeMB is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalDiskSizeMB requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalDiskSizeMB: %w", err)
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *EnvMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.TotalDiskSizeMB, nil
+	return
 }
 
-// AddTotalDiskSizeMB adds i to the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) AddTotalDiskSizeMB(i int64) {
-	if m.addtotal_disk_size_mb != nil {
-		*m.addtotal_disk_size_mb += i
-	} else {
-		m.addtotal_disk_size_mb = &i
-	}
+// ResetTeam resets all changes to the "team" edge.
+func (m *EnvMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
 }
 
-// AddedTotalDiskSizeMB returns the value that was added to the "total_disk_size_mb" field in this mutation.
-func (m *EnvBuildMutation) AddedTotalDiskSizeMB() (r int64, exists bool) {
-	v := m.addtotal_disk_size_mb
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *EnvMutation) SetCreatorID(id uuid.UUID) {
+	m.creator = &id
 }
 
-// ClearTotalDiskSizeMB clears the value of the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) ClearTotalDiskSizeMB() {
-	m.total_disk_size_mb = nil
-	m.addtotal_disk_size_mb = nil
-	m.clearedFields[envbuild.FieldTotalDiskSizeMB] = struct{}{}
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *EnvMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[env.FieldCreatedBy] = struct{}{}
 }
 
-// TotalDiskSizeMBCleared returns if the "total_disk_size_mb" field was cleared in this mutation.
-func (m *EnvBuildMutation) TotalDiskSizeMBCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldTotalDiskSizeMB]
-	return ok
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *EnvMutation) CreatorCleared() bool {
+	return m.CreatedByCleared() || m.clearedcreator
 }
 
-// ResetTotalDiskSizeMB resets all changes to the "total_disk_size_mb" field.
-func (m *EnvBuildMutation) ResetTotalDiskSizeMB() {
-	m.total_disk_size_mb = nil
-	m.addtotal_disk_size_mb = nil
-	delete(m.clearedFields, envbuild.FieldTotalDiskSizeMB)
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *EnvMutation) CreatorID() (id uuid.UUID, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
 }
 
-// SetKernelVersion sets the "kernel_version" field.
-func (m *EnvBuildMutation) SetKernelVersion(s string) {
-	m.kernel_version = &s
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *EnvMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// KernelVersion returns the value of the "kernel_version" field in the mutation.
-func (m *EnvBuildMutation) KernelVersion() (r string, exists bool) {
-	v := m.kernel_version
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetCreator resets all changes to the "creator" edge.
+func (m *EnvMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
 }
 
-// OldKernelVersion returns the old "kernel_version" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldKernelVersion(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKernelVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKernelVersion requires an ID field in the mutation")
+// AddEnvAliasIDs adds the "env_aliases" edge to the EnvAlias entity by ids.
+func (m *EnvMutation) AddEnvAliasIDs(ids ...string) {
+	if m.env_aliases == nil {
+		m.env_aliases = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKernelVersion: %w", err)
+	for i := range ids {
+		m.env_aliases[ids[i]] = struct{}{}
 	}
-	return oldValue.KernelVersion, nil
-}
-
-// ResetKernelVersion resets all changes to the "kernel_version" field.
-func (m *EnvBuildMutation) ResetKernelVersion() {
-	m.kernel_version = nil
 }
 
-// SetFirecrackerVersion sets the "firecracker_version" field.
-func (m *EnvBuildMutation) SetFirecrackerVersion(s string) {
-	m.firecracker_version = &s
+// ClearEnvAliases clears the "env_aliases" edge to the EnvAlias entity.
+func (m *EnvMutation) ClearEnvAliases() {
+	m.clearedenv_aliases = true
 }
 
-// FirecrackerVersion returns the value of the "firecracker_version" field in the mutation.
-func (m *EnvBuildMutation) FirecrackerVersion() (r string, exists bool) {
-	v := m.firecracker_version
-	if v == nil {
-		return
-	}
-	return *v, true
+// EnvAliasesCleared reports if the "env_aliases" edge to the EnvAlias entity was cleared.
+func (m *EnvMutation) EnvAliasesCleared() bool {
+	return m.clearedenv_aliases
 }
 
-// OldFirecrackerVersion returns the old "firecracker_version" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldFirecrackerVersion(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirecrackerVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirecrackerVersion requires an ID field in the mutation")
+// RemoveEnvAliasIDs removes the "env_aliases" edge to the EnvAlias entity by IDs.
+func (m *EnvMutation) RemoveEnvAliasIDs(ids ...string) {
+	if m.removedenv_aliases == nil {
+		m.removedenv_aliases = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirecrackerVersion: %w", err)
+	for i := range ids {
+		delete(m.env_aliases, ids[i])
+		m.removedenv_aliases[ids[i]] = struct{}{}
 	}
-	return oldValue.FirecrackerVersion, nil
 }
 
-// ResetFirecrackerVersion resets all changes to the "firecracker_version" field.
-func (m *EnvBuildMutation) ResetFirecrackerVersion() {
-	m.firecracker_version = nil
+// RemovedEnvAliases returns the removed IDs of the "env_aliases" edge to the EnvAlias entity.
+func (m *EnvMutation) RemovedEnvAliasesIDs() (ids []string) {
+	for id := range m.removedenv_aliases {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetEnvdVersion sets the "envd_version" field.
-func (m *EnvBuildMutation) SetEnvdVersion(s string) {
-	m.envd_version = &s
+// EnvAliasesIDs returns the "env_aliases" edge IDs in the mutation.
+func (m *EnvMutation) EnvAliasesIDs() (ids []string) {
+	for id := range m.env_aliases {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// EnvdVersion returns the value of the "envd_version" field in the mutation.
-func (m *EnvBuildMutation) EnvdVersion() (r string, exists bool) {
-	v := m.envd_version
-	if v == nil {
-		return
+// ResetEnvAliases resets all changes to the "env_aliases" edge.
+func (m *EnvMutation) ResetEnvAliases() {
+	m.env_aliases = nil
+	m.clearedenv_aliases = false
+	m.removedenv_aliases = nil
+}
+
+// AddBuildIDs adds the "builds" edge to the EnvBuild entity by ids.
+func (m *EnvMutation) AddBuildIDs(ids ...uuid.UUID) {
+	if m.builds == nil {
+		m.builds = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.builds[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldEnvdVersion returns the old "envd_version" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldEnvdVersion(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvdVersion is only allowed on UpdateOne operations")
+// ClearBuilds clears the "builds" edge to the EnvBuild entity.
+func (m *EnvMutation) ClearBuilds() {
+	m.clearedbuilds = true
+}
+
+// BuildsCleared reports if the "builds" edge to the EnvBuild entity was cleared.
+func (m *EnvMutation) BuildsCleared() bool {
+	return m.clearedbuilds
+}
+
+// RemoveBuildIDs removes the "builds" edge to the EnvBuild entity by IDs.
+func (m *EnvMutation) RemoveBuildIDs(ids ...uuid.UUID) {
+	if m.removedbuilds == nil {
+		m.removedbuilds = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvdVersion requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.builds, ids[i])
+		m.removedbuilds[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvdVersion: %w", err)
+}
+
+// RemovedBuilds returns the removed IDs of the "builds" edge to the EnvBuild entity.
+func (m *EnvMutation) RemovedBuildsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbuilds {
+		ids = append(ids, id)
 	}
-	return oldValue.EnvdVersion, nil
+	return
 }
 
-// ClearEnvdVersion clears the value of the "envd_version" field.
-func (m *EnvBuildMutation) ClearEnvdVersion() {
-	m.envd_version = nil
-	m.clearedFields[envbuild.FieldEnvdVersion] = struct{}{}
+// BuildsIDs returns the "builds" edge IDs in the mutation.
+func (m *EnvMutation) BuildsIDs() (ids []uuid.UUID) {
+	for id := range m.builds {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// EnvdVersionCleared returns if the "envd_version" field was cleared in this mutation.
-func (m *EnvBuildMutation) EnvdVersionCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldEnvdVersion]
-	return ok
+// ResetBuilds resets all changes to the "builds" edge.
+func (m *EnvMutation) ResetBuilds() {
+	m.builds = nil
+	m.clearedbuilds = false
+	m.removedbuilds = nil
 }
 
-// ResetEnvdVersion resets all changes to the "envd_version" field.
-func (m *EnvBuildMutation) ResetEnvdVersion() {
-	m.envd_version = nil
-	delete(m.clearedFields, envbuild.FieldEnvdVersion)
+// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by ids.
+func (m *EnvMutation) AddSnapshotIDs(ids ...uuid.UUID) {
+	if m.snapshots == nil {
+		m.snapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.snapshots[ids[i]] = struct{}{}
+	}
 }
 
-// SetClusterNodeID sets the "cluster_node_id" field.
-func (m *EnvBuildMutation) SetClusterNodeID(s string) {
-	m.cluster_node_id = &s
+// ClearSnapshots clears the "snapshots" edge to the Snapshot entity.
+func (m *EnvMutation) ClearSnapshots() {
+	m.clearedsnapshots = true
 }
 
-// ClusterNodeID returns the value of the "cluster_node_id" field in the mutation.
-func (m *EnvBuildMutation) ClusterNodeID() (r string, exists bool) {
-	v := m.cluster_node_id
-	if v == nil {
-		return
+// SnapshotsCleared reports if the "snapshots" edge to the Snapshot entity was cleared.
+func (m *EnvMutation) SnapshotsCleared() bool {
+	return m.clearedsnapshots
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to the Snapshot entity by IDs.
+func (m *EnvMutation) RemoveSnapshotIDs(ids ...uuid.UUID) {
+	if m.removedsnapshots == nil {
+		m.removedsnapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.snapshots, ids[i])
+		m.removedsnapshots[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldClusterNodeID returns the old "cluster_node_id" field's value of the EnvBuild entity.
-// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvBuildMutation) OldClusterNodeID(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClusterNodeID is only allowed on UpdateOne operations")
+// RemovedSnapshots returns the removed IDs of the "snapshots" edge to the Snapshot entity.
+func (m *EnvMutation) RemovedSnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsnapshots {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClusterNodeID requires an ID field in the mutation")
+	return
+}
+
+// SnapshotsIDs returns the "snapshots" edge IDs in the mutation.
+func (m *EnvMutation) SnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.snapshots {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClusterNodeID: %w", err)
+	return
+}
+
+// ResetSnapshots resets all changes to the "snapshots" edge.
+func (m *EnvMutation) ResetSnapshots() {
+	m.snapshots = nil
+	m.clearedsnapshots = false
+	m.removedsnapshots = nil
+}
+
+// AddWarmPoolIDs adds the "warm_pools" edge to the WarmPool entity by ids.
+func (m *EnvMutation) AddWarmPoolIDs(ids ...uuid.UUID) {
+	if m.warm_pools == nil {
+		m.warm_pools = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.warm_pools[ids[i]] = struct{}{}
 	}
-	return oldValue.ClusterNodeID, nil
 }
 
-// ClearClusterNodeID clears the value of the "cluster_node_id" field.
-func (m *EnvBuildMutation) ClearClusterNodeID() {
-	m.cluster_node_id = nil
-	m.clearedFields[envbuild.FieldClusterNodeID] = struct{}{}
+// ClearWarmPools clears the "warm_pools" edge to the WarmPool entity.
+func (m *EnvMutation) ClearWarmPools() {
+	m.clearedwarm_pools = true
 }
 
-// ClusterNodeIDCleared returns if the "cluster_node_id" field was cleared in this mutation.
-func (m *EnvBuildMutation) ClusterNodeIDCleared() bool {
-	_, ok := m.clearedFields[envbuild.FieldClusterNodeID]
-	return ok
+// WarmPoolsCleared reports if the "warm_pools" edge to the WarmPool entity was cleared.
+func (m *EnvMutation) WarmPoolsCleared() bool {
+	return m.clearedwarm_pools
 }
 
-// ResetClusterNodeID resets all changes to the "cluster_node_id" field.
-func (m *EnvBuildMutation) ResetClusterNodeID() {
-	m.cluster_node_id = nil
-	delete(m.clearedFields, envbuild.FieldClusterNodeID)
+// RemoveWarmPoolIDs removes the "warm_pools" edge to the WarmPool entity by IDs.
+func (m *EnvMutation) RemoveWarmPoolIDs(ids ...uuid.UUID) {
+	if m.removedwarm_pools == nil {
+		m.removedwarm_pools = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.warm_pools, ids[i])
+		m.removedwarm_pools[ids[i]] = struct{}{}
+	}
 }
 
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *EnvBuildMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+// RemovedWarmPools returns the removed IDs of the "warm_pools" edge to the WarmPool entity.
+func (m *EnvMutation) RemovedWarmPoolsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwarm_pools {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *EnvBuildMutation) EnvCleared() bool {
-	return m.EnvIDCleared() || m.clearedenv
+// WarmPoolsIDs returns the "warm_pools" edge IDs in the mutation.
+func (m *EnvMutation) WarmPoolsIDs() (ids []uuid.UUID) {
+	for id := range m.warm_pools {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *EnvBuildMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
+// ResetWarmPools resets all changes to the "warm_pools" edge.
+func (m *EnvMutation) ResetWarmPools() {
+	m.warm_pools = nil
+	m.clearedwarm_pools = false
+	m.removedwarm_pools = nil
+}
+
+// AddBuildTagIDs adds the "build_tags" edge to the EnvBuildTag entity by ids.
+func (m *EnvMutation) AddBuildTagIDs(ids ...uuid.UUID) {
+	if m.build_tags == nil {
+		m.build_tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.build_tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBuildTags clears the "build_tags" edge to the EnvBuildTag entity.
+func (m *EnvMutation) ClearBuildTags() {
+	m.clearedbuild_tags = true
+}
+
+// BuildTagsCleared reports if the "build_tags" edge to the EnvBuildTag entity was cleared.
+func (m *EnvMutation) BuildTagsCleared() bool {
+	return m.clearedbuild_tags
+}
+
+// RemoveBuildTagIDs removes the "build_tags" edge to the EnvBuildTag entity by IDs.
+func (m *EnvMutation) RemoveBuildTagIDs(ids ...uuid.UUID) {
+	if m.removedbuild_tags == nil {
+		m.removedbuild_tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.build_tags, ids[i])
+		m.removedbuild_tags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBuildTags returns the removed IDs of the "build_tags" edge to the EnvBuildTag entity.
+func (m *EnvMutation) RemovedBuildTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbuild_tags {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEnv resets all changes to the "env" edge.
-func (m *EnvBuildMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
+// BuildTagsIDs returns the "build_tags" edge IDs in the mutation.
+func (m *EnvMutation) BuildTagsIDs() (ids []uuid.UUID) {
+	for id := range m.build_tags {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the EnvBuildMutation builder.
-func (m *EnvBuildMutation) Where(ps ...predicate.EnvBuild) {
+// ResetBuildTags resets all changes to the "build_tags" edge.
+func (m *EnvMutation) ResetBuildTags() {
+	m.build_tags = nil
+	m.clearedbuild_tags = false
+	m.removedbuild_tags = nil
+}
+
+// Where appends a list predicates to the EnvMutation builder.
+func (m *EnvMutation) Where(ps ...predicate.Env) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvBuildMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EnvMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EnvBuild, len(ps))
+func (m *EnvMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Env, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4002,72 +3984,57 @@ func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvBuildMutation) Op() Op {
+func (m *EnvMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvBuildMutation) SetOp(op Op) {
+func (m *EnvMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EnvBuild).
-func (m *EnvBuildMutation) Type() string {
+// Type returns the node type of this mutation (Env).
+func (m *EnvMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvBuildMutation) Fields() []string {
-	fields := make([]string, 0, 16)
+func (m *EnvMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, envbuild.FieldCreatedAt)
+		fields = append(fields, env.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, envbuild.FieldUpdatedAt)
+		fields = append(fields, env.FieldUpdatedAt)
 	}
-	if m.finished_at != nil {
-		fields = append(fields, envbuild.FieldFinishedAt)
+	if m.team != nil {
+		fields = append(fields, env.FieldTeamID)
 	}
-	if m.env != nil {
-		fields = append(fields, envbuild.FieldEnvID)
+	if m.creator != nil {
+		fields = append(fields, env.FieldCreatedBy)
 	}
-	if m.status != nil {
-		fields = append(fields, envbuild.FieldStatus)
-	}
-	if m.dockerfile != nil {
-		fields = append(fields, envbuild.FieldDockerfile)
-	}
-	if m.start_cmd != nil {
-		fields = append(fields, envbuild.FieldStartCmd)
-	}
-	if m.ready_cmd != nil {
-		fields = append(fields, envbuild.FieldReadyCmd)
-	}
-	if m.vcpu != nil {
-		fields = append(fields, envbuild.FieldVcpu)
-	}
-	if m.ram_mb != nil {
-		fields = append(fields, envbuild.FieldRAMMB)
+	if m.public != nil {
+		fields = append(fields, env.FieldPublic)
 	}
-	if m.free_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	if m.build_count != nil {
+		fields = append(fields, env.FieldBuildCount)
 	}
-	if m.total_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	if m.spawn_count != nil {
+		fields = append(fields, env.FieldSpawnCount)
 	}
-	if m.kernel_version != nil {
-		fields = append(fields, envbuild.FieldKernelVersion)
+	if m.last_spawned_at != nil {
+		fields = append(fields, env.FieldLastSpawnedAt)
 	}
-	if m.firecracker_version != nil {
-		fields = append(fields, envbuild.FieldFirecrackerVersion)
+	if m.cluster_id != nil {
+		fields = append(fields, env.FieldClusterID)
 	}
-	if m.envd_version != nil {
-		fields = append(fields, envbuild.FieldEnvdVersion)
+	if m.source_env_id != nil {
+		fields = append(fields, env.FieldSourceEnvID)
 	}
-	if m.cluster_node_id != nil {
-		fields = append(fields, envbuild.FieldClusterNodeID)
+	if m.source_sandbox_id != nil {
+		fields = append(fields, env.FieldSourceSandboxID)
 	}
 	return fields
 }
@@ -4075,40 +4042,30 @@ func (m *EnvBuildMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
+func (m *EnvMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case env.FieldCreatedAt:
 		return m.CreatedAt()
-	case envbuild.FieldUpdatedAt:
+	case env.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case envbuild.FieldFinishedAt:
-		return m.FinishedAt()
-	case envbuild.FieldEnvID:
-		return m.EnvID()
-	case envbuild.FieldStatus:
-		return m.Status()
-	case envbuild.FieldDockerfile:
-		return m.Dockerfile()
-	case envbuild.FieldStartCmd:
-		return m.StartCmd()
-	case envbuild.FieldReadyCmd:
-		return m.ReadyCmd()
-	case envbuild.FieldVcpu:
-		return m.Vcpu()
-	case envbuild.FieldRAMMB:
-		return m.RAMMB()
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.FreeDiskSizeMB()
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.TotalDiskSizeMB()
-	case envbuild.FieldKernelVersion:
-		return m.KernelVersion()
-	case envbuild.FieldFirecrackerVersion:
-		return m.FirecrackerVersion()
-	case envbuild.FieldEnvdVersion:
-		return m.EnvdVersion()
-	case envbuild.FieldClusterNodeID:
-		return m.ClusterNodeID()
+	case env.FieldTeamID:
+		return m.TeamID()
+	case env.FieldCreatedBy:
+		return m.CreatedBy()
+	case env.FieldPublic:
+		return m.Public()
+	case env.FieldBuildCount:
+		return m.BuildCount()
+	case env.FieldSpawnCount:
+		return m.SpawnCount()
+	case env.FieldLastSpawnedAt:
+		return m.LastSpawnedAt()
+	case env.FieldClusterID:
+		return m.ClusterID()
+	case env.FieldSourceEnvID:
+		return m.SourceEnvID()
+	case env.FieldSourceSandboxID:
+		return m.SourceSandboxID()
 	}
 	return nil, false
 }
@@ -4116,180 +4073,129 @@ func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EnvMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case env.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case envbuild.FieldUpdatedAt:
+	case env.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case envbuild.FieldFinishedAt:
-		return m.OldFinishedAt(ctx)
-	case envbuild.FieldEnvID:
-		return m.OldEnvID(ctx)
-	case envbuild.FieldStatus:
-		return m.OldStatus(ctx)
-	case envbuild.FieldDockerfile:
-		return m.OldDockerfile(ctx)
-	case envbuild.FieldStartCmd:
-		return m.OldStartCmd(ctx)
-	case envbuild.FieldReadyCmd:
-		return m.OldReadyCmd(ctx)
-	case envbuild.FieldVcpu:
-		return m.OldVcpu(ctx)
-	case envbuild.FieldRAMMB:
-		return m.OldRAMMB(ctx)
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.OldFreeDiskSizeMB(ctx)
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.OldTotalDiskSizeMB(ctx)
-	case envbuild.FieldKernelVersion:
-		return m.OldKernelVersion(ctx)
-	case envbuild.FieldFirecrackerVersion:
-		return m.OldFirecrackerVersion(ctx)
-	case envbuild.FieldEnvdVersion:
-		return m.OldEnvdVersion(ctx)
-	case envbuild.FieldClusterNodeID:
-		return m.OldClusterNodeID(ctx)
+	case env.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case env.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case env.FieldPublic:
+		return m.OldPublic(ctx)
+	case env.FieldBuildCount:
+		return m.OldBuildCount(ctx)
+	case env.FieldSpawnCount:
+		return m.OldSpawnCount(ctx)
+	case env.FieldLastSpawnedAt:
+		return m.OldLastSpawnedAt(ctx)
+	case env.FieldClusterID:
+		return m.OldClusterID(ctx)
+	case env.FieldSourceEnvID:
+		return m.OldSourceEnvID(ctx)
+	case env.FieldSourceSandboxID:
+		return m.OldSourceSandboxID(ctx)
 	}
-	return nil, fmt.Errorf("unknown EnvBuild field %s", name)
+	return nil, fmt.Errorf("unknown Env field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
+func (m *EnvMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case env.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case envbuild.FieldUpdatedAt:
+	case env.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case envbuild.FieldFinishedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFinishedAt(v)
-		return nil
-	case envbuild.FieldEnvID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnvID(v)
-		return nil
-	case envbuild.FieldStatus:
-		v, ok := value.(envbuild.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case envbuild.FieldDockerfile:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDockerfile(v)
-		return nil
-	case envbuild.FieldStartCmd:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartCmd(v)
-		return nil
-	case envbuild.FieldReadyCmd:
-		v, ok := value.(string)
+	case env.FieldTeamID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReadyCmd(v)
+		m.SetTeamID(v)
 		return nil
-	case envbuild.FieldVcpu:
-		v, ok := value.(int64)
+	case env.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVcpu(v)
+		m.SetCreatedBy(v)
 		return nil
-	case envbuild.FieldRAMMB:
-		v, ok := value.(int64)
+	case env.FieldPublic:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRAMMB(v)
+		m.SetPublic(v)
 		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		v, ok := value.(int64)
+	case env.FieldBuildCount:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFreeDiskSizeMB(v)
+		m.SetBuildCount(v)
 		return nil
-	case envbuild.FieldTotalDiskSizeMB:
+	case env.FieldSpawnCount:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalDiskSizeMB(v)
+		m.SetSpawnCount(v)
 		return nil
-	case envbuild.FieldKernelVersion:
-		v, ok := value.(string)
+	case env.FieldLastSpawnedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKernelVersion(v)
+		m.SetLastSpawnedAt(v)
 		return nil
-	case envbuild.FieldFirecrackerVersion:
-		v, ok := value.(string)
+	case env.FieldClusterID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirecrackerVersion(v)
+		m.SetClusterID(v)
 		return nil
-	case envbuild.FieldEnvdVersion:
+	case env.FieldSourceEnvID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvdVersion(v)
+		m.SetSourceEnvID(v)
 		return nil
-	case envbuild.FieldClusterNodeID:
+	case env.FieldSourceSandboxID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClusterNodeID(v)
+		m.SetSourceSandboxID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild field %s", name)
+	return fmt.Errorf("unknown Env field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvBuildMutation) AddedFields() []string {
+func (m *EnvMutation) AddedFields() []string {
 	var fields []string
-	if m.addvcpu != nil {
-		fields = append(fields, envbuild.FieldVcpu)
-	}
-	if m.addram_mb != nil {
-		fields = append(fields, envbuild.FieldRAMMB)
-	}
-	if m.addfree_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	if m.addbuild_count != nil {
+		fields = append(fields, env.FieldBuildCount)
 	}
-	if m.addtotal_disk_size_mb != nil {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	if m.addspawn_count != nil {
+		fields = append(fields, env.FieldSpawnCount)
 	}
 	return fields
 }
@@ -4297,16 +4203,12 @@ func (m *EnvBuildMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EnvMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case envbuild.FieldVcpu:
-		return m.AddedVcpu()
-	case envbuild.FieldRAMMB:
-		return m.AddedRAMMB()
-	case envbuild.FieldFreeDiskSizeMB:
-		return m.AddedFreeDiskSizeMB()
-	case envbuild.FieldTotalDiskSizeMB:
-		return m.AddedTotalDiskSizeMB()
+	case env.FieldBuildCount:
+		return m.AddedBuildCount()
+	case env.FieldSpawnCount:
+		return m.AddedSpawnCount()
 	}
 	return nil, false
 }
@@ -4314,271 +4216,369 @@ func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvBuildMutation) AddField(name string, value ent.Value) error {
+func (m *EnvMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case envbuild.FieldVcpu:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVcpu(v)
-		return nil
-	case envbuild.FieldRAMMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRAMMB(v)
-		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		v, ok := value.(int64)
+	case env.FieldBuildCount:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFreeDiskSizeMB(v)
+		m.AddBuildCount(v)
 		return nil
-	case envbuild.FieldTotalDiskSizeMB:
+	case env.FieldSpawnCount:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalDiskSizeMB(v)
+		m.AddSpawnCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild numeric field %s", name)
+	return fmt.Errorf("unknown Env numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvBuildMutation) ClearedFields() []string {
+func (m *EnvMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(envbuild.FieldFinishedAt) {
-		fields = append(fields, envbuild.FieldFinishedAt)
-	}
-	if m.FieldCleared(envbuild.FieldEnvID) {
-		fields = append(fields, envbuild.FieldEnvID)
-	}
-	if m.FieldCleared(envbuild.FieldDockerfile) {
-		fields = append(fields, envbuild.FieldDockerfile)
-	}
-	if m.FieldCleared(envbuild.FieldStartCmd) {
-		fields = append(fields, envbuild.FieldStartCmd)
+	if m.FieldCleared(env.FieldCreatedBy) {
+		fields = append(fields, env.FieldCreatedBy)
 	}
-	if m.FieldCleared(envbuild.FieldReadyCmd) {
-		fields = append(fields, envbuild.FieldReadyCmd)
+	if m.FieldCleared(env.FieldLastSpawnedAt) {
+		fields = append(fields, env.FieldLastSpawnedAt)
 	}
-	if m.FieldCleared(envbuild.FieldTotalDiskSizeMB) {
-		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	if m.FieldCleared(env.FieldClusterID) {
+		fields = append(fields, env.FieldClusterID)
 	}
-	if m.FieldCleared(envbuild.FieldEnvdVersion) {
-		fields = append(fields, envbuild.FieldEnvdVersion)
+	if m.FieldCleared(env.FieldSourceEnvID) {
+		fields = append(fields, env.FieldSourceEnvID)
 	}
-	if m.FieldCleared(envbuild.FieldClusterNodeID) {
-		fields = append(fields, envbuild.FieldClusterNodeID)
+	if m.FieldCleared(env.FieldSourceSandboxID) {
+		fields = append(fields, env.FieldSourceSandboxID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvBuildMutation) FieldCleared(name string) bool {
+func (m *EnvMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvBuildMutation) ClearField(name string) error {
+func (m *EnvMutation) ClearField(name string) error {
 	switch name {
-	case envbuild.FieldFinishedAt:
-		m.ClearFinishedAt()
-		return nil
-	case envbuild.FieldEnvID:
-		m.ClearEnvID()
-		return nil
-	case envbuild.FieldDockerfile:
-		m.ClearDockerfile()
-		return nil
-	case envbuild.FieldStartCmd:
-		m.ClearStartCmd()
+	case env.FieldCreatedBy:
+		m.ClearCreatedBy()
 		return nil
-	case envbuild.FieldReadyCmd:
-		m.ClearReadyCmd()
+	case env.FieldLastSpawnedAt:
+		m.ClearLastSpawnedAt()
 		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		m.ClearTotalDiskSizeMB()
+	case env.FieldClusterID:
+		m.ClearClusterID()
 		return nil
-	case envbuild.FieldEnvdVersion:
-		m.ClearEnvdVersion()
+	case env.FieldSourceEnvID:
+		m.ClearSourceEnvID()
 		return nil
-	case envbuild.FieldClusterNodeID:
-		m.ClearClusterNodeID()
+	case env.FieldSourceSandboxID:
+		m.ClearSourceSandboxID()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild nullable field %s", name)
+	return fmt.Errorf("unknown Env nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvBuildMutation) ResetField(name string) error {
+func (m *EnvMutation) ResetField(name string) error {
 	switch name {
-	case envbuild.FieldCreatedAt:
+	case env.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case envbuild.FieldUpdatedAt:
+	case env.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case envbuild.FieldFinishedAt:
-		m.ResetFinishedAt()
-		return nil
-	case envbuild.FieldEnvID:
-		m.ResetEnvID()
-		return nil
-	case envbuild.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case envbuild.FieldDockerfile:
-		m.ResetDockerfile()
-		return nil
-	case envbuild.FieldStartCmd:
-		m.ResetStartCmd()
-		return nil
-	case envbuild.FieldReadyCmd:
-		m.ResetReadyCmd()
+	case env.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case envbuild.FieldVcpu:
-		m.ResetVcpu()
+	case env.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case envbuild.FieldRAMMB:
-		m.ResetRAMMB()
+	case env.FieldPublic:
+		m.ResetPublic()
 		return nil
-	case envbuild.FieldFreeDiskSizeMB:
-		m.ResetFreeDiskSizeMB()
+	case env.FieldBuildCount:
+		m.ResetBuildCount()
 		return nil
-	case envbuild.FieldTotalDiskSizeMB:
-		m.ResetTotalDiskSizeMB()
+	case env.FieldSpawnCount:
+		m.ResetSpawnCount()
 		return nil
-	case envbuild.FieldKernelVersion:
-		m.ResetKernelVersion()
+	case env.FieldLastSpawnedAt:
+		m.ResetLastSpawnedAt()
 		return nil
-	case envbuild.FieldFirecrackerVersion:
-		m.ResetFirecrackerVersion()
+	case env.FieldClusterID:
+		m.ResetClusterID()
 		return nil
-	case envbuild.FieldEnvdVersion:
-		m.ResetEnvdVersion()
+	case env.FieldSourceEnvID:
+		m.ResetSourceEnvID()
 		return nil
-	case envbuild.FieldClusterNodeID:
-		m.ResetClusterNodeID()
+	case env.FieldSourceSandboxID:
+		m.ResetSourceSandboxID()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild field %s", name)
+	return fmt.Errorf("unknown Env field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvBuildMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.env != nil {
-		edges = append(edges, envbuild.EdgeEnv)
+func (m *EnvMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.team != nil {
+		edges = append(edges, env.EdgeTeam)
+	}
+	if m.creator != nil {
+		edges = append(edges, env.EdgeCreator)
+	}
+	if m.env_aliases != nil {
+		edges = append(edges, env.EdgeEnvAliases)
+	}
+	if m.builds != nil {
+		edges = append(edges, env.EdgeBuilds)
+	}
+	if m.snapshots != nil {
+		edges = append(edges, env.EdgeSnapshots)
+	}
+	if m.warm_pools != nil {
+		edges = append(edges, env.EdgeWarmPools)
+	}
+	if m.build_tags != nil {
+		edges = append(edges, env.EdgeBuildTags)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvBuildMutation) AddedIDs(name string) []ent.Value {
+func (m *EnvMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case envbuild.EdgeEnv:
-		if id := m.env; id != nil {
+	case env.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case env.EdgeCreator:
+		if id := m.creator; id != nil {
 			return []ent.Value{*id}
 		}
+	case env.EdgeEnvAliases:
+		ids := make([]ent.Value, 0, len(m.env_aliases))
+		for id := range m.env_aliases {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeBuilds:
+		ids := make([]ent.Value, 0, len(m.builds))
+		for id := range m.builds {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.snapshots))
+		for id := range m.snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeWarmPools:
+		ids := make([]ent.Value, 0, len(m.warm_pools))
+		for id := range m.warm_pools {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeBuildTags:
+		ids := make([]ent.Value, 0, len(m.build_tags))
+		for id := range m.build_tags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvBuildMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *EnvMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedenv_aliases != nil {
+		edges = append(edges, env.EdgeEnvAliases)
+	}
+	if m.removedbuilds != nil {
+		edges = append(edges, env.EdgeBuilds)
+	}
+	if m.removedsnapshots != nil {
+		edges = append(edges, env.EdgeSnapshots)
+	}
+	if m.removedwarm_pools != nil {
+		edges = append(edges, env.EdgeWarmPools)
+	}
+	if m.removedbuild_tags != nil {
+		edges = append(edges, env.EdgeBuildTags)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvBuildMutation) RemovedIDs(name string) []ent.Value {
+func (m *EnvMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case env.EdgeEnvAliases:
+		ids := make([]ent.Value, 0, len(m.removedenv_aliases))
+		for id := range m.removedenv_aliases {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeBuilds:
+		ids := make([]ent.Value, 0, len(m.removedbuilds))
+		for id := range m.removedbuilds {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedsnapshots))
+		for id := range m.removedsnapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeWarmPools:
+		ids := make([]ent.Value, 0, len(m.removedwarm_pools))
+		for id := range m.removedwarm_pools {
+			ids = append(ids, id)
+		}
+		return ids
+	case env.EdgeBuildTags:
+		ids := make([]ent.Value, 0, len(m.removedbuild_tags))
+		for id := range m.removedbuild_tags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvBuildMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedenv {
-		edges = append(edges, envbuild.EdgeEnv)
+func (m *EnvMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedteam {
+		edges = append(edges, env.EdgeTeam)
+	}
+	if m.clearedcreator {
+		edges = append(edges, env.EdgeCreator)
+	}
+	if m.clearedenv_aliases {
+		edges = append(edges, env.EdgeEnvAliases)
+	}
+	if m.clearedbuilds {
+		edges = append(edges, env.EdgeBuilds)
+	}
+	if m.clearedsnapshots {
+		edges = append(edges, env.EdgeSnapshots)
+	}
+	if m.clearedwarm_pools {
+		edges = append(edges, env.EdgeWarmPools)
+	}
+	if m.clearedbuild_tags {
+		edges = append(edges, env.EdgeBuildTags)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvBuildMutation) EdgeCleared(name string) bool {
+func (m *EnvMutation) EdgeCleared(name string) bool {
 	switch name {
-	case envbuild.EdgeEnv:
-		return m.clearedenv
+	case env.EdgeTeam:
+		return m.clearedteam
+	case env.EdgeCreator:
+		return m.clearedcreator
+	case env.EdgeEnvAliases:
+		return m.clearedenv_aliases
+	case env.EdgeBuilds:
+		return m.clearedbuilds
+	case env.EdgeSnapshots:
+		return m.clearedsnapshots
+	case env.EdgeWarmPools:
+		return m.clearedwarm_pools
+	case env.EdgeBuildTags:
+		return m.clearedbuild_tags
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvBuildMutation) ClearEdge(name string) error {
+func (m *EnvMutation) ClearEdge(name string) error {
 	switch name {
-	case envbuild.EdgeEnv:
-		m.ClearEnv()
+	case env.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case env.EdgeCreator:
+		m.ClearCreator()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild unique edge %s", name)
+	return fmt.Errorf("unknown Env unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvBuildMutation) ResetEdge(name string) error {
+func (m *EnvMutation) ResetEdge(name string) error {
 	switch name {
-	case envbuild.EdgeEnv:
-		m.ResetEnv()
+	case env.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case env.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	case env.EdgeEnvAliases:
+		m.ResetEnvAliases()
+		return nil
+	case env.EdgeBuilds:
+		m.ResetBuilds()
+		return nil
+	case env.EdgeSnapshots:
+		m.ResetSnapshots()
+		return nil
+	case env.EdgeWarmPools:
+		m.ResetWarmPools()
+		return nil
+	case env.EdgeBuildTags:
+		m.ResetBuildTags()
 		return nil
 	}
-	return fmt.Errorf("unknown EnvBuild edge %s", name)
+	return fmt.Errorf("unknown Env edge %s", name)
 }
 
-// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
-type SnapshotMutation struct {
+// EnvAliasMutation represents an operation that mutates the EnvAlias nodes in the graph.
+type EnvAliasMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	base_env_id        *string
-	sandbox_id         *string
-	metadata           *map[string]string
-	sandbox_started_at *time.Time
-	env_secure         *bool
-	clearedFields      map[string]struct{}
-	env                *string
-	clearedenv         bool
-	done               bool
-	oldValue           func(context.Context) (*Snapshot, error)
-	predicates         []predicate.Snapshot
+	op            Op
+	typ           string
+	id            *string
+	is_renamable  *bool
+	clearedFields map[string]struct{}
+	env           *string
+	clearedenv    bool
+	done          bool
+	oldValue      func(context.Context) (*EnvAlias, error)
+	predicates    []predicate.EnvAlias
 }
 
-var _ ent.Mutation = (*SnapshotMutation)(nil)
+var _ ent.Mutation = (*EnvAliasMutation)(nil)
 
-// snapshotOption allows management of the mutation configuration using functional options.
-type snapshotOption func(*SnapshotMutation)
+// envaliasOption allows management of the mutation configuration using functional options.
+type envaliasOption func(*EnvAliasMutation)
 
-// newSnapshotMutation creates new mutation for the Snapshot entity.
-func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
-	m := &SnapshotMutation{
+// newEnvAliasMutation creates new mutation for the EnvAlias entity.
+func newEnvAliasMutation(c config, op Op, opts ...envaliasOption) *EnvAliasMutation {
+	m := &EnvAliasMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSnapshot,
+		typ:           TypeEnvAlias,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4587,20 +4587,20 @@ func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutat
 	return m
 }
 
-// withSnapshotID sets the ID field of the mutation.
-func withSnapshotID(id uuid.UUID) snapshotOption {
-	return func(m *SnapshotMutation) {
+// withEnvAliasID sets the ID field of the mutation.
+func withEnvAliasID(id string) envaliasOption {
+	return func(m *EnvAliasMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Snapshot
+			value *EnvAlias
 		)
-		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
+		m.oldValue = func(ctx context.Context) (*EnvAlias, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Snapshot.Get(ctx, id)
+					value, err = m.Client().EnvAlias.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4609,10 +4609,10 @@ func withSnapshotID(id uuid.UUID) snapshotOption {
 	}
 }
 
-// withSnapshot sets the old Snapshot of the mutation.
-func withSnapshot(node *Snapshot) snapshotOption {
-	return func(m *SnapshotMutation) {
-		m.oldValue = func(context.Context) (*Snapshot, error) {
+// withEnvAlias sets the old EnvAlias of the mutation.
+func withEnvAlias(node *EnvAlias) envaliasOption {
+	return func(m *EnvAliasMutation) {
+		m.oldValue = func(context.Context) (*EnvAlias, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4621,7 +4621,7 @@ func withSnapshot(node *Snapshot) snapshotOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SnapshotMutation) Client() *Client {
+func (m EnvAliasMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4629,7 +4629,7 @@ func (m SnapshotMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SnapshotMutation) Tx() (*Tx, error) {
+func (m EnvAliasMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -4639,14 +4639,14 @@ func (m SnapshotMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Snapshot entities.
-func (m *SnapshotMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of EnvAlias entities.
+func (m *EnvAliasMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
+func (m *EnvAliasMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4657,355 +4657,160 @@ func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *EnvAliasMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EnvAlias.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SnapshotMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEnvID sets the "env_id" field.
+func (m *EnvAliasMutation) SetEnvID(s string) {
+	m.env = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvAliasMutation) EnvID() (r string, exists bool) {
+	v := m.env
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldEnvID returns the old "env_id" field's value of the EnvAlias entity.
+// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvAliasMutation) OldEnvID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.EnvID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SnapshotMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvAliasMutation) ResetEnvID() {
+	m.env = nil
 }
 
-// SetBaseEnvID sets the "base_env_id" field.
-func (m *SnapshotMutation) SetBaseEnvID(s string) {
-	m.base_env_id = &s
+// SetIsRenamable sets the "is_renamable" field.
+func (m *EnvAliasMutation) SetIsRenamable(b bool) {
+	m.is_renamable = &b
 }
 
-// BaseEnvID returns the value of the "base_env_id" field in the mutation.
-func (m *SnapshotMutation) BaseEnvID() (r string, exists bool) {
-	v := m.base_env_id
+// IsRenamable returns the value of the "is_renamable" field in the mutation.
+func (m *EnvAliasMutation) IsRenamable() (r bool, exists bool) {
+	v := m.is_renamable
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseEnvID returns the old "base_env_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// OldIsRenamable returns the old "is_renamable" field's value of the EnvAlias entity.
+// If the EnvAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
+func (m *EnvAliasMutation) OldIsRenamable(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsRenamable is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
+		return v, errors.New("OldIsRenamable requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsRenamable: %w", err)
 	}
-	return oldValue.BaseEnvID, nil
+	return oldValue.IsRenamable, nil
 }
 
-// ResetBaseEnvID resets all changes to the "base_env_id" field.
-func (m *SnapshotMutation) ResetBaseEnvID() {
-	m.base_env_id = nil
+// ResetIsRenamable resets all changes to the "is_renamable" field.
+func (m *EnvAliasMutation) ResetIsRenamable() {
+	m.is_renamable = nil
 }
 
-// SetEnvID sets the "env_id" field.
-func (m *SnapshotMutation) SetEnvID(s string) {
-	m.env = &s
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvAliasMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envalias.FieldEnvID] = struct{}{}
 }
 
-// EnvID returns the value of the "env_id" field in the mutation.
-func (m *SnapshotMutation) EnvID() (r string, exists bool) {
-	v := m.env
-	if v == nil {
-		return
-	}
-	return *v, true
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvAliasMutation) EnvCleared() bool {
+	return m.clearedenv
 }
 
-// OldEnvID returns the old "env_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldEnvID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvAliasMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.EnvID, nil
+	return
 }
 
-// ResetEnvID resets all changes to the "env_id" field.
-func (m *SnapshotMutation) ResetEnvID() {
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvAliasMutation) ResetEnv() {
 	m.env = nil
+	m.clearedenv = false
 }
 
-// SetSandboxID sets the "sandbox_id" field.
-func (m *SnapshotMutation) SetSandboxID(s string) {
-	m.sandbox_id = &s
+// Where appends a list predicates to the EnvAliasMutation builder.
+func (m *EnvAliasMutation) Where(ps ...predicate.EnvAlias) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// SandboxID returns the value of the "sandbox_id" field in the mutation.
-func (m *SnapshotMutation) SandboxID() (r string, exists bool) {
-	v := m.sandbox_id
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the EnvAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvAlias, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
-}
-
-// OldSandboxID returns the old "sandbox_id" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldSandboxID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSandboxID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
-	}
-	return oldValue.SandboxID, nil
-}
-
-// ResetSandboxID resets all changes to the "sandbox_id" field.
-func (m *SnapshotMutation) ResetSandboxID() {
-	m.sandbox_id = nil
-}
-
-// SetMetadata sets the "metadata" field.
-func (m *SnapshotMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
-}
-
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SnapshotMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldMetadata returns the old "metadata" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
-	}
-	return oldValue.Metadata, nil
-}
-
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SnapshotMutation) ResetMetadata() {
-	m.metadata = nil
-}
-
-// SetSandboxStartedAt sets the "sandbox_started_at" field.
-func (m *SnapshotMutation) SetSandboxStartedAt(t time.Time) {
-	m.sandbox_started_at = &t
-}
-
-// SandboxStartedAt returns the value of the "sandbox_started_at" field in the mutation.
-func (m *SnapshotMutation) SandboxStartedAt() (r time.Time, exists bool) {
-	v := m.sandbox_started_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSandboxStartedAt returns the old "sandbox_started_at" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldSandboxStartedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSandboxStartedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSandboxStartedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSandboxStartedAt: %w", err)
-	}
-	return oldValue.SandboxStartedAt, nil
-}
-
-// ResetSandboxStartedAt resets all changes to the "sandbox_started_at" field.
-func (m *SnapshotMutation) ResetSandboxStartedAt() {
-	m.sandbox_started_at = nil
-}
-
-// SetEnvSecure sets the "env_secure" field.
-func (m *SnapshotMutation) SetEnvSecure(b bool) {
-	m.env_secure = &b
-}
-
-// EnvSecure returns the value of the "env_secure" field in the mutation.
-func (m *SnapshotMutation) EnvSecure() (r bool, exists bool) {
-	v := m.env_secure
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEnvSecure returns the old "env_secure" field's value of the Snapshot entity.
-// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SnapshotMutation) OldEnvSecure(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvSecure is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvSecure requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvSecure: %w", err)
-	}
-	return oldValue.EnvSecure, nil
-}
-
-// ResetEnvSecure resets all changes to the "env_secure" field.
-func (m *SnapshotMutation) ResetEnvSecure() {
-	m.env_secure = nil
-}
-
-// ClearEnv clears the "env" edge to the Env entity.
-func (m *SnapshotMutation) ClearEnv() {
-	m.clearedenv = true
-	m.clearedFields[snapshot.FieldEnvID] = struct{}{}
-}
-
-// EnvCleared reports if the "env" edge to the Env entity was cleared.
-func (m *SnapshotMutation) EnvCleared() bool {
-	return m.clearedenv
-}
-
-// EnvIDs returns the "env" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvID instead. It exists only for internal usage by the builders.
-func (m *SnapshotMutation) EnvIDs() (ids []string) {
-	if id := m.env; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetEnv resets all changes to the "env" edge.
-func (m *SnapshotMutation) ResetEnv() {
-	m.env = nil
-	m.clearedenv = false
-}
-
-// Where appends a list predicates to the SnapshotMutation builder.
-func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the SnapshotMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Snapshot, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *SnapshotMutation) Op() Op {
+func (m *EnvAliasMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SnapshotMutation) SetOp(op Op) {
+func (m *EnvAliasMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Snapshot).
-func (m *SnapshotMutation) Type() string {
+// Type returns the node type of this mutation (EnvAlias).
+func (m *EnvAliasMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SnapshotMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.created_at != nil {
-		fields = append(fields, snapshot.FieldCreatedAt)
-	}
-	if m.base_env_id != nil {
-		fields = append(fields, snapshot.FieldBaseEnvID)
-	}
+func (m *EnvAliasMutation) Fields() []string {
+	fields := make([]string, 0, 2)
 	if m.env != nil {
-		fields = append(fields, snapshot.FieldEnvID)
-	}
-	if m.sandbox_id != nil {
-		fields = append(fields, snapshot.FieldSandboxID)
-	}
-	if m.metadata != nil {
-		fields = append(fields, snapshot.FieldMetadata)
-	}
-	if m.sandbox_started_at != nil {
-		fields = append(fields, snapshot.FieldSandboxStartedAt)
+		fields = append(fields, envalias.FieldEnvID)
 	}
-	if m.env_secure != nil {
-		fields = append(fields, snapshot.FieldEnvSecure)
+	if m.is_renamable != nil {
+		fields = append(fields, envalias.FieldIsRenamable)
 	}
 	return fields
 }
@@ -5013,22 +4818,12 @@ func (m *SnapshotMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
+func (m *EnvAliasMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case snapshot.FieldCreatedAt:
-		return m.CreatedAt()
-	case snapshot.FieldBaseEnvID:
-		return m.BaseEnvID()
-	case snapshot.FieldEnvID:
+	case envalias.FieldEnvID:
 		return m.EnvID()
-	case snapshot.FieldSandboxID:
-		return m.SandboxID()
-	case snapshot.FieldMetadata:
-		return m.Metadata()
-	case snapshot.FieldSandboxStartedAt:
-		return m.SandboxStartedAt()
-	case snapshot.FieldEnvSecure:
-		return m.EnvSecure()
+	case envalias.FieldIsRenamable:
+		return m.IsRenamable()
 	}
 	return nil, false
 }
@@ -5036,168 +4831,108 @@ func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EnvAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case snapshot.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case snapshot.FieldBaseEnvID:
-		return m.OldBaseEnvID(ctx)
-	case snapshot.FieldEnvID:
+	case envalias.FieldEnvID:
 		return m.OldEnvID(ctx)
-	case snapshot.FieldSandboxID:
-		return m.OldSandboxID(ctx)
-	case snapshot.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case snapshot.FieldSandboxStartedAt:
-		return m.OldSandboxStartedAt(ctx)
-	case snapshot.FieldEnvSecure:
-		return m.OldEnvSecure(ctx)
+	case envalias.FieldIsRenamable:
+		return m.OldIsRenamable(ctx)
 	}
-	return nil, fmt.Errorf("unknown Snapshot field %s", name)
+	return nil, fmt.Errorf("unknown EnvAlias field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
+func (m *EnvAliasMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case snapshot.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case snapshot.FieldBaseEnvID:
+	case envalias.FieldEnvID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBaseEnvID(v)
+		m.SetEnvID(v)
 		return nil
-	case snapshot.FieldEnvID:
-		v, ok := value.(string)
+	case envalias.FieldIsRenamable:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvID(v)
-		return nil
-	case snapshot.FieldSandboxID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSandboxID(v)
-		return nil
-	case snapshot.FieldMetadata:
-		v, ok := value.(map[string]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
-	case snapshot.FieldSandboxStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSandboxStartedAt(v)
-		return nil
-	case snapshot.FieldEnvSecure:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnvSecure(v)
+		m.SetIsRenamable(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot field %s", name)
+	return fmt.Errorf("unknown EnvAlias field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SnapshotMutation) AddedFields() []string {
+func (m *EnvAliasMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EnvAliasMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
+func (m *EnvAliasMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Snapshot numeric field %s", name)
+	return fmt.Errorf("unknown EnvAlias numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SnapshotMutation) ClearedFields() []string {
+func (m *EnvAliasMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SnapshotMutation) FieldCleared(name string) bool {
+func (m *EnvAliasMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SnapshotMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Snapshot nullable field %s", name)
+func (m *EnvAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EnvAlias nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SnapshotMutation) ResetField(name string) error {
+func (m *EnvAliasMutation) ResetField(name string) error {
 	switch name {
-	case snapshot.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case snapshot.FieldBaseEnvID:
-		m.ResetBaseEnvID()
-		return nil
-	case snapshot.FieldEnvID:
+	case envalias.FieldEnvID:
 		m.ResetEnvID()
 		return nil
-	case snapshot.FieldSandboxID:
-		m.ResetSandboxID()
-		return nil
-	case snapshot.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case snapshot.FieldSandboxStartedAt:
-		m.ResetSandboxStartedAt()
-		return nil
-	case snapshot.FieldEnvSecure:
-		m.ResetEnvSecure()
+	case envalias.FieldIsRenamable:
+		m.ResetIsRenamable()
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot field %s", name)
+	return fmt.Errorf("unknown EnvAlias field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SnapshotMutation) AddedEdges() []string {
+func (m *EnvAliasMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.env != nil {
-		edges = append(edges, snapshot.EdgeEnv)
+		edges = append(edges, envalias.EdgeEnv)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
+func (m *EnvAliasMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case snapshot.EdgeEnv:
+	case envalias.EdgeEnv:
 		if id := m.env; id != nil {
 			return []ent.Value{*id}
 		}
@@ -5206,31 +4941,31 @@ func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SnapshotMutation) RemovedEdges() []string {
+func (m *EnvAliasMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
+func (m *EnvAliasMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SnapshotMutation) ClearedEdges() []string {
+func (m *EnvAliasMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedenv {
-		edges = append(edges, snapshot.EdgeEnv)
+		edges = append(edges, envalias.EdgeEnv)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SnapshotMutation) EdgeCleared(name string) bool {
+func (m *EnvAliasMutation) EdgeCleared(name string) bool {
 	switch name {
-	case snapshot.EdgeEnv:
+	case envalias.EdgeEnv:
 		return m.clearedenv
 	}
 	return false
@@ -5238,70 +4973,92 @@ func (m *SnapshotMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SnapshotMutation) ClearEdge(name string) error {
+func (m *EnvAliasMutation) ClearEdge(name string) error {
 	switch name {
-	case snapshot.EdgeEnv:
+	case envalias.EdgeEnv:
 		m.ClearEnv()
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot unique edge %s", name)
+	return fmt.Errorf("unknown EnvAlias unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SnapshotMutation) ResetEdge(name string) error {
+func (m *EnvAliasMutation) ResetEdge(name string) error {
 	switch name {
-	case snapshot.EdgeEnv:
+	case envalias.EdgeEnv:
 		m.ResetEnv()
 		return nil
 	}
-	return fmt.Errorf("unknown Snapshot edge %s", name)
+	return fmt.Errorf("unknown EnvAlias edge %s", name)
 }
 
-// TeamMutation represents an operation that mutates the Team nodes in the graph.
-type TeamMutation struct {
+// EnvBuildMutation represents an operation that mutates the EnvBuild nodes in the graph.
+type EnvBuildMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	created_at           *time.Time
-	is_banned            *bool
-	is_blocked           *bool
-	blocked_reason       *string
-	name                 *string
-	email                *string
-	cluster_id           *uuid.UUID
-	clearedFields        map[string]struct{}
-	users                map[uuid.UUID]struct{}
-	removedusers         map[uuid.UUID]struct{}
-	clearedusers         bool
-	team_api_keys        map[uuid.UUID]struct{}
-	removedteam_api_keys map[uuid.UUID]struct{}
-	clearedteam_api_keys bool
-	team_tier            *string
-	clearedteam_tier     bool
-	envs                 map[string]struct{}
-	removedenvs          map[string]struct{}
-	clearedenvs          bool
-	users_teams          map[int]struct{}
-	removedusers_teams   map[int]struct{}
-	clearedusers_teams   bool
-	done                 bool
-	oldValue             func(context.Context) (*Team, error)
-	predicates           []predicate.Team
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	finished_at                *time.Time
+	status                     *envbuild.Status
+	dockerfile                 *string
+	start_cmd                  *string
+	ready_cmd                  *string
+	vcpu                       *int64
+	addvcpu                    *int64
+	ram_mb                     *int64
+	addram_mb                  *int64
+	free_disk_size_mb          *int64
+	addfree_disk_size_mb       *int64
+	total_disk_size_mb         *int64
+	addtotal_disk_size_mb      *int64
+	kernel_version             *string
+	firecracker_version        *string
+	envd_version               *string
+	cluster_node_id            *string
+	network_bandwidth_mibps    *int64
+	addnetwork_bandwidth_mibps *int64
+	network_ops                *int64
+	addnetwork_ops             *int64
+	disk_bandwidth_mibps       *int64
+	adddisk_bandwidth_mibps    *int64
+	disk_iops                  *int64
+	adddisk_iops               *int64
+	memory_target_mb           *int64
+	addmemory_target_mb        *int64
+	priority                   *int64
+	addpriority                *int64
+	queued_at                  *time.Time
+	base_env_id                *string
+	checkpoint                 *bool
+	parent_build_id            *uuid.UUID
+	clearedFields              map[string]struct{}
+	env                        *string
+	clearedenv                 bool
+	checkpoints                map[uuid.UUID]struct{}
+	removedcheckpoints         map[uuid.UUID]struct{}
+	clearedcheckpoints         bool
+	tags                       map[uuid.UUID]struct{}
+	removedtags                map[uuid.UUID]struct{}
+	clearedtags                bool
+	done                       bool
+	oldValue                   func(context.Context) (*EnvBuild, error)
+	predicates                 []predicate.EnvBuild
 }
 
-var _ ent.Mutation = (*TeamMutation)(nil)
+var _ ent.Mutation = (*EnvBuildMutation)(nil)
 
-// teamOption allows management of the mutation configuration using functional options.
-type teamOption func(*TeamMutation)
+// envbuildOption allows management of the mutation configuration using functional options.
+type envbuildOption func(*EnvBuildMutation)
 
-// newTeamMutation creates new mutation for the Team entity.
-func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
-	m := &TeamMutation{
+// newEnvBuildMutation creates new mutation for the EnvBuild entity.
+func newEnvBuildMutation(c config, op Op, opts ...envbuildOption) *EnvBuildMutation {
+	m := &EnvBuildMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTeam,
+		typ:           TypeEnvBuild,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5310,20 +5067,20 @@ func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
 	return m
 }
 
-// withTeamID sets the ID field of the mutation.
-func withTeamID(id uuid.UUID) teamOption {
-	return func(m *TeamMutation) {
+// withEnvBuildID sets the ID field of the mutation.
+func withEnvBuildID(id uuid.UUID) envbuildOption {
+	return func(m *EnvBuildMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Team
+			value *EnvBuild
 		)
-		m.oldValue = func(ctx context.Context) (*Team, error) {
+		m.oldValue = func(ctx context.Context) (*EnvBuild, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Team.Get(ctx, id)
+					value, err = m.Client().EnvBuild.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5332,10 +5089,10 @@ func withTeamID(id uuid.UUID) teamOption {
 	}
 }
 
-// withTeam sets the old Team of the mutation.
-func withTeam(node *Team) teamOption {
-	return func(m *TeamMutation) {
-		m.oldValue = func(context.Context) (*Team, error) {
+// withEnvBuild sets the old EnvBuild of the mutation.
+func withEnvBuild(node *EnvBuild) envbuildOption {
+	return func(m *EnvBuildMutation) {
+		m.oldValue = func(context.Context) (*EnvBuild, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5344,7 +5101,7 @@ func withTeam(node *Team) teamOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamMutation) Client() *Client {
+func (m EnvBuildMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5352,7 +5109,7 @@ func (m TeamMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TeamMutation) Tx() (*Tx, error) {
+func (m EnvBuildMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -5362,14 +5119,14 @@ func (m TeamMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Team entities.
-func (m *TeamMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of EnvBuild entities.
+func (m *EnvBuildMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
+func (m *EnvBuildMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5380,7 +5137,7 @@ func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *EnvBuildMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5389,19 +5146,19 @@ func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EnvBuild.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TeamMutation) SetCreatedAt(t time.Time) {
+func (m *EnvBuildMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *EnvBuildMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5409,10 +5166,10 @@ func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvBuildMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5427,897 +5184,8748 @@ func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TeamMutation) ResetCreatedAt() {
+func (m *EnvBuildMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetIsBanned sets the "is_banned" field.
-func (m *TeamMutation) SetIsBanned(b bool) {
-	m.is_banned = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvBuildMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// IsBanned returns the value of the "is_banned" field in the mutation.
-func (m *TeamMutation) IsBanned() (r bool, exists bool) {
-	v := m.is_banned
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvBuildMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBanned returns the old "is_banned" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
+func (m *EnvBuildMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBanned requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.IsBanned, nil
-}
-
-// ClearIsBanned clears the value of the "is_banned" field.
-func (m *TeamMutation) ClearIsBanned() {
-	m.is_banned = nil
-	m.clearedFields[team.FieldIsBanned] = struct{}{}
-}
-
-// IsBannedCleared returns if the "is_banned" field was cleared in this mutation.
-func (m *TeamMutation) IsBannedCleared() bool {
-	_, ok := m.clearedFields[team.FieldIsBanned]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetIsBanned resets all changes to the "is_banned" field.
-func (m *TeamMutation) ResetIsBanned() {
-	m.is_banned = nil
-	delete(m.clearedFields, team.FieldIsBanned)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvBuildMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetIsBlocked sets the "is_blocked" field.
-func (m *TeamMutation) SetIsBlocked(b bool) {
-	m.is_blocked = &b
+// SetFinishedAt sets the "finished_at" field.
+func (m *EnvBuildMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// IsBlocked returns the value of the "is_blocked" field in the mutation.
-func (m *TeamMutation) IsBlocked() (r bool, exists bool) {
-	v := m.is_blocked
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *EnvBuildMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBlocked returns the old "is_blocked" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+func (m *EnvBuildMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.IsBlocked, nil
+	return oldValue.FinishedAt, nil
 }
 
-// ClearIsBlocked clears the value of the "is_blocked" field.
-func (m *TeamMutation) ClearIsBlocked() {
-	m.is_blocked = nil
-	m.clearedFields[team.FieldIsBlocked] = struct{}{}
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *EnvBuildMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[envbuild.FieldFinishedAt] = struct{}{}
 }
 
-// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
-func (m *TeamMutation) IsBlockedCleared() bool {
-	_, ok := m.clearedFields[team.FieldIsBlocked]
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *EnvBuildMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldFinishedAt]
 	return ok
 }
 
-// ResetIsBlocked resets all changes to the "is_blocked" field.
-func (m *TeamMutation) ResetIsBlocked() {
-	m.is_blocked = nil
-	delete(m.clearedFields, team.FieldIsBlocked)
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *EnvBuildMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, envbuild.FieldFinishedAt)
 }
 
-// SetBlockedReason sets the "blocked_reason" field.
-func (m *TeamMutation) SetBlockedReason(s string) {
-	m.blocked_reason = &s
+// SetEnvID sets the "env_id" field.
+func (m *EnvBuildMutation) SetEnvID(s string) {
+	m.env = &s
 }
 
-// BlockedReason returns the value of the "blocked_reason" field in the mutation.
-func (m *TeamMutation) BlockedReason() (r string, exists bool) {
-	v := m.blocked_reason
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvBuildMutation) EnvID() (r string, exists bool) {
+	v := m.env
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBlockedReason returns the old "blocked_reason" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldEnvID returns the old "env_id" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldBlockedReason(ctx context.Context) (v *string, err error) {
+func (m *EnvBuildMutation) OldEnvID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBlockedReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBlockedReason requires an ID field in the mutation")
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBlockedReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
 	}
-	return oldValue.BlockedReason, nil
+	return oldValue.EnvID, nil
 }
 
-// ClearBlockedReason clears the value of the "blocked_reason" field.
-func (m *TeamMutation) ClearBlockedReason() {
-	m.blocked_reason = nil
-	m.clearedFields[team.FieldBlockedReason] = struct{}{}
+// ClearEnvID clears the value of the "env_id" field.
+func (m *EnvBuildMutation) ClearEnvID() {
+	m.env = nil
+	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
 }
 
-// BlockedReasonCleared returns if the "blocked_reason" field was cleared in this mutation.
-func (m *TeamMutation) BlockedReasonCleared() bool {
-	_, ok := m.clearedFields[team.FieldBlockedReason]
+// EnvIDCleared returns if the "env_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) EnvIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldEnvID]
 	return ok
 }
 
-// ResetBlockedReason resets all changes to the "blocked_reason" field.
-func (m *TeamMutation) ResetBlockedReason() {
-	m.blocked_reason = nil
-	delete(m.clearedFields, team.FieldBlockedReason)
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvBuildMutation) ResetEnvID() {
+	m.env = nil
+	delete(m.clearedFields, envbuild.FieldEnvID)
 }
 
-// SetName sets the "name" field.
-func (m *TeamMutation) SetName(s string) {
-	m.name = &s
+// SetStatus sets the "status" field.
+func (m *EnvBuildMutation) SetStatus(e envbuild.Status) {
+	m.status = &e
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamMutation) Name() (r string, exists bool) {
-	v := m.name
+// Status returns the value of the "status" field in the mutation.
+func (m *EnvBuildMutation) Status() (r envbuild.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *EnvBuildMutation) OldStatus(ctx context.Context) (v envbuild.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Status, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TeamMutation) ResetName() {
-	m.name = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *EnvBuildMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetTier sets the "tier" field.
-func (m *TeamMutation) SetTier(s string) {
-	m.team_tier = &s
+// SetDockerfile sets the "dockerfile" field.
+func (m *EnvBuildMutation) SetDockerfile(s string) {
+	m.dockerfile = &s
 }
 
-// Tier returns the value of the "tier" field in the mutation.
-func (m *TeamMutation) Tier() (r string, exists bool) {
-	v := m.team_tier
+// Dockerfile returns the value of the "dockerfile" field in the mutation.
+func (m *EnvBuildMutation) Dockerfile() (r string, exists bool) {
+	v := m.dockerfile
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTier returns the old "tier" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldDockerfile returns the old "dockerfile" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldTier(ctx context.Context) (v string, err error) {
+func (m *EnvBuildMutation) OldDockerfile(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+		return v, errors.New("OldDockerfile is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTier requires an ID field in the mutation")
+		return v, errors.New("OldDockerfile requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+		return v, fmt.Errorf("querying old value for OldDockerfile: %w", err)
 	}
-	return oldValue.Tier, nil
+	return oldValue.Dockerfile, nil
 }
 
-// ResetTier resets all changes to the "tier" field.
-func (m *TeamMutation) ResetTier() {
-	m.team_tier = nil
+// ClearDockerfile clears the value of the "dockerfile" field.
+func (m *EnvBuildMutation) ClearDockerfile() {
+	m.dockerfile = nil
+	m.clearedFields[envbuild.FieldDockerfile] = struct{}{}
 }
 
-// SetEmail sets the "email" field.
-func (m *TeamMutation) SetEmail(s string) {
-	m.email = &s
+// DockerfileCleared returns if the "dockerfile" field was cleared in this mutation.
+func (m *EnvBuildMutation) DockerfileCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldDockerfile]
+	return ok
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *TeamMutation) Email() (r string, exists bool) {
-	v := m.email
+// ResetDockerfile resets all changes to the "dockerfile" field.
+func (m *EnvBuildMutation) ResetDockerfile() {
+	m.dockerfile = nil
+	delete(m.clearedFields, envbuild.FieldDockerfile)
+}
+
+// SetStartCmd sets the "start_cmd" field.
+func (m *EnvBuildMutation) SetStartCmd(s string) {
+	m.start_cmd = &s
+}
+
+// StartCmd returns the value of the "start_cmd" field in the mutation.
+func (m *EnvBuildMutation) StartCmd() (r string, exists bool) {
+	v := m.start_cmd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldStartCmd returns the old "start_cmd" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *EnvBuildMutation) OldStartCmd(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartCmd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldStartCmd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartCmd: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.StartCmd, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *TeamMutation) ResetEmail() {
-	m.email = nil
+// ClearStartCmd clears the value of the "start_cmd" field.
+func (m *EnvBuildMutation) ClearStartCmd() {
+	m.start_cmd = nil
+	m.clearedFields[envbuild.FieldStartCmd] = struct{}{}
 }
 
-// SetClusterID sets the "cluster_id" field.
-func (m *TeamMutation) SetClusterID(u uuid.UUID) {
-	m.cluster_id = &u
+// StartCmdCleared returns if the "start_cmd" field was cleared in this mutation.
+func (m *EnvBuildMutation) StartCmdCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldStartCmd]
+	return ok
 }
 
-// ClusterID returns the value of the "cluster_id" field in the mutation.
-func (m *TeamMutation) ClusterID() (r uuid.UUID, exists bool) {
-	v := m.cluster_id
+// ResetStartCmd resets all changes to the "start_cmd" field.
+func (m *EnvBuildMutation) ResetStartCmd() {
+	m.start_cmd = nil
+	delete(m.clearedFields, envbuild.FieldStartCmd)
+}
+
+// SetReadyCmd sets the "ready_cmd" field.
+func (m *EnvBuildMutation) SetReadyCmd(s string) {
+	m.ready_cmd = &s
+}
+
+// ReadyCmd returns the value of the "ready_cmd" field in the mutation.
+func (m *EnvBuildMutation) ReadyCmd() (r string, exists bool) {
+	v := m.ready_cmd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClusterID returns the old "cluster_id" field's value of the Team entity.
-// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// OldReadyCmd returns the old "ready_cmd" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamMutation) OldClusterID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *EnvBuildMutation) OldReadyCmd(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClusterID is only allowed on UpdateOne operations")
+		return v, errors.New("OldReadyCmd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClusterID requires an ID field in the mutation")
+		return v, errors.New("OldReadyCmd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClusterID: %w", err)
+		return v, fmt.Errorf("querying old value for OldReadyCmd: %w", err)
 	}
-	return oldValue.ClusterID, nil
+	return oldValue.ReadyCmd, nil
 }
 
-// ClearClusterID clears the value of the "cluster_id" field.
-func (m *TeamMutation) ClearClusterID() {
-	m.cluster_id = nil
-	m.clearedFields[team.FieldClusterID] = struct{}{}
+// ClearReadyCmd clears the value of the "ready_cmd" field.
+func (m *EnvBuildMutation) ClearReadyCmd() {
+	m.ready_cmd = nil
+	m.clearedFields[envbuild.FieldReadyCmd] = struct{}{}
 }
 
-// ClusterIDCleared returns if the "cluster_id" field was cleared in this mutation.
-func (m *TeamMutation) ClusterIDCleared() bool {
-	_, ok := m.clearedFields[team.FieldClusterID]
+// ReadyCmdCleared returns if the "ready_cmd" field was cleared in this mutation.
+func (m *EnvBuildMutation) ReadyCmdCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldReadyCmd]
 	return ok
 }
 
-// ResetClusterID resets all changes to the "cluster_id" field.
-func (m *TeamMutation) ResetClusterID() {
-	m.cluster_id = nil
-	delete(m.clearedFields, team.FieldClusterID)
+// ResetReadyCmd resets all changes to the "ready_cmd" field.
+func (m *EnvBuildMutation) ResetReadyCmd() {
+	m.ready_cmd = nil
+	delete(m.clearedFields, envbuild.FieldReadyCmd)
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *TeamMutation) AddUserIDs(ids ...uuid.UUID) {
-	if m.users == nil {
-		m.users = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUsers clears the "users" edge to the User entity.
-func (m *TeamMutation) ClearUsers() {
-	m.clearedusers = true
+// SetVcpu sets the "vcpu" field.
+func (m *EnvBuildMutation) SetVcpu(i int64) {
+	m.vcpu = &i
+	m.addvcpu = nil
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *TeamMutation) UsersCleared() bool {
-	return m.clearedusers
+// Vcpu returns the value of the "vcpu" field in the mutation.
+func (m *EnvBuildMutation) Vcpu() (r int64, exists bool) {
+	v := m.vcpu
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *TeamMutation) RemoveUserIDs(ids ...uuid.UUID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uuid.UUID]struct{})
+// OldVcpu returns the old "vcpu" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldVcpu(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVcpu is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVcpu requires an ID field in the mutation")
 	}
-}
-
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *TeamMutation) RemovedUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVcpu: %w", err)
 	}
-	return
+	return oldValue.Vcpu, nil
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *TeamMutation) UsersIDs() (ids []uuid.UUID) {
-	for id := range m.users {
-		ids = append(ids, id)
+// AddVcpu adds i to the "vcpu" field.
+func (m *EnvBuildMutation) AddVcpu(i int64) {
+	if m.addvcpu != nil {
+		*m.addvcpu += i
+	} else {
+		m.addvcpu = &i
 	}
-	return
-}
-
-// ResetUsers resets all changes to the "users" edge.
-func (m *TeamMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
 }
 
-// AddTeamAPIKeyIDs adds the "team_api_keys" edge to the TeamAPIKey entity by ids.
-func (m *TeamMutation) AddTeamAPIKeyIDs(ids ...uuid.UUID) {
-	if m.team_api_keys == nil {
-		m.team_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.team_api_keys[ids[i]] = struct{}{}
+// AddedVcpu returns the value that was added to the "vcpu" field in this mutation.
+func (m *EnvBuildMutation) AddedVcpu() (r int64, exists bool) {
+	v := m.addvcpu
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearTeamAPIKeys clears the "team_api_keys" edge to the TeamAPIKey entity.
-func (m *TeamMutation) ClearTeamAPIKeys() {
-	m.clearedteam_api_keys = true
+// ResetVcpu resets all changes to the "vcpu" field.
+func (m *EnvBuildMutation) ResetVcpu() {
+	m.vcpu = nil
+	m.addvcpu = nil
 }
 
-// TeamAPIKeysCleared reports if the "team_api_keys" edge to the TeamAPIKey entity was cleared.
-func (m *TeamMutation) TeamAPIKeysCleared() bool {
-	return m.clearedteam_api_keys
+// SetRAMMB sets the "ram_mb" field.
+func (m *EnvBuildMutation) SetRAMMB(i int64) {
+	m.ram_mb = &i
+	m.addram_mb = nil
 }
 
-// RemoveTeamAPIKeyIDs removes the "team_api_keys" edge to the TeamAPIKey entity by IDs.
-func (m *TeamMutation) RemoveTeamAPIKeyIDs(ids ...uuid.UUID) {
-	if m.removedteam_api_keys == nil {
-		m.removedteam_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.team_api_keys, ids[i])
-		m.removedteam_api_keys[ids[i]] = struct{}{}
+// RAMMB returns the value of the "ram_mb" field in the mutation.
+func (m *EnvBuildMutation) RAMMB() (r int64, exists bool) {
+	v := m.ram_mb
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTeamAPIKeys returns the removed IDs of the "team_api_keys" edge to the TeamAPIKey entity.
-func (m *TeamMutation) RemovedTeamAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.removedteam_api_keys {
-		ids = append(ids, id)
+// OldRAMMB returns the old "ram_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldRAMMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRAMMB is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// TeamAPIKeysIDs returns the "team_api_keys" edge IDs in the mutation.
-func (m *TeamMutation) TeamAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.team_api_keys {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRAMMB requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRAMMB: %w", err)
+	}
+	return oldValue.RAMMB, nil
 }
 
-// ResetTeamAPIKeys resets all changes to the "team_api_keys" edge.
-func (m *TeamMutation) ResetTeamAPIKeys() {
-	m.team_api_keys = nil
-	m.clearedteam_api_keys = false
-	m.removedteam_api_keys = nil
+// AddRAMMB adds i to the "ram_mb" field.
+func (m *EnvBuildMutation) AddRAMMB(i int64) {
+	if m.addram_mb != nil {
+		*m.addram_mb += i
+	} else {
+		m.addram_mb = &i
+	}
 }
 
-// SetTeamTierID sets the "team_tier" edge to the Tier entity by id.
-func (m *TeamMutation) SetTeamTierID(id string) {
-	m.team_tier = &id
+// AddedRAMMB returns the value that was added to the "ram_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedRAMMB() (r int64, exists bool) {
+	v := m.addram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearTeamTier clears the "team_tier" edge to the Tier entity.
-func (m *TeamMutation) ClearTeamTier() {
-	m.clearedteam_tier = true
-	m.clearedFields[team.FieldTier] = struct{}{}
+// ResetRAMMB resets all changes to the "ram_mb" field.
+func (m *EnvBuildMutation) ResetRAMMB() {
+	m.ram_mb = nil
+	m.addram_mb = nil
 }
 
-// TeamTierCleared reports if the "team_tier" edge to the Tier entity was cleared.
-func (m *TeamMutation) TeamTierCleared() bool {
-	return m.clearedteam_tier
+// SetFreeDiskSizeMB sets the "free_disk_size_mb" field.
+func (m *EnvBuildMutation) SetFreeDiskSizeMB(i int64) {
+	m.free_disk_size_mb = &i
+	m.addfree_disk_size_mb = nil
 }
 
-// TeamTierID returns the "team_tier" edge ID in the mutation.
-func (m *TeamMutation) TeamTierID() (id string, exists bool) {
-	if m.team_tier != nil {
-		return *m.team_tier, true
+// FreeDiskSizeMB returns the value of the "free_disk_size_mb" field in the mutation.
+func (m *EnvBuildMutation) FreeDiskSizeMB() (r int64, exists bool) {
+	v := m.free_disk_size_mb
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TeamTierIDs returns the "team_tier" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamTierID instead. It exists only for internal usage by the builders.
-func (m *TeamMutation) TeamTierIDs() (ids []string) {
-	if id := m.team_tier; id != nil {
-		ids = append(ids, *id)
+// OldFreeDiskSizeMB returns the old "free_disk_size_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldFreeDiskSizeMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFreeDiskSizeMB is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFreeDiskSizeMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFreeDiskSizeMB: %w", err)
+	}
+	return oldValue.FreeDiskSizeMB, nil
 }
 
-// ResetTeamTier resets all changes to the "team_tier" edge.
-func (m *TeamMutation) ResetTeamTier() {
-	m.team_tier = nil
-	m.clearedteam_tier = false
+// AddFreeDiskSizeMB adds i to the "free_disk_size_mb" field.
+func (m *EnvBuildMutation) AddFreeDiskSizeMB(i int64) {
+	if m.addfree_disk_size_mb != nil {
+		*m.addfree_disk_size_mb += i
+	} else {
+		m.addfree_disk_size_mb = &i
+	}
 }
 
-// AddEnvIDs adds the "envs" edge to the Env entity by ids.
-func (m *TeamMutation) AddEnvIDs(ids ...string) {
-	if m.envs == nil {
-		m.envs = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.envs[ids[i]] = struct{}{}
+// AddedFreeDiskSizeMB returns the value that was added to the "free_disk_size_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedFreeDiskSizeMB() (r int64, exists bool) {
+	v := m.addfree_disk_size_mb
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearEnvs clears the "envs" edge to the Env entity.
-func (m *TeamMutation) ClearEnvs() {
-	m.clearedenvs = true
+// ResetFreeDiskSizeMB resets all changes to the "free_disk_size_mb" field.
+func (m *EnvBuildMutation) ResetFreeDiskSizeMB() {
+	m.free_disk_size_mb = nil
+	m.addfree_disk_size_mb = nil
 }
 
-// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
-func (m *TeamMutation) EnvsCleared() bool {
-	return m.clearedenvs
+// SetTotalDiskSizeMB sets the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) SetTotalDiskSizeMB(i int64) {
+	m.total_disk_size_mb = &i
+	m.addtotal_disk_size_mb = nil
 }
 
-// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
-func (m *TeamMutation) RemoveEnvIDs(ids ...string) {
-	if m.removedenvs == nil {
-		m.removedenvs = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.envs, ids[i])
-		m.removedenvs[ids[i]] = struct{}{}
+// TotalDiskSizeMB returns the value of the "total_disk_size_mb" field in the mutation.
+func (m *EnvBuildMutation) TotalDiskSizeMB() (r int64, exists bool) {
+	v := m.total_disk_size_mb
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
-func (m *TeamMutation) RemovedEnvsIDs() (ids []string) {
-	for id := range m.removedenvs {
-		ids = append(ids, id)
+// OldTotalDiskSizeMB returns the old "total_disk_size_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldTotalDiskSizeMB(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalDiskSizeMB is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalDiskSizeMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalDiskSizeMB: %w", err)
+	}
+	return oldValue.TotalDiskSizeMB, nil
 }
 
-// EnvsIDs returns the "envs" edge IDs in the mutation.
-func (m *TeamMutation) EnvsIDs() (ids []string) {
-	for id := range m.envs {
-		ids = append(ids, id)
+// AddTotalDiskSizeMB adds i to the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) AddTotalDiskSizeMB(i int64) {
+	if m.addtotal_disk_size_mb != nil {
+		*m.addtotal_disk_size_mb += i
+	} else {
+		m.addtotal_disk_size_mb = &i
 	}
-	return
 }
 
-// ResetEnvs resets all changes to the "envs" edge.
-func (m *TeamMutation) ResetEnvs() {
-	m.envs = nil
-	m.clearedenvs = false
-	m.removedenvs = nil
+// AddedTotalDiskSizeMB returns the value that was added to the "total_disk_size_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedTotalDiskSizeMB() (r int64, exists bool) {
+	v := m.addtotal_disk_size_mb
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
-func (m *TeamMutation) AddUsersTeamIDs(ids ...int) {
-	if m.users_teams == nil {
-		m.users_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.users_teams[ids[i]] = struct{}{}
-	}
+// ClearTotalDiskSizeMB clears the value of the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) ClearTotalDiskSizeMB() {
+	m.total_disk_size_mb = nil
+	m.addtotal_disk_size_mb = nil
+	m.clearedFields[envbuild.FieldTotalDiskSizeMB] = struct{}{}
 }
 
-// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
-func (m *TeamMutation) ClearUsersTeams() {
-	m.clearedusers_teams = true
+// TotalDiskSizeMBCleared returns if the "total_disk_size_mb" field was cleared in this mutation.
+func (m *EnvBuildMutation) TotalDiskSizeMBCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldTotalDiskSizeMB]
+	return ok
 }
 
-// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
-func (m *TeamMutation) UsersTeamsCleared() bool {
-	return m.clearedusers_teams
+// ResetTotalDiskSizeMB resets all changes to the "total_disk_size_mb" field.
+func (m *EnvBuildMutation) ResetTotalDiskSizeMB() {
+	m.total_disk_size_mb = nil
+	m.addtotal_disk_size_mb = nil
+	delete(m.clearedFields, envbuild.FieldTotalDiskSizeMB)
 }
 
-// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
-func (m *TeamMutation) RemoveUsersTeamIDs(ids ...int) {
-	if m.removedusers_teams == nil {
-		m.removedusers_teams = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.users_teams, ids[i])
-		m.removedusers_teams[ids[i]] = struct{}{}
-	}
+// SetKernelVersion sets the "kernel_version" field.
+func (m *EnvBuildMutation) SetKernelVersion(s string) {
+	m.kernel_version = &s
 }
 
-// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
-func (m *TeamMutation) RemovedUsersTeamsIDs() (ids []int) {
-	for id := range m.removedusers_teams {
-		ids = append(ids, id)
+// KernelVersion returns the value of the "kernel_version" field in the mutation.
+func (m *EnvBuildMutation) KernelVersion() (r string, exists bool) {
+	v := m.kernel_version
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
-func (m *TeamMutation) UsersTeamsIDs() (ids []int) {
-	for id := range m.users_teams {
-		ids = append(ids, id)
+// OldKernelVersion returns the old "kernel_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldKernelVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKernelVersion is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKernelVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKernelVersion: %w", err)
+	}
+	return oldValue.KernelVersion, nil
 }
 
-// ResetUsersTeams resets all changes to the "users_teams" edge.
-func (m *TeamMutation) ResetUsersTeams() {
-	m.users_teams = nil
-	m.clearedusers_teams = false
-	m.removedusers_teams = nil
+// ResetKernelVersion resets all changes to the "kernel_version" field.
+func (m *EnvBuildMutation) ResetKernelVersion() {
+	m.kernel_version = nil
 }
 
-// Where appends a list predicates to the TeamMutation builder.
-func (m *TeamMutation) Where(ps ...predicate.Team) {
-	m.predicates = append(m.predicates, ps...)
+// SetFirecrackerVersion sets the "firecracker_version" field.
+func (m *EnvBuildMutation) SetFirecrackerVersion(s string) {
+	m.firecracker_version = &s
 }
 
-// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Team, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// FirecrackerVersion returns the value of the "firecracker_version" field in the mutation.
+func (m *EnvBuildMutation) FirecrackerVersion() (r string, exists bool) {
+	v := m.firecracker_version
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *TeamMutation) Op() Op {
-	return m.op
+// OldFirecrackerVersion returns the old "firecracker_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldFirecrackerVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirecrackerVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirecrackerVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirecrackerVersion: %w", err)
+	}
+	return oldValue.FirecrackerVersion, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TeamMutation) SetOp(op Op) {
-	m.op = op
+// ResetFirecrackerVersion resets all changes to the "firecracker_version" field.
+func (m *EnvBuildMutation) ResetFirecrackerVersion() {
+	m.firecracker_version = nil
 }
 
-// Type returns the node type of this mutation (Team).
-func (m *TeamMutation) Type() string {
-	return m.typ
+// SetEnvdVersion sets the "envd_version" field.
+func (m *EnvBuildMutation) SetEnvdVersion(s string) {
+	m.envd_version = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TeamMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.created_at != nil {
-		fields = append(fields, team.FieldCreatedAt)
+// EnvdVersion returns the value of the "envd_version" field in the mutation.
+func (m *EnvBuildMutation) EnvdVersion() (r string, exists bool) {
+	v := m.envd_version
+	if v == nil {
+		return
 	}
-	if m.is_banned != nil {
-		fields = append(fields, team.FieldIsBanned)
+	return *v, true
+}
+
+// OldEnvdVersion returns the old "envd_version" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldEnvdVersion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvdVersion is only allowed on UpdateOne operations")
 	}
-	if m.is_blocked != nil {
-		fields = append(fields, team.FieldIsBlocked)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvdVersion requires an ID field in the mutation")
 	}
-	if m.blocked_reason != nil {
-		fields = append(fields, team.FieldBlockedReason)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvdVersion: %w", err)
 	}
-	if m.name != nil {
-		fields = append(fields, team.FieldName)
+	return oldValue.EnvdVersion, nil
+}
+
+// ClearEnvdVersion clears the value of the "envd_version" field.
+func (m *EnvBuildMutation) ClearEnvdVersion() {
+	m.envd_version = nil
+	m.clearedFields[envbuild.FieldEnvdVersion] = struct{}{}
+}
+
+// EnvdVersionCleared returns if the "envd_version" field was cleared in this mutation.
+func (m *EnvBuildMutation) EnvdVersionCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldEnvdVersion]
+	return ok
+}
+
+// ResetEnvdVersion resets all changes to the "envd_version" field.
+func (m *EnvBuildMutation) ResetEnvdVersion() {
+	m.envd_version = nil
+	delete(m.clearedFields, envbuild.FieldEnvdVersion)
+}
+
+// SetClusterNodeID sets the "cluster_node_id" field.
+func (m *EnvBuildMutation) SetClusterNodeID(s string) {
+	m.cluster_node_id = &s
+}
+
+// ClusterNodeID returns the value of the "cluster_node_id" field in the mutation.
+func (m *EnvBuildMutation) ClusterNodeID() (r string, exists bool) {
+	v := m.cluster_node_id
+	if v == nil {
+		return
 	}
-	if m.team_tier != nil {
-		fields = append(fields, team.FieldTier)
+	return *v, true
+}
+
+// OldClusterNodeID returns the old "cluster_node_id" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldClusterNodeID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterNodeID is only allowed on UpdateOne operations")
 	}
-	if m.email != nil {
-		fields = append(fields, team.FieldEmail)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterNodeID requires an ID field in the mutation")
 	}
-	if m.cluster_id != nil {
-		fields = append(fields, team.FieldClusterID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterNodeID: %w", err)
 	}
-	return fields
+	return oldValue.ClusterNodeID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TeamMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case team.FieldCreatedAt:
-		return m.CreatedAt()
-	case team.FieldIsBanned:
-		return m.IsBanned()
-	case team.FieldIsBlocked:
-		return m.IsBlocked()
-	case team.FieldBlockedReason:
-		return m.BlockedReason()
-	case team.FieldName:
-		return m.Name()
-	case team.FieldTier:
-		return m.Tier()
-	case team.FieldEmail:
-		return m.Email()
-	case team.FieldClusterID:
-		return m.ClusterID()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case team.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case team.FieldIsBanned:
-		return m.OldIsBanned(ctx)
-	case team.FieldIsBlocked:
-		return m.OldIsBlocked(ctx)
-	case team.FieldBlockedReason:
-		return m.OldBlockedReason(ctx)
-	case team.FieldName:
-		return m.OldName(ctx)
-	case team.FieldTier:
-		return m.OldTier(ctx)
-	case team.FieldEmail:
-		return m.OldEmail(ctx)
-	case team.FieldClusterID:
-		return m.OldClusterID(ctx)
-	}
-	return nil, fmt.Errorf("unknown Team field %s", name)
+// ClearClusterNodeID clears the value of the "cluster_node_id" field.
+func (m *EnvBuildMutation) ClearClusterNodeID() {
+	m.cluster_node_id = nil
+	m.clearedFields[envbuild.FieldClusterNodeID] = struct{}{}
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TeamMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case team.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case team.FieldIsBanned:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBanned(v)
-		return nil
-	case team.FieldIsBlocked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBlocked(v)
-		return nil
-	case team.FieldBlockedReason:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBlockedReason(v)
-		return nil
-	case team.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case team.FieldTier:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTier(v)
-		return nil
-	case team.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case team.FieldClusterID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetClusterID(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Team field %s", name)
+// ClusterNodeIDCleared returns if the "cluster_node_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) ClusterNodeIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldClusterNodeID]
+	return ok
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TeamMutation) AddedFields() []string {
-	return nil
+// ResetClusterNodeID resets all changes to the "cluster_node_id" field.
+func (m *EnvBuildMutation) ResetClusterNodeID() {
+	m.cluster_node_id = nil
+	delete(m.clearedFields, envbuild.FieldClusterNodeID)
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (m *EnvBuildMutation) SetNetworkBandwidthMibps(i int64) {
+	m.network_bandwidth_mibps = &i
+	m.addnetwork_bandwidth_mibps = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TeamMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// NetworkBandwidthMibps returns the value of the "network_bandwidth_mibps" field in the mutation.
+func (m *EnvBuildMutation) NetworkBandwidthMibps() (r int64, exists bool) {
+	v := m.network_bandwidth_mibps
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Team numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TeamMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(team.FieldIsBanned) {
-		fields = append(fields, team.FieldIsBanned)
+// OldNetworkBandwidthMibps returns the old "network_bandwidth_mibps" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldNetworkBandwidthMibps(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetworkBandwidthMibps is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(team.FieldIsBlocked) {
-		fields = append(fields, team.FieldIsBlocked)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetworkBandwidthMibps requires an ID field in the mutation")
 	}
-	if m.FieldCleared(team.FieldBlockedReason) {
-		fields = append(fields, team.FieldBlockedReason)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetworkBandwidthMibps: %w", err)
 	}
-	if m.FieldCleared(team.FieldClusterID) {
-		fields = append(fields, team.FieldClusterID)
+	return oldValue.NetworkBandwidthMibps, nil
+}
+
+// AddNetworkBandwidthMibps adds i to the "network_bandwidth_mibps" field.
+func (m *EnvBuildMutation) AddNetworkBandwidthMibps(i int64) {
+	if m.addnetwork_bandwidth_mibps != nil {
+		*m.addnetwork_bandwidth_mibps += i
+	} else {
+		m.addnetwork_bandwidth_mibps = &i
 	}
-	return fields
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *TeamMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// AddedNetworkBandwidthMibps returns the value that was added to the "network_bandwidth_mibps" field in this mutation.
+func (m *EnvBuildMutation) AddedNetworkBandwidthMibps() (r int64, exists bool) {
+	v := m.addnetwork_bandwidth_mibps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNetworkBandwidthMibps clears the value of the "network_bandwidth_mibps" field.
+func (m *EnvBuildMutation) ClearNetworkBandwidthMibps() {
+	m.network_bandwidth_mibps = nil
+	m.addnetwork_bandwidth_mibps = nil
+	m.clearedFields[envbuild.FieldNetworkBandwidthMibps] = struct{}{}
+}
+
+// NetworkBandwidthMibpsCleared returns if the "network_bandwidth_mibps" field was cleared in this mutation.
+func (m *EnvBuildMutation) NetworkBandwidthMibpsCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldNetworkBandwidthMibps]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TeamMutation) ClearField(name string) error {
-	switch name {
-	case team.FieldIsBanned:
-		m.ClearIsBanned()
-		return nil
-	case team.FieldIsBlocked:
-		m.ClearIsBlocked()
-		return nil
-	case team.FieldBlockedReason:
-		m.ClearBlockedReason()
-		return nil
-	case team.FieldClusterID:
-		m.ClearClusterID()
-		return nil
-	}
-	return fmt.Errorf("unknown Team nullable field %s", name)
+// ResetNetworkBandwidthMibps resets all changes to the "network_bandwidth_mibps" field.
+func (m *EnvBuildMutation) ResetNetworkBandwidthMibps() {
+	m.network_bandwidth_mibps = nil
+	m.addnetwork_bandwidth_mibps = nil
+	delete(m.clearedFields, envbuild.FieldNetworkBandwidthMibps)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *TeamMutation) ResetField(name string) error {
-	switch name {
-	case team.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case team.FieldIsBanned:
-		m.ResetIsBanned()
-		return nil
-	case team.FieldIsBlocked:
-		m.ResetIsBlocked()
-		return nil
-	case team.FieldBlockedReason:
-		m.ResetBlockedReason()
-		return nil
-	case team.FieldName:
-		m.ResetName()
-		return nil
-	case team.FieldTier:
-		m.ResetTier()
-		return nil
-	case team.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case team.FieldClusterID:
-		m.ResetClusterID()
-		return nil
+// SetNetworkOps sets the "network_ops" field.
+func (m *EnvBuildMutation) SetNetworkOps(i int64) {
+	m.network_ops = &i
+	m.addnetwork_ops = nil
+}
+
+// NetworkOps returns the value of the "network_ops" field in the mutation.
+func (m *EnvBuildMutation) NetworkOps() (r int64, exists bool) {
+	v := m.network_ops
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Team field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.users != nil {
-		edges = append(edges, team.EdgeUsers)
+// OldNetworkOps returns the old "network_ops" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldNetworkOps(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetworkOps is only allowed on UpdateOne operations")
 	}
-	if m.team_api_keys != nil {
-		edges = append(edges, team.EdgeTeamAPIKeys)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetworkOps requires an ID field in the mutation")
 	}
-	if m.team_tier != nil {
-		edges = append(edges, team.EdgeTeamTier)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetworkOps: %w", err)
 	}
-	if m.envs != nil {
-		edges = append(edges, team.EdgeEnvs)
+	return oldValue.NetworkOps, nil
+}
+
+// AddNetworkOps adds i to the "network_ops" field.
+func (m *EnvBuildMutation) AddNetworkOps(i int64) {
+	if m.addnetwork_ops != nil {
+		*m.addnetwork_ops += i
+	} else {
+		m.addnetwork_ops = &i
 	}
-	if m.users_teams != nil {
-		edges = append(edges, team.EdgeUsersTeams)
+}
+
+// AddedNetworkOps returns the value that was added to the "network_ops" field in this mutation.
+func (m *EnvBuildMutation) AddedNetworkOps() (r int64, exists bool) {
+	v := m.addnetwork_ops
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+// ClearNetworkOps clears the value of the "network_ops" field.
+func (m *EnvBuildMutation) ClearNetworkOps() {
+	m.network_ops = nil
+	m.addnetwork_ops = nil
+	m.clearedFields[envbuild.FieldNetworkOps] = struct{}{}
+}
+
+// NetworkOpsCleared returns if the "network_ops" field was cleared in this mutation.
+func (m *EnvBuildMutation) NetworkOpsCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldNetworkOps]
+	return ok
+}
+
+// ResetNetworkOps resets all changes to the "network_ops" field.
+func (m *EnvBuildMutation) ResetNetworkOps() {
+	m.network_ops = nil
+	m.addnetwork_ops = nil
+	delete(m.clearedFields, envbuild.FieldNetworkOps)
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (m *EnvBuildMutation) SetDiskBandwidthMibps(i int64) {
+	m.disk_bandwidth_mibps = &i
+	m.adddisk_bandwidth_mibps = nil
+}
+
+// DiskBandwidthMibps returns the value of the "disk_bandwidth_mibps" field in the mutation.
+func (m *EnvBuildMutation) DiskBandwidthMibps() (r int64, exists bool) {
+	v := m.disk_bandwidth_mibps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiskBandwidthMibps returns the old "disk_bandwidth_mibps" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldDiskBandwidthMibps(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiskBandwidthMibps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiskBandwidthMibps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiskBandwidthMibps: %w", err)
+	}
+	return oldValue.DiskBandwidthMibps, nil
+}
+
+// AddDiskBandwidthMibps adds i to the "disk_bandwidth_mibps" field.
+func (m *EnvBuildMutation) AddDiskBandwidthMibps(i int64) {
+	if m.adddisk_bandwidth_mibps != nil {
+		*m.adddisk_bandwidth_mibps += i
+	} else {
+		m.adddisk_bandwidth_mibps = &i
+	}
+}
+
+// AddedDiskBandwidthMibps returns the value that was added to the "disk_bandwidth_mibps" field in this mutation.
+func (m *EnvBuildMutation) AddedDiskBandwidthMibps() (r int64, exists bool) {
+	v := m.adddisk_bandwidth_mibps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDiskBandwidthMibps clears the value of the "disk_bandwidth_mibps" field.
+func (m *EnvBuildMutation) ClearDiskBandwidthMibps() {
+	m.disk_bandwidth_mibps = nil
+	m.adddisk_bandwidth_mibps = nil
+	m.clearedFields[envbuild.FieldDiskBandwidthMibps] = struct{}{}
+}
+
+// DiskBandwidthMibpsCleared returns if the "disk_bandwidth_mibps" field was cleared in this mutation.
+func (m *EnvBuildMutation) DiskBandwidthMibpsCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldDiskBandwidthMibps]
+	return ok
+}
+
+// ResetDiskBandwidthMibps resets all changes to the "disk_bandwidth_mibps" field.
+func (m *EnvBuildMutation) ResetDiskBandwidthMibps() {
+	m.disk_bandwidth_mibps = nil
+	m.adddisk_bandwidth_mibps = nil
+	delete(m.clearedFields, envbuild.FieldDiskBandwidthMibps)
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (m *EnvBuildMutation) SetDiskIops(i int64) {
+	m.disk_iops = &i
+	m.adddisk_iops = nil
+}
+
+// DiskIops returns the value of the "disk_iops" field in the mutation.
+func (m *EnvBuildMutation) DiskIops() (r int64, exists bool) {
+	v := m.disk_iops
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiskIops returns the old "disk_iops" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldDiskIops(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiskIops is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiskIops requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiskIops: %w", err)
+	}
+	return oldValue.DiskIops, nil
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (m *EnvBuildMutation) AddDiskIops(i int64) {
+	if m.adddisk_iops != nil {
+		*m.adddisk_iops += i
+	} else {
+		m.adddisk_iops = &i
+	}
+}
+
+// AddedDiskIops returns the value that was added to the "disk_iops" field in this mutation.
+func (m *EnvBuildMutation) AddedDiskIops() (r int64, exists bool) {
+	v := m.adddisk_iops
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDiskIops clears the value of the "disk_iops" field.
+func (m *EnvBuildMutation) ClearDiskIops() {
+	m.disk_iops = nil
+	m.adddisk_iops = nil
+	m.clearedFields[envbuild.FieldDiskIops] = struct{}{}
+}
+
+// DiskIopsCleared returns if the "disk_iops" field was cleared in this mutation.
+func (m *EnvBuildMutation) DiskIopsCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldDiskIops]
+	return ok
+}
+
+// ResetDiskIops resets all changes to the "disk_iops" field.
+func (m *EnvBuildMutation) ResetDiskIops() {
+	m.disk_iops = nil
+	m.adddisk_iops = nil
+	delete(m.clearedFields, envbuild.FieldDiskIops)
+}
+
+// SetMemoryTargetMB sets the "memory_target_mb" field.
+func (m *EnvBuildMutation) SetMemoryTargetMB(i int64) {
+	m.memory_target_mb = &i
+	m.addmemory_target_mb = nil
+}
+
+// MemoryTargetMB returns the value of the "memory_target_mb" field in the mutation.
+func (m *EnvBuildMutation) MemoryTargetMB() (r int64, exists bool) {
+	v := m.memory_target_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemoryTargetMB returns the old "memory_target_mb" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldMemoryTargetMB(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemoryTargetMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemoryTargetMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemoryTargetMB: %w", err)
+	}
+	return oldValue.MemoryTargetMB, nil
+}
+
+// AddMemoryTargetMB adds i to the "memory_target_mb" field.
+func (m *EnvBuildMutation) AddMemoryTargetMB(i int64) {
+	if m.addmemory_target_mb != nil {
+		*m.addmemory_target_mb += i
+	} else {
+		m.addmemory_target_mb = &i
+	}
+}
+
+// AddedMemoryTargetMB returns the value that was added to the "memory_target_mb" field in this mutation.
+func (m *EnvBuildMutation) AddedMemoryTargetMB() (r int64, exists bool) {
+	v := m.addmemory_target_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMemoryTargetMB clears the value of the "memory_target_mb" field.
+func (m *EnvBuildMutation) ClearMemoryTargetMB() {
+	m.memory_target_mb = nil
+	m.addmemory_target_mb = nil
+	m.clearedFields[envbuild.FieldMemoryTargetMB] = struct{}{}
+}
+
+// MemoryTargetMBCleared returns if the "memory_target_mb" field was cleared in this mutation.
+func (m *EnvBuildMutation) MemoryTargetMBCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldMemoryTargetMB]
+	return ok
+}
+
+// ResetMemoryTargetMB resets all changes to the "memory_target_mb" field.
+func (m *EnvBuildMutation) ResetMemoryTargetMB() {
+	m.memory_target_mb = nil
+	m.addmemory_target_mb = nil
+	delete(m.clearedFields, envbuild.FieldMemoryTargetMB)
+}
+
+// SetPriority sets the "priority" field.
+func (m *EnvBuildMutation) SetPriority(i int64) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *EnvBuildMutation) Priority() (r int64, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldPriority(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *EnvBuildMutation) AddPriority(i int64) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *EnvBuildMutation) AddedPriority() (r int64, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *EnvBuildMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (m *EnvBuildMutation) SetQueuedAt(t time.Time) {
+	m.queued_at = &t
+}
+
+// QueuedAt returns the value of the "queued_at" field in the mutation.
+func (m *EnvBuildMutation) QueuedAt() (r time.Time, exists bool) {
+	v := m.queued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueuedAt returns the old "queued_at" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldQueuedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueuedAt: %w", err)
+	}
+	return oldValue.QueuedAt, nil
+}
+
+// ClearQueuedAt clears the value of the "queued_at" field.
+func (m *EnvBuildMutation) ClearQueuedAt() {
+	m.queued_at = nil
+	m.clearedFields[envbuild.FieldQueuedAt] = struct{}{}
+}
+
+// QueuedAtCleared returns if the "queued_at" field was cleared in this mutation.
+func (m *EnvBuildMutation) QueuedAtCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldQueuedAt]
+	return ok
+}
+
+// ResetQueuedAt resets all changes to the "queued_at" field.
+func (m *EnvBuildMutation) ResetQueuedAt() {
+	m.queued_at = nil
+	delete(m.clearedFields, envbuild.FieldQueuedAt)
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (m *EnvBuildMutation) SetBaseEnvID(s string) {
+	m.base_env_id = &s
+}
+
+// BaseEnvID returns the value of the "base_env_id" field in the mutation.
+func (m *EnvBuildMutation) BaseEnvID() (r string, exists bool) {
+	v := m.base_env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseEnvID returns the old "base_env_id" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldBaseEnvID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
+	}
+	return oldValue.BaseEnvID, nil
+}
+
+// ClearBaseEnvID clears the value of the "base_env_id" field.
+func (m *EnvBuildMutation) ClearBaseEnvID() {
+	m.base_env_id = nil
+	m.clearedFields[envbuild.FieldBaseEnvID] = struct{}{}
+}
+
+// BaseEnvIDCleared returns if the "base_env_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) BaseEnvIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldBaseEnvID]
+	return ok
+}
+
+// ResetBaseEnvID resets all changes to the "base_env_id" field.
+func (m *EnvBuildMutation) ResetBaseEnvID() {
+	m.base_env_id = nil
+	delete(m.clearedFields, envbuild.FieldBaseEnvID)
+}
+
+// SetCheckpoint sets the "checkpoint" field.
+func (m *EnvBuildMutation) SetCheckpoint(b bool) {
+	m.checkpoint = &b
+}
+
+// Checkpoint returns the value of the "checkpoint" field in the mutation.
+func (m *EnvBuildMutation) Checkpoint() (r bool, exists bool) {
+	v := m.checkpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckpoint returns the old "checkpoint" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldCheckpoint(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckpoint: %w", err)
+	}
+	return oldValue.Checkpoint, nil
+}
+
+// ResetCheckpoint resets all changes to the "checkpoint" field.
+func (m *EnvBuildMutation) ResetCheckpoint() {
+	m.checkpoint = nil
+}
+
+// SetParentBuildID sets the "parent_build_id" field.
+func (m *EnvBuildMutation) SetParentBuildID(u uuid.UUID) {
+	m.parent_build_id = &u
+}
+
+// ParentBuildID returns the value of the "parent_build_id" field in the mutation.
+func (m *EnvBuildMutation) ParentBuildID() (r uuid.UUID, exists bool) {
+	v := m.parent_build_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentBuildID returns the old "parent_build_id" field's value of the EnvBuild entity.
+// If the EnvBuild object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildMutation) OldParentBuildID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentBuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentBuildID: %w", err)
+	}
+	return oldValue.ParentBuildID, nil
+}
+
+// ClearParentBuildID clears the value of the "parent_build_id" field.
+func (m *EnvBuildMutation) ClearParentBuildID() {
+	m.parent_build_id = nil
+	m.clearedFields[envbuild.FieldParentBuildID] = struct{}{}
+}
+
+// ParentBuildIDCleared returns if the "parent_build_id" field was cleared in this mutation.
+func (m *EnvBuildMutation) ParentBuildIDCleared() bool {
+	_, ok := m.clearedFields[envbuild.FieldParentBuildID]
+	return ok
+}
+
+// ResetParentBuildID resets all changes to the "parent_build_id" field.
+func (m *EnvBuildMutation) ResetParentBuildID() {
+	m.parent_build_id = nil
+	delete(m.clearedFields, envbuild.FieldParentBuildID)
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvBuildMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envbuild.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvBuildMutation) EnvCleared() bool {
+	return m.EnvIDCleared() || m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvBuildMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvBuildMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// AddCheckpointIDs adds the "checkpoints" edge to the Checkpoint entity by ids.
+func (m *EnvBuildMutation) AddCheckpointIDs(ids ...uuid.UUID) {
+	if m.checkpoints == nil {
+		m.checkpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.checkpoints[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCheckpoints clears the "checkpoints" edge to the Checkpoint entity.
+func (m *EnvBuildMutation) ClearCheckpoints() {
+	m.clearedcheckpoints = true
+}
+
+// CheckpointsCleared reports if the "checkpoints" edge to the Checkpoint entity was cleared.
+func (m *EnvBuildMutation) CheckpointsCleared() bool {
+	return m.clearedcheckpoints
+}
+
+// RemoveCheckpointIDs removes the "checkpoints" edge to the Checkpoint entity by IDs.
+func (m *EnvBuildMutation) RemoveCheckpointIDs(ids ...uuid.UUID) {
+	if m.removedcheckpoints == nil {
+		m.removedcheckpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.checkpoints, ids[i])
+		m.removedcheckpoints[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCheckpoints returns the removed IDs of the "checkpoints" edge to the Checkpoint entity.
+func (m *EnvBuildMutation) RemovedCheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcheckpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CheckpointsIDs returns the "checkpoints" edge IDs in the mutation.
+func (m *EnvBuildMutation) CheckpointsIDs() (ids []uuid.UUID) {
+	for id := range m.checkpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCheckpoints resets all changes to the "checkpoints" edge.
+func (m *EnvBuildMutation) ResetCheckpoints() {
+	m.checkpoints = nil
+	m.clearedcheckpoints = false
+	m.removedcheckpoints = nil
+}
+
+// AddTagIDs adds the "tags" edge to the EnvBuildTag entity by ids.
+func (m *EnvBuildMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the EnvBuildTag entity.
+func (m *EnvBuildMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the EnvBuildTag entity was cleared.
+func (m *EnvBuildMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the EnvBuildTag entity by IDs.
+func (m *EnvBuildMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the EnvBuildTag entity.
+func (m *EnvBuildMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *EnvBuildMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *EnvBuildMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// Where appends a list predicates to the EnvBuildMutation builder.
+func (m *EnvBuildMutation) Where(ps ...predicate.EnvBuild) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvBuildMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvBuildMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvBuild, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvBuildMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvBuildMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvBuild).
+func (m *EnvBuildMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvBuildMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.created_at != nil {
+		fields = append(fields, envbuild.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, envbuild.FieldUpdatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, envbuild.FieldFinishedAt)
+	}
+	if m.env != nil {
+		fields = append(fields, envbuild.FieldEnvID)
+	}
+	if m.status != nil {
+		fields = append(fields, envbuild.FieldStatus)
+	}
+	if m.dockerfile != nil {
+		fields = append(fields, envbuild.FieldDockerfile)
+	}
+	if m.start_cmd != nil {
+		fields = append(fields, envbuild.FieldStartCmd)
+	}
+	if m.ready_cmd != nil {
+		fields = append(fields, envbuild.FieldReadyCmd)
+	}
+	if m.vcpu != nil {
+		fields = append(fields, envbuild.FieldVcpu)
+	}
+	if m.ram_mb != nil {
+		fields = append(fields, envbuild.FieldRAMMB)
+	}
+	if m.free_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	}
+	if m.total_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.kernel_version != nil {
+		fields = append(fields, envbuild.FieldKernelVersion)
+	}
+	if m.firecracker_version != nil {
+		fields = append(fields, envbuild.FieldFirecrackerVersion)
+	}
+	if m.envd_version != nil {
+		fields = append(fields, envbuild.FieldEnvdVersion)
+	}
+	if m.cluster_node_id != nil {
+		fields = append(fields, envbuild.FieldClusterNodeID)
+	}
+	if m.network_bandwidth_mibps != nil {
+		fields = append(fields, envbuild.FieldNetworkBandwidthMibps)
+	}
+	if m.network_ops != nil {
+		fields = append(fields, envbuild.FieldNetworkOps)
+	}
+	if m.disk_bandwidth_mibps != nil {
+		fields = append(fields, envbuild.FieldDiskBandwidthMibps)
+	}
+	if m.disk_iops != nil {
+		fields = append(fields, envbuild.FieldDiskIops)
+	}
+	if m.memory_target_mb != nil {
+		fields = append(fields, envbuild.FieldMemoryTargetMB)
+	}
+	if m.priority != nil {
+		fields = append(fields, envbuild.FieldPriority)
+	}
+	if m.queued_at != nil {
+		fields = append(fields, envbuild.FieldQueuedAt)
+	}
+	if m.base_env_id != nil {
+		fields = append(fields, envbuild.FieldBaseEnvID)
+	}
+	if m.checkpoint != nil {
+		fields = append(fields, envbuild.FieldCheckpoint)
+	}
+	if m.parent_build_id != nil {
+		fields = append(fields, envbuild.FieldParentBuildID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvBuildMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		return m.CreatedAt()
+	case envbuild.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case envbuild.FieldFinishedAt:
+		return m.FinishedAt()
+	case envbuild.FieldEnvID:
+		return m.EnvID()
+	case envbuild.FieldStatus:
+		return m.Status()
+	case envbuild.FieldDockerfile:
+		return m.Dockerfile()
+	case envbuild.FieldStartCmd:
+		return m.StartCmd()
+	case envbuild.FieldReadyCmd:
+		return m.ReadyCmd()
+	case envbuild.FieldVcpu:
+		return m.Vcpu()
+	case envbuild.FieldRAMMB:
+		return m.RAMMB()
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.FreeDiskSizeMB()
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.TotalDiskSizeMB()
+	case envbuild.FieldKernelVersion:
+		return m.KernelVersion()
+	case envbuild.FieldFirecrackerVersion:
+		return m.FirecrackerVersion()
+	case envbuild.FieldEnvdVersion:
+		return m.EnvdVersion()
+	case envbuild.FieldClusterNodeID:
+		return m.ClusterNodeID()
+	case envbuild.FieldNetworkBandwidthMibps:
+		return m.NetworkBandwidthMibps()
+	case envbuild.FieldNetworkOps:
+		return m.NetworkOps()
+	case envbuild.FieldDiskBandwidthMibps:
+		return m.DiskBandwidthMibps()
+	case envbuild.FieldDiskIops:
+		return m.DiskIops()
+	case envbuild.FieldMemoryTargetMB:
+		return m.MemoryTargetMB()
+	case envbuild.FieldPriority:
+		return m.Priority()
+	case envbuild.FieldQueuedAt:
+		return m.QueuedAt()
+	case envbuild.FieldBaseEnvID:
+		return m.BaseEnvID()
+	case envbuild.FieldCheckpoint:
+		return m.Checkpoint()
+	case envbuild.FieldParentBuildID:
+		return m.ParentBuildID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvBuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case envbuild.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case envbuild.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case envbuild.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envbuild.FieldStatus:
+		return m.OldStatus(ctx)
+	case envbuild.FieldDockerfile:
+		return m.OldDockerfile(ctx)
+	case envbuild.FieldStartCmd:
+		return m.OldStartCmd(ctx)
+	case envbuild.FieldReadyCmd:
+		return m.OldReadyCmd(ctx)
+	case envbuild.FieldVcpu:
+		return m.OldVcpu(ctx)
+	case envbuild.FieldRAMMB:
+		return m.OldRAMMB(ctx)
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.OldFreeDiskSizeMB(ctx)
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.OldTotalDiskSizeMB(ctx)
+	case envbuild.FieldKernelVersion:
+		return m.OldKernelVersion(ctx)
+	case envbuild.FieldFirecrackerVersion:
+		return m.OldFirecrackerVersion(ctx)
+	case envbuild.FieldEnvdVersion:
+		return m.OldEnvdVersion(ctx)
+	case envbuild.FieldClusterNodeID:
+		return m.OldClusterNodeID(ctx)
+	case envbuild.FieldNetworkBandwidthMibps:
+		return m.OldNetworkBandwidthMibps(ctx)
+	case envbuild.FieldNetworkOps:
+		return m.OldNetworkOps(ctx)
+	case envbuild.FieldDiskBandwidthMibps:
+		return m.OldDiskBandwidthMibps(ctx)
+	case envbuild.FieldDiskIops:
+		return m.OldDiskIops(ctx)
+	case envbuild.FieldMemoryTargetMB:
+		return m.OldMemoryTargetMB(ctx)
+	case envbuild.FieldPriority:
+		return m.OldPriority(ctx)
+	case envbuild.FieldQueuedAt:
+		return m.OldQueuedAt(ctx)
+	case envbuild.FieldBaseEnvID:
+		return m.OldBaseEnvID(ctx)
+	case envbuild.FieldCheckpoint:
+		return m.OldCheckpoint(ctx)
+	case envbuild.FieldParentBuildID:
+		return m.OldParentBuildID(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvBuild field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envbuild.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case envbuild.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case envbuild.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envbuild.FieldStatus:
+		v, ok := value.(envbuild.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case envbuild.FieldDockerfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDockerfile(v)
+		return nil
+	case envbuild.FieldStartCmd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartCmd(v)
+		return nil
+	case envbuild.FieldReadyCmd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadyCmd(v)
+		return nil
+	case envbuild.FieldVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVcpu(v)
+		return nil
+	case envbuild.FieldRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRAMMB(v)
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFreeDiskSizeMB(v)
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalDiskSizeMB(v)
+		return nil
+	case envbuild.FieldKernelVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKernelVersion(v)
+		return nil
+	case envbuild.FieldFirecrackerVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirecrackerVersion(v)
+		return nil
+	case envbuild.FieldEnvdVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvdVersion(v)
+		return nil
+	case envbuild.FieldClusterNodeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterNodeID(v)
+		return nil
+	case envbuild.FieldNetworkBandwidthMibps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetworkBandwidthMibps(v)
+		return nil
+	case envbuild.FieldNetworkOps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetworkOps(v)
+		return nil
+	case envbuild.FieldDiskBandwidthMibps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiskBandwidthMibps(v)
+		return nil
+	case envbuild.FieldDiskIops:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiskIops(v)
+		return nil
+	case envbuild.FieldMemoryTargetMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemoryTargetMB(v)
+		return nil
+	case envbuild.FieldPriority:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case envbuild.FieldQueuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueuedAt(v)
+		return nil
+	case envbuild.FieldBaseEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseEnvID(v)
+		return nil
+	case envbuild.FieldCheckpoint:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckpoint(v)
+		return nil
+	case envbuild.FieldParentBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentBuildID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvBuildMutation) AddedFields() []string {
+	var fields []string
+	if m.addvcpu != nil {
+		fields = append(fields, envbuild.FieldVcpu)
+	}
+	if m.addram_mb != nil {
+		fields = append(fields, envbuild.FieldRAMMB)
+	}
+	if m.addfree_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldFreeDiskSizeMB)
+	}
+	if m.addtotal_disk_size_mb != nil {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.addnetwork_bandwidth_mibps != nil {
+		fields = append(fields, envbuild.FieldNetworkBandwidthMibps)
+	}
+	if m.addnetwork_ops != nil {
+		fields = append(fields, envbuild.FieldNetworkOps)
+	}
+	if m.adddisk_bandwidth_mibps != nil {
+		fields = append(fields, envbuild.FieldDiskBandwidthMibps)
+	}
+	if m.adddisk_iops != nil {
+		fields = append(fields, envbuild.FieldDiskIops)
+	}
+	if m.addmemory_target_mb != nil {
+		fields = append(fields, envbuild.FieldMemoryTargetMB)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, envbuild.FieldPriority)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvBuildMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case envbuild.FieldVcpu:
+		return m.AddedVcpu()
+	case envbuild.FieldRAMMB:
+		return m.AddedRAMMB()
+	case envbuild.FieldFreeDiskSizeMB:
+		return m.AddedFreeDiskSizeMB()
+	case envbuild.FieldTotalDiskSizeMB:
+		return m.AddedTotalDiskSizeMB()
+	case envbuild.FieldNetworkBandwidthMibps:
+		return m.AddedNetworkBandwidthMibps()
+	case envbuild.FieldNetworkOps:
+		return m.AddedNetworkOps()
+	case envbuild.FieldDiskBandwidthMibps:
+		return m.AddedDiskBandwidthMibps()
+	case envbuild.FieldDiskIops:
+		return m.AddedDiskIops()
+	case envbuild.FieldMemoryTargetMB:
+		return m.AddedMemoryTargetMB()
+	case envbuild.FieldPriority:
+		return m.AddedPriority()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case envbuild.FieldVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVcpu(v)
+		return nil
+	case envbuild.FieldRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRAMMB(v)
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFreeDiskSizeMB(v)
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDiskSizeMB(v)
+		return nil
+	case envbuild.FieldNetworkBandwidthMibps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNetworkBandwidthMibps(v)
+		return nil
+	case envbuild.FieldNetworkOps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNetworkOps(v)
+		return nil
+	case envbuild.FieldDiskBandwidthMibps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskBandwidthMibps(v)
+		return nil
+	case envbuild.FieldDiskIops:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskIops(v)
+		return nil
+	case envbuild.FieldMemoryTargetMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMemoryTargetMB(v)
+		return nil
+	case envbuild.FieldPriority:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvBuildMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(envbuild.FieldFinishedAt) {
+		fields = append(fields, envbuild.FieldFinishedAt)
+	}
+	if m.FieldCleared(envbuild.FieldEnvID) {
+		fields = append(fields, envbuild.FieldEnvID)
+	}
+	if m.FieldCleared(envbuild.FieldDockerfile) {
+		fields = append(fields, envbuild.FieldDockerfile)
+	}
+	if m.FieldCleared(envbuild.FieldStartCmd) {
+		fields = append(fields, envbuild.FieldStartCmd)
+	}
+	if m.FieldCleared(envbuild.FieldReadyCmd) {
+		fields = append(fields, envbuild.FieldReadyCmd)
+	}
+	if m.FieldCleared(envbuild.FieldTotalDiskSizeMB) {
+		fields = append(fields, envbuild.FieldTotalDiskSizeMB)
+	}
+	if m.FieldCleared(envbuild.FieldEnvdVersion) {
+		fields = append(fields, envbuild.FieldEnvdVersion)
+	}
+	if m.FieldCleared(envbuild.FieldClusterNodeID) {
+		fields = append(fields, envbuild.FieldClusterNodeID)
+	}
+	if m.FieldCleared(envbuild.FieldNetworkBandwidthMibps) {
+		fields = append(fields, envbuild.FieldNetworkBandwidthMibps)
+	}
+	if m.FieldCleared(envbuild.FieldNetworkOps) {
+		fields = append(fields, envbuild.FieldNetworkOps)
+	}
+	if m.FieldCleared(envbuild.FieldDiskBandwidthMibps) {
+		fields = append(fields, envbuild.FieldDiskBandwidthMibps)
+	}
+	if m.FieldCleared(envbuild.FieldDiskIops) {
+		fields = append(fields, envbuild.FieldDiskIops)
+	}
+	if m.FieldCleared(envbuild.FieldMemoryTargetMB) {
+		fields = append(fields, envbuild.FieldMemoryTargetMB)
+	}
+	if m.FieldCleared(envbuild.FieldQueuedAt) {
+		fields = append(fields, envbuild.FieldQueuedAt)
+	}
+	if m.FieldCleared(envbuild.FieldBaseEnvID) {
+		fields = append(fields, envbuild.FieldBaseEnvID)
+	}
+	if m.FieldCleared(envbuild.FieldParentBuildID) {
+		fields = append(fields, envbuild.FieldParentBuildID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvBuildMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvBuildMutation) ClearField(name string) error {
+	switch name {
+	case envbuild.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case envbuild.FieldEnvID:
+		m.ClearEnvID()
+		return nil
+	case envbuild.FieldDockerfile:
+		m.ClearDockerfile()
+		return nil
+	case envbuild.FieldStartCmd:
+		m.ClearStartCmd()
+		return nil
+	case envbuild.FieldReadyCmd:
+		m.ClearReadyCmd()
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		m.ClearTotalDiskSizeMB()
+		return nil
+	case envbuild.FieldEnvdVersion:
+		m.ClearEnvdVersion()
+		return nil
+	case envbuild.FieldClusterNodeID:
+		m.ClearClusterNodeID()
+		return nil
+	case envbuild.FieldNetworkBandwidthMibps:
+		m.ClearNetworkBandwidthMibps()
+		return nil
+	case envbuild.FieldNetworkOps:
+		m.ClearNetworkOps()
+		return nil
+	case envbuild.FieldDiskBandwidthMibps:
+		m.ClearDiskBandwidthMibps()
+		return nil
+	case envbuild.FieldDiskIops:
+		m.ClearDiskIops()
+		return nil
+	case envbuild.FieldMemoryTargetMB:
+		m.ClearMemoryTargetMB()
+		return nil
+	case envbuild.FieldQueuedAt:
+		m.ClearQueuedAt()
+		return nil
+	case envbuild.FieldBaseEnvID:
+		m.ClearBaseEnvID()
+		return nil
+	case envbuild.FieldParentBuildID:
+		m.ClearParentBuildID()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvBuildMutation) ResetField(name string) error {
+	switch name {
+	case envbuild.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case envbuild.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case envbuild.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case envbuild.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envbuild.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case envbuild.FieldDockerfile:
+		m.ResetDockerfile()
+		return nil
+	case envbuild.FieldStartCmd:
+		m.ResetStartCmd()
+		return nil
+	case envbuild.FieldReadyCmd:
+		m.ResetReadyCmd()
+		return nil
+	case envbuild.FieldVcpu:
+		m.ResetVcpu()
+		return nil
+	case envbuild.FieldRAMMB:
+		m.ResetRAMMB()
+		return nil
+	case envbuild.FieldFreeDiskSizeMB:
+		m.ResetFreeDiskSizeMB()
+		return nil
+	case envbuild.FieldTotalDiskSizeMB:
+		m.ResetTotalDiskSizeMB()
+		return nil
+	case envbuild.FieldKernelVersion:
+		m.ResetKernelVersion()
+		return nil
+	case envbuild.FieldFirecrackerVersion:
+		m.ResetFirecrackerVersion()
+		return nil
+	case envbuild.FieldEnvdVersion:
+		m.ResetEnvdVersion()
+		return nil
+	case envbuild.FieldClusterNodeID:
+		m.ResetClusterNodeID()
+		return nil
+	case envbuild.FieldNetworkBandwidthMibps:
+		m.ResetNetworkBandwidthMibps()
+		return nil
+	case envbuild.FieldNetworkOps:
+		m.ResetNetworkOps()
+		return nil
+	case envbuild.FieldDiskBandwidthMibps:
+		m.ResetDiskBandwidthMibps()
+		return nil
+	case envbuild.FieldDiskIops:
+		m.ResetDiskIops()
+		return nil
+	case envbuild.FieldMemoryTargetMB:
+		m.ResetMemoryTargetMB()
+		return nil
+	case envbuild.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case envbuild.FieldQueuedAt:
+		m.ResetQueuedAt()
+		return nil
+	case envbuild.FieldBaseEnvID:
+		m.ResetBaseEnvID()
+		return nil
+	case envbuild.FieldCheckpoint:
+		m.ResetCheckpoint()
+		return nil
+	case envbuild.FieldParentBuildID:
+		m.ResetParentBuildID()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvBuildMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.env != nil {
+		edges = append(edges, envbuild.EdgeEnv)
+	}
+	if m.checkpoints != nil {
+		edges = append(edges, envbuild.EdgeCheckpoints)
+	}
+	if m.tags != nil {
+		edges = append(edges, envbuild.EdgeTags)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvBuildMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envbuild.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	case envbuild.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.checkpoints))
+		for id := range m.checkpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	case envbuild.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvBuildMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedcheckpoints != nil {
+		edges = append(edges, envbuild.EdgeCheckpoints)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, envbuild.EdgeTags)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvBuildMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case envbuild.EdgeCheckpoints:
+		ids := make([]ent.Value, 0, len(m.removedcheckpoints))
+		for id := range m.removedcheckpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	case envbuild.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvBuildMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedenv {
+		edges = append(edges, envbuild.EdgeEnv)
+	}
+	if m.clearedcheckpoints {
+		edges = append(edges, envbuild.EdgeCheckpoints)
+	}
+	if m.clearedtags {
+		edges = append(edges, envbuild.EdgeTags)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvBuildMutation) EdgeCleared(name string) bool {
+	switch name {
+	case envbuild.EdgeEnv:
+		return m.clearedenv
+	case envbuild.EdgeCheckpoints:
+		return m.clearedcheckpoints
+	case envbuild.EdgeTags:
+		return m.clearedtags
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvBuildMutation) ClearEdge(name string) error {
+	switch name {
+	case envbuild.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvBuildMutation) ResetEdge(name string) error {
+	switch name {
+	case envbuild.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	case envbuild.EdgeCheckpoints:
+		m.ResetCheckpoints()
+		return nil
+	case envbuild.EdgeTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuild edge %s", name)
+}
+
+// EnvBuildTagMutation represents an operation that mutates the EnvBuildTag nodes in the graph.
+type EnvBuildTagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	tag           *string
+	clearedFields map[string]struct{}
+	env           *string
+	clearedenv    bool
+	build         *uuid.UUID
+	clearedbuild  bool
+	done          bool
+	oldValue      func(context.Context) (*EnvBuildTag, error)
+	predicates    []predicate.EnvBuildTag
+}
+
+var _ ent.Mutation = (*EnvBuildTagMutation)(nil)
+
+// envbuildtagOption allows management of the mutation configuration using functional options.
+type envbuildtagOption func(*EnvBuildTagMutation)
+
+// newEnvBuildTagMutation creates new mutation for the EnvBuildTag entity.
+func newEnvBuildTagMutation(c config, op Op, opts ...envbuildtagOption) *EnvBuildTagMutation {
+	m := &EnvBuildTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnvBuildTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnvBuildTagID sets the ID field of the mutation.
+func withEnvBuildTagID(id uuid.UUID) envbuildtagOption {
+	return func(m *EnvBuildTagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnvBuildTag
+		)
+		m.oldValue = func(ctx context.Context) (*EnvBuildTag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnvBuildTag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnvBuildTag sets the old EnvBuildTag of the mutation.
+func withEnvBuildTag(node *EnvBuildTag) envbuildtagOption {
+	return func(m *EnvBuildTagMutation) {
+		m.oldValue = func(context.Context) (*EnvBuildTag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnvBuildTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnvBuildTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnvBuildTag entities.
+func (m *EnvBuildTagMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnvBuildTagMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnvBuildTagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnvBuildTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EnvBuildTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnvBuildTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EnvBuildTag entity.
+// If the EnvBuildTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnvBuildTagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EnvBuildTagMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EnvBuildTagMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EnvBuildTag entity.
+// If the EnvBuildTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildTagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EnvBuildTagMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *EnvBuildTagMutation) SetEnvID(s string) {
+	m.env = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *EnvBuildTagMutation) EnvID() (r string, exists bool) {
+	v := m.env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the EnvBuildTag entity.
+// If the EnvBuildTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildTagMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *EnvBuildTagMutation) ResetEnvID() {
+	m.env = nil
+}
+
+// SetTag sets the "tag" field.
+func (m *EnvBuildTagMutation) SetTag(s string) {
+	m.tag = &s
+}
+
+// Tag returns the value of the "tag" field in the mutation.
+func (m *EnvBuildTagMutation) Tag() (r string, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTag returns the old "tag" field's value of the EnvBuildTag entity.
+// If the EnvBuildTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildTagMutation) OldTag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
+	}
+	return oldValue.Tag, nil
+}
+
+// ResetTag resets all changes to the "tag" field.
+func (m *EnvBuildTagMutation) ResetTag() {
+	m.tag = nil
+}
+
+// SetBuildID sets the "build_id" field.
+func (m *EnvBuildTagMutation) SetBuildID(u uuid.UUID) {
+	m.build = &u
+}
+
+// BuildID returns the value of the "build_id" field in the mutation.
+func (m *EnvBuildTagMutation) BuildID() (r uuid.UUID, exists bool) {
+	v := m.build
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildID returns the old "build_id" field's value of the EnvBuildTag entity.
+// If the EnvBuildTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvBuildTagMutation) OldBuildID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildID: %w", err)
+	}
+	return oldValue.BuildID, nil
+}
+
+// ResetBuildID resets all changes to the "build_id" field.
+func (m *EnvBuildTagMutation) ResetBuildID() {
+	m.build = nil
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *EnvBuildTagMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[envbuildtag.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *EnvBuildTagMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *EnvBuildTagMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *EnvBuildTagMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// ClearBuild clears the "build" edge to the EnvBuild entity.
+func (m *EnvBuildTagMutation) ClearBuild() {
+	m.clearedbuild = true
+	m.clearedFields[envbuildtag.FieldBuildID] = struct{}{}
+}
+
+// BuildCleared reports if the "build" edge to the EnvBuild entity was cleared.
+func (m *EnvBuildTagMutation) BuildCleared() bool {
+	return m.clearedbuild
+}
+
+// BuildIDs returns the "build" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BuildID instead. It exists only for internal usage by the builders.
+func (m *EnvBuildTagMutation) BuildIDs() (ids []uuid.UUID) {
+	if id := m.build; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBuild resets all changes to the "build" edge.
+func (m *EnvBuildTagMutation) ResetBuild() {
+	m.build = nil
+	m.clearedbuild = false
+}
+
+// Where appends a list predicates to the EnvBuildTagMutation builder.
+func (m *EnvBuildTagMutation) Where(ps ...predicate.EnvBuildTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvBuildTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvBuildTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnvBuildTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvBuildTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvBuildTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnvBuildTag).
+func (m *EnvBuildTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvBuildTagMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, envbuildtag.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, envbuildtag.FieldUpdatedAt)
+	}
+	if m.env != nil {
+		fields = append(fields, envbuildtag.FieldEnvID)
+	}
+	if m.tag != nil {
+		fields = append(fields, envbuildtag.FieldTag)
+	}
+	if m.build != nil {
+		fields = append(fields, envbuildtag.FieldBuildID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnvBuildTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case envbuildtag.FieldCreatedAt:
+		return m.CreatedAt()
+	case envbuildtag.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case envbuildtag.FieldEnvID:
+		return m.EnvID()
+	case envbuildtag.FieldTag:
+		return m.Tag()
+	case envbuildtag.FieldBuildID:
+		return m.BuildID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnvBuildTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case envbuildtag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case envbuildtag.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case envbuildtag.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case envbuildtag.FieldTag:
+		return m.OldTag(ctx)
+	case envbuildtag.FieldBuildID:
+		return m.OldBuildID(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnvBuildTag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case envbuildtag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case envbuildtag.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case envbuildtag.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case envbuildtag.FieldTag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTag(v)
+		return nil
+	case envbuildtag.FieldBuildID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuildTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnvBuildTagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnvBuildTagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnvBuildTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EnvBuildTag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnvBuildTagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnvBuildTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnvBuildTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EnvBuildTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnvBuildTagMutation) ResetField(name string) error {
+	switch name {
+	case envbuildtag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case envbuildtag.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case envbuildtag.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case envbuildtag.FieldTag:
+		m.ResetTag()
+		return nil
+	case envbuildtag.FieldBuildID:
+		m.ResetBuildID()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuildTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnvBuildTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.env != nil {
+		edges = append(edges, envbuildtag.EdgeEnv)
+	}
+	if m.build != nil {
+		edges = append(edges, envbuildtag.EdgeBuild)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnvBuildTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case envbuildtag.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	case envbuildtag.EdgeBuild:
+		if id := m.build; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnvBuildTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnvBuildTagMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnvBuildTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedenv {
+		edges = append(edges, envbuildtag.EdgeEnv)
+	}
+	if m.clearedbuild {
+		edges = append(edges, envbuildtag.EdgeBuild)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnvBuildTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case envbuildtag.EdgeEnv:
+		return m.clearedenv
+	case envbuildtag.EdgeBuild:
+		return m.clearedbuild
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnvBuildTagMutation) ClearEdge(name string) error {
+	switch name {
+	case envbuildtag.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	case envbuildtag.EdgeBuild:
+		m.ClearBuild()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuildTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnvBuildTagMutation) ResetEdge(name string) error {
+	switch name {
+	case envbuildtag.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	case envbuildtag.EdgeBuild:
+		m.ResetBuild()
+		return nil
+	}
+	return fmt.Errorf("unknown EnvBuildTag edge %s", name)
+}
+
+// SnapshotMutation represents an operation that mutates the Snapshot nodes in the graph.
+type SnapshotMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	base_env_id        *string
+	sandbox_id         *string
+	metadata           *map[string]string
+	sandbox_started_at *time.Time
+	env_secure         *bool
+	network_policy     **types.SandboxNetworkPolicy
+	port_access        **types.SandboxPortAccess
+	paused_at          *time.Time
+	retention_hours    *int64
+	addretention_hours *int64
+	clearedFields      map[string]struct{}
+	env                *string
+	clearedenv         bool
+	done               bool
+	oldValue           func(context.Context) (*Snapshot, error)
+	predicates         []predicate.Snapshot
+}
+
+var _ ent.Mutation = (*SnapshotMutation)(nil)
+
+// snapshotOption allows management of the mutation configuration using functional options.
+type snapshotOption func(*SnapshotMutation)
+
+// newSnapshotMutation creates new mutation for the Snapshot entity.
+func newSnapshotMutation(c config, op Op, opts ...snapshotOption) *SnapshotMutation {
+	m := &SnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSnapshotID sets the ID field of the mutation.
+func withSnapshotID(id uuid.UUID) snapshotOption {
+	return func(m *SnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Snapshot
+		)
+		m.oldValue = func(ctx context.Context) (*Snapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Snapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSnapshot sets the old Snapshot of the mutation.
+func withSnapshot(node *Snapshot) snapshotOption {
+	return func(m *SnapshotMutation) {
+		m.oldValue = func(context.Context) (*Snapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Snapshot entities.
+func (m *SnapshotMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SnapshotMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Snapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetBaseEnvID sets the "base_env_id" field.
+func (m *SnapshotMutation) SetBaseEnvID(s string) {
+	m.base_env_id = &s
+}
+
+// BaseEnvID returns the value of the "base_env_id" field in the mutation.
+func (m *SnapshotMutation) BaseEnvID() (r string, exists bool) {
+	v := m.base_env_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseEnvID returns the old "base_env_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldBaseEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseEnvID: %w", err)
+	}
+	return oldValue.BaseEnvID, nil
+}
+
+// ResetBaseEnvID resets all changes to the "base_env_id" field.
+func (m *SnapshotMutation) ResetBaseEnvID() {
+	m.base_env_id = nil
+}
+
+// SetEnvID sets the "env_id" field.
+func (m *SnapshotMutation) SetEnvID(s string) {
+	m.env = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *SnapshotMutation) EnvID() (r string, exists bool) {
+	v := m.env
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvID returns the old "env_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
+}
+
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *SnapshotMutation) ResetEnvID() {
+	m.env = nil
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (m *SnapshotMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
+}
+
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *SnapshotMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSandboxID returns the old "sandbox_id" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldSandboxID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
+	}
+	return oldValue.SandboxID, nil
+}
+
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *SnapshotMutation) ResetSandboxID() {
+	m.sandbox_id = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SnapshotMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SnapshotMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SnapshotMutation) ResetMetadata() {
+	m.metadata = nil
+}
+
+// SetSandboxStartedAt sets the "sandbox_started_at" field.
+func (m *SnapshotMutation) SetSandboxStartedAt(t time.Time) {
+	m.sandbox_started_at = &t
+}
+
+// SandboxStartedAt returns the value of the "sandbox_started_at" field in the mutation.
+func (m *SnapshotMutation) SandboxStartedAt() (r time.Time, exists bool) {
+	v := m.sandbox_started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSandboxStartedAt returns the old "sandbox_started_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldSandboxStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxStartedAt: %w", err)
+	}
+	return oldValue.SandboxStartedAt, nil
+}
+
+// ResetSandboxStartedAt resets all changes to the "sandbox_started_at" field.
+func (m *SnapshotMutation) ResetSandboxStartedAt() {
+	m.sandbox_started_at = nil
+}
+
+// SetEnvSecure sets the "env_secure" field.
+func (m *SnapshotMutation) SetEnvSecure(b bool) {
+	m.env_secure = &b
+}
+
+// EnvSecure returns the value of the "env_secure" field in the mutation.
+func (m *SnapshotMutation) EnvSecure() (r bool, exists bool) {
+	v := m.env_secure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvSecure returns the old "env_secure" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldEnvSecure(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvSecure is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvSecure requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvSecure: %w", err)
+	}
+	return oldValue.EnvSecure, nil
+}
+
+// ResetEnvSecure resets all changes to the "env_secure" field.
+func (m *SnapshotMutation) ResetEnvSecure() {
+	m.env_secure = nil
+}
+
+// SetNetworkPolicy sets the "network_policy" field.
+func (m *SnapshotMutation) SetNetworkPolicy(tnp *types.SandboxNetworkPolicy) {
+	m.network_policy = &tnp
+}
+
+// NetworkPolicy returns the value of the "network_policy" field in the mutation.
+func (m *SnapshotMutation) NetworkPolicy() (r *types.SandboxNetworkPolicy, exists bool) {
+	v := m.network_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetworkPolicy returns the old "network_policy" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldNetworkPolicy(ctx context.Context) (v *types.SandboxNetworkPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetworkPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetworkPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetworkPolicy: %w", err)
+	}
+	return oldValue.NetworkPolicy, nil
+}
+
+// ClearNetworkPolicy clears the value of the "network_policy" field.
+func (m *SnapshotMutation) ClearNetworkPolicy() {
+	m.network_policy = nil
+	m.clearedFields[snapshot.FieldNetworkPolicy] = struct{}{}
+}
+
+// NetworkPolicyCleared returns if the "network_policy" field was cleared in this mutation.
+func (m *SnapshotMutation) NetworkPolicyCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldNetworkPolicy]
+	return ok
+}
+
+// ResetNetworkPolicy resets all changes to the "network_policy" field.
+func (m *SnapshotMutation) ResetNetworkPolicy() {
+	m.network_policy = nil
+	delete(m.clearedFields, snapshot.FieldNetworkPolicy)
+}
+
+// SetPortAccess sets the "port_access" field.
+func (m *SnapshotMutation) SetPortAccess(tpa *types.SandboxPortAccess) {
+	m.port_access = &tpa
+}
+
+// PortAccess returns the value of the "port_access" field in the mutation.
+func (m *SnapshotMutation) PortAccess() (r *types.SandboxPortAccess, exists bool) {
+	v := m.port_access
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPortAccess returns the old "port_access" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldPortAccess(ctx context.Context) (v *types.SandboxPortAccess, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPortAccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPortAccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPortAccess: %w", err)
+	}
+	return oldValue.PortAccess, nil
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (m *SnapshotMutation) ClearPortAccess() {
+	m.port_access = nil
+	m.clearedFields[snapshot.FieldPortAccess] = struct{}{}
+}
+
+// PortAccessCleared returns if the "port_access" field was cleared in this mutation.
+func (m *SnapshotMutation) PortAccessCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldPortAccess]
+	return ok
+}
+
+// ResetPortAccess resets all changes to the "port_access" field.
+func (m *SnapshotMutation) ResetPortAccess() {
+	m.port_access = nil
+	delete(m.clearedFields, snapshot.FieldPortAccess)
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (m *SnapshotMutation) SetPausedAt(t time.Time) {
+	m.paused_at = &t
+}
+
+// PausedAt returns the value of the "paused_at" field in the mutation.
+func (m *SnapshotMutation) PausedAt() (r time.Time, exists bool) {
+	v := m.paused_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPausedAt returns the old "paused_at" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldPausedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPausedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPausedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPausedAt: %w", err)
+	}
+	return oldValue.PausedAt, nil
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (m *SnapshotMutation) ClearPausedAt() {
+	m.paused_at = nil
+	m.clearedFields[snapshot.FieldPausedAt] = struct{}{}
+}
+
+// PausedAtCleared returns if the "paused_at" field was cleared in this mutation.
+func (m *SnapshotMutation) PausedAtCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldPausedAt]
+	return ok
+}
+
+// ResetPausedAt resets all changes to the "paused_at" field.
+func (m *SnapshotMutation) ResetPausedAt() {
+	m.paused_at = nil
+	delete(m.clearedFields, snapshot.FieldPausedAt)
+}
+
+// SetRetentionHours sets the "retention_hours" field.
+func (m *SnapshotMutation) SetRetentionHours(i int64) {
+	m.retention_hours = &i
+	m.addretention_hours = nil
+}
+
+// RetentionHours returns the value of the "retention_hours" field in the mutation.
+func (m *SnapshotMutation) RetentionHours() (r int64, exists bool) {
+	v := m.retention_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionHours returns the old "retention_hours" field's value of the Snapshot entity.
+// If the Snapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SnapshotMutation) OldRetentionHours(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionHours: %w", err)
+	}
+	return oldValue.RetentionHours, nil
+}
+
+// AddRetentionHours adds i to the "retention_hours" field.
+func (m *SnapshotMutation) AddRetentionHours(i int64) {
+	if m.addretention_hours != nil {
+		*m.addretention_hours += i
+	} else {
+		m.addretention_hours = &i
+	}
+}
+
+// AddedRetentionHours returns the value that was added to the "retention_hours" field in this mutation.
+func (m *SnapshotMutation) AddedRetentionHours() (r int64, exists bool) {
+	v := m.addretention_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetentionHours clears the value of the "retention_hours" field.
+func (m *SnapshotMutation) ClearRetentionHours() {
+	m.retention_hours = nil
+	m.addretention_hours = nil
+	m.clearedFields[snapshot.FieldRetentionHours] = struct{}{}
+}
+
+// RetentionHoursCleared returns if the "retention_hours" field was cleared in this mutation.
+func (m *SnapshotMutation) RetentionHoursCleared() bool {
+	_, ok := m.clearedFields[snapshot.FieldRetentionHours]
+	return ok
+}
+
+// ResetRetentionHours resets all changes to the "retention_hours" field.
+func (m *SnapshotMutation) ResetRetentionHours() {
+	m.retention_hours = nil
+	m.addretention_hours = nil
+	delete(m.clearedFields, snapshot.FieldRetentionHours)
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *SnapshotMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[snapshot.FieldEnvID] = struct{}{}
+}
+
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *SnapshotMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *SnapshotMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnv resets all changes to the "env" edge.
+func (m *SnapshotMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
+}
+
+// Where appends a list predicates to the SnapshotMutation builder.
+func (m *SnapshotMutation) Where(ps ...predicate.Snapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Snapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Snapshot).
+func (m *SnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, snapshot.FieldCreatedAt)
+	}
+	if m.base_env_id != nil {
+		fields = append(fields, snapshot.FieldBaseEnvID)
+	}
+	if m.env != nil {
+		fields = append(fields, snapshot.FieldEnvID)
+	}
+	if m.sandbox_id != nil {
+		fields = append(fields, snapshot.FieldSandboxID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, snapshot.FieldMetadata)
+	}
+	if m.sandbox_started_at != nil {
+		fields = append(fields, snapshot.FieldSandboxStartedAt)
+	}
+	if m.env_secure != nil {
+		fields = append(fields, snapshot.FieldEnvSecure)
+	}
+	if m.network_policy != nil {
+		fields = append(fields, snapshot.FieldNetworkPolicy)
+	}
+	if m.port_access != nil {
+		fields = append(fields, snapshot.FieldPortAccess)
+	}
+	if m.paused_at != nil {
+		fields = append(fields, snapshot.FieldPausedAt)
+	}
+	if m.retention_hours != nil {
+		fields = append(fields, snapshot.FieldRetentionHours)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case snapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	case snapshot.FieldBaseEnvID:
+		return m.BaseEnvID()
+	case snapshot.FieldEnvID:
+		return m.EnvID()
+	case snapshot.FieldSandboxID:
+		return m.SandboxID()
+	case snapshot.FieldMetadata:
+		return m.Metadata()
+	case snapshot.FieldSandboxStartedAt:
+		return m.SandboxStartedAt()
+	case snapshot.FieldEnvSecure:
+		return m.EnvSecure()
+	case snapshot.FieldNetworkPolicy:
+		return m.NetworkPolicy()
+	case snapshot.FieldPortAccess:
+		return m.PortAccess()
+	case snapshot.FieldPausedAt:
+		return m.PausedAt()
+	case snapshot.FieldRetentionHours:
+		return m.RetentionHours()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case snapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case snapshot.FieldBaseEnvID:
+		return m.OldBaseEnvID(ctx)
+	case snapshot.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case snapshot.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case snapshot.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case snapshot.FieldSandboxStartedAt:
+		return m.OldSandboxStartedAt(ctx)
+	case snapshot.FieldEnvSecure:
+		return m.OldEnvSecure(ctx)
+	case snapshot.FieldNetworkPolicy:
+		return m.OldNetworkPolicy(ctx)
+	case snapshot.FieldPortAccess:
+		return m.OldPortAccess(ctx)
+	case snapshot.FieldPausedAt:
+		return m.OldPausedAt(ctx)
+	case snapshot.FieldRetentionHours:
+		return m.OldRetentionHours(ctx)
+	}
+	return nil, fmt.Errorf("unknown Snapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case snapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case snapshot.FieldBaseEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseEnvID(v)
+		return nil
+	case snapshot.FieldEnvID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvID(v)
+		return nil
+	case snapshot.FieldSandboxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxID(v)
+		return nil
+	case snapshot.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case snapshot.FieldSandboxStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxStartedAt(v)
+		return nil
+	case snapshot.FieldEnvSecure:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvSecure(v)
+		return nil
+	case snapshot.FieldNetworkPolicy:
+		v, ok := value.(*types.SandboxNetworkPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetworkPolicy(v)
+		return nil
+	case snapshot.FieldPortAccess:
+		v, ok := value.(*types.SandboxPortAccess)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPortAccess(v)
+		return nil
+	case snapshot.FieldPausedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPausedAt(v)
+		return nil
+	case snapshot.FieldRetentionHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionHours(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SnapshotMutation) AddedFields() []string {
+	var fields []string
+	if m.addretention_hours != nil {
+		fields = append(fields, snapshot.FieldRetentionHours)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case snapshot.FieldRetentionHours:
+		return m.AddedRetentionHours()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case snapshot.FieldRetentionHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetentionHours(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SnapshotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(snapshot.FieldNetworkPolicy) {
+		fields = append(fields, snapshot.FieldNetworkPolicy)
+	}
+	if m.FieldCleared(snapshot.FieldPortAccess) {
+		fields = append(fields, snapshot.FieldPortAccess)
+	}
+	if m.FieldCleared(snapshot.FieldPausedAt) {
+		fields = append(fields, snapshot.FieldPausedAt)
+	}
+	if m.FieldCleared(snapshot.FieldRetentionHours) {
+		fields = append(fields, snapshot.FieldRetentionHours)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SnapshotMutation) ClearField(name string) error {
+	switch name {
+	case snapshot.FieldNetworkPolicy:
+		m.ClearNetworkPolicy()
+		return nil
+	case snapshot.FieldPortAccess:
+		m.ClearPortAccess()
+		return nil
+	case snapshot.FieldPausedAt:
+		m.ClearPausedAt()
+		return nil
+	case snapshot.FieldRetentionHours:
+		m.ClearRetentionHours()
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SnapshotMutation) ResetField(name string) error {
+	switch name {
+	case snapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case snapshot.FieldBaseEnvID:
+		m.ResetBaseEnvID()
+		return nil
+	case snapshot.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case snapshot.FieldSandboxID:
+		m.ResetSandboxID()
+		return nil
+	case snapshot.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case snapshot.FieldSandboxStartedAt:
+		m.ResetSandboxStartedAt()
+		return nil
+	case snapshot.FieldEnvSecure:
+		m.ResetEnvSecure()
+		return nil
+	case snapshot.FieldNetworkPolicy:
+		m.ResetNetworkPolicy()
+		return nil
+	case snapshot.FieldPortAccess:
+		m.ResetPortAccess()
+		return nil
+	case snapshot.FieldPausedAt:
+		m.ResetPausedAt()
+		return nil
+	case snapshot.FieldRetentionHours:
+		m.ResetRetentionHours()
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.env != nil {
+		edges = append(edges, snapshot.EdgeEnv)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SnapshotMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case snapshot.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SnapshotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedenv {
+		edges = append(edges, snapshot.EdgeEnv)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SnapshotMutation) EdgeCleared(name string) bool {
+	switch name {
+	case snapshot.EdgeEnv:
+		return m.clearedenv
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SnapshotMutation) ClearEdge(name string) error {
+	switch name {
+	case snapshot.EdgeEnv:
+		m.ClearEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SnapshotMutation) ResetEdge(name string) error {
+	switch name {
+	case snapshot.EdgeEnv:
+		m.ResetEnv()
+		return nil
+	}
+	return fmt.Errorf("unknown Snapshot edge %s", name)
+}
+
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	created_at           *time.Time
+	is_banned            *bool
+	is_blocked           *bool
+	blocked_reason       *string
+	name                 *string
+	email                *string
+	cluster_id           *uuid.UUID
+	clearedFields        map[string]struct{}
+	users                map[uuid.UUID]struct{}
+	removedusers         map[uuid.UUID]struct{}
+	clearedusers         bool
+	team_api_keys        map[uuid.UUID]struct{}
+	removedteam_api_keys map[uuid.UUID]struct{}
+	clearedteam_api_keys bool
+	team_tier            *string
+	clearedteam_tier     bool
+	envs                 map[string]struct{}
+	removedenvs          map[string]struct{}
+	clearedenvs          bool
+	warm_pools           map[uuid.UUID]struct{}
+	removedwarm_pools    map[uuid.UUID]struct{}
+	clearedwarm_pools    bool
+	webhooks             map[uuid.UUID]struct{}
+	removedwebhooks      map[uuid.UUID]struct{}
+	clearedwebhooks      bool
+	users_teams          map[int]struct{}
+	removedusers_teams   map[int]struct{}
+	clearedusers_teams   bool
+	done                 bool
+	oldValue             func(context.Context) (*Team, error)
+	predicates           []predicate.Team
+}
+
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeam,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id uuid.UUID) teamOption {
+	return func(m *TeamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Team
+		)
+		m.oldValue = func(ctx context.Context) (*Team, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Team.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Team entities.
+func (m *TeamMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetIsBanned sets the "is_banned" field.
+func (m *TeamMutation) SetIsBanned(b bool) {
+	m.is_banned = &b
+}
+
+// IsBanned returns the value of the "is_banned" field in the mutation.
+func (m *TeamMutation) IsBanned() (r bool, exists bool) {
+	v := m.is_banned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBanned returns the old "is_banned" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBanned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
+	}
+	return oldValue.IsBanned, nil
+}
+
+// ClearIsBanned clears the value of the "is_banned" field.
+func (m *TeamMutation) ClearIsBanned() {
+	m.is_banned = nil
+	m.clearedFields[team.FieldIsBanned] = struct{}{}
+}
+
+// IsBannedCleared returns if the "is_banned" field was cleared in this mutation.
+func (m *TeamMutation) IsBannedCleared() bool {
+	_, ok := m.clearedFields[team.FieldIsBanned]
+	return ok
+}
+
+// ResetIsBanned resets all changes to the "is_banned" field.
+func (m *TeamMutation) ResetIsBanned() {
+	m.is_banned = nil
+	delete(m.clearedFields, team.FieldIsBanned)
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (m *TeamMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
+}
+
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *TeamMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBlocked returns the old "is_blocked" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+	}
+	return oldValue.IsBlocked, nil
+}
+
+// ClearIsBlocked clears the value of the "is_blocked" field.
+func (m *TeamMutation) ClearIsBlocked() {
+	m.is_blocked = nil
+	m.clearedFields[team.FieldIsBlocked] = struct{}{}
+}
+
+// IsBlockedCleared returns if the "is_blocked" field was cleared in this mutation.
+func (m *TeamMutation) IsBlockedCleared() bool {
+	_, ok := m.clearedFields[team.FieldIsBlocked]
+	return ok
+}
+
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *TeamMutation) ResetIsBlocked() {
+	m.is_blocked = nil
+	delete(m.clearedFields, team.FieldIsBlocked)
+}
+
+// SetBlockedReason sets the "blocked_reason" field.
+func (m *TeamMutation) SetBlockedReason(s string) {
+	m.blocked_reason = &s
+}
+
+// BlockedReason returns the value of the "blocked_reason" field in the mutation.
+func (m *TeamMutation) BlockedReason() (r string, exists bool) {
+	v := m.blocked_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockedReason returns the old "blocked_reason" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldBlockedReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockedReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockedReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockedReason: %w", err)
+	}
+	return oldValue.BlockedReason, nil
+}
+
+// ClearBlockedReason clears the value of the "blocked_reason" field.
+func (m *TeamMutation) ClearBlockedReason() {
+	m.blocked_reason = nil
+	m.clearedFields[team.FieldBlockedReason] = struct{}{}
+}
+
+// BlockedReasonCleared returns if the "blocked_reason" field was cleared in this mutation.
+func (m *TeamMutation) BlockedReasonCleared() bool {
+	_, ok := m.clearedFields[team.FieldBlockedReason]
+	return ok
+}
+
+// ResetBlockedReason resets all changes to the "blocked_reason" field.
+func (m *TeamMutation) ResetBlockedReason() {
+	m.blocked_reason = nil
+	delete(m.clearedFields, team.FieldBlockedReason)
+}
+
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTier sets the "tier" field.
+func (m *TeamMutation) SetTier(s string) {
+	m.team_tier = &s
+}
+
+// Tier returns the value of the "tier" field in the mutation.
+func (m *TeamMutation) Tier() (r string, exists bool) {
+	v := m.team_tier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTier returns the old "tier" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldTier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
+	}
+	return oldValue.Tier, nil
+}
+
+// ResetTier resets all changes to the "tier" field.
+func (m *TeamMutation) ResetTier() {
+	m.team_tier = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *TeamMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *TeamMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *TeamMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (m *TeamMutation) SetClusterID(u uuid.UUID) {
+	m.cluster_id = &u
+}
+
+// ClusterID returns the value of the "cluster_id" field in the mutation.
+func (m *TeamMutation) ClusterID() (r uuid.UUID, exists bool) {
+	v := m.cluster_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClusterID returns the old "cluster_id" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldClusterID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClusterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClusterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClusterID: %w", err)
+	}
+	return oldValue.ClusterID, nil
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (m *TeamMutation) ClearClusterID() {
+	m.cluster_id = nil
+	m.clearedFields[team.FieldClusterID] = struct{}{}
+}
+
+// ClusterIDCleared returns if the "cluster_id" field was cleared in this mutation.
+func (m *TeamMutation) ClusterIDCleared() bool {
+	_, ok := m.clearedFields[team.FieldClusterID]
+	return ok
+}
+
+// ResetClusterID resets all changes to the "cluster_id" field.
+func (m *TeamMutation) ResetClusterID() {
+	m.cluster_id = nil
+	delete(m.clearedFields, team.FieldClusterID)
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *TeamMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *TeamMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *TeamMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *TeamMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *TeamMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *TeamMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *TeamMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddTeamAPIKeyIDs adds the "team_api_keys" edge to the TeamAPIKey entity by ids.
+func (m *TeamMutation) AddTeamAPIKeyIDs(ids ...uuid.UUID) {
+	if m.team_api_keys == nil {
+		m.team_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.team_api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeamAPIKeys clears the "team_api_keys" edge to the TeamAPIKey entity.
+func (m *TeamMutation) ClearTeamAPIKeys() {
+	m.clearedteam_api_keys = true
+}
+
+// TeamAPIKeysCleared reports if the "team_api_keys" edge to the TeamAPIKey entity was cleared.
+func (m *TeamMutation) TeamAPIKeysCleared() bool {
+	return m.clearedteam_api_keys
+}
+
+// RemoveTeamAPIKeyIDs removes the "team_api_keys" edge to the TeamAPIKey entity by IDs.
+func (m *TeamMutation) RemoveTeamAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedteam_api_keys == nil {
+		m.removedteam_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.team_api_keys, ids[i])
+		m.removedteam_api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeamAPIKeys returns the removed IDs of the "team_api_keys" edge to the TeamAPIKey entity.
+func (m *TeamMutation) RemovedTeamAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedteam_api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamAPIKeysIDs returns the "team_api_keys" edge IDs in the mutation.
+func (m *TeamMutation) TeamAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.team_api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeamAPIKeys resets all changes to the "team_api_keys" edge.
+func (m *TeamMutation) ResetTeamAPIKeys() {
+	m.team_api_keys = nil
+	m.clearedteam_api_keys = false
+	m.removedteam_api_keys = nil
+}
+
+// SetTeamTierID sets the "team_tier" edge to the Tier entity by id.
+func (m *TeamMutation) SetTeamTierID(id string) {
+	m.team_tier = &id
+}
+
+// ClearTeamTier clears the "team_tier" edge to the Tier entity.
+func (m *TeamMutation) ClearTeamTier() {
+	m.clearedteam_tier = true
+	m.clearedFields[team.FieldTier] = struct{}{}
+}
+
+// TeamTierCleared reports if the "team_tier" edge to the Tier entity was cleared.
+func (m *TeamMutation) TeamTierCleared() bool {
+	return m.clearedteam_tier
+}
+
+// TeamTierID returns the "team_tier" edge ID in the mutation.
+func (m *TeamMutation) TeamTierID() (id string, exists bool) {
+	if m.team_tier != nil {
+		return *m.team_tier, true
+	}
+	return
+}
+
+// TeamTierIDs returns the "team_tier" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamTierID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) TeamTierIDs() (ids []string) {
+	if id := m.team_tier; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeamTier resets all changes to the "team_tier" edge.
+func (m *TeamMutation) ResetTeamTier() {
+	m.team_tier = nil
+	m.clearedteam_tier = false
+}
+
+// AddEnvIDs adds the "envs" edge to the Env entity by ids.
+func (m *TeamMutation) AddEnvIDs(ids ...string) {
+	if m.envs == nil {
+		m.envs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.envs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnvs clears the "envs" edge to the Env entity.
+func (m *TeamMutation) ClearEnvs() {
+	m.clearedenvs = true
+}
+
+// EnvsCleared reports if the "envs" edge to the Env entity was cleared.
+func (m *TeamMutation) EnvsCleared() bool {
+	return m.clearedenvs
+}
+
+// RemoveEnvIDs removes the "envs" edge to the Env entity by IDs.
+func (m *TeamMutation) RemoveEnvIDs(ids ...string) {
+	if m.removedenvs == nil {
+		m.removedenvs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.envs, ids[i])
+		m.removedenvs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnvs returns the removed IDs of the "envs" edge to the Env entity.
+func (m *TeamMutation) RemovedEnvsIDs() (ids []string) {
+	for id := range m.removedenvs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnvsIDs returns the "envs" edge IDs in the mutation.
+func (m *TeamMutation) EnvsIDs() (ids []string) {
+	for id := range m.envs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnvs resets all changes to the "envs" edge.
+func (m *TeamMutation) ResetEnvs() {
+	m.envs = nil
+	m.clearedenvs = false
+	m.removedenvs = nil
+}
+
+// AddWarmPoolIDs adds the "warm_pools" edge to the WarmPool entity by ids.
+func (m *TeamMutation) AddWarmPoolIDs(ids ...uuid.UUID) {
+	if m.warm_pools == nil {
+		m.warm_pools = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.warm_pools[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWarmPools clears the "warm_pools" edge to the WarmPool entity.
+func (m *TeamMutation) ClearWarmPools() {
+	m.clearedwarm_pools = true
+}
+
+// WarmPoolsCleared reports if the "warm_pools" edge to the WarmPool entity was cleared.
+func (m *TeamMutation) WarmPoolsCleared() bool {
+	return m.clearedwarm_pools
+}
+
+// RemoveWarmPoolIDs removes the "warm_pools" edge to the WarmPool entity by IDs.
+func (m *TeamMutation) RemoveWarmPoolIDs(ids ...uuid.UUID) {
+	if m.removedwarm_pools == nil {
+		m.removedwarm_pools = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.warm_pools, ids[i])
+		m.removedwarm_pools[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWarmPools returns the removed IDs of the "warm_pools" edge to the WarmPool entity.
+func (m *TeamMutation) RemovedWarmPoolsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwarm_pools {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WarmPoolsIDs returns the "warm_pools" edge IDs in the mutation.
+func (m *TeamMutation) WarmPoolsIDs() (ids []uuid.UUID) {
+	for id := range m.warm_pools {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWarmPools resets all changes to the "warm_pools" edge.
+func (m *TeamMutation) ResetWarmPools() {
+	m.warm_pools = nil
+	m.clearedwarm_pools = false
+	m.removedwarm_pools = nil
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by ids.
+func (m *TeamMutation) AddWebhookIDs(ids ...uuid.UUID) {
+	if m.webhooks == nil {
+		m.webhooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.webhooks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhooks clears the "webhooks" edge to the Webhook entity.
+func (m *TeamMutation) ClearWebhooks() {
+	m.clearedwebhooks = true
+}
+
+// WebhooksCleared reports if the "webhooks" edge to the Webhook entity was cleared.
+func (m *TeamMutation) WebhooksCleared() bool {
+	return m.clearedwebhooks
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to the Webhook entity by IDs.
+func (m *TeamMutation) RemoveWebhookIDs(ids ...uuid.UUID) {
+	if m.removedwebhooks == nil {
+		m.removedwebhooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.webhooks, ids[i])
+		m.removedwebhooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhooks returns the removed IDs of the "webhooks" edge to the Webhook entity.
+func (m *TeamMutation) RemovedWebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.removedwebhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhooksIDs returns the "webhooks" edge IDs in the mutation.
+func (m *TeamMutation) WebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.webhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhooks resets all changes to the "webhooks" edge.
+func (m *TeamMutation) ResetWebhooks() {
+	m.webhooks = nil
+	m.clearedwebhooks = false
+	m.removedwebhooks = nil
+}
+
+// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
+func (m *TeamMutation) AddUsersTeamIDs(ids ...int) {
+	if m.users_teams == nil {
+		m.users_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.users_teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
+func (m *TeamMutation) ClearUsersTeams() {
+	m.clearedusers_teams = true
+}
+
+// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
+func (m *TeamMutation) UsersTeamsCleared() bool {
+	return m.clearedusers_teams
+}
+
+// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
+func (m *TeamMutation) RemoveUsersTeamIDs(ids ...int) {
+	if m.removedusers_teams == nil {
+		m.removedusers_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users_teams, ids[i])
+		m.removedusers_teams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
+func (m *TeamMutation) RemovedUsersTeamsIDs() (ids []int) {
+	for id := range m.removedusers_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
+func (m *TeamMutation) UsersTeamsIDs() (ids []int) {
+	for id := range m.users_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsersTeams resets all changes to the "users_teams" edge.
+func (m *TeamMutation) ResetUsersTeams() {
+	m.users_teams = nil
+	m.clearedusers_teams = false
+	m.removedusers_teams = nil
+}
+
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, team.FieldCreatedAt)
+	}
+	if m.is_banned != nil {
+		fields = append(fields, team.FieldIsBanned)
+	}
+	if m.is_blocked != nil {
+		fields = append(fields, team.FieldIsBlocked)
+	}
+	if m.blocked_reason != nil {
+		fields = append(fields, team.FieldBlockedReason)
+	}
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
+	}
+	if m.team_tier != nil {
+		fields = append(fields, team.FieldTier)
+	}
+	if m.email != nil {
+		fields = append(fields, team.FieldEmail)
+	}
+	if m.cluster_id != nil {
+		fields = append(fields, team.FieldClusterID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldCreatedAt:
+		return m.CreatedAt()
+	case team.FieldIsBanned:
+		return m.IsBanned()
+	case team.FieldIsBlocked:
+		return m.IsBlocked()
+	case team.FieldBlockedReason:
+		return m.BlockedReason()
+	case team.FieldName:
+		return m.Name()
+	case team.FieldTier:
+		return m.Tier()
+	case team.FieldEmail:
+		return m.Email()
+	case team.FieldClusterID:
+		return m.ClusterID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case team.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case team.FieldIsBanned:
+		return m.OldIsBanned(ctx)
+	case team.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case team.FieldBlockedReason:
+		return m.OldBlockedReason(ctx)
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldTier:
+		return m.OldTier(ctx)
+	case team.FieldEmail:
+		return m.OldEmail(ctx)
+	case team.FieldClusterID:
+		return m.OldClusterID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Team field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case team.FieldIsBanned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBanned(v)
+		return nil
+	case team.FieldIsBlocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBlocked(v)
+		return nil
+	case team.FieldBlockedReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockedReason(v)
+		return nil
+	case team.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case team.FieldTier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTier(v)
+		return nil
+	case team.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case team.FieldClusterID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClusterID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Team numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(team.FieldIsBanned) {
+		fields = append(fields, team.FieldIsBanned)
+	}
+	if m.FieldCleared(team.FieldIsBlocked) {
+		fields = append(fields, team.FieldIsBlocked)
+	}
+	if m.FieldCleared(team.FieldBlockedReason) {
+		fields = append(fields, team.FieldBlockedReason)
+	}
+	if m.FieldCleared(team.FieldClusterID) {
+		fields = append(fields, team.FieldClusterID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMutation) ClearField(name string) error {
+	switch name {
+	case team.FieldIsBanned:
+		m.ClearIsBanned()
+		return nil
+	case team.FieldIsBlocked:
+		m.ClearIsBlocked()
+		return nil
+	case team.FieldBlockedReason:
+		m.ClearBlockedReason()
+		return nil
+	case team.FieldClusterID:
+		m.ClearClusterID()
+		return nil
+	}
+	return fmt.Errorf("unknown Team nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMutation) ResetField(name string) error {
+	switch name {
+	case team.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case team.FieldIsBanned:
+		m.ResetIsBanned()
+		return nil
+	case team.FieldIsBlocked:
+		m.ResetIsBlocked()
+		return nil
+	case team.FieldBlockedReason:
+		m.ResetBlockedReason()
+		return nil
+	case team.FieldName:
+		m.ResetName()
+		return nil
+	case team.FieldTier:
+		m.ResetTier()
+		return nil
+	case team.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case team.FieldClusterID:
+		m.ResetClusterID()
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.users != nil {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.team_api_keys != nil {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.team_tier != nil {
+		edges = append(edges, team.EdgeTeamTier)
+	}
+	if m.envs != nil {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.warm_pools != nil {
+		edges = append(edges, team.EdgeWarmPools)
+	}
+	if m.webhooks != nil {
+		edges = append(edges, team.EdgeWebhooks)
+	}
+	if m.users_teams != nil {
+		edges = append(edges, team.EdgeUsersTeams)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamAPIKeys:
+		ids := make([]ent.Value, 0, len(m.team_api_keys))
+		for id := range m.team_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamTier:
+		if id := m.team_tier; id != nil {
+			return []ent.Value{*id}
+		}
+	case team.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.envs))
+		for id := range m.envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeWarmPools:
+		ids := make([]ent.Value, 0, len(m.warm_pools))
+		for id := range m.warm_pools {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.webhooks))
+		for id := range m.webhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.users_teams))
+		for id := range m.users_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedusers != nil {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.removedteam_api_keys != nil {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.removedenvs != nil {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.removedwarm_pools != nil {
+		edges = append(edges, team.EdgeWarmPools)
+	}
+	if m.removedwebhooks != nil {
+		edges = append(edges, team.EdgeWebhooks)
+	}
+	if m.removedusers_teams != nil {
+		edges = append(edges, team.EdgeUsersTeams)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeTeamAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedteam_api_keys))
+		for id := range m.removedteam_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeEnvs:
+		ids := make([]ent.Value, 0, len(m.removedenvs))
+		for id := range m.removedenvs {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeWarmPools:
+		ids := make([]ent.Value, 0, len(m.removedwarm_pools))
+		for id := range m.removedwarm_pools {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.removedwebhooks))
+		for id := range m.removedwebhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case team.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.removedusers_teams))
+		for id := range m.removedusers_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedusers {
+		edges = append(edges, team.EdgeUsers)
+	}
+	if m.clearedteam_api_keys {
+		edges = append(edges, team.EdgeTeamAPIKeys)
+	}
+	if m.clearedteam_tier {
+		edges = append(edges, team.EdgeTeamTier)
+	}
+	if m.clearedenvs {
+		edges = append(edges, team.EdgeEnvs)
+	}
+	if m.clearedwarm_pools {
+		edges = append(edges, team.EdgeWarmPools)
+	}
+	if m.clearedwebhooks {
+		edges = append(edges, team.EdgeWebhooks)
+	}
+	if m.clearedusers_teams {
+		edges = append(edges, team.EdgeUsersTeams)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMutation) EdgeCleared(name string) bool {
+	switch name {
+	case team.EdgeUsers:
+		return m.clearedusers
+	case team.EdgeTeamAPIKeys:
+		return m.clearedteam_api_keys
+	case team.EdgeTeamTier:
+		return m.clearedteam_tier
+	case team.EdgeEnvs:
+		return m.clearedenvs
+	case team.EdgeWarmPools:
+		return m.clearedwarm_pools
+	case team.EdgeWebhooks:
+		return m.clearedwebhooks
+	case team.EdgeUsersTeams:
+		return m.clearedusers_teams
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMutation) ClearEdge(name string) error {
+	switch name {
+	case team.EdgeTeamTier:
+		m.ClearTeamTier()
+		return nil
+	}
+	return fmt.Errorf("unknown Team unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMutation) ResetEdge(name string) error {
+	switch name {
+	case team.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case team.EdgeTeamAPIKeys:
+		m.ResetTeamAPIKeys()
+		return nil
+	case team.EdgeTeamTier:
+		m.ResetTeamTier()
+		return nil
+	case team.EdgeEnvs:
+		m.ResetEnvs()
+		return nil
+	case team.EdgeWarmPools:
+		m.ResetWarmPools()
+		return nil
+	case team.EdgeWebhooks:
+		m.ResetWebhooks()
+		return nil
+	case team.EdgeUsersTeams:
+		m.ResetUsersTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown Team edge %s", name)
+}
+
+// TeamAPIKeyMutation represents an operation that mutates the TeamAPIKey nodes in the graph.
+type TeamAPIKeyMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	api_key                 *string
+	api_key_hash            *string
+	api_key_prefix          *string
+	api_key_length          *int
+	addapi_key_length       *int
+	api_key_mask_prefix     *string
+	api_key_mask_suffix     *string
+	created_at              *time.Time
+	updated_at              *time.Time
+	name                    *string
+	last_used               *time.Time
+	expires_at              *time.Time
+	scopes                  *[]string
+	appendscopes            []string
+	allowed_templates       *[]string
+	appendallowed_templates []string
+	clearedFields           map[string]struct{}
+	team                    *uuid.UUID
+	clearedteam             bool
+	creator                 *uuid.UUID
+	clearedcreator          bool
+	done                    bool
+	oldValue                func(context.Context) (*TeamAPIKey, error)
+	predicates              []predicate.TeamAPIKey
+}
+
+var _ ent.Mutation = (*TeamAPIKeyMutation)(nil)
+
+// teamapikeyOption allows management of the mutation configuration using functional options.
+type teamapikeyOption func(*TeamAPIKeyMutation)
+
+// newTeamAPIKeyMutation creates new mutation for the TeamAPIKey entity.
+func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKeyMutation {
+	m := &TeamAPIKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeamAPIKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamAPIKeyID sets the ID field of the mutation.
+func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
+	return func(m *TeamAPIKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TeamAPIKey
+		)
+		m.oldValue = func(ctx context.Context) (*TeamAPIKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TeamAPIKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeamAPIKey sets the old TeamAPIKey of the mutation.
+func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
+	return func(m *TeamAPIKeyMutation) {
+		m.oldValue = func(context.Context) (*TeamAPIKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamAPIKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TeamAPIKey entities.
+func (m *TeamAPIKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamAPIKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamAPIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TeamAPIKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAPIKey sets the "api_key" field.
+func (m *TeamAPIKeyMutation) SetAPIKey(s string) {
+	m.api_key = &s
+}
+
+// APIKey returns the value of the "api_key" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKey() (r string, exists bool) {
+	v := m.api_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKey returns the old "api_key" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldAPIKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
+	}
+	return oldValue.APIKey, nil
+}
+
+// ResetAPIKey resets all changes to the "api_key" field.
+func (m *TeamAPIKeyMutation) ResetAPIKey() {
+	m.api_key = nil
+}
+
+// SetAPIKeyHash sets the "api_key_hash" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyHash(s string) {
+	m.api_key_hash = &s
+}
+
+// APIKeyHash returns the value of the "api_key_hash" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyHash() (r string, exists bool) {
+	v := m.api_key_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKeyHash returns the old "api_key_hash" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldAPIKeyHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKeyHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKeyHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKeyHash: %w", err)
+	}
+	return oldValue.APIKeyHash, nil
+}
+
+// ResetAPIKeyHash resets all changes to the "api_key_hash" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyHash() {
+	m.api_key_hash = nil
+}
+
+// SetAPIKeyPrefix sets the "api_key_prefix" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyPrefix(s string) {
+	m.api_key_prefix = &s
+}
+
+// APIKeyPrefix returns the value of the "api_key_prefix" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyPrefix() (r string, exists bool) {
+	v := m.api_key_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKeyPrefix returns the old "api_key_prefix" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldAPIKeyPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKeyPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKeyPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKeyPrefix: %w", err)
+	}
+	return oldValue.APIKeyPrefix, nil
+}
+
+// ResetAPIKeyPrefix resets all changes to the "api_key_prefix" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyPrefix() {
+	m.api_key_prefix = nil
+}
+
+// SetAPIKeyLength sets the "api_key_length" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyLength(i int) {
+	m.api_key_length = &i
+	m.addapi_key_length = nil
+}
+
+// APIKeyLength returns the value of the "api_key_length" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyLength() (r int, exists bool) {
+	v := m.api_key_length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKeyLength returns the old "api_key_length" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldAPIKeyLength(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKeyLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKeyLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKeyLength: %w", err)
+	}
+	return oldValue.APIKeyLength, nil
+}
+
+// AddAPIKeyLength adds i to the "api_key_length" field.
+func (m *TeamAPIKeyMutation) AddAPIKeyLength(i int) {
+	if m.addapi_key_length != nil {
+		*m.addapi_key_length += i
+	} else {
+		m.addapi_key_length = &i
+	}
+}
+
+// AddedAPIKeyLength returns the value that was added to the "api_key_length" field in this mutation.
+func (m *TeamAPIKeyMutation) AddedAPIKeyLength() (r int, exists bool) {
+	v := m.addapi_key_length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAPIKeyLength resets all changes to the "api_key_length" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyLength() {
+	m.api_key_length = nil
+	m.addapi_key_length = nil
+}
+
+// SetAPIKeyMaskPrefix sets the "api_key_mask_prefix" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyMaskPrefix(s string) {
+	m.api_key_mask_prefix = &s
+}
+
+// APIKeyMaskPrefix returns the value of the "api_key_mask_prefix" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyMaskPrefix() (r string, exists bool) {
+	v := m.api_key_mask_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKeyMaskPrefix returns the old "api_key_mask_prefix" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldAPIKeyMaskPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKeyMaskPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKeyMaskPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKeyMaskPrefix: %w", err)
+	}
+	return oldValue.APIKeyMaskPrefix, nil
+}
+
+// ResetAPIKeyMaskPrefix resets all changes to the "api_key_mask_prefix" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyMaskPrefix() {
+	m.api_key_mask_prefix = nil
+}
+
+// SetAPIKeyMaskSuffix sets the "api_key_mask_suffix" field.
+func (m *TeamAPIKeyMutation) SetAPIKeyMaskSuffix(s string) {
+	m.api_key_mask_suffix = &s
+}
+
+// APIKeyMaskSuffix returns the value of the "api_key_mask_suffix" field in the mutation.
+func (m *TeamAPIKeyMutation) APIKeyMaskSuffix() (r string, exists bool) {
+	v := m.api_key_mask_suffix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKeyMaskSuffix returns the old "api_key_mask_suffix" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldAPIKeyMaskSuffix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKeyMaskSuffix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKeyMaskSuffix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKeyMaskSuffix: %w", err)
+	}
+	return oldValue.APIKeyMaskSuffix, nil
+}
+
+// ResetAPIKeyMaskSuffix resets all changes to the "api_key_mask_suffix" field.
+func (m *TeamAPIKeyMutation) ResetAPIKeyMaskSuffix() {
+	m.api_key_mask_suffix = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TeamAPIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TeamAPIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TeamAPIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TeamAPIKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TeamAPIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *TeamAPIKeyMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[teamapikey.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TeamAPIKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, teamapikey.FieldUpdatedAt)
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *TeamAPIKeyMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *TeamAPIKeyMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *TeamAPIKeyMutation) ResetTeamID() {
+	m.team = nil
+}
+
+// SetName sets the "name" field.
+func (m *TeamAPIKeyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamAPIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamAPIKeyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *TeamAPIKeyMutation) SetCreatedBy(u uuid.UUID) {
+	m.creator = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *TeamAPIKeyMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *TeamAPIKeyMutation) ClearCreatedBy() {
+	m.creator = nil
+	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *TeamAPIKeyMutation) ResetCreatedBy() {
+	m.creator = nil
+	delete(m.clearedFields, teamapikey.FieldCreatedBy)
+}
+
+// SetLastUsed sets the "last_used" field.
+func (m *TeamAPIKeyMutation) SetLastUsed(t time.Time) {
+	m.last_used = &t
+}
+
+// LastUsed returns the value of the "last_used" field in the mutation.
+func (m *TeamAPIKeyMutation) LastUsed() (r time.Time, exists bool) {
+	v := m.last_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsed returns the old "last_used" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldLastUsed(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
+	}
+	return oldValue.LastUsed, nil
+}
+
+// ClearLastUsed clears the value of the "last_used" field.
+func (m *TeamAPIKeyMutation) ClearLastUsed() {
+	m.last_used = nil
+	m.clearedFields[teamapikey.FieldLastUsed] = struct{}{}
+}
+
+// LastUsedCleared returns if the "last_used" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) LastUsedCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldLastUsed]
+	return ok
+}
+
+// ResetLastUsed resets all changes to the "last_used" field.
+func (m *TeamAPIKeyMutation) ResetLastUsed() {
+	m.last_used = nil
+	delete(m.clearedFields, teamapikey.FieldLastUsed)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *TeamAPIKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TeamAPIKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *TeamAPIKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[teamapikey.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TeamAPIKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, teamapikey.FieldExpiresAt)
+}
+
+// SetScopes sets the "scopes" field.
+func (m *TeamAPIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *TeamAPIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *TeamAPIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *TeamAPIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *TeamAPIKeyMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[teamapikey.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *TeamAPIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, teamapikey.FieldScopes)
+}
+
+// SetAllowedTemplates sets the "allowed_templates" field.
+func (m *TeamAPIKeyMutation) SetAllowedTemplates(s []string) {
+	m.allowed_templates = &s
+	m.appendallowed_templates = nil
+}
+
+// AllowedTemplates returns the value of the "allowed_templates" field in the mutation.
+func (m *TeamAPIKeyMutation) AllowedTemplates() (r []string, exists bool) {
+	v := m.allowed_templates
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedTemplates returns the old "allowed_templates" field's value of the TeamAPIKey entity.
+// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamAPIKeyMutation) OldAllowedTemplates(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedTemplates is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedTemplates requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedTemplates: %w", err)
+	}
+	return oldValue.AllowedTemplates, nil
+}
+
+// AppendAllowedTemplates adds s to the "allowed_templates" field.
+func (m *TeamAPIKeyMutation) AppendAllowedTemplates(s []string) {
+	m.appendallowed_templates = append(m.appendallowed_templates, s...)
+}
+
+// AppendedAllowedTemplates returns the list of values that were appended to the "allowed_templates" field in this mutation.
+func (m *TeamAPIKeyMutation) AppendedAllowedTemplates() ([]string, bool) {
+	if len(m.appendallowed_templates) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_templates, true
+}
+
+// ClearAllowedTemplates clears the value of the "allowed_templates" field.
+func (m *TeamAPIKeyMutation) ClearAllowedTemplates() {
+	m.allowed_templates = nil
+	m.appendallowed_templates = nil
+	m.clearedFields[teamapikey.FieldAllowedTemplates] = struct{}{}
+}
+
+// AllowedTemplatesCleared returns if the "allowed_templates" field was cleared in this mutation.
+func (m *TeamAPIKeyMutation) AllowedTemplatesCleared() bool {
+	_, ok := m.clearedFields[teamapikey.FieldAllowedTemplates]
+	return ok
+}
+
+// ResetAllowedTemplates resets all changes to the "allowed_templates" field.
+func (m *TeamAPIKeyMutation) ResetAllowedTemplates() {
+	m.allowed_templates = nil
+	m.appendallowed_templates = nil
+	delete(m.clearedFields, teamapikey.FieldAllowedTemplates)
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *TeamAPIKeyMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[teamapikey.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *TeamAPIKeyMutation) TeamCleared() bool {
+	return m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *TeamAPIKeyMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *TeamAPIKeyMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *TeamAPIKeyMutation) SetCreatorID(id uuid.UUID) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *TeamAPIKeyMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *TeamAPIKeyMutation) CreatorCleared() bool {
+	return m.CreatedByCleared() || m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *TeamAPIKeyMutation) CreatorID() (id uuid.UUID, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *TeamAPIKeyMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *TeamAPIKeyMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// Where appends a list predicates to the TeamAPIKeyMutation builder.
+func (m *TeamAPIKeyMutation) Where(ps ...predicate.TeamAPIKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamAPIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TeamAPIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamAPIKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamAPIKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TeamAPIKey).
+func (m *TeamAPIKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamAPIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.api_key != nil {
+		fields = append(fields, teamapikey.FieldAPIKey)
+	}
+	if m.api_key_hash != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyHash)
+	}
+	if m.api_key_prefix != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyPrefix)
+	}
+	if m.api_key_length != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyLength)
+	}
+	if m.api_key_mask_prefix != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyMaskPrefix)
+	}
+	if m.api_key_mask_suffix != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyMaskSuffix)
+	}
+	if m.created_at != nil {
+		fields = append(fields, teamapikey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, teamapikey.FieldUpdatedAt)
+	}
+	if m.team != nil {
+		fields = append(fields, teamapikey.FieldTeamID)
+	}
+	if m.name != nil {
+		fields = append(fields, teamapikey.FieldName)
+	}
+	if m.creator != nil {
+		fields = append(fields, teamapikey.FieldCreatedBy)
+	}
+	if m.last_used != nil {
+		fields = append(fields, teamapikey.FieldLastUsed)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, teamapikey.FieldExpiresAt)
+	}
+	if m.scopes != nil {
+		fields = append(fields, teamapikey.FieldScopes)
+	}
+	if m.allowed_templates != nil {
+		fields = append(fields, teamapikey.FieldAllowedTemplates)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case teamapikey.FieldAPIKey:
+		return m.APIKey()
+	case teamapikey.FieldAPIKeyHash:
+		return m.APIKeyHash()
+	case teamapikey.FieldAPIKeyPrefix:
+		return m.APIKeyPrefix()
+	case teamapikey.FieldAPIKeyLength:
+		return m.APIKeyLength()
+	case teamapikey.FieldAPIKeyMaskPrefix:
+		return m.APIKeyMaskPrefix()
+	case teamapikey.FieldAPIKeyMaskSuffix:
+		return m.APIKeyMaskSuffix()
+	case teamapikey.FieldCreatedAt:
+		return m.CreatedAt()
+	case teamapikey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case teamapikey.FieldTeamID:
+		return m.TeamID()
+	case teamapikey.FieldName:
+		return m.Name()
+	case teamapikey.FieldCreatedBy:
+		return m.CreatedBy()
+	case teamapikey.FieldLastUsed:
+		return m.LastUsed()
+	case teamapikey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case teamapikey.FieldScopes:
+		return m.Scopes()
+	case teamapikey.FieldAllowedTemplates:
+		return m.AllowedTemplates()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamAPIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case teamapikey.FieldAPIKey:
+		return m.OldAPIKey(ctx)
+	case teamapikey.FieldAPIKeyHash:
+		return m.OldAPIKeyHash(ctx)
+	case teamapikey.FieldAPIKeyPrefix:
+		return m.OldAPIKeyPrefix(ctx)
+	case teamapikey.FieldAPIKeyLength:
+		return m.OldAPIKeyLength(ctx)
+	case teamapikey.FieldAPIKeyMaskPrefix:
+		return m.OldAPIKeyMaskPrefix(ctx)
+	case teamapikey.FieldAPIKeyMaskSuffix:
+		return m.OldAPIKeyMaskSuffix(ctx)
+	case teamapikey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case teamapikey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case teamapikey.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case teamapikey.FieldName:
+		return m.OldName(ctx)
+	case teamapikey.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case teamapikey.FieldLastUsed:
+		return m.OldLastUsed(ctx)
+	case teamapikey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case teamapikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case teamapikey.FieldAllowedTemplates:
+		return m.OldAllowedTemplates(ctx)
+	}
+	return nil, fmt.Errorf("unknown TeamAPIKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamAPIKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case teamapikey.FieldAPIKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKey(v)
+		return nil
+	case teamapikey.FieldAPIKeyHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyHash(v)
+		return nil
+	case teamapikey.FieldAPIKeyPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyPrefix(v)
+		return nil
+	case teamapikey.FieldAPIKeyLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyLength(v)
+		return nil
+	case teamapikey.FieldAPIKeyMaskPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyMaskPrefix(v)
+		return nil
+	case teamapikey.FieldAPIKeyMaskSuffix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyMaskSuffix(v)
+		return nil
+	case teamapikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case teamapikey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case teamapikey.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case teamapikey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case teamapikey.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case teamapikey.FieldLastUsed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsed(v)
+		return nil
+	case teamapikey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case teamapikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case teamapikey.FieldAllowedTemplates:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedTemplates(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamAPIKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addapi_key_length != nil {
+		fields = append(fields, teamapikey.FieldAPIKeyLength)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamAPIKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case teamapikey.FieldAPIKeyLength:
+		return m.AddedAPIKeyLength()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamAPIKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case teamapikey.FieldAPIKeyLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAPIKeyLength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamAPIKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(teamapikey.FieldUpdatedAt) {
+		fields = append(fields, teamapikey.FieldUpdatedAt)
+	}
+	if m.FieldCleared(teamapikey.FieldCreatedBy) {
+		fields = append(fields, teamapikey.FieldCreatedBy)
+	}
+	if m.FieldCleared(teamapikey.FieldLastUsed) {
+		fields = append(fields, teamapikey.FieldLastUsed)
+	}
+	if m.FieldCleared(teamapikey.FieldExpiresAt) {
+		fields = append(fields, teamapikey.FieldExpiresAt)
+	}
+	if m.FieldCleared(teamapikey.FieldScopes) {
+		fields = append(fields, teamapikey.FieldScopes)
+	}
+	if m.FieldCleared(teamapikey.FieldAllowedTemplates) {
+		fields = append(fields, teamapikey.FieldAllowedTemplates)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamAPIKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamAPIKeyMutation) ClearField(name string) error {
+	switch name {
+	case teamapikey.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case teamapikey.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case teamapikey.FieldLastUsed:
+		m.ClearLastUsed()
+		return nil
+	case teamapikey.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case teamapikey.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case teamapikey.FieldAllowedTemplates:
+		m.ClearAllowedTemplates()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamAPIKeyMutation) ResetField(name string) error {
+	switch name {
+	case teamapikey.FieldAPIKey:
+		m.ResetAPIKey()
+		return nil
+	case teamapikey.FieldAPIKeyHash:
+		m.ResetAPIKeyHash()
+		return nil
+	case teamapikey.FieldAPIKeyPrefix:
+		m.ResetAPIKeyPrefix()
+		return nil
+	case teamapikey.FieldAPIKeyLength:
+		m.ResetAPIKeyLength()
+		return nil
+	case teamapikey.FieldAPIKeyMaskPrefix:
+		m.ResetAPIKeyMaskPrefix()
+		return nil
+	case teamapikey.FieldAPIKeyMaskSuffix:
+		m.ResetAPIKeyMaskSuffix()
+		return nil
+	case teamapikey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case teamapikey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case teamapikey.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case teamapikey.FieldName:
+		m.ResetName()
+		return nil
+	case teamapikey.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case teamapikey.FieldLastUsed:
+		m.ResetLastUsed()
+		return nil
+	case teamapikey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case teamapikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case teamapikey.FieldAllowedTemplates:
+		m.ResetAllowedTemplates()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamAPIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.team != nil {
+		edges = append(edges, teamapikey.EdgeTeam)
+	}
+	if m.creator != nil {
+		edges = append(edges, teamapikey.EdgeCreator)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamAPIKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case teamapikey.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case teamapikey.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamAPIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamAPIKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamAPIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteam {
+		edges = append(edges, teamapikey.EdgeTeam)
+	}
+	if m.clearedcreator {
+		edges = append(edges, teamapikey.EdgeCreator)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamAPIKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case teamapikey.EdgeTeam:
+		return m.clearedteam
+	case teamapikey.EdgeCreator:
+		return m.clearedcreator
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamAPIKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case teamapikey.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case teamapikey.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamAPIKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case teamapikey.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case teamapikey.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown TeamAPIKey edge %s", name)
+}
+
+// TierMutation represents an operation that mutates the Tier nodes in the graph.
+type TierMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *string
+	name                          *string
+	disk_mb                       *int64
+	adddisk_mb                    *int64
+	concurrent_instances          *int64
+	addconcurrent_instances       *int64
+	max_length_hours              *int64
+	addmax_length_hours           *int64
+	max_vcpu                      *int64
+	addmax_vcpu                   *int64
+	max_ram_mb                    *int64
+	addmax_ram_mb                 *int64
+	concurrent_vcpus              *int64
+	addconcurrent_vcpus           *int64
+	concurrent_ram_mb             *int64
+	addconcurrent_ram_mb          *int64
+	paused_storage_mb             *int64
+	addpaused_storage_mb          *int64
+	paused_retention_hours        *int64
+	addpaused_retention_hours     *int64
+	max_checkpoints               *int64
+	addmax_checkpoints            *int64
+	network_bandwidth_mibps       *int64
+	addnetwork_bandwidth_mibps    *int64
+	network_ops                   *int64
+	addnetwork_ops                *int64
+	disk_bandwidth_mibps          *int64
+	adddisk_bandwidth_mibps       *int64
+	disk_iops                     *int64
+	adddisk_iops                  *int64
+	api_requests_per_second       *int64
+	addapi_requests_per_second    *int64
+	api_requests_burst            *int64
+	addapi_requests_burst         *int64
+	concurrent_template_builds    *int64
+	addconcurrent_template_builds *int64
+	build_priority                *int64
+	addbuild_priority             *int64
+	clearedFields                 map[string]struct{}
+	teams                         map[uuid.UUID]struct{}
+	removedteams                  map[uuid.UUID]struct{}
+	clearedteams                  bool
+	done                          bool
+	oldValue                      func(context.Context) (*Tier, error)
+	predicates                    []predicate.Tier
+}
+
+var _ ent.Mutation = (*TierMutation)(nil)
+
+// tierOption allows management of the mutation configuration using functional options.
+type tierOption func(*TierMutation)
+
+// newTierMutation creates new mutation for the Tier entity.
+func newTierMutation(c config, op Op, opts ...tierOption) *TierMutation {
+	m := &TierMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTier,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTierID sets the ID field of the mutation.
+func withTierID(id string) tierOption {
+	return func(m *TierMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tier
+		)
+		m.oldValue = func(ctx context.Context) (*Tier, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tier.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTier sets the old Tier of the mutation.
+func withTier(node *Tier) tierOption {
+	return func(m *TierMutation) {
+		m.oldValue = func(context.Context) (*Tier, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TierMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TierMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tier entities.
+func (m *TierMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TierMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TierMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tier.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TierMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TierMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TierMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDiskMB sets the "disk_mb" field.
+func (m *TierMutation) SetDiskMB(i int64) {
+	m.disk_mb = &i
+	m.adddisk_mb = nil
+}
+
+// DiskMB returns the value of the "disk_mb" field in the mutation.
+func (m *TierMutation) DiskMB() (r int64, exists bool) {
+	v := m.disk_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiskMB returns the old "disk_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldDiskMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiskMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiskMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiskMB: %w", err)
+	}
+	return oldValue.DiskMB, nil
+}
+
+// AddDiskMB adds i to the "disk_mb" field.
+func (m *TierMutation) AddDiskMB(i int64) {
+	if m.adddisk_mb != nil {
+		*m.adddisk_mb += i
+	} else {
+		m.adddisk_mb = &i
+	}
+}
+
+// AddedDiskMB returns the value that was added to the "disk_mb" field in this mutation.
+func (m *TierMutation) AddedDiskMB() (r int64, exists bool) {
+	v := m.adddisk_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDiskMB resets all changes to the "disk_mb" field.
+func (m *TierMutation) ResetDiskMB() {
+	m.disk_mb = nil
+	m.adddisk_mb = nil
+}
+
+// SetConcurrentInstances sets the "concurrent_instances" field.
+func (m *TierMutation) SetConcurrentInstances(i int64) {
+	m.concurrent_instances = &i
+	m.addconcurrent_instances = nil
+}
+
+// ConcurrentInstances returns the value of the "concurrent_instances" field in the mutation.
+func (m *TierMutation) ConcurrentInstances() (r int64, exists bool) {
+	v := m.concurrent_instances
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConcurrentInstances returns the old "concurrent_instances" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldConcurrentInstances(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConcurrentInstances is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConcurrentInstances requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConcurrentInstances: %w", err)
+	}
+	return oldValue.ConcurrentInstances, nil
+}
+
+// AddConcurrentInstances adds i to the "concurrent_instances" field.
+func (m *TierMutation) AddConcurrentInstances(i int64) {
+	if m.addconcurrent_instances != nil {
+		*m.addconcurrent_instances += i
+	} else {
+		m.addconcurrent_instances = &i
+	}
+}
+
+// AddedConcurrentInstances returns the value that was added to the "concurrent_instances" field in this mutation.
+func (m *TierMutation) AddedConcurrentInstances() (r int64, exists bool) {
+	v := m.addconcurrent_instances
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConcurrentInstances resets all changes to the "concurrent_instances" field.
+func (m *TierMutation) ResetConcurrentInstances() {
+	m.concurrent_instances = nil
+	m.addconcurrent_instances = nil
+}
+
+// SetMaxLengthHours sets the "max_length_hours" field.
+func (m *TierMutation) SetMaxLengthHours(i int64) {
+	m.max_length_hours = &i
+	m.addmax_length_hours = nil
+}
+
+// MaxLengthHours returns the value of the "max_length_hours" field in the mutation.
+func (m *TierMutation) MaxLengthHours() (r int64, exists bool) {
+	v := m.max_length_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxLengthHours returns the old "max_length_hours" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldMaxLengthHours(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxLengthHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxLengthHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxLengthHours: %w", err)
+	}
+	return oldValue.MaxLengthHours, nil
+}
+
+// AddMaxLengthHours adds i to the "max_length_hours" field.
+func (m *TierMutation) AddMaxLengthHours(i int64) {
+	if m.addmax_length_hours != nil {
+		*m.addmax_length_hours += i
+	} else {
+		m.addmax_length_hours = &i
+	}
+}
+
+// AddedMaxLengthHours returns the value that was added to the "max_length_hours" field in this mutation.
+func (m *TierMutation) AddedMaxLengthHours() (r int64, exists bool) {
+	v := m.addmax_length_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxLengthHours resets all changes to the "max_length_hours" field.
+func (m *TierMutation) ResetMaxLengthHours() {
+	m.max_length_hours = nil
+	m.addmax_length_hours = nil
+}
+
+// SetMaxVcpu sets the "max_vcpu" field.
+func (m *TierMutation) SetMaxVcpu(i int64) {
+	m.max_vcpu = &i
+	m.addmax_vcpu = nil
+}
+
+// MaxVcpu returns the value of the "max_vcpu" field in the mutation.
+func (m *TierMutation) MaxVcpu() (r int64, exists bool) {
+	v := m.max_vcpu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxVcpu returns the old "max_vcpu" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldMaxVcpu(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxVcpu is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxVcpu requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxVcpu: %w", err)
+	}
+	return oldValue.MaxVcpu, nil
+}
+
+// AddMaxVcpu adds i to the "max_vcpu" field.
+func (m *TierMutation) AddMaxVcpu(i int64) {
+	if m.addmax_vcpu != nil {
+		*m.addmax_vcpu += i
+	} else {
+		m.addmax_vcpu = &i
+	}
+}
+
+// AddedMaxVcpu returns the value that was added to the "max_vcpu" field in this mutation.
+func (m *TierMutation) AddedMaxVcpu() (r int64, exists bool) {
+	v := m.addmax_vcpu
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxVcpu resets all changes to the "max_vcpu" field.
+func (m *TierMutation) ResetMaxVcpu() {
+	m.max_vcpu = nil
+	m.addmax_vcpu = nil
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (m *TierMutation) SetMaxRAMMB(i int64) {
+	m.max_ram_mb = &i
+	m.addmax_ram_mb = nil
+}
+
+// MaxRAMMB returns the value of the "max_ram_mb" field in the mutation.
+func (m *TierMutation) MaxRAMMB() (r int64, exists bool) {
+	v := m.max_ram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRAMMB returns the old "max_ram_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldMaxRAMMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRAMMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRAMMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRAMMB: %w", err)
+	}
+	return oldValue.MaxRAMMB, nil
+}
+
+// AddMaxRAMMB adds i to the "max_ram_mb" field.
+func (m *TierMutation) AddMaxRAMMB(i int64) {
+	if m.addmax_ram_mb != nil {
+		*m.addmax_ram_mb += i
+	} else {
+		m.addmax_ram_mb = &i
+	}
+}
+
+// AddedMaxRAMMB returns the value that was added to the "max_ram_mb" field in this mutation.
+func (m *TierMutation) AddedMaxRAMMB() (r int64, exists bool) {
+	v := m.addmax_ram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxRAMMB resets all changes to the "max_ram_mb" field.
+func (m *TierMutation) ResetMaxRAMMB() {
+	m.max_ram_mb = nil
+	m.addmax_ram_mb = nil
+}
+
+// SetConcurrentVcpus sets the "concurrent_vcpus" field.
+func (m *TierMutation) SetConcurrentVcpus(i int64) {
+	m.concurrent_vcpus = &i
+	m.addconcurrent_vcpus = nil
+}
+
+// ConcurrentVcpus returns the value of the "concurrent_vcpus" field in the mutation.
+func (m *TierMutation) ConcurrentVcpus() (r int64, exists bool) {
+	v := m.concurrent_vcpus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConcurrentVcpus returns the old "concurrent_vcpus" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldConcurrentVcpus(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConcurrentVcpus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConcurrentVcpus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConcurrentVcpus: %w", err)
+	}
+	return oldValue.ConcurrentVcpus, nil
+}
+
+// AddConcurrentVcpus adds i to the "concurrent_vcpus" field.
+func (m *TierMutation) AddConcurrentVcpus(i int64) {
+	if m.addconcurrent_vcpus != nil {
+		*m.addconcurrent_vcpus += i
+	} else {
+		m.addconcurrent_vcpus = &i
+	}
+}
+
+// AddedConcurrentVcpus returns the value that was added to the "concurrent_vcpus" field in this mutation.
+func (m *TierMutation) AddedConcurrentVcpus() (r int64, exists bool) {
+	v := m.addconcurrent_vcpus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConcurrentVcpus resets all changes to the "concurrent_vcpus" field.
+func (m *TierMutation) ResetConcurrentVcpus() {
+	m.concurrent_vcpus = nil
+	m.addconcurrent_vcpus = nil
+}
+
+// SetConcurrentRAMMB sets the "concurrent_ram_mb" field.
+func (m *TierMutation) SetConcurrentRAMMB(i int64) {
+	m.concurrent_ram_mb = &i
+	m.addconcurrent_ram_mb = nil
+}
+
+// ConcurrentRAMMB returns the value of the "concurrent_ram_mb" field in the mutation.
+func (m *TierMutation) ConcurrentRAMMB() (r int64, exists bool) {
+	v := m.concurrent_ram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConcurrentRAMMB returns the old "concurrent_ram_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldConcurrentRAMMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConcurrentRAMMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConcurrentRAMMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConcurrentRAMMB: %w", err)
+	}
+	return oldValue.ConcurrentRAMMB, nil
+}
+
+// AddConcurrentRAMMB adds i to the "concurrent_ram_mb" field.
+func (m *TierMutation) AddConcurrentRAMMB(i int64) {
+	if m.addconcurrent_ram_mb != nil {
+		*m.addconcurrent_ram_mb += i
+	} else {
+		m.addconcurrent_ram_mb = &i
+	}
+}
+
+// AddedConcurrentRAMMB returns the value that was added to the "concurrent_ram_mb" field in this mutation.
+func (m *TierMutation) AddedConcurrentRAMMB() (r int64, exists bool) {
+	v := m.addconcurrent_ram_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConcurrentRAMMB resets all changes to the "concurrent_ram_mb" field.
+func (m *TierMutation) ResetConcurrentRAMMB() {
+	m.concurrent_ram_mb = nil
+	m.addconcurrent_ram_mb = nil
+}
+
+// SetPausedStorageMB sets the "paused_storage_mb" field.
+func (m *TierMutation) SetPausedStorageMB(i int64) {
+	m.paused_storage_mb = &i
+	m.addpaused_storage_mb = nil
+}
+
+// PausedStorageMB returns the value of the "paused_storage_mb" field in the mutation.
+func (m *TierMutation) PausedStorageMB() (r int64, exists bool) {
+	v := m.paused_storage_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPausedStorageMB returns the old "paused_storage_mb" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldPausedStorageMB(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPausedStorageMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPausedStorageMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPausedStorageMB: %w", err)
+	}
+	return oldValue.PausedStorageMB, nil
+}
+
+// AddPausedStorageMB adds i to the "paused_storage_mb" field.
+func (m *TierMutation) AddPausedStorageMB(i int64) {
+	if m.addpaused_storage_mb != nil {
+		*m.addpaused_storage_mb += i
+	} else {
+		m.addpaused_storage_mb = &i
+	}
+}
+
+// AddedPausedStorageMB returns the value that was added to the "paused_storage_mb" field in this mutation.
+func (m *TierMutation) AddedPausedStorageMB() (r int64, exists bool) {
+	v := m.addpaused_storage_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPausedStorageMB resets all changes to the "paused_storage_mb" field.
+func (m *TierMutation) ResetPausedStorageMB() {
+	m.paused_storage_mb = nil
+	m.addpaused_storage_mb = nil
+}
+
+// SetPausedRetentionHours sets the "paused_retention_hours" field.
+func (m *TierMutation) SetPausedRetentionHours(i int64) {
+	m.paused_retention_hours = &i
+	m.addpaused_retention_hours = nil
+}
+
+// PausedRetentionHours returns the value of the "paused_retention_hours" field in the mutation.
+func (m *TierMutation) PausedRetentionHours() (r int64, exists bool) {
+	v := m.paused_retention_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPausedRetentionHours returns the old "paused_retention_hours" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldPausedRetentionHours(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPausedRetentionHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPausedRetentionHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPausedRetentionHours: %w", err)
+	}
+	return oldValue.PausedRetentionHours, nil
+}
+
+// AddPausedRetentionHours adds i to the "paused_retention_hours" field.
+func (m *TierMutation) AddPausedRetentionHours(i int64) {
+	if m.addpaused_retention_hours != nil {
+		*m.addpaused_retention_hours += i
+	} else {
+		m.addpaused_retention_hours = &i
+	}
+}
+
+// AddedPausedRetentionHours returns the value that was added to the "paused_retention_hours" field in this mutation.
+func (m *TierMutation) AddedPausedRetentionHours() (r int64, exists bool) {
+	v := m.addpaused_retention_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPausedRetentionHours resets all changes to the "paused_retention_hours" field.
+func (m *TierMutation) ResetPausedRetentionHours() {
+	m.paused_retention_hours = nil
+	m.addpaused_retention_hours = nil
+}
+
+// SetMaxCheckpoints sets the "max_checkpoints" field.
+func (m *TierMutation) SetMaxCheckpoints(i int64) {
+	m.max_checkpoints = &i
+	m.addmax_checkpoints = nil
+}
+
+// MaxCheckpoints returns the value of the "max_checkpoints" field in the mutation.
+func (m *TierMutation) MaxCheckpoints() (r int64, exists bool) {
+	v := m.max_checkpoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxCheckpoints returns the old "max_checkpoints" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldMaxCheckpoints(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxCheckpoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxCheckpoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxCheckpoints: %w", err)
+	}
+	return oldValue.MaxCheckpoints, nil
+}
+
+// AddMaxCheckpoints adds i to the "max_checkpoints" field.
+func (m *TierMutation) AddMaxCheckpoints(i int64) {
+	if m.addmax_checkpoints != nil {
+		*m.addmax_checkpoints += i
+	} else {
+		m.addmax_checkpoints = &i
+	}
+}
+
+// AddedMaxCheckpoints returns the value that was added to the "max_checkpoints" field in this mutation.
+func (m *TierMutation) AddedMaxCheckpoints() (r int64, exists bool) {
+	v := m.addmax_checkpoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxCheckpoints resets all changes to the "max_checkpoints" field.
+func (m *TierMutation) ResetMaxCheckpoints() {
+	m.max_checkpoints = nil
+	m.addmax_checkpoints = nil
+}
+
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (m *TierMutation) SetNetworkBandwidthMibps(i int64) {
+	m.network_bandwidth_mibps = &i
+	m.addnetwork_bandwidth_mibps = nil
+}
+
+// NetworkBandwidthMibps returns the value of the "network_bandwidth_mibps" field in the mutation.
+func (m *TierMutation) NetworkBandwidthMibps() (r int64, exists bool) {
+	v := m.network_bandwidth_mibps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetworkBandwidthMibps returns the old "network_bandwidth_mibps" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldNetworkBandwidthMibps(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetworkBandwidthMibps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetworkBandwidthMibps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetworkBandwidthMibps: %w", err)
+	}
+	return oldValue.NetworkBandwidthMibps, nil
+}
+
+// AddNetworkBandwidthMibps adds i to the "network_bandwidth_mibps" field.
+func (m *TierMutation) AddNetworkBandwidthMibps(i int64) {
+	if m.addnetwork_bandwidth_mibps != nil {
+		*m.addnetwork_bandwidth_mibps += i
+	} else {
+		m.addnetwork_bandwidth_mibps = &i
+	}
+}
+
+// AddedNetworkBandwidthMibps returns the value that was added to the "network_bandwidth_mibps" field in this mutation.
+func (m *TierMutation) AddedNetworkBandwidthMibps() (r int64, exists bool) {
+	v := m.addnetwork_bandwidth_mibps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNetworkBandwidthMibps resets all changes to the "network_bandwidth_mibps" field.
+func (m *TierMutation) ResetNetworkBandwidthMibps() {
+	m.network_bandwidth_mibps = nil
+	m.addnetwork_bandwidth_mibps = nil
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (m *TierMutation) SetNetworkOps(i int64) {
+	m.network_ops = &i
+	m.addnetwork_ops = nil
+}
+
+// NetworkOps returns the value of the "network_ops" field in the mutation.
+func (m *TierMutation) NetworkOps() (r int64, exists bool) {
+	v := m.network_ops
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetworkOps returns the old "network_ops" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldNetworkOps(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetworkOps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetworkOps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetworkOps: %w", err)
+	}
+	return oldValue.NetworkOps, nil
+}
+
+// AddNetworkOps adds i to the "network_ops" field.
+func (m *TierMutation) AddNetworkOps(i int64) {
+	if m.addnetwork_ops != nil {
+		*m.addnetwork_ops += i
+	} else {
+		m.addnetwork_ops = &i
+	}
+}
+
+// AddedNetworkOps returns the value that was added to the "network_ops" field in this mutation.
+func (m *TierMutation) AddedNetworkOps() (r int64, exists bool) {
+	v := m.addnetwork_ops
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNetworkOps resets all changes to the "network_ops" field.
+func (m *TierMutation) ResetNetworkOps() {
+	m.network_ops = nil
+	m.addnetwork_ops = nil
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (m *TierMutation) SetDiskBandwidthMibps(i int64) {
+	m.disk_bandwidth_mibps = &i
+	m.adddisk_bandwidth_mibps = nil
+}
+
+// DiskBandwidthMibps returns the value of the "disk_bandwidth_mibps" field in the mutation.
+func (m *TierMutation) DiskBandwidthMibps() (r int64, exists bool) {
+	v := m.disk_bandwidth_mibps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiskBandwidthMibps returns the old "disk_bandwidth_mibps" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldDiskBandwidthMibps(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiskBandwidthMibps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiskBandwidthMibps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiskBandwidthMibps: %w", err)
+	}
+	return oldValue.DiskBandwidthMibps, nil
+}
+
+// AddDiskBandwidthMibps adds i to the "disk_bandwidth_mibps" field.
+func (m *TierMutation) AddDiskBandwidthMibps(i int64) {
+	if m.adddisk_bandwidth_mibps != nil {
+		*m.adddisk_bandwidth_mibps += i
+	} else {
+		m.adddisk_bandwidth_mibps = &i
+	}
+}
+
+// AddedDiskBandwidthMibps returns the value that was added to the "disk_bandwidth_mibps" field in this mutation.
+func (m *TierMutation) AddedDiskBandwidthMibps() (r int64, exists bool) {
+	v := m.adddisk_bandwidth_mibps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDiskBandwidthMibps resets all changes to the "disk_bandwidth_mibps" field.
+func (m *TierMutation) ResetDiskBandwidthMibps() {
+	m.disk_bandwidth_mibps = nil
+	m.adddisk_bandwidth_mibps = nil
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (m *TierMutation) SetDiskIops(i int64) {
+	m.disk_iops = &i
+	m.adddisk_iops = nil
+}
+
+// DiskIops returns the value of the "disk_iops" field in the mutation.
+func (m *TierMutation) DiskIops() (r int64, exists bool) {
+	v := m.disk_iops
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiskIops returns the old "disk_iops" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldDiskIops(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiskIops is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiskIops requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiskIops: %w", err)
+	}
+	return oldValue.DiskIops, nil
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (m *TierMutation) AddDiskIops(i int64) {
+	if m.adddisk_iops != nil {
+		*m.adddisk_iops += i
+	} else {
+		m.adddisk_iops = &i
+	}
+}
+
+// AddedDiskIops returns the value that was added to the "disk_iops" field in this mutation.
+func (m *TierMutation) AddedDiskIops() (r int64, exists bool) {
+	v := m.adddisk_iops
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDiskIops resets all changes to the "disk_iops" field.
+func (m *TierMutation) ResetDiskIops() {
+	m.disk_iops = nil
+	m.adddisk_iops = nil
+}
+
+// SetAPIRequestsPerSecond sets the "api_requests_per_second" field.
+func (m *TierMutation) SetAPIRequestsPerSecond(i int64) {
+	m.api_requests_per_second = &i
+	m.addapi_requests_per_second = nil
+}
+
+// APIRequestsPerSecond returns the value of the "api_requests_per_second" field in the mutation.
+func (m *TierMutation) APIRequestsPerSecond() (r int64, exists bool) {
+	v := m.api_requests_per_second
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIRequestsPerSecond returns the old "api_requests_per_second" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldAPIRequestsPerSecond(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIRequestsPerSecond is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIRequestsPerSecond requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIRequestsPerSecond: %w", err)
+	}
+	return oldValue.APIRequestsPerSecond, nil
+}
+
+// AddAPIRequestsPerSecond adds i to the "api_requests_per_second" field.
+func (m *TierMutation) AddAPIRequestsPerSecond(i int64) {
+	if m.addapi_requests_per_second != nil {
+		*m.addapi_requests_per_second += i
+	} else {
+		m.addapi_requests_per_second = &i
+	}
+}
+
+// AddedAPIRequestsPerSecond returns the value that was added to the "api_requests_per_second" field in this mutation.
+func (m *TierMutation) AddedAPIRequestsPerSecond() (r int64, exists bool) {
+	v := m.addapi_requests_per_second
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAPIRequestsPerSecond resets all changes to the "api_requests_per_second" field.
+func (m *TierMutation) ResetAPIRequestsPerSecond() {
+	m.api_requests_per_second = nil
+	m.addapi_requests_per_second = nil
+}
+
+// SetAPIRequestsBurst sets the "api_requests_burst" field.
+func (m *TierMutation) SetAPIRequestsBurst(i int64) {
+	m.api_requests_burst = &i
+	m.addapi_requests_burst = nil
+}
+
+// APIRequestsBurst returns the value of the "api_requests_burst" field in the mutation.
+func (m *TierMutation) APIRequestsBurst() (r int64, exists bool) {
+	v := m.api_requests_burst
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIRequestsBurst returns the old "api_requests_burst" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldAPIRequestsBurst(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIRequestsBurst is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIRequestsBurst requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIRequestsBurst: %w", err)
+	}
+	return oldValue.APIRequestsBurst, nil
+}
+
+// AddAPIRequestsBurst adds i to the "api_requests_burst" field.
+func (m *TierMutation) AddAPIRequestsBurst(i int64) {
+	if m.addapi_requests_burst != nil {
+		*m.addapi_requests_burst += i
+	} else {
+		m.addapi_requests_burst = &i
+	}
+}
+
+// AddedAPIRequestsBurst returns the value that was added to the "api_requests_burst" field in this mutation.
+func (m *TierMutation) AddedAPIRequestsBurst() (r int64, exists bool) {
+	v := m.addapi_requests_burst
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAPIRequestsBurst resets all changes to the "api_requests_burst" field.
+func (m *TierMutation) ResetAPIRequestsBurst() {
+	m.api_requests_burst = nil
+	m.addapi_requests_burst = nil
+}
+
+// SetConcurrentTemplateBuilds sets the "concurrent_template_builds" field.
+func (m *TierMutation) SetConcurrentTemplateBuilds(i int64) {
+	m.concurrent_template_builds = &i
+	m.addconcurrent_template_builds = nil
+}
+
+// ConcurrentTemplateBuilds returns the value of the "concurrent_template_builds" field in the mutation.
+func (m *TierMutation) ConcurrentTemplateBuilds() (r int64, exists bool) {
+	v := m.concurrent_template_builds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConcurrentTemplateBuilds returns the old "concurrent_template_builds" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldConcurrentTemplateBuilds(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConcurrentTemplateBuilds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConcurrentTemplateBuilds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConcurrentTemplateBuilds: %w", err)
+	}
+	return oldValue.ConcurrentTemplateBuilds, nil
+}
+
+// AddConcurrentTemplateBuilds adds i to the "concurrent_template_builds" field.
+func (m *TierMutation) AddConcurrentTemplateBuilds(i int64) {
+	if m.addconcurrent_template_builds != nil {
+		*m.addconcurrent_template_builds += i
+	} else {
+		m.addconcurrent_template_builds = &i
+	}
+}
+
+// AddedConcurrentTemplateBuilds returns the value that was added to the "concurrent_template_builds" field in this mutation.
+func (m *TierMutation) AddedConcurrentTemplateBuilds() (r int64, exists bool) {
+	v := m.addconcurrent_template_builds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConcurrentTemplateBuilds resets all changes to the "concurrent_template_builds" field.
+func (m *TierMutation) ResetConcurrentTemplateBuilds() {
+	m.concurrent_template_builds = nil
+	m.addconcurrent_template_builds = nil
+}
+
+// SetBuildPriority sets the "build_priority" field.
+func (m *TierMutation) SetBuildPriority(i int64) {
+	m.build_priority = &i
+	m.addbuild_priority = nil
+}
+
+// BuildPriority returns the value of the "build_priority" field in the mutation.
+func (m *TierMutation) BuildPriority() (r int64, exists bool) {
+	v := m.build_priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildPriority returns the old "build_priority" field's value of the Tier entity.
+// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TierMutation) OldBuildPriority(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildPriority: %w", err)
+	}
+	return oldValue.BuildPriority, nil
+}
+
+// AddBuildPriority adds i to the "build_priority" field.
+func (m *TierMutation) AddBuildPriority(i int64) {
+	if m.addbuild_priority != nil {
+		*m.addbuild_priority += i
+	} else {
+		m.addbuild_priority = &i
+	}
+}
+
+// AddedBuildPriority returns the value that was added to the "build_priority" field in this mutation.
+func (m *TierMutation) AddedBuildPriority() (r int64, exists bool) {
+	v := m.addbuild_priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBuildPriority resets all changes to the "build_priority" field.
+func (m *TierMutation) ResetBuildPriority() {
+	m.build_priority = nil
+	m.addbuild_priority = nil
+}
+
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *TierMutation) AddTeamIDs(ids ...uuid.UUID) {
+	if m.teams == nil {
+		m.teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *TierMutation) ClearTeams() {
+	m.clearedteams = true
+}
+
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *TierMutation) TeamsCleared() bool {
+	return m.clearedteams
+}
+
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *TierMutation) RemoveTeamIDs(ids ...uuid.UUID) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *TierMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *TierMutation) TeamsIDs() (ids []uuid.UUID) {
+	for id := range m.teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeams resets all changes to the "teams" edge.
+func (m *TierMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
+}
+
+// Where appends a list predicates to the TierMutation builder.
+func (m *TierMutation) Where(ps ...predicate.Tier) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TierMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tier, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TierMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TierMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tier).
+func (m *TierMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TierMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.name != nil {
+		fields = append(fields, tier.FieldName)
+	}
+	if m.disk_mb != nil {
+		fields = append(fields, tier.FieldDiskMB)
+	}
+	if m.concurrent_instances != nil {
+		fields = append(fields, tier.FieldConcurrentInstances)
+	}
+	if m.max_length_hours != nil {
+		fields = append(fields, tier.FieldMaxLengthHours)
+	}
+	if m.max_vcpu != nil {
+		fields = append(fields, tier.FieldMaxVcpu)
+	}
+	if m.max_ram_mb != nil {
+		fields = append(fields, tier.FieldMaxRAMMB)
+	}
+	if m.concurrent_vcpus != nil {
+		fields = append(fields, tier.FieldConcurrentVcpus)
+	}
+	if m.concurrent_ram_mb != nil {
+		fields = append(fields, tier.FieldConcurrentRAMMB)
+	}
+	if m.paused_storage_mb != nil {
+		fields = append(fields, tier.FieldPausedStorageMB)
+	}
+	if m.paused_retention_hours != nil {
+		fields = append(fields, tier.FieldPausedRetentionHours)
+	}
+	if m.max_checkpoints != nil {
+		fields = append(fields, tier.FieldMaxCheckpoints)
+	}
+	if m.network_bandwidth_mibps != nil {
+		fields = append(fields, tier.FieldNetworkBandwidthMibps)
+	}
+	if m.network_ops != nil {
+		fields = append(fields, tier.FieldNetworkOps)
+	}
+	if m.disk_bandwidth_mibps != nil {
+		fields = append(fields, tier.FieldDiskBandwidthMibps)
+	}
+	if m.disk_iops != nil {
+		fields = append(fields, tier.FieldDiskIops)
+	}
+	if m.api_requests_per_second != nil {
+		fields = append(fields, tier.FieldAPIRequestsPerSecond)
+	}
+	if m.api_requests_burst != nil {
+		fields = append(fields, tier.FieldAPIRequestsBurst)
+	}
+	if m.concurrent_template_builds != nil {
+		fields = append(fields, tier.FieldConcurrentTemplateBuilds)
+	}
+	if m.build_priority != nil {
+		fields = append(fields, tier.FieldBuildPriority)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TierMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tier.FieldName:
+		return m.Name()
+	case tier.FieldDiskMB:
+		return m.DiskMB()
+	case tier.FieldConcurrentInstances:
+		return m.ConcurrentInstances()
+	case tier.FieldMaxLengthHours:
+		return m.MaxLengthHours()
+	case tier.FieldMaxVcpu:
+		return m.MaxVcpu()
+	case tier.FieldMaxRAMMB:
+		return m.MaxRAMMB()
+	case tier.FieldConcurrentVcpus:
+		return m.ConcurrentVcpus()
+	case tier.FieldConcurrentRAMMB:
+		return m.ConcurrentRAMMB()
+	case tier.FieldPausedStorageMB:
+		return m.PausedStorageMB()
+	case tier.FieldPausedRetentionHours:
+		return m.PausedRetentionHours()
+	case tier.FieldMaxCheckpoints:
+		return m.MaxCheckpoints()
+	case tier.FieldNetworkBandwidthMibps:
+		return m.NetworkBandwidthMibps()
+	case tier.FieldNetworkOps:
+		return m.NetworkOps()
+	case tier.FieldDiskBandwidthMibps:
+		return m.DiskBandwidthMibps()
+	case tier.FieldDiskIops:
+		return m.DiskIops()
+	case tier.FieldAPIRequestsPerSecond:
+		return m.APIRequestsPerSecond()
+	case tier.FieldAPIRequestsBurst:
+		return m.APIRequestsBurst()
+	case tier.FieldConcurrentTemplateBuilds:
+		return m.ConcurrentTemplateBuilds()
+	case tier.FieldBuildPriority:
+		return m.BuildPriority()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tier.FieldName:
+		return m.OldName(ctx)
+	case tier.FieldDiskMB:
+		return m.OldDiskMB(ctx)
+	case tier.FieldConcurrentInstances:
+		return m.OldConcurrentInstances(ctx)
+	case tier.FieldMaxLengthHours:
+		return m.OldMaxLengthHours(ctx)
+	case tier.FieldMaxVcpu:
+		return m.OldMaxVcpu(ctx)
+	case tier.FieldMaxRAMMB:
+		return m.OldMaxRAMMB(ctx)
+	case tier.FieldConcurrentVcpus:
+		return m.OldConcurrentVcpus(ctx)
+	case tier.FieldConcurrentRAMMB:
+		return m.OldConcurrentRAMMB(ctx)
+	case tier.FieldPausedStorageMB:
+		return m.OldPausedStorageMB(ctx)
+	case tier.FieldPausedRetentionHours:
+		return m.OldPausedRetentionHours(ctx)
+	case tier.FieldMaxCheckpoints:
+		return m.OldMaxCheckpoints(ctx)
+	case tier.FieldNetworkBandwidthMibps:
+		return m.OldNetworkBandwidthMibps(ctx)
+	case tier.FieldNetworkOps:
+		return m.OldNetworkOps(ctx)
+	case tier.FieldDiskBandwidthMibps:
+		return m.OldDiskBandwidthMibps(ctx)
+	case tier.FieldDiskIops:
+		return m.OldDiskIops(ctx)
+	case tier.FieldAPIRequestsPerSecond:
+		return m.OldAPIRequestsPerSecond(ctx)
+	case tier.FieldAPIRequestsBurst:
+		return m.OldAPIRequestsBurst(ctx)
+	case tier.FieldConcurrentTemplateBuilds:
+		return m.OldConcurrentTemplateBuilds(ctx)
+	case tier.FieldBuildPriority:
+		return m.OldBuildPriority(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tier field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TierMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tier.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tier.FieldDiskMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiskMB(v)
+		return nil
+	case tier.FieldConcurrentInstances:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConcurrentInstances(v)
+		return nil
+	case tier.FieldMaxLengthHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLengthHours(v)
+		return nil
+	case tier.FieldMaxVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxVcpu(v)
+		return nil
+	case tier.FieldMaxRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRAMMB(v)
+		return nil
+	case tier.FieldConcurrentVcpus:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConcurrentVcpus(v)
+		return nil
+	case tier.FieldConcurrentRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConcurrentRAMMB(v)
+		return nil
+	case tier.FieldPausedStorageMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPausedStorageMB(v)
+		return nil
+	case tier.FieldPausedRetentionHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPausedRetentionHours(v)
+		return nil
+	case tier.FieldMaxCheckpoints:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxCheckpoints(v)
+		return nil
+	case tier.FieldNetworkBandwidthMibps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetworkBandwidthMibps(v)
+		return nil
+	case tier.FieldNetworkOps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetworkOps(v)
+		return nil
+	case tier.FieldDiskBandwidthMibps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiskBandwidthMibps(v)
+		return nil
+	case tier.FieldDiskIops:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiskIops(v)
+		return nil
+	case tier.FieldAPIRequestsPerSecond:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIRequestsPerSecond(v)
+		return nil
+	case tier.FieldAPIRequestsBurst:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIRequestsBurst(v)
+		return nil
+	case tier.FieldConcurrentTemplateBuilds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConcurrentTemplateBuilds(v)
+		return nil
+	case tier.FieldBuildPriority:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tier field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TierMutation) AddedFields() []string {
+	var fields []string
+	if m.adddisk_mb != nil {
+		fields = append(fields, tier.FieldDiskMB)
+	}
+	if m.addconcurrent_instances != nil {
+		fields = append(fields, tier.FieldConcurrentInstances)
+	}
+	if m.addmax_length_hours != nil {
+		fields = append(fields, tier.FieldMaxLengthHours)
+	}
+	if m.addmax_vcpu != nil {
+		fields = append(fields, tier.FieldMaxVcpu)
+	}
+	if m.addmax_ram_mb != nil {
+		fields = append(fields, tier.FieldMaxRAMMB)
+	}
+	if m.addconcurrent_vcpus != nil {
+		fields = append(fields, tier.FieldConcurrentVcpus)
+	}
+	if m.addconcurrent_ram_mb != nil {
+		fields = append(fields, tier.FieldConcurrentRAMMB)
+	}
+	if m.addpaused_storage_mb != nil {
+		fields = append(fields, tier.FieldPausedStorageMB)
+	}
+	if m.addpaused_retention_hours != nil {
+		fields = append(fields, tier.FieldPausedRetentionHours)
+	}
+	if m.addmax_checkpoints != nil {
+		fields = append(fields, tier.FieldMaxCheckpoints)
+	}
+	if m.addnetwork_bandwidth_mibps != nil {
+		fields = append(fields, tier.FieldNetworkBandwidthMibps)
+	}
+	if m.addnetwork_ops != nil {
+		fields = append(fields, tier.FieldNetworkOps)
+	}
+	if m.adddisk_bandwidth_mibps != nil {
+		fields = append(fields, tier.FieldDiskBandwidthMibps)
+	}
+	if m.adddisk_iops != nil {
+		fields = append(fields, tier.FieldDiskIops)
+	}
+	if m.addapi_requests_per_second != nil {
+		fields = append(fields, tier.FieldAPIRequestsPerSecond)
+	}
+	if m.addapi_requests_burst != nil {
+		fields = append(fields, tier.FieldAPIRequestsBurst)
+	}
+	if m.addconcurrent_template_builds != nil {
+		fields = append(fields, tier.FieldConcurrentTemplateBuilds)
+	}
+	if m.addbuild_priority != nil {
+		fields = append(fields, tier.FieldBuildPriority)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case team.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
+	case tier.FieldDiskMB:
+		return m.AddedDiskMB()
+	case tier.FieldConcurrentInstances:
+		return m.AddedConcurrentInstances()
+	case tier.FieldMaxLengthHours:
+		return m.AddedMaxLengthHours()
+	case tier.FieldMaxVcpu:
+		return m.AddedMaxVcpu()
+	case tier.FieldMaxRAMMB:
+		return m.AddedMaxRAMMB()
+	case tier.FieldConcurrentVcpus:
+		return m.AddedConcurrentVcpus()
+	case tier.FieldConcurrentRAMMB:
+		return m.AddedConcurrentRAMMB()
+	case tier.FieldPausedStorageMB:
+		return m.AddedPausedStorageMB()
+	case tier.FieldPausedRetentionHours:
+		return m.AddedPausedRetentionHours()
+	case tier.FieldMaxCheckpoints:
+		return m.AddedMaxCheckpoints()
+	case tier.FieldNetworkBandwidthMibps:
+		return m.AddedNetworkBandwidthMibps()
+	case tier.FieldNetworkOps:
+		return m.AddedNetworkOps()
+	case tier.FieldDiskBandwidthMibps:
+		return m.AddedDiskBandwidthMibps()
+	case tier.FieldDiskIops:
+		return m.AddedDiskIops()
+	case tier.FieldAPIRequestsPerSecond:
+		return m.AddedAPIRequestsPerSecond()
+	case tier.FieldAPIRequestsBurst:
+		return m.AddedAPIRequestsBurst()
+	case tier.FieldConcurrentTemplateBuilds:
+		return m.AddedConcurrentTemplateBuilds()
+	case tier.FieldBuildPriority:
+		return m.AddedBuildPriority()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TierMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tier.FieldDiskMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case team.EdgeTeamAPIKeys:
-		ids := make([]ent.Value, 0, len(m.team_api_keys))
-		for id := range m.team_api_keys {
-			ids = append(ids, id)
+		m.AddDiskMB(v)
+		return nil
+	case tier.FieldConcurrentInstances:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		return ids
-	case team.EdgeTeamTier:
-		if id := m.team_tier; id != nil {
-			return []ent.Value{*id}
+		m.AddConcurrentInstances(v)
+		return nil
+	case tier.FieldMaxLengthHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-	case team.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.envs))
-		for id := range m.envs {
+		m.AddMaxLengthHours(v)
+		return nil
+	case tier.FieldMaxVcpu:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxVcpu(v)
+		return nil
+	case tier.FieldMaxRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRAMMB(v)
+		return nil
+	case tier.FieldConcurrentVcpus:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConcurrentVcpus(v)
+		return nil
+	case tier.FieldConcurrentRAMMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConcurrentRAMMB(v)
+		return nil
+	case tier.FieldPausedStorageMB:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPausedStorageMB(v)
+		return nil
+	case tier.FieldPausedRetentionHours:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPausedRetentionHours(v)
+		return nil
+	case tier.FieldMaxCheckpoints:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxCheckpoints(v)
+		return nil
+	case tier.FieldNetworkBandwidthMibps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNetworkBandwidthMibps(v)
+		return nil
+	case tier.FieldNetworkOps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNetworkOps(v)
+		return nil
+	case tier.FieldDiskBandwidthMibps:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskBandwidthMibps(v)
+		return nil
+	case tier.FieldDiskIops:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiskIops(v)
+		return nil
+	case tier.FieldAPIRequestsPerSecond:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAPIRequestsPerSecond(v)
+		return nil
+	case tier.FieldAPIRequestsBurst:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAPIRequestsBurst(v)
+		return nil
+	case tier.FieldConcurrentTemplateBuilds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConcurrentTemplateBuilds(v)
+		return nil
+	case tier.FieldBuildPriority:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuildPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tier numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TierMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TierMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TierMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tier nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TierMutation) ResetField(name string) error {
+	switch name {
+	case tier.FieldName:
+		m.ResetName()
+		return nil
+	case tier.FieldDiskMB:
+		m.ResetDiskMB()
+		return nil
+	case tier.FieldConcurrentInstances:
+		m.ResetConcurrentInstances()
+		return nil
+	case tier.FieldMaxLengthHours:
+		m.ResetMaxLengthHours()
+		return nil
+	case tier.FieldMaxVcpu:
+		m.ResetMaxVcpu()
+		return nil
+	case tier.FieldMaxRAMMB:
+		m.ResetMaxRAMMB()
+		return nil
+	case tier.FieldConcurrentVcpus:
+		m.ResetConcurrentVcpus()
+		return nil
+	case tier.FieldConcurrentRAMMB:
+		m.ResetConcurrentRAMMB()
+		return nil
+	case tier.FieldPausedStorageMB:
+		m.ResetPausedStorageMB()
+		return nil
+	case tier.FieldPausedRetentionHours:
+		m.ResetPausedRetentionHours()
+		return nil
+	case tier.FieldMaxCheckpoints:
+		m.ResetMaxCheckpoints()
+		return nil
+	case tier.FieldNetworkBandwidthMibps:
+		m.ResetNetworkBandwidthMibps()
+		return nil
+	case tier.FieldNetworkOps:
+		m.ResetNetworkOps()
+		return nil
+	case tier.FieldDiskBandwidthMibps:
+		m.ResetDiskBandwidthMibps()
+		return nil
+	case tier.FieldDiskIops:
+		m.ResetDiskIops()
+		return nil
+	case tier.FieldAPIRequestsPerSecond:
+		m.ResetAPIRequestsPerSecond()
+		return nil
+	case tier.FieldAPIRequestsBurst:
+		m.ResetAPIRequestsBurst()
+		return nil
+	case tier.FieldConcurrentTemplateBuilds:
+		m.ResetConcurrentTemplateBuilds()
+		return nil
+	case tier.FieldBuildPriority:
+		m.ResetBuildPriority()
+		return nil
+	}
+	return fmt.Errorf("unknown Tier field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TierMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.teams != nil {
+		edges = append(edges, tier.EdgeTeams)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TierMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tier.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
 			ids = append(ids, id)
 		}
 		return ids
-	case team.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.users_teams))
-		for id := range m.users_teams {
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TierMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedteams != nil {
+		edges = append(edges, tier.EdgeTeams)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TierMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tier.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6325,827 +13933,1113 @@ func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedusers != nil {
-		edges = append(edges, team.EdgeUsers)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TierMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedteams {
+		edges = append(edges, tier.EdgeTeams)
 	}
-	if m.removedteam_api_keys != nil {
-		edges = append(edges, team.EdgeTeamAPIKeys)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TierMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tier.EdgeTeams:
+		return m.clearedteams
 	}
-	if m.removedenvs != nil {
-		edges = append(edges, team.EdgeEnvs)
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TierMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tier unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TierMutation) ResetEdge(name string) error {
+	switch name {
+	case tier.EdgeTeams:
+		m.ResetTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown Tier edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	email                   *string
+	clearedFields           map[string]struct{}
+	teams                   map[uuid.UUID]struct{}
+	removedteams            map[uuid.UUID]struct{}
+	clearedteams            bool
+	created_envs            map[string]struct{}
+	removedcreated_envs     map[string]struct{}
+	clearedcreated_envs     bool
+	access_tokens           map[uuid.UUID]struct{}
+	removedaccess_tokens    map[uuid.UUID]struct{}
+	clearedaccess_tokens    bool
+	created_api_keys        map[uuid.UUID]struct{}
+	removedcreated_api_keys map[uuid.UUID]struct{}
+	clearedcreated_api_keys bool
+	users_teams             map[int]struct{}
+	removedusers_teams      map[int]struct{}
+	clearedusers_teams      bool
+	done                    bool
+	oldValue                func(context.Context) (*User, error)
+	predicates              []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *UserMutation) AddTeamIDs(ids ...uuid.UUID) {
+	if m.teams == nil {
+		m.teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *UserMutation) ClearTeams() {
+	m.clearedteams = true
+}
+
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *UserMutation) TeamsCleared() bool {
+	return m.clearedteams
+}
+
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *UserMutation) RemoveTeamIDs(ids ...uuid.UUID) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[uuid.UUID]struct{})
 	}
-	if m.removedusers_teams != nil {
-		edges = append(edges, team.EdgeUsersTeams)
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case team.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeTeamAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedteam_api_keys))
-		for id := range m.removedteam_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeEnvs:
-		ids := make([]ent.Value, 0, len(m.removedenvs))
-		for id := range m.removedenvs {
-			ids = append(ids, id)
-		}
-		return ids
-	case team.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.removedusers_teams))
-		for id := range m.removedusers_teams {
-			ids = append(ids, id)
-		}
-		return ids
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *UserMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
 	}
-	return nil
+	return
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedusers {
-		edges = append(edges, team.EdgeUsers)
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *UserMutation) TeamsIDs() (ids []uuid.UUID) {
+	for id := range m.teams {
+		ids = append(ids, id)
 	}
-	if m.clearedteam_api_keys {
-		edges = append(edges, team.EdgeTeamAPIKeys)
+	return
+}
+
+// ResetTeams resets all changes to the "teams" edge.
+func (m *UserMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
+}
+
+// AddCreatedEnvIDs adds the "created_envs" edge to the Env entity by ids.
+func (m *UserMutation) AddCreatedEnvIDs(ids ...string) {
+	if m.created_envs == nil {
+		m.created_envs = make(map[string]struct{})
 	}
-	if m.clearedteam_tier {
-		edges = append(edges, team.EdgeTeamTier)
+	for i := range ids {
+		m.created_envs[ids[i]] = struct{}{}
 	}
-	if m.clearedenvs {
-		edges = append(edges, team.EdgeEnvs)
+}
+
+// ClearCreatedEnvs clears the "created_envs" edge to the Env entity.
+func (m *UserMutation) ClearCreatedEnvs() {
+	m.clearedcreated_envs = true
+}
+
+// CreatedEnvsCleared reports if the "created_envs" edge to the Env entity was cleared.
+func (m *UserMutation) CreatedEnvsCleared() bool {
+	return m.clearedcreated_envs
+}
+
+// RemoveCreatedEnvIDs removes the "created_envs" edge to the Env entity by IDs.
+func (m *UserMutation) RemoveCreatedEnvIDs(ids ...string) {
+	if m.removedcreated_envs == nil {
+		m.removedcreated_envs = make(map[string]struct{})
 	}
-	if m.clearedusers_teams {
-		edges = append(edges, team.EdgeUsersTeams)
+	for i := range ids {
+		delete(m.created_envs, ids[i])
+		m.removedcreated_envs[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *TeamMutation) EdgeCleared(name string) bool {
-	switch name {
-	case team.EdgeUsers:
-		return m.clearedusers
-	case team.EdgeTeamAPIKeys:
-		return m.clearedteam_api_keys
-	case team.EdgeTeamTier:
-		return m.clearedteam_tier
-	case team.EdgeEnvs:
-		return m.clearedenvs
-	case team.EdgeUsersTeams:
-		return m.clearedusers_teams
+// RemovedCreatedEnvs returns the removed IDs of the "created_envs" edge to the Env entity.
+func (m *UserMutation) RemovedCreatedEnvsIDs() (ids []string) {
+	for id := range m.removedcreated_envs {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *TeamMutation) ClearEdge(name string) error {
-	switch name {
-	case team.EdgeTeamTier:
-		m.ClearTeamTier()
-		return nil
+// CreatedEnvsIDs returns the "created_envs" edge IDs in the mutation.
+func (m *UserMutation) CreatedEnvsIDs() (ids []string) {
+	for id := range m.created_envs {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Team unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TeamMutation) ResetEdge(name string) error {
-	switch name {
-	case team.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case team.EdgeTeamAPIKeys:
-		m.ResetTeamAPIKeys()
-		return nil
-	case team.EdgeTeamTier:
-		m.ResetTeamTier()
-		return nil
-	case team.EdgeEnvs:
-		m.ResetEnvs()
-		return nil
-	case team.EdgeUsersTeams:
-		m.ResetUsersTeams()
-		return nil
-	}
-	return fmt.Errorf("unknown Team edge %s", name)
+// ResetCreatedEnvs resets all changes to the "created_envs" edge.
+func (m *UserMutation) ResetCreatedEnvs() {
+	m.created_envs = nil
+	m.clearedcreated_envs = false
+	m.removedcreated_envs = nil
 }
 
-// TeamAPIKeyMutation represents an operation that mutates the TeamAPIKey nodes in the graph.
-type TeamAPIKeyMutation struct {
-	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	api_key             *string
-	api_key_hash        *string
-	api_key_prefix      *string
-	api_key_length      *int
-	addapi_key_length   *int
-	api_key_mask_prefix *string
-	api_key_mask_suffix *string
-	created_at          *time.Time
-	updated_at          *time.Time
-	name                *string
-	last_used           *time.Time
-	clearedFields       map[string]struct{}
-	team                *uuid.UUID
-	clearedteam         bool
-	creator             *uuid.UUID
-	clearedcreator      bool
-	done                bool
-	oldValue            func(context.Context) (*TeamAPIKey, error)
-	predicates          []predicate.TeamAPIKey
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
+func (m *UserMutation) AddAccessTokenIDs(ids ...uuid.UUID) {
+	if m.access_tokens == nil {
+		m.access_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.access_tokens[ids[i]] = struct{}{}
+	}
 }
 
-var _ ent.Mutation = (*TeamAPIKeyMutation)(nil)
+// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) ClearAccessTokens() {
+	m.clearedaccess_tokens = true
+}
 
-// teamapikeyOption allows management of the mutation configuration using functional options.
-type teamapikeyOption func(*TeamAPIKeyMutation)
+// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
+func (m *UserMutation) AccessTokensCleared() bool {
+	return m.clearedaccess_tokens
+}
 
-// newTeamAPIKeyMutation creates new mutation for the TeamAPIKey entity.
-func newTeamAPIKeyMutation(c config, op Op, opts ...teamapikeyOption) *TeamAPIKeyMutation {
-	m := &TeamAPIKeyMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTeamAPIKey,
-		clearedFields: make(map[string]struct{}),
+// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
+func (m *UserMutation) RemoveAccessTokenIDs(ids ...uuid.UUID) {
+	if m.removedaccess_tokens == nil {
+		m.removedaccess_tokens = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.access_tokens, ids[i])
+		m.removedaccess_tokens[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withTeamAPIKeyID sets the ID field of the mutation.
-func withTeamAPIKeyID(id uuid.UUID) teamapikeyOption {
-	return func(m *TeamAPIKeyMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *TeamAPIKey
-		)
-		m.oldValue = func(ctx context.Context) (*TeamAPIKey, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().TeamAPIKey.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) RemovedAccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedaccess_tokens {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withTeamAPIKey sets the old TeamAPIKey of the mutation.
-func withTeamAPIKey(node *TeamAPIKey) teamapikeyOption {
-	return func(m *TeamAPIKeyMutation) {
-		m.oldValue = func(context.Context) (*TeamAPIKey, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
+func (m *UserMutation) AccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m.access_tokens {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TeamAPIKeyMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetAccessTokens resets all changes to the "access_tokens" edge.
+func (m *UserMutation) ResetAccessTokens() {
+	m.access_tokens = nil
+	m.clearedaccess_tokens = false
+	m.removedaccess_tokens = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TeamAPIKeyMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("models: mutation is not running in a transaction")
+// AddCreatedAPIKeyIDs adds the "created_api_keys" edge to the TeamAPIKey entity by ids.
+func (m *UserMutation) AddCreatedAPIKeyIDs(ids ...uuid.UUID) {
+	if m.created_api_keys == nil {
+		m.created_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.created_api_keys[ids[i]] = struct{}{}
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TeamAPIKey entities.
-func (m *TeamAPIKeyMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearCreatedAPIKeys clears the "created_api_keys" edge to the TeamAPIKey entity.
+func (m *UserMutation) ClearCreatedAPIKeys() {
+	m.clearedcreated_api_keys = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TeamAPIKeyMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// CreatedAPIKeysCleared reports if the "created_api_keys" edge to the TeamAPIKey entity was cleared.
+func (m *UserMutation) CreatedAPIKeysCleared() bool {
+	return m.clearedcreated_api_keys
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TeamAPIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TeamAPIKey.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// RemoveCreatedAPIKeyIDs removes the "created_api_keys" edge to the TeamAPIKey entity by IDs.
+func (m *UserMutation) RemoveCreatedAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedcreated_api_keys == nil {
+		m.removedcreated_api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.created_api_keys, ids[i])
+		m.removedcreated_api_keys[ids[i]] = struct{}{}
 	}
 }
 
-// SetAPIKey sets the "api_key" field.
-func (m *TeamAPIKeyMutation) SetAPIKey(s string) {
-	m.api_key = &s
+// RemovedCreatedAPIKeys returns the removed IDs of the "created_api_keys" edge to the TeamAPIKey entity.
+func (m *UserMutation) RemovedCreatedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedcreated_api_keys {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// APIKey returns the value of the "api_key" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKey() (r string, exists bool) {
-	v := m.api_key
-	if v == nil {
-		return
+// CreatedAPIKeysIDs returns the "created_api_keys" edge IDs in the mutation.
+func (m *UserMutation) CreatedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.created_api_keys {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldAPIKey returns the old "api_key" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKey requires an ID field in the mutation")
+// ResetCreatedAPIKeys resets all changes to the "created_api_keys" edge.
+func (m *UserMutation) ResetCreatedAPIKeys() {
+	m.created_api_keys = nil
+	m.clearedcreated_api_keys = false
+	m.removedcreated_api_keys = nil
+}
+
+// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
+func (m *UserMutation) AddUsersTeamIDs(ids ...int) {
+	if m.users_teams == nil {
+		m.users_teams = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
+	for i := range ids {
+		m.users_teams[ids[i]] = struct{}{}
 	}
-	return oldValue.APIKey, nil
 }
 
-// ResetAPIKey resets all changes to the "api_key" field.
-func (m *TeamAPIKeyMutation) ResetAPIKey() {
-	m.api_key = nil
+// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
+func (m *UserMutation) ClearUsersTeams() {
+	m.clearedusers_teams = true
 }
 
-// SetAPIKeyHash sets the "api_key_hash" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyHash(s string) {
-	m.api_key_hash = &s
+// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
+func (m *UserMutation) UsersTeamsCleared() bool {
+	return m.clearedusers_teams
 }
 
-// APIKeyHash returns the value of the "api_key_hash" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyHash() (r string, exists bool) {
-	v := m.api_key_hash
-	if v == nil {
-		return
+// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
+func (m *UserMutation) RemoveUsersTeamIDs(ids ...int) {
+	if m.removedusers_teams == nil {
+		m.removedusers_teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.users_teams, ids[i])
+		m.removedusers_teams[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldAPIKeyHash returns the old "api_key_hash" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyHash(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyHash is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyHash requires an ID field in the mutation")
+// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
+func (m *UserMutation) RemovedUsersTeamsIDs() (ids []int) {
+	for id := range m.removedusers_teams {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyHash: %w", err)
+	return
+}
+
+// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
+func (m *UserMutation) UsersTeamsIDs() (ids []int) {
+	for id := range m.users_teams {
+		ids = append(ids, id)
 	}
-	return oldValue.APIKeyHash, nil
+	return
 }
 
-// ResetAPIKeyHash resets all changes to the "api_key_hash" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyHash() {
-	m.api_key_hash = nil
+// ResetUsersTeams resets all changes to the "users_teams" edge.
+func (m *UserMutation) ResetUsersTeams() {
+	m.users_teams = nil
+	m.clearedusers_teams = false
+	m.removedusers_teams = nil
 }
 
-// SetAPIKeyPrefix sets the "api_key_prefix" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyPrefix(s string) {
-	m.api_key_prefix = &s
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// APIKeyPrefix returns the value of the "api_key_prefix" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyPrefix() (r string, exists bool) {
-	v := m.api_key_prefix
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldAPIKeyPrefix returns the old "api_key_prefix" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyPrefix(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyPrefix is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyPrefix requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyPrefix: %w", err)
-	}
-	return oldValue.APIKeyPrefix, nil
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
 }
 
-// ResetAPIKeyPrefix resets all changes to the "api_key_prefix" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyPrefix() {
-	m.api_key_prefix = nil
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetAPIKeyLength sets the "api_key_length" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyLength(i int) {
-	m.api_key_length = &i
-	m.addapi_key_length = nil
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
 }
 
-// APIKeyLength returns the value of the "api_key_length" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyLength() (r int, exists bool) {
-	v := m.api_key_length
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
-	return *v, true
+	return fields
 }
 
-// OldAPIKeyLength returns the old "api_key_length" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyLength(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyLength is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldEmail:
+		return m.Email()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyLength requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyLength: %w", err)
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
 	}
-	return oldValue.APIKeyLength, nil
+	return fmt.Errorf("unknown User field %s", name)
 }
 
-// AddAPIKeyLength adds i to the "api_key_length" field.
-func (m *TeamAPIKeyMutation) AddAPIKeyLength(i int) {
-	if m.addapi_key_length != nil {
-		*m.addapi_key_length += i
-	} else {
-		m.addapi_key_length = &i
-	}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
-// AddedAPIKeyLength returns the value that was added to the "api_key_length" field in this mutation.
-func (m *TeamAPIKeyMutation) AddedAPIKeyLength() (r int, exists bool) {
-	v := m.addapi_key_length
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
-// ResetAPIKeyLength resets all changes to the "api_key_length" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyLength() {
-	m.api_key_length = nil
-	m.addapi_key_length = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetAPIKeyMaskPrefix sets the "api_key_mask_prefix" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyMaskPrefix(s string) {
-	m.api_key_mask_prefix = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// APIKeyMaskPrefix returns the value of the "api_key_mask_prefix" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyMaskPrefix() (r string, exists bool) {
-	v := m.api_key_mask_prefix
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown User field %s", name)
 }
 
-// OldAPIKeyMaskPrefix returns the old "api_key_mask_prefix" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyMaskPrefix(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyMaskPrefix is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.teams != nil {
+		edges = append(edges, user.EdgeTeams)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyMaskPrefix requires an ID field in the mutation")
+	if m.created_envs != nil {
+		edges = append(edges, user.EdgeCreatedEnvs)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyMaskPrefix: %w", err)
+	if m.access_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
 	}
-	return oldValue.APIKeyMaskPrefix, nil
-}
-
-// ResetAPIKeyMaskPrefix resets all changes to the "api_key_mask_prefix" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyMaskPrefix() {
-	m.api_key_mask_prefix = nil
-}
-
-// SetAPIKeyMaskSuffix sets the "api_key_mask_suffix" field.
-func (m *TeamAPIKeyMutation) SetAPIKeyMaskSuffix(s string) {
-	m.api_key_mask_suffix = &s
+	if m.created_api_keys != nil {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.users_teams != nil {
+		edges = append(edges, user.EdgeUsersTeams)
+	}
+	return edges
 }
 
-// APIKeyMaskSuffix returns the value of the "api_key_mask_suffix" field in the mutation.
-func (m *TeamAPIKeyMutation) APIKeyMaskSuffix() (r string, exists bool) {
-	v := m.api_key_mask_suffix
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedEnvs:
+		ids := make([]ent.Value, 0, len(m.created_envs))
+		for id := range m.created_envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.access_tokens))
+		for id := range m.access_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedAPIKeys:
+		ids := make([]ent.Value, 0, len(m.created_api_keys))
+		for id := range m.created_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.users_teams))
+		for id := range m.users_teams {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldAPIKeyMaskSuffix returns the old "api_key_mask_suffix" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldAPIKeyMaskSuffix(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyMaskSuffix is only allowed on UpdateOne operations")
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedteams != nil {
+		edges = append(edges, user.EdgeTeams)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyMaskSuffix requires an ID field in the mutation")
+	if m.removedcreated_envs != nil {
+		edges = append(edges, user.EdgeCreatedEnvs)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyMaskSuffix: %w", err)
+	if m.removedaccess_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
 	}
-	return oldValue.APIKeyMaskSuffix, nil
+	if m.removedcreated_api_keys != nil {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.removedusers_teams != nil {
+		edges = append(edges, user.EdgeUsersTeams)
+	}
+	return edges
 }
 
-// ResetAPIKeyMaskSuffix resets all changes to the "api_key_mask_suffix" field.
-func (m *TeamAPIKeyMutation) ResetAPIKeyMaskSuffix() {
-	m.api_key_mask_suffix = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedEnvs:
+		ids := make([]ent.Value, 0, len(m.removedcreated_envs))
+		for id := range m.removedcreated_envs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
+		for id := range m.removedaccess_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedcreated_api_keys))
+		for id := range m.removedcreated_api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsersTeams:
+		ids := make([]ent.Value, 0, len(m.removedusers_teams))
+		for id := range m.removedusers_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TeamAPIKeyMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedteams {
+		edges = append(edges, user.EdgeTeams)
+	}
+	if m.clearedcreated_envs {
+		edges = append(edges, user.EdgeCreatedEnvs)
+	}
+	if m.clearedaccess_tokens {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.clearedcreated_api_keys {
+		edges = append(edges, user.EdgeCreatedAPIKeys)
+	}
+	if m.clearedusers_teams {
+		edges = append(edges, user.EdgeUsersTeams)
+	}
+	return edges
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TeamAPIKeyMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeTeams:
+		return m.clearedteams
+	case user.EdgeCreatedEnvs:
+		return m.clearedcreated_envs
+	case user.EdgeAccessTokens:
+		return m.clearedaccess_tokens
+	case user.EdgeCreatedAPIKeys:
+		return m.clearedcreated_api_keys
+	case user.EdgeUsersTeams:
+		return m.clearedusers_teams
 	}
-	return *v, true
+	return false
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TeamAPIKeyMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeTeams:
+		m.ResetTeams()
+		return nil
+	case user.EdgeCreatedEnvs:
+		m.ResetCreatedEnvs()
+		return nil
+	case user.EdgeAccessTokens:
+		m.ResetAccessTokens()
+		return nil
+	case user.EdgeCreatedAPIKeys:
+		m.ResetCreatedAPIKeys()
+		return nil
+	case user.EdgeUsersTeams:
+		m.ResetUsersTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TeamAPIKeyMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// UsersTeamsMutation represents an operation that mutates the UsersTeams nodes in the graph.
+type UsersTeamsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	is_default    *bool
+	clearedFields map[string]struct{}
+	users         *uuid.UUID
+	clearedusers  bool
+	teams         *uuid.UUID
+	clearedteams  bool
+	done          bool
+	oldValue      func(context.Context) (*UsersTeams, error)
+	predicates    []predicate.UsersTeams
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TeamAPIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*UsersTeamsMutation)(nil)
 
-// OldUpdatedAt returns the old "updated_at" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// usersteamsOption allows management of the mutation configuration using functional options.
+type usersteamsOption func(*UsersTeamsMutation)
+
+// newUsersTeamsMutation creates new mutation for the UsersTeams entity.
+func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeamsMutation {
+	m := &UsersTeamsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUsersTeams,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.UpdatedAt, nil
+	return m
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *TeamAPIKeyMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[teamapikey.FieldUpdatedAt] = struct{}{}
+// withUsersTeamsID sets the ID field of the mutation.
+func withUsersTeamsID(id int) usersteamsOption {
+	return func(m *UsersTeamsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UsersTeams
+		)
+		m.oldValue = func(ctx context.Context) (*UsersTeams, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UsersTeams.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldUpdatedAt]
-	return ok
+// withUsersTeams sets the old UsersTeams of the mutation.
+func withUsersTeams(node *UsersTeams) usersteamsOption {
+	return func(m *UsersTeamsMutation) {
+		m.oldValue = func(context.Context) (*UsersTeams, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TeamAPIKeyMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, teamapikey.FieldUpdatedAt)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UsersTeamsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *TeamAPIKeyMutation) SetTeamID(u uuid.UUID) {
-	m.team = &u
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UsersTeamsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("models: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *TeamAPIKeyMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.team
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UsersTeamsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UsersTeamsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UsersTeams.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.TeamID, nil
-}
-
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *TeamAPIKeyMutation) ResetTeamID() {
-	m.team = nil
 }
 
-// SetName sets the "name" field.
-func (m *TeamAPIKeyMutation) SetName(s string) {
-	m.name = &s
+// SetUserID sets the "user_id" field.
+func (m *UsersTeamsMutation) SetUserID(u uuid.UUID) {
+	m.users = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TeamAPIKeyMutation) Name() (r string, exists bool) {
-	v := m.name
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UsersTeamsMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.users
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UsersTeamsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.UserID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TeamAPIKeyMutation) ResetName() {
-	m.name = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UsersTeamsMutation) ResetUserID() {
+	m.users = nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *TeamAPIKeyMutation) SetCreatedBy(u uuid.UUID) {
-	m.creator = &u
+// SetTeamID sets the "team_id" field.
+func (m *UsersTeamsMutation) SetTeamID(u uuid.UUID) {
+	m.teams = &u
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *TeamAPIKeyMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.creator
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *UsersTeamsMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.teams
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.CreatedBy, nil
-}
-
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *TeamAPIKeyMutation) ClearCreatedBy() {
-	m.creator = nil
-	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
-}
-
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldCreatedBy]
-	return ok
+	return oldValue.TeamID, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *TeamAPIKeyMutation) ResetCreatedBy() {
-	m.creator = nil
-	delete(m.clearedFields, teamapikey.FieldCreatedBy)
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *UsersTeamsMutation) ResetTeamID() {
+	m.teams = nil
 }
 
-// SetLastUsed sets the "last_used" field.
-func (m *TeamAPIKeyMutation) SetLastUsed(t time.Time) {
-	m.last_used = &t
+// SetIsDefault sets the "is_default" field.
+func (m *UsersTeamsMutation) SetIsDefault(b bool) {
+	m.is_default = &b
 }
 
-// LastUsed returns the value of the "last_used" field in the mutation.
-func (m *TeamAPIKeyMutation) LastUsed() (r time.Time, exists bool) {
-	v := m.last_used
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *UsersTeamsMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUsed returns the old "last_used" field's value of the TeamAPIKey entity.
-// If the TeamAPIKey object wasn't provided to the builder, the object is fetched from the database.
+// OldIsDefault returns the old "is_default" field's value of the UsersTeams entity.
+// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TeamAPIKeyMutation) OldLastUsed(ctx context.Context) (v *time.Time, err error) {
+func (m *UsersTeamsMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUsed requires an ID field in the mutation")
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
 	}
-	return oldValue.LastUsed, nil
+	return oldValue.IsDefault, nil
 }
 
-// ClearLastUsed clears the value of the "last_used" field.
-func (m *TeamAPIKeyMutation) ClearLastUsed() {
-	m.last_used = nil
-	m.clearedFields[teamapikey.FieldLastUsed] = struct{}{}
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *UsersTeamsMutation) ResetIsDefault() {
+	m.is_default = nil
 }
 
-// LastUsedCleared returns if the "last_used" field was cleared in this mutation.
-func (m *TeamAPIKeyMutation) LastUsedCleared() bool {
-	_, ok := m.clearedFields[teamapikey.FieldLastUsed]
-	return ok
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *UsersTeamsMutation) SetUsersID(id uuid.UUID) {
+	m.users = &id
 }
 
-// ResetLastUsed resets all changes to the "last_used" field.
-func (m *TeamAPIKeyMutation) ResetLastUsed() {
-	m.last_used = nil
-	delete(m.clearedFields, teamapikey.FieldLastUsed)
+// ClearUsers clears the "users" edge to the User entity.
+func (m *UsersTeamsMutation) ClearUsers() {
+	m.clearedusers = true
+	m.clearedFields[usersteams.FieldUserID] = struct{}{}
 }
 
-// ClearTeam clears the "team" edge to the Team entity.
-func (m *TeamAPIKeyMutation) ClearTeam() {
-	m.clearedteam = true
-	m.clearedFields[teamapikey.FieldTeamID] = struct{}{}
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *UsersTeamsMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// TeamCleared reports if the "team" edge to the Team entity was cleared.
-func (m *TeamAPIKeyMutation) TeamCleared() bool {
-	return m.clearedteam
+// UsersID returns the "users" edge ID in the mutation.
+func (m *UsersTeamsMutation) UsersID() (id uuid.UUID, exists bool) {
+	if m.users != nil {
+		return *m.users, true
+	}
+	return
 }
 
-// TeamIDs returns the "team" edge IDs in the mutation.
+// UsersIDs returns the "users" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamID instead. It exists only for internal usage by the builders.
-func (m *TeamAPIKeyMutation) TeamIDs() (ids []uuid.UUID) {
-	if id := m.team; id != nil {
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *UsersTeamsMutation) UsersIDs() (ids []uuid.UUID) {
+	if id := m.users; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTeam resets all changes to the "team" edge.
-func (m *TeamAPIKeyMutation) ResetTeam() {
-	m.team = nil
-	m.clearedteam = false
+// ResetUsers resets all changes to the "users" edge.
+func (m *UsersTeamsMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *TeamAPIKeyMutation) SetCreatorID(id uuid.UUID) {
-	m.creator = &id
+// SetTeamsID sets the "teams" edge to the Team entity by id.
+func (m *UsersTeamsMutation) SetTeamsID(id uuid.UUID) {
+	m.teams = &id
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *TeamAPIKeyMutation) ClearCreator() {
-	m.clearedcreator = true
-	m.clearedFields[teamapikey.FieldCreatedBy] = struct{}{}
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *UsersTeamsMutation) ClearTeams() {
+	m.clearedteams = true
+	m.clearedFields[usersteams.FieldTeamID] = struct{}{}
 }
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *TeamAPIKeyMutation) CreatorCleared() bool {
-	return m.CreatedByCleared() || m.clearedcreator
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *UsersTeamsMutation) TeamsCleared() bool {
+	return m.clearedteams
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *TeamAPIKeyMutation) CreatorID() (id uuid.UUID, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// TeamsID returns the "teams" edge ID in the mutation.
+func (m *UsersTeamsMutation) TeamsID() (id uuid.UUID, exists bool) {
+	if m.teams != nil {
+		return *m.teams, true
 	}
 	return
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
+// TeamsIDs returns the "teams" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *TeamAPIKeyMutation) CreatorIDs() (ids []uuid.UUID) {
-	if id := m.creator; id != nil {
+// TeamsID instead. It exists only for internal usage by the builders.
+func (m *UsersTeamsMutation) TeamsIDs() (ids []uuid.UUID) {
+	if id := m.teams; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCreator resets all changes to the "creator" edge.
-func (m *TeamAPIKeyMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
+// ResetTeams resets all changes to the "teams" edge.
+func (m *UsersTeamsMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
 }
 
-// Where appends a list predicates to the TeamAPIKeyMutation builder.
-func (m *TeamAPIKeyMutation) Where(ps ...predicate.TeamAPIKey) {
+// Where appends a list predicates to the UsersTeamsMutation builder.
+func (m *UsersTeamsMutation) Where(ps ...predicate.UsersTeams) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TeamAPIKeyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsersTeamsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TeamAPIKey, len(ps))
+func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsersTeams, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7153,60 +15047,33 @@ func (m *TeamAPIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TeamAPIKeyMutation) Op() Op {
+func (m *UsersTeamsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TeamAPIKeyMutation) SetOp(op Op) {
+func (m *UsersTeamsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TeamAPIKey).
-func (m *TeamAPIKeyMutation) Type() string {
+// Type returns the node type of this mutation (UsersTeams).
+func (m *UsersTeamsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TeamAPIKeyMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.api_key != nil {
-		fields = append(fields, teamapikey.FieldAPIKey)
-	}
-	if m.api_key_hash != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyHash)
-	}
-	if m.api_key_prefix != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyPrefix)
-	}
-	if m.api_key_length != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyLength)
-	}
-	if m.api_key_mask_prefix != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyMaskPrefix)
-	}
-	if m.api_key_mask_suffix != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyMaskSuffix)
-	}
-	if m.created_at != nil {
-		fields = append(fields, teamapikey.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, teamapikey.FieldUpdatedAt)
-	}
-	if m.team != nil {
-		fields = append(fields, teamapikey.FieldTeamID)
-	}
-	if m.name != nil {
-		fields = append(fields, teamapikey.FieldName)
+func (m *UsersTeamsMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.users != nil {
+		fields = append(fields, usersteams.FieldUserID)
 	}
-	if m.creator != nil {
-		fields = append(fields, teamapikey.FieldCreatedBy)
+	if m.teams != nil {
+		fields = append(fields, usersteams.FieldTeamID)
 	}
-	if m.last_used != nil {
-		fields = append(fields, teamapikey.FieldLastUsed)
+	if m.is_default != nil {
+		fields = append(fields, usersteams.FieldIsDefault)
 	}
 	return fields
 }
@@ -7214,32 +15081,14 @@ func (m *TeamAPIKeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
+func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		return m.APIKey()
-	case teamapikey.FieldAPIKeyHash:
-		return m.APIKeyHash()
-	case teamapikey.FieldAPIKeyPrefix:
-		return m.APIKeyPrefix()
-	case teamapikey.FieldAPIKeyLength:
-		return m.APIKeyLength()
-	case teamapikey.FieldAPIKeyMaskPrefix:
-		return m.APIKeyMaskPrefix()
-	case teamapikey.FieldAPIKeyMaskSuffix:
-		return m.APIKeyMaskSuffix()
-	case teamapikey.FieldCreatedAt:
-		return m.CreatedAt()
-	case teamapikey.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case teamapikey.FieldTeamID:
+	case usersteams.FieldUserID:
+		return m.UserID()
+	case usersteams.FieldTeamID:
 		return m.TeamID()
-	case teamapikey.FieldName:
-		return m.Name()
-	case teamapikey.FieldCreatedBy:
-		return m.CreatedBy()
-	case teamapikey.FieldLastUsed:
-		return m.LastUsed()
+	case usersteams.FieldIsDefault:
+		return m.IsDefault()
 	}
 	return nil, false
 }
@@ -7247,272 +15096,128 @@ func (m *TeamAPIKeyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TeamAPIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsersTeamsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		return m.OldAPIKey(ctx)
-	case teamapikey.FieldAPIKeyHash:
-		return m.OldAPIKeyHash(ctx)
-	case teamapikey.FieldAPIKeyPrefix:
-		return m.OldAPIKeyPrefix(ctx)
-	case teamapikey.FieldAPIKeyLength:
-		return m.OldAPIKeyLength(ctx)
-	case teamapikey.FieldAPIKeyMaskPrefix:
-		return m.OldAPIKeyMaskPrefix(ctx)
-	case teamapikey.FieldAPIKeyMaskSuffix:
-		return m.OldAPIKeyMaskSuffix(ctx)
-	case teamapikey.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case teamapikey.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case teamapikey.FieldTeamID:
+	case usersteams.FieldUserID:
+		return m.OldUserID(ctx)
+	case usersteams.FieldTeamID:
 		return m.OldTeamID(ctx)
-	case teamapikey.FieldName:
-		return m.OldName(ctx)
-	case teamapikey.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case teamapikey.FieldLastUsed:
-		return m.OldLastUsed(ctx)
+	case usersteams.FieldIsDefault:
+		return m.OldIsDefault(ctx)
 	}
-	return nil, fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return nil, fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamAPIKeyMutation) SetField(name string, value ent.Value) error {
+func (m *UsersTeamsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKey(v)
-		return nil
-	case teamapikey.FieldAPIKeyHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKeyHash(v)
-		return nil
-	case teamapikey.FieldAPIKeyPrefix:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKeyPrefix(v)
-		return nil
-	case teamapikey.FieldAPIKeyLength:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKeyLength(v)
-		return nil
-	case teamapikey.FieldAPIKeyMaskPrefix:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKeyMaskPrefix(v)
-		return nil
-	case teamapikey.FieldAPIKeyMaskSuffix:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAPIKeyMaskSuffix(v)
-		return nil
-	case teamapikey.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case teamapikey.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case teamapikey.FieldTeamID:
+	case usersteams.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamID(v)
-		return nil
-	case teamapikey.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
+		m.SetUserID(v)
 		return nil
-	case teamapikey.FieldCreatedBy:
+	case usersteams.FieldTeamID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetTeamID(v)
 		return nil
-	case teamapikey.FieldLastUsed:
-		v, ok := value.(time.Time)
+	case usersteams.FieldIsDefault:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUsed(v)
+		m.SetIsDefault(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TeamAPIKeyMutation) AddedFields() []string {
-	var fields []string
-	if m.addapi_key_length != nil {
-		fields = append(fields, teamapikey.FieldAPIKeyLength)
-	}
-	return fields
+func (m *UsersTeamsMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TeamAPIKeyMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case teamapikey.FieldAPIKeyLength:
-		return m.AddedAPIKeyLength()
-	}
+func (m *UsersTeamsMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TeamAPIKeyMutation) AddField(name string, value ent.Value) error {
+func (m *UsersTeamsMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case teamapikey.FieldAPIKeyLength:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAPIKeyLength(v)
-		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey numeric field %s", name)
+	return fmt.Errorf("unknown UsersTeams numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TeamAPIKeyMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(teamapikey.FieldUpdatedAt) {
-		fields = append(fields, teamapikey.FieldUpdatedAt)
-	}
-	if m.FieldCleared(teamapikey.FieldCreatedBy) {
-		fields = append(fields, teamapikey.FieldCreatedBy)
-	}
-	if m.FieldCleared(teamapikey.FieldLastUsed) {
-		fields = append(fields, teamapikey.FieldLastUsed)
-	}
-	return fields
+func (m *UsersTeamsMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TeamAPIKeyMutation) FieldCleared(name string) bool {
+func (m *UsersTeamsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TeamAPIKeyMutation) ClearField(name string) error {
-	switch name {
-	case teamapikey.FieldUpdatedAt:
-		m.ClearUpdatedAt()
-		return nil
-	case teamapikey.FieldCreatedBy:
-		m.ClearCreatedBy()
-		return nil
-	case teamapikey.FieldLastUsed:
-		m.ClearLastUsed()
-		return nil
-	}
-	return fmt.Errorf("unknown TeamAPIKey nullable field %s", name)
+func (m *UsersTeamsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UsersTeams nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TeamAPIKeyMutation) ResetField(name string) error {
+func (m *UsersTeamsMutation) ResetField(name string) error {
 	switch name {
-	case teamapikey.FieldAPIKey:
-		m.ResetAPIKey()
-		return nil
-	case teamapikey.FieldAPIKeyHash:
-		m.ResetAPIKeyHash()
-		return nil
-	case teamapikey.FieldAPIKeyPrefix:
-		m.ResetAPIKeyPrefix()
-		return nil
-	case teamapikey.FieldAPIKeyLength:
-		m.ResetAPIKeyLength()
-		return nil
-	case teamapikey.FieldAPIKeyMaskPrefix:
-		m.ResetAPIKeyMaskPrefix()
-		return nil
-	case teamapikey.FieldAPIKeyMaskSuffix:
-		m.ResetAPIKeyMaskSuffix()
-		return nil
-	case teamapikey.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case teamapikey.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case usersteams.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case teamapikey.FieldTeamID:
+	case usersteams.FieldTeamID:
 		m.ResetTeamID()
 		return nil
-	case teamapikey.FieldName:
-		m.ResetName()
-		return nil
-	case teamapikey.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case teamapikey.FieldLastUsed:
-		m.ResetLastUsed()
+	case usersteams.FieldIsDefault:
+		m.ResetIsDefault()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey field %s", name)
+	return fmt.Errorf("unknown UsersTeams field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TeamAPIKeyMutation) AddedEdges() []string {
+func (m *UsersTeamsMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.team != nil {
-		edges = append(edges, teamapikey.EdgeTeam)
+	if m.users != nil {
+		edges = append(edges, usersteams.EdgeUsers)
 	}
-	if m.creator != nil {
-		edges = append(edges, teamapikey.EdgeCreator)
+	if m.teams != nil {
+		edges = append(edges, usersteams.EdgeTeams)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TeamAPIKeyMutation) AddedIDs(name string) []ent.Value {
+func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case teamapikey.EdgeTeam:
-		if id := m.team; id != nil {
+	case usersteams.EdgeUsers:
+		if id := m.users; id != nil {
 			return []ent.Value{*id}
 		}
-	case teamapikey.EdgeCreator:
-		if id := m.creator; id != nil {
+	case usersteams.EdgeTeams:
+		if id := m.teams; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -7520,102 +15225,100 @@ func (m *TeamAPIKeyMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TeamAPIKeyMutation) RemovedEdges() []string {
+func (m *UsersTeamsMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TeamAPIKeyMutation) RemovedIDs(name string) []ent.Value {
+func (m *UsersTeamsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TeamAPIKeyMutation) ClearedEdges() []string {
+func (m *UsersTeamsMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedteam {
-		edges = append(edges, teamapikey.EdgeTeam)
+	if m.clearedusers {
+		edges = append(edges, usersteams.EdgeUsers)
 	}
-	if m.clearedcreator {
-		edges = append(edges, teamapikey.EdgeCreator)
+	if m.clearedteams {
+		edges = append(edges, usersteams.EdgeTeams)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TeamAPIKeyMutation) EdgeCleared(name string) bool {
+func (m *UsersTeamsMutation) EdgeCleared(name string) bool {
 	switch name {
-	case teamapikey.EdgeTeam:
-		return m.clearedteam
-	case teamapikey.EdgeCreator:
-		return m.clearedcreator
+	case usersteams.EdgeUsers:
+		return m.clearedusers
+	case usersteams.EdgeTeams:
+		return m.clearedteams
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TeamAPIKeyMutation) ClearEdge(name string) error {
+func (m *UsersTeamsMutation) ClearEdge(name string) error {
 	switch name {
-	case teamapikey.EdgeTeam:
-		m.ClearTeam()
+	case usersteams.EdgeUsers:
+		m.ClearUsers()
 		return nil
-	case teamapikey.EdgeCreator:
-		m.ClearCreator()
+	case usersteams.EdgeTeams:
+		m.ClearTeams()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey unique edge %s", name)
+	return fmt.Errorf("unknown UsersTeams unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TeamAPIKeyMutation) ResetEdge(name string) error {
+func (m *UsersTeamsMutation) ResetEdge(name string) error {
 	switch name {
-	case teamapikey.EdgeTeam:
-		m.ResetTeam()
-		return nil
-	case teamapikey.EdgeCreator:
-		m.ResetCreator()
+	case usersteams.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case usersteams.EdgeTeams:
+		m.ResetTeams()
 		return nil
 	}
-	return fmt.Errorf("unknown TeamAPIKey edge %s", name)
+	return fmt.Errorf("unknown UsersTeams edge %s", name)
 }
 
-// TierMutation represents an operation that mutates the Tier nodes in the graph.
-type TierMutation struct {
+// WarmPoolMutation represents an operation that mutates the WarmPool nodes in the graph.
+type WarmPoolMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *string
-	name                    *string
-	disk_mb                 *int64
-	adddisk_mb              *int64
-	concurrent_instances    *int64
-	addconcurrent_instances *int64
-	max_length_hours        *int64
-	addmax_length_hours     *int64
-	clearedFields           map[string]struct{}
-	teams                   map[uuid.UUID]struct{}
-	removedteams            map[uuid.UUID]struct{}
-	clearedteams            bool
-	done                    bool
-	oldValue                func(context.Context) (*Tier, error)
-	predicates              []predicate.Tier
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	size          *int32
+	addsize       *int32
+	clearedFields map[string]struct{}
+	team          *uuid.UUID
+	clearedteam   bool
+	env           *string
+	clearedenv    bool
+	done          bool
+	oldValue      func(context.Context) (*WarmPool, error)
+	predicates    []predicate.WarmPool
 }
 
-var _ ent.Mutation = (*TierMutation)(nil)
+var _ ent.Mutation = (*WarmPoolMutation)(nil)
 
-// tierOption allows management of the mutation configuration using functional options.
-type tierOption func(*TierMutation)
+// warmpoolOption allows management of the mutation configuration using functional options.
+type warmpoolOption func(*WarmPoolMutation)
 
-// newTierMutation creates new mutation for the Tier entity.
-func newTierMutation(c config, op Op, opts ...tierOption) *TierMutation {
-	m := &TierMutation{
+// newWarmPoolMutation creates new mutation for the WarmPool entity.
+func newWarmPoolMutation(c config, op Op, opts ...warmpoolOption) *WarmPoolMutation {
+	m := &WarmPoolMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTier,
+		typ:           TypeWarmPool,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7624,20 +15327,20 @@ func newTierMutation(c config, op Op, opts ...tierOption) *TierMutation {
 	return m
 }
 
-// withTierID sets the ID field of the mutation.
-func withTierID(id string) tierOption {
-	return func(m *TierMutation) {
+// withWarmPoolID sets the ID field of the mutation.
+func withWarmPoolID(id uuid.UUID) warmpoolOption {
+	return func(m *WarmPoolMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tier
+			value *WarmPool
 		)
-		m.oldValue = func(ctx context.Context) (*Tier, error) {
+		m.oldValue = func(ctx context.Context) (*WarmPool, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tier.Get(ctx, id)
+					value, err = m.Client().WarmPool.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7646,10 +15349,10 @@ func withTierID(id string) tierOption {
 	}
 }
 
-// withTier sets the old Tier of the mutation.
-func withTier(node *Tier) tierOption {
-	return func(m *TierMutation) {
-		m.oldValue = func(context.Context) (*Tier, error) {
+// withWarmPool sets the old WarmPool of the mutation.
+func withWarmPool(node *WarmPool) warmpoolOption {
+	return func(m *WarmPoolMutation) {
+		m.oldValue = func(context.Context) (*WarmPool, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7658,7 +15361,7 @@ func withTier(node *Tier) tierOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TierMutation) Client() *Client {
+func (m WarmPoolMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7666,7 +15369,7 @@ func (m TierMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TierMutation) Tx() (*Tx, error) {
+func (m WarmPoolMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -7676,14 +15379,14 @@ func (m TierMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tier entities.
-func (m *TierMutation) SetID(id string) {
+// operation is only accepted on creation of WarmPool entities.
+func (m *WarmPoolMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TierMutation) ID() (id string, exists bool) {
+func (m *WarmPoolMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7694,288 +15397,284 @@ func (m *TierMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TierMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *WarmPoolMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tier.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WarmPool.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TierMutation) SetName(s string) {
-	m.name = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *WarmPoolMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TierMutation) Name() (r string, exists bool) {
-	v := m.name
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WarmPoolMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WarmPool entity.
+// If the WarmPool object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WarmPoolMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TierMutation) ResetName() {
-	m.name = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WarmPoolMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetDiskMB sets the "disk_mb" field.
-func (m *TierMutation) SetDiskMB(i int64) {
-	m.disk_mb = &i
-	m.adddisk_mb = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WarmPoolMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// DiskMB returns the value of the "disk_mb" field in the mutation.
-func (m *TierMutation) DiskMB() (r int64, exists bool) {
-	v := m.disk_mb
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WarmPoolMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDiskMB returns the old "disk_mb" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WarmPool entity.
+// If the WarmPool object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldDiskMB(ctx context.Context) (v int64, err error) {
+func (m *WarmPoolMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiskMB is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiskMB requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiskMB: %w", err)
-	}
-	return oldValue.DiskMB, nil
-}
-
-// AddDiskMB adds i to the "disk_mb" field.
-func (m *TierMutation) AddDiskMB(i int64) {
-	if m.adddisk_mb != nil {
-		*m.adddisk_mb += i
-	} else {
-		m.adddisk_mb = &i
-	}
-}
-
-// AddedDiskMB returns the value that was added to the "disk_mb" field in this mutation.
-func (m *TierMutation) AddedDiskMB() (r int64, exists bool) {
-	v := m.adddisk_mb
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetDiskMB resets all changes to the "disk_mb" field.
-func (m *TierMutation) ResetDiskMB() {
-	m.disk_mb = nil
-	m.adddisk_mb = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WarmPoolMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetConcurrentInstances sets the "concurrent_instances" field.
-func (m *TierMutation) SetConcurrentInstances(i int64) {
-	m.concurrent_instances = &i
-	m.addconcurrent_instances = nil
+// SetTeamID sets the "team_id" field.
+func (m *WarmPoolMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
-// ConcurrentInstances returns the value of the "concurrent_instances" field in the mutation.
-func (m *TierMutation) ConcurrentInstances() (r int64, exists bool) {
-	v := m.concurrent_instances
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *WarmPoolMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConcurrentInstances returns the old "concurrent_instances" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// OldTeamID returns the old "team_id" field's value of the WarmPool entity.
+// If the WarmPool object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldConcurrentInstances(ctx context.Context) (v int64, err error) {
+func (m *WarmPoolMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConcurrentInstances is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConcurrentInstances requires an ID field in the mutation")
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConcurrentInstances: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
-	return oldValue.ConcurrentInstances, nil
+	return oldValue.TeamID, nil
 }
 
-// AddConcurrentInstances adds i to the "concurrent_instances" field.
-func (m *TierMutation) AddConcurrentInstances(i int64) {
-	if m.addconcurrent_instances != nil {
-		*m.addconcurrent_instances += i
-	} else {
-		m.addconcurrent_instances = &i
-	}
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *WarmPoolMutation) ResetTeamID() {
+	m.team = nil
 }
 
-// AddedConcurrentInstances returns the value that was added to the "concurrent_instances" field in this mutation.
-func (m *TierMutation) AddedConcurrentInstances() (r int64, exists bool) {
-	v := m.addconcurrent_instances
+// SetEnvID sets the "env_id" field.
+func (m *WarmPoolMutation) SetEnvID(s string) {
+	m.env = &s
+}
+
+// EnvID returns the value of the "env_id" field in the mutation.
+func (m *WarmPoolMutation) EnvID() (r string, exists bool) {
+	v := m.env
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetConcurrentInstances resets all changes to the "concurrent_instances" field.
-func (m *TierMutation) ResetConcurrentInstances() {
-	m.concurrent_instances = nil
-	m.addconcurrent_instances = nil
+// OldEnvID returns the old "env_id" field's value of the WarmPool entity.
+// If the WarmPool object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WarmPoolMutation) OldEnvID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvID: %w", err)
+	}
+	return oldValue.EnvID, nil
 }
 
-// SetMaxLengthHours sets the "max_length_hours" field.
-func (m *TierMutation) SetMaxLengthHours(i int64) {
-	m.max_length_hours = &i
-	m.addmax_length_hours = nil
+// ResetEnvID resets all changes to the "env_id" field.
+func (m *WarmPoolMutation) ResetEnvID() {
+	m.env = nil
 }
 
-// MaxLengthHours returns the value of the "max_length_hours" field in the mutation.
-func (m *TierMutation) MaxLengthHours() (r int64, exists bool) {
-	v := m.max_length_hours
+// SetSize sets the "size" field.
+func (m *WarmPoolMutation) SetSize(i int32) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *WarmPoolMutation) Size() (r int32, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxLengthHours returns the old "max_length_hours" field's value of the Tier entity.
-// If the Tier object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the WarmPool entity.
+// If the WarmPool object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TierMutation) OldMaxLengthHours(ctx context.Context) (v int64, err error) {
+func (m *WarmPoolMutation) OldSize(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxLengthHours is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxLengthHours requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxLengthHours: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.MaxLengthHours, nil
+	return oldValue.Size, nil
 }
 
-// AddMaxLengthHours adds i to the "max_length_hours" field.
-func (m *TierMutation) AddMaxLengthHours(i int64) {
-	if m.addmax_length_hours != nil {
-		*m.addmax_length_hours += i
+// AddSize adds i to the "size" field.
+func (m *WarmPoolMutation) AddSize(i int32) {
+	if m.addsize != nil {
+		*m.addsize += i
 	} else {
-		m.addmax_length_hours = &i
+		m.addsize = &i
 	}
 }
 
-// AddedMaxLengthHours returns the value that was added to the "max_length_hours" field in this mutation.
-func (m *TierMutation) AddedMaxLengthHours() (r int64, exists bool) {
-	v := m.addmax_length_hours
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *WarmPoolMutation) AddedSize() (r int32, exists bool) {
+	v := m.addsize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMaxLengthHours resets all changes to the "max_length_hours" field.
-func (m *TierMutation) ResetMaxLengthHours() {
-	m.max_length_hours = nil
-	m.addmax_length_hours = nil
+// ResetSize resets all changes to the "size" field.
+func (m *WarmPoolMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *TierMutation) AddTeamIDs(ids ...uuid.UUID) {
-	if m.teams == nil {
-		m.teams = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.teams[ids[i]] = struct{}{}
-	}
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *WarmPoolMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[warmpool.FieldTeamID] = struct{}{}
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *TierMutation) ClearTeams() {
-	m.clearedteams = true
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *WarmPoolMutation) TeamCleared() bool {
+	return m.clearedteam
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *TierMutation) TeamsCleared() bool {
-	return m.clearedteams
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *WarmPoolMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *TierMutation) RemoveTeamIDs(ids ...uuid.UUID) {
-	if m.removedteams == nil {
-		m.removedteams = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.teams, ids[i])
-		m.removedteams[ids[i]] = struct{}{}
-	}
+// ResetTeam resets all changes to the "team" edge.
+func (m *WarmPoolMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
 }
 
-// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *TierMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
-	for id := range m.removedteams {
-		ids = append(ids, id)
-	}
-	return
+// ClearEnv clears the "env" edge to the Env entity.
+func (m *WarmPoolMutation) ClearEnv() {
+	m.clearedenv = true
+	m.clearedFields[warmpool.FieldEnvID] = struct{}{}
 }
 
-// TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *TierMutation) TeamsIDs() (ids []uuid.UUID) {
-	for id := range m.teams {
-		ids = append(ids, id)
+// EnvCleared reports if the "env" edge to the Env entity was cleared.
+func (m *WarmPoolMutation) EnvCleared() bool {
+	return m.clearedenv
+}
+
+// EnvIDs returns the "env" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvID instead. It exists only for internal usage by the builders.
+func (m *WarmPoolMutation) EnvIDs() (ids []string) {
+	if id := m.env; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTeams resets all changes to the "teams" edge.
-func (m *TierMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
-	m.removedteams = nil
+// ResetEnv resets all changes to the "env" edge.
+func (m *WarmPoolMutation) ResetEnv() {
+	m.env = nil
+	m.clearedenv = false
 }
 
-// Where appends a list predicates to the TierMutation builder.
-func (m *TierMutation) Where(ps ...predicate.Tier) {
+// Where appends a list predicates to the WarmPoolMutation builder.
+func (m *WarmPoolMutation) Where(ps ...predicate.WarmPool) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TierMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WarmPoolMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tier, len(ps))
+func (m *WarmPoolMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WarmPool, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7983,36 +15682,39 @@ func (m *TierMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TierMutation) Op() Op {
+func (m *WarmPoolMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TierMutation) SetOp(op Op) {
+func (m *WarmPoolMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tier).
-func (m *TierMutation) Type() string {
+// Type returns the node type of this mutation (WarmPool).
+func (m *WarmPoolMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TierMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, tier.FieldName)
+func (m *WarmPoolMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, warmpool.FieldCreatedAt)
 	}
-	if m.disk_mb != nil {
-		fields = append(fields, tier.FieldDiskMB)
+	if m.updated_at != nil {
+		fields = append(fields, warmpool.FieldUpdatedAt)
 	}
-	if m.concurrent_instances != nil {
-		fields = append(fields, tier.FieldConcurrentInstances)
+	if m.team != nil {
+		fields = append(fields, warmpool.FieldTeamID)
 	}
-	if m.max_length_hours != nil {
-		fields = append(fields, tier.FieldMaxLengthHours)
+	if m.env != nil {
+		fields = append(fields, warmpool.FieldEnvID)
+	}
+	if m.size != nil {
+		fields = append(fields, warmpool.FieldSize)
 	}
 	return fields
 }
@@ -8020,16 +15722,18 @@ func (m *TierMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TierMutation) Field(name string) (ent.Value, bool) {
+func (m *WarmPoolMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tier.FieldName:
-		return m.Name()
-	case tier.FieldDiskMB:
-		return m.DiskMB()
-	case tier.FieldConcurrentInstances:
-		return m.ConcurrentInstances()
-	case tier.FieldMaxLengthHours:
-		return m.MaxLengthHours()
+	case warmpool.FieldCreatedAt:
+		return m.CreatedAt()
+	case warmpool.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case warmpool.FieldTeamID:
+		return m.TeamID()
+	case warmpool.FieldEnvID:
+		return m.EnvID()
+	case warmpool.FieldSize:
+		return m.Size()
 	}
 	return nil, false
 }
@@ -8037,69 +15741,72 @@ func (m *TierMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WarmPoolMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tier.FieldName:
-		return m.OldName(ctx)
-	case tier.FieldDiskMB:
-		return m.OldDiskMB(ctx)
-	case tier.FieldConcurrentInstances:
-		return m.OldConcurrentInstances(ctx)
-	case tier.FieldMaxLengthHours:
-		return m.OldMaxLengthHours(ctx)
+	case warmpool.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case warmpool.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case warmpool.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case warmpool.FieldEnvID:
+		return m.OldEnvID(ctx)
+	case warmpool.FieldSize:
+		return m.OldSize(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tier field %s", name)
+	return nil, fmt.Errorf("unknown WarmPool field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TierMutation) SetField(name string, value ent.Value) error {
+func (m *WarmPoolMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tier.FieldName:
-		v, ok := value.(string)
+	case warmpool.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetCreatedAt(v)
 		return nil
-	case tier.FieldDiskMB:
-		v, ok := value.(int64)
+	case warmpool.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDiskMB(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case tier.FieldConcurrentInstances:
-		v, ok := value.(int64)
+	case warmpool.FieldTeamID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConcurrentInstances(v)
+		m.SetTeamID(v)
 		return nil
-	case tier.FieldMaxLengthHours:
-		v, ok := value.(int64)
+	case warmpool.FieldEnvID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxLengthHours(v)
+		m.SetEnvID(v)
+		return nil
+	case warmpool.FieldSize:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tier field %s", name)
+	return fmt.Errorf("unknown WarmPool field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TierMutation) AddedFields() []string {
+func (m *WarmPoolMutation) AddedFields() []string {
 	var fields []string
-	if m.adddisk_mb != nil {
-		fields = append(fields, tier.FieldDiskMB)
-	}
-	if m.addconcurrent_instances != nil {
-		fields = append(fields, tier.FieldConcurrentInstances)
-	}
-	if m.addmax_length_hours != nil {
-		fields = append(fields, tier.FieldMaxLengthHours)
+	if m.addsize != nil {
+		fields = append(fields, warmpool.FieldSize)
 	}
 	return fields
 }
@@ -8107,14 +15814,10 @@ func (m *TierMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WarmPoolMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case tier.FieldDiskMB:
-		return m.AddedDiskMB()
-	case tier.FieldConcurrentInstances:
-		return m.AddedConcurrentInstances()
-	case tier.FieldMaxLengthHours:
-		return m.AddedMaxLengthHours()
+	case warmpool.FieldSize:
+		return m.AddedSize()
 	}
 	return nil, false
 }
@@ -8122,195 +15825,188 @@ func (m *TierMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TierMutation) AddField(name string, value ent.Value) error {
+func (m *WarmPoolMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tier.FieldDiskMB:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiskMB(v)
-		return nil
-	case tier.FieldConcurrentInstances:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddConcurrentInstances(v)
-		return nil
-	case tier.FieldMaxLengthHours:
-		v, ok := value.(int64)
+	case warmpool.FieldSize:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxLengthHours(v)
+		m.AddSize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tier numeric field %s", name)
+	return fmt.Errorf("unknown WarmPool numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TierMutation) ClearedFields() []string {
+func (m *WarmPoolMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TierMutation) FieldCleared(name string) bool {
+func (m *WarmPoolMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TierMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tier nullable field %s", name)
+func (m *WarmPoolMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WarmPool nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TierMutation) ResetField(name string) error {
+func (m *WarmPoolMutation) ResetField(name string) error {
 	switch name {
-	case tier.FieldName:
-		m.ResetName()
+	case warmpool.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case tier.FieldDiskMB:
-		m.ResetDiskMB()
+	case warmpool.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case tier.FieldConcurrentInstances:
-		m.ResetConcurrentInstances()
+	case warmpool.FieldTeamID:
+		m.ResetTeamID()
 		return nil
-	case tier.FieldMaxLengthHours:
-		m.ResetMaxLengthHours()
+	case warmpool.FieldEnvID:
+		m.ResetEnvID()
+		return nil
+	case warmpool.FieldSize:
+		m.ResetSize()
 		return nil
 	}
-	return fmt.Errorf("unknown Tier field %s", name)
+	return fmt.Errorf("unknown WarmPool field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TierMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.teams != nil {
-		edges = append(edges, tier.EdgeTeams)
+func (m *WarmPoolMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.team != nil {
+		edges = append(edges, warmpool.EdgeTeam)
+	}
+	if m.env != nil {
+		edges = append(edges, warmpool.EdgeEnv)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TierMutation) AddedIDs(name string) []ent.Value {
+func (m *WarmPoolMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tier.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.teams))
-		for id := range m.teams {
-			ids = append(ids, id)
+	case warmpool.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case warmpool.EdgeEnv:
+		if id := m.env; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TierMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedteams != nil {
-		edges = append(edges, tier.EdgeTeams)
-	}
+func (m *WarmPoolMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TierMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tier.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.removedteams))
-		for id := range m.removedteams {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WarmPoolMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TierMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedteams {
-		edges = append(edges, tier.EdgeTeams)
+func (m *WarmPoolMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteam {
+		edges = append(edges, warmpool.EdgeTeam)
+	}
+	if m.clearedenv {
+		edges = append(edges, warmpool.EdgeEnv)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TierMutation) EdgeCleared(name string) bool {
+func (m *WarmPoolMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tier.EdgeTeams:
-		return m.clearedteams
+	case warmpool.EdgeTeam:
+		return m.clearedteam
+	case warmpool.EdgeEnv:
+		return m.clearedenv
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TierMutation) ClearEdge(name string) error {
+func (m *WarmPoolMutation) ClearEdge(name string) error {
 	switch name {
+	case warmpool.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case warmpool.EdgeEnv:
+		m.ClearEnv()
+		return nil
 	}
-	return fmt.Errorf("unknown Tier unique edge %s", name)
+	return fmt.Errorf("unknown WarmPool unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TierMutation) ResetEdge(name string) error {
+func (m *WarmPoolMutation) ResetEdge(name string) error {
 	switch name {
-	case tier.EdgeTeams:
-		m.ResetTeams()
+	case warmpool.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case warmpool.EdgeEnv:
+		m.ResetEnv()
 		return nil
 	}
-	return fmt.Errorf("unknown Tier edge %s", name)
+	return fmt.Errorf("unknown WarmPool edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	email                   *string
-	clearedFields           map[string]struct{}
-	teams                   map[uuid.UUID]struct{}
-	removedteams            map[uuid.UUID]struct{}
-	clearedteams            bool
-	created_envs            map[string]struct{}
-	removedcreated_envs     map[string]struct{}
-	clearedcreated_envs     bool
-	access_tokens           map[uuid.UUID]struct{}
-	removedaccess_tokens    map[uuid.UUID]struct{}
-	clearedaccess_tokens    bool
-	created_api_keys        map[uuid.UUID]struct{}
-	removedcreated_api_keys map[uuid.UUID]struct{}
-	clearedcreated_api_keys bool
-	users_teams             map[int]struct{}
-	removedusers_teams      map[int]struct{}
-	clearedusers_teams      bool
-	done                    bool
-	oldValue                func(context.Context) (*User, error)
-	predicates              []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	url               *string
+	secret            *string
+	events            *[]string
+	appendevents      []string
+	enabled           *bool
+	clearedFields     map[string]struct{}
+	team              *uuid.UUID
+	clearedteam       bool
+	deliveries        map[uuid.UUID]struct{}
+	removeddeliveries map[uuid.UUID]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*Webhook, error)
+	predicates        []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8319,32 +16015,32 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id uuid.UUID) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
 		}
 		m.id = &id
 	}
-}
-
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+}
+
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8353,7 +16049,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8361,7 +16057,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -8371,14 +16067,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8389,7 +16085,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8398,327 +16094,369 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddTeamIDs adds the "teams" edge to the Team entity by ids.
-func (m *UserMutation) AddTeamIDs(ids ...uuid.UUID) {
-	if m.teams == nil {
-		m.teams = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.teams[ids[i]] = struct{}{}
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *UserMutation) ClearTeams() {
-	m.clearedteams = true
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *UserMutation) TeamsCleared() bool {
-	return m.clearedteams
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
-func (m *UserMutation) RemoveTeamIDs(ids ...uuid.UUID) {
-	if m.removedteams == nil {
-		m.removedteams = make(map[uuid.UUID]struct{})
+// OldUpdatedAt returns the old "updated_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.teams, ids[i])
-		m.removedteams[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
+	return oldValue.UpdatedAt, nil
 }
 
-// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
-func (m *UserMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
-	for id := range m.removedteams {
-		ids = append(ids, id)
-	}
-	return
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// TeamsIDs returns the "teams" edge IDs in the mutation.
-func (m *UserMutation) TeamsIDs() (ids []uuid.UUID) {
-	for id := range m.teams {
-		ids = append(ids, id)
-	}
-	return
+// SetTeamID sets the "team_id" field.
+func (m *WebhookMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
 }
 
-// ResetTeams resets all changes to the "teams" edge.
-func (m *UserMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
-	m.removedteams = nil
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *WebhookMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddCreatedEnvIDs adds the "created_envs" edge to the Env entity by ids.
-func (m *UserMutation) AddCreatedEnvIDs(ids ...string) {
-	if m.created_envs == nil {
-		m.created_envs = make(map[string]struct{})
+// OldTeamID returns the old "team_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.created_envs[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
 	}
+	return oldValue.TeamID, nil
 }
 
-// ClearCreatedEnvs clears the "created_envs" edge to the Env entity.
-func (m *UserMutation) ClearCreatedEnvs() {
-	m.clearedcreated_envs = true
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *WebhookMutation) ResetTeamID() {
+	m.team = nil
 }
 
-// CreatedEnvsCleared reports if the "created_envs" edge to the Env entity was cleared.
-func (m *UserMutation) CreatedEnvsCleared() bool {
-	return m.clearedcreated_envs
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// RemoveCreatedEnvIDs removes the "created_envs" edge to the Env entity by IDs.
-func (m *UserMutation) RemoveCreatedEnvIDs(ids ...string) {
-	if m.removedcreated_envs == nil {
-		m.removedcreated_envs = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.created_envs, ids[i])
-		m.removedcreated_envs[ids[i]] = struct{}{}
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedCreatedEnvs returns the removed IDs of the "created_envs" edge to the Env entity.
-func (m *UserMutation) RemovedCreatedEnvsIDs() (ids []string) {
-	for id := range m.removedcreated_envs {
-		ids = append(ids, id)
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
 }
 
-// CreatedEnvsIDs returns the "created_envs" edge IDs in the mutation.
-func (m *UserMutation) CreatedEnvsIDs() (ids []string) {
-	for id := range m.created_envs {
-		ids = append(ids, id)
-	}
-	return
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// ResetCreatedEnvs resets all changes to the "created_envs" edge.
-func (m *UserMutation) ResetCreatedEnvs() {
-	m.created_envs = nil
-	m.clearedcreated_envs = false
-	m.removedcreated_envs = nil
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
-func (m *UserMutation) AddAccessTokenIDs(ids ...uuid.UUID) {
-	if m.access_tokens == nil {
-		m.access_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.access_tokens[ids[i]] = struct{}{}
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
-func (m *UserMutation) ClearAccessTokens() {
-	m.clearedaccess_tokens = true
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
 }
 
-// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
-func (m *UserMutation) AccessTokensCleared() bool {
-	return m.clearedaccess_tokens
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
-func (m *UserMutation) RemoveAccessTokenIDs(ids ...uuid.UUID) {
-	if m.removedaccess_tokens == nil {
-		m.removedaccess_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.access_tokens, ids[i])
-		m.removedaccess_tokens[ids[i]] = struct{}{}
-	}
+// SetEvents sets the "events" field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
 }
 
-// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
-func (m *UserMutation) RemovedAccessTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedaccess_tokens {
-		ids = append(ids, id)
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
-func (m *UserMutation) AccessTokensIDs() (ids []uuid.UUID) {
-	for id := range m.access_tokens {
-		ids = append(ids, id)
+// OldEvents returns the old "events" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
 }
 
-// ResetAccessTokens resets all changes to the "access_tokens" edge.
-func (m *UserMutation) ResetAccessTokens() {
-	m.access_tokens = nil
-	m.clearedaccess_tokens = false
-	m.removedaccess_tokens = nil
+// AppendEvents adds s to the "events" field.
+func (m *WebhookMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
 }
 
-// AddCreatedAPIKeyIDs adds the "created_api_keys" edge to the TeamAPIKey entity by ids.
-func (m *UserMutation) AddCreatedAPIKeyIDs(ids ...uuid.UUID) {
-	if m.created_api_keys == nil {
-		m.created_api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.created_api_keys[ids[i]] = struct{}{}
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
 	}
+	return m.appendevents, true
 }
 
-// ClearCreatedAPIKeys clears the "created_api_keys" edge to the TeamAPIKey entity.
-func (m *UserMutation) ClearCreatedAPIKeys() {
-	m.clearedcreated_api_keys = true
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
 }
 
-// CreatedAPIKeysCleared reports if the "created_api_keys" edge to the TeamAPIKey entity was cleared.
-func (m *UserMutation) CreatedAPIKeysCleared() bool {
-	return m.clearedcreated_api_keys
+// SetEnabled sets the "enabled" field.
+func (m *WebhookMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// RemoveCreatedAPIKeyIDs removes the "created_api_keys" edge to the TeamAPIKey entity by IDs.
-func (m *UserMutation) RemoveCreatedAPIKeyIDs(ids ...uuid.UUID) {
-	if m.removedcreated_api_keys == nil {
-		m.removedcreated_api_keys = make(map[uuid.UUID]struct{})
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.created_api_keys, ids[i])
-		m.removedcreated_api_keys[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *WebhookMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[webhook.FieldTeamID] = struct{}{}
 }
 
-// RemovedCreatedAPIKeys returns the removed IDs of the "created_api_keys" edge to the TeamAPIKey entity.
-func (m *UserMutation) RemovedCreatedAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.removedcreated_api_keys {
-		ids = append(ids, id)
-	}
-	return
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *WebhookMutation) TeamCleared() bool {
+	return m.clearedteam
 }
 
-// CreatedAPIKeysIDs returns the "created_api_keys" edge IDs in the mutation.
-func (m *UserMutation) CreatedAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.created_api_keys {
-		ids = append(ids, id)
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *WebhookMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCreatedAPIKeys resets all changes to the "created_api_keys" edge.
-func (m *UserMutation) ResetCreatedAPIKeys() {
-	m.created_api_keys = nil
-	m.clearedcreated_api_keys = false
-	m.removedcreated_api_keys = nil
+// ResetTeam resets all changes to the "team" edge.
+func (m *WebhookMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
 }
 
-// AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by ids.
-func (m *UserMutation) AddUsersTeamIDs(ids ...int) {
-	if m.users_teams == nil {
-		m.users_teams = make(map[int]struct{})
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.users_teams[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUsersTeams clears the "users_teams" edge to the UsersTeams entity.
-func (m *UserMutation) ClearUsersTeams() {
-	m.clearedusers_teams = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// UsersTeamsCleared reports if the "users_teams" edge to the UsersTeams entity was cleared.
-func (m *UserMutation) UsersTeamsCleared() bool {
-	return m.clearedusers_teams
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemoveUsersTeamIDs removes the "users_teams" edge to the UsersTeams entity by IDs.
-func (m *UserMutation) RemoveUsersTeamIDs(ids ...int) {
-	if m.removedusers_teams == nil {
-		m.removedusers_teams = make(map[int]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.users_teams, ids[i])
-		m.removedusers_teams[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUsersTeams returns the removed IDs of the "users_teams" edge to the UsersTeams entity.
-func (m *UserMutation) RemovedUsersTeamsIDs() (ids []int) {
-	for id := range m.removedusers_teams {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UsersTeamsIDs returns the "users_teams" edge IDs in the mutation.
-func (m *UserMutation) UsersTeamsIDs() (ids []int) {
-	for id := range m.users_teams {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUsersTeams resets all changes to the "users_teams" edge.
-func (m *UserMutation) ResetUsersTeams() {
-	m.users_teams = nil
-	m.clearedusers_teams = false
-	m.removedusers_teams = nil
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8726,27 +16464,45 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, webhook.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhook.FieldUpdatedAt)
+	}
+	if m.team != nil {
+		fields = append(fields, webhook.FieldTeamID)
+	}
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.enabled != nil {
+		fields = append(fields, webhook.FieldEnabled)
 	}
 	return fields
 }
@@ -8754,10 +16510,22 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldEmail:
-		return m.Email()
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhook.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case webhook.FieldTeamID:
+		return m.TeamID()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldEnabled:
+		return m.Enabled()
 	}
 	return nil, false
 }
@@ -8765,134 +16533,177 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhook.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case webhook.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldEnabled:
+		return m.OldEnabled(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldEmail:
+	case webhook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhook.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case webhook.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case webhook.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetURL(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhook.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *WebhookMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebhookMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *WebhookMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
+	case webhook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhook.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case webhook.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case webhook.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhook.FieldEnabled:
+		m.ResetEnabled()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.teams != nil {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.created_envs != nil {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.access_tokens != nil {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.created_api_keys != nil {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.team != nil {
+		edges = append(edges, webhook.EdgeTeam)
 	}
-	if m.users_teams != nil {
-		edges = append(edges, user.EdgeUsersTeams)
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.teams))
-		for id := range m.teams {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEnvs:
-		ids := make([]ent.Value, 0, len(m.created_envs))
-		for id := range m.created_envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAccessTokens:
-		ids := make([]ent.Value, 0, len(m.access_tokens))
-		for id := range m.access_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedAPIKeys:
-		ids := make([]ent.Value, 0, len(m.created_api_keys))
-		for id := range m.created_api_keys {
-			ids = append(ids, id)
+	case webhook.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.users_teams))
-		for id := range m.users_teams {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -8901,57 +16712,21 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedteams != nil {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.removedcreated_envs != nil {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.removedaccess_tokens != nil {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.removedcreated_api_keys != nil {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
-	}
-	if m.removedusers_teams != nil {
-		edges = append(edges, user.EdgeUsersTeams)
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeTeams:
-		ids := make([]ent.Value, 0, len(m.removedteams))
-		for id := range m.removedteams {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEnvs:
-		ids := make([]ent.Value, 0, len(m.removedcreated_envs))
-		for id := range m.removedcreated_envs {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAccessTokens:
-		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
-		for id := range m.removedaccess_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedcreated_api_keys))
-		for id := range m.removedcreated_api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUsersTeams:
-		ids := make([]ent.Value, 0, len(m.removedusers_teams))
-		for id := range m.removedusers_teams {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -8960,103 +16735,89 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedteams {
-		edges = append(edges, user.EdgeTeams)
-	}
-	if m.clearedcreated_envs {
-		edges = append(edges, user.EdgeCreatedEnvs)
-	}
-	if m.clearedaccess_tokens {
-		edges = append(edges, user.EdgeAccessTokens)
-	}
-	if m.clearedcreated_api_keys {
-		edges = append(edges, user.EdgeCreatedAPIKeys)
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteam {
+		edges = append(edges, webhook.EdgeTeam)
 	}
-	if m.clearedusers_teams {
-		edges = append(edges, user.EdgeUsersTeams)
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeTeams:
-		return m.clearedteams
-	case user.EdgeCreatedEnvs:
-		return m.clearedcreated_envs
-	case user.EdgeAccessTokens:
-		return m.clearedaccess_tokens
-	case user.EdgeCreatedAPIKeys:
-		return m.clearedcreated_api_keys
-	case user.EdgeUsersTeams:
-		return m.clearedusers_teams
+	case webhook.EdgeTeam:
+		return m.clearedteam
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WebhookMutation) ClearEdge(name string) error {
 	switch name {
+	case webhook.EdgeTeam:
+		m.ClearTeam()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WebhookMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeTeams:
-		m.ResetTeams()
-		return nil
-	case user.EdgeCreatedEnvs:
-		m.ResetCreatedEnvs()
-		return nil
-	case user.EdgeAccessTokens:
-		m.ResetAccessTokens()
-		return nil
-	case user.EdgeCreatedAPIKeys:
-		m.ResetCreatedAPIKeys()
+	case webhook.EdgeTeam:
+		m.ResetTeam()
 		return nil
-	case user.EdgeUsersTeams:
-		m.ResetUsersTeams()
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// UsersTeamsMutation represents an operation that mutates the UsersTeams nodes in the graph.
-type UsersTeamsMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	is_default    *bool
-	clearedFields map[string]struct{}
-	users         *uuid.UUID
-	clearedusers  bool
-	teams         *uuid.UUID
-	clearedteams  bool
-	done          bool
-	oldValue      func(context.Context) (*UsersTeams, error)
-	predicates    []predicate.UsersTeams
-}
-
-var _ ent.Mutation = (*UsersTeamsMutation)(nil)
-
-// usersteamsOption allows management of the mutation configuration using functional options.
-type usersteamsOption func(*UsersTeamsMutation)
-
-// newUsersTeamsMutation creates new mutation for the UsersTeams entity.
-func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeamsMutation {
-	m := &UsersTeamsMutation{
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	event_id       *uuid.UUID
+	event_type     *string
+	sandbox_id     *string
+	attempt        *int32
+	addattempt     *int32
+	success        *bool
+	status_code    *int32
+	addstatus_code *int32
+	error          *string
+	clearedFields  map[string]struct{}
+	webhook        *uuid.UUID
+	clearedwebhook bool
+	done           bool
+	oldValue       func(context.Context) (*WebhookDelivery, error)
+	predicates     []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUsersTeams,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9065,20 +16826,20 @@ func newUsersTeamsMutation(c config, op Op, opts ...usersteamsOption) *UsersTeam
 	return m
 }
 
-// withUsersTeamsID sets the ID field of the mutation.
-func withUsersTeamsID(id int) usersteamsOption {
-	return func(m *UsersTeamsMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UsersTeams
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*UsersTeams, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UsersTeams.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9087,10 +16848,10 @@ func withUsersTeamsID(id int) usersteamsOption {
 	}
 }
 
-// withUsersTeams sets the old UsersTeams of the mutation.
-func withUsersTeams(node *UsersTeams) usersteamsOption {
-	return func(m *UsersTeamsMutation) {
-		m.oldValue = func(context.Context) (*UsersTeams, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9099,7 +16860,7 @@ func withUsersTeams(node *UsersTeams) usersteamsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UsersTeamsMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9107,7 +16868,7 @@ func (m UsersTeamsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UsersTeamsMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("models: mutation is not running in a transaction")
 	}
@@ -9116,9 +16877,15 @@ func (m UsersTeamsMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UsersTeamsMutation) ID() (id int, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9129,218 +16896,448 @@ func (m *UsersTeamsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UsersTeamsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UsersTeams.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UsersTeamsMutation) SetUserID(u uuid.UUID) {
-	m.users = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UsersTeamsMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.users
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UsersTeamsMutation) ResetUserID() {
-	m.users = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTeamID sets the "team_id" field.
-func (m *UsersTeamsMutation) SetTeamID(u uuid.UUID) {
-	m.teams = &u
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(u uuid.UUID) {
+	m.webhook = &u
 }
 
-// TeamID returns the value of the "team_id" field in the mutation.
-func (m *UsersTeamsMutation) TeamID() (r uuid.UUID, exists bool) {
-	v := m.teams
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r uuid.UUID, exists bool) {
+	v := m.webhook
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeamID returns the old "team_id" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldTeamID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeamID requires an ID field in the mutation")
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
 	}
-	return oldValue.TeamID, nil
+	return oldValue.WebhookID, nil
 }
 
-// ResetTeamID resets all changes to the "team_id" field.
-func (m *UsersTeamsMutation) ResetTeamID() {
-	m.teams = nil
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook = nil
 }
 
-// SetIsDefault sets the "is_default" field.
-func (m *UsersTeamsMutation) SetIsDefault(b bool) {
-	m.is_default = &b
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
 }
 
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *UsersTeamsMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDefault returns the old "is_default" field's value of the UsersTeams entity.
-// If the UsersTeams object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersTeamsMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (m *WebhookDeliveryMutation) SetSandboxID(s string) {
+	m.sandbox_id = &s
+}
+
+// SandboxID returns the value of the "sandbox_id" field in the mutation.
+func (m *WebhookDeliveryMutation) SandboxID() (r string, exists bool) {
+	v := m.sandbox_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSandboxID returns the old "sandbox_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldSandboxID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSandboxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSandboxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSandboxID: %w", err)
+	}
+	return oldValue.SandboxID, nil
+}
+
+// ResetSandboxID resets all changes to the "sandbox_id" field.
+func (m *WebhookDeliveryMutation) ResetSandboxID() {
+	m.sandbox_id = nil
+}
+
+// SetAttempt sets the "attempt" field.
+func (m *WebhookDeliveryMutation) SetAttempt(i int32) {
+	m.attempt = &i
+	m.addattempt = nil
+}
+
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempt() (r int32, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempt returns the old "attempt" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempt(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
+	}
+	return oldValue.Attempt, nil
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (m *WebhookDeliveryMutation) AddAttempt(i int32) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
+}
+
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempt() (r int32, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *WebhookDeliveryMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
+}
+
+// SetSuccess sets the "success" field.
+func (m *WebhookDeliveryMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *WebhookDeliveryMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *WebhookDeliveryMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetStatusCode sets the "status_code" field.
+func (m *WebhookDeliveryMutation) SetStatusCode(i int32) {
+	m.status_code = &i
+	m.addstatus_code = nil
+}
+
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *WebhookDeliveryMutation) StatusCode() (r int32, exists bool) {
+	v := m.status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusCode returns the old "status_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatusCode(ctx context.Context) (v *int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
+	}
+	return oldValue.StatusCode, nil
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (m *WebhookDeliveryMutation) AddStatusCode(i int32) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+}
+
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedStatusCode() (r int32, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
 	}
-	return oldValue.IsDefault, nil
+	return *v, true
 }
 
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *UsersTeamsMutation) ResetIsDefault() {
-	m.is_default = nil
+// ClearStatusCode clears the value of the "status_code" field.
+func (m *WebhookDeliveryMutation) ClearStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	m.clearedFields[webhookdelivery.FieldStatusCode] = struct{}{}
 }
 
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *UsersTeamsMutation) SetUsersID(id uuid.UUID) {
-	m.users = &id
+// StatusCodeCleared returns if the "status_code" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) StatusCodeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldStatusCode]
+	return ok
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *UsersTeamsMutation) ClearUsers() {
-	m.clearedusers = true
-	m.clearedFields[usersteams.FieldUserID] = struct{}{}
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *WebhookDeliveryMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	delete(m.clearedFields, webhookdelivery.FieldStatusCode)
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *UsersTeamsMutation) UsersCleared() bool {
-	return m.clearedusers
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
 }
 
-// UsersID returns the "users" edge ID in the mutation.
-func (m *UsersTeamsMutation) UsersID() (id uuid.UUID, exists bool) {
-	if m.users != nil {
-		return *m.users, true
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *UsersTeamsMutation) UsersIDs() (ids []uuid.UUID) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *UsersTeamsMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
 }
 
-// SetTeamsID sets the "teams" edge to the Team entity by id.
-func (m *UsersTeamsMutation) SetTeamsID(id uuid.UUID) {
-	m.teams = &id
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
 }
 
-// ClearTeams clears the "teams" edge to the Team entity.
-func (m *UsersTeamsMutation) ClearTeams() {
-	m.clearedteams = true
-	m.clearedFields[usersteams.FieldTeamID] = struct{}{}
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
 }
 
-// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
-func (m *UsersTeamsMutation) TeamsCleared() bool {
-	return m.clearedteams
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
+	m.clearedFields[webhookdelivery.FieldWebhookID] = struct{}{}
 }
 
-// TeamsID returns the "teams" edge ID in the mutation.
-func (m *UsersTeamsMutation) TeamsID() (id uuid.UUID, exists bool) {
-	if m.teams != nil {
-		return *m.teams, true
-	}
-	return
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
 }
 
-// TeamsIDs returns the "teams" edge IDs in the mutation.
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TeamsID instead. It exists only for internal usage by the builders.
-func (m *UsersTeamsMutation) TeamsIDs() (ids []uuid.UUID) {
-	if id := m.teams; id != nil {
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTeams resets all changes to the "teams" edge.
-func (m *UsersTeamsMutation) ResetTeams() {
-	m.teams = nil
-	m.clearedteams = false
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
-// Where appends a list predicates to the UsersTeamsMutation builder.
-func (m *UsersTeamsMutation) Where(ps ...predicate.UsersTeams) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UsersTeamsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UsersTeams, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9348,33 +17345,51 @@ func (m *UsersTeamsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UsersTeamsMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UsersTeamsMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UsersTeams).
-func (m *UsersTeamsMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UsersTeamsMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.users != nil {
-		fields = append(fields, usersteams.FieldUserID)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
-	if m.teams != nil {
-		fields = append(fields, usersteams.FieldTeamID)
+	if m.webhook != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m.is_default != nil {
-		fields = append(fields, usersteams.FieldIsDefault)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
+	}
+	if m.sandbox_id != nil {
+		fields = append(fields, webhookdelivery.FieldSandboxID)
+	}
+	if m.attempt != nil {
+		fields = append(fields, webhookdelivery.FieldAttempt)
+	}
+	if m.success != nil {
+		fields = append(fields, webhookdelivery.FieldSuccess)
+	}
+	if m.status_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
 	}
 	return fields
 }
@@ -9382,14 +17397,26 @@ func (m *UsersTeamsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case usersteams.FieldUserID:
-		return m.UserID()
-	case usersteams.FieldTeamID:
-		return m.TeamID()
-	case usersteams.FieldIsDefault:
-		return m.IsDefault()
+	case webhookdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldSandboxID:
+		return m.SandboxID()
+	case webhookdelivery.FieldAttempt:
+		return m.Attempt()
+	case webhookdelivery.FieldSuccess:
+		return m.Success()
+	case webhookdelivery.FieldStatusCode:
+		return m.StatusCode()
+	case webhookdelivery.FieldError:
+		return m.Error()
 	}
 	return nil, false
 }
@@ -9397,128 +17424,235 @@ func (m *UsersTeamsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UsersTeamsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case usersteams.FieldUserID:
-		return m.OldUserID(ctx)
-	case usersteams.FieldTeamID:
-		return m.OldTeamID(ctx)
-	case usersteams.FieldIsDefault:
-		return m.OldIsDefault(ctx)
+	case webhookdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldSandboxID:
+		return m.OldSandboxID(ctx)
+	case webhookdelivery.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case webhookdelivery.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case webhookdelivery.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
 	}
-	return nil, fmt.Errorf("unknown UsersTeams field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsersTeamsMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case usersteams.FieldUserID:
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdelivery.FieldWebhookID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetWebhookID(v)
 		return nil
-	case usersteams.FieldTeamID:
+	case webhookdelivery.FieldEventID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeamID(v)
+		m.SetEventID(v)
 		return nil
-	case usersteams.FieldIsDefault:
+	case webhookdelivery.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case webhookdelivery.FieldSandboxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSandboxID(v)
+		return nil
+	case webhookdelivery.FieldAttempt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempt(v)
+		return nil
+	case webhookdelivery.FieldSuccess:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDefault(v)
+		m.SetSuccess(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case webhookdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UsersTeamsMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempt != nil {
+		fields = append(fields, webhookdelivery.FieldAttempt)
+	}
+	if m.addstatus_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UsersTeamsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempt:
+		return m.AddedAttempt()
+	case webhookdelivery.FieldStatusCode:
+		return m.AddedStatusCode()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UsersTeamsMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempt:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempt(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UsersTeamsMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldStatusCode) {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UsersTeamsMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UsersTeamsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UsersTeams nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldStatusCode:
+		m.ClearStatusCode()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UsersTeamsMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case usersteams.FieldUserID:
-		m.ResetUserID()
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case usersteams.FieldTeamID:
-		m.ResetTeamID()
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case usersteams.FieldIsDefault:
-		m.ResetIsDefault()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case webhookdelivery.FieldSandboxID:
+		m.ResetSandboxID()
+		return nil
+	case webhookdelivery.FieldAttempt:
+		m.ResetAttempt()
+		return nil
+	case webhookdelivery.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ResetError()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UsersTeamsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, usersteams.EdgeUsers)
-	}
-	if m.teams != nil {
-		edges = append(edges, usersteams.EdgeTeams)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case usersteams.EdgeUsers:
-		if id := m.users; id != nil {
-			return []ent.Value{*id}
-		}
-	case usersteams.EdgeTeams:
-		if id := m.teams; id != nil {
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -9526,65 +17660,54 @@ func (m *UsersTeamsMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UsersTeamsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UsersTeamsMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UsersTeamsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, usersteams.EdgeUsers)
-	}
-	if m.clearedteams {
-		edges = append(edges, usersteams.EdgeTeams)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UsersTeamsMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case usersteams.EdgeUsers:
-		return m.clearedusers
-	case usersteams.EdgeTeams:
-		return m.clearedteams
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UsersTeamsMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case usersteams.EdgeUsers:
-		m.ClearUsers()
-		return nil
-	case usersteams.EdgeTeams:
-		m.ClearTeams()
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UsersTeamsMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case usersteams.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case usersteams.EdgeTeams:
-		m.ResetTeams()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown UsersTeams edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
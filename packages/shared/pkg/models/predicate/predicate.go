@@ -9,6 +9,12 @@ import (
 // AccessToken is the predicate function for accesstoken builders.
 type AccessToken func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Checkpoint is the predicate function for checkpoint builders.
+type Checkpoint func(*sql.Selector)
+
 // Cluster is the predicate function for cluster builders.
 type Cluster func(*sql.Selector)
 
@@ -21,6 +27,9 @@ type EnvAlias func(*sql.Selector)
 // EnvBuild is the predicate function for envbuild builders.
 type EnvBuild func(*sql.Selector)
 
+// EnvBuildTag is the predicate function for envbuildtag builders.
+type EnvBuildTag func(*sql.Selector)
+
 // Snapshot is the predicate function for snapshot builders.
 type Snapshot func(*sql.Selector)
 
@@ -38,3 +47,12 @@ type User func(*sql.Selector)
 
 // UsersTeams is the predicate function for usersteams builders.
 type UsersTeams func(*sql.Selector)
+
+// WarmPool is the predicate function for warmpool builders.
+type WarmPool func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -6,15 +6,22 @@ import (
 	"time"
 
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/accesstoken"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/auditlog"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/checkpoint"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/cluster"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envalias"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuild"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/envbuildtag"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/teamapikey"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhook"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
 	"github.com/e2b-dev/infra/packages/shared/pkg/schema"
 )
 
@@ -28,6 +35,18 @@ func init() {
 	accesstokenDescName := accesstokenFields[7].Descriptor()
 	// accesstoken.DefaultName holds the default value on creation for the name field.
 	accesstoken.DefaultName = accesstokenDescName.Default.(string)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[1].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	checkpointFields := schema.Checkpoint{}.Fields()
+	_ = checkpointFields
+	// checkpointDescCreatedAt is the schema descriptor for created_at field.
+	checkpointDescCreatedAt := checkpointFields[1].Descriptor()
+	// checkpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	checkpoint.DefaultCreatedAt = checkpointDescCreatedAt.Default.(func() time.Time)
 	clusterFields := schema.Cluster{}.Fields()
 	_ = clusterFields
 	// clusterDescEndpoint is the schema descriptor for endpoint field.
@@ -84,6 +103,26 @@ func init() {
 	envbuildDescFirecrackerVersion := envbuildFields[14].Descriptor()
 	// envbuild.DefaultFirecrackerVersion holds the default value on creation for the firecracker_version field.
 	envbuild.DefaultFirecrackerVersion = envbuildDescFirecrackerVersion.Default.(string)
+	// envbuildDescPriority is the schema descriptor for priority field.
+	envbuildDescPriority := envbuildFields[22].Descriptor()
+	// envbuild.DefaultPriority holds the default value on creation for the priority field.
+	envbuild.DefaultPriority = envbuildDescPriority.Default.(int64)
+	// envbuildDescCheckpoint is the schema descriptor for checkpoint field.
+	envbuildDescCheckpoint := envbuildFields[25].Descriptor()
+	// envbuild.DefaultCheckpoint holds the default value on creation for the checkpoint field.
+	envbuild.DefaultCheckpoint = envbuildDescCheckpoint.Default.(bool)
+	envbuildtagFields := schema.EnvBuildTag{}.Fields()
+	_ = envbuildtagFields
+	// envbuildtagDescCreatedAt is the schema descriptor for created_at field.
+	envbuildtagDescCreatedAt := envbuildtagFields[1].Descriptor()
+	// envbuildtag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	envbuildtag.DefaultCreatedAt = envbuildtagDescCreatedAt.Default.(func() time.Time)
+	// envbuildtagDescUpdatedAt is the schema descriptor for updated_at field.
+	envbuildtagDescUpdatedAt := envbuildtagFields[2].Descriptor()
+	// envbuildtag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	envbuildtag.DefaultUpdatedAt = envbuildtagDescUpdatedAt.Default.(func() time.Time)
+	// envbuildtag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	envbuildtag.UpdateDefaultUpdatedAt = envbuildtagDescUpdatedAt.UpdateDefault.(func() time.Time)
 	snapshotFields := schema.Snapshot{}.Fields()
 	_ = snapshotFields
 	// snapshotDescCreatedAt is the schema descriptor for created_at field.
@@ -114,6 +153,64 @@ func init() {
 	teamapikeyDescName := teamapikeyFields[10].Descriptor()
 	// teamapikey.DefaultName holds the default value on creation for the name field.
 	teamapikey.DefaultName = teamapikeyDescName.Default.(string)
+	tierFields := schema.Tier{}.Fields()
+	_ = tierFields
+	// tierDescMaxVcpu is the schema descriptor for max_vcpu field.
+	tierDescMaxVcpu := tierFields[5].Descriptor()
+	// tier.DefaultMaxVcpu holds the default value on creation for the max_vcpu field.
+	tier.DefaultMaxVcpu = tierDescMaxVcpu.Default.(int64)
+	// tierDescMaxRAMMB is the schema descriptor for max_ram_mb field.
+	tierDescMaxRAMMB := tierFields[6].Descriptor()
+	// tier.DefaultMaxRAMMB holds the default value on creation for the max_ram_mb field.
+	tier.DefaultMaxRAMMB = tierDescMaxRAMMB.Default.(int64)
+	// tierDescConcurrentVcpus is the schema descriptor for concurrent_vcpus field.
+	tierDescConcurrentVcpus := tierFields[7].Descriptor()
+	// tier.DefaultConcurrentVcpus holds the default value on creation for the concurrent_vcpus field.
+	tier.DefaultConcurrentVcpus = tierDescConcurrentVcpus.Default.(int64)
+	// tierDescConcurrentRAMMB is the schema descriptor for concurrent_ram_mb field.
+	tierDescConcurrentRAMMB := tierFields[8].Descriptor()
+	// tier.DefaultConcurrentRAMMB holds the default value on creation for the concurrent_ram_mb field.
+	tier.DefaultConcurrentRAMMB = tierDescConcurrentRAMMB.Default.(int64)
+	// tierDescPausedStorageMB is the schema descriptor for paused_storage_mb field.
+	tierDescPausedStorageMB := tierFields[9].Descriptor()
+	// tier.DefaultPausedStorageMB holds the default value on creation for the paused_storage_mb field.
+	tier.DefaultPausedStorageMB = tierDescPausedStorageMB.Default.(int64)
+	// tierDescPausedRetentionHours is the schema descriptor for paused_retention_hours field.
+	tierDescPausedRetentionHours := tierFields[10].Descriptor()
+	// tier.DefaultPausedRetentionHours holds the default value on creation for the paused_retention_hours field.
+	tier.DefaultPausedRetentionHours = tierDescPausedRetentionHours.Default.(int64)
+	// tierDescNetworkBandwidthMibps is the schema descriptor for network_bandwidth_mibps field.
+	tierDescNetworkBandwidthMibps := tierFields[12].Descriptor()
+	// tier.DefaultNetworkBandwidthMibps holds the default value on creation for the network_bandwidth_mibps field.
+	tier.DefaultNetworkBandwidthMibps = tierDescNetworkBandwidthMibps.Default.(int64)
+	// tierDescNetworkOps is the schema descriptor for network_ops field.
+	tierDescNetworkOps := tierFields[13].Descriptor()
+	// tier.DefaultNetworkOps holds the default value on creation for the network_ops field.
+	tier.DefaultNetworkOps = tierDescNetworkOps.Default.(int64)
+	// tierDescDiskBandwidthMibps is the schema descriptor for disk_bandwidth_mibps field.
+	tierDescDiskBandwidthMibps := tierFields[14].Descriptor()
+	// tier.DefaultDiskBandwidthMibps holds the default value on creation for the disk_bandwidth_mibps field.
+	tier.DefaultDiskBandwidthMibps = tierDescDiskBandwidthMibps.Default.(int64)
+	// tierDescDiskIops is the schema descriptor for disk_iops field.
+	tierDescDiskIops := tierFields[15].Descriptor()
+	// tier.DefaultDiskIops holds the default value on creation for the disk_iops field.
+	tier.DefaultDiskIops = tierDescDiskIops.Default.(int64)
+	// tierDescAPIRequestsPerSecond is the schema descriptor for api_requests_per_second field.
+	tierDescAPIRequestsPerSecond := tierFields[16].Descriptor()
+	// tier.DefaultAPIRequestsPerSecond holds the default value on creation for the api_requests_per_second field.
+	tier.DefaultAPIRequestsPerSecond = tierDescAPIRequestsPerSecond.Default.(int64)
+	// tierDescAPIRequestsBurst is the schema descriptor for api_requests_burst field.
+	tierDescAPIRequestsBurst := tierFields[17].Descriptor()
+	// tier.DefaultAPIRequestsBurst holds the default value on creation for the api_requests_burst field.
+	tier.DefaultAPIRequestsBurst = tierDescAPIRequestsBurst.Default.(int64)
+	// tierDescConcurrentTemplateBuilds is the schema descriptor for concurrent_template_builds field.
+	tierDescConcurrentTemplateBuilds := tierFields[18].Descriptor()
+	// tier.DefaultConcurrentTemplateBuilds holds the default value on creation for the concurrent_template_builds field.
+	tier.DefaultConcurrentTemplateBuilds = tierDescConcurrentTemplateBuilds.Default.(int64)
+	// tierDescBuildPriority is the schema descriptor for build_priority field.
+	tierDescBuildPriority := tierFields[19].Descriptor()
+	// tier.DefaultBuildPriority holds the default value on creation for the build_priority field.
+	tier.DefaultBuildPriority = tierDescBuildPriority.Default.(int64)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -126,4 +223,38 @@ func init() {
 	usersteamsDescIsDefault := usersteamsFields[2].Descriptor()
 	// usersteams.DefaultIsDefault holds the default value on creation for the is_default field.
 	usersteams.DefaultIsDefault = usersteamsDescIsDefault.Default.(bool)
+	warmpoolFields := schema.WarmPool{}.Fields()
+	_ = warmpoolFields
+	// warmpoolDescCreatedAt is the schema descriptor for created_at field.
+	warmpoolDescCreatedAt := warmpoolFields[1].Descriptor()
+	// warmpool.DefaultCreatedAt holds the default value on creation for the created_at field.
+	warmpool.DefaultCreatedAt = warmpoolDescCreatedAt.Default.(func() time.Time)
+	// warmpoolDescUpdatedAt is the schema descriptor for updated_at field.
+	warmpoolDescUpdatedAt := warmpoolFields[2].Descriptor()
+	// warmpool.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	warmpool.DefaultUpdatedAt = warmpoolDescUpdatedAt.Default.(func() time.Time)
+	// warmpool.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	warmpool.UpdateDefaultUpdatedAt = warmpoolDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[1].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[2].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[7].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -34,6 +35,14 @@ type Snapshot struct {
 	SandboxStartedAt time.Time `json:"sandbox_started_at,omitempty"`
 	// EnvSecure holds the value of the "env_secure" field.
 	EnvSecure bool `json:"env_secure,omitempty"`
+	// NetworkPolicy holds the value of the "network_policy" field.
+	NetworkPolicy *types.SandboxNetworkPolicy `json:"network_policy,omitempty"`
+	// PortAccess holds the value of the "port_access" field.
+	PortAccess *types.SandboxPortAccess `json:"port_access,omitempty"`
+	// Time the sandbox was paused, not set while the sandbox is running
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// Overrides the tier retention of the paused sandbox
+	RetentionHours *int64 `json:"retention_hours,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SnapshotQuery when eager-loading is set.
 	Edges        SnapshotEdges `json:"edges"`
@@ -67,13 +76,15 @@ func (*Snapshot) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case snapshot.FieldMetadata:
+		case snapshot.FieldMetadata, snapshot.FieldNetworkPolicy, snapshot.FieldPortAccess:
 			values[i] = new([]byte)
 		case snapshot.FieldEnvSecure:
 			values[i] = new(sql.NullBool)
+		case snapshot.FieldRetentionHours:
+			values[i] = new(sql.NullInt64)
 		case snapshot.FieldBaseEnvID, snapshot.FieldEnvID, snapshot.FieldSandboxID:
 			values[i] = new(sql.NullString)
-		case snapshot.FieldCreatedAt, snapshot.FieldSandboxStartedAt:
+		case snapshot.FieldCreatedAt, snapshot.FieldSandboxStartedAt, snapshot.FieldPausedAt:
 			values[i] = new(sql.NullTime)
 		case snapshot.FieldID:
 			values[i] = new(uuid.UUID)
@@ -142,6 +153,36 @@ func (s *Snapshot) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.EnvSecure = value.Bool
 			}
+		case snapshot.FieldNetworkPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field network_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.NetworkPolicy); err != nil {
+					return fmt.Errorf("unmarshal field network_policy: %w", err)
+				}
+			}
+		case snapshot.FieldPortAccess:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field port_access", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.PortAccess); err != nil {
+					return fmt.Errorf("unmarshal field port_access: %w", err)
+				}
+			}
+		case snapshot.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				s.PausedAt = new(time.Time)
+				*s.PausedAt = value.Time
+			}
+		case snapshot.FieldRetentionHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_hours", values[i])
+			} else if value.Valid {
+				s.RetentionHours = new(int64)
+				*s.RetentionHours = value.Int64
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -203,6 +244,22 @@ func (s *Snapshot) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("env_secure=")
 	builder.WriteString(fmt.Sprintf("%v", s.EnvSecure))
+	builder.WriteString(", ")
+	builder.WriteString("network_policy=")
+	builder.WriteString(fmt.Sprintf("%v", s.NetworkPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("port_access=")
+	builder.WriteString(fmt.Sprintf("%v", s.PortAccess))
+	builder.WriteString(", ")
+	if v := s.PausedAt; v != nil {
+		builder.WriteString("paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.RetentionHours; v != nil {
+		builder.WriteString("retention_hours=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,14 @@ const (
 	FieldSandboxStartedAt = "sandbox_started_at"
 	// FieldEnvSecure holds the string denoting the env_secure field in the database.
 	FieldEnvSecure = "env_secure"
+	// FieldNetworkPolicy holds the string denoting the network_policy field in the database.
+	FieldNetworkPolicy = "network_policy"
+	// FieldPortAccess holds the string denoting the port_access field in the database.
+	FieldPortAccess = "port_access"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// FieldRetentionHours holds the string denoting the retention_hours field in the database.
+	FieldRetentionHours = "retention_hours"
 	// EdgeEnv holds the string denoting the env edge name in mutations.
 	EdgeEnv = "env"
 	// Table holds the table name of the snapshot in the database.
@@ -51,6 +59,10 @@ var Columns = []string{
 	FieldMetadata,
 	FieldSandboxStartedAt,
 	FieldEnvSecure,
+	FieldNetworkPolicy,
+	FieldPortAccess,
+	FieldPausedAt,
+	FieldRetentionHours,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -108,6 +120,16 @@ func ByEnvSecure(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnvSecure, opts...).ToFunc()
 }
 
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
+
+// ByRetentionHours orders the results by the retention_hours field.
+func ByRetentionHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionHours, opts...).ToFunc()
+}
+
 // ByEnvField orders the results by env field.
 func ByEnvField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -87,6 +87,16 @@ func EnvSecure(v bool) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldEQ(FieldEnvSecure, v))
 }
 
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// RetentionHours applies equality check predicate on the "retention_hours" field. It's identical to RetentionHoursEQ.
+func RetentionHours(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldRetentionHours, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldEQ(FieldCreatedAt, v))
@@ -372,6 +382,126 @@ func EnvSecureNEQ(v bool) predicate.Snapshot {
 	return predicate.Snapshot(sql.FieldNEQ(FieldEnvSecure, v))
 }
 
+// NetworkPolicyIsNil applies the IsNil predicate on the "network_policy" field.
+func NetworkPolicyIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldNetworkPolicy))
+}
+
+// NetworkPolicyNotNil applies the NotNil predicate on the "network_policy" field.
+func NetworkPolicyNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldNetworkPolicy))
+}
+
+// PortAccessIsNil applies the IsNil predicate on the "port_access" field.
+func PortAccessIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldPortAccess))
+}
+
+// PortAccessNotNil applies the NotNil predicate on the "port_access" field.
+func PortAccessNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldPortAccess))
+}
+
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// PausedAtIsNil applies the IsNil predicate on the "paused_at" field.
+func PausedAtIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldPausedAt))
+}
+
+// PausedAtNotNil applies the NotNil predicate on the "paused_at" field.
+func PausedAtNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldPausedAt))
+}
+
+// RetentionHoursEQ applies the EQ predicate on the "retention_hours" field.
+func RetentionHoursEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldEQ(FieldRetentionHours, v))
+}
+
+// RetentionHoursNEQ applies the NEQ predicate on the "retention_hours" field.
+func RetentionHoursNEQ(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNEQ(FieldRetentionHours, v))
+}
+
+// RetentionHoursIn applies the In predicate on the "retention_hours" field.
+func RetentionHoursIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIn(FieldRetentionHours, vs...))
+}
+
+// RetentionHoursNotIn applies the NotIn predicate on the "retention_hours" field.
+func RetentionHoursNotIn(vs ...int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotIn(FieldRetentionHours, vs...))
+}
+
+// RetentionHoursGT applies the GT predicate on the "retention_hours" field.
+func RetentionHoursGT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGT(FieldRetentionHours, v))
+}
+
+// RetentionHoursGTE applies the GTE predicate on the "retention_hours" field.
+func RetentionHoursGTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldGTE(FieldRetentionHours, v))
+}
+
+// RetentionHoursLT applies the LT predicate on the "retention_hours" field.
+func RetentionHoursLT(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLT(FieldRetentionHours, v))
+}
+
+// RetentionHoursLTE applies the LTE predicate on the "retention_hours" field.
+func RetentionHoursLTE(v int64) predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldLTE(FieldRetentionHours, v))
+}
+
+// RetentionHoursIsNil applies the IsNil predicate on the "retention_hours" field.
+func RetentionHoursIsNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldIsNull(FieldRetentionHours))
+}
+
+// RetentionHoursNotNil applies the NotNil predicate on the "retention_hours" field.
+func RetentionHoursNotNil() predicate.Snapshot {
+	return predicate.Snapshot(sql.FieldNotNull(FieldRetentionHours))
+}
+
 // HasEnv applies the HasEdge predicate on the "env" edge.
 func HasEnv() predicate.Snapshot {
 	return predicate.Snapshot(func(s *sql.Selector) {
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -83,6 +84,46 @@ func (sc *SnapshotCreate) SetNillableEnvSecure(b *bool) *SnapshotCreate {
 	return sc
 }
 
+// SetNetworkPolicy sets the "network_policy" field.
+func (sc *SnapshotCreate) SetNetworkPolicy(tnp *types.SandboxNetworkPolicy) *SnapshotCreate {
+	sc.mutation.SetNetworkPolicy(tnp)
+	return sc
+}
+
+// SetPortAccess sets the "port_access" field.
+func (sc *SnapshotCreate) SetPortAccess(tpa *types.SandboxPortAccess) *SnapshotCreate {
+	sc.mutation.SetPortAccess(tpa)
+	return sc
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (sc *SnapshotCreate) SetPausedAt(t time.Time) *SnapshotCreate {
+	sc.mutation.SetPausedAt(t)
+	return sc
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillablePausedAt(t *time.Time) *SnapshotCreate {
+	if t != nil {
+		sc.SetPausedAt(*t)
+	}
+	return sc
+}
+
+// SetRetentionHours sets the "retention_hours" field.
+func (sc *SnapshotCreate) SetRetentionHours(i int64) *SnapshotCreate {
+	sc.mutation.SetRetentionHours(i)
+	return sc
+}
+
+// SetNillableRetentionHours sets the "retention_hours" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableRetentionHours(i *int64) *SnapshotCreate {
+	if i != nil {
+		sc.SetRetentionHours(*i)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SnapshotCreate) SetID(u uuid.UUID) *SnapshotCreate {
 	sc.mutation.SetID(u)
@@ -226,6 +267,22 @@ func (sc *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
 		_spec.SetField(snapshot.FieldEnvSecure, field.TypeBool, value)
 		_node.EnvSecure = value
 	}
+	if value, ok := sc.mutation.NetworkPolicy(); ok {
+		_spec.SetField(snapshot.FieldNetworkPolicy, field.TypeJSON, value)
+		_node.NetworkPolicy = value
+	}
+	if value, ok := sc.mutation.PortAccess(); ok {
+		_spec.SetField(snapshot.FieldPortAccess, field.TypeJSON, value)
+		_node.PortAccess = value
+	}
+	if value, ok := sc.mutation.PausedAt(); ok {
+		_spec.SetField(snapshot.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = &value
+	}
+	if value, ok := sc.mutation.RetentionHours(); ok {
+		_spec.SetField(snapshot.FieldRetentionHours, field.TypeInt64, value)
+		_node.RetentionHours = &value
+	}
 	if nodes := sc.mutation.EnvIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -368,6 +425,84 @@ func (u *SnapshotUpsert) UpdateEnvSecure() *SnapshotUpsert {
 	return u
 }
 
+// SetNetworkPolicy sets the "network_policy" field.
+func (u *SnapshotUpsert) SetNetworkPolicy(v *types.SandboxNetworkPolicy) *SnapshotUpsert {
+	u.Set(snapshot.FieldNetworkPolicy, v)
+	return u
+}
+
+// UpdateNetworkPolicy sets the "network_policy" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateNetworkPolicy() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldNetworkPolicy)
+	return u
+}
+
+// ClearNetworkPolicy clears the value of the "network_policy" field.
+func (u *SnapshotUpsert) ClearNetworkPolicy() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldNetworkPolicy)
+	return u
+}
+
+// SetPortAccess sets the "port_access" field.
+func (u *SnapshotUpsert) SetPortAccess(v *types.SandboxPortAccess) *SnapshotUpsert {
+	u.Set(snapshot.FieldPortAccess, v)
+	return u
+}
+
+// UpdatePortAccess sets the "port_access" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdatePortAccess() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldPortAccess)
+	return u
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (u *SnapshotUpsert) ClearPortAccess() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldPortAccess)
+	return u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (u *SnapshotUpsert) SetPausedAt(v time.Time) *SnapshotUpsert {
+	u.Set(snapshot.FieldPausedAt, v)
+	return u
+}
+
+// UpdatePausedAt sets the "paused_at" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdatePausedAt() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldPausedAt)
+	return u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (u *SnapshotUpsert) ClearPausedAt() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldPausedAt)
+	return u
+}
+
+// SetRetentionHours sets the "retention_hours" field.
+func (u *SnapshotUpsert) SetRetentionHours(v int64) *SnapshotUpsert {
+	u.Set(snapshot.FieldRetentionHours, v)
+	return u
+}
+
+// UpdateRetentionHours sets the "retention_hours" field to the value that was provided on create.
+func (u *SnapshotUpsert) UpdateRetentionHours() *SnapshotUpsert {
+	u.SetExcluded(snapshot.FieldRetentionHours)
+	return u
+}
+
+// AddRetentionHours adds v to the "retention_hours" field.
+func (u *SnapshotUpsert) AddRetentionHours(v int64) *SnapshotUpsert {
+	u.Add(snapshot.FieldRetentionHours, v)
+	return u
+}
+
+// ClearRetentionHours clears the value of the "retention_hours" field.
+func (u *SnapshotUpsert) ClearRetentionHours() *SnapshotUpsert {
+	u.SetNull(snapshot.FieldRetentionHours)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -503,6 +638,97 @@ func (u *SnapshotUpsertOne) UpdateEnvSecure() *SnapshotUpsertOne {
 	})
 }
 
+// SetNetworkPolicy sets the "network_policy" field.
+func (u *SnapshotUpsertOne) SetNetworkPolicy(v *types.SandboxNetworkPolicy) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetworkPolicy(v)
+	})
+}
+
+// UpdateNetworkPolicy sets the "network_policy" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateNetworkPolicy() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetworkPolicy()
+	})
+}
+
+// ClearNetworkPolicy clears the value of the "network_policy" field.
+func (u *SnapshotUpsertOne) ClearNetworkPolicy() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetworkPolicy()
+	})
+}
+
+// SetPortAccess sets the "port_access" field.
+func (u *SnapshotUpsertOne) SetPortAccess(v *types.SandboxPortAccess) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetPortAccess(v)
+	})
+}
+
+// UpdatePortAccess sets the "port_access" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdatePortAccess() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdatePortAccess()
+	})
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (u *SnapshotUpsertOne) ClearPortAccess() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearPortAccess()
+	})
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (u *SnapshotUpsertOne) SetPausedAt(v time.Time) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetPausedAt(v)
+	})
+}
+
+// UpdatePausedAt sets the "paused_at" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdatePausedAt() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdatePausedAt()
+	})
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (u *SnapshotUpsertOne) ClearPausedAt() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearPausedAt()
+	})
+}
+
+// SetRetentionHours sets the "retention_hours" field.
+func (u *SnapshotUpsertOne) SetRetentionHours(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetRetentionHours(v)
+	})
+}
+
+// AddRetentionHours adds v to the "retention_hours" field.
+func (u *SnapshotUpsertOne) AddRetentionHours(v int64) *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddRetentionHours(v)
+	})
+}
+
+// UpdateRetentionHours sets the "retention_hours" field to the value that was provided on create.
+func (u *SnapshotUpsertOne) UpdateRetentionHours() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateRetentionHours()
+	})
+}
+
+// ClearRetentionHours clears the value of the "retention_hours" field.
+func (u *SnapshotUpsertOne) ClearRetentionHours() *SnapshotUpsertOne {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearRetentionHours()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -805,6 +1031,97 @@ func (u *SnapshotUpsertBulk) UpdateEnvSecure() *SnapshotUpsertBulk {
 	})
 }
 
+// SetNetworkPolicy sets the "network_policy" field.
+func (u *SnapshotUpsertBulk) SetNetworkPolicy(v *types.SandboxNetworkPolicy) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetNetworkPolicy(v)
+	})
+}
+
+// UpdateNetworkPolicy sets the "network_policy" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateNetworkPolicy() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateNetworkPolicy()
+	})
+}
+
+// ClearNetworkPolicy clears the value of the "network_policy" field.
+func (u *SnapshotUpsertBulk) ClearNetworkPolicy() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearNetworkPolicy()
+	})
+}
+
+// SetPortAccess sets the "port_access" field.
+func (u *SnapshotUpsertBulk) SetPortAccess(v *types.SandboxPortAccess) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetPortAccess(v)
+	})
+}
+
+// UpdatePortAccess sets the "port_access" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdatePortAccess() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdatePortAccess()
+	})
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (u *SnapshotUpsertBulk) ClearPortAccess() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearPortAccess()
+	})
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (u *SnapshotUpsertBulk) SetPausedAt(v time.Time) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetPausedAt(v)
+	})
+}
+
+// UpdatePausedAt sets the "paused_at" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdatePausedAt() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdatePausedAt()
+	})
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (u *SnapshotUpsertBulk) ClearPausedAt() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearPausedAt()
+	})
+}
+
+// SetRetentionHours sets the "retention_hours" field.
+func (u *SnapshotUpsertBulk) SetRetentionHours(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.SetRetentionHours(v)
+	})
+}
+
+// AddRetentionHours adds v to the "retention_hours" field.
+func (u *SnapshotUpsertBulk) AddRetentionHours(v int64) *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.AddRetentionHours(v)
+	})
+}
+
+// UpdateRetentionHours sets the "retention_hours" field to the value that was provided on create.
+func (u *SnapshotUpsertBulk) UpdateRetentionHours() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.UpdateRetentionHours()
+	})
+}
+
+// ClearRetentionHours clears the value of the "retention_hours" field.
+func (u *SnapshotUpsertBulk) ClearRetentionHours() *SnapshotUpsertBulk {
+	return u.Update(func(s *SnapshotUpsert) {
+		s.ClearRetentionHours()
+	})
+}
+
 // Exec executes the query.
 func (u *SnapshotUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/snapshot"
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 )
 
 // SnapshotUpdate is the builder for updating Snapshot entities.
@@ -107,6 +108,77 @@ func (su *SnapshotUpdate) SetNillableEnvSecure(b *bool) *SnapshotUpdate {
 	return su
 }
 
+// SetNetworkPolicy sets the "network_policy" field.
+func (su *SnapshotUpdate) SetNetworkPolicy(tnp *types.SandboxNetworkPolicy) *SnapshotUpdate {
+	su.mutation.SetNetworkPolicy(tnp)
+	return su
+}
+
+// ClearNetworkPolicy clears the value of the "network_policy" field.
+func (su *SnapshotUpdate) ClearNetworkPolicy() *SnapshotUpdate {
+	su.mutation.ClearNetworkPolicy()
+	return su
+}
+
+// SetPortAccess sets the "port_access" field.
+func (su *SnapshotUpdate) SetPortAccess(tpa *types.SandboxPortAccess) *SnapshotUpdate {
+	su.mutation.SetPortAccess(tpa)
+	return su
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (su *SnapshotUpdate) ClearPortAccess() *SnapshotUpdate {
+	su.mutation.ClearPortAccess()
+	return su
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (su *SnapshotUpdate) SetPausedAt(t time.Time) *SnapshotUpdate {
+	su.mutation.SetPausedAt(t)
+	return su
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillablePausedAt(t *time.Time) *SnapshotUpdate {
+	if t != nil {
+		su.SetPausedAt(*t)
+	}
+	return su
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (su *SnapshotUpdate) ClearPausedAt() *SnapshotUpdate {
+	su.mutation.ClearPausedAt()
+	return su
+}
+
+// SetRetentionHours sets the "retention_hours" field.
+func (su *SnapshotUpdate) SetRetentionHours(i int64) *SnapshotUpdate {
+	su.mutation.ResetRetentionHours()
+	su.mutation.SetRetentionHours(i)
+	return su
+}
+
+// SetNillableRetentionHours sets the "retention_hours" field if the given value is not nil.
+func (su *SnapshotUpdate) SetNillableRetentionHours(i *int64) *SnapshotUpdate {
+	if i != nil {
+		su.SetRetentionHours(*i)
+	}
+	return su
+}
+
+// AddRetentionHours adds i to the "retention_hours" field.
+func (su *SnapshotUpdate) AddRetentionHours(i int64) *SnapshotUpdate {
+	su.mutation.AddRetentionHours(i)
+	return su
+}
+
+// ClearRetentionHours clears the value of the "retention_hours" field.
+func (su *SnapshotUpdate) ClearRetentionHours() *SnapshotUpdate {
+	su.mutation.ClearRetentionHours()
+	return su
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (su *SnapshotUpdate) SetEnv(e *Env) *SnapshotUpdate {
 	return su.SetEnvID(e.ID)
@@ -191,6 +263,33 @@ func (su *SnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.EnvSecure(); ok {
 		_spec.SetField(snapshot.FieldEnvSecure, field.TypeBool, value)
 	}
+	if value, ok := su.mutation.NetworkPolicy(); ok {
+		_spec.SetField(snapshot.FieldNetworkPolicy, field.TypeJSON, value)
+	}
+	if su.mutation.NetworkPolicyCleared() {
+		_spec.ClearField(snapshot.FieldNetworkPolicy, field.TypeJSON)
+	}
+	if value, ok := su.mutation.PortAccess(); ok {
+		_spec.SetField(snapshot.FieldPortAccess, field.TypeJSON, value)
+	}
+	if su.mutation.PortAccessCleared() {
+		_spec.ClearField(snapshot.FieldPortAccess, field.TypeJSON)
+	}
+	if value, ok := su.mutation.PausedAt(); ok {
+		_spec.SetField(snapshot.FieldPausedAt, field.TypeTime, value)
+	}
+	if su.mutation.PausedAtCleared() {
+		_spec.ClearField(snapshot.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.RetentionHours(); ok {
+		_spec.SetField(snapshot.FieldRetentionHours, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedRetentionHours(); ok {
+		_spec.AddField(snapshot.FieldRetentionHours, field.TypeInt64, value)
+	}
+	if su.mutation.RetentionHoursCleared() {
+		_spec.ClearField(snapshot.FieldRetentionHours, field.TypeInt64)
+	}
 	if su.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -322,6 +421,77 @@ func (suo *SnapshotUpdateOne) SetNillableEnvSecure(b *bool) *SnapshotUpdateOne {
 	return suo
 }
 
+// SetNetworkPolicy sets the "network_policy" field.
+func (suo *SnapshotUpdateOne) SetNetworkPolicy(tnp *types.SandboxNetworkPolicy) *SnapshotUpdateOne {
+	suo.mutation.SetNetworkPolicy(tnp)
+	return suo
+}
+
+// ClearNetworkPolicy clears the value of the "network_policy" field.
+func (suo *SnapshotUpdateOne) ClearNetworkPolicy() *SnapshotUpdateOne {
+	suo.mutation.ClearNetworkPolicy()
+	return suo
+}
+
+// SetPortAccess sets the "port_access" field.
+func (suo *SnapshotUpdateOne) SetPortAccess(tpa *types.SandboxPortAccess) *SnapshotUpdateOne {
+	suo.mutation.SetPortAccess(tpa)
+	return suo
+}
+
+// ClearPortAccess clears the value of the "port_access" field.
+func (suo *SnapshotUpdateOne) ClearPortAccess() *SnapshotUpdateOne {
+	suo.mutation.ClearPortAccess()
+	return suo
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (suo *SnapshotUpdateOne) SetPausedAt(t time.Time) *SnapshotUpdateOne {
+	suo.mutation.SetPausedAt(t)
+	return suo
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillablePausedAt(t *time.Time) *SnapshotUpdateOne {
+	if t != nil {
+		suo.SetPausedAt(*t)
+	}
+	return suo
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (suo *SnapshotUpdateOne) ClearPausedAt() *SnapshotUpdateOne {
+	suo.mutation.ClearPausedAt()
+	return suo
+}
+
+// SetRetentionHours sets the "retention_hours" field.
+func (suo *SnapshotUpdateOne) SetRetentionHours(i int64) *SnapshotUpdateOne {
+	suo.mutation.ResetRetentionHours()
+	suo.mutation.SetRetentionHours(i)
+	return suo
+}
+
+// SetNillableRetentionHours sets the "retention_hours" field if the given value is not nil.
+func (suo *SnapshotUpdateOne) SetNillableRetentionHours(i *int64) *SnapshotUpdateOne {
+	if i != nil {
+		suo.SetRetentionHours(*i)
+	}
+	return suo
+}
+
+// AddRetentionHours adds i to the "retention_hours" field.
+func (suo *SnapshotUpdateOne) AddRetentionHours(i int64) *SnapshotUpdateOne {
+	suo.mutation.AddRetentionHours(i)
+	return suo
+}
+
+// ClearRetentionHours clears the value of the "retention_hours" field.
+func (suo *SnapshotUpdateOne) ClearRetentionHours() *SnapshotUpdateOne {
+	suo.mutation.ClearRetentionHours()
+	return suo
+}
+
 // SetEnv sets the "env" edge to the Env entity.
 func (suo *SnapshotUpdateOne) SetEnv(e *Env) *SnapshotUpdateOne {
 	return suo.SetEnvID(e.ID)
@@ -436,6 +606,33 @@ func (suo *SnapshotUpdateOne) sqlSave(ctx context.Context) (_node *Snapshot, err
 	if value, ok := suo.mutation.EnvSecure(); ok {
 		_spec.SetField(snapshot.FieldEnvSecure, field.TypeBool, value)
 	}
+	if value, ok := suo.mutation.NetworkPolicy(); ok {
+		_spec.SetField(snapshot.FieldNetworkPolicy, field.TypeJSON, value)
+	}
+	if suo.mutation.NetworkPolicyCleared() {
+		_spec.ClearField(snapshot.FieldNetworkPolicy, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.PortAccess(); ok {
+		_spec.SetField(snapshot.FieldPortAccess, field.TypeJSON, value)
+	}
+	if suo.mutation.PortAccessCleared() {
+		_spec.ClearField(snapshot.FieldPortAccess, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.PausedAt(); ok {
+		_spec.SetField(snapshot.FieldPausedAt, field.TypeTime, value)
+	}
+	if suo.mutation.PausedAtCleared() {
+		_spec.ClearField(snapshot.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.RetentionHours(); ok {
+		_spec.SetField(snapshot.FieldRetentionHours, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedRetentionHours(); ok {
+		_spec.AddField(snapshot.FieldRetentionHours, field.TypeInt64, value)
+	}
+	if suo.mutation.RetentionHoursCleared() {
+		_spec.ClearField(snapshot.FieldRetentionHours, field.TypeInt64)
+	}
 	if suo.mutation.EnvCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
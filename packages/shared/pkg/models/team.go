@@ -51,11 +51,15 @@ type TeamEdges struct {
 	TeamTier *Tier `json:"team_tier,omitempty"`
 	// Envs holds the value of the envs edge.
 	Envs []*Env `json:"envs,omitempty"`
+	// WarmPools holds the value of the warm_pools edge.
+	WarmPools []*WarmPool `json:"warm_pools,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
 	// UsersTeams holds the value of the users_teams edge.
 	UsersTeams []*UsersTeams `json:"users_teams,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -98,10 +102,28 @@ func (e TeamEdges) EnvsOrErr() ([]*Env, error) {
 	return nil, &NotLoadedError{edge: "envs"}
 }
 
+// WarmPoolsOrErr returns the WarmPools value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) WarmPoolsOrErr() ([]*WarmPool, error) {
+	if e.loadedTypes[4] {
+		return e.WarmPools, nil
+	}
+	return nil, &NotLoadedError{edge: "warm_pools"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[5] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
 // UsersTeamsOrErr returns the UsersTeams value or an error if the edge
 // was not loaded in eager-loading.
 func (e TeamEdges) UsersTeamsOrErr() ([]*UsersTeams, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[6] {
 		return e.UsersTeams, nil
 	}
 	return nil, &NotLoadedError{edge: "users_teams"}
@@ -226,6 +248,16 @@ func (t *Team) QueryEnvs() *EnvQuery {
 	return NewTeamClient(t.config).QueryEnvs(t)
 }
 
+// QueryWarmPools queries the "warm_pools" edge of the Team entity.
+func (t *Team) QueryWarmPools() *WarmPoolQuery {
+	return NewTeamClient(t.config).QueryWarmPools(t)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Team entity.
+func (t *Team) QueryWebhooks() *WebhookQuery {
+	return NewTeamClient(t.config).QueryWebhooks(t)
+}
+
 // QueryUsersTeams queries the "users_teams" edge of the Team entity.
 func (t *Team) QueryUsersTeams() *UsersTeamsQuery {
 	return NewTeamClient(t.config).QueryUsersTeams(t)
@@ -38,6 +38,10 @@ const (
 	EdgeTeamTier = "team_tier"
 	// EdgeEnvs holds the string denoting the envs edge name in mutations.
 	EdgeEnvs = "envs"
+	// EdgeWarmPools holds the string denoting the warm_pools edge name in mutations.
+	EdgeWarmPools = "warm_pools"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
 	// EdgeUsersTeams holds the string denoting the users_teams edge name in mutations.
 	EdgeUsersTeams = "users_teams"
 	// Table holds the table name of the team in the database.
@@ -68,6 +72,20 @@ const (
 	EnvsInverseTable = "envs"
 	// EnvsColumn is the table column denoting the envs relation/edge.
 	EnvsColumn = "team_id"
+	// WarmPoolsTable is the table that holds the warm_pools relation/edge.
+	WarmPoolsTable = "warm_pools"
+	// WarmPoolsInverseTable is the table name for the WarmPool entity.
+	// It exists in this package in order to avoid circular dependency with the "warmpool" package.
+	WarmPoolsInverseTable = "warm_pools"
+	// WarmPoolsColumn is the table column denoting the warm_pools relation/edge.
+	WarmPoolsColumn = "team_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "team_id"
 	// UsersTeamsTable is the table that holds the users_teams relation/edge.
 	UsersTeamsTable = "users_teams"
 	// UsersTeamsInverseTable is the table name for the UsersTeams entity.
@@ -210,6 +228,34 @@ func ByEnvs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByWarmPoolsCount orders the results by warm_pools count.
+func ByWarmPoolsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWarmPoolsStep(), opts...)
+	}
+}
+
+// ByWarmPools orders the results by warm_pools terms.
+func ByWarmPools(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWarmPoolsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByUsersTeamsCount orders the results by users_teams count.
 func ByUsersTeamsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -251,6 +297,20 @@ func newEnvsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, EnvsTable, EnvsColumn),
 	)
 }
+func newWarmPoolsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WarmPoolsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WarmPoolsTable, WarmPoolsColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
 func newUsersTeamsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -613,6 +613,64 @@ func HasEnvsWith(preds ...predicate.Env) predicate.Team {
 	})
 }
 
+// HasWarmPools applies the HasEdge predicate on the "warm_pools" edge.
+func HasWarmPools() predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WarmPoolsTable, WarmPoolsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.WarmPool
+		step.Edge.Schema = schemaConfig.WarmPool
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWarmPoolsWith applies the HasEdge predicate on the "warm_pools" edge with a given conditions (other predicates).
+func HasWarmPoolsWith(preds ...predicate.WarmPool) predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := newWarmPoolsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.WarmPool
+		step.Edge.Schema = schemaConfig.WarmPool
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasUsersTeams applies the HasEdge predicate on the "users_teams" edge.
 func HasUsersTeams() predicate.Team {
 	return predicate.Team(func(s *sql.Selector) {
@@ -18,6 +18,8 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhook"
 	"github.com/google/uuid"
 )
 
@@ -179,6 +181,36 @@ func (tc *TeamCreate) AddEnvs(e ...*Env) *TeamCreate {
 	return tc.AddEnvIDs(ids...)
 }
 
+// AddWarmPoolIDs adds the "warm_pools" edge to the WarmPool entity by IDs.
+func (tc *TeamCreate) AddWarmPoolIDs(ids ...uuid.UUID) *TeamCreate {
+	tc.mutation.AddWarmPoolIDs(ids...)
+	return tc
+}
+
+// AddWarmPools adds the "warm_pools" edges to the WarmPool entity.
+func (tc *TeamCreate) AddWarmPools(w ...*WarmPool) *TeamCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tc.AddWarmPoolIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (tc *TeamCreate) AddWebhookIDs(ids ...uuid.UUID) *TeamCreate {
+	tc.mutation.AddWebhookIDs(ids...)
+	return tc
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (tc *TeamCreate) AddWebhooks(w ...*Webhook) *TeamCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tc.AddWebhookIDs(ids...)
+}
+
 // AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by IDs.
 func (tc *TeamCreate) AddUsersTeamIDs(ids ...int) *TeamCreate {
 	tc.mutation.AddUsersTeamIDs(ids...)
@@ -395,6 +427,40 @@ func (tc *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.WarmPoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WarmPoolsTable,
+			Columns: []string{team.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tc.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tc.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tc.mutation.UsersTeamsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -19,6 +19,8 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhook"
 	"github.com/google/uuid"
 )
 
@@ -33,6 +35,8 @@ type TeamQuery struct {
 	withTeamAPIKeys *TeamAPIKeyQuery
 	withTeamTier    *TierQuery
 	withEnvs        *EnvQuery
+	withWarmPools   *WarmPoolQuery
+	withWebhooks    *WebhookQuery
 	withUsersTeams  *UsersTeamsQuery
 	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -171,6 +175,56 @@ func (tq *TeamQuery) QueryEnvs() *EnvQuery {
 	return query
 }
 
+// QueryWarmPools chains the current query on the "warm_pools" edge.
+func (tq *TeamQuery) QueryWarmPools() *WarmPoolQuery {
+	query := (&WarmPoolClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(warmpool.Table, warmpool.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.WarmPoolsTable, team.WarmPoolsColumn),
+		)
+		schemaConfig := tq.schemaConfig
+		step.To.Schema = schemaConfig.WarmPool
+		step.Edge.Schema = schemaConfig.WarmPool
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhooks chains the current query on the "webhooks" edge.
+func (tq *TeamQuery) QueryWebhooks() *WebhookQuery {
+	query := (&WebhookClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.WebhooksTable, team.WebhooksColumn),
+		)
+		schemaConfig := tq.schemaConfig
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryUsersTeams chains the current query on the "users_teams" edge.
 func (tq *TeamQuery) QueryUsersTeams() *UsersTeamsQuery {
 	query := (&UsersTeamsClient{config: tq.config}).Query()
@@ -392,6 +446,8 @@ func (tq *TeamQuery) Clone() *TeamQuery {
 		withTeamAPIKeys: tq.withTeamAPIKeys.Clone(),
 		withTeamTier:    tq.withTeamTier.Clone(),
 		withEnvs:        tq.withEnvs.Clone(),
+		withWarmPools:   tq.withWarmPools.Clone(),
+		withWebhooks:    tq.withWebhooks.Clone(),
 		withUsersTeams:  tq.withUsersTeams.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
@@ -443,6 +499,28 @@ func (tq *TeamQuery) WithEnvs(opts ...func(*EnvQuery)) *TeamQuery {
 	return tq
 }
 
+// WithWarmPools tells the query-builder to eager-load the nodes that are connected to
+// the "warm_pools" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TeamQuery) WithWarmPools(opts ...func(*WarmPoolQuery)) *TeamQuery {
+	query := (&WarmPoolClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withWarmPools = query
+	return tq
+}
+
+// WithWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TeamQuery) WithWebhooks(opts ...func(*WebhookQuery)) *TeamQuery {
+	query := (&WebhookClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withWebhooks = query
+	return tq
+}
+
 // WithUsersTeams tells the query-builder to eager-load the nodes that are connected to
 // the "users_teams" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TeamQuery) WithUsersTeams(opts ...func(*UsersTeamsQuery)) *TeamQuery {
@@ -532,11 +610,13 @@ func (tq *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 	var (
 		nodes       = []*Team{}
 		_spec       = tq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			tq.withUsers != nil,
 			tq.withTeamAPIKeys != nil,
 			tq.withTeamTier != nil,
 			tq.withEnvs != nil,
+			tq.withWarmPools != nil,
+			tq.withWebhooks != nil,
 			tq.withUsersTeams != nil,
 		}
 	)
@@ -590,6 +670,20 @@ func (tq *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 			return nil, err
 		}
 	}
+	if query := tq.withWarmPools; query != nil {
+		if err := tq.loadWarmPools(ctx, query, nodes,
+			func(n *Team) { n.Edges.WarmPools = []*WarmPool{} },
+			func(n *Team, e *WarmPool) { n.Edges.WarmPools = append(n.Edges.WarmPools, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withWebhooks; query != nil {
+		if err := tq.loadWebhooks(ctx, query, nodes,
+			func(n *Team) { n.Edges.Webhooks = []*Webhook{} },
+			func(n *Team, e *Webhook) { n.Edges.Webhooks = append(n.Edges.Webhooks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tq.withUsersTeams; query != nil {
 		if err := tq.loadUsersTeams(ctx, query, nodes,
 			func(n *Team) { n.Edges.UsersTeams = []*UsersTeams{} },
@@ -751,6 +845,66 @@ func (tq *TeamQuery) loadEnvs(ctx context.Context, query *EnvQuery, nodes []*Tea
 	}
 	return nil
 }
+func (tq *TeamQuery) loadWarmPools(ctx context.Context, query *WarmPoolQuery, nodes []*Team, init func(*Team), assign func(*Team, *WarmPool)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Team)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(warmpool.FieldTeamID)
+	}
+	query.Where(predicate.WarmPool(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(team.WarmPoolsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TeamQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*Team, init func(*Team), assign func(*Team, *Webhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Team)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhook.FieldTeamID)
+	}
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(team.WebhooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeamID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (tq *TeamQuery) loadUsersTeams(ctx context.Context, query *UsersTeamsQuery, nodes []*Team, init func(*Team), assign func(*Team, *UsersTeams)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Team)
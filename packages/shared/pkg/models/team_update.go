@@ -18,6 +18,8 @@ import (
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/tier"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/user"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/usersteams"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhook"
 	"github.com/google/uuid"
 )
 
@@ -213,6 +215,36 @@ func (tu *TeamUpdate) AddEnvs(e ...*Env) *TeamUpdate {
 	return tu.AddEnvIDs(ids...)
 }
 
+// AddWarmPoolIDs adds the "warm_pools" edge to the WarmPool entity by IDs.
+func (tu *TeamUpdate) AddWarmPoolIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.AddWarmPoolIDs(ids...)
+	return tu
+}
+
+// AddWarmPools adds the "warm_pools" edges to the WarmPool entity.
+func (tu *TeamUpdate) AddWarmPools(w ...*WarmPool) *TeamUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.AddWarmPoolIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (tu *TeamUpdate) AddWebhookIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.AddWebhookIDs(ids...)
+	return tu
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (tu *TeamUpdate) AddWebhooks(w ...*Webhook) *TeamUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.AddWebhookIDs(ids...)
+}
+
 // AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by IDs.
 func (tu *TeamUpdate) AddUsersTeamIDs(ids ...int) *TeamUpdate {
 	tu.mutation.AddUsersTeamIDs(ids...)
@@ -302,6 +334,48 @@ func (tu *TeamUpdate) RemoveEnvs(e ...*Env) *TeamUpdate {
 	return tu.RemoveEnvIDs(ids...)
 }
 
+// ClearWarmPools clears all "warm_pools" edges to the WarmPool entity.
+func (tu *TeamUpdate) ClearWarmPools() *TeamUpdate {
+	tu.mutation.ClearWarmPools()
+	return tu
+}
+
+// RemoveWarmPoolIDs removes the "warm_pools" edge to WarmPool entities by IDs.
+func (tu *TeamUpdate) RemoveWarmPoolIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.RemoveWarmPoolIDs(ids...)
+	return tu
+}
+
+// RemoveWarmPools removes "warm_pools" edges to WarmPool entities.
+func (tu *TeamUpdate) RemoveWarmPools(w ...*WarmPool) *TeamUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.RemoveWarmPoolIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (tu *TeamUpdate) ClearWebhooks() *TeamUpdate {
+	tu.mutation.ClearWebhooks()
+	return tu
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (tu *TeamUpdate) RemoveWebhookIDs(ids ...uuid.UUID) *TeamUpdate {
+	tu.mutation.RemoveWebhookIDs(ids...)
+	return tu
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (tu *TeamUpdate) RemoveWebhooks(w ...*Webhook) *TeamUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.RemoveWebhookIDs(ids...)
+}
+
 // ClearUsersTeams clears all "users_teams" edges to the UsersTeams entity.
 func (tu *TeamUpdate) ClearUsersTeams() *TeamUpdate {
 	tu.mutation.ClearUsersTeams()
@@ -598,6 +672,102 @@ func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.WarmPoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WarmPoolsTable,
+			Columns: []string{team.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.WarmPool
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWarmPoolsIDs(); len(nodes) > 0 && !tu.mutation.WarmPoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WarmPoolsTable,
+			Columns: []string{team.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WarmPoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WarmPoolsTable,
+			Columns: []string{team.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.Webhook
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !tu.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tu.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tu.mutation.UsersTeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -848,6 +1018,36 @@ func (tuo *TeamUpdateOne) AddEnvs(e ...*Env) *TeamUpdateOne {
 	return tuo.AddEnvIDs(ids...)
 }
 
+// AddWarmPoolIDs adds the "warm_pools" edge to the WarmPool entity by IDs.
+func (tuo *TeamUpdateOne) AddWarmPoolIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.AddWarmPoolIDs(ids...)
+	return tuo
+}
+
+// AddWarmPools adds the "warm_pools" edges to the WarmPool entity.
+func (tuo *TeamUpdateOne) AddWarmPools(w ...*WarmPool) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.AddWarmPoolIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (tuo *TeamUpdateOne) AddWebhookIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.AddWebhookIDs(ids...)
+	return tuo
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (tuo *TeamUpdateOne) AddWebhooks(w ...*Webhook) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.AddWebhookIDs(ids...)
+}
+
 // AddUsersTeamIDs adds the "users_teams" edge to the UsersTeams entity by IDs.
 func (tuo *TeamUpdateOne) AddUsersTeamIDs(ids ...int) *TeamUpdateOne {
 	tuo.mutation.AddUsersTeamIDs(ids...)
@@ -937,6 +1137,48 @@ func (tuo *TeamUpdateOne) RemoveEnvs(e ...*Env) *TeamUpdateOne {
 	return tuo.RemoveEnvIDs(ids...)
 }
 
+// ClearWarmPools clears all "warm_pools" edges to the WarmPool entity.
+func (tuo *TeamUpdateOne) ClearWarmPools() *TeamUpdateOne {
+	tuo.mutation.ClearWarmPools()
+	return tuo
+}
+
+// RemoveWarmPoolIDs removes the "warm_pools" edge to WarmPool entities by IDs.
+func (tuo *TeamUpdateOne) RemoveWarmPoolIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.RemoveWarmPoolIDs(ids...)
+	return tuo
+}
+
+// RemoveWarmPools removes "warm_pools" edges to WarmPool entities.
+func (tuo *TeamUpdateOne) RemoveWarmPools(w ...*WarmPool) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.RemoveWarmPoolIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (tuo *TeamUpdateOne) ClearWebhooks() *TeamUpdateOne {
+	tuo.mutation.ClearWebhooks()
+	return tuo
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (tuo *TeamUpdateOne) RemoveWebhookIDs(ids ...uuid.UUID) *TeamUpdateOne {
+	tuo.mutation.RemoveWebhookIDs(ids...)
+	return tuo
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (tuo *TeamUpdateOne) RemoveWebhooks(w ...*Webhook) *TeamUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.RemoveWebhookIDs(ids...)
+}
+
 // ClearUsersTeams clears all "users_teams" edges to the UsersTeams entity.
 func (tuo *TeamUpdateOne) ClearUsersTeams() *TeamUpdateOne {
 	tuo.mutation.ClearUsersTeams()
@@ -1263,6 +1505,102 @@ func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.WarmPoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WarmPoolsTable,
+			Columns: []string{team.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.WarmPool
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedWarmPoolsIDs(); len(nodes) > 0 && !tuo.mutation.WarmPoolsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WarmPoolsTable,
+			Columns: []string{team.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.WarmPoolsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WarmPoolsTable,
+			Columns: []string{team.WarmPoolsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.Webhook
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !tuo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.WebhooksTable,
+			Columns: []string{team.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tuo.mutation.UsersTeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
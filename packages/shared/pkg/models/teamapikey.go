@@ -3,6 +3,7 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -44,6 +45,12 @@ type TeamAPIKey struct {
 	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
 	// LastUsed holds the value of the "last_used" field.
 	LastUsed *time.Time `json:"last_used,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// AllowedTemplates holds the value of the "allowed_templates" field.
+	AllowedTemplates []string `json:"allowed_templates,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TeamAPIKeyQuery when eager-loading is set.
 	Edges        TeamAPIKeyEdges `json:"edges"`
@@ -94,11 +101,13 @@ func (*TeamAPIKey) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case teamapikey.FieldCreatedBy:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case teamapikey.FieldScopes, teamapikey.FieldAllowedTemplates:
+			values[i] = new([]byte)
 		case teamapikey.FieldAPIKeyLength:
 			values[i] = new(sql.NullInt64)
 		case teamapikey.FieldAPIKey, teamapikey.FieldAPIKeyHash, teamapikey.FieldAPIKeyPrefix, teamapikey.FieldAPIKeyMaskPrefix, teamapikey.FieldAPIKeyMaskSuffix, teamapikey.FieldName:
 			values[i] = new(sql.NullString)
-		case teamapikey.FieldCreatedAt, teamapikey.FieldUpdatedAt, teamapikey.FieldLastUsed:
+		case teamapikey.FieldCreatedAt, teamapikey.FieldUpdatedAt, teamapikey.FieldLastUsed, teamapikey.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		case teamapikey.FieldID, teamapikey.FieldTeamID:
 			values[i] = new(uuid.UUID)
@@ -198,6 +207,29 @@ func (tak *TeamAPIKey) assignValues(columns []string, values []any) error {
 				tak.LastUsed = new(time.Time)
 				*tak.LastUsed = value.Time
 			}
+		case teamapikey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				tak.ExpiresAt = new(time.Time)
+				*tak.ExpiresAt = value.Time
+			}
+		case teamapikey.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tak.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case teamapikey.FieldAllowedTemplates:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_templates", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tak.AllowedTemplates); err != nil {
+					return fmt.Errorf("unmarshal field allowed_templates: %w", err)
+				}
+			}
 		default:
 			tak.selectValues.Set(columns[i], values[i])
 		}
@@ -283,6 +315,17 @@ func (tak *TeamAPIKey) String() string {
 		builder.WriteString("last_used=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := tak.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", tak.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_templates=")
+	builder.WriteString(fmt.Sprintf("%v", tak.AllowedTemplates))
 	builder.WriteByte(')')
 	return builder.String()
 }
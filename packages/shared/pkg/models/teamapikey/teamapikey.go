@@ -38,6 +38,12 @@ const (
 	FieldCreatedBy = "created_by"
 	// FieldLastUsed holds the string denoting the last_used field in the database.
 	FieldLastUsed = "last_used"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldAllowedTemplates holds the string denoting the allowed_templates field in the database.
+	FieldAllowedTemplates = "allowed_templates"
 	// EdgeTeam holds the string denoting the team edge name in mutations.
 	EdgeTeam = "team"
 	// EdgeCreator holds the string denoting the creator edge name in mutations.
@@ -75,6 +81,9 @@ var Columns = []string{
 	FieldName,
 	FieldCreatedBy,
 	FieldLastUsed,
+	FieldExpiresAt,
+	FieldScopes,
+	FieldAllowedTemplates,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -162,6 +171,11 @@ func ByLastUsed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastUsed, opts...).ToFunc()
 }
 
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
 // ByTeamField orders the results by team field.
 func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
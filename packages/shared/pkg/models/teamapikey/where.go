@@ -117,6 +117,11 @@ func LastUsed(v time.Time) predicate.TeamAPIKey {
 	return predicate.TeamAPIKey(sql.FieldEQ(FieldLastUsed, v))
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldEQ(FieldExpiresAt, v))
+}
+
 // APIKeyEQ applies the EQ predicate on the "api_key" field.
 func APIKeyEQ(v string) predicate.TeamAPIKey {
 	return predicate.TeamAPIKey(sql.FieldEQ(FieldAPIKey, v))
@@ -737,6 +742,76 @@ func LastUsedNotNil() predicate.TeamAPIKey {
 	return predicate.TeamAPIKey(sql.FieldNotNull(FieldLastUsed))
 }
 
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldNotNull(FieldScopes))
+}
+
+// AllowedTemplatesIsNil applies the IsNil predicate on the "allowed_templates" field.
+func AllowedTemplatesIsNil() predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldIsNull(FieldAllowedTemplates))
+}
+
+// AllowedTemplatesNotNil applies the NotNil predicate on the "allowed_templates" field.
+func AllowedTemplatesNotNil() predicate.TeamAPIKey {
+	return predicate.TeamAPIKey(sql.FieldNotNull(FieldAllowedTemplates))
+}
+
 // HasTeam applies the HasEdge predicate on the "team" edge.
 func HasTeam() predicate.TeamAPIKey {
 	return predicate.TeamAPIKey(func(s *sql.Selector) {
@@ -138,6 +138,32 @@ func (takc *TeamAPIKeyCreate) SetNillableLastUsed(t *time.Time) *TeamAPIKeyCreat
 	return takc
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (takc *TeamAPIKeyCreate) SetExpiresAt(t time.Time) *TeamAPIKeyCreate {
+	takc.mutation.SetExpiresAt(t)
+	return takc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (takc *TeamAPIKeyCreate) SetNillableExpiresAt(t *time.Time) *TeamAPIKeyCreate {
+	if t != nil {
+		takc.SetExpiresAt(*t)
+	}
+	return takc
+}
+
+// SetScopes sets the "scopes" field.
+func (takc *TeamAPIKeyCreate) SetScopes(s []string) *TeamAPIKeyCreate {
+	takc.mutation.SetScopes(s)
+	return takc
+}
+
+// SetAllowedTemplates sets the "allowed_templates" field.
+func (takc *TeamAPIKeyCreate) SetAllowedTemplates(s []string) *TeamAPIKeyCreate {
+	takc.mutation.SetAllowedTemplates(s)
+	return takc
+}
+
 // SetID sets the "id" field.
 func (takc *TeamAPIKeyCreate) SetID(u uuid.UUID) *TeamAPIKeyCreate {
 	takc.mutation.SetID(u)
@@ -322,6 +348,18 @@ func (takc *TeamAPIKeyCreate) createSpec() (*TeamAPIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(teamapikey.FieldLastUsed, field.TypeTime, value)
 		_node.LastUsed = &value
 	}
+	if value, ok := takc.mutation.ExpiresAt(); ok {
+		_spec.SetField(teamapikey.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := takc.mutation.Scopes(); ok {
+		_spec.SetField(teamapikey.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := takc.mutation.AllowedTemplates(); ok {
+		_spec.SetField(teamapikey.FieldAllowedTemplates, field.TypeJSON, value)
+		_node.AllowedTemplates = value
+	}
 	if nodes := takc.mutation.TeamIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -512,6 +550,60 @@ func (u *TeamAPIKeyUpsert) ClearLastUsed() *TeamAPIKeyUpsert {
 	return u
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *TeamAPIKeyUpsert) SetExpiresAt(v time.Time) *TeamAPIKeyUpsert {
+	u.Set(teamapikey.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsert) UpdateExpiresAt() *TeamAPIKeyUpsert {
+	u.SetExcluded(teamapikey.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *TeamAPIKeyUpsert) ClearExpiresAt() *TeamAPIKeyUpsert {
+	u.SetNull(teamapikey.FieldExpiresAt)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *TeamAPIKeyUpsert) SetScopes(v []string) *TeamAPIKeyUpsert {
+	u.Set(teamapikey.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsert) UpdateScopes() *TeamAPIKeyUpsert {
+	u.SetExcluded(teamapikey.FieldScopes)
+	return u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TeamAPIKeyUpsert) ClearScopes() *TeamAPIKeyUpsert {
+	u.SetNull(teamapikey.FieldScopes)
+	return u
+}
+
+// SetAllowedTemplates sets the "allowed_templates" field.
+func (u *TeamAPIKeyUpsert) SetAllowedTemplates(v []string) *TeamAPIKeyUpsert {
+	u.Set(teamapikey.FieldAllowedTemplates, v)
+	return u
+}
+
+// UpdateAllowedTemplates sets the "allowed_templates" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsert) UpdateAllowedTemplates() *TeamAPIKeyUpsert {
+	u.SetExcluded(teamapikey.FieldAllowedTemplates)
+	return u
+}
+
+// ClearAllowedTemplates clears the value of the "allowed_templates" field.
+func (u *TeamAPIKeyUpsert) ClearAllowedTemplates() *TeamAPIKeyUpsert {
+	u.SetNull(teamapikey.FieldAllowedTemplates)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -694,6 +786,69 @@ func (u *TeamAPIKeyUpsertOne) ClearLastUsed() *TeamAPIKeyUpsertOne {
 	})
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *TeamAPIKeyUpsertOne) SetExpiresAt(v time.Time) *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertOne) UpdateExpiresAt() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *TeamAPIKeyUpsertOne) ClearExpiresAt() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *TeamAPIKeyUpsertOne) SetScopes(v []string) *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertOne) UpdateScopes() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TeamAPIKeyUpsertOne) ClearScopes() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetAllowedTemplates sets the "allowed_templates" field.
+func (u *TeamAPIKeyUpsertOne) SetAllowedTemplates(v []string) *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetAllowedTemplates(v)
+	})
+}
+
+// UpdateAllowedTemplates sets the "allowed_templates" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertOne) UpdateAllowedTemplates() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateAllowedTemplates()
+	})
+}
+
+// ClearAllowedTemplates clears the value of the "allowed_templates" field.
+func (u *TeamAPIKeyUpsertOne) ClearAllowedTemplates() *TeamAPIKeyUpsertOne {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearAllowedTemplates()
+	})
+}
+
 // Exec executes the query.
 func (u *TeamAPIKeyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1043,6 +1198,69 @@ func (u *TeamAPIKeyUpsertBulk) ClearLastUsed() *TeamAPIKeyUpsertBulk {
 	})
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (u *TeamAPIKeyUpsertBulk) SetExpiresAt(v time.Time) *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertBulk) UpdateExpiresAt() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *TeamAPIKeyUpsertBulk) ClearExpiresAt() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *TeamAPIKeyUpsertBulk) SetScopes(v []string) *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertBulk) UpdateScopes() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TeamAPIKeyUpsertBulk) ClearScopes() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// SetAllowedTemplates sets the "allowed_templates" field.
+func (u *TeamAPIKeyUpsertBulk) SetAllowedTemplates(v []string) *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.SetAllowedTemplates(v)
+	})
+}
+
+// UpdateAllowedTemplates sets the "allowed_templates" field to the value that was provided on create.
+func (u *TeamAPIKeyUpsertBulk) UpdateAllowedTemplates() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.UpdateAllowedTemplates()
+	})
+}
+
+// ClearAllowedTemplates clears the value of the "allowed_templates" field.
+func (u *TeamAPIKeyUpsertBulk) ClearAllowedTemplates() *TeamAPIKeyUpsertBulk {
+	return u.Update(func(s *TeamAPIKeyUpsert) {
+		s.ClearAllowedTemplates()
+	})
+}
+
 // Exec executes the query.
 func (u *TeamAPIKeyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
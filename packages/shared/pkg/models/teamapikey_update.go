@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
 	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
@@ -149,6 +150,62 @@ func (taku *TeamAPIKeyUpdate) ClearLastUsed() *TeamAPIKeyUpdate {
 	return taku
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (taku *TeamAPIKeyUpdate) SetExpiresAt(t time.Time) *TeamAPIKeyUpdate {
+	taku.mutation.SetExpiresAt(t)
+	return taku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (taku *TeamAPIKeyUpdate) SetNillableExpiresAt(t *time.Time) *TeamAPIKeyUpdate {
+	if t != nil {
+		taku.SetExpiresAt(*t)
+	}
+	return taku
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (taku *TeamAPIKeyUpdate) ClearExpiresAt() *TeamAPIKeyUpdate {
+	taku.mutation.ClearExpiresAt()
+	return taku
+}
+
+// SetScopes sets the "scopes" field.
+func (taku *TeamAPIKeyUpdate) SetScopes(s []string) *TeamAPIKeyUpdate {
+	taku.mutation.SetScopes(s)
+	return taku
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (taku *TeamAPIKeyUpdate) AppendScopes(s []string) *TeamAPIKeyUpdate {
+	taku.mutation.AppendScopes(s)
+	return taku
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (taku *TeamAPIKeyUpdate) ClearScopes() *TeamAPIKeyUpdate {
+	taku.mutation.ClearScopes()
+	return taku
+}
+
+// SetAllowedTemplates sets the "allowed_templates" field.
+func (taku *TeamAPIKeyUpdate) SetAllowedTemplates(s []string) *TeamAPIKeyUpdate {
+	taku.mutation.SetAllowedTemplates(s)
+	return taku
+}
+
+// AppendAllowedTemplates appends s to the "allowed_templates" field.
+func (taku *TeamAPIKeyUpdate) AppendAllowedTemplates(s []string) *TeamAPIKeyUpdate {
+	taku.mutation.AppendAllowedTemplates(s)
+	return taku
+}
+
+// ClearAllowedTemplates clears the value of the "allowed_templates" field.
+func (taku *TeamAPIKeyUpdate) ClearAllowedTemplates() *TeamAPIKeyUpdate {
+	taku.mutation.ClearAllowedTemplates()
+	return taku
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (taku *TeamAPIKeyUpdate) SetTeam(t *Team) *TeamAPIKeyUpdate {
 	return taku.SetTeamID(t.ID)
@@ -264,6 +321,34 @@ func (taku *TeamAPIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if taku.mutation.LastUsedCleared() {
 		_spec.ClearField(teamapikey.FieldLastUsed, field.TypeTime)
 	}
+	if value, ok := taku.mutation.ExpiresAt(); ok {
+		_spec.SetField(teamapikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if taku.mutation.ExpiresAtCleared() {
+		_spec.ClearField(teamapikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := taku.mutation.Scopes(); ok {
+		_spec.SetField(teamapikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := taku.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamapikey.FieldScopes, value)
+		})
+	}
+	if taku.mutation.ScopesCleared() {
+		_spec.ClearField(teamapikey.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := taku.mutation.AllowedTemplates(); ok {
+		_spec.SetField(teamapikey.FieldAllowedTemplates, field.TypeJSON, value)
+	}
+	if value, ok := taku.mutation.AppendedAllowedTemplates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamapikey.FieldAllowedTemplates, value)
+		})
+	}
+	if taku.mutation.AllowedTemplatesCleared() {
+		_spec.ClearField(teamapikey.FieldAllowedTemplates, field.TypeJSON)
+	}
 	if taku.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -466,6 +551,62 @@ func (takuo *TeamAPIKeyUpdateOne) ClearLastUsed() *TeamAPIKeyUpdateOne {
 	return takuo
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (takuo *TeamAPIKeyUpdateOne) SetExpiresAt(t time.Time) *TeamAPIKeyUpdateOne {
+	takuo.mutation.SetExpiresAt(t)
+	return takuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (takuo *TeamAPIKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *TeamAPIKeyUpdateOne {
+	if t != nil {
+		takuo.SetExpiresAt(*t)
+	}
+	return takuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (takuo *TeamAPIKeyUpdateOne) ClearExpiresAt() *TeamAPIKeyUpdateOne {
+	takuo.mutation.ClearExpiresAt()
+	return takuo
+}
+
+// SetScopes sets the "scopes" field.
+func (takuo *TeamAPIKeyUpdateOne) SetScopes(s []string) *TeamAPIKeyUpdateOne {
+	takuo.mutation.SetScopes(s)
+	return takuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (takuo *TeamAPIKeyUpdateOne) AppendScopes(s []string) *TeamAPIKeyUpdateOne {
+	takuo.mutation.AppendScopes(s)
+	return takuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (takuo *TeamAPIKeyUpdateOne) ClearScopes() *TeamAPIKeyUpdateOne {
+	takuo.mutation.ClearScopes()
+	return takuo
+}
+
+// SetAllowedTemplates sets the "allowed_templates" field.
+func (takuo *TeamAPIKeyUpdateOne) SetAllowedTemplates(s []string) *TeamAPIKeyUpdateOne {
+	takuo.mutation.SetAllowedTemplates(s)
+	return takuo
+}
+
+// AppendAllowedTemplates appends s to the "allowed_templates" field.
+func (takuo *TeamAPIKeyUpdateOne) AppendAllowedTemplates(s []string) *TeamAPIKeyUpdateOne {
+	takuo.mutation.AppendAllowedTemplates(s)
+	return takuo
+}
+
+// ClearAllowedTemplates clears the value of the "allowed_templates" field.
+func (takuo *TeamAPIKeyUpdateOne) ClearAllowedTemplates() *TeamAPIKeyUpdateOne {
+	takuo.mutation.ClearAllowedTemplates()
+	return takuo
+}
+
 // SetTeam sets the "team" edge to the Team entity.
 func (takuo *TeamAPIKeyUpdateOne) SetTeam(t *Team) *TeamAPIKeyUpdateOne {
 	return takuo.SetTeamID(t.ID)
@@ -611,6 +752,34 @@ func (takuo *TeamAPIKeyUpdateOne) sqlSave(ctx context.Context) (_node *TeamAPIKe
 	if takuo.mutation.LastUsedCleared() {
 		_spec.ClearField(teamapikey.FieldLastUsed, field.TypeTime)
 	}
+	if value, ok := takuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(teamapikey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if takuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(teamapikey.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := takuo.mutation.Scopes(); ok {
+		_spec.SetField(teamapikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := takuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamapikey.FieldScopes, value)
+		})
+	}
+	if takuo.mutation.ScopesCleared() {
+		_spec.ClearField(teamapikey.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := takuo.mutation.AllowedTemplates(); ok {
+		_spec.SetField(teamapikey.FieldAllowedTemplates, field.TypeJSON, value)
+	}
+	if value, ok := takuo.mutation.AppendedAllowedTemplates(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, teamapikey.FieldAllowedTemplates, value)
+		})
+	}
+	if takuo.mutation.AllowedTemplatesCleared() {
+		_spec.ClearField(teamapikey.FieldAllowedTemplates, field.TypeJSON)
+	}
 	if takuo.mutation.TeamCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
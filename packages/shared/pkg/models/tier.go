@@ -24,6 +24,36 @@ type Tier struct {
 	ConcurrentInstances int64 `json:"concurrent_instances,omitempty"`
 	// MaxLengthHours holds the value of the "max_length_hours" field.
 	MaxLengthHours int64 `json:"max_length_hours,omitempty"`
+	// The maximum number of vCPUs of the templates and sandboxes
+	MaxVcpu int64 `json:"max_vcpu,omitempty"`
+	// The maximum memory in MiB of the templates and sandboxes
+	MaxRAMMB int64 `json:"max_ram_mb,omitempty"`
+	// The number of vCPUs the running sandboxes of the team can use in total, 0 means unlimited
+	ConcurrentVcpus int64 `json:"concurrent_vcpus,omitempty"`
+	// The memory in MiB the running sandboxes of the team can use in total, 0 means unlimited
+	ConcurrentRAMMB int64 `json:"concurrent_ram_mb,omitempty"`
+	// The storage in MiB the paused sandbox snapshots of the team can use in total, 0 means unlimited
+	PausedStorageMB int64 `json:"paused_storage_mb,omitempty"`
+	// How long the paused sandboxes of the team are kept before they are deleted, 0 means forever
+	PausedRetentionHours int64 `json:"paused_retention_hours,omitempty"`
+	// The number of checkpoints kept per sandbox
+	MaxCheckpoints int64 `json:"max_checkpoints,omitempty"`
+	// Default network bandwidth limit of the sandboxes in MiB/s, 0 means unlimited
+	NetworkBandwidthMibps int64 `json:"network_bandwidth_mibps,omitempty"`
+	// Default network packets per second limit of the sandboxes, 0 means unlimited
+	NetworkOps int64 `json:"network_ops,omitempty"`
+	// Default disk bandwidth limit of the sandboxes in MiB/s, 0 means unlimited
+	DiskBandwidthMibps int64 `json:"disk_bandwidth_mibps,omitempty"`
+	// Default disk operations per second limit of the sandboxes, 0 means unlimited
+	DiskIops int64 `json:"disk_iops,omitempty"`
+	// Number of API requests per second the team can make to each endpoint, 0 means unlimited
+	APIRequestsPerSecond int64 `json:"api_requests_per_second,omitempty"`
+	// Number of API requests the team can make to each endpoint in a burst
+	APIRequestsBurst int64 `json:"api_requests_burst,omitempty"`
+	// The number of template builds the team can run concurrently, 0 means unlimited
+	ConcurrentTemplateBuilds int64 `json:"concurrent_template_builds,omitempty"`
+	// Priority of the template builds of the team in the build queue
+	BuildPriority int64 `json:"build_priority,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TierQuery when eager-loading is set.
 	Edges        TierEdges `json:"edges"`
@@ -53,7 +83,7 @@ func (*Tier) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tier.FieldDiskMB, tier.FieldConcurrentInstances, tier.FieldMaxLengthHours:
+		case tier.FieldDiskMB, tier.FieldConcurrentInstances, tier.FieldMaxLengthHours, tier.FieldMaxVcpu, tier.FieldMaxRAMMB, tier.FieldConcurrentVcpus, tier.FieldConcurrentRAMMB, tier.FieldPausedStorageMB, tier.FieldPausedRetentionHours, tier.FieldMaxCheckpoints, tier.FieldNetworkBandwidthMibps, tier.FieldNetworkOps, tier.FieldDiskBandwidthMibps, tier.FieldDiskIops, tier.FieldAPIRequestsPerSecond, tier.FieldAPIRequestsBurst, tier.FieldConcurrentTemplateBuilds, tier.FieldBuildPriority:
 			values[i] = new(sql.NullInt64)
 		case tier.FieldID, tier.FieldName:
 			values[i] = new(sql.NullString)
@@ -102,6 +132,96 @@ func (t *Tier) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.MaxLengthHours = value.Int64
 			}
+		case tier.FieldMaxVcpu:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_vcpu", values[i])
+			} else if value.Valid {
+				t.MaxVcpu = value.Int64
+			}
+		case tier.FieldMaxRAMMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_ram_mb", values[i])
+			} else if value.Valid {
+				t.MaxRAMMB = value.Int64
+			}
+		case tier.FieldConcurrentVcpus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field concurrent_vcpus", values[i])
+			} else if value.Valid {
+				t.ConcurrentVcpus = value.Int64
+			}
+		case tier.FieldConcurrentRAMMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field concurrent_ram_mb", values[i])
+			} else if value.Valid {
+				t.ConcurrentRAMMB = value.Int64
+			}
+		case tier.FieldPausedStorageMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_storage_mb", values[i])
+			} else if value.Valid {
+				t.PausedStorageMB = value.Int64
+			}
+		case tier.FieldPausedRetentionHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_retention_hours", values[i])
+			} else if value.Valid {
+				t.PausedRetentionHours = value.Int64
+			}
+		case tier.FieldMaxCheckpoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_checkpoints", values[i])
+			} else if value.Valid {
+				t.MaxCheckpoints = value.Int64
+			}
+		case tier.FieldNetworkBandwidthMibps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field network_bandwidth_mibps", values[i])
+			} else if value.Valid {
+				t.NetworkBandwidthMibps = value.Int64
+			}
+		case tier.FieldNetworkOps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field network_ops", values[i])
+			} else if value.Valid {
+				t.NetworkOps = value.Int64
+			}
+		case tier.FieldDiskBandwidthMibps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disk_bandwidth_mibps", values[i])
+			} else if value.Valid {
+				t.DiskBandwidthMibps = value.Int64
+			}
+		case tier.FieldDiskIops:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disk_iops", values[i])
+			} else if value.Valid {
+				t.DiskIops = value.Int64
+			}
+		case tier.FieldAPIRequestsPerSecond:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field api_requests_per_second", values[i])
+			} else if value.Valid {
+				t.APIRequestsPerSecond = value.Int64
+			}
+		case tier.FieldAPIRequestsBurst:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field api_requests_burst", values[i])
+			} else if value.Valid {
+				t.APIRequestsBurst = value.Int64
+			}
+		case tier.FieldConcurrentTemplateBuilds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field concurrent_template_builds", values[i])
+			} else if value.Valid {
+				t.ConcurrentTemplateBuilds = value.Int64
+			}
+		case tier.FieldBuildPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field build_priority", values[i])
+			} else if value.Valid {
+				t.BuildPriority = value.Int64
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -154,6 +274,51 @@ func (t *Tier) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("max_length_hours=")
 	builder.WriteString(fmt.Sprintf("%v", t.MaxLengthHours))
+	builder.WriteString(", ")
+	builder.WriteString("max_vcpu=")
+	builder.WriteString(fmt.Sprintf("%v", t.MaxVcpu))
+	builder.WriteString(", ")
+	builder.WriteString("max_ram_mb=")
+	builder.WriteString(fmt.Sprintf("%v", t.MaxRAMMB))
+	builder.WriteString(", ")
+	builder.WriteString("concurrent_vcpus=")
+	builder.WriteString(fmt.Sprintf("%v", t.ConcurrentVcpus))
+	builder.WriteString(", ")
+	builder.WriteString("concurrent_ram_mb=")
+	builder.WriteString(fmt.Sprintf("%v", t.ConcurrentRAMMB))
+	builder.WriteString(", ")
+	builder.WriteString("paused_storage_mb=")
+	builder.WriteString(fmt.Sprintf("%v", t.PausedStorageMB))
+	builder.WriteString(", ")
+	builder.WriteString("paused_retention_hours=")
+	builder.WriteString(fmt.Sprintf("%v", t.PausedRetentionHours))
+	builder.WriteString(", ")
+	builder.WriteString("max_checkpoints=")
+	builder.WriteString(fmt.Sprintf("%v", t.MaxCheckpoints))
+	builder.WriteString(", ")
+	builder.WriteString("network_bandwidth_mibps=")
+	builder.WriteString(fmt.Sprintf("%v", t.NetworkBandwidthMibps))
+	builder.WriteString(", ")
+	builder.WriteString("network_ops=")
+	builder.WriteString(fmt.Sprintf("%v", t.NetworkOps))
+	builder.WriteString(", ")
+	builder.WriteString("disk_bandwidth_mibps=")
+	builder.WriteString(fmt.Sprintf("%v", t.DiskBandwidthMibps))
+	builder.WriteString(", ")
+	builder.WriteString("disk_iops=")
+	builder.WriteString(fmt.Sprintf("%v", t.DiskIops))
+	builder.WriteString(", ")
+	builder.WriteString("api_requests_per_second=")
+	builder.WriteString(fmt.Sprintf("%v", t.APIRequestsPerSecond))
+	builder.WriteString(", ")
+	builder.WriteString("api_requests_burst=")
+	builder.WriteString(fmt.Sprintf("%v", t.APIRequestsBurst))
+	builder.WriteString(", ")
+	builder.WriteString("concurrent_template_builds=")
+	builder.WriteString(fmt.Sprintf("%v", t.ConcurrentTemplateBuilds))
+	builder.WriteString(", ")
+	builder.WriteString("build_priority=")
+	builder.WriteString(fmt.Sprintf("%v", t.BuildPriority))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -20,6 +20,36 @@ const (
 	FieldConcurrentInstances = "concurrent_instances"
 	// FieldMaxLengthHours holds the string denoting the max_length_hours field in the database.
 	FieldMaxLengthHours = "max_length_hours"
+	// FieldMaxVcpu holds the string denoting the max_vcpu field in the database.
+	FieldMaxVcpu = "max_vcpu"
+	// FieldMaxRAMMB holds the string denoting the max_ram_mb field in the database.
+	FieldMaxRAMMB = "max_ram_mb"
+	// FieldConcurrentVcpus holds the string denoting the concurrent_vcpus field in the database.
+	FieldConcurrentVcpus = "concurrent_vcpus"
+	// FieldConcurrentRAMMB holds the string denoting the concurrent_ram_mb field in the database.
+	FieldConcurrentRAMMB = "concurrent_ram_mb"
+	// FieldPausedStorageMB holds the string denoting the paused_storage_mb field in the database.
+	FieldPausedStorageMB = "paused_storage_mb"
+	// FieldPausedRetentionHours holds the string denoting the paused_retention_hours field in the database.
+	FieldPausedRetentionHours = "paused_retention_hours"
+	// FieldMaxCheckpoints holds the string denoting the max_checkpoints field in the database.
+	FieldMaxCheckpoints = "max_checkpoints"
+	// FieldNetworkBandwidthMibps holds the string denoting the network_bandwidth_mibps field in the database.
+	FieldNetworkBandwidthMibps = "network_bandwidth_mibps"
+	// FieldNetworkOps holds the string denoting the network_ops field in the database.
+	FieldNetworkOps = "network_ops"
+	// FieldDiskBandwidthMibps holds the string denoting the disk_bandwidth_mibps field in the database.
+	FieldDiskBandwidthMibps = "disk_bandwidth_mibps"
+	// FieldDiskIops holds the string denoting the disk_iops field in the database.
+	FieldDiskIops = "disk_iops"
+	// FieldAPIRequestsPerSecond holds the string denoting the api_requests_per_second field in the database.
+	FieldAPIRequestsPerSecond = "api_requests_per_second"
+	// FieldAPIRequestsBurst holds the string denoting the api_requests_burst field in the database.
+	FieldAPIRequestsBurst = "api_requests_burst"
+	// FieldConcurrentTemplateBuilds holds the string denoting the concurrent_template_builds field in the database.
+	FieldConcurrentTemplateBuilds = "concurrent_template_builds"
+	// FieldBuildPriority holds the string denoting the build_priority field in the database.
+	FieldBuildPriority = "build_priority"
 	// EdgeTeams holds the string denoting the teams edge name in mutations.
 	EdgeTeams = "teams"
 	// Table holds the table name of the tier in the database.
@@ -40,6 +70,21 @@ var Columns = []string{
 	FieldDiskMB,
 	FieldConcurrentInstances,
 	FieldMaxLengthHours,
+	FieldMaxVcpu,
+	FieldMaxRAMMB,
+	FieldConcurrentVcpus,
+	FieldConcurrentRAMMB,
+	FieldPausedStorageMB,
+	FieldPausedRetentionHours,
+	FieldMaxCheckpoints,
+	FieldNetworkBandwidthMibps,
+	FieldNetworkOps,
+	FieldDiskBandwidthMibps,
+	FieldDiskIops,
+	FieldAPIRequestsPerSecond,
+	FieldAPIRequestsBurst,
+	FieldConcurrentTemplateBuilds,
+	FieldBuildPriority,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -52,6 +97,37 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultMaxVcpu holds the default value on creation for the "max_vcpu" field.
+	DefaultMaxVcpu int64
+	// DefaultMaxRAMMB holds the default value on creation for the "max_ram_mb" field.
+	DefaultMaxRAMMB int64
+	// DefaultConcurrentVcpus holds the default value on creation for the "concurrent_vcpus" field.
+	DefaultConcurrentVcpus int64
+	// DefaultConcurrentRAMMB holds the default value on creation for the "concurrent_ram_mb" field.
+	DefaultConcurrentRAMMB int64
+	// DefaultPausedStorageMB holds the default value on creation for the "paused_storage_mb" field.
+	DefaultPausedStorageMB int64
+	// DefaultPausedRetentionHours holds the default value on creation for the "paused_retention_hours" field.
+	DefaultPausedRetentionHours int64
+	// DefaultNetworkBandwidthMibps holds the default value on creation for the "network_bandwidth_mibps" field.
+	DefaultNetworkBandwidthMibps int64
+	// DefaultNetworkOps holds the default value on creation for the "network_ops" field.
+	DefaultNetworkOps int64
+	// DefaultDiskBandwidthMibps holds the default value on creation for the "disk_bandwidth_mibps" field.
+	DefaultDiskBandwidthMibps int64
+	// DefaultDiskIops holds the default value on creation for the "disk_iops" field.
+	DefaultDiskIops int64
+	// DefaultAPIRequestsPerSecond holds the default value on creation for the "api_requests_per_second" field.
+	DefaultAPIRequestsPerSecond int64
+	// DefaultAPIRequestsBurst holds the default value on creation for the "api_requests_burst" field.
+	DefaultAPIRequestsBurst int64
+	// DefaultConcurrentTemplateBuilds holds the default value on creation for the "concurrent_template_builds" field.
+	DefaultConcurrentTemplateBuilds int64
+	// DefaultBuildPriority holds the default value on creation for the "build_priority" field.
+	DefaultBuildPriority int64
+)
+
 // OrderOption defines the ordering options for the Tier queries.
 type OrderOption func(*sql.Selector)
 
@@ -80,6 +156,81 @@ func ByMaxLengthHours(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxLengthHours, opts...).ToFunc()
 }
 
+// ByMaxVcpu orders the results by the max_vcpu field.
+func ByMaxVcpu(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxVcpu, opts...).ToFunc()
+}
+
+// ByMaxRAMMB orders the results by the max_ram_mb field.
+func ByMaxRAMMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRAMMB, opts...).ToFunc()
+}
+
+// ByConcurrentVcpus orders the results by the concurrent_vcpus field.
+func ByConcurrentVcpus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConcurrentVcpus, opts...).ToFunc()
+}
+
+// ByConcurrentRAMMB orders the results by the concurrent_ram_mb field.
+func ByConcurrentRAMMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConcurrentRAMMB, opts...).ToFunc()
+}
+
+// ByPausedStorageMB orders the results by the paused_storage_mb field.
+func ByPausedStorageMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedStorageMB, opts...).ToFunc()
+}
+
+// ByPausedRetentionHours orders the results by the paused_retention_hours field.
+func ByPausedRetentionHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedRetentionHours, opts...).ToFunc()
+}
+
+// ByMaxCheckpoints orders the results by the max_checkpoints field.
+func ByMaxCheckpoints(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxCheckpoints, opts...).ToFunc()
+}
+
+// ByNetworkBandwidthMibps orders the results by the network_bandwidth_mibps field.
+func ByNetworkBandwidthMibps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkBandwidthMibps, opts...).ToFunc()
+}
+
+// ByNetworkOps orders the results by the network_ops field.
+func ByNetworkOps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkOps, opts...).ToFunc()
+}
+
+// ByDiskBandwidthMibps orders the results by the disk_bandwidth_mibps field.
+func ByDiskBandwidthMibps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiskBandwidthMibps, opts...).ToFunc()
+}
+
+// ByDiskIops orders the results by the disk_iops field.
+func ByDiskIops(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiskIops, opts...).ToFunc()
+}
+
+// ByAPIRequestsPerSecond orders the results by the api_requests_per_second field.
+func ByAPIRequestsPerSecond(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIRequestsPerSecond, opts...).ToFunc()
+}
+
+// ByAPIRequestsBurst orders the results by the api_requests_burst field.
+func ByAPIRequestsBurst(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIRequestsBurst, opts...).ToFunc()
+}
+
+// ByConcurrentTemplateBuilds orders the results by the concurrent_template_builds field.
+func ByConcurrentTemplateBuilds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConcurrentTemplateBuilds, opts...).ToFunc()
+}
+
+// ByBuildPriority orders the results by the build_priority field.
+func ByBuildPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildPriority, opts...).ToFunc()
+}
+
 // ByTeamsCount orders the results by teams count.
 func ByTeamsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
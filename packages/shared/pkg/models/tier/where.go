@@ -84,6 +84,81 @@ func MaxLengthHours(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldMaxLengthHours, v))
 }
 
+// MaxVcpu applies equality check predicate on the "max_vcpu" field. It's identical to MaxVcpuEQ.
+func MaxVcpu(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxVcpu, v))
+}
+
+// MaxRAMMB applies equality check predicate on the "max_ram_mb" field. It's identical to MaxRAMMBEQ.
+func MaxRAMMB(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxRAMMB, v))
+}
+
+// ConcurrentVcpus applies equality check predicate on the "concurrent_vcpus" field. It's identical to ConcurrentVcpusEQ.
+func ConcurrentVcpus(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldConcurrentVcpus, v))
+}
+
+// ConcurrentRAMMB applies equality check predicate on the "concurrent_ram_mb" field. It's identical to ConcurrentRAMMBEQ.
+func ConcurrentRAMMB(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldConcurrentRAMMB, v))
+}
+
+// PausedStorageMB applies equality check predicate on the "paused_storage_mb" field. It's identical to PausedStorageMBEQ.
+func PausedStorageMB(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldPausedStorageMB, v))
+}
+
+// PausedRetentionHours applies equality check predicate on the "paused_retention_hours" field. It's identical to PausedRetentionHoursEQ.
+func PausedRetentionHours(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldPausedRetentionHours, v))
+}
+
+// MaxCheckpoints applies equality check predicate on the "max_checkpoints" field. It's identical to MaxCheckpointsEQ.
+func MaxCheckpoints(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxCheckpoints, v))
+}
+
+// NetworkBandwidthMibps applies equality check predicate on the "network_bandwidth_mibps" field. It's identical to NetworkBandwidthMibpsEQ.
+func NetworkBandwidthMibps(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkOps applies equality check predicate on the "network_ops" field. It's identical to NetworkOpsEQ.
+func NetworkOps(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkOps, v))
+}
+
+// DiskBandwidthMibps applies equality check predicate on the "disk_bandwidth_mibps" field. It's identical to DiskBandwidthMibpsEQ.
+func DiskBandwidthMibps(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskBandwidthMibps, v))
+}
+
+// DiskIops applies equality check predicate on the "disk_iops" field. It's identical to DiskIopsEQ.
+func DiskIops(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskIops, v))
+}
+
+// APIRequestsPerSecond applies equality check predicate on the "api_requests_per_second" field. It's identical to APIRequestsPerSecondEQ.
+func APIRequestsPerSecond(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldAPIRequestsPerSecond, v))
+}
+
+// APIRequestsBurst applies equality check predicate on the "api_requests_burst" field. It's identical to APIRequestsBurstEQ.
+func APIRequestsBurst(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldAPIRequestsBurst, v))
+}
+
+// ConcurrentTemplateBuilds applies equality check predicate on the "concurrent_template_builds" field. It's identical to ConcurrentTemplateBuildsEQ.
+func ConcurrentTemplateBuilds(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldConcurrentTemplateBuilds, v))
+}
+
+// BuildPriority applies equality check predicate on the "build_priority" field. It's identical to BuildPriorityEQ.
+func BuildPriority(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldBuildPriority, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Tier {
 	return predicate.Tier(sql.FieldEQ(FieldName, v))
@@ -269,6 +344,606 @@ func MaxLengthHoursLTE(v int64) predicate.Tier {
 	return predicate.Tier(sql.FieldLTE(FieldMaxLengthHours, v))
 }
 
+// MaxVcpuEQ applies the EQ predicate on the "max_vcpu" field.
+func MaxVcpuEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxVcpu, v))
+}
+
+// MaxVcpuNEQ applies the NEQ predicate on the "max_vcpu" field.
+func MaxVcpuNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxVcpu, v))
+}
+
+// MaxVcpuIn applies the In predicate on the "max_vcpu" field.
+func MaxVcpuIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxVcpu, vs...))
+}
+
+// MaxVcpuNotIn applies the NotIn predicate on the "max_vcpu" field.
+func MaxVcpuNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxVcpu, vs...))
+}
+
+// MaxVcpuGT applies the GT predicate on the "max_vcpu" field.
+func MaxVcpuGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxVcpu, v))
+}
+
+// MaxVcpuGTE applies the GTE predicate on the "max_vcpu" field.
+func MaxVcpuGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxVcpu, v))
+}
+
+// MaxVcpuLT applies the LT predicate on the "max_vcpu" field.
+func MaxVcpuLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxVcpu, v))
+}
+
+// MaxVcpuLTE applies the LTE predicate on the "max_vcpu" field.
+func MaxVcpuLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxVcpu, v))
+}
+
+// MaxRAMMBEQ applies the EQ predicate on the "max_ram_mb" field.
+func MaxRAMMBEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBNEQ applies the NEQ predicate on the "max_ram_mb" field.
+func MaxRAMMBNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBIn applies the In predicate on the "max_ram_mb" field.
+func MaxRAMMBIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxRAMMB, vs...))
+}
+
+// MaxRAMMBNotIn applies the NotIn predicate on the "max_ram_mb" field.
+func MaxRAMMBNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxRAMMB, vs...))
+}
+
+// MaxRAMMBGT applies the GT predicate on the "max_ram_mb" field.
+func MaxRAMMBGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBGTE applies the GTE predicate on the "max_ram_mb" field.
+func MaxRAMMBGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBLT applies the LT predicate on the "max_ram_mb" field.
+func MaxRAMMBLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxRAMMB, v))
+}
+
+// MaxRAMMBLTE applies the LTE predicate on the "max_ram_mb" field.
+func MaxRAMMBLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxRAMMB, v))
+}
+
+// ConcurrentVcpusEQ applies the EQ predicate on the "concurrent_vcpus" field.
+func ConcurrentVcpusEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldConcurrentVcpus, v))
+}
+
+// ConcurrentVcpusNEQ applies the NEQ predicate on the "concurrent_vcpus" field.
+func ConcurrentVcpusNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldConcurrentVcpus, v))
+}
+
+// ConcurrentVcpusIn applies the In predicate on the "concurrent_vcpus" field.
+func ConcurrentVcpusIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldConcurrentVcpus, vs...))
+}
+
+// ConcurrentVcpusNotIn applies the NotIn predicate on the "concurrent_vcpus" field.
+func ConcurrentVcpusNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldConcurrentVcpus, vs...))
+}
+
+// ConcurrentVcpusGT applies the GT predicate on the "concurrent_vcpus" field.
+func ConcurrentVcpusGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldConcurrentVcpus, v))
+}
+
+// ConcurrentVcpusGTE applies the GTE predicate on the "concurrent_vcpus" field.
+func ConcurrentVcpusGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldConcurrentVcpus, v))
+}
+
+// ConcurrentVcpusLT applies the LT predicate on the "concurrent_vcpus" field.
+func ConcurrentVcpusLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldConcurrentVcpus, v))
+}
+
+// ConcurrentVcpusLTE applies the LTE predicate on the "concurrent_vcpus" field.
+func ConcurrentVcpusLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldConcurrentVcpus, v))
+}
+
+// ConcurrentRAMMBEQ applies the EQ predicate on the "concurrent_ram_mb" field.
+func ConcurrentRAMMBEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldConcurrentRAMMB, v))
+}
+
+// ConcurrentRAMMBNEQ applies the NEQ predicate on the "concurrent_ram_mb" field.
+func ConcurrentRAMMBNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldConcurrentRAMMB, v))
+}
+
+// ConcurrentRAMMBIn applies the In predicate on the "concurrent_ram_mb" field.
+func ConcurrentRAMMBIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldConcurrentRAMMB, vs...))
+}
+
+// ConcurrentRAMMBNotIn applies the NotIn predicate on the "concurrent_ram_mb" field.
+func ConcurrentRAMMBNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldConcurrentRAMMB, vs...))
+}
+
+// ConcurrentRAMMBGT applies the GT predicate on the "concurrent_ram_mb" field.
+func ConcurrentRAMMBGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldConcurrentRAMMB, v))
+}
+
+// ConcurrentRAMMBGTE applies the GTE predicate on the "concurrent_ram_mb" field.
+func ConcurrentRAMMBGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldConcurrentRAMMB, v))
+}
+
+// ConcurrentRAMMBLT applies the LT predicate on the "concurrent_ram_mb" field.
+func ConcurrentRAMMBLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldConcurrentRAMMB, v))
+}
+
+// ConcurrentRAMMBLTE applies the LTE predicate on the "concurrent_ram_mb" field.
+func ConcurrentRAMMBLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldConcurrentRAMMB, v))
+}
+
+// PausedStorageMBEQ applies the EQ predicate on the "paused_storage_mb" field.
+func PausedStorageMBEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldPausedStorageMB, v))
+}
+
+// PausedStorageMBNEQ applies the NEQ predicate on the "paused_storage_mb" field.
+func PausedStorageMBNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldPausedStorageMB, v))
+}
+
+// PausedStorageMBIn applies the In predicate on the "paused_storage_mb" field.
+func PausedStorageMBIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldPausedStorageMB, vs...))
+}
+
+// PausedStorageMBNotIn applies the NotIn predicate on the "paused_storage_mb" field.
+func PausedStorageMBNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldPausedStorageMB, vs...))
+}
+
+// PausedStorageMBGT applies the GT predicate on the "paused_storage_mb" field.
+func PausedStorageMBGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldPausedStorageMB, v))
+}
+
+// PausedStorageMBGTE applies the GTE predicate on the "paused_storage_mb" field.
+func PausedStorageMBGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldPausedStorageMB, v))
+}
+
+// PausedStorageMBLT applies the LT predicate on the "paused_storage_mb" field.
+func PausedStorageMBLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldPausedStorageMB, v))
+}
+
+// PausedStorageMBLTE applies the LTE predicate on the "paused_storage_mb" field.
+func PausedStorageMBLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldPausedStorageMB, v))
+}
+
+// PausedRetentionHoursEQ applies the EQ predicate on the "paused_retention_hours" field.
+func PausedRetentionHoursEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldPausedRetentionHours, v))
+}
+
+// PausedRetentionHoursNEQ applies the NEQ predicate on the "paused_retention_hours" field.
+func PausedRetentionHoursNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldPausedRetentionHours, v))
+}
+
+// PausedRetentionHoursIn applies the In predicate on the "paused_retention_hours" field.
+func PausedRetentionHoursIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldPausedRetentionHours, vs...))
+}
+
+// PausedRetentionHoursNotIn applies the NotIn predicate on the "paused_retention_hours" field.
+func PausedRetentionHoursNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldPausedRetentionHours, vs...))
+}
+
+// PausedRetentionHoursGT applies the GT predicate on the "paused_retention_hours" field.
+func PausedRetentionHoursGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldPausedRetentionHours, v))
+}
+
+// PausedRetentionHoursGTE applies the GTE predicate on the "paused_retention_hours" field.
+func PausedRetentionHoursGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldPausedRetentionHours, v))
+}
+
+// PausedRetentionHoursLT applies the LT predicate on the "paused_retention_hours" field.
+func PausedRetentionHoursLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldPausedRetentionHours, v))
+}
+
+// PausedRetentionHoursLTE applies the LTE predicate on the "paused_retention_hours" field.
+func PausedRetentionHoursLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldPausedRetentionHours, v))
+}
+
+// MaxCheckpointsEQ applies the EQ predicate on the "max_checkpoints" field.
+func MaxCheckpointsEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldMaxCheckpoints, v))
+}
+
+// MaxCheckpointsNEQ applies the NEQ predicate on the "max_checkpoints" field.
+func MaxCheckpointsNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldMaxCheckpoints, v))
+}
+
+// MaxCheckpointsIn applies the In predicate on the "max_checkpoints" field.
+func MaxCheckpointsIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldMaxCheckpoints, vs...))
+}
+
+// MaxCheckpointsNotIn applies the NotIn predicate on the "max_checkpoints" field.
+func MaxCheckpointsNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldMaxCheckpoints, vs...))
+}
+
+// MaxCheckpointsGT applies the GT predicate on the "max_checkpoints" field.
+func MaxCheckpointsGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldMaxCheckpoints, v))
+}
+
+// MaxCheckpointsGTE applies the GTE predicate on the "max_checkpoints" field.
+func MaxCheckpointsGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldMaxCheckpoints, v))
+}
+
+// MaxCheckpointsLT applies the LT predicate on the "max_checkpoints" field.
+func MaxCheckpointsLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldMaxCheckpoints, v))
+}
+
+// MaxCheckpointsLTE applies the LTE predicate on the "max_checkpoints" field.
+func MaxCheckpointsLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldMaxCheckpoints, v))
+}
+
+// NetworkBandwidthMibpsEQ applies the EQ predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsNEQ applies the NEQ predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsIn applies the In predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldNetworkBandwidthMibps, vs...))
+}
+
+// NetworkBandwidthMibpsNotIn applies the NotIn predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldNetworkBandwidthMibps, vs...))
+}
+
+// NetworkBandwidthMibpsGT applies the GT predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsGTE applies the GTE predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsLT applies the LT predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkBandwidthMibpsLTE applies the LTE predicate on the "network_bandwidth_mibps" field.
+func NetworkBandwidthMibpsLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldNetworkBandwidthMibps, v))
+}
+
+// NetworkOpsEQ applies the EQ predicate on the "network_ops" field.
+func NetworkOpsEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldNetworkOps, v))
+}
+
+// NetworkOpsNEQ applies the NEQ predicate on the "network_ops" field.
+func NetworkOpsNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldNetworkOps, v))
+}
+
+// NetworkOpsIn applies the In predicate on the "network_ops" field.
+func NetworkOpsIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldNetworkOps, vs...))
+}
+
+// NetworkOpsNotIn applies the NotIn predicate on the "network_ops" field.
+func NetworkOpsNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldNetworkOps, vs...))
+}
+
+// NetworkOpsGT applies the GT predicate on the "network_ops" field.
+func NetworkOpsGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldNetworkOps, v))
+}
+
+// NetworkOpsGTE applies the GTE predicate on the "network_ops" field.
+func NetworkOpsGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldNetworkOps, v))
+}
+
+// NetworkOpsLT applies the LT predicate on the "network_ops" field.
+func NetworkOpsLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldNetworkOps, v))
+}
+
+// NetworkOpsLTE applies the LTE predicate on the "network_ops" field.
+func NetworkOpsLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldNetworkOps, v))
+}
+
+// DiskBandwidthMibpsEQ applies the EQ predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsNEQ applies the NEQ predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsIn applies the In predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldDiskBandwidthMibps, vs...))
+}
+
+// DiskBandwidthMibpsNotIn applies the NotIn predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldDiskBandwidthMibps, vs...))
+}
+
+// DiskBandwidthMibpsGT applies the GT predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsGTE applies the GTE predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsLT applies the LT predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldDiskBandwidthMibps, v))
+}
+
+// DiskBandwidthMibpsLTE applies the LTE predicate on the "disk_bandwidth_mibps" field.
+func DiskBandwidthMibpsLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldDiskBandwidthMibps, v))
+}
+
+// DiskIopsEQ applies the EQ predicate on the "disk_iops" field.
+func DiskIopsEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldDiskIops, v))
+}
+
+// DiskIopsNEQ applies the NEQ predicate on the "disk_iops" field.
+func DiskIopsNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldDiskIops, v))
+}
+
+// DiskIopsIn applies the In predicate on the "disk_iops" field.
+func DiskIopsIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldDiskIops, vs...))
+}
+
+// DiskIopsNotIn applies the NotIn predicate on the "disk_iops" field.
+func DiskIopsNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldDiskIops, vs...))
+}
+
+// DiskIopsGT applies the GT predicate on the "disk_iops" field.
+func DiskIopsGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldDiskIops, v))
+}
+
+// DiskIopsGTE applies the GTE predicate on the "disk_iops" field.
+func DiskIopsGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldDiskIops, v))
+}
+
+// DiskIopsLT applies the LT predicate on the "disk_iops" field.
+func DiskIopsLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldDiskIops, v))
+}
+
+// DiskIopsLTE applies the LTE predicate on the "disk_iops" field.
+func DiskIopsLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldDiskIops, v))
+}
+
+// APIRequestsPerSecondEQ applies the EQ predicate on the "api_requests_per_second" field.
+func APIRequestsPerSecondEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldAPIRequestsPerSecond, v))
+}
+
+// APIRequestsPerSecondNEQ applies the NEQ predicate on the "api_requests_per_second" field.
+func APIRequestsPerSecondNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldAPIRequestsPerSecond, v))
+}
+
+// APIRequestsPerSecondIn applies the In predicate on the "api_requests_per_second" field.
+func APIRequestsPerSecondIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldAPIRequestsPerSecond, vs...))
+}
+
+// APIRequestsPerSecondNotIn applies the NotIn predicate on the "api_requests_per_second" field.
+func APIRequestsPerSecondNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldAPIRequestsPerSecond, vs...))
+}
+
+// APIRequestsPerSecondGT applies the GT predicate on the "api_requests_per_second" field.
+func APIRequestsPerSecondGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldAPIRequestsPerSecond, v))
+}
+
+// APIRequestsPerSecondGTE applies the GTE predicate on the "api_requests_per_second" field.
+func APIRequestsPerSecondGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldAPIRequestsPerSecond, v))
+}
+
+// APIRequestsPerSecondLT applies the LT predicate on the "api_requests_per_second" field.
+func APIRequestsPerSecondLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldAPIRequestsPerSecond, v))
+}
+
+// APIRequestsPerSecondLTE applies the LTE predicate on the "api_requests_per_second" field.
+func APIRequestsPerSecondLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldAPIRequestsPerSecond, v))
+}
+
+// APIRequestsBurstEQ applies the EQ predicate on the "api_requests_burst" field.
+func APIRequestsBurstEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldAPIRequestsBurst, v))
+}
+
+// APIRequestsBurstNEQ applies the NEQ predicate on the "api_requests_burst" field.
+func APIRequestsBurstNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldAPIRequestsBurst, v))
+}
+
+// APIRequestsBurstIn applies the In predicate on the "api_requests_burst" field.
+func APIRequestsBurstIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldAPIRequestsBurst, vs...))
+}
+
+// APIRequestsBurstNotIn applies the NotIn predicate on the "api_requests_burst" field.
+func APIRequestsBurstNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldAPIRequestsBurst, vs...))
+}
+
+// APIRequestsBurstGT applies the GT predicate on the "api_requests_burst" field.
+func APIRequestsBurstGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldAPIRequestsBurst, v))
+}
+
+// APIRequestsBurstGTE applies the GTE predicate on the "api_requests_burst" field.
+func APIRequestsBurstGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldAPIRequestsBurst, v))
+}
+
+// APIRequestsBurstLT applies the LT predicate on the "api_requests_burst" field.
+func APIRequestsBurstLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldAPIRequestsBurst, v))
+}
+
+// APIRequestsBurstLTE applies the LTE predicate on the "api_requests_burst" field.
+func APIRequestsBurstLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldAPIRequestsBurst, v))
+}
+
+// ConcurrentTemplateBuildsEQ applies the EQ predicate on the "concurrent_template_builds" field.
+func ConcurrentTemplateBuildsEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldConcurrentTemplateBuilds, v))
+}
+
+// ConcurrentTemplateBuildsNEQ applies the NEQ predicate on the "concurrent_template_builds" field.
+func ConcurrentTemplateBuildsNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldConcurrentTemplateBuilds, v))
+}
+
+// ConcurrentTemplateBuildsIn applies the In predicate on the "concurrent_template_builds" field.
+func ConcurrentTemplateBuildsIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldConcurrentTemplateBuilds, vs...))
+}
+
+// ConcurrentTemplateBuildsNotIn applies the NotIn predicate on the "concurrent_template_builds" field.
+func ConcurrentTemplateBuildsNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldConcurrentTemplateBuilds, vs...))
+}
+
+// ConcurrentTemplateBuildsGT applies the GT predicate on the "concurrent_template_builds" field.
+func ConcurrentTemplateBuildsGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldConcurrentTemplateBuilds, v))
+}
+
+// ConcurrentTemplateBuildsGTE applies the GTE predicate on the "concurrent_template_builds" field.
+func ConcurrentTemplateBuildsGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldConcurrentTemplateBuilds, v))
+}
+
+// ConcurrentTemplateBuildsLT applies the LT predicate on the "concurrent_template_builds" field.
+func ConcurrentTemplateBuildsLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldConcurrentTemplateBuilds, v))
+}
+
+// ConcurrentTemplateBuildsLTE applies the LTE predicate on the "concurrent_template_builds" field.
+func ConcurrentTemplateBuildsLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldConcurrentTemplateBuilds, v))
+}
+
+// BuildPriorityEQ applies the EQ predicate on the "build_priority" field.
+func BuildPriorityEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldEQ(FieldBuildPriority, v))
+}
+
+// BuildPriorityNEQ applies the NEQ predicate on the "build_priority" field.
+func BuildPriorityNEQ(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNEQ(FieldBuildPriority, v))
+}
+
+// BuildPriorityIn applies the In predicate on the "build_priority" field.
+func BuildPriorityIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldIn(FieldBuildPriority, vs...))
+}
+
+// BuildPriorityNotIn applies the NotIn predicate on the "build_priority" field.
+func BuildPriorityNotIn(vs ...int64) predicate.Tier {
+	return predicate.Tier(sql.FieldNotIn(FieldBuildPriority, vs...))
+}
+
+// BuildPriorityGT applies the GT predicate on the "build_priority" field.
+func BuildPriorityGT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGT(FieldBuildPriority, v))
+}
+
+// BuildPriorityGTE applies the GTE predicate on the "build_priority" field.
+func BuildPriorityGTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldGTE(FieldBuildPriority, v))
+}
+
+// BuildPriorityLT applies the LT predicate on the "build_priority" field.
+func BuildPriorityLT(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLT(FieldBuildPriority, v))
+}
+
+// BuildPriorityLTE applies the LTE predicate on the "build_priority" field.
+func BuildPriorityLTE(v int64) predicate.Tier {
+	return predicate.Tier(sql.FieldLTE(FieldBuildPriority, v))
+}
+
 // HasTeams applies the HasEdge predicate on the "teams" edge.
 func HasTeams() predicate.Tier {
 	return predicate.Tier(func(s *sql.Selector) {
@@ -48,6 +48,208 @@ func (tc *TierCreate) SetMaxLengthHours(i int64) *TierCreate {
 	return tc
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (tc *TierCreate) SetMaxVcpu(i int64) *TierCreate {
+	tc.mutation.SetMaxVcpu(i)
+	return tc
+}
+
+// SetNillableMaxVcpu sets the "max_vcpu" field if the given value is not nil.
+func (tc *TierCreate) SetNillableMaxVcpu(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetMaxVcpu(*i)
+	}
+	return tc
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (tc *TierCreate) SetMaxRAMMB(i int64) *TierCreate {
+	tc.mutation.SetMaxRAMMB(i)
+	return tc
+}
+
+// SetNillableMaxRAMMB sets the "max_ram_mb" field if the given value is not nil.
+func (tc *TierCreate) SetNillableMaxRAMMB(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetMaxRAMMB(*i)
+	}
+	return tc
+}
+
+// SetConcurrentVcpus sets the "concurrent_vcpus" field.
+func (tc *TierCreate) SetConcurrentVcpus(i int64) *TierCreate {
+	tc.mutation.SetConcurrentVcpus(i)
+	return tc
+}
+
+// SetNillableConcurrentVcpus sets the "concurrent_vcpus" field if the given value is not nil.
+func (tc *TierCreate) SetNillableConcurrentVcpus(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetConcurrentVcpus(*i)
+	}
+	return tc
+}
+
+// SetConcurrentRAMMB sets the "concurrent_ram_mb" field.
+func (tc *TierCreate) SetConcurrentRAMMB(i int64) *TierCreate {
+	tc.mutation.SetConcurrentRAMMB(i)
+	return tc
+}
+
+// SetNillableConcurrentRAMMB sets the "concurrent_ram_mb" field if the given value is not nil.
+func (tc *TierCreate) SetNillableConcurrentRAMMB(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetConcurrentRAMMB(*i)
+	}
+	return tc
+}
+
+// SetPausedStorageMB sets the "paused_storage_mb" field.
+func (tc *TierCreate) SetPausedStorageMB(i int64) *TierCreate {
+	tc.mutation.SetPausedStorageMB(i)
+	return tc
+}
+
+// SetNillablePausedStorageMB sets the "paused_storage_mb" field if the given value is not nil.
+func (tc *TierCreate) SetNillablePausedStorageMB(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetPausedStorageMB(*i)
+	}
+	return tc
+}
+
+// SetPausedRetentionHours sets the "paused_retention_hours" field.
+func (tc *TierCreate) SetPausedRetentionHours(i int64) *TierCreate {
+	tc.mutation.SetPausedRetentionHours(i)
+	return tc
+}
+
+// SetNillablePausedRetentionHours sets the "paused_retention_hours" field if the given value is not nil.
+func (tc *TierCreate) SetNillablePausedRetentionHours(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetPausedRetentionHours(*i)
+	}
+	return tc
+}
+
+// SetMaxCheckpoints sets the "max_checkpoints" field.
+func (tc *TierCreate) SetMaxCheckpoints(i int64) *TierCreate {
+	tc.mutation.SetMaxCheckpoints(i)
+	return tc
+}
+
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (tc *TierCreate) SetNetworkBandwidthMibps(i int64) *TierCreate {
+	tc.mutation.SetNetworkBandwidthMibps(i)
+	return tc
+}
+
+// SetNillableNetworkBandwidthMibps sets the "network_bandwidth_mibps" field if the given value is not nil.
+func (tc *TierCreate) SetNillableNetworkBandwidthMibps(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetNetworkBandwidthMibps(*i)
+	}
+	return tc
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (tc *TierCreate) SetNetworkOps(i int64) *TierCreate {
+	tc.mutation.SetNetworkOps(i)
+	return tc
+}
+
+// SetNillableNetworkOps sets the "network_ops" field if the given value is not nil.
+func (tc *TierCreate) SetNillableNetworkOps(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetNetworkOps(*i)
+	}
+	return tc
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (tc *TierCreate) SetDiskBandwidthMibps(i int64) *TierCreate {
+	tc.mutation.SetDiskBandwidthMibps(i)
+	return tc
+}
+
+// SetNillableDiskBandwidthMibps sets the "disk_bandwidth_mibps" field if the given value is not nil.
+func (tc *TierCreate) SetNillableDiskBandwidthMibps(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetDiskBandwidthMibps(*i)
+	}
+	return tc
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (tc *TierCreate) SetDiskIops(i int64) *TierCreate {
+	tc.mutation.SetDiskIops(i)
+	return tc
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (tc *TierCreate) SetNillableDiskIops(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetDiskIops(*i)
+	}
+	return tc
+}
+
+// SetAPIRequestsPerSecond sets the "api_requests_per_second" field.
+func (tc *TierCreate) SetAPIRequestsPerSecond(i int64) *TierCreate {
+	tc.mutation.SetAPIRequestsPerSecond(i)
+	return tc
+}
+
+// SetNillableAPIRequestsPerSecond sets the "api_requests_per_second" field if the given value is not nil.
+func (tc *TierCreate) SetNillableAPIRequestsPerSecond(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetAPIRequestsPerSecond(*i)
+	}
+	return tc
+}
+
+// SetAPIRequestsBurst sets the "api_requests_burst" field.
+func (tc *TierCreate) SetAPIRequestsBurst(i int64) *TierCreate {
+	tc.mutation.SetAPIRequestsBurst(i)
+	return tc
+}
+
+// SetNillableAPIRequestsBurst sets the "api_requests_burst" field if the given value is not nil.
+func (tc *TierCreate) SetNillableAPIRequestsBurst(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetAPIRequestsBurst(*i)
+	}
+	return tc
+}
+
+// SetConcurrentTemplateBuilds sets the "concurrent_template_builds" field.
+func (tc *TierCreate) SetConcurrentTemplateBuilds(i int64) *TierCreate {
+	tc.mutation.SetConcurrentTemplateBuilds(i)
+	return tc
+}
+
+// SetNillableConcurrentTemplateBuilds sets the "concurrent_template_builds" field if the given value is not nil.
+func (tc *TierCreate) SetNillableConcurrentTemplateBuilds(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetConcurrentTemplateBuilds(*i)
+	}
+	return tc
+}
+
+// SetBuildPriority sets the "build_priority" field.
+func (tc *TierCreate) SetBuildPriority(i int64) *TierCreate {
+	tc.mutation.SetBuildPriority(i)
+	return tc
+}
+
+// SetNillableBuildPriority sets the "build_priority" field if the given value is not nil.
+func (tc *TierCreate) SetNillableBuildPriority(i *int64) *TierCreate {
+	if i != nil {
+		tc.SetBuildPriority(*i)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TierCreate) SetID(s string) *TierCreate {
 	tc.mutation.SetID(s)
@@ -76,6 +278,7 @@ func (tc *TierCreate) Mutation() *TierMutation {
 
 // Save creates the Tier in the database.
 func (tc *TierCreate) Save(ctx context.Context) (*Tier, error) {
+	tc.defaults()
 	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
 }
 
@@ -101,6 +304,66 @@ func (tc *TierCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (tc *TierCreate) defaults() {
+	if _, ok := tc.mutation.MaxVcpu(); !ok {
+		v := tier.DefaultMaxVcpu
+		tc.mutation.SetMaxVcpu(v)
+	}
+	if _, ok := tc.mutation.MaxRAMMB(); !ok {
+		v := tier.DefaultMaxRAMMB
+		tc.mutation.SetMaxRAMMB(v)
+	}
+	if _, ok := tc.mutation.ConcurrentVcpus(); !ok {
+		v := tier.DefaultConcurrentVcpus
+		tc.mutation.SetConcurrentVcpus(v)
+	}
+	if _, ok := tc.mutation.ConcurrentRAMMB(); !ok {
+		v := tier.DefaultConcurrentRAMMB
+		tc.mutation.SetConcurrentRAMMB(v)
+	}
+	if _, ok := tc.mutation.PausedStorageMB(); !ok {
+		v := tier.DefaultPausedStorageMB
+		tc.mutation.SetPausedStorageMB(v)
+	}
+	if _, ok := tc.mutation.PausedRetentionHours(); !ok {
+		v := tier.DefaultPausedRetentionHours
+		tc.mutation.SetPausedRetentionHours(v)
+	}
+	if _, ok := tc.mutation.NetworkBandwidthMibps(); !ok {
+		v := tier.DefaultNetworkBandwidthMibps
+		tc.mutation.SetNetworkBandwidthMibps(v)
+	}
+	if _, ok := tc.mutation.NetworkOps(); !ok {
+		v := tier.DefaultNetworkOps
+		tc.mutation.SetNetworkOps(v)
+	}
+	if _, ok := tc.mutation.DiskBandwidthMibps(); !ok {
+		v := tier.DefaultDiskBandwidthMibps
+		tc.mutation.SetDiskBandwidthMibps(v)
+	}
+	if _, ok := tc.mutation.DiskIops(); !ok {
+		v := tier.DefaultDiskIops
+		tc.mutation.SetDiskIops(v)
+	}
+	if _, ok := tc.mutation.APIRequestsPerSecond(); !ok {
+		v := tier.DefaultAPIRequestsPerSecond
+		tc.mutation.SetAPIRequestsPerSecond(v)
+	}
+	if _, ok := tc.mutation.APIRequestsBurst(); !ok {
+		v := tier.DefaultAPIRequestsBurst
+		tc.mutation.SetAPIRequestsBurst(v)
+	}
+	if _, ok := tc.mutation.ConcurrentTemplateBuilds(); !ok {
+		v := tier.DefaultConcurrentTemplateBuilds
+		tc.mutation.SetConcurrentTemplateBuilds(v)
+	}
+	if _, ok := tc.mutation.BuildPriority(); !ok {
+		v := tier.DefaultBuildPriority
+		tc.mutation.SetBuildPriority(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (tc *TierCreate) check() error {
 	if _, ok := tc.mutation.Name(); !ok {
@@ -115,6 +378,51 @@ func (tc *TierCreate) check() error {
 	if _, ok := tc.mutation.MaxLengthHours(); !ok {
 		return &ValidationError{Name: "max_length_hours", err: errors.New(`models: missing required field "Tier.max_length_hours"`)}
 	}
+	if _, ok := tc.mutation.MaxVcpu(); !ok {
+		return &ValidationError{Name: "max_vcpu", err: errors.New(`models: missing required field "Tier.max_vcpu"`)}
+	}
+	if _, ok := tc.mutation.MaxRAMMB(); !ok {
+		return &ValidationError{Name: "max_ram_mb", err: errors.New(`models: missing required field "Tier.max_ram_mb"`)}
+	}
+	if _, ok := tc.mutation.ConcurrentVcpus(); !ok {
+		return &ValidationError{Name: "concurrent_vcpus", err: errors.New(`models: missing required field "Tier.concurrent_vcpus"`)}
+	}
+	if _, ok := tc.mutation.ConcurrentRAMMB(); !ok {
+		return &ValidationError{Name: "concurrent_ram_mb", err: errors.New(`models: missing required field "Tier.concurrent_ram_mb"`)}
+	}
+	if _, ok := tc.mutation.PausedStorageMB(); !ok {
+		return &ValidationError{Name: "paused_storage_mb", err: errors.New(`models: missing required field "Tier.paused_storage_mb"`)}
+	}
+	if _, ok := tc.mutation.PausedRetentionHours(); !ok {
+		return &ValidationError{Name: "paused_retention_hours", err: errors.New(`models: missing required field "Tier.paused_retention_hours"`)}
+	}
+	if _, ok := tc.mutation.MaxCheckpoints(); !ok {
+		return &ValidationError{Name: "max_checkpoints", err: errors.New(`models: missing required field "Tier.max_checkpoints"`)}
+	}
+	if _, ok := tc.mutation.NetworkBandwidthMibps(); !ok {
+		return &ValidationError{Name: "network_bandwidth_mibps", err: errors.New(`models: missing required field "Tier.network_bandwidth_mibps"`)}
+	}
+	if _, ok := tc.mutation.NetworkOps(); !ok {
+		return &ValidationError{Name: "network_ops", err: errors.New(`models: missing required field "Tier.network_ops"`)}
+	}
+	if _, ok := tc.mutation.DiskBandwidthMibps(); !ok {
+		return &ValidationError{Name: "disk_bandwidth_mibps", err: errors.New(`models: missing required field "Tier.disk_bandwidth_mibps"`)}
+	}
+	if _, ok := tc.mutation.DiskIops(); !ok {
+		return &ValidationError{Name: "disk_iops", err: errors.New(`models: missing required field "Tier.disk_iops"`)}
+	}
+	if _, ok := tc.mutation.APIRequestsPerSecond(); !ok {
+		return &ValidationError{Name: "api_requests_per_second", err: errors.New(`models: missing required field "Tier.api_requests_per_second"`)}
+	}
+	if _, ok := tc.mutation.APIRequestsBurst(); !ok {
+		return &ValidationError{Name: "api_requests_burst", err: errors.New(`models: missing required field "Tier.api_requests_burst"`)}
+	}
+	if _, ok := tc.mutation.ConcurrentTemplateBuilds(); !ok {
+		return &ValidationError{Name: "concurrent_template_builds", err: errors.New(`models: missing required field "Tier.concurrent_template_builds"`)}
+	}
+	if _, ok := tc.mutation.BuildPriority(); !ok {
+		return &ValidationError{Name: "build_priority", err: errors.New(`models: missing required field "Tier.build_priority"`)}
+	}
 	return nil
 }
 
@@ -168,6 +476,66 @@ func (tc *TierCreate) createSpec() (*Tier, *sqlgraph.CreateSpec) {
 		_spec.SetField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 		_node.MaxLengthHours = value
 	}
+	if value, ok := tc.mutation.MaxVcpu(); ok {
+		_spec.SetField(tier.FieldMaxVcpu, field.TypeInt64, value)
+		_node.MaxVcpu = value
+	}
+	if value, ok := tc.mutation.MaxRAMMB(); ok {
+		_spec.SetField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+		_node.MaxRAMMB = value
+	}
+	if value, ok := tc.mutation.ConcurrentVcpus(); ok {
+		_spec.SetField(tier.FieldConcurrentVcpus, field.TypeInt64, value)
+		_node.ConcurrentVcpus = value
+	}
+	if value, ok := tc.mutation.ConcurrentRAMMB(); ok {
+		_spec.SetField(tier.FieldConcurrentRAMMB, field.TypeInt64, value)
+		_node.ConcurrentRAMMB = value
+	}
+	if value, ok := tc.mutation.PausedStorageMB(); ok {
+		_spec.SetField(tier.FieldPausedStorageMB, field.TypeInt64, value)
+		_node.PausedStorageMB = value
+	}
+	if value, ok := tc.mutation.PausedRetentionHours(); ok {
+		_spec.SetField(tier.FieldPausedRetentionHours, field.TypeInt64, value)
+		_node.PausedRetentionHours = value
+	}
+	if value, ok := tc.mutation.MaxCheckpoints(); ok {
+		_spec.SetField(tier.FieldMaxCheckpoints, field.TypeInt64, value)
+		_node.MaxCheckpoints = value
+	}
+	if value, ok := tc.mutation.NetworkBandwidthMibps(); ok {
+		_spec.SetField(tier.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+		_node.NetworkBandwidthMibps = value
+	}
+	if value, ok := tc.mutation.NetworkOps(); ok {
+		_spec.SetField(tier.FieldNetworkOps, field.TypeInt64, value)
+		_node.NetworkOps = value
+	}
+	if value, ok := tc.mutation.DiskBandwidthMibps(); ok {
+		_spec.SetField(tier.FieldDiskBandwidthMibps, field.TypeInt64, value)
+		_node.DiskBandwidthMibps = value
+	}
+	if value, ok := tc.mutation.DiskIops(); ok {
+		_spec.SetField(tier.FieldDiskIops, field.TypeInt64, value)
+		_node.DiskIops = value
+	}
+	if value, ok := tc.mutation.APIRequestsPerSecond(); ok {
+		_spec.SetField(tier.FieldAPIRequestsPerSecond, field.TypeInt64, value)
+		_node.APIRequestsPerSecond = value
+	}
+	if value, ok := tc.mutation.APIRequestsBurst(); ok {
+		_spec.SetField(tier.FieldAPIRequestsBurst, field.TypeInt64, value)
+		_node.APIRequestsBurst = value
+	}
+	if value, ok := tc.mutation.ConcurrentTemplateBuilds(); ok {
+		_spec.SetField(tier.FieldConcurrentTemplateBuilds, field.TypeInt64, value)
+		_node.ConcurrentTemplateBuilds = value
+	}
+	if value, ok := tc.mutation.BuildPriority(); ok {
+		_spec.SetField(tier.FieldBuildPriority, field.TypeInt64, value)
+		_node.BuildPriority = value
+	}
 	if nodes := tc.mutation.TeamsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -303,6 +671,276 @@ func (u *TierUpsert) AddMaxLengthHours(v int64) *TierUpsert {
 	return u
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (u *TierUpsert) SetMaxVcpu(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxVcpu, v)
+	return u
+}
+
+// UpdateMaxVcpu sets the "max_vcpu" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxVcpu() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxVcpu)
+	return u
+}
+
+// AddMaxVcpu adds v to the "max_vcpu" field.
+func (u *TierUpsert) AddMaxVcpu(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxVcpu, v)
+	return u
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (u *TierUpsert) SetMaxRAMMB(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxRAMMB, v)
+	return u
+}
+
+// UpdateMaxRAMMB sets the "max_ram_mb" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxRAMMB() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxRAMMB)
+	return u
+}
+
+// AddMaxRAMMB adds v to the "max_ram_mb" field.
+func (u *TierUpsert) AddMaxRAMMB(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxRAMMB, v)
+	return u
+}
+
+// SetConcurrentVcpus sets the "concurrent_vcpus" field.
+func (u *TierUpsert) SetConcurrentVcpus(v int64) *TierUpsert {
+	u.Set(tier.FieldConcurrentVcpus, v)
+	return u
+}
+
+// UpdateConcurrentVcpus sets the "concurrent_vcpus" field to the value that was provided on create.
+func (u *TierUpsert) UpdateConcurrentVcpus() *TierUpsert {
+	u.SetExcluded(tier.FieldConcurrentVcpus)
+	return u
+}
+
+// AddConcurrentVcpus adds v to the "concurrent_vcpus" field.
+func (u *TierUpsert) AddConcurrentVcpus(v int64) *TierUpsert {
+	u.Add(tier.FieldConcurrentVcpus, v)
+	return u
+}
+
+// SetConcurrentRAMMB sets the "concurrent_ram_mb" field.
+func (u *TierUpsert) SetConcurrentRAMMB(v int64) *TierUpsert {
+	u.Set(tier.FieldConcurrentRAMMB, v)
+	return u
+}
+
+// UpdateConcurrentRAMMB sets the "concurrent_ram_mb" field to the value that was provided on create.
+func (u *TierUpsert) UpdateConcurrentRAMMB() *TierUpsert {
+	u.SetExcluded(tier.FieldConcurrentRAMMB)
+	return u
+}
+
+// AddConcurrentRAMMB adds v to the "concurrent_ram_mb" field.
+func (u *TierUpsert) AddConcurrentRAMMB(v int64) *TierUpsert {
+	u.Add(tier.FieldConcurrentRAMMB, v)
+	return u
+}
+
+// SetPausedStorageMB sets the "paused_storage_mb" field.
+func (u *TierUpsert) SetPausedStorageMB(v int64) *TierUpsert {
+	u.Set(tier.FieldPausedStorageMB, v)
+	return u
+}
+
+// UpdatePausedStorageMB sets the "paused_storage_mb" field to the value that was provided on create.
+func (u *TierUpsert) UpdatePausedStorageMB() *TierUpsert {
+	u.SetExcluded(tier.FieldPausedStorageMB)
+	return u
+}
+
+// AddPausedStorageMB adds v to the "paused_storage_mb" field.
+func (u *TierUpsert) AddPausedStorageMB(v int64) *TierUpsert {
+	u.Add(tier.FieldPausedStorageMB, v)
+	return u
+}
+
+// SetPausedRetentionHours sets the "paused_retention_hours" field.
+func (u *TierUpsert) SetPausedRetentionHours(v int64) *TierUpsert {
+	u.Set(tier.FieldPausedRetentionHours, v)
+	return u
+}
+
+// UpdatePausedRetentionHours sets the "paused_retention_hours" field to the value that was provided on create.
+func (u *TierUpsert) UpdatePausedRetentionHours() *TierUpsert {
+	u.SetExcluded(tier.FieldPausedRetentionHours)
+	return u
+}
+
+// AddPausedRetentionHours adds v to the "paused_retention_hours" field.
+func (u *TierUpsert) AddPausedRetentionHours(v int64) *TierUpsert {
+	u.Add(tier.FieldPausedRetentionHours, v)
+	return u
+}
+
+// SetMaxCheckpoints sets the "max_checkpoints" field.
+func (u *TierUpsert) SetMaxCheckpoints(v int64) *TierUpsert {
+	u.Set(tier.FieldMaxCheckpoints, v)
+	return u
+}
+
+// UpdateMaxCheckpoints sets the "max_checkpoints" field to the value that was provided on create.
+func (u *TierUpsert) UpdateMaxCheckpoints() *TierUpsert {
+	u.SetExcluded(tier.FieldMaxCheckpoints)
+	return u
+}
+
+// AddMaxCheckpoints adds v to the "max_checkpoints" field.
+func (u *TierUpsert) AddMaxCheckpoints(v int64) *TierUpsert {
+	u.Add(tier.FieldMaxCheckpoints, v)
+	return u
+}
+
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (u *TierUpsert) SetNetworkBandwidthMibps(v int64) *TierUpsert {
+	u.Set(tier.FieldNetworkBandwidthMibps, v)
+	return u
+}
+
+// UpdateNetworkBandwidthMibps sets the "network_bandwidth_mibps" field to the value that was provided on create.
+func (u *TierUpsert) UpdateNetworkBandwidthMibps() *TierUpsert {
+	u.SetExcluded(tier.FieldNetworkBandwidthMibps)
+	return u
+}
+
+// AddNetworkBandwidthMibps adds v to the "network_bandwidth_mibps" field.
+func (u *TierUpsert) AddNetworkBandwidthMibps(v int64) *TierUpsert {
+	u.Add(tier.FieldNetworkBandwidthMibps, v)
+	return u
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (u *TierUpsert) SetNetworkOps(v int64) *TierUpsert {
+	u.Set(tier.FieldNetworkOps, v)
+	return u
+}
+
+// UpdateNetworkOps sets the "network_ops" field to the value that was provided on create.
+func (u *TierUpsert) UpdateNetworkOps() *TierUpsert {
+	u.SetExcluded(tier.FieldNetworkOps)
+	return u
+}
+
+// AddNetworkOps adds v to the "network_ops" field.
+func (u *TierUpsert) AddNetworkOps(v int64) *TierUpsert {
+	u.Add(tier.FieldNetworkOps, v)
+	return u
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (u *TierUpsert) SetDiskBandwidthMibps(v int64) *TierUpsert {
+	u.Set(tier.FieldDiskBandwidthMibps, v)
+	return u
+}
+
+// UpdateDiskBandwidthMibps sets the "disk_bandwidth_mibps" field to the value that was provided on create.
+func (u *TierUpsert) UpdateDiskBandwidthMibps() *TierUpsert {
+	u.SetExcluded(tier.FieldDiskBandwidthMibps)
+	return u
+}
+
+// AddDiskBandwidthMibps adds v to the "disk_bandwidth_mibps" field.
+func (u *TierUpsert) AddDiskBandwidthMibps(v int64) *TierUpsert {
+	u.Add(tier.FieldDiskBandwidthMibps, v)
+	return u
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *TierUpsert) SetDiskIops(v int64) *TierUpsert {
+	u.Set(tier.FieldDiskIops, v)
+	return u
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *TierUpsert) UpdateDiskIops() *TierUpsert {
+	u.SetExcluded(tier.FieldDiskIops)
+	return u
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *TierUpsert) AddDiskIops(v int64) *TierUpsert {
+	u.Add(tier.FieldDiskIops, v)
+	return u
+}
+
+// SetAPIRequestsPerSecond sets the "api_requests_per_second" field.
+func (u *TierUpsert) SetAPIRequestsPerSecond(v int64) *TierUpsert {
+	u.Set(tier.FieldAPIRequestsPerSecond, v)
+	return u
+}
+
+// UpdateAPIRequestsPerSecond sets the "api_requests_per_second" field to the value that was provided on create.
+func (u *TierUpsert) UpdateAPIRequestsPerSecond() *TierUpsert {
+	u.SetExcluded(tier.FieldAPIRequestsPerSecond)
+	return u
+}
+
+// AddAPIRequestsPerSecond adds v to the "api_requests_per_second" field.
+func (u *TierUpsert) AddAPIRequestsPerSecond(v int64) *TierUpsert {
+	u.Add(tier.FieldAPIRequestsPerSecond, v)
+	return u
+}
+
+// SetAPIRequestsBurst sets the "api_requests_burst" field.
+func (u *TierUpsert) SetAPIRequestsBurst(v int64) *TierUpsert {
+	u.Set(tier.FieldAPIRequestsBurst, v)
+	return u
+}
+
+// UpdateAPIRequestsBurst sets the "api_requests_burst" field to the value that was provided on create.
+func (u *TierUpsert) UpdateAPIRequestsBurst() *TierUpsert {
+	u.SetExcluded(tier.FieldAPIRequestsBurst)
+	return u
+}
+
+// AddAPIRequestsBurst adds v to the "api_requests_burst" field.
+func (u *TierUpsert) AddAPIRequestsBurst(v int64) *TierUpsert {
+	u.Add(tier.FieldAPIRequestsBurst, v)
+	return u
+}
+
+// SetConcurrentTemplateBuilds sets the "concurrent_template_builds" field.
+func (u *TierUpsert) SetConcurrentTemplateBuilds(v int64) *TierUpsert {
+	u.Set(tier.FieldConcurrentTemplateBuilds, v)
+	return u
+}
+
+// UpdateConcurrentTemplateBuilds sets the "concurrent_template_builds" field to the value that was provided on create.
+func (u *TierUpsert) UpdateConcurrentTemplateBuilds() *TierUpsert {
+	u.SetExcluded(tier.FieldConcurrentTemplateBuilds)
+	return u
+}
+
+// AddConcurrentTemplateBuilds adds v to the "concurrent_template_builds" field.
+func (u *TierUpsert) AddConcurrentTemplateBuilds(v int64) *TierUpsert {
+	u.Add(tier.FieldConcurrentTemplateBuilds, v)
+	return u
+}
+
+// SetBuildPriority sets the "build_priority" field.
+func (u *TierUpsert) SetBuildPriority(v int64) *TierUpsert {
+	u.Set(tier.FieldBuildPriority, v)
+	return u
+}
+
+// UpdateBuildPriority sets the "build_priority" field to the value that was provided on create.
+func (u *TierUpsert) UpdateBuildPriority() *TierUpsert {
+	u.SetExcluded(tier.FieldBuildPriority)
+	return u
+}
+
+// AddBuildPriority adds v to the "build_priority" field.
+func (u *TierUpsert) AddBuildPriority(v int64) *TierUpsert {
+	u.Add(tier.FieldBuildPriority, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -324,107 +962,422 @@ func (u *TierUpsertOne) UpdateNewValues() *TierUpsertOne {
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.Tier.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *TierUpsertOne) Ignore() *TierUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
-	return u
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Tier.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TierUpsertOne) Ignore() *TierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TierUpsertOne) DoNothing() *TierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TierCreate.OnConflict
+// documentation for more info.
+func (u *TierUpsertOne) Update(set func(*TierUpsert)) *TierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TierUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TierUpsertOne) SetName(v string) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateName() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDiskMB sets the "disk_mb" field.
+func (u *TierUpsertOne) SetDiskMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskMB(v)
+	})
+}
+
+// AddDiskMB adds v to the "disk_mb" field.
+func (u *TierUpsertOne) AddDiskMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskMB(v)
+	})
+}
+
+// UpdateDiskMB sets the "disk_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateDiskMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskMB()
+	})
+}
+
+// SetConcurrentInstances sets the "concurrent_instances" field.
+func (u *TierUpsertOne) SetConcurrentInstances(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetConcurrentInstances(v)
+	})
+}
+
+// AddConcurrentInstances adds v to the "concurrent_instances" field.
+func (u *TierUpsertOne) AddConcurrentInstances(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddConcurrentInstances(v)
+	})
+}
+
+// UpdateConcurrentInstances sets the "concurrent_instances" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateConcurrentInstances() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateConcurrentInstances()
+	})
+}
+
+// SetMaxLengthHours sets the "max_length_hours" field.
+func (u *TierUpsertOne) SetMaxLengthHours(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxLengthHours(v)
+	})
+}
+
+// AddMaxLengthHours adds v to the "max_length_hours" field.
+func (u *TierUpsertOne) AddMaxLengthHours(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxLengthHours(v)
+	})
+}
+
+// UpdateMaxLengthHours sets the "max_length_hours" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxLengthHours() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxLengthHours()
+	})
+}
+
+// SetMaxVcpu sets the "max_vcpu" field.
+func (u *TierUpsertOne) SetMaxVcpu(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxVcpu(v)
+	})
+}
+
+// AddMaxVcpu adds v to the "max_vcpu" field.
+func (u *TierUpsertOne) AddMaxVcpu(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxVcpu(v)
+	})
+}
+
+// UpdateMaxVcpu sets the "max_vcpu" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxVcpu() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxVcpu()
+	})
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (u *TierUpsertOne) SetMaxRAMMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxRAMMB(v)
+	})
+}
+
+// AddMaxRAMMB adds v to the "max_ram_mb" field.
+func (u *TierUpsertOne) AddMaxRAMMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxRAMMB(v)
+	})
+}
+
+// UpdateMaxRAMMB sets the "max_ram_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxRAMMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxRAMMB()
+	})
+}
+
+// SetConcurrentVcpus sets the "concurrent_vcpus" field.
+func (u *TierUpsertOne) SetConcurrentVcpus(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetConcurrentVcpus(v)
+	})
+}
+
+// AddConcurrentVcpus adds v to the "concurrent_vcpus" field.
+func (u *TierUpsertOne) AddConcurrentVcpus(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddConcurrentVcpus(v)
+	})
+}
+
+// UpdateConcurrentVcpus sets the "concurrent_vcpus" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateConcurrentVcpus() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateConcurrentVcpus()
+	})
+}
+
+// SetConcurrentRAMMB sets the "concurrent_ram_mb" field.
+func (u *TierUpsertOne) SetConcurrentRAMMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetConcurrentRAMMB(v)
+	})
+}
+
+// AddConcurrentRAMMB adds v to the "concurrent_ram_mb" field.
+func (u *TierUpsertOne) AddConcurrentRAMMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddConcurrentRAMMB(v)
+	})
+}
+
+// UpdateConcurrentRAMMB sets the "concurrent_ram_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateConcurrentRAMMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateConcurrentRAMMB()
+	})
+}
+
+// SetPausedStorageMB sets the "paused_storage_mb" field.
+func (u *TierUpsertOne) SetPausedStorageMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetPausedStorageMB(v)
+	})
+}
+
+// AddPausedStorageMB adds v to the "paused_storage_mb" field.
+func (u *TierUpsertOne) AddPausedStorageMB(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddPausedStorageMB(v)
+	})
+}
+
+// UpdatePausedStorageMB sets the "paused_storage_mb" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdatePausedStorageMB() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdatePausedStorageMB()
+	})
+}
+
+// SetPausedRetentionHours sets the "paused_retention_hours" field.
+func (u *TierUpsertOne) SetPausedRetentionHours(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetPausedRetentionHours(v)
+	})
+}
+
+// AddPausedRetentionHours adds v to the "paused_retention_hours" field.
+func (u *TierUpsertOne) AddPausedRetentionHours(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddPausedRetentionHours(v)
+	})
+}
+
+// UpdatePausedRetentionHours sets the "paused_retention_hours" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdatePausedRetentionHours() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdatePausedRetentionHours()
+	})
+}
+
+// SetMaxCheckpoints sets the "max_checkpoints" field.
+func (u *TierUpsertOne) SetMaxCheckpoints(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxCheckpoints(v)
+	})
+}
+
+// AddMaxCheckpoints adds v to the "max_checkpoints" field.
+func (u *TierUpsertOne) AddMaxCheckpoints(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxCheckpoints(v)
+	})
+}
+
+// UpdateMaxCheckpoints sets the "max_checkpoints" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateMaxCheckpoints() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxCheckpoints()
+	})
+}
+
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (u *TierUpsertOne) SetNetworkBandwidthMibps(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkBandwidthMibps(v)
+	})
+}
+
+// AddNetworkBandwidthMibps adds v to the "network_bandwidth_mibps" field.
+func (u *TierUpsertOne) AddNetworkBandwidthMibps(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkBandwidthMibps(v)
+	})
+}
+
+// UpdateNetworkBandwidthMibps sets the "network_bandwidth_mibps" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateNetworkBandwidthMibps() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkBandwidthMibps()
+	})
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (u *TierUpsertOne) SetNetworkOps(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkOps(v)
+	})
+}
+
+// AddNetworkOps adds v to the "network_ops" field.
+func (u *TierUpsertOne) AddNetworkOps(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkOps(v)
+	})
+}
+
+// UpdateNetworkOps sets the "network_ops" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateNetworkOps() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkOps()
+	})
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (u *TierUpsertOne) SetDiskBandwidthMibps(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskBandwidthMibps(v)
+	})
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *TierUpsertOne) DoNothing() *TierUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
-	return u
+// AddDiskBandwidthMibps adds v to the "disk_bandwidth_mibps" field.
+func (u *TierUpsertOne) AddDiskBandwidthMibps(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskBandwidthMibps(v)
+	})
 }
 
-// Update allows overriding fields `UPDATE` values. See the TierCreate.OnConflict
-// documentation for more info.
-func (u *TierUpsertOne) Update(set func(*TierUpsert)) *TierUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&TierUpsert{UpdateSet: update})
-	}))
-	return u
+// UpdateDiskBandwidthMibps sets the "disk_bandwidth_mibps" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateDiskBandwidthMibps() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskBandwidthMibps()
+	})
 }
 
-// SetName sets the "name" field.
-func (u *TierUpsertOne) SetName(v string) *TierUpsertOne {
+// SetDiskIops sets the "disk_iops" field.
+func (u *TierUpsertOne) SetDiskIops(v int64) *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.SetName(v)
+		s.SetDiskIops(v)
 	})
 }
 
-// UpdateName sets the "name" field to the value that was provided on create.
-func (u *TierUpsertOne) UpdateName() *TierUpsertOne {
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *TierUpsertOne) AddDiskIops(v int64) *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.UpdateName()
+		s.AddDiskIops(v)
 	})
 }
 
-// SetDiskMB sets the "disk_mb" field.
-func (u *TierUpsertOne) SetDiskMB(v int64) *TierUpsertOne {
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateDiskIops() *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.SetDiskMB(v)
+		s.UpdateDiskIops()
 	})
 }
 
-// AddDiskMB adds v to the "disk_mb" field.
-func (u *TierUpsertOne) AddDiskMB(v int64) *TierUpsertOne {
+// SetAPIRequestsPerSecond sets the "api_requests_per_second" field.
+func (u *TierUpsertOne) SetAPIRequestsPerSecond(v int64) *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.AddDiskMB(v)
+		s.SetAPIRequestsPerSecond(v)
 	})
 }
 
-// UpdateDiskMB sets the "disk_mb" field to the value that was provided on create.
-func (u *TierUpsertOne) UpdateDiskMB() *TierUpsertOne {
+// AddAPIRequestsPerSecond adds v to the "api_requests_per_second" field.
+func (u *TierUpsertOne) AddAPIRequestsPerSecond(v int64) *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.UpdateDiskMB()
+		s.AddAPIRequestsPerSecond(v)
 	})
 }
 
-// SetConcurrentInstances sets the "concurrent_instances" field.
-func (u *TierUpsertOne) SetConcurrentInstances(v int64) *TierUpsertOne {
+// UpdateAPIRequestsPerSecond sets the "api_requests_per_second" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateAPIRequestsPerSecond() *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.SetConcurrentInstances(v)
+		s.UpdateAPIRequestsPerSecond()
 	})
 }
 
-// AddConcurrentInstances adds v to the "concurrent_instances" field.
-func (u *TierUpsertOne) AddConcurrentInstances(v int64) *TierUpsertOne {
+// SetAPIRequestsBurst sets the "api_requests_burst" field.
+func (u *TierUpsertOne) SetAPIRequestsBurst(v int64) *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.AddConcurrentInstances(v)
+		s.SetAPIRequestsBurst(v)
 	})
 }
 
-// UpdateConcurrentInstances sets the "concurrent_instances" field to the value that was provided on create.
-func (u *TierUpsertOne) UpdateConcurrentInstances() *TierUpsertOne {
+// AddAPIRequestsBurst adds v to the "api_requests_burst" field.
+func (u *TierUpsertOne) AddAPIRequestsBurst(v int64) *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.UpdateConcurrentInstances()
+		s.AddAPIRequestsBurst(v)
 	})
 }
 
-// SetMaxLengthHours sets the "max_length_hours" field.
-func (u *TierUpsertOne) SetMaxLengthHours(v int64) *TierUpsertOne {
+// UpdateAPIRequestsBurst sets the "api_requests_burst" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateAPIRequestsBurst() *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.SetMaxLengthHours(v)
+		s.UpdateAPIRequestsBurst()
 	})
 }
 
-// AddMaxLengthHours adds v to the "max_length_hours" field.
-func (u *TierUpsertOne) AddMaxLengthHours(v int64) *TierUpsertOne {
+// SetConcurrentTemplateBuilds sets the "concurrent_template_builds" field.
+func (u *TierUpsertOne) SetConcurrentTemplateBuilds(v int64) *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.AddMaxLengthHours(v)
+		s.SetConcurrentTemplateBuilds(v)
 	})
 }
 
-// UpdateMaxLengthHours sets the "max_length_hours" field to the value that was provided on create.
-func (u *TierUpsertOne) UpdateMaxLengthHours() *TierUpsertOne {
+// AddConcurrentTemplateBuilds adds v to the "concurrent_template_builds" field.
+func (u *TierUpsertOne) AddConcurrentTemplateBuilds(v int64) *TierUpsertOne {
 	return u.Update(func(s *TierUpsert) {
-		s.UpdateMaxLengthHours()
+		s.AddConcurrentTemplateBuilds(v)
+	})
+}
+
+// UpdateConcurrentTemplateBuilds sets the "concurrent_template_builds" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateConcurrentTemplateBuilds() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateConcurrentTemplateBuilds()
+	})
+}
+
+// SetBuildPriority sets the "build_priority" field.
+func (u *TierUpsertOne) SetBuildPriority(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.SetBuildPriority(v)
+	})
+}
+
+// AddBuildPriority adds v to the "build_priority" field.
+func (u *TierUpsertOne) AddBuildPriority(v int64) *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.AddBuildPriority(v)
+	})
+}
+
+// UpdateBuildPriority sets the "build_priority" field to the value that was provided on create.
+func (u *TierUpsertOne) UpdateBuildPriority() *TierUpsertOne {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateBuildPriority()
 	})
 }
 
@@ -485,6 +1438,7 @@ func (tcb *TierCreateBulk) Save(ctx context.Context) ([]*Tier, error) {
 	for i := range tcb.builders {
 		func(i int, root context.Context) {
 			builder := tcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*TierMutation)
 				if !ok {
@@ -719,6 +1673,321 @@ func (u *TierUpsertBulk) UpdateMaxLengthHours() *TierUpsertBulk {
 	})
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (u *TierUpsertBulk) SetMaxVcpu(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxVcpu(v)
+	})
+}
+
+// AddMaxVcpu adds v to the "max_vcpu" field.
+func (u *TierUpsertBulk) AddMaxVcpu(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxVcpu(v)
+	})
+}
+
+// UpdateMaxVcpu sets the "max_vcpu" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxVcpu() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxVcpu()
+	})
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (u *TierUpsertBulk) SetMaxRAMMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxRAMMB(v)
+	})
+}
+
+// AddMaxRAMMB adds v to the "max_ram_mb" field.
+func (u *TierUpsertBulk) AddMaxRAMMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxRAMMB(v)
+	})
+}
+
+// UpdateMaxRAMMB sets the "max_ram_mb" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxRAMMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxRAMMB()
+	})
+}
+
+// SetConcurrentVcpus sets the "concurrent_vcpus" field.
+func (u *TierUpsertBulk) SetConcurrentVcpus(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetConcurrentVcpus(v)
+	})
+}
+
+// AddConcurrentVcpus adds v to the "concurrent_vcpus" field.
+func (u *TierUpsertBulk) AddConcurrentVcpus(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddConcurrentVcpus(v)
+	})
+}
+
+// UpdateConcurrentVcpus sets the "concurrent_vcpus" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateConcurrentVcpus() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateConcurrentVcpus()
+	})
+}
+
+// SetConcurrentRAMMB sets the "concurrent_ram_mb" field.
+func (u *TierUpsertBulk) SetConcurrentRAMMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetConcurrentRAMMB(v)
+	})
+}
+
+// AddConcurrentRAMMB adds v to the "concurrent_ram_mb" field.
+func (u *TierUpsertBulk) AddConcurrentRAMMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddConcurrentRAMMB(v)
+	})
+}
+
+// UpdateConcurrentRAMMB sets the "concurrent_ram_mb" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateConcurrentRAMMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateConcurrentRAMMB()
+	})
+}
+
+// SetPausedStorageMB sets the "paused_storage_mb" field.
+func (u *TierUpsertBulk) SetPausedStorageMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetPausedStorageMB(v)
+	})
+}
+
+// AddPausedStorageMB adds v to the "paused_storage_mb" field.
+func (u *TierUpsertBulk) AddPausedStorageMB(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddPausedStorageMB(v)
+	})
+}
+
+// UpdatePausedStorageMB sets the "paused_storage_mb" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdatePausedStorageMB() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdatePausedStorageMB()
+	})
+}
+
+// SetPausedRetentionHours sets the "paused_retention_hours" field.
+func (u *TierUpsertBulk) SetPausedRetentionHours(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetPausedRetentionHours(v)
+	})
+}
+
+// AddPausedRetentionHours adds v to the "paused_retention_hours" field.
+func (u *TierUpsertBulk) AddPausedRetentionHours(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddPausedRetentionHours(v)
+	})
+}
+
+// UpdatePausedRetentionHours sets the "paused_retention_hours" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdatePausedRetentionHours() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdatePausedRetentionHours()
+	})
+}
+
+// SetMaxCheckpoints sets the "max_checkpoints" field.
+func (u *TierUpsertBulk) SetMaxCheckpoints(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetMaxCheckpoints(v)
+	})
+}
+
+// AddMaxCheckpoints adds v to the "max_checkpoints" field.
+func (u *TierUpsertBulk) AddMaxCheckpoints(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddMaxCheckpoints(v)
+	})
+}
+
+// UpdateMaxCheckpoints sets the "max_checkpoints" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateMaxCheckpoints() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateMaxCheckpoints()
+	})
+}
+
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (u *TierUpsertBulk) SetNetworkBandwidthMibps(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkBandwidthMibps(v)
+	})
+}
+
+// AddNetworkBandwidthMibps adds v to the "network_bandwidth_mibps" field.
+func (u *TierUpsertBulk) AddNetworkBandwidthMibps(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkBandwidthMibps(v)
+	})
+}
+
+// UpdateNetworkBandwidthMibps sets the "network_bandwidth_mibps" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateNetworkBandwidthMibps() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkBandwidthMibps()
+	})
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (u *TierUpsertBulk) SetNetworkOps(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetNetworkOps(v)
+	})
+}
+
+// AddNetworkOps adds v to the "network_ops" field.
+func (u *TierUpsertBulk) AddNetworkOps(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddNetworkOps(v)
+	})
+}
+
+// UpdateNetworkOps sets the "network_ops" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateNetworkOps() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateNetworkOps()
+	})
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (u *TierUpsertBulk) SetDiskBandwidthMibps(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskBandwidthMibps(v)
+	})
+}
+
+// AddDiskBandwidthMibps adds v to the "disk_bandwidth_mibps" field.
+func (u *TierUpsertBulk) AddDiskBandwidthMibps(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskBandwidthMibps(v)
+	})
+}
+
+// UpdateDiskBandwidthMibps sets the "disk_bandwidth_mibps" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateDiskBandwidthMibps() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskBandwidthMibps()
+	})
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (u *TierUpsertBulk) SetDiskIops(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetDiskIops(v)
+	})
+}
+
+// AddDiskIops adds v to the "disk_iops" field.
+func (u *TierUpsertBulk) AddDiskIops(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddDiskIops(v)
+	})
+}
+
+// UpdateDiskIops sets the "disk_iops" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateDiskIops() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateDiskIops()
+	})
+}
+
+// SetAPIRequestsPerSecond sets the "api_requests_per_second" field.
+func (u *TierUpsertBulk) SetAPIRequestsPerSecond(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetAPIRequestsPerSecond(v)
+	})
+}
+
+// AddAPIRequestsPerSecond adds v to the "api_requests_per_second" field.
+func (u *TierUpsertBulk) AddAPIRequestsPerSecond(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddAPIRequestsPerSecond(v)
+	})
+}
+
+// UpdateAPIRequestsPerSecond sets the "api_requests_per_second" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateAPIRequestsPerSecond() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateAPIRequestsPerSecond()
+	})
+}
+
+// SetAPIRequestsBurst sets the "api_requests_burst" field.
+func (u *TierUpsertBulk) SetAPIRequestsBurst(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetAPIRequestsBurst(v)
+	})
+}
+
+// AddAPIRequestsBurst adds v to the "api_requests_burst" field.
+func (u *TierUpsertBulk) AddAPIRequestsBurst(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddAPIRequestsBurst(v)
+	})
+}
+
+// UpdateAPIRequestsBurst sets the "api_requests_burst" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateAPIRequestsBurst() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateAPIRequestsBurst()
+	})
+}
+
+// SetConcurrentTemplateBuilds sets the "concurrent_template_builds" field.
+func (u *TierUpsertBulk) SetConcurrentTemplateBuilds(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetConcurrentTemplateBuilds(v)
+	})
+}
+
+// AddConcurrentTemplateBuilds adds v to the "concurrent_template_builds" field.
+func (u *TierUpsertBulk) AddConcurrentTemplateBuilds(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddConcurrentTemplateBuilds(v)
+	})
+}
+
+// UpdateConcurrentTemplateBuilds sets the "concurrent_template_builds" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateConcurrentTemplateBuilds() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateConcurrentTemplateBuilds()
+	})
+}
+
+// SetBuildPriority sets the "build_priority" field.
+func (u *TierUpsertBulk) SetBuildPriority(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.SetBuildPriority(v)
+	})
+}
+
+// AddBuildPriority adds v to the "build_priority" field.
+func (u *TierUpsertBulk) AddBuildPriority(v int64) *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.AddBuildPriority(v)
+	})
+}
+
+// UpdateBuildPriority sets the "build_priority" field to the value that was provided on create.
+func (u *TierUpsertBulk) UpdateBuildPriority() *TierUpsertBulk {
+	return u.Update(func(s *TierUpsert) {
+		s.UpdateBuildPriority()
+	})
+}
+
 // Exec executes the query.
 func (u *TierUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -108,6 +108,321 @@ func (tu *TierUpdate) AddMaxLengthHours(i int64) *TierUpdate {
 	return tu
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (tu *TierUpdate) SetMaxVcpu(i int64) *TierUpdate {
+	tu.mutation.ResetMaxVcpu()
+	tu.mutation.SetMaxVcpu(i)
+	return tu
+}
+
+// SetNillableMaxVcpu sets the "max_vcpu" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxVcpu(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxVcpu(*i)
+	}
+	return tu
+}
+
+// AddMaxVcpu adds i to the "max_vcpu" field.
+func (tu *TierUpdate) AddMaxVcpu(i int64) *TierUpdate {
+	tu.mutation.AddMaxVcpu(i)
+	return tu
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (tu *TierUpdate) SetMaxRAMMB(i int64) *TierUpdate {
+	tu.mutation.ResetMaxRAMMB()
+	tu.mutation.SetMaxRAMMB(i)
+	return tu
+}
+
+// SetNillableMaxRAMMB sets the "max_ram_mb" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxRAMMB(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxRAMMB(*i)
+	}
+	return tu
+}
+
+// AddMaxRAMMB adds i to the "max_ram_mb" field.
+func (tu *TierUpdate) AddMaxRAMMB(i int64) *TierUpdate {
+	tu.mutation.AddMaxRAMMB(i)
+	return tu
+}
+
+// SetConcurrentVcpus sets the "concurrent_vcpus" field.
+func (tu *TierUpdate) SetConcurrentVcpus(i int64) *TierUpdate {
+	tu.mutation.ResetConcurrentVcpus()
+	tu.mutation.SetConcurrentVcpus(i)
+	return tu
+}
+
+// SetNillableConcurrentVcpus sets the "concurrent_vcpus" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableConcurrentVcpus(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetConcurrentVcpus(*i)
+	}
+	return tu
+}
+
+// AddConcurrentVcpus adds i to the "concurrent_vcpus" field.
+func (tu *TierUpdate) AddConcurrentVcpus(i int64) *TierUpdate {
+	tu.mutation.AddConcurrentVcpus(i)
+	return tu
+}
+
+// SetConcurrentRAMMB sets the "concurrent_ram_mb" field.
+func (tu *TierUpdate) SetConcurrentRAMMB(i int64) *TierUpdate {
+	tu.mutation.ResetConcurrentRAMMB()
+	tu.mutation.SetConcurrentRAMMB(i)
+	return tu
+}
+
+// SetNillableConcurrentRAMMB sets the "concurrent_ram_mb" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableConcurrentRAMMB(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetConcurrentRAMMB(*i)
+	}
+	return tu
+}
+
+// AddConcurrentRAMMB adds i to the "concurrent_ram_mb" field.
+func (tu *TierUpdate) AddConcurrentRAMMB(i int64) *TierUpdate {
+	tu.mutation.AddConcurrentRAMMB(i)
+	return tu
+}
+
+// SetPausedStorageMB sets the "paused_storage_mb" field.
+func (tu *TierUpdate) SetPausedStorageMB(i int64) *TierUpdate {
+	tu.mutation.ResetPausedStorageMB()
+	tu.mutation.SetPausedStorageMB(i)
+	return tu
+}
+
+// SetNillablePausedStorageMB sets the "paused_storage_mb" field if the given value is not nil.
+func (tu *TierUpdate) SetNillablePausedStorageMB(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetPausedStorageMB(*i)
+	}
+	return tu
+}
+
+// AddPausedStorageMB adds i to the "paused_storage_mb" field.
+func (tu *TierUpdate) AddPausedStorageMB(i int64) *TierUpdate {
+	tu.mutation.AddPausedStorageMB(i)
+	return tu
+}
+
+// SetPausedRetentionHours sets the "paused_retention_hours" field.
+func (tu *TierUpdate) SetPausedRetentionHours(i int64) *TierUpdate {
+	tu.mutation.ResetPausedRetentionHours()
+	tu.mutation.SetPausedRetentionHours(i)
+	return tu
+}
+
+// SetNillablePausedRetentionHours sets the "paused_retention_hours" field if the given value is not nil.
+func (tu *TierUpdate) SetNillablePausedRetentionHours(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetPausedRetentionHours(*i)
+	}
+	return tu
+}
+
+// AddPausedRetentionHours adds i to the "paused_retention_hours" field.
+func (tu *TierUpdate) AddPausedRetentionHours(i int64) *TierUpdate {
+	tu.mutation.AddPausedRetentionHours(i)
+	return tu
+}
+
+// SetMaxCheckpoints sets the "max_checkpoints" field.
+func (tu *TierUpdate) SetMaxCheckpoints(i int64) *TierUpdate {
+	tu.mutation.ResetMaxCheckpoints()
+	tu.mutation.SetMaxCheckpoints(i)
+	return tu
+}
+
+// SetNillableMaxCheckpoints sets the "max_checkpoints" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableMaxCheckpoints(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetMaxCheckpoints(*i)
+	}
+	return tu
+}
+
+// AddMaxCheckpoints adds i to the "max_checkpoints" field.
+func (tu *TierUpdate) AddMaxCheckpoints(i int64) *TierUpdate {
+	tu.mutation.AddMaxCheckpoints(i)
+	return tu
+}
+
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (tu *TierUpdate) SetNetworkBandwidthMibps(i int64) *TierUpdate {
+	tu.mutation.ResetNetworkBandwidthMibps()
+	tu.mutation.SetNetworkBandwidthMibps(i)
+	return tu
+}
+
+// SetNillableNetworkBandwidthMibps sets the "network_bandwidth_mibps" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableNetworkBandwidthMibps(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetNetworkBandwidthMibps(*i)
+	}
+	return tu
+}
+
+// AddNetworkBandwidthMibps adds i to the "network_bandwidth_mibps" field.
+func (tu *TierUpdate) AddNetworkBandwidthMibps(i int64) *TierUpdate {
+	tu.mutation.AddNetworkBandwidthMibps(i)
+	return tu
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (tu *TierUpdate) SetNetworkOps(i int64) *TierUpdate {
+	tu.mutation.ResetNetworkOps()
+	tu.mutation.SetNetworkOps(i)
+	return tu
+}
+
+// SetNillableNetworkOps sets the "network_ops" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableNetworkOps(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetNetworkOps(*i)
+	}
+	return tu
+}
+
+// AddNetworkOps adds i to the "network_ops" field.
+func (tu *TierUpdate) AddNetworkOps(i int64) *TierUpdate {
+	tu.mutation.AddNetworkOps(i)
+	return tu
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (tu *TierUpdate) SetDiskBandwidthMibps(i int64) *TierUpdate {
+	tu.mutation.ResetDiskBandwidthMibps()
+	tu.mutation.SetDiskBandwidthMibps(i)
+	return tu
+}
+
+// SetNillableDiskBandwidthMibps sets the "disk_bandwidth_mibps" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableDiskBandwidthMibps(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetDiskBandwidthMibps(*i)
+	}
+	return tu
+}
+
+// AddDiskBandwidthMibps adds i to the "disk_bandwidth_mibps" field.
+func (tu *TierUpdate) AddDiskBandwidthMibps(i int64) *TierUpdate {
+	tu.mutation.AddDiskBandwidthMibps(i)
+	return tu
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (tu *TierUpdate) SetDiskIops(i int64) *TierUpdate {
+	tu.mutation.ResetDiskIops()
+	tu.mutation.SetDiskIops(i)
+	return tu
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableDiskIops(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetDiskIops(*i)
+	}
+	return tu
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (tu *TierUpdate) AddDiskIops(i int64) *TierUpdate {
+	tu.mutation.AddDiskIops(i)
+	return tu
+}
+
+// SetAPIRequestsPerSecond sets the "api_requests_per_second" field.
+func (tu *TierUpdate) SetAPIRequestsPerSecond(i int64) *TierUpdate {
+	tu.mutation.ResetAPIRequestsPerSecond()
+	tu.mutation.SetAPIRequestsPerSecond(i)
+	return tu
+}
+
+// SetNillableAPIRequestsPerSecond sets the "api_requests_per_second" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableAPIRequestsPerSecond(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetAPIRequestsPerSecond(*i)
+	}
+	return tu
+}
+
+// AddAPIRequestsPerSecond adds i to the "api_requests_per_second" field.
+func (tu *TierUpdate) AddAPIRequestsPerSecond(i int64) *TierUpdate {
+	tu.mutation.AddAPIRequestsPerSecond(i)
+	return tu
+}
+
+// SetAPIRequestsBurst sets the "api_requests_burst" field.
+func (tu *TierUpdate) SetAPIRequestsBurst(i int64) *TierUpdate {
+	tu.mutation.ResetAPIRequestsBurst()
+	tu.mutation.SetAPIRequestsBurst(i)
+	return tu
+}
+
+// SetNillableAPIRequestsBurst sets the "api_requests_burst" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableAPIRequestsBurst(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetAPIRequestsBurst(*i)
+	}
+	return tu
+}
+
+// AddAPIRequestsBurst adds i to the "api_requests_burst" field.
+func (tu *TierUpdate) AddAPIRequestsBurst(i int64) *TierUpdate {
+	tu.mutation.AddAPIRequestsBurst(i)
+	return tu
+}
+
+// SetConcurrentTemplateBuilds sets the "concurrent_template_builds" field.
+func (tu *TierUpdate) SetConcurrentTemplateBuilds(i int64) *TierUpdate {
+	tu.mutation.ResetConcurrentTemplateBuilds()
+	tu.mutation.SetConcurrentTemplateBuilds(i)
+	return tu
+}
+
+// SetNillableConcurrentTemplateBuilds sets the "concurrent_template_builds" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableConcurrentTemplateBuilds(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetConcurrentTemplateBuilds(*i)
+	}
+	return tu
+}
+
+// AddConcurrentTemplateBuilds adds i to the "concurrent_template_builds" field.
+func (tu *TierUpdate) AddConcurrentTemplateBuilds(i int64) *TierUpdate {
+	tu.mutation.AddConcurrentTemplateBuilds(i)
+	return tu
+}
+
+// SetBuildPriority sets the "build_priority" field.
+func (tu *TierUpdate) SetBuildPriority(i int64) *TierUpdate {
+	tu.mutation.ResetBuildPriority()
+	tu.mutation.SetBuildPriority(i)
+	return tu
+}
+
+// SetNillableBuildPriority sets the "build_priority" field if the given value is not nil.
+func (tu *TierUpdate) SetNillableBuildPriority(i *int64) *TierUpdate {
+	if i != nil {
+		tu.SetBuildPriority(*i)
+	}
+	return tu
+}
+
+// AddBuildPriority adds i to the "build_priority" field.
+func (tu *TierUpdate) AddBuildPriority(i int64) *TierUpdate {
+	tu.mutation.AddBuildPriority(i)
+	return tu
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tu *TierUpdate) AddTeamIDs(ids ...uuid.UUID) *TierUpdate {
 	tu.mutation.AddTeamIDs(ids...)
@@ -212,6 +527,96 @@ func (tu *TierUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tu.mutation.MaxVcpu(); ok {
+		_spec.SetField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxVcpu(); ok {
+		_spec.AddField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.MaxRAMMB(); ok {
+		_spec.SetField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxRAMMB(); ok {
+		_spec.AddField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.ConcurrentVcpus(); ok {
+		_spec.SetField(tier.FieldConcurrentVcpus, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedConcurrentVcpus(); ok {
+		_spec.AddField(tier.FieldConcurrentVcpus, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.ConcurrentRAMMB(); ok {
+		_spec.SetField(tier.FieldConcurrentRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedConcurrentRAMMB(); ok {
+		_spec.AddField(tier.FieldConcurrentRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.PausedStorageMB(); ok {
+		_spec.SetField(tier.FieldPausedStorageMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedPausedStorageMB(); ok {
+		_spec.AddField(tier.FieldPausedStorageMB, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.PausedRetentionHours(); ok {
+		_spec.SetField(tier.FieldPausedRetentionHours, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedPausedRetentionHours(); ok {
+		_spec.AddField(tier.FieldPausedRetentionHours, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.MaxCheckpoints(); ok {
+		_spec.SetField(tier.FieldMaxCheckpoints, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedMaxCheckpoints(); ok {
+		_spec.AddField(tier.FieldMaxCheckpoints, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.NetworkBandwidthMibps(); ok {
+		_spec.SetField(tier.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedNetworkBandwidthMibps(); ok {
+		_spec.AddField(tier.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.NetworkOps(); ok {
+		_spec.SetField(tier.FieldNetworkOps, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedNetworkOps(); ok {
+		_spec.AddField(tier.FieldNetworkOps, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.DiskBandwidthMibps(); ok {
+		_spec.SetField(tier.FieldDiskBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedDiskBandwidthMibps(); ok {
+		_spec.AddField(tier.FieldDiskBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.DiskIops(); ok {
+		_spec.SetField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedDiskIops(); ok {
+		_spec.AddField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.APIRequestsPerSecond(); ok {
+		_spec.SetField(tier.FieldAPIRequestsPerSecond, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedAPIRequestsPerSecond(); ok {
+		_spec.AddField(tier.FieldAPIRequestsPerSecond, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.APIRequestsBurst(); ok {
+		_spec.SetField(tier.FieldAPIRequestsBurst, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedAPIRequestsBurst(); ok {
+		_spec.AddField(tier.FieldAPIRequestsBurst, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.ConcurrentTemplateBuilds(); ok {
+		_spec.SetField(tier.FieldConcurrentTemplateBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedConcurrentTemplateBuilds(); ok {
+		_spec.AddField(tier.FieldConcurrentTemplateBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.BuildPriority(); ok {
+		_spec.SetField(tier.FieldBuildPriority, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedBuildPriority(); ok {
+		_spec.AddField(tier.FieldBuildPriority, field.TypeInt64, value)
+	}
 	if tu.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -361,6 +766,321 @@ func (tuo *TierUpdateOne) AddMaxLengthHours(i int64) *TierUpdateOne {
 	return tuo
 }
 
+// SetMaxVcpu sets the "max_vcpu" field.
+func (tuo *TierUpdateOne) SetMaxVcpu(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxVcpu()
+	tuo.mutation.SetMaxVcpu(i)
+	return tuo
+}
+
+// SetNillableMaxVcpu sets the "max_vcpu" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxVcpu(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxVcpu(*i)
+	}
+	return tuo
+}
+
+// AddMaxVcpu adds i to the "max_vcpu" field.
+func (tuo *TierUpdateOne) AddMaxVcpu(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxVcpu(i)
+	return tuo
+}
+
+// SetMaxRAMMB sets the "max_ram_mb" field.
+func (tuo *TierUpdateOne) SetMaxRAMMB(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxRAMMB()
+	tuo.mutation.SetMaxRAMMB(i)
+	return tuo
+}
+
+// SetNillableMaxRAMMB sets the "max_ram_mb" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxRAMMB(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxRAMMB(*i)
+	}
+	return tuo
+}
+
+// AddMaxRAMMB adds i to the "max_ram_mb" field.
+func (tuo *TierUpdateOne) AddMaxRAMMB(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxRAMMB(i)
+	return tuo
+}
+
+// SetConcurrentVcpus sets the "concurrent_vcpus" field.
+func (tuo *TierUpdateOne) SetConcurrentVcpus(i int64) *TierUpdateOne {
+	tuo.mutation.ResetConcurrentVcpus()
+	tuo.mutation.SetConcurrentVcpus(i)
+	return tuo
+}
+
+// SetNillableConcurrentVcpus sets the "concurrent_vcpus" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableConcurrentVcpus(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetConcurrentVcpus(*i)
+	}
+	return tuo
+}
+
+// AddConcurrentVcpus adds i to the "concurrent_vcpus" field.
+func (tuo *TierUpdateOne) AddConcurrentVcpus(i int64) *TierUpdateOne {
+	tuo.mutation.AddConcurrentVcpus(i)
+	return tuo
+}
+
+// SetConcurrentRAMMB sets the "concurrent_ram_mb" field.
+func (tuo *TierUpdateOne) SetConcurrentRAMMB(i int64) *TierUpdateOne {
+	tuo.mutation.ResetConcurrentRAMMB()
+	tuo.mutation.SetConcurrentRAMMB(i)
+	return tuo
+}
+
+// SetNillableConcurrentRAMMB sets the "concurrent_ram_mb" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableConcurrentRAMMB(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetConcurrentRAMMB(*i)
+	}
+	return tuo
+}
+
+// AddConcurrentRAMMB adds i to the "concurrent_ram_mb" field.
+func (tuo *TierUpdateOne) AddConcurrentRAMMB(i int64) *TierUpdateOne {
+	tuo.mutation.AddConcurrentRAMMB(i)
+	return tuo
+}
+
+// SetPausedStorageMB sets the "paused_storage_mb" field.
+func (tuo *TierUpdateOne) SetPausedStorageMB(i int64) *TierUpdateOne {
+	tuo.mutation.ResetPausedStorageMB()
+	tuo.mutation.SetPausedStorageMB(i)
+	return tuo
+}
+
+// SetNillablePausedStorageMB sets the "paused_storage_mb" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillablePausedStorageMB(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetPausedStorageMB(*i)
+	}
+	return tuo
+}
+
+// AddPausedStorageMB adds i to the "paused_storage_mb" field.
+func (tuo *TierUpdateOne) AddPausedStorageMB(i int64) *TierUpdateOne {
+	tuo.mutation.AddPausedStorageMB(i)
+	return tuo
+}
+
+// SetPausedRetentionHours sets the "paused_retention_hours" field.
+func (tuo *TierUpdateOne) SetPausedRetentionHours(i int64) *TierUpdateOne {
+	tuo.mutation.ResetPausedRetentionHours()
+	tuo.mutation.SetPausedRetentionHours(i)
+	return tuo
+}
+
+// SetNillablePausedRetentionHours sets the "paused_retention_hours" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillablePausedRetentionHours(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetPausedRetentionHours(*i)
+	}
+	return tuo
+}
+
+// AddPausedRetentionHours adds i to the "paused_retention_hours" field.
+func (tuo *TierUpdateOne) AddPausedRetentionHours(i int64) *TierUpdateOne {
+	tuo.mutation.AddPausedRetentionHours(i)
+	return tuo
+}
+
+// SetMaxCheckpoints sets the "max_checkpoints" field.
+func (tuo *TierUpdateOne) SetMaxCheckpoints(i int64) *TierUpdateOne {
+	tuo.mutation.ResetMaxCheckpoints()
+	tuo.mutation.SetMaxCheckpoints(i)
+	return tuo
+}
+
+// SetNillableMaxCheckpoints sets the "max_checkpoints" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableMaxCheckpoints(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetMaxCheckpoints(*i)
+	}
+	return tuo
+}
+
+// AddMaxCheckpoints adds i to the "max_checkpoints" field.
+func (tuo *TierUpdateOne) AddMaxCheckpoints(i int64) *TierUpdateOne {
+	tuo.mutation.AddMaxCheckpoints(i)
+	return tuo
+}
+
+// SetNetworkBandwidthMibps sets the "network_bandwidth_mibps" field.
+func (tuo *TierUpdateOne) SetNetworkBandwidthMibps(i int64) *TierUpdateOne {
+	tuo.mutation.ResetNetworkBandwidthMibps()
+	tuo.mutation.SetNetworkBandwidthMibps(i)
+	return tuo
+}
+
+// SetNillableNetworkBandwidthMibps sets the "network_bandwidth_mibps" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableNetworkBandwidthMibps(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetNetworkBandwidthMibps(*i)
+	}
+	return tuo
+}
+
+// AddNetworkBandwidthMibps adds i to the "network_bandwidth_mibps" field.
+func (tuo *TierUpdateOne) AddNetworkBandwidthMibps(i int64) *TierUpdateOne {
+	tuo.mutation.AddNetworkBandwidthMibps(i)
+	return tuo
+}
+
+// SetNetworkOps sets the "network_ops" field.
+func (tuo *TierUpdateOne) SetNetworkOps(i int64) *TierUpdateOne {
+	tuo.mutation.ResetNetworkOps()
+	tuo.mutation.SetNetworkOps(i)
+	return tuo
+}
+
+// SetNillableNetworkOps sets the "network_ops" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableNetworkOps(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetNetworkOps(*i)
+	}
+	return tuo
+}
+
+// AddNetworkOps adds i to the "network_ops" field.
+func (tuo *TierUpdateOne) AddNetworkOps(i int64) *TierUpdateOne {
+	tuo.mutation.AddNetworkOps(i)
+	return tuo
+}
+
+// SetDiskBandwidthMibps sets the "disk_bandwidth_mibps" field.
+func (tuo *TierUpdateOne) SetDiskBandwidthMibps(i int64) *TierUpdateOne {
+	tuo.mutation.ResetDiskBandwidthMibps()
+	tuo.mutation.SetDiskBandwidthMibps(i)
+	return tuo
+}
+
+// SetNillableDiskBandwidthMibps sets the "disk_bandwidth_mibps" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableDiskBandwidthMibps(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetDiskBandwidthMibps(*i)
+	}
+	return tuo
+}
+
+// AddDiskBandwidthMibps adds i to the "disk_bandwidth_mibps" field.
+func (tuo *TierUpdateOne) AddDiskBandwidthMibps(i int64) *TierUpdateOne {
+	tuo.mutation.AddDiskBandwidthMibps(i)
+	return tuo
+}
+
+// SetDiskIops sets the "disk_iops" field.
+func (tuo *TierUpdateOne) SetDiskIops(i int64) *TierUpdateOne {
+	tuo.mutation.ResetDiskIops()
+	tuo.mutation.SetDiskIops(i)
+	return tuo
+}
+
+// SetNillableDiskIops sets the "disk_iops" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableDiskIops(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetDiskIops(*i)
+	}
+	return tuo
+}
+
+// AddDiskIops adds i to the "disk_iops" field.
+func (tuo *TierUpdateOne) AddDiskIops(i int64) *TierUpdateOne {
+	tuo.mutation.AddDiskIops(i)
+	return tuo
+}
+
+// SetAPIRequestsPerSecond sets the "api_requests_per_second" field.
+func (tuo *TierUpdateOne) SetAPIRequestsPerSecond(i int64) *TierUpdateOne {
+	tuo.mutation.ResetAPIRequestsPerSecond()
+	tuo.mutation.SetAPIRequestsPerSecond(i)
+	return tuo
+}
+
+// SetNillableAPIRequestsPerSecond sets the "api_requests_per_second" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableAPIRequestsPerSecond(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetAPIRequestsPerSecond(*i)
+	}
+	return tuo
+}
+
+// AddAPIRequestsPerSecond adds i to the "api_requests_per_second" field.
+func (tuo *TierUpdateOne) AddAPIRequestsPerSecond(i int64) *TierUpdateOne {
+	tuo.mutation.AddAPIRequestsPerSecond(i)
+	return tuo
+}
+
+// SetAPIRequestsBurst sets the "api_requests_burst" field.
+func (tuo *TierUpdateOne) SetAPIRequestsBurst(i int64) *TierUpdateOne {
+	tuo.mutation.ResetAPIRequestsBurst()
+	tuo.mutation.SetAPIRequestsBurst(i)
+	return tuo
+}
+
+// SetNillableAPIRequestsBurst sets the "api_requests_burst" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableAPIRequestsBurst(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetAPIRequestsBurst(*i)
+	}
+	return tuo
+}
+
+// AddAPIRequestsBurst adds i to the "api_requests_burst" field.
+func (tuo *TierUpdateOne) AddAPIRequestsBurst(i int64) *TierUpdateOne {
+	tuo.mutation.AddAPIRequestsBurst(i)
+	return tuo
+}
+
+// SetConcurrentTemplateBuilds sets the "concurrent_template_builds" field.
+func (tuo *TierUpdateOne) SetConcurrentTemplateBuilds(i int64) *TierUpdateOne {
+	tuo.mutation.ResetConcurrentTemplateBuilds()
+	tuo.mutation.SetConcurrentTemplateBuilds(i)
+	return tuo
+}
+
+// SetNillableConcurrentTemplateBuilds sets the "concurrent_template_builds" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableConcurrentTemplateBuilds(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetConcurrentTemplateBuilds(*i)
+	}
+	return tuo
+}
+
+// AddConcurrentTemplateBuilds adds i to the "concurrent_template_builds" field.
+func (tuo *TierUpdateOne) AddConcurrentTemplateBuilds(i int64) *TierUpdateOne {
+	tuo.mutation.AddConcurrentTemplateBuilds(i)
+	return tuo
+}
+
+// SetBuildPriority sets the "build_priority" field.
+func (tuo *TierUpdateOne) SetBuildPriority(i int64) *TierUpdateOne {
+	tuo.mutation.ResetBuildPriority()
+	tuo.mutation.SetBuildPriority(i)
+	return tuo
+}
+
+// SetNillableBuildPriority sets the "build_priority" field if the given value is not nil.
+func (tuo *TierUpdateOne) SetNillableBuildPriority(i *int64) *TierUpdateOne {
+	if i != nil {
+		tuo.SetBuildPriority(*i)
+	}
+	return tuo
+}
+
+// AddBuildPriority adds i to the "build_priority" field.
+func (tuo *TierUpdateOne) AddBuildPriority(i int64) *TierUpdateOne {
+	tuo.mutation.AddBuildPriority(i)
+	return tuo
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tuo *TierUpdateOne) AddTeamIDs(ids ...uuid.UUID) *TierUpdateOne {
 	tuo.mutation.AddTeamIDs(ids...)
@@ -495,6 +1215,96 @@ func (tuo *TierUpdateOne) sqlSave(ctx context.Context) (_node *Tier, err error)
 	if value, ok := tuo.mutation.AddedMaxLengthHours(); ok {
 		_spec.AddField(tier.FieldMaxLengthHours, field.TypeInt64, value)
 	}
+	if value, ok := tuo.mutation.MaxVcpu(); ok {
+		_spec.SetField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxVcpu(); ok {
+		_spec.AddField(tier.FieldMaxVcpu, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.MaxRAMMB(); ok {
+		_spec.SetField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxRAMMB(); ok {
+		_spec.AddField(tier.FieldMaxRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.ConcurrentVcpus(); ok {
+		_spec.SetField(tier.FieldConcurrentVcpus, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedConcurrentVcpus(); ok {
+		_spec.AddField(tier.FieldConcurrentVcpus, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.ConcurrentRAMMB(); ok {
+		_spec.SetField(tier.FieldConcurrentRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedConcurrentRAMMB(); ok {
+		_spec.AddField(tier.FieldConcurrentRAMMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.PausedStorageMB(); ok {
+		_spec.SetField(tier.FieldPausedStorageMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedPausedStorageMB(); ok {
+		_spec.AddField(tier.FieldPausedStorageMB, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.PausedRetentionHours(); ok {
+		_spec.SetField(tier.FieldPausedRetentionHours, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedPausedRetentionHours(); ok {
+		_spec.AddField(tier.FieldPausedRetentionHours, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.MaxCheckpoints(); ok {
+		_spec.SetField(tier.FieldMaxCheckpoints, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedMaxCheckpoints(); ok {
+		_spec.AddField(tier.FieldMaxCheckpoints, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.NetworkBandwidthMibps(); ok {
+		_spec.SetField(tier.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedNetworkBandwidthMibps(); ok {
+		_spec.AddField(tier.FieldNetworkBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.NetworkOps(); ok {
+		_spec.SetField(tier.FieldNetworkOps, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedNetworkOps(); ok {
+		_spec.AddField(tier.FieldNetworkOps, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.DiskBandwidthMibps(); ok {
+		_spec.SetField(tier.FieldDiskBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedDiskBandwidthMibps(); ok {
+		_spec.AddField(tier.FieldDiskBandwidthMibps, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.DiskIops(); ok {
+		_spec.SetField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedDiskIops(); ok {
+		_spec.AddField(tier.FieldDiskIops, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.APIRequestsPerSecond(); ok {
+		_spec.SetField(tier.FieldAPIRequestsPerSecond, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedAPIRequestsPerSecond(); ok {
+		_spec.AddField(tier.FieldAPIRequestsPerSecond, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.APIRequestsBurst(); ok {
+		_spec.SetField(tier.FieldAPIRequestsBurst, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedAPIRequestsBurst(); ok {
+		_spec.AddField(tier.FieldAPIRequestsBurst, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.ConcurrentTemplateBuilds(); ok {
+		_spec.SetField(tier.FieldConcurrentTemplateBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedConcurrentTemplateBuilds(); ok {
+		_spec.AddField(tier.FieldConcurrentTemplateBuilds, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.BuildPriority(); ok {
+		_spec.SetField(tier.FieldBuildPriority, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedBuildPriority(); ok {
+		_spec.AddField(tier.FieldBuildPriority, field.TypeInt64, value)
+	}
 	if tuo.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/google/uuid"
+)
+
+// WarmPool is the model entity for the WarmPool schema.
+type WarmPool struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID uuid.UUID `json:"team_id,omitempty"`
+	// EnvID holds the value of the "env_id" field.
+	EnvID string `json:"env_id,omitempty"`
+	// Number of pre-warmed sandboxes kept ready for the team
+	Size int32 `json:"size,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WarmPoolQuery when eager-loading is set.
+	Edges        WarmPoolEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WarmPoolEdges holds the relations/edges for other nodes in the graph.
+type WarmPoolEdges struct {
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
+	// Env holds the value of the env edge.
+	Env *Env `json:"env,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WarmPoolEdges) TeamOrErr() (*Team, error) {
+	if e.loadedTypes[0] {
+		if e.Team == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: team.Label}
+		}
+		return e.Team, nil
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// EnvOrErr returns the Env value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WarmPoolEdges) EnvOrErr() (*Env, error) {
+	if e.loadedTypes[1] {
+		if e.Env == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: env.Label}
+		}
+		return e.Env, nil
+	}
+	return nil, &NotLoadedError{edge: "env"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WarmPool) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case warmpool.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case warmpool.FieldEnvID:
+			values[i] = new(sql.NullString)
+		case warmpool.FieldCreatedAt, warmpool.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case warmpool.FieldID, warmpool.FieldTeamID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WarmPool fields.
+func (wp *WarmPool) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case warmpool.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wp.ID = *value
+			}
+		case warmpool.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wp.CreatedAt = value.Time
+			}
+		case warmpool.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wp.UpdatedAt = value.Time
+			}
+		case warmpool.FieldTeamID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value != nil {
+				wp.TeamID = *value
+			}
+		case warmpool.FieldEnvID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field env_id", values[i])
+			} else if value.Valid {
+				wp.EnvID = value.String
+			}
+		case warmpool.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				wp.Size = int32(value.Int64)
+			}
+		default:
+			wp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WarmPool.
+// This includes values selected through modifiers, order, etc.
+func (wp *WarmPool) Value(name string) (ent.Value, error) {
+	return wp.selectValues.Get(name)
+}
+
+// QueryTeam queries the "team" edge of the WarmPool entity.
+func (wp *WarmPool) QueryTeam() *TeamQuery {
+	return NewWarmPoolClient(wp.config).QueryTeam(wp)
+}
+
+// QueryEnv queries the "env" edge of the WarmPool entity.
+func (wp *WarmPool) QueryEnv() *EnvQuery {
+	return NewWarmPoolClient(wp.config).QueryEnv(wp)
+}
+
+// Update returns a builder for updating this WarmPool.
+// Note that you need to call WarmPool.Unwrap() before calling this method if this WarmPool
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wp *WarmPool) Update() *WarmPoolUpdateOne {
+	return NewWarmPoolClient(wp.config).UpdateOne(wp)
+}
+
+// Unwrap unwraps the WarmPool entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wp *WarmPool) Unwrap() *WarmPool {
+	_tx, ok := wp.config.driver.(*txDriver)
+	if !ok {
+		panic("models: WarmPool is not a transactional entity")
+	}
+	wp.config.driver = _tx.drv
+	return wp
+}
+
+// String implements the fmt.Stringer.
+func (wp *WarmPool) String() string {
+	var builder strings.Builder
+	builder.WriteString("WarmPool(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", wp.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("env_id=")
+	builder.WriteString(wp.EnvID)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", wp.Size))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WarmPools is a parsable slice of WarmPool.
+type WarmPools []*WarmPool
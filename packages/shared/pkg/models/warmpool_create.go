@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/google/uuid"
+)
+
+// WarmPoolCreate is the builder for creating a WarmPool entity.
+type WarmPoolCreate struct {
+	config
+	mutation *WarmPoolMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wpc *WarmPoolCreate) SetCreatedAt(t time.Time) *WarmPoolCreate {
+	wpc.mutation.SetCreatedAt(t)
+	return wpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wpc *WarmPoolCreate) SetNillableCreatedAt(t *time.Time) *WarmPoolCreate {
+	if t != nil {
+		wpc.SetCreatedAt(*t)
+	}
+	return wpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wpc *WarmPoolCreate) SetUpdatedAt(t time.Time) *WarmPoolCreate {
+	wpc.mutation.SetUpdatedAt(t)
+	return wpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wpc *WarmPoolCreate) SetNillableUpdatedAt(t *time.Time) *WarmPoolCreate {
+	if t != nil {
+		wpc.SetUpdatedAt(*t)
+	}
+	return wpc
+}
+
+// SetTeamID sets the "team_id" field.
+func (wpc *WarmPoolCreate) SetTeamID(u uuid.UUID) *WarmPoolCreate {
+	wpc.mutation.SetTeamID(u)
+	return wpc
+}
+
+// SetEnvID sets the "env_id" field.
+func (wpc *WarmPoolCreate) SetEnvID(s string) *WarmPoolCreate {
+	wpc.mutation.SetEnvID(s)
+	return wpc
+}
+
+// SetSize sets the "size" field.
+func (wpc *WarmPoolCreate) SetSize(i int32) *WarmPoolCreate {
+	wpc.mutation.SetSize(i)
+	return wpc
+}
+
+// SetID sets the "id" field.
+func (wpc *WarmPoolCreate) SetID(u uuid.UUID) *WarmPoolCreate {
+	wpc.mutation.SetID(u)
+	return wpc
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (wpc *WarmPoolCreate) SetTeam(t *Team) *WarmPoolCreate {
+	return wpc.SetTeamID(t.ID)
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (wpc *WarmPoolCreate) SetEnv(e *Env) *WarmPoolCreate {
+	return wpc.SetEnvID(e.ID)
+}
+
+// Mutation returns the WarmPoolMutation object of the builder.
+func (wpc *WarmPoolCreate) Mutation() *WarmPoolMutation {
+	return wpc.mutation
+}
+
+// Save creates the WarmPool in the database.
+func (wpc *WarmPoolCreate) Save(ctx context.Context) (*WarmPool, error) {
+	wpc.defaults()
+	return withHooks(ctx, wpc.sqlSave, wpc.mutation, wpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wpc *WarmPoolCreate) SaveX(ctx context.Context) *WarmPool {
+	v, err := wpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wpc *WarmPoolCreate) Exec(ctx context.Context) error {
+	_, err := wpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpc *WarmPoolCreate) ExecX(ctx context.Context) {
+	if err := wpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpc *WarmPoolCreate) defaults() {
+	if _, ok := wpc.mutation.CreatedAt(); !ok {
+		v := warmpool.DefaultCreatedAt()
+		wpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wpc.mutation.UpdatedAt(); !ok {
+		v := warmpool.DefaultUpdatedAt()
+		wpc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpc *WarmPoolCreate) check() error {
+	if _, ok := wpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`models: missing required field "WarmPool.created_at"`)}
+	}
+	if _, ok := wpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`models: missing required field "WarmPool.updated_at"`)}
+	}
+	if _, ok := wpc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team_id", err: errors.New(`models: missing required field "WarmPool.team_id"`)}
+	}
+	if _, ok := wpc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env_id", err: errors.New(`models: missing required field "WarmPool.env_id"`)}
+	}
+	if _, ok := wpc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`models: missing required field "WarmPool.size"`)}
+	}
+	if _, ok := wpc.mutation.TeamID(); !ok {
+		return &ValidationError{Name: "team", err: errors.New(`models: missing required edge "WarmPool.team"`)}
+	}
+	if _, ok := wpc.mutation.EnvID(); !ok {
+		return &ValidationError{Name: "env", err: errors.New(`models: missing required edge "WarmPool.env"`)}
+	}
+	return nil
+}
+
+func (wpc *WarmPoolCreate) sqlSave(ctx context.Context) (*WarmPool, error) {
+	if err := wpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wpc.mutation.id = &_node.ID
+	wpc.mutation.done = true
+	return _node, nil
+}
+
+func (wpc *WarmPoolCreate) createSpec() (*WarmPool, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WarmPool{config: wpc.config}
+		_spec = sqlgraph.NewCreateSpec(warmpool.Table, sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = wpc.schemaConfig.WarmPool
+	_spec.OnConflict = wpc.conflict
+	if id, ok := wpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wpc.mutation.CreatedAt(); ok {
+		_spec.SetField(warmpool.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(warmpool.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wpc.mutation.Size(); ok {
+		_spec.SetField(warmpool.FieldSize, field.TypeInt32, value)
+		_node.Size = value
+	}
+	if nodes := wpc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.TeamTable,
+			Columns: []string{warmpool.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wpc.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wpc.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.EnvTable,
+			Columns: []string{warmpool.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wpc.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WarmPool.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WarmPoolUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wpc *WarmPoolCreate) OnConflict(opts ...sql.ConflictOption) *WarmPoolUpsertOne {
+	wpc.conflict = opts
+	return &WarmPoolUpsertOne{
+		create: wpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WarmPool.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wpc *WarmPoolCreate) OnConflictColumns(columns ...string) *WarmPoolUpsertOne {
+	wpc.conflict = append(wpc.conflict, sql.ConflictColumns(columns...))
+	return &WarmPoolUpsertOne{
+		create: wpc,
+	}
+}
+
+type (
+	// WarmPoolUpsertOne is the builder for "upsert"-ing
+	//  one WarmPool node.
+	WarmPoolUpsertOne struct {
+		create *WarmPoolCreate
+	}
+
+	// WarmPoolUpsert is the "OnConflict" setter.
+	WarmPoolUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WarmPoolUpsert) SetUpdatedAt(v time.Time) *WarmPoolUpsert {
+	u.Set(warmpool.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WarmPoolUpsert) UpdateUpdatedAt() *WarmPoolUpsert {
+	u.SetExcluded(warmpool.FieldUpdatedAt)
+	return u
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *WarmPoolUpsert) SetTeamID(v uuid.UUID) *WarmPoolUpsert {
+	u.Set(warmpool.FieldTeamID, v)
+	return u
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *WarmPoolUpsert) UpdateTeamID() *WarmPoolUpsert {
+	u.SetExcluded(warmpool.FieldTeamID)
+	return u
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *WarmPoolUpsert) SetEnvID(v string) *WarmPoolUpsert {
+	u.Set(warmpool.FieldEnvID, v)
+	return u
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *WarmPoolUpsert) UpdateEnvID() *WarmPoolUpsert {
+	u.SetExcluded(warmpool.FieldEnvID)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *WarmPoolUpsert) SetSize(v int32) *WarmPoolUpsert {
+	u.Set(warmpool.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *WarmPoolUpsert) UpdateSize() *WarmPoolUpsert {
+	u.SetExcluded(warmpool.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *WarmPoolUpsert) AddSize(v int32) *WarmPoolUpsert {
+	u.Add(warmpool.FieldSize, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WarmPool.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(warmpool.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WarmPoolUpsertOne) UpdateNewValues() *WarmPoolUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(warmpool.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(warmpool.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WarmPool.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WarmPoolUpsertOne) Ignore() *WarmPoolUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WarmPoolUpsertOne) DoNothing() *WarmPoolUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WarmPoolCreate.OnConflict
+// documentation for more info.
+func (u *WarmPoolUpsertOne) Update(set func(*WarmPoolUpsert)) *WarmPoolUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WarmPoolUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WarmPoolUpsertOne) SetUpdatedAt(v time.Time) *WarmPoolUpsertOne {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WarmPoolUpsertOne) UpdateUpdatedAt() *WarmPoolUpsertOne {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *WarmPoolUpsertOne) SetTeamID(v uuid.UUID) *WarmPoolUpsertOne {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *WarmPoolUpsertOne) UpdateTeamID() *WarmPoolUpsertOne {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *WarmPoolUpsertOne) SetEnvID(v string) *WarmPoolUpsertOne {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.SetEnvID(v)
+	})
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *WarmPoolUpsertOne) UpdateEnvID() *WarmPoolUpsertOne {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.UpdateEnvID()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *WarmPoolUpsertOne) SetSize(v int32) *WarmPoolUpsertOne {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *WarmPoolUpsertOne) AddSize(v int32) *WarmPoolUpsertOne {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *WarmPoolUpsertOne) UpdateSize() *WarmPoolUpsertOne {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// Exec executes the query.
+func (u *WarmPoolUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for WarmPoolCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WarmPoolUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WarmPoolUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("models: WarmPoolUpsertOne.ID is not supported by MySQL driver. Use WarmPoolUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WarmPoolUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WarmPoolCreateBulk is the builder for creating many WarmPool entities in bulk.
+type WarmPoolCreateBulk struct {
+	config
+	err      error
+	builders []*WarmPoolCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WarmPool entities in the database.
+func (wpcb *WarmPoolCreateBulk) Save(ctx context.Context) ([]*WarmPool, error) {
+	if wpcb.err != nil {
+		return nil, wpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wpcb.builders))
+	nodes := make([]*WarmPool, len(wpcb.builders))
+	mutators := make([]Mutator, len(wpcb.builders))
+	for i := range wpcb.builders {
+		func(i int, root context.Context) {
+			builder := wpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WarmPoolMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpcb *WarmPoolCreateBulk) SaveX(ctx context.Context) []*WarmPool {
+	v, err := wpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wpcb *WarmPoolCreateBulk) Exec(ctx context.Context) error {
+	_, err := wpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpcb *WarmPoolCreateBulk) ExecX(ctx context.Context) {
+	if err := wpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WarmPool.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WarmPoolUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wpcb *WarmPoolCreateBulk) OnConflict(opts ...sql.ConflictOption) *WarmPoolUpsertBulk {
+	wpcb.conflict = opts
+	return &WarmPoolUpsertBulk{
+		create: wpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WarmPool.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wpcb *WarmPoolCreateBulk) OnConflictColumns(columns ...string) *WarmPoolUpsertBulk {
+	wpcb.conflict = append(wpcb.conflict, sql.ConflictColumns(columns...))
+	return &WarmPoolUpsertBulk{
+		create: wpcb,
+	}
+}
+
+// WarmPoolUpsertBulk is the builder for "upsert"-ing
+// a bulk of WarmPool nodes.
+type WarmPoolUpsertBulk struct {
+	create *WarmPoolCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WarmPool.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(warmpool.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WarmPoolUpsertBulk) UpdateNewValues() *WarmPoolUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(warmpool.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(warmpool.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WarmPool.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WarmPoolUpsertBulk) Ignore() *WarmPoolUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WarmPoolUpsertBulk) DoNothing() *WarmPoolUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WarmPoolCreateBulk.OnConflict
+// documentation for more info.
+func (u *WarmPoolUpsertBulk) Update(set func(*WarmPoolUpsert)) *WarmPoolUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WarmPoolUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WarmPoolUpsertBulk) SetUpdatedAt(v time.Time) *WarmPoolUpsertBulk {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WarmPoolUpsertBulk) UpdateUpdatedAt() *WarmPoolUpsertBulk {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *WarmPoolUpsertBulk) SetTeamID(v uuid.UUID) *WarmPoolUpsertBulk {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *WarmPoolUpsertBulk) UpdateTeamID() *WarmPoolUpsertBulk {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// SetEnvID sets the "env_id" field.
+func (u *WarmPoolUpsertBulk) SetEnvID(v string) *WarmPoolUpsertBulk {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.SetEnvID(v)
+	})
+}
+
+// UpdateEnvID sets the "env_id" field to the value that was provided on create.
+func (u *WarmPoolUpsertBulk) UpdateEnvID() *WarmPoolUpsertBulk {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.UpdateEnvID()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *WarmPoolUpsertBulk) SetSize(v int32) *WarmPoolUpsertBulk {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *WarmPoolUpsertBulk) AddSize(v int32) *WarmPoolUpsertBulk {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *WarmPoolUpsertBulk) UpdateSize() *WarmPoolUpsertBulk {
+	return u.Update(func(s *WarmPoolUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// Exec executes the query.
+func (u *WarmPoolUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("models: OnConflict was set for builder %d. Set it on the WarmPoolCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("models: missing options for WarmPoolCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WarmPoolUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+)
+
+// WarmPoolDelete is the builder for deleting a WarmPool entity.
+type WarmPoolDelete struct {
+	config
+	hooks    []Hook
+	mutation *WarmPoolMutation
+}
+
+// Where appends a list predicates to the WarmPoolDelete builder.
+func (wpd *WarmPoolDelete) Where(ps ...predicate.WarmPool) *WarmPoolDelete {
+	wpd.mutation.Where(ps...)
+	return wpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wpd *WarmPoolDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wpd.sqlExec, wpd.mutation, wpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpd *WarmPoolDelete) ExecX(ctx context.Context) int {
+	n, err := wpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wpd *WarmPoolDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(warmpool.Table, sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID))
+	_spec.Node.Schema = wpd.schemaConfig.WarmPool
+	ctx = internal.NewSchemaConfigContext(ctx, wpd.schemaConfig)
+	if ps := wpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wpd.mutation.done = true
+	return affected, err
+}
+
+// WarmPoolDeleteOne is the builder for deleting a single WarmPool entity.
+type WarmPoolDeleteOne struct {
+	wpd *WarmPoolDelete
+}
+
+// Where appends a list predicates to the WarmPoolDelete builder.
+func (wpdo *WarmPoolDeleteOne) Where(ps ...predicate.WarmPool) *WarmPoolDeleteOne {
+	wpdo.wpd.mutation.Where(ps...)
+	return wpdo
+}
+
+// Exec executes the deletion query.
+func (wpdo *WarmPoolDeleteOne) Exec(ctx context.Context) error {
+	n, err := wpdo.wpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{warmpool.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpdo *WarmPoolDeleteOne) ExecX(ctx context.Context) {
+	if err := wpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
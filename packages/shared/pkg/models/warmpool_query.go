@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/google/uuid"
+)
+
+// WarmPoolQuery is the builder for querying WarmPool entities.
+type WarmPoolQuery struct {
+	config
+	ctx        *QueryContext
+	order      []warmpool.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WarmPool
+	withTeam   *TeamQuery
+	withEnv    *EnvQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WarmPoolQuery builder.
+func (wpq *WarmPoolQuery) Where(ps ...predicate.WarmPool) *WarmPoolQuery {
+	wpq.predicates = append(wpq.predicates, ps...)
+	return wpq
+}
+
+// Limit the number of records to be returned by this query.
+func (wpq *WarmPoolQuery) Limit(limit int) *WarmPoolQuery {
+	wpq.ctx.Limit = &limit
+	return wpq
+}
+
+// Offset to start from.
+func (wpq *WarmPoolQuery) Offset(offset int) *WarmPoolQuery {
+	wpq.ctx.Offset = &offset
+	return wpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wpq *WarmPoolQuery) Unique(unique bool) *WarmPoolQuery {
+	wpq.ctx.Unique = &unique
+	return wpq
+}
+
+// Order specifies how the records should be ordered.
+func (wpq *WarmPoolQuery) Order(o ...warmpool.OrderOption) *WarmPoolQuery {
+	wpq.order = append(wpq.order, o...)
+	return wpq
+}
+
+// QueryTeam chains the current query on the "team" edge.
+func (wpq *WarmPoolQuery) QueryTeam() *TeamQuery {
+	query := (&TeamClient{config: wpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warmpool.Table, warmpool.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, warmpool.TeamTable, warmpool.TeamColumn),
+		)
+		schemaConfig := wpq.schemaConfig
+		step.To.Schema = schemaConfig.Team
+		step.Edge.Schema = schemaConfig.WarmPool
+		fromU = sqlgraph.SetNeighbors(wpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnv chains the current query on the "env" edge.
+func (wpq *WarmPoolQuery) QueryEnv() *EnvQuery {
+	query := (&EnvClient{config: wpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warmpool.Table, warmpool.FieldID, selector),
+			sqlgraph.To(env.Table, env.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, warmpool.EnvTable, warmpool.EnvColumn),
+		)
+		schemaConfig := wpq.schemaConfig
+		step.To.Schema = schemaConfig.Env
+		step.Edge.Schema = schemaConfig.WarmPool
+		fromU = sqlgraph.SetNeighbors(wpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WarmPool entity from the query.
+// Returns a *NotFoundError when no WarmPool was found.
+func (wpq *WarmPoolQuery) First(ctx context.Context) (*WarmPool, error) {
+	nodes, err := wpq.Limit(1).All(setContextOp(ctx, wpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{warmpool.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wpq *WarmPoolQuery) FirstX(ctx context.Context) *WarmPool {
+	node, err := wpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WarmPool ID from the query.
+// Returns a *NotFoundError when no WarmPool ID was found.
+func (wpq *WarmPoolQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wpq.Limit(1).IDs(setContextOp(ctx, wpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{warmpool.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wpq *WarmPoolQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WarmPool entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WarmPool entity is found.
+// Returns a *NotFoundError when no WarmPool entities are found.
+func (wpq *WarmPoolQuery) Only(ctx context.Context) (*WarmPool, error) {
+	nodes, err := wpq.Limit(2).All(setContextOp(ctx, wpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{warmpool.Label}
+	default:
+		return nil, &NotSingularError{warmpool.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wpq *WarmPoolQuery) OnlyX(ctx context.Context) *WarmPool {
+	node, err := wpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WarmPool ID in the query.
+// Returns a *NotSingularError when more than one WarmPool ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wpq *WarmPoolQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wpq.Limit(2).IDs(setContextOp(ctx, wpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{warmpool.Label}
+	default:
+		err = &NotSingularError{warmpool.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wpq *WarmPoolQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WarmPools.
+func (wpq *WarmPoolQuery) All(ctx context.Context) ([]*WarmPool, error) {
+	ctx = setContextOp(ctx, wpq.ctx, "All")
+	if err := wpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WarmPool, *WarmPoolQuery]()
+	return withInterceptors[[]*WarmPool](ctx, wpq, qr, wpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wpq *WarmPoolQuery) AllX(ctx context.Context) []*WarmPool {
+	nodes, err := wpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WarmPool IDs.
+func (wpq *WarmPoolQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wpq.ctx.Unique == nil && wpq.path != nil {
+		wpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wpq.ctx, "IDs")
+	if err = wpq.Select(warmpool.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wpq *WarmPoolQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wpq *WarmPoolQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wpq.ctx, "Count")
+	if err := wpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wpq, querierCount[*WarmPoolQuery](), wpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wpq *WarmPoolQuery) CountX(ctx context.Context) int {
+	count, err := wpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wpq *WarmPoolQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wpq.ctx, "Exist")
+	switch _, err := wpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("models: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wpq *WarmPoolQuery) ExistX(ctx context.Context) bool {
+	exist, err := wpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WarmPoolQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wpq *WarmPoolQuery) Clone() *WarmPoolQuery {
+	if wpq == nil {
+		return nil
+	}
+	return &WarmPoolQuery{
+		config:     wpq.config,
+		ctx:        wpq.ctx.Clone(),
+		order:      append([]warmpool.OrderOption{}, wpq.order...),
+		inters:     append([]Interceptor{}, wpq.inters...),
+		predicates: append([]predicate.WarmPool{}, wpq.predicates...),
+		withTeam:   wpq.withTeam.Clone(),
+		withEnv:    wpq.withEnv.Clone(),
+		// clone intermediate query.
+		sql:  wpq.sql.Clone(),
+		path: wpq.path,
+	}
+}
+
+// WithTeam tells the query-builder to eager-load the nodes that are connected to
+// the "team" edge. The optional arguments are used to configure the query builder of the edge.
+func (wpq *WarmPoolQuery) WithTeam(opts ...func(*TeamQuery)) *WarmPoolQuery {
+	query := (&TeamClient{config: wpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wpq.withTeam = query
+	return wpq
+}
+
+// WithEnv tells the query-builder to eager-load the nodes that are connected to
+// the "env" edge. The optional arguments are used to configure the query builder of the edge.
+func (wpq *WarmPoolQuery) WithEnv(opts ...func(*EnvQuery)) *WarmPoolQuery {
+	query := (&EnvClient{config: wpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wpq.withEnv = query
+	return wpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WarmPool.Query().
+//		GroupBy(warmpool.FieldCreatedAt).
+//		Aggregate(models.Count()).
+//		Scan(ctx, &v)
+func (wpq *WarmPoolQuery) GroupBy(field string, fields ...string) *WarmPoolGroupBy {
+	wpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WarmPoolGroupBy{build: wpq}
+	grbuild.flds = &wpq.ctx.Fields
+	grbuild.label = warmpool.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.WarmPool.Query().
+//		Select(warmpool.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (wpq *WarmPoolQuery) Select(fields ...string) *WarmPoolSelect {
+	wpq.ctx.Fields = append(wpq.ctx.Fields, fields...)
+	sbuild := &WarmPoolSelect{WarmPoolQuery: wpq}
+	sbuild.label = warmpool.Label
+	sbuild.flds, sbuild.scan = &wpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WarmPoolSelect configured with the given aggregations.
+func (wpq *WarmPoolQuery) Aggregate(fns ...AggregateFunc) *WarmPoolSelect {
+	return wpq.Select().Aggregate(fns...)
+}
+
+func (wpq *WarmPoolQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wpq.inters {
+		if inter == nil {
+			return fmt.Errorf("models: uninitialized interceptor (forgotten import models/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wpq.ctx.Fields {
+		if !warmpool.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+		}
+	}
+	if wpq.path != nil {
+		prev, err := wpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wpq.sql = prev
+	}
+	return nil
+}
+
+func (wpq *WarmPoolQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WarmPool, error) {
+	var (
+		nodes       = []*WarmPool{}
+		_spec       = wpq.querySpec()
+		loadedTypes = [2]bool{
+			wpq.withTeam != nil,
+			wpq.withEnv != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WarmPool).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WarmPool{config: wpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = wpq.schemaConfig.WarmPool
+	ctx = internal.NewSchemaConfigContext(ctx, wpq.schemaConfig)
+	if len(wpq.modifiers) > 0 {
+		_spec.Modifiers = wpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wpq.withTeam; query != nil {
+		if err := wpq.loadTeam(ctx, query, nodes, nil,
+			func(n *WarmPool, e *Team) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wpq.withEnv; query != nil {
+		if err := wpq.loadEnv(ctx, query, nodes, nil,
+			func(n *WarmPool, e *Env) { n.Edges.Env = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wpq *WarmPoolQuery) loadTeam(ctx context.Context, query *TeamQuery, nodes []*WarmPool, init func(*WarmPool), assign func(*WarmPool, *Team)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*WarmPool)
+	for i := range nodes {
+		fk := nodes[i].TeamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(team.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "team_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (wpq *WarmPoolQuery) loadEnv(ctx context.Context, query *EnvQuery, nodes []*WarmPool, init func(*WarmPool), assign func(*WarmPool, *Env)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*WarmPool)
+	for i := range nodes {
+		fk := nodes[i].EnvID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(env.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "env_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wpq *WarmPoolQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wpq.querySpec()
+	_spec.Node.Schema = wpq.schemaConfig.WarmPool
+	ctx = internal.NewSchemaConfigContext(ctx, wpq.schemaConfig)
+	if len(wpq.modifiers) > 0 {
+		_spec.Modifiers = wpq.modifiers
+	}
+	_spec.Node.Columns = wpq.ctx.Fields
+	if len(wpq.ctx.Fields) > 0 {
+		_spec.Unique = wpq.ctx.Unique != nil && *wpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wpq.driver, _spec)
+}
+
+func (wpq *WarmPoolQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(warmpool.Table, warmpool.Columns, sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID))
+	_spec.From = wpq.sql
+	if unique := wpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, warmpool.FieldID)
+		for i := range fields {
+			if fields[i] != warmpool.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if wpq.withTeam != nil {
+			_spec.Node.AddColumnOnce(warmpool.FieldTeamID)
+		}
+		if wpq.withEnv != nil {
+			_spec.Node.AddColumnOnce(warmpool.FieldEnvID)
+		}
+	}
+	if ps := wpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wpq *WarmPoolQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wpq.driver.Dialect())
+	t1 := builder.Table(warmpool.Table)
+	columns := wpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = warmpool.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wpq.sql != nil {
+		selector = wpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wpq.ctx.Unique != nil && *wpq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(wpq.schemaConfig.WarmPool)
+	ctx = internal.NewSchemaConfigContext(ctx, wpq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range wpq.modifiers {
+		m(selector)
+	}
+	for _, p := range wpq.predicates {
+		p(selector)
+	}
+	for _, p := range wpq.order {
+		p(selector)
+	}
+	if offset := wpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wpq *WarmPoolQuery) Modify(modifiers ...func(s *sql.Selector)) *WarmPoolSelect {
+	wpq.modifiers = append(wpq.modifiers, modifiers...)
+	return wpq.Select()
+}
+
+// WarmPoolGroupBy is the group-by builder for WarmPool entities.
+type WarmPoolGroupBy struct {
+	selector
+	build *WarmPoolQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wpgb *WarmPoolGroupBy) Aggregate(fns ...AggregateFunc) *WarmPoolGroupBy {
+	wpgb.fns = append(wpgb.fns, fns...)
+	return wpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wpgb *WarmPoolGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wpgb.build.ctx, "GroupBy")
+	if err := wpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WarmPoolQuery, *WarmPoolGroupBy](ctx, wpgb.build, wpgb, wpgb.build.inters, v)
+}
+
+func (wpgb *WarmPoolGroupBy) sqlScan(ctx context.Context, root *WarmPoolQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wpgb.fns))
+	for _, fn := range wpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wpgb.flds)+len(wpgb.fns))
+		for _, f := range *wpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WarmPoolSelect is the builder for selecting fields of WarmPool entities.
+type WarmPoolSelect struct {
+	*WarmPoolQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wps *WarmPoolSelect) Aggregate(fns ...AggregateFunc) *WarmPoolSelect {
+	wps.fns = append(wps.fns, fns...)
+	return wps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wps *WarmPoolSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wps.ctx, "Select")
+	if err := wps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WarmPoolQuery, *WarmPoolSelect](ctx, wps.WarmPoolQuery, wps, wps.inters, v)
+}
+
+func (wps *WarmPoolSelect) sqlScan(ctx context.Context, root *WarmPoolQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wps.fns))
+	for _, fn := range wps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wps *WarmPoolSelect) Modify(modifiers ...func(s *sql.Selector)) *WarmPoolSelect {
+	wps.modifiers = append(wps.modifiers, modifiers...)
+	return wps
+}
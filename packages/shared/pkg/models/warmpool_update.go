@@ -0,0 +1,542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/env"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/team"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/warmpool"
+	"github.com/google/uuid"
+)
+
+// WarmPoolUpdate is the builder for updating WarmPool entities.
+type WarmPoolUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WarmPoolMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WarmPoolUpdate builder.
+func (wpu *WarmPoolUpdate) Where(ps ...predicate.WarmPool) *WarmPoolUpdate {
+	wpu.mutation.Where(ps...)
+	return wpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wpu *WarmPoolUpdate) SetUpdatedAt(t time.Time) *WarmPoolUpdate {
+	wpu.mutation.SetUpdatedAt(t)
+	return wpu
+}
+
+// SetTeamID sets the "team_id" field.
+func (wpu *WarmPoolUpdate) SetTeamID(u uuid.UUID) *WarmPoolUpdate {
+	wpu.mutation.SetTeamID(u)
+	return wpu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (wpu *WarmPoolUpdate) SetNillableTeamID(u *uuid.UUID) *WarmPoolUpdate {
+	if u != nil {
+		wpu.SetTeamID(*u)
+	}
+	return wpu
+}
+
+// SetEnvID sets the "env_id" field.
+func (wpu *WarmPoolUpdate) SetEnvID(s string) *WarmPoolUpdate {
+	wpu.mutation.SetEnvID(s)
+	return wpu
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (wpu *WarmPoolUpdate) SetNillableEnvID(s *string) *WarmPoolUpdate {
+	if s != nil {
+		wpu.SetEnvID(*s)
+	}
+	return wpu
+}
+
+// SetSize sets the "size" field.
+func (wpu *WarmPoolUpdate) SetSize(i int32) *WarmPoolUpdate {
+	wpu.mutation.ResetSize()
+	wpu.mutation.SetSize(i)
+	return wpu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (wpu *WarmPoolUpdate) SetNillableSize(i *int32) *WarmPoolUpdate {
+	if i != nil {
+		wpu.SetSize(*i)
+	}
+	return wpu
+}
+
+// AddSize adds i to the "size" field.
+func (wpu *WarmPoolUpdate) AddSize(i int32) *WarmPoolUpdate {
+	wpu.mutation.AddSize(i)
+	return wpu
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (wpu *WarmPoolUpdate) SetTeam(t *Team) *WarmPoolUpdate {
+	return wpu.SetTeamID(t.ID)
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (wpu *WarmPoolUpdate) SetEnv(e *Env) *WarmPoolUpdate {
+	return wpu.SetEnvID(e.ID)
+}
+
+// Mutation returns the WarmPoolMutation object of the builder.
+func (wpu *WarmPoolUpdate) Mutation() *WarmPoolMutation {
+	return wpu.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (wpu *WarmPoolUpdate) ClearTeam() *WarmPoolUpdate {
+	wpu.mutation.ClearTeam()
+	return wpu
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (wpu *WarmPoolUpdate) ClearEnv() *WarmPoolUpdate {
+	wpu.mutation.ClearEnv()
+	return wpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wpu *WarmPoolUpdate) Save(ctx context.Context) (int, error) {
+	wpu.defaults()
+	return withHooks(ctx, wpu.sqlSave, wpu.mutation, wpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpu *WarmPoolUpdate) SaveX(ctx context.Context) int {
+	affected, err := wpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wpu *WarmPoolUpdate) Exec(ctx context.Context) error {
+	_, err := wpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpu *WarmPoolUpdate) ExecX(ctx context.Context) {
+	if err := wpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpu *WarmPoolUpdate) defaults() {
+	if _, ok := wpu.mutation.UpdatedAt(); !ok {
+		v := warmpool.UpdateDefaultUpdatedAt()
+		wpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpu *WarmPoolUpdate) check() error {
+	if _, ok := wpu.mutation.TeamID(); wpu.mutation.TeamCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "WarmPool.team"`)
+	}
+	if _, ok := wpu.mutation.EnvID(); wpu.mutation.EnvCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "WarmPool.env"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wpu *WarmPoolUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WarmPoolUpdate {
+	wpu.modifiers = append(wpu.modifiers, modifiers...)
+	return wpu
+}
+
+func (wpu *WarmPoolUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(warmpool.Table, warmpool.Columns, sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID))
+	if ps := wpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(warmpool.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wpu.mutation.Size(); ok {
+		_spec.SetField(warmpool.FieldSize, field.TypeInt32, value)
+	}
+	if value, ok := wpu.mutation.AddedSize(); ok {
+		_spec.AddField(warmpool.FieldSize, field.TypeInt32, value)
+	}
+	if wpu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.TeamTable,
+			Columns: []string{warmpool.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wpu.schemaConfig.WarmPool
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.TeamTable,
+			Columns: []string{warmpool.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wpu.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wpu.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.EnvTable,
+			Columns: []string{warmpool.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wpu.schemaConfig.WarmPool
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpu.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.EnvTable,
+			Columns: []string{warmpool.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wpu.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wpu.schemaConfig.WarmPool
+	ctx = internal.NewSchemaConfigContext(ctx, wpu.schemaConfig)
+	_spec.AddModifiers(wpu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{warmpool.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wpu.mutation.done = true
+	return n, nil
+}
+
+// WarmPoolUpdateOne is the builder for updating a single WarmPool entity.
+type WarmPoolUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WarmPoolMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wpuo *WarmPoolUpdateOne) SetUpdatedAt(t time.Time) *WarmPoolUpdateOne {
+	wpuo.mutation.SetUpdatedAt(t)
+	return wpuo
+}
+
+// SetTeamID sets the "team_id" field.
+func (wpuo *WarmPoolUpdateOne) SetTeamID(u uuid.UUID) *WarmPoolUpdateOne {
+	wpuo.mutation.SetTeamID(u)
+	return wpuo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (wpuo *WarmPoolUpdateOne) SetNillableTeamID(u *uuid.UUID) *WarmPoolUpdateOne {
+	if u != nil {
+		wpuo.SetTeamID(*u)
+	}
+	return wpuo
+}
+
+// SetEnvID sets the "env_id" field.
+func (wpuo *WarmPoolUpdateOne) SetEnvID(s string) *WarmPoolUpdateOne {
+	wpuo.mutation.SetEnvID(s)
+	return wpuo
+}
+
+// SetNillableEnvID sets the "env_id" field if the given value is not nil.
+func (wpuo *WarmPoolUpdateOne) SetNillableEnvID(s *string) *WarmPoolUpdateOne {
+	if s != nil {
+		wpuo.SetEnvID(*s)
+	}
+	return wpuo
+}
+
+// SetSize sets the "size" field.
+func (wpuo *WarmPoolUpdateOne) SetSize(i int32) *WarmPoolUpdateOne {
+	wpuo.mutation.ResetSize()
+	wpuo.mutation.SetSize(i)
+	return wpuo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (wpuo *WarmPoolUpdateOne) SetNillableSize(i *int32) *WarmPoolUpdateOne {
+	if i != nil {
+		wpuo.SetSize(*i)
+	}
+	return wpuo
+}
+
+// AddSize adds i to the "size" field.
+func (wpuo *WarmPoolUpdateOne) AddSize(i int32) *WarmPoolUpdateOne {
+	wpuo.mutation.AddSize(i)
+	return wpuo
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (wpuo *WarmPoolUpdateOne) SetTeam(t *Team) *WarmPoolUpdateOne {
+	return wpuo.SetTeamID(t.ID)
+}
+
+// SetEnv sets the "env" edge to the Env entity.
+func (wpuo *WarmPoolUpdateOne) SetEnv(e *Env) *WarmPoolUpdateOne {
+	return wpuo.SetEnvID(e.ID)
+}
+
+// Mutation returns the WarmPoolMutation object of the builder.
+func (wpuo *WarmPoolUpdateOne) Mutation() *WarmPoolMutation {
+	return wpuo.mutation
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (wpuo *WarmPoolUpdateOne) ClearTeam() *WarmPoolUpdateOne {
+	wpuo.mutation.ClearTeam()
+	return wpuo
+}
+
+// ClearEnv clears the "env" edge to the Env entity.
+func (wpuo *WarmPoolUpdateOne) ClearEnv() *WarmPoolUpdateOne {
+	wpuo.mutation.ClearEnv()
+	return wpuo
+}
+
+// Where appends a list predicates to the WarmPoolUpdate builder.
+func (wpuo *WarmPoolUpdateOne) Where(ps ...predicate.WarmPool) *WarmPoolUpdateOne {
+	wpuo.mutation.Where(ps...)
+	return wpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wpuo *WarmPoolUpdateOne) Select(field string, fields ...string) *WarmPoolUpdateOne {
+	wpuo.fields = append([]string{field}, fields...)
+	return wpuo
+}
+
+// Save executes the query and returns the updated WarmPool entity.
+func (wpuo *WarmPoolUpdateOne) Save(ctx context.Context) (*WarmPool, error) {
+	wpuo.defaults()
+	return withHooks(ctx, wpuo.sqlSave, wpuo.mutation, wpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpuo *WarmPoolUpdateOne) SaveX(ctx context.Context) *WarmPool {
+	node, err := wpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wpuo *WarmPoolUpdateOne) Exec(ctx context.Context) error {
+	_, err := wpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpuo *WarmPoolUpdateOne) ExecX(ctx context.Context) {
+	if err := wpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpuo *WarmPoolUpdateOne) defaults() {
+	if _, ok := wpuo.mutation.UpdatedAt(); !ok {
+		v := warmpool.UpdateDefaultUpdatedAt()
+		wpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpuo *WarmPoolUpdateOne) check() error {
+	if _, ok := wpuo.mutation.TeamID(); wpuo.mutation.TeamCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "WarmPool.team"`)
+	}
+	if _, ok := wpuo.mutation.EnvID(); wpuo.mutation.EnvCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "WarmPool.env"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wpuo *WarmPoolUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WarmPoolUpdateOne {
+	wpuo.modifiers = append(wpuo.modifiers, modifiers...)
+	return wpuo
+}
+
+func (wpuo *WarmPoolUpdateOne) sqlSave(ctx context.Context) (_node *WarmPool, err error) {
+	if err := wpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(warmpool.Table, warmpool.Columns, sqlgraph.NewFieldSpec(warmpool.FieldID, field.TypeUUID))
+	id, ok := wpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "WarmPool.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, warmpool.FieldID)
+		for _, f := range fields {
+			if !warmpool.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != warmpool.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(warmpool.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wpuo.mutation.Size(); ok {
+		_spec.SetField(warmpool.FieldSize, field.TypeInt32, value)
+	}
+	if value, ok := wpuo.mutation.AddedSize(); ok {
+		_spec.AddField(warmpool.FieldSize, field.TypeInt32, value)
+	}
+	if wpuo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.TeamTable,
+			Columns: []string{warmpool.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wpuo.schemaConfig.WarmPool
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpuo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.TeamTable,
+			Columns: []string{warmpool.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wpuo.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wpuo.mutation.EnvCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.EnvTable,
+			Columns: []string{warmpool.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wpuo.schemaConfig.WarmPool
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpuo.mutation.EnvIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   warmpool.EnvTable,
+			Columns: []string{warmpool.EnvColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(env.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wpuo.schemaConfig.WarmPool
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wpuo.schemaConfig.WarmPool
+	ctx = internal.NewSchemaConfigContext(ctx, wpuo.schemaConfig)
+	_spec.AddModifiers(wpuo.modifiers...)
+	_node = &WarmPool{config: wpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{warmpool.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wpuo.mutation.done = true
+	return _node, nil
+}
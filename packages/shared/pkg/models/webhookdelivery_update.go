@@ -0,0 +1,646 @@
+// Code generated by ent, DO NOT EDIT.
+
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/internal"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/predicate"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhook"
+	"github.com/e2b-dev/infra/packages/shared/pkg/models/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdu *WebhookDeliveryUpdate) SetWebhookID(u uuid.UUID) *WebhookDeliveryUpdate {
+	wdu.mutation.SetWebhookID(u)
+	return wdu
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableWebhookID(u *uuid.UUID) *WebhookDeliveryUpdate {
+	if u != nil {
+		wdu.SetWebhookID(*u)
+	}
+	return wdu
+}
+
+// SetEventID sets the "event_id" field.
+func (wdu *WebhookDeliveryUpdate) SetEventID(u uuid.UUID) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventID(u)
+	return wdu
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEventID(u *uuid.UUID) *WebhookDeliveryUpdate {
+	if u != nil {
+		wdu.SetEventID(*u)
+	}
+	return wdu
+}
+
+// SetEventType sets the "event_type" field.
+func (wdu *WebhookDeliveryUpdate) SetEventType(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventType(s)
+	return wdu
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEventType(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetEventType(*s)
+	}
+	return wdu
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (wdu *WebhookDeliveryUpdate) SetSandboxID(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetSandboxID(s)
+	return wdu
+}
+
+// SetNillableSandboxID sets the "sandbox_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableSandboxID(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetSandboxID(*s)
+	}
+	return wdu
+}
+
+// SetAttempt sets the "attempt" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempt(i int32) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempt()
+	wdu.mutation.SetAttempt(i)
+	return wdu
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempt(i *int32) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempt(*i)
+	}
+	return wdu
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempt(i int32) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempt(i)
+	return wdu
+}
+
+// SetSuccess sets the "success" field.
+func (wdu *WebhookDeliveryUpdate) SetSuccess(b bool) *WebhookDeliveryUpdate {
+	wdu.mutation.SetSuccess(b)
+	return wdu
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableSuccess(b *bool) *WebhookDeliveryUpdate {
+	if b != nil {
+		wdu.SetSuccess(*b)
+	}
+	return wdu
+}
+
+// SetStatusCode sets the "status_code" field.
+func (wdu *WebhookDeliveryUpdate) SetStatusCode(i int32) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetStatusCode()
+	wdu.mutation.SetStatusCode(i)
+	return wdu
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatusCode(i *int32) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetStatusCode(*i)
+	}
+	return wdu
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (wdu *WebhookDeliveryUpdate) AddStatusCode(i int32) *WebhookDeliveryUpdate {
+	wdu.mutation.AddStatusCode(i)
+	return wdu
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (wdu *WebhookDeliveryUpdate) ClearStatusCode() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearStatusCode()
+	return wdu
+}
+
+// SetError sets the "error" field.
+func (wdu *WebhookDeliveryUpdate) SetError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetError(s)
+	return wdu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetError(*s)
+	}
+	return wdu
+}
+
+// ClearError clears the value of the "error" field.
+func (wdu *WebhookDeliveryUpdate) ClearError() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearError()
+	return wdu
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) SetWebhook(w *Webhook) *WebhookDeliveryUpdate {
+	return wdu.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) ClearWebhook() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearWebhook()
+	return wdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if _, ok := wdu.mutation.WebhookID(); wdu.mutation.WebhookCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wdu *WebhookDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdate {
+	wdu.modifiers = append(wdu.modifiers, modifiers...)
+	return wdu
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeUUID, value)
+	}
+	if value, ok := wdu.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.SandboxID(); ok {
+		_spec.SetField(webhookdelivery.FieldSandboxID, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Attempt(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempt, field.TypeInt32, value)
+	}
+	if value, ok := wdu.mutation.AddedAttempt(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempt, field.TypeInt32, value)
+	}
+	if value, ok := wdu.mutation.Success(); ok {
+		_spec.SetField(webhookdelivery.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := wdu.mutation.StatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldStatusCode, field.TypeInt32, value)
+	}
+	if value, ok := wdu.mutation.AddedStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldStatusCode, field.TypeInt32, value)
+	}
+	if wdu.mutation.StatusCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldStatusCode, field.TypeInt32)
+	}
+	if value, ok := wdu.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if wdu.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if wdu.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wdu.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wdu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wdu.schemaConfig.WebhookDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, wdu.schemaConfig)
+	_spec.AddModifiers(wdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhookID(u uuid.UUID) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetWebhookID(u)
+	return wduo
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableWebhookID(u *uuid.UUID) *WebhookDeliveryUpdateOne {
+	if u != nil {
+		wduo.SetWebhookID(*u)
+	}
+	return wduo
+}
+
+// SetEventID sets the "event_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventID(u uuid.UUID) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventID(u)
+	return wduo
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEventID(u *uuid.UUID) *WebhookDeliveryUpdateOne {
+	if u != nil {
+		wduo.SetEventID(*u)
+	}
+	return wduo
+}
+
+// SetEventType sets the "event_type" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventType(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventType(s)
+	return wduo
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEventType(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetEventType(*s)
+	}
+	return wduo
+}
+
+// SetSandboxID sets the "sandbox_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetSandboxID(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetSandboxID(s)
+	return wduo
+}
+
+// SetNillableSandboxID sets the "sandbox_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableSandboxID(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetSandboxID(*s)
+	}
+	return wduo
+}
+
+// SetAttempt sets the "attempt" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempt(i int32) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempt()
+	wduo.mutation.SetAttempt(i)
+	return wduo
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempt(i *int32) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempt(*i)
+	}
+	return wduo
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempt(i int32) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempt(i)
+	return wduo
+}
+
+// SetSuccess sets the "success" field.
+func (wduo *WebhookDeliveryUpdateOne) SetSuccess(b bool) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetSuccess(b)
+	return wduo
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableSuccess(b *bool) *WebhookDeliveryUpdateOne {
+	if b != nil {
+		wduo.SetSuccess(*b)
+	}
+	return wduo
+}
+
+// SetStatusCode sets the "status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatusCode(i int32) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetStatusCode()
+	wduo.mutation.SetStatusCode(i)
+	return wduo
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatusCode(i *int32) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetStatusCode(*i)
+	}
+	return wduo
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) AddStatusCode(i int32) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddStatusCode(i)
+	return wduo
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearStatusCode() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearStatusCode()
+	return wduo
+}
+
+// SetError sets the "error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetError(s)
+	return wduo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetError(*s)
+	}
+	return wduo
+}
+
+// ClearError clears the value of the "error" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearError() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearError()
+	return wduo
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhook(w *Webhook) *WebhookDeliveryUpdateOne {
+	return wduo.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) ClearWebhook() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearWebhook()
+	return wduo
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if _, ok := wduo.mutation.WebhookID(); wduo.mutation.WebhookCleared() && !ok {
+		return errors.New(`models: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wduo *WebhookDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdateOne {
+	wduo.modifiers = append(wduo.modifiers, modifiers...)
+	return wduo
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`models: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("models: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeUUID, value)
+	}
+	if value, ok := wduo.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.SandboxID(); ok {
+		_spec.SetField(webhookdelivery.FieldSandboxID, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Attempt(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempt, field.TypeInt32, value)
+	}
+	if value, ok := wduo.mutation.AddedAttempt(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempt, field.TypeInt32, value)
+	}
+	if value, ok := wduo.mutation.Success(); ok {
+		_spec.SetField(webhookdelivery.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := wduo.mutation.StatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldStatusCode, field.TypeInt32, value)
+	}
+	if value, ok := wduo.mutation.AddedStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldStatusCode, field.TypeInt32, value)
+	}
+	if wduo.mutation.StatusCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldStatusCode, field.TypeInt32)
+	}
+	if value, ok := wduo.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if wduo.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if wduo.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wduo.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wduo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wduo.schemaConfig.WebhookDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, wduo.schemaConfig)
+	_spec.AddModifiers(wduo.modifiers...)
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,31 @@
+package proxy
+
+import (
+	"net/http"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
+)
+
+// PortAccessTokenHeader is the header with the token granting access to a non-public port of the sandbox.
+const PortAccessTokenHeader = "E2b-Port-Access-Token"
+
+// CheckPortAccess validates the port access token of the request.
+// Requests to non-public ports must have a valid token, a token sent to a public port must be valid too.
+// The token is valid only for the execution of the sandbox it was issued for and until it expires.
+// Without the signer the tokens can't be validated, so only the public ports are accessible.
+func CheckPortAccess(r *http.Request, signer *keys.PortAccessTokenSigner, sandboxId string, executionId string, port uint64, public bool) error {
+	token := r.Header.Get(PortAccessTokenHeader)
+	if token == "" || signer == nil {
+		if public {
+			return nil
+		}
+
+		return NewErrPortAccessDenied(sandboxId, port)
+	}
+
+	if !signer.Verify(sandboxId, executionId, port, token) {
+		return NewErrPortAccessDenied(sandboxId, port)
+	}
+
+	return nil
+}
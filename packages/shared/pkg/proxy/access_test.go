@@ -0,0 +1,67 @@
+package proxy
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/keys"
+)
+
+func TestCheckPortAccess(t *testing.T) {
+	signer := keys.NewPortAccessTokenSigner([]byte("test-seed"))
+
+	validToken, err := signer.Sign("sandbox", "execution", 8080, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	expiredToken, err := signer.Sign("sandbox", "execution", 8080, time.Now().Add(-time.Second))
+	require.NoError(t, err)
+
+	otherExecutionToken, err := signer.Sign("sandbox", "other-execution", 8080, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		token  string
+		signer *keys.PortAccessTokenSigner
+		public bool
+		denied bool
+	}{
+		{name: "public port without token", public: true, signer: signer},
+		{name: "public port with valid token", public: true, signer: signer, token: validToken},
+		{name: "public port with invalid token", public: true, signer: signer, token: "invalid", denied: true},
+		{name: "public port without signer", public: true, token: "invalid"},
+		{name: "non-public port without token", signer: signer, denied: true},
+		{name: "non-public port with valid token", signer: signer, token: validToken},
+		{name: "non-public port with invalid token", signer: signer, token: "invalid", denied: true},
+		{name: "non-public port with expired token", signer: signer, token: expiredToken, denied: true},
+		{name: "non-public port with token of other execution", signer: signer, token: otherExecutionToken, denied: true},
+		{name: "non-public port without signer", token: validToken, denied: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "http://8080-sandbox.e2b.app", nil)
+			if tt.token != "" {
+				r.Header.Set(PortAccessTokenHeader, tt.token)
+			}
+
+			err := CheckPortAccess(r, tt.signer, "sandbox", "execution", 8080, tt.public)
+			if !tt.denied {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			var deniedErr *ErrPortAccessDenied
+			require.True(t, errors.As(err, &deniedErr))
+			assert.Equal(t, "sandbox", deniedErr.SandboxId)
+			assert.Equal(t, uint64(8080), deniedErr.Port)
+		})
+	}
+}
@@ -38,6 +38,22 @@ func (e *ErrSandboxNotFound) Error() string {
 	return "sandbox not found"
 }
 
+func NewErrPortAccessDenied(sandboxId string, port uint64) *ErrPortAccessDenied {
+	return &ErrPortAccessDenied{
+		SandboxId: sandboxId,
+		Port:      port,
+	}
+}
+
+type ErrPortAccessDenied struct {
+	SandboxId string
+	Port      uint64
+}
+
+func (e *ErrPortAccessDenied) Error() string {
+	return "port access denied"
+}
+
 func handler(p *pool.ProxyPool, getDestination func(r *http.Request) (*pool.Destination, error)) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		d, err := getDestination(r)
@@ -75,6 +91,23 @@ func handler(p *pool.ProxyPool, getDestination func(r *http.Request) (*pool.Dest
 			return
 		}
 
+		var accessDeniedErr *ErrPortAccessDenied
+		if errors.As(err, &accessDeniedErr) {
+			zap.L().Warn("port access denied", zap.String("host", r.Host))
+
+			err := template.
+				NewPortAccessDeniedError(accessDeniedErr.SandboxId, r.Host, accessDeniedErr.Port, PortAccessTokenHeader).
+				HandleError(w, r)
+			if err != nil {
+				zap.L().Error("failed to handle port access denied error", zap.Error(err))
+				http.Error(w, "Failed to handle port access denied error", http.StatusInternalServerError)
+
+				return
+			}
+
+			return
+		}
+
 		if err != nil {
 			zap.L().Error("failed to route request", zap.Error(err), zap.String("host", r.Host))
 			http.Error(w, fmt.Sprintf("Unexpected error when routing request: %s", err), http.StatusInternalServerError)
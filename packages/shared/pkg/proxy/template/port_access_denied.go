@@ -0,0 +1,38 @@
+package template
+
+import (
+	_ "embed"
+	"html/template"
+	"net/http"
+)
+
+//go:embed browser_port_access_denied.html
+var portAccessDeniedHtml string
+var portAccessDeniedHtmlTemplate = template.Must(template.New("portAccessDeniedHtml").Parse(portAccessDeniedHtml))
+
+type portAccessDeniedError struct {
+	SandboxId string `json:"sandboxId"`
+	Message   string `json:"message"`
+	Port      uint64 `json:"port"`
+	Code      int    `json:"code"`
+	Host      string `json:"-"`
+	Header    string `json:"-"`
+}
+
+func (e portAccessDeniedError) StatusCode() int {
+	return e.Code
+}
+
+func NewPortAccessDeniedError(sandboxId, host string, port uint64, header string) *TemplatedError[portAccessDeniedError] {
+	return &TemplatedError[portAccessDeniedError]{
+		template: portAccessDeniedHtmlTemplate,
+		vars: portAccessDeniedError{
+			Message:   "The port is not public and the request doesn't have a valid port access token",
+			SandboxId: sandboxId,
+			Host:      host,
+			Port:      port,
+			Header:    header,
+			Code:      http.StatusForbidden,
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditLog is an append-only record of a change made through the API.
+// It has no edges, so the records are kept after the team or the actor is deleted.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("team_id", uuid.UUID{}).Immutable().Optional().Nillable().Comment("Team the change belongs to, empty for the changes not related to a team"),
+		field.String("action").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("actor_type").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}).Comment("user, api_key or admin"),
+		field.String("actor_id").Immutable().Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("resource_type").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("resource_id").Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("ip").Immutable().Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("request_id").Immutable().Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.JSON("metadata", map[string]string{}).Immutable().Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+	}
+}
+
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "created_at"),
+	}
+}
+
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
@@ -32,7 +32,7 @@ func (EnvBuild) Fields() []ent.Field {
 		field.Time("updated_at").Default(time.Now),
 		field.Time("finished_at").Optional().Nillable(),
 		field.String("env_id").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
-		field.Enum("status").Values("waiting", "building", "snapshotting", "failed", "success", "uploaded").Default("waiting").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Enum("status").Values("waiting", "queued", "building", "snapshotting", "failed", "success", "uploaded", "cancelled").Default("waiting").SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("dockerfile").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
 		field.String("start_cmd").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
 		field.String("ready_cmd").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
@@ -44,12 +44,24 @@ func (EnvBuild) Fields() []ent.Field {
 		field.String("firecracker_version").Default(DefaultFirecrackerVersion).SchemaType(map[string]string{dialect.Postgres: "text"}),
 		field.String("envd_version").SchemaType(map[string]string{dialect.Postgres: "text"}).Nillable().Optional(),
 		field.String("cluster_node_id").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable(),
+		field.Int64("network_bandwidth_mibps").Optional().Nillable().Comment("Overrides the tier network bandwidth limit"),
+		field.Int64("network_ops").Optional().Nillable().Comment("Overrides the tier network packets per second limit"),
+		field.Int64("disk_bandwidth_mibps").Optional().Nillable().Comment("Overrides the tier disk bandwidth limit"),
+		field.Int64("disk_iops").Optional().Nillable().Comment("Overrides the tier disk operations per second limit"),
+		field.Int64("memory_target_mb").Optional().Nillable().Comment("Memory the sandbox is sized down to by the balloon device, the whole ram_mb when not set"),
+		field.Int64("priority").Default(0).Annotations(entsql.Default("0")).Comment("Priority of the build in the build queue, the builds with higher priority are started first"),
+		field.Time("queued_at").Optional().Nillable().Comment("Time the build was added to the build queue"),
+		field.String("base_env_id").SchemaType(map[string]string{dialect.Postgres: "text"}).Optional().Nillable().Comment("Template the snapshot of the build was originally started from, set for the templates created from sandboxes"),
+		field.Bool("checkpoint").Default(false).Annotations(entsql.Default("false")).Comment("The build is a checkpoint of a running sandbox, the sandbox isn't resumed from it"),
+		field.UUID("parent_build_id", uuid.UUID{}).Optional().Nillable().Comment("Build the sandbox was running from when the snapshot was taken, the snapshot files are layered on top of it"),
 	}
 }
 
 func (EnvBuild) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("env", Env.Type).Ref("builds").Unique().Field("env_id"),
+		edge.To("checkpoints", Checkpoint.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", EnvBuildTag.Type).Annotations(entsql.OnDelete(entsql.NoAction)),
 	}
 }
 
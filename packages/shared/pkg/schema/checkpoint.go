@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type Checkpoint struct {
+	ent.Schema
+}
+
+func (Checkpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.String("sandbox_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.UUID("team_id", uuid.UUID{}),
+		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.UUID("env_build_id", uuid.UUID{}),
+		field.UUID("parent_build_id", uuid.UUID{}).Optional().Nillable().Comment("Build the sandbox was running from when the checkpoint was created"),
+	}
+}
+
+func (Checkpoint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("build", EnvBuild.Type).Ref("checkpoints").Unique().Field("env_build_id").Required(),
+	}
+}
+
+func (Checkpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "sandbox_id", "name").Unique(),
+	}
+}
+
+func (Checkpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
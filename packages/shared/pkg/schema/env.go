@@ -31,6 +31,8 @@ func (Env) Fields() []ent.Field {
 		field.Int64("spawn_count").Default(0).Comment("Number of times the env was spawned"),
 		field.Time("last_spawned_at").Optional().Comment("Timestamp of the last time the env was spawned"),
 		field.UUID("cluster_id", uuid.UUID{}).Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "uuid"}),
+		field.String("source_env_id").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}).Comment("Env the snapshot of the sandbox was taken from, its builds are the base of the env builds"),
+		field.String("source_sandbox_id").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}).Comment("Sandbox the env holds the state of for the envs derived from it, the env is removed once it is not used"),
 	}
 }
 
@@ -41,6 +43,8 @@ func (Env) Edges() []ent.Edge {
 		edge.To("env_aliases", EnvAlias.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("builds", EnvBuild.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("snapshots", Snapshot.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("warm_pools", WarmPool.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("build_tags", EnvBuildTag.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
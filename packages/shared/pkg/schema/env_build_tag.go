@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type EnvBuildTag struct {
+	ent.Schema
+}
+
+func (EnvBuildTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.String("env_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("tag").SchemaType(map[string]string{dialect.Postgres: "text"}).Comment("Name of the tag, unique for the template"),
+		field.UUID("build_id", uuid.UUID{}).Comment("Build the tag points to, the build can't be deleted while it's tagged"),
+	}
+}
+
+func (EnvBuildTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("env", Env.Type).Ref("build_tags").Unique().Field("env_id").Required(),
+		edge.From("build", EnvBuild.Type).Ref("tags").Unique().Field("build_id").Required(),
+	}
+}
+
+func (EnvBuildTag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("env_id", "tag").Unique(),
+	}
+}
+
+func (EnvBuildTag) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
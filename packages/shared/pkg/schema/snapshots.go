@@ -8,7 +8,10 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+
+	"github.com/e2b-dev/infra/packages/shared/pkg/schema/types"
 )
 
 type Snapshot struct {
@@ -28,6 +31,16 @@ func (Snapshot) Fields() []ent.Field {
 		field.JSON("metadata", map[string]string{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
 		field.Time("sandbox_started_at"),
 		field.Bool("env_secure").Default(false),
+		field.JSON("network_policy", &types.SandboxNetworkPolicy{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.JSON("port_access", &types.SandboxPortAccess{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.Time("paused_at").Optional().Nillable().Comment("Time the sandbox was paused, not set while the sandbox is running"),
+		field.Int64("retention_hours").Optional().Nillable().Comment("Overrides the tier retention of the paused sandbox"),
+	}
+}
+
+func (Snapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("paused_at"),
 	}
 }
 
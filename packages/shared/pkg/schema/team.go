@@ -37,6 +37,8 @@ func (Team) Edges() []ent.Edge {
 		edge.To("team_api_keys", TeamAPIKey.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.From("team_tier", Tier.Type).Ref("teams").Unique().Field("tier").Required(),
 		edge.To("envs", Env.Type),
+		edge.To("warm_pools", WarmPool.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("webhooks", Webhook.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
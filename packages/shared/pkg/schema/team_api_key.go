@@ -35,6 +35,10 @@ func (TeamAPIKey) Fields() []ent.Field {
 		field.String("name").SchemaType(map[string]string{dialect.Postgres: "text"}).Default("Unnamed API Key"),
 		field.UUID("created_by", uuid.UUID{}).Nillable().Optional(),
 		field.Time("last_used").Nillable().Optional(),
+		field.Time("expires_at").Nillable().Optional(),
+		// Scopes and allowed templates restrict the key, the key without them has full access to the team
+		field.JSON("scopes", []string{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.JSON("allowed_templates", []string{}).Optional().SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
 	}
 }
 
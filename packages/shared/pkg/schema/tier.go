@@ -20,6 +20,21 @@ func (Tier) Fields() []ent.Field {
 		field.Int64("disk_mb").Annotations(entsql.Check("disk_mb > 0"), entsql.Default("512")),
 		field.Int64("concurrent_instances").Annotations(entsql.Check("concurrent_instances > 0")).Comment("The number of instances the team can run concurrently"),
 		field.Int64("max_length_hours"),
+		field.Int64("max_vcpu").Default(8).Annotations(entsql.Default("8")).Comment("The maximum number of vCPUs of the templates and sandboxes"),
+		field.Int64("max_ram_mb").Default(8192).Annotations(entsql.Default("8192")).Comment("The maximum memory in MiB of the templates and sandboxes"),
+		field.Int64("concurrent_vcpus").Default(0).Annotations(entsql.Default("0")).Comment("The number of vCPUs the running sandboxes of the team can use in total, 0 means unlimited"),
+		field.Int64("concurrent_ram_mb").Default(0).Annotations(entsql.Default("0")).Comment("The memory in MiB the running sandboxes of the team can use in total, 0 means unlimited"),
+		field.Int64("paused_storage_mb").Default(0).Annotations(entsql.Default("0")).Comment("The storage in MiB the paused sandbox snapshots of the team can use in total, 0 means unlimited"),
+		field.Int64("paused_retention_hours").Default(0).Annotations(entsql.Default("0")).Comment("How long the paused sandboxes of the team are kept before they are deleted, 0 means forever"),
+		field.Int64("max_checkpoints").Annotations(entsql.Default("10")).Comment("The number of checkpoints kept per sandbox"),
+		field.Int64("network_bandwidth_mibps").Default(0).Annotations(entsql.Default("0")).Comment("Default network bandwidth limit of the sandboxes in MiB/s, 0 means unlimited"),
+		field.Int64("network_ops").Default(0).Annotations(entsql.Default("0")).Comment("Default network packets per second limit of the sandboxes, 0 means unlimited"),
+		field.Int64("disk_bandwidth_mibps").Default(0).Annotations(entsql.Default("0")).Comment("Default disk bandwidth limit of the sandboxes in MiB/s, 0 means unlimited"),
+		field.Int64("disk_iops").Default(0).Annotations(entsql.Default("0")).Comment("Default disk operations per second limit of the sandboxes, 0 means unlimited"),
+		field.Int64("api_requests_per_second").Default(20).Annotations(entsql.Default("20")).Comment("Number of API requests per second the team can make to each endpoint, 0 means unlimited"),
+		field.Int64("api_requests_burst").Default(100).Annotations(entsql.Default("100")).Comment("Number of API requests the team can make to each endpoint in a burst"),
+		field.Int64("concurrent_template_builds").Default(0).Annotations(entsql.Default("0")).Comment("The number of template builds the team can run concurrently, 0 means unlimited"),
+		field.Int64("build_priority").Default(0).Annotations(entsql.Default("0")).Comment("Priority of the template builds of the team in the build queue"),
 	}
 }
 
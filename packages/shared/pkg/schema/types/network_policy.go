@@ -0,0 +1,11 @@
+package types
+
+// SandboxNetworkPolicy is the egress firewall policy of a sandbox, it is stored with the sandbox snapshot so it's kept after resume.
+type SandboxNetworkPolicy struct {
+	AllowedCIDRs   []string `json:"allowed_cidrs,omitempty"`
+	DeniedCIDRs    []string `json:"denied_cidrs,omitempty"`
+	AllowedDomains []string `json:"allowed_domains,omitempty"`
+	DeniedDomains  []string `json:"denied_domains,omitempty"`
+	AllowedPorts   []uint32 `json:"allowed_ports,omitempty"`
+	DeniedPorts    []uint32 `json:"denied_ports,omitempty"`
+}
@@ -0,0 +1,6 @@
+package types
+
+// SandboxPortAccess is the inbound port access of a sandbox, it is stored with the sandbox snapshot so it's kept after resume.
+type SandboxPortAccess struct {
+	PublicPorts []uint32 `json:"public_ports"`
+}
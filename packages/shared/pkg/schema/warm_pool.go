@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type WarmPool struct {
+	ent.Schema
+}
+
+func (WarmPool) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.UUID("team_id", uuid.UUID{}),
+		field.String("env_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int32("size").Comment("Number of pre-warmed sandboxes kept ready for the team"),
+	}
+}
+
+func (WarmPool) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).Ref("warm_pools").Unique().Field("team_id").Required(),
+		edge.From("env", Env.Type).Ref("warm_pools").Unique().Field("env_id").Required(),
+	}
+}
+
+func (WarmPool) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id", "env_id").Unique(),
+	}
+}
+
+func (WarmPool) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.UUID("team_id", uuid.UUID{}),
+		field.String("url").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("secret").Sensitive().SchemaType(map[string]string{dialect.Postgres: "text"}).Comment("Key the deliveries are signed with"),
+		field.JSON("events", []string{}).SchemaType(map[string]string{dialect.Postgres: "jsonb"}).Comment("Event types delivered to the webhook"),
+		field.Bool("enabled").Default(true).Annotations(entsql.Default("true")),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("team", Team.Type).Ref("webhooks").Unique().Field("team_id").Required(),
+		edge.To("deliveries", WebhookDelivery.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("team_id"),
+	}
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Immutable().Unique().Annotations(entsql.Default("gen_random_uuid()")),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(
+				entsql.Default("CURRENT_TIMESTAMP"),
+			),
+		field.UUID("webhook_id", uuid.UUID{}),
+		field.UUID("event_id", uuid.UUID{}),
+		field.String("event_type").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.String("sandbox_id").SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int32("attempt"),
+		field.Bool("success"),
+		field.Int32("status_code").Optional().Nillable(),
+		field.String("error").Optional().Nillable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).Ref("deliveries").Unique().Field("webhook_id").Required(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "created_at"),
+	}
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Mixin{},
+	}
+}
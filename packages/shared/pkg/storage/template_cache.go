@@ -41,6 +41,14 @@ func (c *TemplateCacheFiles) CacheSnapfilePath() string {
 	return filepath.Join(c.cacheDir(), SnapfileName)
 }
 
+func (c *TemplateCacheFiles) CacheMemfilePath() string {
+	return filepath.Join(c.cacheDir(), MemfileName)
+}
+
+func (c *TemplateCacheFiles) CacheRootfsPath() string {
+	return filepath.Join(c.cacheDir(), RootfsName)
+}
+
 func (c *TemplateCacheFiles) cacheDir() string {
 	return filepath.Join(templateCacheDir, c.TemplateId, c.BuildId, "cache", c.CacheIdentifier)
 }
@@ -11,7 +11,9 @@ type (
 )
 
 const (
-	SandboxCreateMeterName CounterType = "api.env.instance.started"
+	SandboxCreateMeterName        CounterType = "api.env.instance.started"
+	WarmPoolClaimCounterMeterName CounterType = "api.env.instance.warm_pool.claims"
+	RateLimitCounterMeterName     CounterType = "api.rate_limit.requests"
 )
 
 const (
@@ -41,18 +43,24 @@ const (
 
 const (
 	ApiOrchestratorCountMeterName GaugeIntType = "api.orchestrator.status"
+	WarmPoolReadyGaugeName        GaugeIntType = "api.env.instance.warm_pool.ready"
 
-	SandboxRamUsedGaugeName  GaugeIntType = "e2b.sandbox.ram.used"
-	SandboxRamTotalGaugeName GaugeIntType = "e2b.sandbox.ram.total"
-	SandboxCpuTotalGaugeName GaugeIntType = "e2b.sandbox.cpu.total"
+	SandboxRamUsedGaugeName      GaugeIntType = "e2b.sandbox.ram.used"
+	SandboxRamTotalGaugeName     GaugeIntType = "e2b.sandbox.ram.total"
+	SandboxRamReclaimedGaugeName GaugeIntType = "e2b.sandbox.ram.reclaimed"
+	SandboxCpuTotalGaugeName     GaugeIntType = "e2b.sandbox.cpu.total"
 )
 
 var counterDesc = map[CounterType]string{
-	SandboxCreateMeterName: "Number of currently waiting requests to create a new sandbox",
+	SandboxCreateMeterName:        "Number of currently waiting requests to create a new sandbox",
+	WarmPoolClaimCounterMeterName: "Number of sandbox creations for templates with a warm pool, by whether a pooled sandbox was claimed.",
+	RateLimitCounterMeterName:     "Number of API requests checked by the team rate limiter, by whether they were allowed.",
 }
 
 var counterUnits = map[CounterType]string{
-	SandboxCreateMeterName: "{sandbox}",
+	SandboxCreateMeterName:        "{sandbox}",
+	WarmPoolClaimCounterMeterName: "{sandbox}",
+	RateLimitCounterMeterName:     "{request}",
 }
 
 var upDownCounterDesc = map[UpDownCounterType]string{
@@ -101,15 +109,19 @@ var gaugeFloatUnits = map[GaugeFloatType]string{
 
 var gaugeIntDesc = map[GaugeIntType]string{
 	ApiOrchestratorCountMeterName: "Counter of running orchestrators.",
+	WarmPoolReadyGaugeName:        "Number of pooled sandboxes ready to be claimed.",
 	SandboxRamUsedGaugeName:       "Amount of RAM used by the sandbox.",
 	SandboxRamTotalGaugeName:      "Amount of RAM available to the sandbox.",
+	SandboxRamReclaimedGaugeName:  "Amount of RAM reclaimed from the sandbox by the balloon device.",
 	SandboxCpuTotalGaugeName:      "Amount of CPU available to the sandbox.",
 }
 
 var gaugeIntUnits = map[GaugeIntType]string{
 	ApiOrchestratorCountMeterName: "{orchestrator}",
+	WarmPoolReadyGaugeName:        "{sandbox}",
 	SandboxRamUsedGaugeName:       "{By}",
 	SandboxRamTotalGaugeName:      "{By}",
+	SandboxRamReclaimedGaugeName:  "{By}",
 	SandboxCpuTotalGaugeName:      "{count}",
 }
 